@@ -0,0 +1,854 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/account.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetTradingAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradingAccountRequest) Reset() {
+	*x = GetTradingAccountRequest{}
+	mi := &file_user_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradingAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradingAccountRequest) ProtoMessage() {}
+
+func (x *GetTradingAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradingAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetTradingAccountRequest) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTradingAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetTradingAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TradingAccount        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradingAccountResponse) Reset() {
+	*x = GetTradingAccountResponse{}
+	mi := &file_user_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradingAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradingAccountResponse) ProtoMessage() {}
+
+func (x *GetTradingAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradingAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetTradingAccountResponse) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTradingAccountResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetTradingAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTradingAccountResponse) GetData() *TradingAccount {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetTradingTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Tier          string                 `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTradingTierRequest) Reset() {
+	*x = SetTradingTierRequest{}
+	mi := &file_user_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTradingTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTradingTierRequest) ProtoMessage() {}
+
+func (x *SetTradingTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTradingTierRequest.ProtoReflect.Descriptor instead.
+func (*SetTradingTierRequest) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetTradingTierRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SetTradingTierRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+type SetTradingTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TradingAccount        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTradingTierResponse) Reset() {
+	*x = SetTradingTierResponse{}
+	mi := &file_user_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTradingTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTradingTierResponse) ProtoMessage() {}
+
+func (x *SetTradingTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTradingTierResponse.ProtoReflect.Descriptor instead.
+func (*SetTradingTierResponse) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetTradingTierResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetTradingTierResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetTradingTierResponse) GetData() *TradingAccount {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AdjustBalanceRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Stock code of a share adjustment; empty adjusts cash.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// VND for cash, shares for stocks; negative takes out of the account.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Identifies the adjustment so it is posted once.
+	Reference     string `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	Note          string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustBalanceRequest) Reset() {
+	*x = AdjustBalanceRequest{}
+	mi := &file_user_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustBalanceRequest) ProtoMessage() {}
+
+func (x *AdjustBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustBalanceRequest.ProtoReflect.Descriptor instead.
+func (*AdjustBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AdjustBalanceRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdjustBalanceRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AdjustBalanceRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdjustBalanceRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *AdjustBalanceRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdjustBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *LedgerEntry           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustBalanceResponse) Reset() {
+	*x = AdjustBalanceResponse{}
+	mi := &file_user_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustBalanceResponse) ProtoMessage() {}
+
+func (x *AdjustBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustBalanceResponse.ProtoReflect.Descriptor instead.
+func (*AdjustBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AdjustBalanceResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AdjustBalanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AdjustBalanceResponse) GetData() *LedgerEntry {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TradingAccount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tier  string                 `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	// Settled cash in VND.
+	Cash int64 `protobuf:"varint,2,opt,name=cash,proto3" json:"cash,omitempty"`
+	// Settled cash neither open buy orders nor open withdrawals hold back.
+	BuyingPower int64       `protobuf:"varint,3,opt,name=buying_power,json=buyingPower,proto3" json:"buying_power,omitempty"`
+	Positions   []*Position `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Cash pending settlements will deliver.
+	PendingCash int64 `protobuf:"varint,5,opt,name=pending_cash,json=pendingCash,proto3" json:"pending_cash,omitempty"`
+	// Pending settlements, earliest first. Only filled in GetTradingAccount.
+	Settlements []*Settlement `protobuf:"bytes,6,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	// Cash held by withdrawals that have not completed or failed.
+	Withheld      int64 `protobuf:"varint,7,opt,name=withheld,proto3" json:"withheld,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradingAccount) Reset() {
+	*x = TradingAccount{}
+	mi := &file_user_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradingAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingAccount) ProtoMessage() {}
+
+func (x *TradingAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingAccount.ProtoReflect.Descriptor instead.
+func (*TradingAccount) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TradingAccount) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *TradingAccount) GetCash() int64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *TradingAccount) GetBuyingPower() int64 {
+	if x != nil {
+		return x.BuyingPower
+	}
+	return 0
+}
+
+func (x *TradingAccount) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *TradingAccount) GetPendingCash() int64 {
+	if x != nil {
+		return x.PendingCash
+	}
+	return 0
+}
+
+func (x *TradingAccount) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
+func (x *TradingAccount) GetWithheld() int64 {
+	if x != nil {
+		return x.Withheld
+	}
+	return 0
+}
+
+type Position struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Settled shares.
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Settled shares not reserved by open sell orders. Only filled in
+	// GetTradingAccount.
+	Available int64 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	// Shares bought that have not settled.
+	Pending       int64 `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_user_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Position) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Position) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Position) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *Position) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+type Settlement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Stock code; empty for cash.
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Reference of the trade, such as its fill.
+	Reference string `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Settlement date as YYYY-MM-DD.
+	SettlesOn     string `protobuf:"bytes,5,opt,name=settles_on,json=settlesOn,proto3" json:"settles_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_user_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Settlement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Settlement) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Settlement) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Settlement) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Settlement) GetSettlesOn() string {
+	if x != nil {
+		return x.SettlesOn
+	}
+	return ""
+}
+
+type LedgerEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Stock code; empty for cash.
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// deposit, withdrawal, trade, adjustment, settlement, fee or tax.
+	Type          string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reference     string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Note          string `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_user_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_user_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_user_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LedgerEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_user_account_proto protoreflect.FileDescriptor
+
+const file_user_account_proto_rawDesc = "" +
+	"\n" +
+	"\x12user/account.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"A\n" +
+	"\x18GetTradingAccountRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\"\x89\x01\n" +
+	"\x19GetTradingAccountResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
+	"\x04data\x18\x03 \x01(\v2*.stock_trading.user_service.TradingAccountR\x04data\"]\n" +
+	"\x15SetTradingTierRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1d\n" +
+	"\x04tier\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04tier\"\x86\x01\n" +
+	"\x16SetTradingTierResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12>\n" +
+	"\x04data\x18\x03 \x01(\v2*.stock_trading.user_service.TradingAccountR\x04data\"\xc2\x01\n" +
+	"\x14AdjustBalanceRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1b\n" +
+	"\x04code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18\n" +
+	"R\x04code\x12\x1f\n" +
+	"\x06amount\x18\x03 \x01(\x03B\a\xfaB\x04\"\x028\x00R\x06amount\x12'\n" +
+	"\treference\x18\x04 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\treference\x12\x1c\n" +
+	"\x04note\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04note\"\x82\x01\n" +
+	"\x15AdjustBalanceResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.LedgerEntryR\x04data\"\xa8\x02\n" +
+	"\x0eTradingAccount\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\tR\x04tier\x12\x12\n" +
+	"\x04cash\x18\x02 \x01(\x03R\x04cash\x12!\n" +
+	"\fbuying_power\x18\x03 \x01(\x03R\vbuyingPower\x12B\n" +
+	"\tpositions\x18\x04 \x03(\v2$.stock_trading.user_service.PositionR\tpositions\x12!\n" +
+	"\fpending_cash\x18\x05 \x01(\x03R\vpendingCash\x12H\n" +
+	"\vsettlements\x18\x06 \x03(\v2&.stock_trading.user_service.SettlementR\vsettlements\x12\x1a\n" +
+	"\bwithheld\x18\a \x01(\x03R\bwithheld\"r\n" +
+	"\bPosition\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x03R\tavailable\x12\x18\n" +
+	"\apending\x18\x04 \x01(\x03R\apending\"\x85\x01\n" +
+	"\n" +
+	"Settlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12\x1d\n" +
+	"\n" +
+	"settles_on\x18\x05 \x01(\tR\tsettlesOn\"\xae\x01\n" +
+	"\vLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt2\xa3\x04\n" +
+	"\x0eAccountService\x12\xa9\x01\n" +
+	"\x11GetTradingAccount\x124.stock_trading.user_service.GetTradingAccountRequest\x1a5.stock_trading.user_service.GetTradingAccountResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/user/{username}/account\x12\xaf\x01\n" +
+	"\x0eSetTradingTier\x121.stock_trading.user_service.SetTradingTierRequest\x1a2.stock_trading.user_service.SetTradingTierResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/admin/users/{username}/trading-tier\x12\xb2\x01\n" +
+	"\rAdjustBalance\x120.stock_trading.user_service.AdjustBalanceRequest\x1a1.stock_trading.user_service.AdjustBalanceResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/admin/users/{username}/ledger-adjustmentsB\xe4\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\fAccountProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_account_proto_rawDescOnce sync.Once
+	file_user_account_proto_rawDescData []byte
+)
+
+func file_user_account_proto_rawDescGZIP() []byte {
+	file_user_account_proto_rawDescOnce.Do(func() {
+		file_user_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_account_proto_rawDesc), len(file_user_account_proto_rawDesc)))
+	})
+	return file_user_account_proto_rawDescData
+}
+
+var file_user_account_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_user_account_proto_goTypes = []any{
+	(*GetTradingAccountRequest)(nil),  // 0: stock_trading.user_service.GetTradingAccountRequest
+	(*GetTradingAccountResponse)(nil), // 1: stock_trading.user_service.GetTradingAccountResponse
+	(*SetTradingTierRequest)(nil),     // 2: stock_trading.user_service.SetTradingTierRequest
+	(*SetTradingTierResponse)(nil),    // 3: stock_trading.user_service.SetTradingTierResponse
+	(*AdjustBalanceRequest)(nil),      // 4: stock_trading.user_service.AdjustBalanceRequest
+	(*AdjustBalanceResponse)(nil),     // 5: stock_trading.user_service.AdjustBalanceResponse
+	(*TradingAccount)(nil),            // 6: stock_trading.user_service.TradingAccount
+	(*Position)(nil),                  // 7: stock_trading.user_service.Position
+	(*Settlement)(nil),                // 8: stock_trading.user_service.Settlement
+	(*LedgerEntry)(nil),               // 9: stock_trading.user_service.LedgerEntry
+}
+var file_user_account_proto_depIdxs = []int32{
+	6, // 0: stock_trading.user_service.GetTradingAccountResponse.data:type_name -> stock_trading.user_service.TradingAccount
+	6, // 1: stock_trading.user_service.SetTradingTierResponse.data:type_name -> stock_trading.user_service.TradingAccount
+	9, // 2: stock_trading.user_service.AdjustBalanceResponse.data:type_name -> stock_trading.user_service.LedgerEntry
+	7, // 3: stock_trading.user_service.TradingAccount.positions:type_name -> stock_trading.user_service.Position
+	8, // 4: stock_trading.user_service.TradingAccount.settlements:type_name -> stock_trading.user_service.Settlement
+	0, // 5: stock_trading.user_service.AccountService.GetTradingAccount:input_type -> stock_trading.user_service.GetTradingAccountRequest
+	2, // 6: stock_trading.user_service.AccountService.SetTradingTier:input_type -> stock_trading.user_service.SetTradingTierRequest
+	4, // 7: stock_trading.user_service.AccountService.AdjustBalance:input_type -> stock_trading.user_service.AdjustBalanceRequest
+	1, // 8: stock_trading.user_service.AccountService.GetTradingAccount:output_type -> stock_trading.user_service.GetTradingAccountResponse
+	3, // 9: stock_trading.user_service.AccountService.SetTradingTier:output_type -> stock_trading.user_service.SetTradingTierResponse
+	5, // 10: stock_trading.user_service.AccountService.AdjustBalance:output_type -> stock_trading.user_service.AdjustBalanceResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_user_account_proto_init() }
+func file_user_account_proto_init() {
+	if File_user_account_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_account_proto_rawDesc), len(file_user_account_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_account_proto_goTypes,
+		DependencyIndexes: file_user_account_proto_depIdxs,
+		MessageInfos:      file_user_account_proto_msgTypes,
+	}.Build()
+	File_user_account_proto = out.File
+	file_user_account_proto_goTypes = nil
+	file_user_account_proto_depIdxs = nil
+}
@@ -0,0 +1,1346 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/account.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetTradingAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTradingAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTradingAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTradingAccountRequestMultiError, or nil if none found.
+func (m *GetTradingAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTradingAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := GetTradingAccountRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTradingAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTradingAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTradingAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTradingAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTradingAccountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTradingAccountRequestMultiError) AllErrors() []error { return m }
+
+// GetTradingAccountRequestValidationError is the validation error returned by
+// GetTradingAccountRequest.Validate if the designated constraints aren't met.
+type GetTradingAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTradingAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTradingAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTradingAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTradingAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTradingAccountRequestValidationError) ErrorName() string {
+	return "GetTradingAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTradingAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTradingAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTradingAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTradingAccountRequestValidationError{}
+
+// Validate checks the field values on GetTradingAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTradingAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTradingAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTradingAccountResponseMultiError, or nil if none found.
+func (m *GetTradingAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTradingAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTradingAccountResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTradingAccountResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTradingAccountResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTradingAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTradingAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTradingAccountResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetTradingAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTradingAccountResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTradingAccountResponseMultiError) AllErrors() []error { return m }
+
+// GetTradingAccountResponseValidationError is the validation error returned by
+// GetTradingAccountResponse.Validate if the designated constraints aren't met.
+type GetTradingAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTradingAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTradingAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTradingAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTradingAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTradingAccountResponseValidationError) ErrorName() string {
+	return "GetTradingAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTradingAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTradingAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTradingAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTradingAccountResponseValidationError{}
+
+// Validate checks the field values on SetTradingTierRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTradingTierRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTradingTierRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTradingTierRequestMultiError, or nil if none found.
+func (m *SetTradingTierRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTradingTierRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := SetTradingTierRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTier()); l < 1 || l > 32 {
+		err := SetTradingTierRequestValidationError{
+			field:  "Tier",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetTradingTierRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTradingTierRequestMultiError is an error wrapping multiple validation
+// errors returned by SetTradingTierRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetTradingTierRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTradingTierRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTradingTierRequestMultiError) AllErrors() []error { return m }
+
+// SetTradingTierRequestValidationError is the validation error returned by
+// SetTradingTierRequest.Validate if the designated constraints aren't met.
+type SetTradingTierRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTradingTierRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTradingTierRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTradingTierRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTradingTierRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTradingTierRequestValidationError) ErrorName() string {
+	return "SetTradingTierRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTradingTierRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTradingTierRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTradingTierRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTradingTierRequestValidationError{}
+
+// Validate checks the field values on SetTradingTierResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTradingTierResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTradingTierResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTradingTierResponseMultiError, or nil if none found.
+func (m *SetTradingTierResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTradingTierResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetTradingTierResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetTradingTierResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetTradingTierResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetTradingTierResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTradingTierResponseMultiError is an error wrapping multiple validation
+// errors returned by SetTradingTierResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetTradingTierResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTradingTierResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTradingTierResponseMultiError) AllErrors() []error { return m }
+
+// SetTradingTierResponseValidationError is the validation error returned by
+// SetTradingTierResponse.Validate if the designated constraints aren't met.
+type SetTradingTierResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTradingTierResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTradingTierResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTradingTierResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTradingTierResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTradingTierResponseValidationError) ErrorName() string {
+	return "SetTradingTierResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTradingTierResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTradingTierResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTradingTierResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTradingTierResponseValidationError{}
+
+// Validate checks the field values on AdjustBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustBalanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustBalanceRequestMultiError, or nil if none found.
+func (m *AdjustBalanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustBalanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := AdjustBalanceRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) > 10 {
+		err := AdjustBalanceRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at most 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdjustBalanceRequest_Amount_NotInLookup[m.GetAmount()]; ok {
+		err := AdjustBalanceRequestValidationError{
+			field:  "Amount",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReference()); l < 1 || l > 50 {
+		err := AdjustBalanceRequestValidationError{
+			field:  "Reference",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := AdjustBalanceRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdjustBalanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustBalanceRequestMultiError is an error wrapping multiple validation
+// errors returned by AdjustBalanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustBalanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustBalanceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustBalanceRequestMultiError) AllErrors() []error { return m }
+
+// AdjustBalanceRequestValidationError is the validation error returned by
+// AdjustBalanceRequest.Validate if the designated constraints aren't met.
+type AdjustBalanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustBalanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustBalanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustBalanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustBalanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustBalanceRequestValidationError) ErrorName() string {
+	return "AdjustBalanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustBalanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustBalanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustBalanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustBalanceRequestValidationError{}
+
+var _AdjustBalanceRequest_Amount_NotInLookup = map[int64]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AdjustBalanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustBalanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustBalanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustBalanceResponseMultiError, or nil if none found.
+func (m *AdjustBalanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustBalanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustBalanceResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustBalanceResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustBalanceResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdjustBalanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustBalanceResponseMultiError is an error wrapping multiple validation
+// errors returned by AdjustBalanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustBalanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustBalanceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustBalanceResponseMultiError) AllErrors() []error { return m }
+
+// AdjustBalanceResponseValidationError is the validation error returned by
+// AdjustBalanceResponse.Validate if the designated constraints aren't met.
+type AdjustBalanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustBalanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustBalanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustBalanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustBalanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustBalanceResponseValidationError) ErrorName() string {
+	return "AdjustBalanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustBalanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustBalanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustBalanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustBalanceResponseValidationError{}
+
+// Validate checks the field values on TradingAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TradingAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TradingAccount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TradingAccountMultiError,
+// or nil if none found.
+func (m *TradingAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TradingAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tier
+
+	// no validation rules for Cash
+
+	// no validation rules for BuyingPower
+
+	for idx, item := range m.GetPositions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TradingAccountValidationError{
+						field:  fmt.Sprintf("Positions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TradingAccountValidationError{
+						field:  fmt.Sprintf("Positions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TradingAccountValidationError{
+					field:  fmt.Sprintf("Positions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PendingCash
+
+	for idx, item := range m.GetSettlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TradingAccountValidationError{
+						field:  fmt.Sprintf("Settlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TradingAccountValidationError{
+						field:  fmt.Sprintf("Settlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TradingAccountValidationError{
+					field:  fmt.Sprintf("Settlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Withheld
+
+	if len(errors) > 0 {
+		return TradingAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// TradingAccountMultiError is an error wrapping multiple validation errors
+// returned by TradingAccount.ValidateAll() if the designated constraints
+// aren't met.
+type TradingAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TradingAccountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TradingAccountMultiError) AllErrors() []error { return m }
+
+// TradingAccountValidationError is the validation error returned by
+// TradingAccount.Validate if the designated constraints aren't met.
+type TradingAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TradingAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TradingAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TradingAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TradingAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TradingAccountValidationError) ErrorName() string { return "TradingAccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TradingAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTradingAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TradingAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TradingAccountValidationError{}
+
+// Validate checks the field values on Position with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Position) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Position with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PositionMultiError, or nil
+// if none found.
+func (m *Position) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Position) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Quantity
+
+	// no validation rules for Available
+
+	// no validation rules for Pending
+
+	if len(errors) > 0 {
+		return PositionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PositionMultiError is an error wrapping multiple validation errors returned
+// by Position.ValidateAll() if the designated constraints aren't met.
+type PositionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PositionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PositionMultiError) AllErrors() []error { return m }
+
+// PositionValidationError is the validation error returned by
+// Position.Validate if the designated constraints aren't met.
+type PositionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PositionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PositionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PositionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PositionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PositionValidationError) ErrorName() string { return "PositionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PositionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPosition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PositionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PositionValidationError{}
+
+// Validate checks the field values on Settlement with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Settlement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Settlement with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SettlementMultiError, or
+// nil if none found.
+func (m *Settlement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Settlement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Amount
+
+	// no validation rules for Reference
+
+	// no validation rules for SettlesOn
+
+	if len(errors) > 0 {
+		return SettlementMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettlementMultiError is an error wrapping multiple validation errors
+// returned by Settlement.ValidateAll() if the designated constraints aren't met.
+type SettlementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettlementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettlementMultiError) AllErrors() []error { return m }
+
+// SettlementValidationError is the validation error returned by
+// Settlement.Validate if the designated constraints aren't met.
+type SettlementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettlementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettlementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettlementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettlementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettlementValidationError) ErrorName() string { return "SettlementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SettlementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettlement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettlementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettlementValidationError{}
+
+// Validate checks the field values on LedgerEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LedgerEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LedgerEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LedgerEntryMultiError, or
+// nil if none found.
+func (m *LedgerEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LedgerEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reference
+
+	// no validation rules for Note
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return LedgerEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// LedgerEntryMultiError is an error wrapping multiple validation errors
+// returned by LedgerEntry.ValidateAll() if the designated constraints aren't met.
+type LedgerEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LedgerEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LedgerEntryMultiError) AllErrors() []error { return m }
+
+// LedgerEntryValidationError is the validation error returned by
+// LedgerEntry.Validate if the designated constraints aren't met.
+type LedgerEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LedgerEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LedgerEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LedgerEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LedgerEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LedgerEntryValidationError) ErrorName() string { return "LedgerEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LedgerEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLedgerEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LedgerEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LedgerEntryValidationError{}
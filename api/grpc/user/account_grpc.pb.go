@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: user/account.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AccountService_GetTradingAccount_FullMethodName = "/stock_trading.user_service.AccountService/GetTradingAccount"
+	AccountService_SetTradingTier_FullMethodName    = "/stock_trading.user_service.AccountService/SetTradingTier"
+	AccountService_AdjustBalance_FullMethodName     = "/stock_trading.user_service.AccountService/AdjustBalance"
+)
+
+// AccountServiceClient is the client API for AccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AccountService shows the cash and shares users hold, summed over their
+// ledger. The tier of a user selects the risk limits orders are checked
+// against; administrators set it and post adjustments such as opening
+// balances.
+type AccountServiceClient interface {
+	// GetTradingAccount returns the caller's balances and what open orders
+	// leave free of them.
+	GetTradingAccount(ctx context.Context, in *GetTradingAccountRequest, opts ...grpc.CallOption) (*GetTradingAccountResponse, error)
+	// SetTradingTier moves a user to one of the configured tiers.
+	SetTradingTier(ctx context.Context, in *SetTradingTierRequest, opts ...grpc.CallOption) (*SetTradingTierResponse, error)
+	// AdjustBalance posts an adjustment of cash, or of the shares of one stock,
+	// to a user's ledger. Posting a reference again posts nothing and returns
+	// the entry stored the first time.
+	AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error)
+}
+
+type accountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountServiceClient(cc grpc.ClientConnInterface) AccountServiceClient {
+	return &accountServiceClient{cc}
+}
+
+func (c *accountServiceClient) GetTradingAccount(ctx context.Context, in *GetTradingAccountRequest, opts ...grpc.CallOption) (*GetTradingAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTradingAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetTradingAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetTradingTier(ctx context.Context, in *SetTradingTierRequest, opts ...grpc.CallOption) (*SetTradingTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTradingTierResponse)
+	err := c.cc.Invoke(ctx, AccountService_SetTradingTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustBalanceResponse)
+	err := c.cc.Invoke(ctx, AccountService_AdjustBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountServiceServer is the server API for AccountService service.
+// All implementations must embed UnimplementedAccountServiceServer
+// for forward compatibility.
+//
+// AccountService shows the cash and shares users hold, summed over their
+// ledger. The tier of a user selects the risk limits orders are checked
+// against; administrators set it and post adjustments such as opening
+// balances.
+type AccountServiceServer interface {
+	// GetTradingAccount returns the caller's balances and what open orders
+	// leave free of them.
+	GetTradingAccount(context.Context, *GetTradingAccountRequest) (*GetTradingAccountResponse, error)
+	// SetTradingTier moves a user to one of the configured tiers.
+	SetTradingTier(context.Context, *SetTradingTierRequest) (*SetTradingTierResponse, error)
+	// AdjustBalance posts an adjustment of cash, or of the shares of one stock,
+	// to a user's ledger. Posting a reference again posts nothing and returns
+	// the entry stored the first time.
+	AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error)
+	mustEmbedUnimplementedAccountServiceServer()
+}
+
+// UnimplementedAccountServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountServiceServer struct{}
+
+func (UnimplementedAccountServiceServer) GetTradingAccount(context.Context, *GetTradingAccountRequest) (*GetTradingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradingAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) SetTradingTier(context.Context, *SetTradingTierRequest) (*SetTradingTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTradingTier not implemented")
+}
+func (UnimplementedAccountServiceServer) AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustBalance not implemented")
+}
+func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
+func (UnimplementedAccountServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountServiceServer will
+// result in compilation errors.
+type UnsafeAccountServiceServer interface {
+	mustEmbedUnimplementedAccountServiceServer()
+}
+
+func RegisterAccountServiceServer(s grpc.ServiceRegistrar, srv AccountServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAccountServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountService_ServiceDesc, srv)
+}
+
+func _AccountService_GetTradingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetTradingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetTradingAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetTradingAccount(ctx, req.(*GetTradingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetTradingTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTradingTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetTradingTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetTradingTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetTradingTier(ctx, req.(*SetTradingTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_AdjustBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).AdjustBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_AdjustBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).AdjustBalance(ctx, req.(*AdjustBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stock_trading.user_service.AccountService",
+	HandlerType: (*AccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTradingAccount",
+			Handler:    _AccountService_GetTradingAccount_Handler,
+		},
+		{
+			MethodName: "SetTradingTier",
+			Handler:    _AccountService_SetTradingTier_Handler,
+		},
+		{
+			MethodName: "AdjustBalance",
+			Handler:    _AccountService_AdjustBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/account.proto",
+}
@@ -0,0 +1,662 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/corporate_action.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateCorporateActionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// cash_dividend, stock_dividend or split.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// VND paid per share held; cash dividends only.
+	CashPerShare int64 `protobuf:"varint,3,opt,name=cash_per_share,json=cashPerShare,proto3" json:"cash_per_share,omitempty"`
+	// Every old_shares shares held become new_shares shares; stock dividends
+	// and splits only.
+	OldShares int64 `protobuf:"varint,4,opt,name=old_shares,json=oldShares,proto3" json:"old_shares,omitempty"`
+	NewShares int64 `protobuf:"varint,5,opt,name=new_shares,json=newShares,proto3" json:"new_shares,omitempty"`
+	// YYYY-MM-DD. The ex-date must be a future trading day; the record date
+	// may not precede it.
+	ExDate        string `protobuf:"bytes,6,opt,name=ex_date,json=exDate,proto3" json:"ex_date,omitempty"`
+	RecordDate    string `protobuf:"bytes,7,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCorporateActionRequest) Reset() {
+	*x = CreateCorporateActionRequest{}
+	mi := &file_user_corporate_action_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCorporateActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCorporateActionRequest) ProtoMessage() {}
+
+func (x *CreateCorporateActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_corporate_action_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCorporateActionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCorporateActionRequest) Descriptor() ([]byte, []int) {
+	return file_user_corporate_action_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCorporateActionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCorporateActionRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateCorporateActionRequest) GetCashPerShare() int64 {
+	if x != nil {
+		return x.CashPerShare
+	}
+	return 0
+}
+
+func (x *CreateCorporateActionRequest) GetOldShares() int64 {
+	if x != nil {
+		return x.OldShares
+	}
+	return 0
+}
+
+func (x *CreateCorporateActionRequest) GetNewShares() int64 {
+	if x != nil {
+		return x.NewShares
+	}
+	return 0
+}
+
+func (x *CreateCorporateActionRequest) GetExDate() string {
+	if x != nil {
+		return x.ExDate
+	}
+	return ""
+}
+
+func (x *CreateCorporateActionRequest) GetRecordDate() string {
+	if x != nil {
+		return x.RecordDate
+	}
+	return ""
+}
+
+type CreateCorporateActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *CorporateAction       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCorporateActionResponse) Reset() {
+	*x = CreateCorporateActionResponse{}
+	mi := &file_user_corporate_action_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCorporateActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCorporateActionResponse) ProtoMessage() {}
+
+func (x *CreateCorporateActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_corporate_action_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCorporateActionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCorporateActionResponse) Descriptor() ([]byte, []int) {
+	return file_user_corporate_action_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCorporateActionResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateCorporateActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateCorporateActionResponse) GetData() *CorporateAction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListCorporateActionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty lists every stock.
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCorporateActionsRequest) Reset() {
+	*x = ListCorporateActionsRequest{}
+	mi := &file_user_corporate_action_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCorporateActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorporateActionsRequest) ProtoMessage() {}
+
+func (x *ListCorporateActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_corporate_action_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorporateActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCorporateActionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_corporate_action_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListCorporateActionsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListCorporateActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*CorporateAction     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCorporateActionsResponse) Reset() {
+	*x = ListCorporateActionsResponse{}
+	mi := &file_user_corporate_action_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCorporateActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCorporateActionsResponse) ProtoMessage() {}
+
+func (x *ListCorporateActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_corporate_action_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCorporateActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCorporateActionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_corporate_action_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCorporateActionsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListCorporateActionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListCorporateActionsResponse) GetData() []*CorporateAction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteCorporateActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActionId      int64                  `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCorporateActionRequest) Reset() {
+	*x = DeleteCorporateActionRequest{}
+	mi := &file_user_corporate_action_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCorporateActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCorporateActionRequest) ProtoMessage() {}
+
+func (x *DeleteCorporateActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_corporate_action_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCorporateActionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCorporateActionRequest) Descriptor() ([]byte, []int) {
+	return file_user_corporate_action_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteCorporateActionRequest) GetActionId() int64 {
+	if x != nil {
+		return x.ActionId
+	}
+	return 0
+}
+
+type DeleteCorporateActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCorporateActionResponse) Reset() {
+	*x = DeleteCorporateActionResponse{}
+	mi := &file_user_corporate_action_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCorporateActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCorporateActionResponse) ProtoMessage() {}
+
+func (x *DeleteCorporateActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_corporate_action_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCorporateActionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCorporateActionResponse) Descriptor() ([]byte, []int) {
+	return file_user_corporate_action_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCorporateActionResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteCorporateActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CorporateAction struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code         string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Kind         string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	CashPerShare int64                  `protobuf:"varint,4,opt,name=cash_per_share,json=cashPerShare,proto3" json:"cash_per_share,omitempty"`
+	OldShares    int64                  `protobuf:"varint,5,opt,name=old_shares,json=oldShares,proto3" json:"old_shares,omitempty"`
+	NewShares    int64                  `protobuf:"varint,6,opt,name=new_shares,json=newShares,proto3" json:"new_shares,omitempty"`
+	// YYYY-MM-DD.
+	ExDate     string `protobuf:"bytes,7,opt,name=ex_date,json=exDate,proto3" json:"ex_date,omitempty"`
+	RecordDate string `protobuf:"bytes,8,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	// scheduled or applied.
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// Prices recorded before the ex-date were multiplied by
+	// price_numerator / price_denominator; set once applied.
+	PriceNumerator   int64 `protobuf:"varint,10,opt,name=price_numerator,json=priceNumerator,proto3" json:"price_numerator,omitempty"`
+	PriceDenominator int64 `protobuf:"varint,11,opt,name=price_denominator,json=priceDenominator,proto3" json:"price_denominator,omitempty"`
+	// Id of the administrator who created the action.
+	CreatedBy int64 `protobuf:"varint,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt int64 `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unix time the action was applied; 0 while scheduled.
+	AppliedAt     int64 `protobuf:"varint,14,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorporateAction) Reset() {
+	*x = CorporateAction{}
+	mi := &file_user_corporate_action_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorporateAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorporateAction) ProtoMessage() {}
+
+func (x *CorporateAction) ProtoReflect() protoreflect.Message {
+	mi := &file_user_corporate_action_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorporateAction.ProtoReflect.Descriptor instead.
+func (*CorporateAction) Descriptor() ([]byte, []int) {
+	return file_user_corporate_action_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CorporateAction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetCashPerShare() int64 {
+	if x != nil {
+		return x.CashPerShare
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetOldShares() int64 {
+	if x != nil {
+		return x.OldShares
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetNewShares() int64 {
+	if x != nil {
+		return x.NewShares
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetExDate() string {
+	if x != nil {
+		return x.ExDate
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetRecordDate() string {
+	if x != nil {
+		return x.RecordDate
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CorporateAction) GetPriceNumerator() int64 {
+	if x != nil {
+		return x.PriceNumerator
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetPriceDenominator() int64 {
+	if x != nil {
+		return x.PriceDenominator
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CorporateAction) GetAppliedAt() int64 {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return 0
+}
+
+var File_user_corporate_action_proto protoreflect.FileDescriptor
+
+const file_user_corporate_action_proto_rawDesc = "" +
+	"\n" +
+	"\x1buser/corporate_action.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xcb\x02\n" +
+	"\x1cCreateCorporateActionRequest\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\n" +
+	"R\x04code\x12?\n" +
+	"\x04kind\x18\x02 \x01(\tB+\xfaB(r&R\rcash_dividendR\x0estock_dividendR\x05splitR\x04kind\x12-\n" +
+	"\x0ecash_per_share\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\fcashPerShare\x12&\n" +
+	"\n" +
+	"old_shares\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\toldShares\x12&\n" +
+	"\n" +
+	"new_shares\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tnewShares\x12!\n" +
+	"\aex_date\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x98\x01\n" +
+	"R\x06exDate\x12)\n" +
+	"\vrecord_date\x18\a \x01(\tB\b\xfaB\x05r\x03\x98\x01\n" +
+	"R\n" +
+	"recordDate\"\x8e\x01\n" +
+	"\x1dCreateCorporateActionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12?\n" +
+	"\x04data\x18\x03 \x01(\v2+.stock_trading.user_service.CorporateActionR\x04data\":\n" +
+	"\x1bListCorporateActionsRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18\n" +
+	"R\x04code\"\x8d\x01\n" +
+	"\x1cListCorporateActionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12?\n" +
+	"\x04data\x18\x03 \x03(\v2+.stock_trading.user_service.CorporateActionR\x04data\"D\n" +
+	"\x1cDeleteCorporateActionRequest\x12$\n" +
+	"\taction_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bactionId\"M\n" +
+	"\x1dDeleteCorporateActionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb2\x03\n" +
+	"\x0fCorporateAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12$\n" +
+	"\x0ecash_per_share\x18\x04 \x01(\x03R\fcashPerShare\x12\x1d\n" +
+	"\n" +
+	"old_shares\x18\x05 \x01(\x03R\toldShares\x12\x1d\n" +
+	"\n" +
+	"new_shares\x18\x06 \x01(\x03R\tnewShares\x12\x17\n" +
+	"\aex_date\x18\a \x01(\tR\x06exDate\x12\x1f\n" +
+	"\vrecord_date\x18\b \x01(\tR\n" +
+	"recordDate\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12'\n" +
+	"\x0fprice_numerator\x18\n" +
+	" \x01(\x03R\x0epriceNumerator\x12+\n" +
+	"\x11price_denominator\x18\v \x01(\x03R\x10priceDenominator\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\x03R\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"applied_at\x18\x0e \x01(\x03R\tappliedAt2\xc6\x04\n" +
+	"\x16CorporateActionService\x12\xb8\x01\n" +
+	"\x15CreateCorporateAction\x128.stock_trading.user_service.CreateCorporateActionRequest\x1a9.stock_trading.user_service.CreateCorporateActionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/admin/corporate-actions\x12\xac\x01\n" +
+	"\x14ListCorporateActions\x127.stock_trading.user_service.ListCorporateActionsRequest\x1a8.stock_trading.user_service.ListCorporateActionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/corporate-actions\x12\xc1\x01\n" +
+	"\x15DeleteCorporateAction\x128.stock_trading.user_service.DeleteCorporateActionRequest\x1a9.stock_trading.user_service.DeleteCorporateActionResponse\"3\x82\xd3\xe4\x93\x02-*+/api/v1/admin/corporate-actions/{action_id}B\xec\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\x14CorporateActionProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_corporate_action_proto_rawDescOnce sync.Once
+	file_user_corporate_action_proto_rawDescData []byte
+)
+
+func file_user_corporate_action_proto_rawDescGZIP() []byte {
+	file_user_corporate_action_proto_rawDescOnce.Do(func() {
+		file_user_corporate_action_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_corporate_action_proto_rawDesc), len(file_user_corporate_action_proto_rawDesc)))
+	})
+	return file_user_corporate_action_proto_rawDescData
+}
+
+var file_user_corporate_action_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_user_corporate_action_proto_goTypes = []any{
+	(*CreateCorporateActionRequest)(nil),  // 0: stock_trading.user_service.CreateCorporateActionRequest
+	(*CreateCorporateActionResponse)(nil), // 1: stock_trading.user_service.CreateCorporateActionResponse
+	(*ListCorporateActionsRequest)(nil),   // 2: stock_trading.user_service.ListCorporateActionsRequest
+	(*ListCorporateActionsResponse)(nil),  // 3: stock_trading.user_service.ListCorporateActionsResponse
+	(*DeleteCorporateActionRequest)(nil),  // 4: stock_trading.user_service.DeleteCorporateActionRequest
+	(*DeleteCorporateActionResponse)(nil), // 5: stock_trading.user_service.DeleteCorporateActionResponse
+	(*CorporateAction)(nil),               // 6: stock_trading.user_service.CorporateAction
+}
+var file_user_corporate_action_proto_depIdxs = []int32{
+	6, // 0: stock_trading.user_service.CreateCorporateActionResponse.data:type_name -> stock_trading.user_service.CorporateAction
+	6, // 1: stock_trading.user_service.ListCorporateActionsResponse.data:type_name -> stock_trading.user_service.CorporateAction
+	0, // 2: stock_trading.user_service.CorporateActionService.CreateCorporateAction:input_type -> stock_trading.user_service.CreateCorporateActionRequest
+	2, // 3: stock_trading.user_service.CorporateActionService.ListCorporateActions:input_type -> stock_trading.user_service.ListCorporateActionsRequest
+	4, // 4: stock_trading.user_service.CorporateActionService.DeleteCorporateAction:input_type -> stock_trading.user_service.DeleteCorporateActionRequest
+	1, // 5: stock_trading.user_service.CorporateActionService.CreateCorporateAction:output_type -> stock_trading.user_service.CreateCorporateActionResponse
+	3, // 6: stock_trading.user_service.CorporateActionService.ListCorporateActions:output_type -> stock_trading.user_service.ListCorporateActionsResponse
+	5, // 7: stock_trading.user_service.CorporateActionService.DeleteCorporateAction:output_type -> stock_trading.user_service.DeleteCorporateActionResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_user_corporate_action_proto_init() }
+func file_user_corporate_action_proto_init() {
+	if File_user_corporate_action_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_corporate_action_proto_rawDesc), len(file_user_corporate_action_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_corporate_action_proto_goTypes,
+		DependencyIndexes: file_user_corporate_action_proto_depIdxs,
+		MessageInfos:      file_user_corporate_action_proto_msgTypes,
+	}.Build()
+	File_user_corporate_action_proto = out.File
+	file_user_corporate_action_proto_goTypes = nil
+	file_user_corporate_action_proto_depIdxs = nil
+}
@@ -0,0 +1,964 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/corporate_action.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateCorporateActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCorporateActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCorporateActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCorporateActionRequestMultiError, or nil if none found.
+func (m *CreateCorporateActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCorporateActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 10 {
+		err := CreateCorporateActionRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateCorporateActionRequest_Kind_InLookup[m.GetKind()]; !ok {
+		err := CreateCorporateActionRequestValidationError{
+			field:  "Kind",
+			reason: "value must be in list [cash_dividend stock_dividend split]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCashPerShare() < 0 {
+		err := CreateCorporateActionRequestValidationError{
+			field:  "CashPerShare",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOldShares() < 0 {
+		err := CreateCorporateActionRequestValidationError{
+			field:  "OldShares",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetNewShares() < 0 {
+		err := CreateCorporateActionRequestValidationError{
+			field:  "NewShares",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetExDate()) != 10 {
+		err := CreateCorporateActionRequestValidationError{
+			field:  "ExDate",
+			reason: "value length must be 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if utf8.RuneCountInString(m.GetRecordDate()) != 10 {
+		err := CreateCorporateActionRequestValidationError{
+			field:  "RecordDate",
+			reason: "value length must be 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCorporateActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCorporateActionRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateCorporateActionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateCorporateActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCorporateActionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCorporateActionRequestMultiError) AllErrors() []error { return m }
+
+// CreateCorporateActionRequestValidationError is the validation error returned
+// by CreateCorporateActionRequest.Validate if the designated constraints
+// aren't met.
+type CreateCorporateActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCorporateActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCorporateActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCorporateActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCorporateActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCorporateActionRequestValidationError) ErrorName() string {
+	return "CreateCorporateActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCorporateActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCorporateActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCorporateActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCorporateActionRequestValidationError{}
+
+var _CreateCorporateActionRequest_Kind_InLookup = map[string]struct{}{
+	"cash_dividend":  {},
+	"stock_dividend": {},
+	"split":          {},
+}
+
+// Validate checks the field values on CreateCorporateActionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCorporateActionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCorporateActionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateCorporateActionResponseMultiError, or nil if none found.
+func (m *CreateCorporateActionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCorporateActionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCorporateActionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCorporateActionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCorporateActionResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCorporateActionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCorporateActionResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateCorporateActionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateCorporateActionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCorporateActionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCorporateActionResponseMultiError) AllErrors() []error { return m }
+
+// CreateCorporateActionResponseValidationError is the validation error
+// returned by CreateCorporateActionResponse.Validate if the designated
+// constraints aren't met.
+type CreateCorporateActionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCorporateActionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCorporateActionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCorporateActionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCorporateActionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCorporateActionResponseValidationError) ErrorName() string {
+	return "CreateCorporateActionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCorporateActionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCorporateActionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCorporateActionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCorporateActionResponseValidationError{}
+
+// Validate checks the field values on ListCorporateActionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCorporateActionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCorporateActionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCorporateActionsRequestMultiError, or nil if none found.
+func (m *ListCorporateActionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCorporateActionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) > 10 {
+		err := ListCorporateActionsRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at most 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCorporateActionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCorporateActionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListCorporateActionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListCorporateActionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCorporateActionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCorporateActionsRequestMultiError) AllErrors() []error { return m }
+
+// ListCorporateActionsRequestValidationError is the validation error returned
+// by ListCorporateActionsRequest.Validate if the designated constraints
+// aren't met.
+type ListCorporateActionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCorporateActionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCorporateActionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCorporateActionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCorporateActionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCorporateActionsRequestValidationError) ErrorName() string {
+	return "ListCorporateActionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCorporateActionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCorporateActionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCorporateActionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCorporateActionsRequestValidationError{}
+
+// Validate checks the field values on ListCorporateActionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCorporateActionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCorporateActionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCorporateActionsResponseMultiError, or nil if none found.
+func (m *ListCorporateActionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCorporateActionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCorporateActionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCorporateActionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCorporateActionsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCorporateActionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCorporateActionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCorporateActionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListCorporateActionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCorporateActionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCorporateActionsResponseMultiError) AllErrors() []error { return m }
+
+// ListCorporateActionsResponseValidationError is the validation error returned
+// by ListCorporateActionsResponse.Validate if the designated constraints
+// aren't met.
+type ListCorporateActionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCorporateActionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCorporateActionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCorporateActionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCorporateActionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCorporateActionsResponseValidationError) ErrorName() string {
+	return "ListCorporateActionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCorporateActionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCorporateActionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCorporateActionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCorporateActionsResponseValidationError{}
+
+// Validate checks the field values on DeleteCorporateActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCorporateActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCorporateActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCorporateActionRequestMultiError, or nil if none found.
+func (m *DeleteCorporateActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCorporateActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetActionId() <= 0 {
+		err := DeleteCorporateActionRequestValidationError{
+			field:  "ActionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCorporateActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCorporateActionRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteCorporateActionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteCorporateActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCorporateActionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCorporateActionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCorporateActionRequestValidationError is the validation error returned
+// by DeleteCorporateActionRequest.Validate if the designated constraints
+// aren't met.
+type DeleteCorporateActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCorporateActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCorporateActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCorporateActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCorporateActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCorporateActionRequestValidationError) ErrorName() string {
+	return "DeleteCorporateActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCorporateActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCorporateActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCorporateActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCorporateActionRequestValidationError{}
+
+// Validate checks the field values on DeleteCorporateActionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCorporateActionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCorporateActionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteCorporateActionResponseMultiError, or nil if none found.
+func (m *DeleteCorporateActionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCorporateActionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteCorporateActionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCorporateActionResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteCorporateActionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteCorporateActionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCorporateActionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCorporateActionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteCorporateActionResponseValidationError is the validation error
+// returned by DeleteCorporateActionResponse.Validate if the designated
+// constraints aren't met.
+type DeleteCorporateActionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCorporateActionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCorporateActionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCorporateActionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCorporateActionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCorporateActionResponseValidationError) ErrorName() string {
+	return "DeleteCorporateActionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCorporateActionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCorporateActionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCorporateActionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCorporateActionResponseValidationError{}
+
+// Validate checks the field values on CorporateAction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CorporateAction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CorporateAction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CorporateActionMultiError, or nil if none found.
+func (m *CorporateAction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CorporateAction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Kind
+
+	// no validation rules for CashPerShare
+
+	// no validation rules for OldShares
+
+	// no validation rules for NewShares
+
+	// no validation rules for ExDate
+
+	// no validation rules for RecordDate
+
+	// no validation rules for Status
+
+	// no validation rules for PriceNumerator
+
+	// no validation rules for PriceDenominator
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for AppliedAt
+
+	if len(errors) > 0 {
+		return CorporateActionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CorporateActionMultiError is an error wrapping multiple validation errors
+// returned by CorporateAction.ValidateAll() if the designated constraints
+// aren't met.
+type CorporateActionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CorporateActionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CorporateActionMultiError) AllErrors() []error { return m }
+
+// CorporateActionValidationError is the validation error returned by
+// CorporateAction.Validate if the designated constraints aren't met.
+type CorporateActionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CorporateActionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CorporateActionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CorporateActionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CorporateActionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CorporateActionValidationError) ErrorName() string { return "CorporateActionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CorporateActionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCorporateAction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CorporateActionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CorporateActionValidationError{}
@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: user/corporate_action.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CorporateActionService_CreateCorporateAction_FullMethodName = "/stock_trading.user_service.CorporateActionService/CreateCorporateAction"
+	CorporateActionService_ListCorporateActions_FullMethodName  = "/stock_trading.user_service.CorporateActionService/ListCorporateActions"
+	CorporateActionService_DeleteCorporateAction_FullMethodName = "/stock_trading.user_service.CorporateActionService/DeleteCorporateAction"
+)
+
+// CorporateActionServiceClient is the client API for CorporateActionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CorporateActionService manages the cash dividends, stock dividends and
+// splits of listed stocks. An action is applied on its ex-date by the
+// apply-corporate-actions command: the holders at the start of the ex-date
+// are paid or receive their new shares, the prices recorded before it are
+// back-adjusted and every holder is notified. Scheduling and deleting
+// actions is for administrators only.
+type CorporateActionServiceClient interface {
+	// CreateCorporateAction schedules an action on a future trading day.
+	CreateCorporateAction(ctx context.Context, in *CreateCorporateActionRequest, opts ...grpc.CallOption) (*CreateCorporateActionResponse, error)
+	// ListCorporateActions returns the actions on a stock, or on every stock,
+	// latest ex-date first.
+	ListCorporateActions(ctx context.Context, in *ListCorporateActionsRequest, opts ...grpc.CallOption) (*ListCorporateActionsResponse, error)
+	// DeleteCorporateAction removes an action that has not been applied yet.
+	DeleteCorporateAction(ctx context.Context, in *DeleteCorporateActionRequest, opts ...grpc.CallOption) (*DeleteCorporateActionResponse, error)
+}
+
+type corporateActionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCorporateActionServiceClient(cc grpc.ClientConnInterface) CorporateActionServiceClient {
+	return &corporateActionServiceClient{cc}
+}
+
+func (c *corporateActionServiceClient) CreateCorporateAction(ctx context.Context, in *CreateCorporateActionRequest, opts ...grpc.CallOption) (*CreateCorporateActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCorporateActionResponse)
+	err := c.cc.Invoke(ctx, CorporateActionService_CreateCorporateAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *corporateActionServiceClient) ListCorporateActions(ctx context.Context, in *ListCorporateActionsRequest, opts ...grpc.CallOption) (*ListCorporateActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCorporateActionsResponse)
+	err := c.cc.Invoke(ctx, CorporateActionService_ListCorporateActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *corporateActionServiceClient) DeleteCorporateAction(ctx context.Context, in *DeleteCorporateActionRequest, opts ...grpc.CallOption) (*DeleteCorporateActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCorporateActionResponse)
+	err := c.cc.Invoke(ctx, CorporateActionService_DeleteCorporateAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CorporateActionServiceServer is the server API for CorporateActionService service.
+// All implementations must embed UnimplementedCorporateActionServiceServer
+// for forward compatibility.
+//
+// CorporateActionService manages the cash dividends, stock dividends and
+// splits of listed stocks. An action is applied on its ex-date by the
+// apply-corporate-actions command: the holders at the start of the ex-date
+// are paid or receive their new shares, the prices recorded before it are
+// back-adjusted and every holder is notified. Scheduling and deleting
+// actions is for administrators only.
+type CorporateActionServiceServer interface {
+	// CreateCorporateAction schedules an action on a future trading day.
+	CreateCorporateAction(context.Context, *CreateCorporateActionRequest) (*CreateCorporateActionResponse, error)
+	// ListCorporateActions returns the actions on a stock, or on every stock,
+	// latest ex-date first.
+	ListCorporateActions(context.Context, *ListCorporateActionsRequest) (*ListCorporateActionsResponse, error)
+	// DeleteCorporateAction removes an action that has not been applied yet.
+	DeleteCorporateAction(context.Context, *DeleteCorporateActionRequest) (*DeleteCorporateActionResponse, error)
+	mustEmbedUnimplementedCorporateActionServiceServer()
+}
+
+// UnimplementedCorporateActionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCorporateActionServiceServer struct{}
+
+func (UnimplementedCorporateActionServiceServer) CreateCorporateAction(context.Context, *CreateCorporateActionRequest) (*CreateCorporateActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCorporateAction not implemented")
+}
+func (UnimplementedCorporateActionServiceServer) ListCorporateActions(context.Context, *ListCorporateActionsRequest) (*ListCorporateActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCorporateActions not implemented")
+}
+func (UnimplementedCorporateActionServiceServer) DeleteCorporateAction(context.Context, *DeleteCorporateActionRequest) (*DeleteCorporateActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCorporateAction not implemented")
+}
+func (UnimplementedCorporateActionServiceServer) mustEmbedUnimplementedCorporateActionServiceServer() {
+}
+func (UnimplementedCorporateActionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeCorporateActionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CorporateActionServiceServer will
+// result in compilation errors.
+type UnsafeCorporateActionServiceServer interface {
+	mustEmbedUnimplementedCorporateActionServiceServer()
+}
+
+func RegisterCorporateActionServiceServer(s grpc.ServiceRegistrar, srv CorporateActionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCorporateActionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CorporateActionService_ServiceDesc, srv)
+}
+
+func _CorporateActionService_CreateCorporateAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCorporateActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CorporateActionServiceServer).CreateCorporateAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CorporateActionService_CreateCorporateAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CorporateActionServiceServer).CreateCorporateAction(ctx, req.(*CreateCorporateActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CorporateActionService_ListCorporateActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCorporateActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CorporateActionServiceServer).ListCorporateActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CorporateActionService_ListCorporateActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CorporateActionServiceServer).ListCorporateActions(ctx, req.(*ListCorporateActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CorporateActionService_DeleteCorporateAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCorporateActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CorporateActionServiceServer).DeleteCorporateAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CorporateActionService_DeleteCorporateAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CorporateActionServiceServer).DeleteCorporateAction(ctx, req.(*DeleteCorporateActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CorporateActionService_ServiceDesc is the grpc.ServiceDesc for CorporateActionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CorporateActionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stock_trading.user_service.CorporateActionService",
+	HandlerType: (*CorporateActionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCorporateAction",
+			Handler:    _CorporateActionService_CreateCorporateAction_Handler,
+		},
+		{
+			MethodName: "ListCorporateActions",
+			Handler:    _CorporateActionService_ListCorporateActions_Handler,
+		},
+		{
+			MethodName: "DeleteCorporateAction",
+			Handler:    _CorporateActionService_DeleteCorporateAction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/corporate_action.proto",
+}
@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/fee.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateFeeScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tier  string                 `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	// Commission rates in basis points of the value of a fill.
+	BuyRateBps  int64 `protobuf:"varint,2,opt,name=buy_rate_bps,json=buyRateBps,proto3" json:"buy_rate_bps,omitempty"`
+	SellRateBps int64 `protobuf:"varint,3,opt,name=sell_rate_bps,json=sellRateBps,proto3" json:"sell_rate_bps,omitempty"`
+	// Bounds of the commission of a fill in VND; a zero max_fee means none.
+	MinFee int64 `protobuf:"varint,4,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	MaxFee int64 `protobuf:"varint,5,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	// Tax withheld from sales, in basis points of their value.
+	SellTaxBps int64 `protobuf:"varint,6,opt,name=sell_tax_bps,json=sellTaxBps,proto3" json:"sell_tax_bps,omitempty"`
+	// By ascending min_monthly_value.
+	VolumeTiers []*FeeVolumeTier `protobuf:"bytes,7,rep,name=volume_tiers,json=volumeTiers,proto3" json:"volume_tiers,omitempty"`
+	// Unix time the schedule takes effect; must be in the future.
+	EffectiveFrom int64 `protobuf:"varint,8,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeeScheduleRequest) Reset() {
+	*x = CreateFeeScheduleRequest{}
+	mi := &file_user_fee_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeeScheduleRequest) ProtoMessage() {}
+
+func (x *CreateFeeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_fee_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_user_fee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateFeeScheduleRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *CreateFeeScheduleRequest) GetBuyRateBps() int64 {
+	if x != nil {
+		return x.BuyRateBps
+	}
+	return 0
+}
+
+func (x *CreateFeeScheduleRequest) GetSellRateBps() int64 {
+	if x != nil {
+		return x.SellRateBps
+	}
+	return 0
+}
+
+func (x *CreateFeeScheduleRequest) GetMinFee() int64 {
+	if x != nil {
+		return x.MinFee
+	}
+	return 0
+}
+
+func (x *CreateFeeScheduleRequest) GetMaxFee() int64 {
+	if x != nil {
+		return x.MaxFee
+	}
+	return 0
+}
+
+func (x *CreateFeeScheduleRequest) GetSellTaxBps() int64 {
+	if x != nil {
+		return x.SellTaxBps
+	}
+	return 0
+}
+
+func (x *CreateFeeScheduleRequest) GetVolumeTiers() []*FeeVolumeTier {
+	if x != nil {
+		return x.VolumeTiers
+	}
+	return nil
+}
+
+func (x *CreateFeeScheduleRequest) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+type CreateFeeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *FeeSchedule           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeeScheduleResponse) Reset() {
+	*x = CreateFeeScheduleResponse{}
+	mi := &file_user_fee_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeeScheduleResponse) ProtoMessage() {}
+
+func (x *CreateFeeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_fee_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_user_fee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFeeScheduleResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateFeeScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateFeeScheduleResponse) GetData() *FeeSchedule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListFeeSchedulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty lists every tier.
+	Tier          string `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeeSchedulesRequest) Reset() {
+	*x = ListFeeSchedulesRequest{}
+	mi := &file_user_fee_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeeSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeSchedulesRequest) ProtoMessage() {}
+
+func (x *ListFeeSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_fee_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListFeeSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_user_fee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListFeeSchedulesRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+type ListFeeSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*FeeSchedule         `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeeSchedulesResponse) Reset() {
+	*x = ListFeeSchedulesResponse{}
+	mi := &file_user_fee_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeeSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeeSchedulesResponse) ProtoMessage() {}
+
+func (x *ListFeeSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_fee_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeeSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListFeeSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_user_fee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFeeSchedulesResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListFeeSchedulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListFeeSchedulesResponse) GetData() []*FeeSchedule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteFeeScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeeScheduleRequest) Reset() {
+	*x = DeleteFeeScheduleRequest{}
+	mi := &file_user_fee_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeeScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteFeeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_fee_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_user_fee_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteFeeScheduleRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+type DeleteFeeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeeScheduleResponse) Reset() {
+	*x = DeleteFeeScheduleResponse{}
+	mi := &file_user_fee_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeeScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteFeeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_fee_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_user_fee_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteFeeScheduleResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteFeeScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type FeeSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tier          string                 `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	BuyRateBps    int64                  `protobuf:"varint,3,opt,name=buy_rate_bps,json=buyRateBps,proto3" json:"buy_rate_bps,omitempty"`
+	SellRateBps   int64                  `protobuf:"varint,4,opt,name=sell_rate_bps,json=sellRateBps,proto3" json:"sell_rate_bps,omitempty"`
+	MinFee        int64                  `protobuf:"varint,5,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	MaxFee        int64                  `protobuf:"varint,6,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	SellTaxBps    int64                  `protobuf:"varint,7,opt,name=sell_tax_bps,json=sellTaxBps,proto3" json:"sell_tax_bps,omitempty"`
+	VolumeTiers   []*FeeVolumeTier       `protobuf:"bytes,8,rep,name=volume_tiers,json=volumeTiers,proto3" json:"volume_tiers,omitempty"`
+	EffectiveFrom int64                  `protobuf:"varint,9,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// Id of the administrator who created the schedule.
+	CreatedBy     int64 `protobuf:"varint,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeSchedule) Reset() {
+	*x = FeeSchedule{}
+	mi := &file_user_fee_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSchedule) ProtoMessage() {}
+
+func (x *FeeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_user_fee_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeSchedule.ProtoReflect.Descriptor instead.
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return file_user_fee_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FeeSchedule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetBuyRateBps() int64 {
+	if x != nil {
+		return x.BuyRateBps
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetSellRateBps() int64 {
+	if x != nil {
+		return x.SellRateBps
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetMinFee() int64 {
+	if x != nil {
+		return x.MinFee
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetMaxFee() int64 {
+	if x != nil {
+		return x.MaxFee
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetSellTaxBps() int64 {
+	if x != nil {
+		return x.SellTaxBps
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetVolumeTiers() []*FeeVolumeTier {
+	if x != nil {
+		return x.VolumeTiers
+	}
+	return nil
+}
+
+func (x *FeeSchedule) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// FeeVolumeTier replaces the commission rates of users whose fills of the
+// calendar month, in the market's time zone, are worth min_monthly_value
+// VND or more.
+type FeeVolumeTier struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MinMonthlyValue int64                  `protobuf:"varint,1,opt,name=min_monthly_value,json=minMonthlyValue,proto3" json:"min_monthly_value,omitempty"`
+	BuyRateBps      int64                  `protobuf:"varint,2,opt,name=buy_rate_bps,json=buyRateBps,proto3" json:"buy_rate_bps,omitempty"`
+	SellRateBps     int64                  `protobuf:"varint,3,opt,name=sell_rate_bps,json=sellRateBps,proto3" json:"sell_rate_bps,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FeeVolumeTier) Reset() {
+	*x = FeeVolumeTier{}
+	mi := &file_user_fee_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeVolumeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeVolumeTier) ProtoMessage() {}
+
+func (x *FeeVolumeTier) ProtoReflect() protoreflect.Message {
+	mi := &file_user_fee_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeVolumeTier.ProtoReflect.Descriptor instead.
+func (*FeeVolumeTier) Descriptor() ([]byte, []int) {
+	return file_user_fee_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FeeVolumeTier) GetMinMonthlyValue() int64 {
+	if x != nil {
+		return x.MinMonthlyValue
+	}
+	return 0
+}
+
+func (x *FeeVolumeTier) GetBuyRateBps() int64 {
+	if x != nil {
+		return x.BuyRateBps
+	}
+	return 0
+}
+
+func (x *FeeVolumeTier) GetSellRateBps() int64 {
+	if x != nil {
+		return x.SellRateBps
+	}
+	return 0
+}
+
+var File_user_fee_proto protoreflect.FileDescriptor
+
+const file_user_fee_proto_rawDesc = "" +
+	"\n" +
+	"\x0euser/fee.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\x87\x03\n" +
+	"\x18CreateFeeScheduleRequest\x12\x1d\n" +
+	"\x04tier\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04tier\x12,\n" +
+	"\fbuy_rate_bps\x18\x02 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\x90N(\x00R\n" +
+	"buyRateBps\x12.\n" +
+	"\rsell_rate_bps\x18\x03 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\x90N(\x00R\vsellRateBps\x12 \n" +
+	"\amin_fee\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06minFee\x12 \n" +
+	"\amax_fee\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06maxFee\x12,\n" +
+	"\fsell_tax_bps\x18\x06 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\x90N(\x00R\n" +
+	"sellTaxBps\x12L\n" +
+	"\fvolume_tiers\x18\a \x03(\v2).stock_trading.user_service.FeeVolumeTierR\vvolumeTiers\x12.\n" +
+	"\x0eeffective_from\x18\b \x01(\x03B\a\xfaB\x04\"\x02 \x00R\reffectiveFrom\"\x86\x01\n" +
+	"\x19CreateFeeScheduleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.FeeScheduleR\x04data\"6\n" +
+	"\x17ListFeeSchedulesRequest\x12\x1b\n" +
+	"\x04tier\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18 R\x04tier\"\x85\x01\n" +
+	"\x18ListFeeSchedulesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x03(\v2'.stock_trading.user_service.FeeScheduleR\x04data\"D\n" +
+	"\x18DeleteFeeScheduleRequest\x12(\n" +
+	"\vschedule_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"scheduleId\"I\n" +
+	"\x19DeleteFeeScheduleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xfe\x02\n" +
+	"\vFeeSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04tier\x18\x02 \x01(\tR\x04tier\x12 \n" +
+	"\fbuy_rate_bps\x18\x03 \x01(\x03R\n" +
+	"buyRateBps\x12\"\n" +
+	"\rsell_rate_bps\x18\x04 \x01(\x03R\vsellRateBps\x12\x17\n" +
+	"\amin_fee\x18\x05 \x01(\x03R\x06minFee\x12\x17\n" +
+	"\amax_fee\x18\x06 \x01(\x03R\x06maxFee\x12 \n" +
+	"\fsell_tax_bps\x18\a \x01(\x03R\n" +
+	"sellTaxBps\x12L\n" +
+	"\fvolume_tiers\x18\b \x03(\v2).stock_trading.user_service.FeeVolumeTierR\vvolumeTiers\x12%\n" +
+	"\x0eeffective_from\x18\t \x01(\x03R\reffectiveFrom\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\x03R\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\"\xa2\x01\n" +
+	"\rFeeVolumeTier\x123\n" +
+	"\x11min_monthly_value\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x0fminMonthlyValue\x12,\n" +
+	"\fbuy_rate_bps\x18\x02 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\x90N(\x00R\n" +
+	"buyRateBps\x12.\n" +
+	"\rsell_rate_bps\x18\x03 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\x90N(\x00R\vsellRateBps2\x92\x04\n" +
+	"\n" +
+	"FeeService\x12\xa8\x01\n" +
+	"\x11CreateFeeSchedule\x124.stock_trading.user_service.CreateFeeScheduleRequest\x1a5.stock_trading.user_service.CreateFeeScheduleResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/admin/fee-schedules\x12\xa2\x01\n" +
+	"\x10ListFeeSchedules\x123.stock_trading.user_service.ListFeeSchedulesRequest\x1a4.stock_trading.user_service.ListFeeSchedulesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/admin/fee-schedules\x12\xb3\x01\n" +
+	"\x11DeleteFeeSchedule\x124.stock_trading.user_service.DeleteFeeScheduleRequest\x1a5.stock_trading.user_service.DeleteFeeScheduleResponse\"1\x82\xd3\xe4\x93\x02+*)/api/v1/admin/fee-schedules/{schedule_id}B\xe0\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\bFeeProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_fee_proto_rawDescOnce sync.Once
+	file_user_fee_proto_rawDescData []byte
+)
+
+func file_user_fee_proto_rawDescGZIP() []byte {
+	file_user_fee_proto_rawDescOnce.Do(func() {
+		file_user_fee_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_fee_proto_rawDesc), len(file_user_fee_proto_rawDesc)))
+	})
+	return file_user_fee_proto_rawDescData
+}
+
+var file_user_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_user_fee_proto_goTypes = []any{
+	(*CreateFeeScheduleRequest)(nil),  // 0: stock_trading.user_service.CreateFeeScheduleRequest
+	(*CreateFeeScheduleResponse)(nil), // 1: stock_trading.user_service.CreateFeeScheduleResponse
+	(*ListFeeSchedulesRequest)(nil),   // 2: stock_trading.user_service.ListFeeSchedulesRequest
+	(*ListFeeSchedulesResponse)(nil),  // 3: stock_trading.user_service.ListFeeSchedulesResponse
+	(*DeleteFeeScheduleRequest)(nil),  // 4: stock_trading.user_service.DeleteFeeScheduleRequest
+	(*DeleteFeeScheduleResponse)(nil), // 5: stock_trading.user_service.DeleteFeeScheduleResponse
+	(*FeeSchedule)(nil),               // 6: stock_trading.user_service.FeeSchedule
+	(*FeeVolumeTier)(nil),             // 7: stock_trading.user_service.FeeVolumeTier
+}
+var file_user_fee_proto_depIdxs = []int32{
+	7, // 0: stock_trading.user_service.CreateFeeScheduleRequest.volume_tiers:type_name -> stock_trading.user_service.FeeVolumeTier
+	6, // 1: stock_trading.user_service.CreateFeeScheduleResponse.data:type_name -> stock_trading.user_service.FeeSchedule
+	6, // 2: stock_trading.user_service.ListFeeSchedulesResponse.data:type_name -> stock_trading.user_service.FeeSchedule
+	7, // 3: stock_trading.user_service.FeeSchedule.volume_tiers:type_name -> stock_trading.user_service.FeeVolumeTier
+	0, // 4: stock_trading.user_service.FeeService.CreateFeeSchedule:input_type -> stock_trading.user_service.CreateFeeScheduleRequest
+	2, // 5: stock_trading.user_service.FeeService.ListFeeSchedules:input_type -> stock_trading.user_service.ListFeeSchedulesRequest
+	4, // 6: stock_trading.user_service.FeeService.DeleteFeeSchedule:input_type -> stock_trading.user_service.DeleteFeeScheduleRequest
+	1, // 7: stock_trading.user_service.FeeService.CreateFeeSchedule:output_type -> stock_trading.user_service.CreateFeeScheduleResponse
+	3, // 8: stock_trading.user_service.FeeService.ListFeeSchedules:output_type -> stock_trading.user_service.ListFeeSchedulesResponse
+	5, // 9: stock_trading.user_service.FeeService.DeleteFeeSchedule:output_type -> stock_trading.user_service.DeleteFeeScheduleResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_user_fee_proto_init() }
+func file_user_fee_proto_init() {
+	if File_user_fee_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_fee_proto_rawDesc), len(file_user_fee_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_fee_proto_goTypes,
+		DependencyIndexes: file_user_fee_proto_depIdxs,
+		MessageInfos:      file_user_fee_proto_msgTypes,
+	}.Build()
+	File_user_fee_proto = out.File
+	file_user_fee_proto_goTypes = nil
+	file_user_fee_proto_depIdxs = nil
+}
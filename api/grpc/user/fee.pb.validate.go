@@ -0,0 +1,1142 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/fee.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateFeeScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFeeScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFeeScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFeeScheduleRequestMultiError, or nil if none found.
+func (m *CreateFeeScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFeeScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTier()); l < 1 || l > 32 {
+		err := CreateFeeScheduleRequestValidationError{
+			field:  "Tier",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetBuyRateBps(); val < 0 || val > 10000 {
+		err := CreateFeeScheduleRequestValidationError{
+			field:  "BuyRateBps",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSellRateBps(); val < 0 || val > 10000 {
+		err := CreateFeeScheduleRequestValidationError{
+			field:  "SellRateBps",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinFee() < 0 {
+		err := CreateFeeScheduleRequestValidationError{
+			field:  "MinFee",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxFee() < 0 {
+		err := CreateFeeScheduleRequestValidationError{
+			field:  "MaxFee",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSellTaxBps(); val < 0 || val > 10000 {
+		err := CreateFeeScheduleRequestValidationError{
+			field:  "SellTaxBps",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetVolumeTiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateFeeScheduleRequestValidationError{
+						field:  fmt.Sprintf("VolumeTiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateFeeScheduleRequestValidationError{
+						field:  fmt.Sprintf("VolumeTiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateFeeScheduleRequestValidationError{
+					field:  fmt.Sprintf("VolumeTiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetEffectiveFrom() <= 0 {
+		err := CreateFeeScheduleRequestValidationError{
+			field:  "EffectiveFrom",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateFeeScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFeeScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateFeeScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFeeScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFeeScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFeeScheduleRequestMultiError) AllErrors() []error { return m }
+
+// CreateFeeScheduleRequestValidationError is the validation error returned by
+// CreateFeeScheduleRequest.Validate if the designated constraints aren't met.
+type CreateFeeScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFeeScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFeeScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFeeScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFeeScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFeeScheduleRequestValidationError) ErrorName() string {
+	return "CreateFeeScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFeeScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFeeScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFeeScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFeeScheduleRequestValidationError{}
+
+// Validate checks the field values on CreateFeeScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFeeScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFeeScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFeeScheduleResponseMultiError, or nil if none found.
+func (m *CreateFeeScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFeeScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFeeScheduleResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFeeScheduleResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFeeScheduleResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateFeeScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFeeScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateFeeScheduleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateFeeScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFeeScheduleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFeeScheduleResponseMultiError) AllErrors() []error { return m }
+
+// CreateFeeScheduleResponseValidationError is the validation error returned by
+// CreateFeeScheduleResponse.Validate if the designated constraints aren't met.
+type CreateFeeScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFeeScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFeeScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFeeScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFeeScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFeeScheduleResponseValidationError) ErrorName() string {
+	return "CreateFeeScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFeeScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFeeScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFeeScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFeeScheduleResponseValidationError{}
+
+// Validate checks the field values on ListFeeSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeeSchedulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeeSchedulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeeSchedulesRequestMultiError, or nil if none found.
+func (m *ListFeeSchedulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeeSchedulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTier()) > 32 {
+		err := ListFeeSchedulesRequestValidationError{
+			field:  "Tier",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListFeeSchedulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeeSchedulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFeeSchedulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeeSchedulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeeSchedulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeeSchedulesRequestMultiError) AllErrors() []error { return m }
+
+// ListFeeSchedulesRequestValidationError is the validation error returned by
+// ListFeeSchedulesRequest.Validate if the designated constraints aren't met.
+type ListFeeSchedulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeeSchedulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeeSchedulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeeSchedulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeeSchedulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeeSchedulesRequestValidationError) ErrorName() string {
+	return "ListFeeSchedulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeeSchedulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeeSchedulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeeSchedulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeeSchedulesRequestValidationError{}
+
+// Validate checks the field values on ListFeeSchedulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeeSchedulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeeSchedulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeeSchedulesResponseMultiError, or nil if none found.
+func (m *ListFeeSchedulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeeSchedulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFeeSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFeeSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFeeSchedulesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFeeSchedulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeeSchedulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFeeSchedulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeeSchedulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeeSchedulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeeSchedulesResponseMultiError) AllErrors() []error { return m }
+
+// ListFeeSchedulesResponseValidationError is the validation error returned by
+// ListFeeSchedulesResponse.Validate if the designated constraints aren't met.
+type ListFeeSchedulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeeSchedulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeeSchedulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeeSchedulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeeSchedulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeeSchedulesResponseValidationError) ErrorName() string {
+	return "ListFeeSchedulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeeSchedulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeeSchedulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeeSchedulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeeSchedulesResponseValidationError{}
+
+// Validate checks the field values on DeleteFeeScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFeeScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFeeScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFeeScheduleRequestMultiError, or nil if none found.
+func (m *DeleteFeeScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFeeScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetScheduleId() <= 0 {
+		err := DeleteFeeScheduleRequestValidationError{
+			field:  "ScheduleId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteFeeScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFeeScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFeeScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFeeScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFeeScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFeeScheduleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFeeScheduleRequestValidationError is the validation error returned by
+// DeleteFeeScheduleRequest.Validate if the designated constraints aren't met.
+type DeleteFeeScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFeeScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFeeScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFeeScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFeeScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFeeScheduleRequestValidationError) ErrorName() string {
+	return "DeleteFeeScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFeeScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFeeScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFeeScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFeeScheduleRequestValidationError{}
+
+// Validate checks the field values on DeleteFeeScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFeeScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFeeScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFeeScheduleResponseMultiError, or nil if none found.
+func (m *DeleteFeeScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFeeScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteFeeScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFeeScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteFeeScheduleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteFeeScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFeeScheduleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFeeScheduleResponseMultiError) AllErrors() []error { return m }
+
+// DeleteFeeScheduleResponseValidationError is the validation error returned by
+// DeleteFeeScheduleResponse.Validate if the designated constraints aren't met.
+type DeleteFeeScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFeeScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFeeScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFeeScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFeeScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFeeScheduleResponseValidationError) ErrorName() string {
+	return "DeleteFeeScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFeeScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFeeScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFeeScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFeeScheduleResponseValidationError{}
+
+// Validate checks the field values on FeeSchedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeeSchedule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeSchedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeeScheduleMultiError, or
+// nil if none found.
+func (m *FeeSchedule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeSchedule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Tier
+
+	// no validation rules for BuyRateBps
+
+	// no validation rules for SellRateBps
+
+	// no validation rules for MinFee
+
+	// no validation rules for MaxFee
+
+	// no validation rules for SellTaxBps
+
+	for idx, item := range m.GetVolumeTiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FeeScheduleValidationError{
+						field:  fmt.Sprintf("VolumeTiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FeeScheduleValidationError{
+						field:  fmt.Sprintf("VolumeTiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FeeScheduleValidationError{
+					field:  fmt.Sprintf("VolumeTiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return FeeScheduleMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeScheduleMultiError is an error wrapping multiple validation errors
+// returned by FeeSchedule.ValidateAll() if the designated constraints aren't met.
+type FeeScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeScheduleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeScheduleMultiError) AllErrors() []error { return m }
+
+// FeeScheduleValidationError is the validation error returned by
+// FeeSchedule.Validate if the designated constraints aren't met.
+type FeeScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeScheduleValidationError) ErrorName() string { return "FeeScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeeScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeScheduleValidationError{}
+
+// Validate checks the field values on FeeVolumeTier with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeeVolumeTier) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeeVolumeTier with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeeVolumeTierMultiError, or
+// nil if none found.
+func (m *FeeVolumeTier) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeeVolumeTier) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMinMonthlyValue() <= 0 {
+		err := FeeVolumeTierValidationError{
+			field:  "MinMonthlyValue",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetBuyRateBps(); val < 0 || val > 10000 {
+		err := FeeVolumeTierValidationError{
+			field:  "BuyRateBps",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSellRateBps(); val < 0 || val > 10000 {
+		err := FeeVolumeTierValidationError{
+			field:  "SellRateBps",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FeeVolumeTierMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeVolumeTierMultiError is an error wrapping multiple validation errors
+// returned by FeeVolumeTier.ValidateAll() if the designated constraints
+// aren't met.
+type FeeVolumeTierMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeVolumeTierMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeVolumeTierMultiError) AllErrors() []error { return m }
+
+// FeeVolumeTierValidationError is the validation error returned by
+// FeeVolumeTier.Validate if the designated constraints aren't met.
+type FeeVolumeTierValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeVolumeTierValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeVolumeTierValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeVolumeTierValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeVolumeTierValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeVolumeTierValidationError) ErrorName() string { return "FeeVolumeTierValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeeVolumeTierValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeeVolumeTier.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeVolumeTierValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeVolumeTierValidationError{}
@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: user/fee.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FeeService_CreateFeeSchedule_FullMethodName = "/stock_trading.user_service.FeeService/CreateFeeSchedule"
+	FeeService_ListFeeSchedules_FullMethodName  = "/stock_trading.user_service.FeeService/ListFeeSchedules"
+	FeeService_DeleteFeeSchedule_FullMethodName = "/stock_trading.user_service.FeeService/DeleteFeeSchedule"
+)
+
+// FeeServiceClient is the client API for FeeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FeeService manages the fee schedules fills are charged by. A schedule
+// belongs to a trading tier and applies from its effective date until the
+// next schedule of the tier takes effect; users of a tier without one pay
+// the schedule of the standard tier. Administrators only.
+type FeeServiceClient interface {
+	// CreateFeeSchedule schedules the fees of a tier from a future date on.
+	CreateFeeSchedule(ctx context.Context, in *CreateFeeScheduleRequest, opts ...grpc.CallOption) (*CreateFeeScheduleResponse, error)
+	// ListFeeSchedules returns the schedules of a tier, or of every tier, by
+	// tier and then effective date.
+	ListFeeSchedules(ctx context.Context, in *ListFeeSchedulesRequest, opts ...grpc.CallOption) (*ListFeeSchedulesResponse, error)
+	// DeleteFeeSchedule removes a schedule that has not taken effect yet.
+	DeleteFeeSchedule(ctx context.Context, in *DeleteFeeScheduleRequest, opts ...grpc.CallOption) (*DeleteFeeScheduleResponse, error)
+}
+
+type feeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeeServiceClient(cc grpc.ClientConnInterface) FeeServiceClient {
+	return &feeServiceClient{cc}
+}
+
+func (c *feeServiceClient) CreateFeeSchedule(ctx context.Context, in *CreateFeeScheduleRequest, opts ...grpc.CallOption) (*CreateFeeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, FeeService_CreateFeeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ListFeeSchedules(ctx context.Context, in *ListFeeSchedulesRequest, opts ...grpc.CallOption) (*ListFeeSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeeSchedulesResponse)
+	err := c.cc.Invoke(ctx, FeeService_ListFeeSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) DeleteFeeSchedule(ctx context.Context, in *DeleteFeeScheduleRequest, opts ...grpc.CallOption) (*DeleteFeeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, FeeService_DeleteFeeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeeServiceServer is the server API for FeeService service.
+// All implementations must embed UnimplementedFeeServiceServer
+// for forward compatibility.
+//
+// FeeService manages the fee schedules fills are charged by. A schedule
+// belongs to a trading tier and applies from its effective date until the
+// next schedule of the tier takes effect; users of a tier without one pay
+// the schedule of the standard tier. Administrators only.
+type FeeServiceServer interface {
+	// CreateFeeSchedule schedules the fees of a tier from a future date on.
+	CreateFeeSchedule(context.Context, *CreateFeeScheduleRequest) (*CreateFeeScheduleResponse, error)
+	// ListFeeSchedules returns the schedules of a tier, or of every tier, by
+	// tier and then effective date.
+	ListFeeSchedules(context.Context, *ListFeeSchedulesRequest) (*ListFeeSchedulesResponse, error)
+	// DeleteFeeSchedule removes a schedule that has not taken effect yet.
+	DeleteFeeSchedule(context.Context, *DeleteFeeScheduleRequest) (*DeleteFeeScheduleResponse, error)
+	mustEmbedUnimplementedFeeServiceServer()
+}
+
+// UnimplementedFeeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeeServiceServer struct{}
+
+func (UnimplementedFeeServiceServer) CreateFeeSchedule(context.Context, *CreateFeeScheduleRequest) (*CreateFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeeSchedule not implemented")
+}
+func (UnimplementedFeeServiceServer) ListFeeSchedules(context.Context, *ListFeeSchedulesRequest) (*ListFeeSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeSchedules not implemented")
+}
+func (UnimplementedFeeServiceServer) DeleteFeeSchedule(context.Context, *DeleteFeeScheduleRequest) (*DeleteFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeSchedule not implemented")
+}
+func (UnimplementedFeeServiceServer) mustEmbedUnimplementedFeeServiceServer() {}
+func (UnimplementedFeeServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeFeeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeeServiceServer will
+// result in compilation errors.
+type UnsafeFeeServiceServer interface {
+	mustEmbedUnimplementedFeeServiceServer()
+}
+
+func RegisterFeeServiceServer(s grpc.ServiceRegistrar, srv FeeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFeeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeeService_ServiceDesc, srv)
+}
+
+func _FeeService_CreateFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).CreateFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_CreateFeeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).CreateFeeSchedule(ctx, req.(*CreateFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ListFeeSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ListFeeSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ListFeeSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ListFeeSchedules(ctx, req.(*ListFeeSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_DeleteFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).DeleteFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_DeleteFeeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).DeleteFeeSchedule(ctx, req.(*DeleteFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeeService_ServiceDesc is the grpc.ServiceDesc for FeeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stock_trading.user_service.FeeService",
+	HandlerType: (*FeeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFeeSchedule",
+			Handler:    _FeeService_CreateFeeSchedule_Handler,
+		},
+		{
+			MethodName: "ListFeeSchedules",
+			Handler:    _FeeService_ListFeeSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteFeeSchedule",
+			Handler:    _FeeService_DeleteFeeSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/fee.proto",
+}
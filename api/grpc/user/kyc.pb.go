@@ -0,0 +1,1318 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/kyc.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UploadKycDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadKycDocumentRequest) Reset() {
+	*x = UploadKycDocumentRequest{}
+	mi := &file_user_kyc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadKycDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKycDocumentRequest) ProtoMessage() {}
+
+func (x *UploadKycDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKycDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadKycDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadKycDocumentRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UploadKycDocumentRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UploadKycDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadKycDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *KycDocument           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadKycDocumentResponse) Reset() {
+	*x = UploadKycDocumentResponse{}
+	mi := &file_user_kyc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadKycDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKycDocumentResponse) ProtoMessage() {}
+
+func (x *UploadKycDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKycDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadKycDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadKycDocumentResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UploadKycDocumentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadKycDocumentResponse) GetData() *KycDocument {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SubmitKycRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Username       string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	DocumentType   string                 `protobuf:"bytes,2,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	DocumentNumber string                 `protobuf:"bytes,3,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
+	FullName       string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// Unix time; zero when unknown.
+	IssuedOn int64 `protobuf:"varint,5,opt,name=issued_on,json=issuedOn,proto3" json:"issued_on,omitempty"`
+	// Unix time; zero for documents without expiry.
+	ExpiresOn     int64   `protobuf:"varint,6,opt,name=expires_on,json=expiresOn,proto3" json:"expires_on,omitempty"`
+	DocumentIds   []int64 `protobuf:"varint,7,rep,packed,name=document_ids,json=documentIds,proto3" json:"document_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitKycRequest) Reset() {
+	*x = SubmitKycRequest{}
+	mi := &file_user_kyc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitKycRequest) ProtoMessage() {}
+
+func (x *SubmitKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitKycRequest.ProtoReflect.Descriptor instead.
+func (*SubmitKycRequest) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitKycRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SubmitKycRequest) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *SubmitKycRequest) GetDocumentNumber() string {
+	if x != nil {
+		return x.DocumentNumber
+	}
+	return ""
+}
+
+func (x *SubmitKycRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *SubmitKycRequest) GetIssuedOn() int64 {
+	if x != nil {
+		return x.IssuedOn
+	}
+	return 0
+}
+
+func (x *SubmitKycRequest) GetExpiresOn() int64 {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return 0
+}
+
+func (x *SubmitKycRequest) GetDocumentIds() []int64 {
+	if x != nil {
+		return x.DocumentIds
+	}
+	return nil
+}
+
+type SubmitKycResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *KycSubmission         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitKycResponse) Reset() {
+	*x = SubmitKycResponse{}
+	mi := &file_user_kyc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitKycResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitKycResponse) ProtoMessage() {}
+
+func (x *SubmitKycResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitKycResponse.ProtoReflect.Descriptor instead.
+func (*SubmitKycResponse) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitKycResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SubmitKycResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitKycResponse) GetData() *KycSubmission {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetKycStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycStatusRequest) Reset() {
+	*x = GetKycStatusRequest{}
+	mi := &file_user_kyc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycStatusRequest) ProtoMessage() {}
+
+func (x *GetKycStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetKycStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetKycStatusRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetKycStatusResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// none, pending, approved or rejected.
+	KycStatus string `protobuf:"bytes,3,opt,name=kyc_status,json=kycStatus,proto3" json:"kyc_status,omitempty"`
+	// Newest submission; unset before the first one.
+	Submission    *KycSubmission `protobuf:"bytes,4,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycStatusResponse) Reset() {
+	*x = GetKycStatusResponse{}
+	mi := &file_user_kyc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycStatusResponse) ProtoMessage() {}
+
+func (x *GetKycStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetKycStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetKycStatusResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetKycStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetKycStatusResponse) GetKycStatus() string {
+	if x != nil {
+		return x.KycStatus
+	}
+	return ""
+}
+
+func (x *GetKycStatusResponse) GetSubmission() *KycSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type ListKycSubmissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pending, approved or rejected; empty lists every submission.
+	Status   string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response; the status must be unchanged.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKycSubmissionsRequest) Reset() {
+	*x = ListKycSubmissionsRequest{}
+	mi := &file_user_kyc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKycSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKycSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListKycSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKycSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListKycSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListKycSubmissionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListKycSubmissionsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListKycSubmissionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListKycSubmissionsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    []*KycSubmission       `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Token for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKycSubmissionsResponse) Reset() {
+	*x = ListKycSubmissionsResponse{}
+	mi := &file_user_kyc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKycSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKycSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListKycSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKycSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListKycSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListKycSubmissionsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListKycSubmissionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListKycSubmissionsResponse) GetData() []*KycSubmission {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListKycSubmissionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetKycSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycSubmissionRequest) Reset() {
+	*x = GetKycSubmissionRequest{}
+	mi := &file_user_kyc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycSubmissionRequest) ProtoMessage() {}
+
+func (x *GetKycSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GetKycSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetKycSubmissionRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+type GetKycSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *KycSubmission         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycSubmissionResponse) Reset() {
+	*x = GetKycSubmissionResponse{}
+	mi := &file_user_kyc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycSubmissionResponse) ProtoMessage() {}
+
+func (x *GetKycSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GetKycSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetKycSubmissionResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetKycSubmissionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetKycSubmissionResponse) GetData() *KycSubmission {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetKycSubmissionResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetKycSubmissionResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetKycDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycDocumentRequest) Reset() {
+	*x = GetKycDocumentRequest{}
+	mi := &file_user_kyc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycDocumentRequest) ProtoMessage() {}
+
+func (x *GetKycDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetKycDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetKycDocumentRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+type GetKycDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *KycDocument           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycDocumentResponse) Reset() {
+	*x = GetKycDocumentResponse{}
+	mi := &file_user_kyc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycDocumentResponse) ProtoMessage() {}
+
+func (x *GetKycDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetKycDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetKycDocumentResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetKycDocumentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetKycDocumentResponse) GetData() *KycDocument {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetKycDocumentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ReviewKycSubmissionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId int64                  `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	Decision     string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	// Shown to the user; required for rejections.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewKycSubmissionRequest) Reset() {
+	*x = ReviewKycSubmissionRequest{}
+	mi := &file_user_kyc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewKycSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKycSubmissionRequest) ProtoMessage() {}
+
+func (x *ReviewKycSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKycSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewKycSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReviewKycSubmissionRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *ReviewKycSubmissionRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ReviewKycSubmissionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewKycSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *KycSubmission         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewKycSubmissionResponse) Reset() {
+	*x = ReviewKycSubmissionResponse{}
+	mi := &file_user_kyc_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewKycSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKycSubmissionResponse) ProtoMessage() {}
+
+func (x *ReviewKycSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKycSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ReviewKycSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReviewKycSubmissionResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReviewKycSubmissionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReviewKycSubmissionResponse) GetData() *KycSubmission {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type KycDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// front, back or selfie.
+	Kind        string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes   int64  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Zero until the image is submitted.
+	SubmissionId  int64 `protobuf:"varint,5,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KycDocument) Reset() {
+	*x = KycDocument{}
+	mi := &file_user_kyc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KycDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycDocument) ProtoMessage() {}
+
+func (x *KycDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycDocument.ProtoReflect.Descriptor instead.
+func (*KycDocument) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KycDocument) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KycDocument) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *KycDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *KycDocument) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *KycDocument) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *KycDocument) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type KycSubmission struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// id_card or passport.
+	DocumentType   string `protobuf:"bytes,3,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	DocumentNumber string `protobuf:"bytes,4,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
+	FullName       string `protobuf:"bytes,5,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	IssuedOn       int64  `protobuf:"varint,6,opt,name=issued_on,json=issuedOn,proto3" json:"issued_on,omitempty"`
+	ExpiresOn      int64  `protobuf:"varint,7,opt,name=expires_on,json=expiresOn,proto3" json:"expires_on,omitempty"`
+	// pending, approved or rejected.
+	Status          string         `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	RejectionReason string         `protobuf:"bytes,9,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	ReviewerId      int64          `protobuf:"varint,10,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ReviewedAt      int64          `protobuf:"varint,11,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt       int64          `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Documents       []*KycDocument `protobuf:"bytes,13,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *KycSubmission) Reset() {
+	*x = KycSubmission{}
+	mi := &file_user_kyc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KycSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycSubmission) ProtoMessage() {}
+
+func (x *KycSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_user_kyc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycSubmission.ProtoReflect.Descriptor instead.
+func (*KycSubmission) Descriptor() ([]byte, []int) {
+	return file_user_kyc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KycSubmission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetDocumentNumber() string {
+	if x != nil {
+		return x.DocumentNumber
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetIssuedOn() int64 {
+	if x != nil {
+		return x.IssuedOn
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetExpiresOn() int64 {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetDocuments() []*KycDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+var File_user_kyc_proto protoreflect.FileDescriptor
+
+const file_user_kyc_proto_rawDesc = "" +
+	"\n" +
+	"\x0euser/kyc.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\x99\x01\n" +
+	"\x18UploadKycDocumentRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12.\n" +
+	"\x04kind\x18\x02 \x01(\tB\x1a\xfaB\x17r\x15R\x05frontR\x04backR\x06selfieR\x04kind\x12&\n" +
+	"\acontent\x18\x03 \x01(\fB\f\xfaB\tz\a\x10\x01\x18\x80\x80\x80\x05R\acontent\"\x86\x01\n" +
+	"\x19UploadKycDocumentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.KycDocumentR\x04data\"\xc0\x02\n" +
+	"\x10SubmitKycRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12=\n" +
+	"\rdocument_type\x18\x02 \x01(\tB\x18\xfaB\x15r\x13R\aid_cardR\bpassportR\fdocumentType\x122\n" +
+	"\x0fdocument_number\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18 R\x0edocumentNumber\x12%\n" +
+	"\tfull_name\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\bfullName\x12\x1b\n" +
+	"\tissued_on\x18\x05 \x01(\x03R\bissuedOn\x12\x1d\n" +
+	"\n" +
+	"expires_on\x18\x06 \x01(\x03R\texpiresOn\x12/\n" +
+	"\fdocument_ids\x18\a \x03(\x03B\f\xfaB\t\x92\x01\x06\b\x01\x10\x03\x18\x01R\vdocumentIds\"\x80\x01\n" +
+	"\x11SubmitKycResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
+	"\x04data\x18\x03 \x01(\v2).stock_trading.user_service.KycSubmissionR\x04data\"<\n" +
+	"\x13GetKycStatusRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\"\xae\x01\n" +
+	"\x14GetKycStatusResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"kyc_status\x18\x03 \x01(\tR\tkycStatus\x12I\n" +
+	"\n" +
+	"submission\x18\x04 \x01(\v2).stock_trading.user_service.KycSubmissionR\n" +
+	"submission\"\x95\x01\n" +
+	"\x19ListKycSubmissionsRequest\x12<\n" +
+	"\x06status\x18\x01 \x01(\tB$\xfaB!r\x1fR\x00R\apendingR\bapprovedR\brejectedR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xb1\x01\n" +
+	"\x1aListKycSubmissionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
+	"\x04data\x18\x03 \x03(\v2).stock_trading.user_service.KycSubmissionR\x04data\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"G\n" +
+	"\x17GetKycSubmissionRequest\x12,\n" +
+	"\rsubmission_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\fsubmissionId\"\xb9\x01\n" +
+	"\x18GetKycSubmissionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
+	"\x04data\x18\x03 \x01(\v2).stock_trading.user_service.KycSubmissionR\x04data\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\"A\n" +
+	"\x15GetKycDocumentRequest\x12(\n" +
+	"\vdocument_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
+	"documentId\"\x9d\x01\n" +
+	"\x16GetKycDocumentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.KycDocumentR\x04data\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\"\xa3\x01\n" +
+	"\x1aReviewKycSubmissionRequest\x12,\n" +
+	"\rsubmission_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\fsubmissionId\x125\n" +
+	"\bdecision\x18\x02 \x01(\tB\x19\xfaB\x16r\x14R\bapprovedR\brejectedR\bdecision\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06reason\"\x8a\x01\n" +
+	"\x1bReviewKycSubmissionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
+	"\x04data\x18\x03 \x01(\v2).stock_trading.user_service.KycSubmissionR\x04data\"\xb7\x01\n" +
+	"\vKycDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12#\n" +
+	"\rsubmission_id\x18\x05 \x01(\x03R\fsubmissionId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\xca\x03\n" +
+	"\rKycSubmission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12#\n" +
+	"\rdocument_type\x18\x03 \x01(\tR\fdocumentType\x12'\n" +
+	"\x0fdocument_number\x18\x04 \x01(\tR\x0edocumentNumber\x12\x1b\n" +
+	"\tfull_name\x18\x05 \x01(\tR\bfullName\x12\x1b\n" +
+	"\tissued_on\x18\x06 \x01(\x03R\bissuedOn\x12\x1d\n" +
+	"\n" +
+	"expires_on\x18\a \x01(\x03R\texpiresOn\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12)\n" +
+	"\x10rejection_reason\x18\t \x01(\tR\x0frejectionReason\x12\x1f\n" +
+	"\vreviewer_id\x18\n" +
+	" \x01(\x03R\n" +
+	"reviewerId\x12\x1f\n" +
+	"\vreviewed_at\x18\v \x01(\x03R\n" +
+	"reviewedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12E\n" +
+	"\tdocuments\x18\r \x03(\v2'.stock_trading.user_service.KycDocumentR\tdocuments2\xa2\t\n" +
+	"\n" +
+	"KycService\x12\x80\x01\n" +
+	"\x11UploadKycDocument\x124.stock_trading.user_service.UploadKycDocumentRequest\x1a5.stock_trading.user_service.UploadKycDocumentResponse\x12\x9c\x01\n" +
+	"\tSubmitKyc\x12,.stock_trading.user_service.SubmitKycRequest\x1a-.stock_trading.user_service.SubmitKycResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/user/{username}/kyc/submissions\x12\x96\x01\n" +
+	"\fGetKycStatus\x12/.stock_trading.user_service.GetKycStatusRequest\x1a0.stock_trading.user_service.GetKycStatusResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/user/{username}/kyc\x12\xaa\x01\n" +
+	"\x12ListKycSubmissions\x125.stock_trading.user_service.ListKycSubmissionsRequest\x1a6.stock_trading.user_service.ListKycSubmissionsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/admin/kyc/submissions\x12\xb4\x01\n" +
+	"\x10GetKycSubmission\x123.stock_trading.user_service.GetKycSubmissionRequest\x1a4.stock_trading.user_service.GetKycSubmissionResponse\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/admin/kyc/submissions/{submission_id}\x12\xaa\x01\n" +
+	"\x0eGetKycDocument\x121.stock_trading.user_service.GetKycDocumentRequest\x1a2.stock_trading.user_service.GetKycDocumentResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/admin/kyc/documents/{document_id}\x12\xc7\x01\n" +
+	"\x13ReviewKycSubmission\x126.stock_trading.user_service.ReviewKycSubmissionRequest\x1a7.stock_trading.user_service.ReviewKycSubmissionResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/admin/kyc/submissions/{submission_id}/reviewB\xe0\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\bKycProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_kyc_proto_rawDescOnce sync.Once
+	file_user_kyc_proto_rawDescData []byte
+)
+
+func file_user_kyc_proto_rawDescGZIP() []byte {
+	file_user_kyc_proto_rawDescOnce.Do(func() {
+		file_user_kyc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_kyc_proto_rawDesc), len(file_user_kyc_proto_rawDesc)))
+	})
+	return file_user_kyc_proto_rawDescData
+}
+
+var file_user_kyc_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_user_kyc_proto_goTypes = []any{
+	(*UploadKycDocumentRequest)(nil),    // 0: stock_trading.user_service.UploadKycDocumentRequest
+	(*UploadKycDocumentResponse)(nil),   // 1: stock_trading.user_service.UploadKycDocumentResponse
+	(*SubmitKycRequest)(nil),            // 2: stock_trading.user_service.SubmitKycRequest
+	(*SubmitKycResponse)(nil),           // 3: stock_trading.user_service.SubmitKycResponse
+	(*GetKycStatusRequest)(nil),         // 4: stock_trading.user_service.GetKycStatusRequest
+	(*GetKycStatusResponse)(nil),        // 5: stock_trading.user_service.GetKycStatusResponse
+	(*ListKycSubmissionsRequest)(nil),   // 6: stock_trading.user_service.ListKycSubmissionsRequest
+	(*ListKycSubmissionsResponse)(nil),  // 7: stock_trading.user_service.ListKycSubmissionsResponse
+	(*GetKycSubmissionRequest)(nil),     // 8: stock_trading.user_service.GetKycSubmissionRequest
+	(*GetKycSubmissionResponse)(nil),    // 9: stock_trading.user_service.GetKycSubmissionResponse
+	(*GetKycDocumentRequest)(nil),       // 10: stock_trading.user_service.GetKycDocumentRequest
+	(*GetKycDocumentResponse)(nil),      // 11: stock_trading.user_service.GetKycDocumentResponse
+	(*ReviewKycSubmissionRequest)(nil),  // 12: stock_trading.user_service.ReviewKycSubmissionRequest
+	(*ReviewKycSubmissionResponse)(nil), // 13: stock_trading.user_service.ReviewKycSubmissionResponse
+	(*KycDocument)(nil),                 // 14: stock_trading.user_service.KycDocument
+	(*KycSubmission)(nil),               // 15: stock_trading.user_service.KycSubmission
+}
+var file_user_kyc_proto_depIdxs = []int32{
+	14, // 0: stock_trading.user_service.UploadKycDocumentResponse.data:type_name -> stock_trading.user_service.KycDocument
+	15, // 1: stock_trading.user_service.SubmitKycResponse.data:type_name -> stock_trading.user_service.KycSubmission
+	15, // 2: stock_trading.user_service.GetKycStatusResponse.submission:type_name -> stock_trading.user_service.KycSubmission
+	15, // 3: stock_trading.user_service.ListKycSubmissionsResponse.data:type_name -> stock_trading.user_service.KycSubmission
+	15, // 4: stock_trading.user_service.GetKycSubmissionResponse.data:type_name -> stock_trading.user_service.KycSubmission
+	14, // 5: stock_trading.user_service.GetKycDocumentResponse.data:type_name -> stock_trading.user_service.KycDocument
+	15, // 6: stock_trading.user_service.ReviewKycSubmissionResponse.data:type_name -> stock_trading.user_service.KycSubmission
+	14, // 7: stock_trading.user_service.KycSubmission.documents:type_name -> stock_trading.user_service.KycDocument
+	0,  // 8: stock_trading.user_service.KycService.UploadKycDocument:input_type -> stock_trading.user_service.UploadKycDocumentRequest
+	2,  // 9: stock_trading.user_service.KycService.SubmitKyc:input_type -> stock_trading.user_service.SubmitKycRequest
+	4,  // 10: stock_trading.user_service.KycService.GetKycStatus:input_type -> stock_trading.user_service.GetKycStatusRequest
+	6,  // 11: stock_trading.user_service.KycService.ListKycSubmissions:input_type -> stock_trading.user_service.ListKycSubmissionsRequest
+	8,  // 12: stock_trading.user_service.KycService.GetKycSubmission:input_type -> stock_trading.user_service.GetKycSubmissionRequest
+	10, // 13: stock_trading.user_service.KycService.GetKycDocument:input_type -> stock_trading.user_service.GetKycDocumentRequest
+	12, // 14: stock_trading.user_service.KycService.ReviewKycSubmission:input_type -> stock_trading.user_service.ReviewKycSubmissionRequest
+	1,  // 15: stock_trading.user_service.KycService.UploadKycDocument:output_type -> stock_trading.user_service.UploadKycDocumentResponse
+	3,  // 16: stock_trading.user_service.KycService.SubmitKyc:output_type -> stock_trading.user_service.SubmitKycResponse
+	5,  // 17: stock_trading.user_service.KycService.GetKycStatus:output_type -> stock_trading.user_service.GetKycStatusResponse
+	7,  // 18: stock_trading.user_service.KycService.ListKycSubmissions:output_type -> stock_trading.user_service.ListKycSubmissionsResponse
+	9,  // 19: stock_trading.user_service.KycService.GetKycSubmission:output_type -> stock_trading.user_service.GetKycSubmissionResponse
+	11, // 20: stock_trading.user_service.KycService.GetKycDocument:output_type -> stock_trading.user_service.GetKycDocumentResponse
+	13, // 21: stock_trading.user_service.KycService.ReviewKycSubmission:output_type -> stock_trading.user_service.ReviewKycSubmissionResponse
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_user_kyc_proto_init() }
+func file_user_kyc_proto_init() {
+	if File_user_kyc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_kyc_proto_rawDesc), len(file_user_kyc_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_kyc_proto_goTypes,
+		DependencyIndexes: file_user_kyc_proto_depIdxs,
+		MessageInfos:      file_user_kyc_proto_msgTypes,
+	}.Build()
+	File_user_kyc_proto = out.File
+	file_user_kyc_proto_goTypes = nil
+	file_user_kyc_proto_depIdxs = nil
+}
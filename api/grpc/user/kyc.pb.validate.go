@@ -0,0 +1,2195 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/kyc.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UploadKycDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadKycDocumentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadKycDocumentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadKycDocumentRequestMultiError, or nil if none found.
+func (m *UploadKycDocumentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadKycDocumentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := UploadKycDocumentRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UploadKycDocumentRequest_Kind_InLookup[m.GetKind()]; !ok {
+		err := UploadKycDocumentRequestValidationError{
+			field:  "Kind",
+			reason: "value must be in list [front back selfie]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetContent()); l < 1 || l > 10485760 {
+		err := UploadKycDocumentRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 10485760 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadKycDocumentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadKycDocumentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadKycDocumentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadKycDocumentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadKycDocumentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadKycDocumentRequestMultiError) AllErrors() []error { return m }
+
+// UploadKycDocumentRequestValidationError is the validation error returned by
+// UploadKycDocumentRequest.Validate if the designated constraints aren't met.
+type UploadKycDocumentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadKycDocumentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadKycDocumentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadKycDocumentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadKycDocumentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadKycDocumentRequestValidationError) ErrorName() string {
+	return "UploadKycDocumentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadKycDocumentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadKycDocumentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadKycDocumentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadKycDocumentRequestValidationError{}
+
+var _UploadKycDocumentRequest_Kind_InLookup = map[string]struct{}{
+	"front":  {},
+	"back":   {},
+	"selfie": {},
+}
+
+// Validate checks the field values on UploadKycDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadKycDocumentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadKycDocumentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadKycDocumentResponseMultiError, or nil if none found.
+func (m *UploadKycDocumentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadKycDocumentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadKycDocumentResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadKycDocumentResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadKycDocumentResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadKycDocumentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadKycDocumentResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadKycDocumentResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UploadKycDocumentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadKycDocumentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadKycDocumentResponseMultiError) AllErrors() []error { return m }
+
+// UploadKycDocumentResponseValidationError is the validation error returned by
+// UploadKycDocumentResponse.Validate if the designated constraints aren't met.
+type UploadKycDocumentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadKycDocumentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadKycDocumentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadKycDocumentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadKycDocumentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadKycDocumentResponseValidationError) ErrorName() string {
+	return "UploadKycDocumentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadKycDocumentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadKycDocumentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadKycDocumentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadKycDocumentResponseValidationError{}
+
+// Validate checks the field values on SubmitKycRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubmitKycRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitKycRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitKycRequestMultiError, or nil if none found.
+func (m *SubmitKycRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitKycRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := SubmitKycRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SubmitKycRequest_DocumentType_InLookup[m.GetDocumentType()]; !ok {
+		err := SubmitKycRequestValidationError{
+			field:  "DocumentType",
+			reason: "value must be in list [id_card passport]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetDocumentNumber()); l < 6 || l > 32 {
+		err := SubmitKycRequestValidationError{
+			field:  "DocumentNumber",
+			reason: "value length must be between 6 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFullName()) > 255 {
+		err := SubmitKycRequestValidationError{
+			field:  "FullName",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IssuedOn
+
+	// no validation rules for ExpiresOn
+
+	if l := len(m.GetDocumentIds()); l < 1 || l > 3 {
+		err := SubmitKycRequestValidationError{
+			field:  "DocumentIds",
+			reason: "value must contain between 1 and 3 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SubmitKycRequest_DocumentIds_Unique := make(map[int64]struct{}, len(m.GetDocumentIds()))
+
+	for idx, item := range m.GetDocumentIds() {
+		_, _ = idx, item
+
+		if _, exists := _SubmitKycRequest_DocumentIds_Unique[item]; exists {
+			err := SubmitKycRequestValidationError{
+				field:  fmt.Sprintf("DocumentIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SubmitKycRequest_DocumentIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for DocumentIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return SubmitKycRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitKycRequestMultiError is an error wrapping multiple validation errors
+// returned by SubmitKycRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SubmitKycRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitKycRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitKycRequestMultiError) AllErrors() []error { return m }
+
+// SubmitKycRequestValidationError is the validation error returned by
+// SubmitKycRequest.Validate if the designated constraints aren't met.
+type SubmitKycRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitKycRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitKycRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitKycRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitKycRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitKycRequestValidationError) ErrorName() string { return "SubmitKycRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubmitKycRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitKycRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitKycRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitKycRequestValidationError{}
+
+var _SubmitKycRequest_DocumentType_InLookup = map[string]struct{}{
+	"id_card":  {},
+	"passport": {},
+}
+
+// Validate checks the field values on SubmitKycResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubmitKycResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitKycResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitKycResponseMultiError, or nil if none found.
+func (m *SubmitKycResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitKycResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitKycResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitKycResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitKycResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitKycResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitKycResponseMultiError is an error wrapping multiple validation errors
+// returned by SubmitKycResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SubmitKycResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitKycResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitKycResponseMultiError) AllErrors() []error { return m }
+
+// SubmitKycResponseValidationError is the validation error returned by
+// SubmitKycResponse.Validate if the designated constraints aren't met.
+type SubmitKycResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitKycResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitKycResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitKycResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitKycResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitKycResponseValidationError) ErrorName() string {
+	return "SubmitKycResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitKycResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitKycResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitKycResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitKycResponseValidationError{}
+
+// Validate checks the field values on GetKycStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKycStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKycStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKycStatusRequestMultiError, or nil if none found.
+func (m *GetKycStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKycStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := GetKycStatusRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetKycStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKycStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetKycStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetKycStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKycStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKycStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetKycStatusRequestValidationError is the validation error returned by
+// GetKycStatusRequest.Validate if the designated constraints aren't met.
+type GetKycStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKycStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKycStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKycStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKycStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKycStatusRequestValidationError) ErrorName() string {
+	return "GetKycStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKycStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKycStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKycStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKycStatusRequestValidationError{}
+
+// Validate checks the field values on GetKycStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKycStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKycStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKycStatusResponseMultiError, or nil if none found.
+func (m *GetKycStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKycStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for KycStatus
+
+	if all {
+		switch v := interface{}(m.GetSubmission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetKycStatusResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetKycStatusResponseValidationError{
+					field:  "Submission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetKycStatusResponseValidationError{
+				field:  "Submission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetKycStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKycStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetKycStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetKycStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKycStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKycStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetKycStatusResponseValidationError is the validation error returned by
+// GetKycStatusResponse.Validate if the designated constraints aren't met.
+type GetKycStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKycStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKycStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKycStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKycStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKycStatusResponseValidationError) ErrorName() string {
+	return "GetKycStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKycStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKycStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKycStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKycStatusResponseValidationError{}
+
+// Validate checks the field values on ListKycSubmissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListKycSubmissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKycSubmissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKycSubmissionsRequestMultiError, or nil if none found.
+func (m *ListKycSubmissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKycSubmissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListKycSubmissionsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListKycSubmissionsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ pending approved rejected]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListKycSubmissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKycSubmissionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListKycSubmissionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListKycSubmissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKycSubmissionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKycSubmissionsRequestMultiError) AllErrors() []error { return m }
+
+// ListKycSubmissionsRequestValidationError is the validation error returned by
+// ListKycSubmissionsRequest.Validate if the designated constraints aren't met.
+type ListKycSubmissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKycSubmissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKycSubmissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKycSubmissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKycSubmissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKycSubmissionsRequestValidationError) ErrorName() string {
+	return "ListKycSubmissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListKycSubmissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKycSubmissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKycSubmissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKycSubmissionsRequestValidationError{}
+
+var _ListKycSubmissionsRequest_Status_InLookup = map[string]struct{}{
+	"":         {},
+	"pending":  {},
+	"approved": {},
+	"rejected": {},
+}
+
+// Validate checks the field values on ListKycSubmissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListKycSubmissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKycSubmissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKycSubmissionsResponseMultiError, or nil if none found.
+func (m *ListKycSubmissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKycSubmissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListKycSubmissionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListKycSubmissionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListKycSubmissionsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListKycSubmissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKycSubmissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListKycSubmissionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListKycSubmissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKycSubmissionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKycSubmissionsResponseMultiError) AllErrors() []error { return m }
+
+// ListKycSubmissionsResponseValidationError is the validation error returned
+// by ListKycSubmissionsResponse.Validate if the designated constraints aren't met.
+type ListKycSubmissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKycSubmissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKycSubmissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKycSubmissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKycSubmissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKycSubmissionsResponseValidationError) ErrorName() string {
+	return "ListKycSubmissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListKycSubmissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKycSubmissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKycSubmissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKycSubmissionsResponseValidationError{}
+
+// Validate checks the field values on GetKycSubmissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKycSubmissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKycSubmissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKycSubmissionRequestMultiError, or nil if none found.
+func (m *GetKycSubmissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKycSubmissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSubmissionId() <= 0 {
+		err := GetKycSubmissionRequestValidationError{
+			field:  "SubmissionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetKycSubmissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKycSubmissionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetKycSubmissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetKycSubmissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKycSubmissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKycSubmissionRequestMultiError) AllErrors() []error { return m }
+
+// GetKycSubmissionRequestValidationError is the validation error returned by
+// GetKycSubmissionRequest.Validate if the designated constraints aren't met.
+type GetKycSubmissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKycSubmissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKycSubmissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKycSubmissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKycSubmissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKycSubmissionRequestValidationError) ErrorName() string {
+	return "GetKycSubmissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKycSubmissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKycSubmissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKycSubmissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKycSubmissionRequestValidationError{}
+
+// Validate checks the field values on GetKycSubmissionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKycSubmissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKycSubmissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKycSubmissionResponseMultiError, or nil if none found.
+func (m *GetKycSubmissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKycSubmissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetKycSubmissionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetKycSubmissionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetKycSubmissionResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return GetKycSubmissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKycSubmissionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetKycSubmissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetKycSubmissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKycSubmissionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKycSubmissionResponseMultiError) AllErrors() []error { return m }
+
+// GetKycSubmissionResponseValidationError is the validation error returned by
+// GetKycSubmissionResponse.Validate if the designated constraints aren't met.
+type GetKycSubmissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKycSubmissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKycSubmissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKycSubmissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKycSubmissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKycSubmissionResponseValidationError) ErrorName() string {
+	return "GetKycSubmissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKycSubmissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKycSubmissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKycSubmissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKycSubmissionResponseValidationError{}
+
+// Validate checks the field values on GetKycDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKycDocumentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKycDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKycDocumentRequestMultiError, or nil if none found.
+func (m *GetKycDocumentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKycDocumentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDocumentId() <= 0 {
+		err := GetKycDocumentRequestValidationError{
+			field:  "DocumentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetKycDocumentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKycDocumentRequestMultiError is an error wrapping multiple validation
+// errors returned by GetKycDocumentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetKycDocumentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKycDocumentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKycDocumentRequestMultiError) AllErrors() []error { return m }
+
+// GetKycDocumentRequestValidationError is the validation error returned by
+// GetKycDocumentRequest.Validate if the designated constraints aren't met.
+type GetKycDocumentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKycDocumentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKycDocumentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKycDocumentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKycDocumentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKycDocumentRequestValidationError) ErrorName() string {
+	return "GetKycDocumentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKycDocumentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKycDocumentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKycDocumentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKycDocumentRequestValidationError{}
+
+// Validate checks the field values on GetKycDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKycDocumentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKycDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKycDocumentResponseMultiError, or nil if none found.
+func (m *GetKycDocumentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKycDocumentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetKycDocumentResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetKycDocumentResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetKycDocumentResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return GetKycDocumentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKycDocumentResponseMultiError is an error wrapping multiple validation
+// errors returned by GetKycDocumentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetKycDocumentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKycDocumentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKycDocumentResponseMultiError) AllErrors() []error { return m }
+
+// GetKycDocumentResponseValidationError is the validation error returned by
+// GetKycDocumentResponse.Validate if the designated constraints aren't met.
+type GetKycDocumentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKycDocumentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKycDocumentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKycDocumentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKycDocumentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKycDocumentResponseValidationError) ErrorName() string {
+	return "GetKycDocumentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKycDocumentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKycDocumentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKycDocumentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKycDocumentResponseValidationError{}
+
+// Validate checks the field values on ReviewKycSubmissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReviewKycSubmissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewKycSubmissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewKycSubmissionRequestMultiError, or nil if none found.
+func (m *ReviewKycSubmissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewKycSubmissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSubmissionId() <= 0 {
+		err := ReviewKycSubmissionRequestValidationError{
+			field:  "SubmissionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReviewKycSubmissionRequest_Decision_InLookup[m.GetDecision()]; !ok {
+		err := ReviewKycSubmissionRequestValidationError{
+			field:  "Decision",
+			reason: "value must be in list [approved rejected]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 255 {
+		err := ReviewKycSubmissionRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReviewKycSubmissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewKycSubmissionRequestMultiError is an error wrapping multiple
+// validation errors returned by ReviewKycSubmissionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReviewKycSubmissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewKycSubmissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewKycSubmissionRequestMultiError) AllErrors() []error { return m }
+
+// ReviewKycSubmissionRequestValidationError is the validation error returned
+// by ReviewKycSubmissionRequest.Validate if the designated constraints aren't met.
+type ReviewKycSubmissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewKycSubmissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewKycSubmissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewKycSubmissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewKycSubmissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewKycSubmissionRequestValidationError) ErrorName() string {
+	return "ReviewKycSubmissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewKycSubmissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewKycSubmissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewKycSubmissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewKycSubmissionRequestValidationError{}
+
+var _ReviewKycSubmissionRequest_Decision_InLookup = map[string]struct{}{
+	"approved": {},
+	"rejected": {},
+}
+
+// Validate checks the field values on ReviewKycSubmissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReviewKycSubmissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewKycSubmissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewKycSubmissionResponseMultiError, or nil if none found.
+func (m *ReviewKycSubmissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewKycSubmissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewKycSubmissionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewKycSubmissionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewKycSubmissionResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewKycSubmissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewKycSubmissionResponseMultiError is an error wrapping multiple
+// validation errors returned by ReviewKycSubmissionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReviewKycSubmissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewKycSubmissionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewKycSubmissionResponseMultiError) AllErrors() []error { return m }
+
+// ReviewKycSubmissionResponseValidationError is the validation error returned
+// by ReviewKycSubmissionResponse.Validate if the designated constraints
+// aren't met.
+type ReviewKycSubmissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewKycSubmissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewKycSubmissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewKycSubmissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewKycSubmissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewKycSubmissionResponseValidationError) ErrorName() string {
+	return "ReviewKycSubmissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewKycSubmissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewKycSubmissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewKycSubmissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewKycSubmissionResponseValidationError{}
+
+// Validate checks the field values on KycDocument with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KycDocument) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KycDocument with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KycDocumentMultiError, or
+// nil if none found.
+func (m *KycDocument) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KycDocument) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for ContentType
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for SubmissionId
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return KycDocumentMultiError(errors)
+	}
+
+	return nil
+}
+
+// KycDocumentMultiError is an error wrapping multiple validation errors
+// returned by KycDocument.ValidateAll() if the designated constraints aren't met.
+type KycDocumentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KycDocumentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KycDocumentMultiError) AllErrors() []error { return m }
+
+// KycDocumentValidationError is the validation error returned by
+// KycDocument.Validate if the designated constraints aren't met.
+type KycDocumentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KycDocumentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KycDocumentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KycDocumentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KycDocumentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KycDocumentValidationError) ErrorName() string { return "KycDocumentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KycDocumentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKycDocument.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KycDocumentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KycDocumentValidationError{}
+
+// Validate checks the field values on KycSubmission with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KycSubmission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KycSubmission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KycSubmissionMultiError, or
+// nil if none found.
+func (m *KycSubmission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KycSubmission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for DocumentType
+
+	// no validation rules for DocumentNumber
+
+	// no validation rules for FullName
+
+	// no validation rules for IssuedOn
+
+	// no validation rules for ExpiresOn
+
+	// no validation rules for Status
+
+	// no validation rules for RejectionReason
+
+	// no validation rules for ReviewerId
+
+	// no validation rules for ReviewedAt
+
+	// no validation rules for CreatedAt
+
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KycSubmissionValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KycSubmissionValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KycSubmissionValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return KycSubmissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// KycSubmissionMultiError is an error wrapping multiple validation errors
+// returned by KycSubmission.ValidateAll() if the designated constraints
+// aren't met.
+type KycSubmissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KycSubmissionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KycSubmissionMultiError) AllErrors() []error { return m }
+
+// KycSubmissionValidationError is the validation error returned by
+// KycSubmission.Validate if the designated constraints aren't met.
+type KycSubmissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KycSubmissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KycSubmissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KycSubmissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KycSubmissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KycSubmissionValidationError) ErrorName() string { return "KycSubmissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KycSubmissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKycSubmission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KycSubmissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KycSubmissionValidationError{}
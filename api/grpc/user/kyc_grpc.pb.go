@@ -0,0 +1,383 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: user/kyc.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	KycService_UploadKycDocument_FullMethodName   = "/stock_trading.user_service.KycService/UploadKycDocument"
+	KycService_SubmitKyc_FullMethodName           = "/stock_trading.user_service.KycService/SubmitKyc"
+	KycService_GetKycStatus_FullMethodName        = "/stock_trading.user_service.KycService/GetKycStatus"
+	KycService_ListKycSubmissions_FullMethodName  = "/stock_trading.user_service.KycService/ListKycSubmissions"
+	KycService_GetKycSubmission_FullMethodName    = "/stock_trading.user_service.KycService/GetKycSubmission"
+	KycService_GetKycDocument_FullMethodName      = "/stock_trading.user_service.KycService/GetKycDocument"
+	KycService_ReviewKycSubmission_FullMethodName = "/stock_trading.user_service.KycService/ReviewKycSubmission"
+)
+
+// KycServiceClient is the client API for KycService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// KycService verifies the identity of users before they may trade. Users
+// upload document images and submit them with the document metadata;
+// administrators approve or reject the submissions.
+type KycServiceClient interface {
+	// UploadKycDocument stores a JPEG or PNG image of at most 10 MiB. Over HTTP
+	// the gateway accepts it as multipart/form-data on
+	// POST /api/v1/user/{username}/kyc/documents with the fields kind and file.
+	UploadKycDocument(ctx context.Context, in *UploadKycDocumentRequest, opts ...grpc.CallOption) (*UploadKycDocumentResponse, error)
+	// SubmitKyc sends uploaded images for review. An id card needs its front
+	// and back, a passport its front; a selfie is optional.
+	SubmitKyc(ctx context.Context, in *SubmitKycRequest, opts ...grpc.CallOption) (*SubmitKycResponse, error)
+	// GetKycStatus returns the caller's verification status and newest
+	// submission.
+	GetKycStatus(ctx context.Context, in *GetKycStatusRequest, opts ...grpc.CallOption) (*GetKycStatusResponse, error)
+	// ListKycSubmissions returns the review queue, oldest first.
+	// Administrators only.
+	ListKycSubmissions(ctx context.Context, in *ListKycSubmissionsRequest, opts ...grpc.CallOption) (*ListKycSubmissionsResponse, error)
+	// GetKycSubmission returns a submission with its owner. Administrators only.
+	GetKycSubmission(ctx context.Context, in *GetKycSubmissionRequest, opts ...grpc.CallOption) (*GetKycSubmissionResponse, error)
+	// GetKycDocument returns a document image. Administrators only.
+	GetKycDocument(ctx context.Context, in *GetKycDocumentRequest, opts ...grpc.CallOption) (*GetKycDocumentResponse, error)
+	// ReviewKycSubmission approves or rejects a pending submission and
+	// notifies the user. Administrators only.
+	ReviewKycSubmission(ctx context.Context, in *ReviewKycSubmissionRequest, opts ...grpc.CallOption) (*ReviewKycSubmissionResponse, error)
+}
+
+type kycServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKycServiceClient(cc grpc.ClientConnInterface) KycServiceClient {
+	return &kycServiceClient{cc}
+}
+
+func (c *kycServiceClient) UploadKycDocument(ctx context.Context, in *UploadKycDocumentRequest, opts ...grpc.CallOption) (*UploadKycDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadKycDocumentResponse)
+	err := c.cc.Invoke(ctx, KycService_UploadKycDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) SubmitKyc(ctx context.Context, in *SubmitKycRequest, opts ...grpc.CallOption) (*SubmitKycResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitKycResponse)
+	err := c.cc.Invoke(ctx, KycService_SubmitKyc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) GetKycStatus(ctx context.Context, in *GetKycStatusRequest, opts ...grpc.CallOption) (*GetKycStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKycStatusResponse)
+	err := c.cc.Invoke(ctx, KycService_GetKycStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) ListKycSubmissions(ctx context.Context, in *ListKycSubmissionsRequest, opts ...grpc.CallOption) (*ListKycSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKycSubmissionsResponse)
+	err := c.cc.Invoke(ctx, KycService_ListKycSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) GetKycSubmission(ctx context.Context, in *GetKycSubmissionRequest, opts ...grpc.CallOption) (*GetKycSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKycSubmissionResponse)
+	err := c.cc.Invoke(ctx, KycService_GetKycSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) GetKycDocument(ctx context.Context, in *GetKycDocumentRequest, opts ...grpc.CallOption) (*GetKycDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKycDocumentResponse)
+	err := c.cc.Invoke(ctx, KycService_GetKycDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) ReviewKycSubmission(ctx context.Context, in *ReviewKycSubmissionRequest, opts ...grpc.CallOption) (*ReviewKycSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewKycSubmissionResponse)
+	err := c.cc.Invoke(ctx, KycService_ReviewKycSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KycServiceServer is the server API for KycService service.
+// All implementations must embed UnimplementedKycServiceServer
+// for forward compatibility.
+//
+// KycService verifies the identity of users before they may trade. Users
+// upload document images and submit them with the document metadata;
+// administrators approve or reject the submissions.
+type KycServiceServer interface {
+	// UploadKycDocument stores a JPEG or PNG image of at most 10 MiB. Over HTTP
+	// the gateway accepts it as multipart/form-data on
+	// POST /api/v1/user/{username}/kyc/documents with the fields kind and file.
+	UploadKycDocument(context.Context, *UploadKycDocumentRequest) (*UploadKycDocumentResponse, error)
+	// SubmitKyc sends uploaded images for review. An id card needs its front
+	// and back, a passport its front; a selfie is optional.
+	SubmitKyc(context.Context, *SubmitKycRequest) (*SubmitKycResponse, error)
+	// GetKycStatus returns the caller's verification status and newest
+	// submission.
+	GetKycStatus(context.Context, *GetKycStatusRequest) (*GetKycStatusResponse, error)
+	// ListKycSubmissions returns the review queue, oldest first.
+	// Administrators only.
+	ListKycSubmissions(context.Context, *ListKycSubmissionsRequest) (*ListKycSubmissionsResponse, error)
+	// GetKycSubmission returns a submission with its owner. Administrators only.
+	GetKycSubmission(context.Context, *GetKycSubmissionRequest) (*GetKycSubmissionResponse, error)
+	// GetKycDocument returns a document image. Administrators only.
+	GetKycDocument(context.Context, *GetKycDocumentRequest) (*GetKycDocumentResponse, error)
+	// ReviewKycSubmission approves or rejects a pending submission and
+	// notifies the user. Administrators only.
+	ReviewKycSubmission(context.Context, *ReviewKycSubmissionRequest) (*ReviewKycSubmissionResponse, error)
+	mustEmbedUnimplementedKycServiceServer()
+}
+
+// UnimplementedKycServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKycServiceServer struct{}
+
+func (UnimplementedKycServiceServer) UploadKycDocument(context.Context, *UploadKycDocumentRequest) (*UploadKycDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadKycDocument not implemented")
+}
+func (UnimplementedKycServiceServer) SubmitKyc(context.Context, *SubmitKycRequest) (*SubmitKycResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitKyc not implemented")
+}
+func (UnimplementedKycServiceServer) GetKycStatus(context.Context, *GetKycStatusRequest) (*GetKycStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKycStatus not implemented")
+}
+func (UnimplementedKycServiceServer) ListKycSubmissions(context.Context, *ListKycSubmissionsRequest) (*ListKycSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKycSubmissions not implemented")
+}
+func (UnimplementedKycServiceServer) GetKycSubmission(context.Context, *GetKycSubmissionRequest) (*GetKycSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKycSubmission not implemented")
+}
+func (UnimplementedKycServiceServer) GetKycDocument(context.Context, *GetKycDocumentRequest) (*GetKycDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKycDocument not implemented")
+}
+func (UnimplementedKycServiceServer) ReviewKycSubmission(context.Context, *ReviewKycSubmissionRequest) (*ReviewKycSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewKycSubmission not implemented")
+}
+func (UnimplementedKycServiceServer) mustEmbedUnimplementedKycServiceServer() {}
+func (UnimplementedKycServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeKycServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KycServiceServer will
+// result in compilation errors.
+type UnsafeKycServiceServer interface {
+	mustEmbedUnimplementedKycServiceServer()
+}
+
+func RegisterKycServiceServer(s grpc.ServiceRegistrar, srv KycServiceServer) {
+	// If the following call pancis, it indicates UnimplementedKycServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KycService_ServiceDesc, srv)
+}
+
+func _KycService_UploadKycDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadKycDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).UploadKycDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_UploadKycDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).UploadKycDocument(ctx, req.(*UploadKycDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_SubmitKyc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitKycRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).SubmitKyc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_SubmitKyc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).SubmitKyc(ctx, req.(*SubmitKycRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_GetKycStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKycStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).GetKycStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_GetKycStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).GetKycStatus(ctx, req.(*GetKycStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_ListKycSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKycSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).ListKycSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_ListKycSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).ListKycSubmissions(ctx, req.(*ListKycSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_GetKycSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKycSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).GetKycSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_GetKycSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).GetKycSubmission(ctx, req.(*GetKycSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_GetKycDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKycDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).GetKycDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_GetKycDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).GetKycDocument(ctx, req.(*GetKycDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_ReviewKycSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewKycSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).ReviewKycSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_ReviewKycSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).ReviewKycSubmission(ctx, req.(*ReviewKycSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KycService_ServiceDesc is the grpc.ServiceDesc for KycService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KycService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stock_trading.user_service.KycService",
+	HandlerType: (*KycServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadKycDocument",
+			Handler:    _KycService_UploadKycDocument_Handler,
+		},
+		{
+			MethodName: "SubmitKyc",
+			Handler:    _KycService_SubmitKyc_Handler,
+		},
+		{
+			MethodName: "GetKycStatus",
+			Handler:    _KycService_GetKycStatus_Handler,
+		},
+		{
+			MethodName: "ListKycSubmissions",
+			Handler:    _KycService_ListKycSubmissions_Handler,
+		},
+		{
+			MethodName: "GetKycSubmission",
+			Handler:    _KycService_GetKycSubmission_Handler,
+		},
+		{
+			MethodName: "GetKycDocument",
+			Handler:    _KycService_GetKycDocument_Handler,
+		},
+		{
+			MethodName: "ReviewKycSubmission",
+			Handler:    _KycService_ReviewKycSubmission_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/kyc.proto",
+}
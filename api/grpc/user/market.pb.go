@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/market.proto
+
+package user
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetMarketSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketSessionRequest) Reset() {
+	*x = GetMarketSessionRequest{}
+	mi := &file_user_market_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketSessionRequest) ProtoMessage() {}
+
+func (x *GetMarketSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_market_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketSessionRequest) Descriptor() ([]byte, []int) {
+	return file_user_market_proto_rawDescGZIP(), []int{0}
+}
+
+type GetMarketSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *MarketSession         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarketSessionResponse) Reset() {
+	*x = GetMarketSessionResponse{}
+	mi := &file_user_market_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarketSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketSessionResponse) ProtoMessage() {}
+
+func (x *GetMarketSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_market_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketSessionResponse) Descriptor() ([]byte, []int) {
+	return file_user_market_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetMarketSessionResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetMarketSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetMarketSessionResponse) GetData() *MarketSession {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MarketSession struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ato, continuous, atc or closed. Orders are accepted in every session but
+	// closed; IOC and FOK orders only in continuous.
+	Session string `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	// Whether the market trades today; false on weekends and holidays.
+	TradingDay bool `protobuf:"varint,2,opt,name=trading_day,json=tradingDay,proto3" json:"trading_day,omitempty"`
+	// IANA time zone of the trading calendar, such as Asia/Ho_Chi_Minh.
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Session the market moves to next and the Unix time it does; empty and
+	// zero when the market does not trade in the coming year.
+	NextSession   string `protobuf:"bytes,4,opt,name=next_session,json=nextSession,proto3" json:"next_session,omitempty"`
+	NextSessionAt int64  `protobuf:"varint,5,opt,name=next_session_at,json=nextSessionAt,proto3" json:"next_session_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketSession) Reset() {
+	*x = MarketSession{}
+	mi := &file_user_market_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketSession) ProtoMessage() {}
+
+func (x *MarketSession) ProtoReflect() protoreflect.Message {
+	mi := &file_user_market_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketSession.ProtoReflect.Descriptor instead.
+func (*MarketSession) Descriptor() ([]byte, []int) {
+	return file_user_market_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MarketSession) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *MarketSession) GetTradingDay() bool {
+	if x != nil {
+		return x.TradingDay
+	}
+	return false
+}
+
+func (x *MarketSession) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *MarketSession) GetNextSession() string {
+	if x != nil {
+		return x.NextSession
+	}
+	return ""
+}
+
+func (x *MarketSession) GetNextSessionAt() int64 {
+	if x != nil {
+		return x.NextSessionAt
+	}
+	return 0
+}
+
+var File_user_market_proto protoreflect.FileDescriptor
+
+const file_user_market_proto_rawDesc = "" +
+	"\n" +
+	"\x11user/market.proto\x12\x1astock_trading.user_service\x1a\x1cgoogle/api/annotations.proto\"\x19\n" +
+	"\x17GetMarketSessionRequest\"\x87\x01\n" +
+	"\x18GetMarketSessionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12=\n" +
+	"\x04data\x18\x03 \x01(\v2).stock_trading.user_service.MarketSessionR\x04data\"\xb2\x01\n" +
+	"\rMarketSession\x12\x18\n" +
+	"\asession\x18\x01 \x01(\tR\asession\x12\x1f\n" +
+	"\vtrading_day\x18\x02 \x01(\bR\n" +
+	"tradingDay\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12!\n" +
+	"\fnext_session\x18\x04 \x01(\tR\vnextSession\x12&\n" +
+	"\x0fnext_session_at\x18\x05 \x01(\x03R\rnextSessionAt2\xaf\x01\n" +
+	"\rMarketService\x12\x9d\x01\n" +
+	"\x10GetMarketSession\x123.stock_trading.user_service.GetMarketSessionRequest\x1a4.stock_trading.user_service.GetMarketSessionResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/market/sessionB\xe3\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\vMarketProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_market_proto_rawDescOnce sync.Once
+	file_user_market_proto_rawDescData []byte
+)
+
+func file_user_market_proto_rawDescGZIP() []byte {
+	file_user_market_proto_rawDescOnce.Do(func() {
+		file_user_market_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_market_proto_rawDesc), len(file_user_market_proto_rawDesc)))
+	})
+	return file_user_market_proto_rawDescData
+}
+
+var file_user_market_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_user_market_proto_goTypes = []any{
+	(*GetMarketSessionRequest)(nil),  // 0: stock_trading.user_service.GetMarketSessionRequest
+	(*GetMarketSessionResponse)(nil), // 1: stock_trading.user_service.GetMarketSessionResponse
+	(*MarketSession)(nil),            // 2: stock_trading.user_service.MarketSession
+}
+var file_user_market_proto_depIdxs = []int32{
+	2, // 0: stock_trading.user_service.GetMarketSessionResponse.data:type_name -> stock_trading.user_service.MarketSession
+	0, // 1: stock_trading.user_service.MarketService.GetMarketSession:input_type -> stock_trading.user_service.GetMarketSessionRequest
+	1, // 2: stock_trading.user_service.MarketService.GetMarketSession:output_type -> stock_trading.user_service.GetMarketSessionResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_user_market_proto_init() }
+func file_user_market_proto_init() {
+	if File_user_market_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_market_proto_rawDesc), len(file_user_market_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_market_proto_goTypes,
+		DependencyIndexes: file_user_market_proto_depIdxs,
+		MessageInfos:      file_user_market_proto_msgTypes,
+	}.Build()
+	File_user_market_proto = out.File
+	file_user_market_proto_goTypes = nil
+	file_user_market_proto_depIdxs = nil
+}
@@ -0,0 +1,383 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/market.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetMarketSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMarketSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMarketSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMarketSessionRequestMultiError, or nil if none found.
+func (m *GetMarketSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMarketSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMarketSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMarketSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMarketSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMarketSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMarketSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMarketSessionRequestMultiError) AllErrors() []error { return m }
+
+// GetMarketSessionRequestValidationError is the validation error returned by
+// GetMarketSessionRequest.Validate if the designated constraints aren't met.
+type GetMarketSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMarketSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMarketSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMarketSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMarketSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMarketSessionRequestValidationError) ErrorName() string {
+	return "GetMarketSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMarketSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMarketSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMarketSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMarketSessionRequestValidationError{}
+
+// Validate checks the field values on GetMarketSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMarketSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMarketSessionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMarketSessionResponseMultiError, or nil if none found.
+func (m *GetMarketSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMarketSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMarketSessionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMarketSessionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMarketSessionResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMarketSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMarketSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMarketSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMarketSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMarketSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMarketSessionResponseMultiError) AllErrors() []error { return m }
+
+// GetMarketSessionResponseValidationError is the validation error returned by
+// GetMarketSessionResponse.Validate if the designated constraints aren't met.
+type GetMarketSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMarketSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMarketSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMarketSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMarketSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMarketSessionResponseValidationError) ErrorName() string {
+	return "GetMarketSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMarketSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMarketSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMarketSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMarketSessionResponseValidationError{}
+
+// Validate checks the field values on MarketSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MarketSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarketSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MarketSessionMultiError, or
+// nil if none found.
+func (m *MarketSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarketSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Session
+
+	// no validation rules for TradingDay
+
+	// no validation rules for TimeZone
+
+	// no validation rules for NextSession
+
+	// no validation rules for NextSessionAt
+
+	if len(errors) > 0 {
+		return MarketSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarketSessionMultiError is an error wrapping multiple validation errors
+// returned by MarketSession.ValidateAll() if the designated constraints
+// aren't met.
+type MarketSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarketSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarketSessionMultiError) AllErrors() []error { return m }
+
+// MarketSessionValidationError is the validation error returned by
+// MarketSession.Validate if the designated constraints aren't met.
+type MarketSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarketSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarketSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarketSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarketSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarketSessionValidationError) ErrorName() string { return "MarketSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarketSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarketSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarketSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarketSessionValidationError{}
@@ -0,0 +1,992 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/news.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NewsContent is what administrators write.
+type NewsContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// market, company, economy, analysis or announcement.
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Title    string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Summary  string `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Content  string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Publisher of the item.
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// Link to the original article.
+	Url string `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	// Related stock codes such as VNM; at most 20.
+	Codes []string `protobuf:"bytes,7,rep,name=codes,proto3" json:"codes,omitempty"`
+	// Unix time the item is dated; zero means now when publishing and keeps
+	// the current time when updating.
+	PublishedAt   int64 `protobuf:"varint,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewsContent) Reset() {
+	*x = NewsContent{}
+	mi := &file_user_news_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewsContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewsContent) ProtoMessage() {}
+
+func (x *NewsContent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewsContent.ProtoReflect.Descriptor instead.
+func (*NewsContent) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NewsContent) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NewsContent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NewsContent) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *NewsContent) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NewsContent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *NewsContent) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NewsContent) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *NewsContent) GetPublishedAt() int64 {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return 0
+}
+
+type PublishNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          *NewsContent           `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishNewsRequest) Reset() {
+	*x = PublishNewsRequest{}
+	mi := &file_user_news_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishNewsRequest) ProtoMessage() {}
+
+func (x *PublishNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishNewsRequest.ProtoReflect.Descriptor instead.
+func (*PublishNewsRequest) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PublishNewsRequest) GetNews() *NewsContent {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+type PublishNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *News                  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishNewsResponse) Reset() {
+	*x = PublishNewsResponse{}
+	mi := &file_user_news_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishNewsResponse) ProtoMessage() {}
+
+func (x *PublishNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishNewsResponse.ProtoReflect.Descriptor instead.
+func (*PublishNewsResponse) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PublishNewsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PublishNewsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PublishNewsResponse) GetData() *News {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewsId        int64                  `protobuf:"varint,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	News          *NewsContent           `protobuf:"bytes,2,opt,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNewsRequest) Reset() {
+	*x = UpdateNewsRequest{}
+	mi := &file_user_news_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNewsRequest) ProtoMessage() {}
+
+func (x *UpdateNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNewsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNewsRequest) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateNewsRequest) GetNewsId() int64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+func (x *UpdateNewsRequest) GetNews() *NewsContent {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+type UpdateNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *News                  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNewsResponse) Reset() {
+	*x = UpdateNewsResponse{}
+	mi := &file_user_news_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNewsResponse) ProtoMessage() {}
+
+func (x *UpdateNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNewsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNewsResponse) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateNewsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateNewsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateNewsResponse) GetData() *News {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UnpublishNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewsId        int64                  `protobuf:"varint,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishNewsRequest) Reset() {
+	*x = UnpublishNewsRequest{}
+	mi := &file_user_news_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishNewsRequest) ProtoMessage() {}
+
+func (x *UnpublishNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishNewsRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishNewsRequest) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnpublishNewsRequest) GetNewsId() int64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+type UnpublishNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *News                  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishNewsResponse) Reset() {
+	*x = UnpublishNewsResponse{}
+	mi := &file_user_news_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishNewsResponse) ProtoMessage() {}
+
+func (x *UnpublishNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishNewsResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishNewsResponse) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnpublishNewsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UnpublishNewsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnpublishNewsResponse) GetData() *News {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListNewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only items of this category; empty lists every category.
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Only items related to this stock code.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Search text. Every word must start a word of the title or summary;
+	// case and Vietnamese diacritics are ignored.
+	Query    string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response; the filters must be unchanged.
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNewsRequest) Reset() {
+	*x = ListNewsRequest{}
+	mi := &file_user_news_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNewsRequest) ProtoMessage() {}
+
+func (x *ListNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNewsRequest.ProtoReflect.Descriptor instead.
+func (*ListNewsRequest) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListNewsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListNewsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListNewsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListNewsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListNewsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    []*News                `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Token for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNewsResponse) Reset() {
+	*x = ListNewsResponse{}
+	mi := &file_user_news_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNewsResponse) ProtoMessage() {}
+
+func (x *ListNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNewsResponse.ProtoReflect.Descriptor instead.
+func (*ListNewsResponse) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListNewsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListNewsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListNewsResponse) GetData() []*News {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListNewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewsId        int64                  `protobuf:"varint,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsRequest) Reset() {
+	*x = GetNewsRequest{}
+	mi := &file_user_news_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsRequest) ProtoMessage() {}
+
+func (x *GetNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsRequest.ProtoReflect.Descriptor instead.
+func (*GetNewsRequest) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetNewsRequest) GetNewsId() int64 {
+	if x != nil {
+		return x.NewsId
+	}
+	return 0
+}
+
+type GetNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *News                  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsResponse) Reset() {
+	*x = GetNewsResponse{}
+	mi := &file_user_news_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsResponse) ProtoMessage() {}
+
+func (x *GetNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsResponse.ProtoReflect.Descriptor instead.
+func (*GetNewsResponse) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetNewsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetNewsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetNewsResponse) GetData() *News {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type News struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Summary  string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Content  string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Source   string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	Url      string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	Codes    []string               `protobuf:"bytes,8,rep,name=codes,proto3" json:"codes,omitempty"`
+	// published or unpublished.
+	Status        string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	PublishedAt   int64  `protobuf:"varint,10,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *News) Reset() {
+	*x = News{}
+	mi := &file_user_news_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *News) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*News) ProtoMessage() {}
+
+func (x *News) ProtoReflect() protoreflect.Message {
+	mi := &file_user_news_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use News.ProtoReflect.Descriptor instead.
+func (*News) Descriptor() ([]byte, []int) {
+	return file_user_news_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *News) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *News) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *News) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *News) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *News) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *News) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *News) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *News) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *News) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *News) GetPublishedAt() int64 {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return 0
+}
+
+func (x *News) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *News) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+var File_user_news_proto protoreflect.FileDescriptor
+
+const file_user_news_proto_rawDesc = "" +
+	"\n" +
+	"\x0fuser/news.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xd7\x02\n" +
+	"\vNewsContent\x12S\n" +
+	"\bcategory\x18\x01 \x01(\tB7\xfaB4r2R\x06marketR\acompanyR\aeconomyR\banalysisR\fannouncementR\bcategory\x12 \n" +
+	"\x05title\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05title\x12\"\n" +
+	"\asummary\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\asummary\x12#\n" +
+	"\acontent\x18\x04 \x01(\tB\t\xfaB\x06r\x04\x18\xa0\x8d\x06R\acontent\x12 \n" +
+	"\x06source\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06source\x12\x1a\n" +
+	"\x03url\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x10R\x03url\x12\x1e\n" +
+	"\x05codes\x18\a \x03(\tB\b\xfaB\x05\x92\x01\x02\x10\x14R\x05codes\x12*\n" +
+	"\fpublished_at\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vpublishedAt\"[\n" +
+	"\x12PublishNewsRequest\x12E\n" +
+	"\x04news\x18\x01 \x01(\v2'.stock_trading.user_service.NewsContentB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04news\"y\n" +
+	"\x13PublishNewsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\x04data\x18\x03 \x01(\v2 .stock_trading.user_service.NewsR\x04data\"|\n" +
+	"\x11UpdateNewsRequest\x12 \n" +
+	"\anews_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06newsId\x12E\n" +
+	"\x04news\x18\x02 \x01(\v2'.stock_trading.user_service.NewsContentB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04news\"x\n" +
+	"\x12UpdateNewsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\x04data\x18\x03 \x01(\v2 .stock_trading.user_service.NewsR\x04data\"8\n" +
+	"\x14UnpublishNewsRequest\x12 \n" +
+	"\anews_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06newsId\"{\n" +
+	"\x15UnpublishNewsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\x04data\x18\x03 \x01(\v2 .stock_trading.user_service.NewsR\x04data\"\xe1\x01\n" +
+	"\x0fListNewsRequest\x12U\n" +
+	"\bcategory\x18\x01 \x01(\tB9\xfaB6r4R\x00R\x06marketR\acompanyR\aeconomyR\banalysisR\fannouncementR\bcategory\x12\x1b\n" +
+	"\x04code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18\n" +
+	"R\x04code\x12\x1e\n" +
+	"\x05query\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\x9e\x01\n" +
+	"\x10ListNewsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\x04data\x18\x03 \x03(\v2 .stock_trading.user_service.NewsR\x04data\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"2\n" +
+	"\x0eGetNewsRequest\x12 \n" +
+	"\anews_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06newsId\"u\n" +
+	"\x0fGetNewsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x124\n" +
+	"\x04data\x18\x03 \x01(\v2 .stock_trading.user_service.NewsR\x04data\"\xb5\x02\n" +
+	"\x04News\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12\x14\n" +
+	"\x05codes\x18\b \x03(\tR\x05codes\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12!\n" +
+	"\fpublished_at\x18\n" +
+	" \x01(\x03R\vpublishedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt2\xe0\x05\n" +
+	"\vNewsService\x12\x8d\x01\n" +
+	"\vPublishNews\x12..stock_trading.user_service.PublishNewsRequest\x1a/.stock_trading.user_service.PublishNewsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/admin/news\x12\x94\x01\n" +
+	"\n" +
+	"UpdateNews\x12-.stock_trading.user_service.UpdateNewsRequest\x1a..stock_trading.user_service.UpdateNewsResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/admin/news/{news_id}\x12\xa7\x01\n" +
+	"\rUnpublishNews\x120.stock_trading.user_service.UnpublishNewsRequest\x1a1.stock_trading.user_service.UnpublishNewsResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/admin/news/{news_id}/unpublish\x12{\n" +
+	"\bListNews\x12+.stock_trading.user_service.ListNewsRequest\x1a,.stock_trading.user_service.ListNewsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/news\x12\x82\x01\n" +
+	"\aGetNews\x12*.stock_trading.user_service.GetNewsRequest\x1a+.stock_trading.user_service.GetNewsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/news/{news_id}B\xe1\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\tNewsProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_news_proto_rawDescOnce sync.Once
+	file_user_news_proto_rawDescData []byte
+)
+
+func file_user_news_proto_rawDescGZIP() []byte {
+	file_user_news_proto_rawDescOnce.Do(func() {
+		file_user_news_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_news_proto_rawDesc), len(file_user_news_proto_rawDesc)))
+	})
+	return file_user_news_proto_rawDescData
+}
+
+var file_user_news_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_user_news_proto_goTypes = []any{
+	(*NewsContent)(nil),           // 0: stock_trading.user_service.NewsContent
+	(*PublishNewsRequest)(nil),    // 1: stock_trading.user_service.PublishNewsRequest
+	(*PublishNewsResponse)(nil),   // 2: stock_trading.user_service.PublishNewsResponse
+	(*UpdateNewsRequest)(nil),     // 3: stock_trading.user_service.UpdateNewsRequest
+	(*UpdateNewsResponse)(nil),    // 4: stock_trading.user_service.UpdateNewsResponse
+	(*UnpublishNewsRequest)(nil),  // 5: stock_trading.user_service.UnpublishNewsRequest
+	(*UnpublishNewsResponse)(nil), // 6: stock_trading.user_service.UnpublishNewsResponse
+	(*ListNewsRequest)(nil),       // 7: stock_trading.user_service.ListNewsRequest
+	(*ListNewsResponse)(nil),      // 8: stock_trading.user_service.ListNewsResponse
+	(*GetNewsRequest)(nil),        // 9: stock_trading.user_service.GetNewsRequest
+	(*GetNewsResponse)(nil),       // 10: stock_trading.user_service.GetNewsResponse
+	(*News)(nil),                  // 11: stock_trading.user_service.News
+}
+var file_user_news_proto_depIdxs = []int32{
+	0,  // 0: stock_trading.user_service.PublishNewsRequest.news:type_name -> stock_trading.user_service.NewsContent
+	11, // 1: stock_trading.user_service.PublishNewsResponse.data:type_name -> stock_trading.user_service.News
+	0,  // 2: stock_trading.user_service.UpdateNewsRequest.news:type_name -> stock_trading.user_service.NewsContent
+	11, // 3: stock_trading.user_service.UpdateNewsResponse.data:type_name -> stock_trading.user_service.News
+	11, // 4: stock_trading.user_service.UnpublishNewsResponse.data:type_name -> stock_trading.user_service.News
+	11, // 5: stock_trading.user_service.ListNewsResponse.data:type_name -> stock_trading.user_service.News
+	11, // 6: stock_trading.user_service.GetNewsResponse.data:type_name -> stock_trading.user_service.News
+	1,  // 7: stock_trading.user_service.NewsService.PublishNews:input_type -> stock_trading.user_service.PublishNewsRequest
+	3,  // 8: stock_trading.user_service.NewsService.UpdateNews:input_type -> stock_trading.user_service.UpdateNewsRequest
+	5,  // 9: stock_trading.user_service.NewsService.UnpublishNews:input_type -> stock_trading.user_service.UnpublishNewsRequest
+	7,  // 10: stock_trading.user_service.NewsService.ListNews:input_type -> stock_trading.user_service.ListNewsRequest
+	9,  // 11: stock_trading.user_service.NewsService.GetNews:input_type -> stock_trading.user_service.GetNewsRequest
+	2,  // 12: stock_trading.user_service.NewsService.PublishNews:output_type -> stock_trading.user_service.PublishNewsResponse
+	4,  // 13: stock_trading.user_service.NewsService.UpdateNews:output_type -> stock_trading.user_service.UpdateNewsResponse
+	6,  // 14: stock_trading.user_service.NewsService.UnpublishNews:output_type -> stock_trading.user_service.UnpublishNewsResponse
+	8,  // 15: stock_trading.user_service.NewsService.ListNews:output_type -> stock_trading.user_service.ListNewsResponse
+	10, // 16: stock_trading.user_service.NewsService.GetNews:output_type -> stock_trading.user_service.GetNewsResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_user_news_proto_init() }
+func file_user_news_proto_init() {
+	if File_user_news_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_news_proto_rawDesc), len(file_user_news_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_news_proto_goTypes,
+		DependencyIndexes: file_user_news_proto_depIdxs,
+		MessageInfos:      file_user_news_proto_msgTypes,
+	}.Build()
+	File_user_news_proto = out.File
+	file_user_news_proto_goTypes = nil
+	file_user_news_proto_depIdxs = nil
+}
@@ -0,0 +1,1694 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/news.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on NewsContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NewsContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NewsContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NewsContentMultiError, or
+// nil if none found.
+func (m *NewsContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NewsContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _NewsContent_Category_InLookup[m.GetCategory()]; !ok {
+		err := NewsContentValidationError{
+			field:  "Category",
+			reason: "value must be in list [market company economy analysis announcement]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
+		err := NewsContentValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSummary()) > 2000 {
+		err := NewsContentValidationError{
+			field:  "Summary",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) > 100000 {
+		err := NewsContentValidationError{
+			field:  "Content",
+			reason: "value length must be at most 100000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSource()) > 255 {
+		err := NewsContentValidationError{
+			field:  "Source",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := NewsContentValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCodes()) > 20 {
+		err := NewsContentValidationError{
+			field:  "Codes",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPublishedAt() < 0 {
+		err := NewsContentValidationError{
+			field:  "PublishedAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NewsContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// NewsContentMultiError is an error wrapping multiple validation errors
+// returned by NewsContent.ValidateAll() if the designated constraints aren't met.
+type NewsContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NewsContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NewsContentMultiError) AllErrors() []error { return m }
+
+// NewsContentValidationError is the validation error returned by
+// NewsContent.Validate if the designated constraints aren't met.
+type NewsContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewsContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewsContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewsContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewsContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewsContentValidationError) ErrorName() string { return "NewsContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NewsContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNewsContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewsContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewsContentValidationError{}
+
+var _NewsContent_Category_InLookup = map[string]struct{}{
+	"market":       {},
+	"company":      {},
+	"economy":      {},
+	"analysis":     {},
+	"announcement": {},
+}
+
+// Validate checks the field values on PublishNewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishNewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishNewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishNewsRequestMultiError, or nil if none found.
+func (m *PublishNewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishNewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNews() == nil {
+		err := PublishNewsRequestValidationError{
+			field:  "News",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetNews()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublishNewsRequestValidationError{
+					field:  "News",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublishNewsRequestValidationError{
+					field:  "News",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNews()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublishNewsRequestValidationError{
+				field:  "News",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PublishNewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishNewsRequestMultiError is an error wrapping multiple validation errors
+// returned by PublishNewsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PublishNewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishNewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishNewsRequestMultiError) AllErrors() []error { return m }
+
+// PublishNewsRequestValidationError is the validation error returned by
+// PublishNewsRequest.Validate if the designated constraints aren't met.
+type PublishNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishNewsRequestValidationError) ErrorName() string {
+	return "PublishNewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishNewsRequestValidationError{}
+
+// Validate checks the field values on PublishNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishNewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishNewsResponseMultiError, or nil if none found.
+func (m *PublishNewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishNewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublishNewsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublishNewsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublishNewsResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PublishNewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishNewsResponseMultiError is an error wrapping multiple validation
+// errors returned by PublishNewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PublishNewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishNewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishNewsResponseMultiError) AllErrors() []error { return m }
+
+// PublishNewsResponseValidationError is the validation error returned by
+// PublishNewsResponse.Validate if the designated constraints aren't met.
+type PublishNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishNewsResponseValidationError) ErrorName() string {
+	return "PublishNewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishNewsResponseValidationError{}
+
+// Validate checks the field values on UpdateNewsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNewsRequestMultiError, or nil if none found.
+func (m *UpdateNewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNewsId() <= 0 {
+		err := UpdateNewsRequestValidationError{
+			field:  "NewsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetNews() == nil {
+		err := UpdateNewsRequestValidationError{
+			field:  "News",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetNews()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNewsRequestValidationError{
+					field:  "News",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNewsRequestValidationError{
+					field:  "News",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNews()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNewsRequestValidationError{
+				field:  "News",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateNewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNewsRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateNewsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateNewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNewsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateNewsRequestValidationError is the validation error returned by
+// UpdateNewsRequest.Validate if the designated constraints aren't met.
+type UpdateNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNewsRequestValidationError) ErrorName() string {
+	return "UpdateNewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNewsRequestValidationError{}
+
+// Validate checks the field values on UpdateNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNewsResponseMultiError, or nil if none found.
+func (m *UpdateNewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNewsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNewsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNewsResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateNewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNewsResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateNewsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateNewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNewsResponseMultiError) AllErrors() []error { return m }
+
+// UpdateNewsResponseValidationError is the validation error returned by
+// UpdateNewsResponse.Validate if the designated constraints aren't met.
+type UpdateNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNewsResponseValidationError) ErrorName() string {
+	return "UpdateNewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNewsResponseValidationError{}
+
+// Validate checks the field values on UnpublishNewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpublishNewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpublishNewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpublishNewsRequestMultiError, or nil if none found.
+func (m *UnpublishNewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpublishNewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNewsId() <= 0 {
+		err := UnpublishNewsRequestValidationError{
+			field:  "NewsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnpublishNewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpublishNewsRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpublishNewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnpublishNewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpublishNewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpublishNewsRequestMultiError) AllErrors() []error { return m }
+
+// UnpublishNewsRequestValidationError is the validation error returned by
+// UnpublishNewsRequest.Validate if the designated constraints aren't met.
+type UnpublishNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpublishNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpublishNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpublishNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpublishNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpublishNewsRequestValidationError) ErrorName() string {
+	return "UnpublishNewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpublishNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpublishNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpublishNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpublishNewsRequestValidationError{}
+
+// Validate checks the field values on UnpublishNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpublishNewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpublishNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpublishNewsResponseMultiError, or nil if none found.
+func (m *UnpublishNewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpublishNewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnpublishNewsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnpublishNewsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnpublishNewsResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UnpublishNewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpublishNewsResponseMultiError is an error wrapping multiple validation
+// errors returned by UnpublishNewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnpublishNewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpublishNewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpublishNewsResponseMultiError) AllErrors() []error { return m }
+
+// UnpublishNewsResponseValidationError is the validation error returned by
+// UnpublishNewsResponse.Validate if the designated constraints aren't met.
+type UnpublishNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpublishNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpublishNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpublishNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpublishNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpublishNewsResponseValidationError) ErrorName() string {
+	return "UnpublishNewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpublishNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpublishNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpublishNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpublishNewsResponseValidationError{}
+
+// Validate checks the field values on ListNewsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListNewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNewsRequestMultiError, or nil if none found.
+func (m *ListNewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListNewsRequest_Category_InLookup[m.GetCategory()]; !ok {
+		err := ListNewsRequestValidationError{
+			field:  "Category",
+			reason: "value must be in list [ market company economy analysis announcement]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) > 10 {
+		err := ListNewsRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at most 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) > 200 {
+		err := ListNewsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListNewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNewsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListNewsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListNewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNewsRequestMultiError) AllErrors() []error { return m }
+
+// ListNewsRequestValidationError is the validation error returned by
+// ListNewsRequest.Validate if the designated constraints aren't met.
+type ListNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNewsRequestValidationError) ErrorName() string { return "ListNewsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNewsRequestValidationError{}
+
+var _ListNewsRequest_Category_InLookup = map[string]struct{}{
+	"":             {},
+	"market":       {},
+	"company":      {},
+	"economy":      {},
+	"analysis":     {},
+	"announcement": {},
+}
+
+// Validate checks the field values on ListNewsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListNewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNewsResponseMultiError, or nil if none found.
+func (m *ListNewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNewsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNewsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNewsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListNewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNewsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListNewsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListNewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNewsResponseMultiError) AllErrors() []error { return m }
+
+// ListNewsResponseValidationError is the validation error returned by
+// ListNewsResponse.Validate if the designated constraints aren't met.
+type ListNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNewsResponseValidationError) ErrorName() string { return "ListNewsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNewsResponseValidationError{}
+
+// Validate checks the field values on GetNewsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetNewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNewsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetNewsRequestMultiError,
+// or nil if none found.
+func (m *GetNewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNewsId() <= 0 {
+		err := GetNewsRequestValidationError{
+			field:  "NewsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetNewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNewsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetNewsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetNewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNewsRequestMultiError) AllErrors() []error { return m }
+
+// GetNewsRequestValidationError is the validation error returned by
+// GetNewsRequest.Validate if the designated constraints aren't met.
+type GetNewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNewsRequestValidationError) ErrorName() string { return "GetNewsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetNewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNewsRequestValidationError{}
+
+// Validate checks the field values on GetNewsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetNewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNewsResponseMultiError, or nil if none found.
+func (m *GetNewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNewsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNewsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNewsResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNewsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetNewsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetNewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNewsResponseMultiError) AllErrors() []error { return m }
+
+// GetNewsResponseValidationError is the validation error returned by
+// GetNewsResponse.Validate if the designated constraints aren't met.
+type GetNewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNewsResponseValidationError) ErrorName() string { return "GetNewsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetNewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNewsResponseValidationError{}
+
+// Validate checks the field values on News with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *News) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on News with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NewsMultiError, or nil if none found.
+func (m *News) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *News) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Category
+
+	// no validation rules for Title
+
+	// no validation rules for Summary
+
+	// no validation rules for Content
+
+	// no validation rules for Source
+
+	// no validation rules for Url
+
+	// no validation rules for Status
+
+	// no validation rules for PublishedAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return NewsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NewsMultiError is an error wrapping multiple validation errors returned by
+// News.ValidateAll() if the designated constraints aren't met.
+type NewsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NewsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NewsMultiError) AllErrors() []error { return m }
+
+// NewsValidationError is the validation error returned by News.Validate if the
+// designated constraints aren't met.
+type NewsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NewsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NewsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NewsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NewsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NewsValidationError) ErrorName() string { return "NewsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NewsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNews.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NewsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NewsValidationError{}
@@ -0,0 +1,1394 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/order.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlaceOrderRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Stock code such as VNM.
+	Code        string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Side        string `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	TimeInForce string `protobuf:"bytes,4,opt,name=time_in_force,json=timeInForce,proto3" json:"time_in_force,omitempty"`
+	// Limit price in VND per share.
+	Price         int64 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64 `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	mi := &file_user_order_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlaceOrderRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetTimeInForce() string {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PlaceOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderResponse) Reset() {
+	*x = PlaceOrderResponse{}
+	mi := &file_user_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderResponse) ProtoMessage() {}
+
+func (x *PlaceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderResponse.ProtoReflect.Descriptor instead.
+func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlaceOrderResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PlaceOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlaceOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Only orders in one of these statuses; empty lists every order. Repeat
+	// the query parameter for several: ?status=new&status=partially_filled.
+	Status   []string `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty"`
+	PageSize uint32   `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response; the filter must be unchanged.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_user_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListOrdersRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    []*Order               `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Token for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_user_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOrdersResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListOrdersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListOrdersResponse) GetData() []*Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_user_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetOrderRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type GetOrderResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// The changes of the order, oldest first.
+	Events        []*OrderEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_user_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetOrderResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetEvents() []*OrderEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AmendOrderRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	OrderId  int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// New limit price; zero keeps the current price.
+	Price int64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// New total quantity, filled shares included; zero keeps the current
+	// quantity. It must exceed the filled quantity.
+	Quantity      int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmendOrderRequest) Reset() {
+	*x = AmendOrderRequest{}
+	mi := &file_user_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendOrderRequest) ProtoMessage() {}
+
+func (x *AmendOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendOrderRequest.ProtoReflect.Descriptor instead.
+func (*AmendOrderRequest) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AmendOrderRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AmendOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AmendOrderRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AmendOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AmendOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmendOrderResponse) Reset() {
+	*x = AmendOrderResponse{}
+	mi := &file_user_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendOrderResponse) ProtoMessage() {}
+
+func (x *AmendOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendOrderResponse.ProtoReflect.Descriptor instead.
+func (*AmendOrderResponse) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AmendOrderResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AmendOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AmendOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_user_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelOrderRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_user_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelOrderResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CancelOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RecordOrderFillRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Id of the execution at the market; an order records it once.
+	ExecutionId string `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// Fill price in VND per share.
+	Price    int64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Unix time of the execution; zero means now.
+	ExecutedAt    int64 `protobuf:"varint,5,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordOrderFillRequest) Reset() {
+	*x = RecordOrderFillRequest{}
+	mi := &file_user_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordOrderFillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordOrderFillRequest) ProtoMessage() {}
+
+func (x *RecordOrderFillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordOrderFillRequest.ProtoReflect.Descriptor instead.
+func (*RecordOrderFillRequest) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RecordOrderFillRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RecordOrderFillRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *RecordOrderFillRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RecordOrderFillRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RecordOrderFillRequest) GetExecutedAt() int64 {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return 0
+}
+
+type RecordOrderFillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordOrderFillResponse) Reset() {
+	*x = RecordOrderFillResponse{}
+	mi := &file_user_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordOrderFillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordOrderFillResponse) ProtoMessage() {}
+
+func (x *RecordOrderFillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordOrderFillResponse.ProtoReflect.Descriptor instead.
+func (*RecordOrderFillResponse) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RecordOrderFillResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RecordOrderFillResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RecordOrderFillResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RejectOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectOrderRequest) Reset() {
+	*x = RejectOrderRequest{}
+	mi := &file_user_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectOrderRequest) ProtoMessage() {}
+
+func (x *RejectOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectOrderRequest.ProtoReflect.Descriptor instead.
+func (*RejectOrderRequest) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RejectOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RejectOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectOrderResponse) Reset() {
+	*x = RejectOrderResponse{}
+	mi := &file_user_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectOrderResponse) ProtoMessage() {}
+
+func (x *RejectOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectOrderResponse.ProtoReflect.Descriptor instead.
+func (*RejectOrderResponse) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RejectOrderResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RejectOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RejectOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Order struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code  string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// buy or sell.
+	Side string `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	// GTC or DAY; IOC and FOK are not supported.
+	TimeInForce    string `protobuf:"bytes,4,opt,name=time_in_force,json=timeInForce,proto3" json:"time_in_force,omitempty"`
+	Price          int64  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity       int64  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FilledQuantity int64  `protobuf:"varint,7,opt,name=filled_quantity,json=filledQuantity,proto3" json:"filled_quantity,omitempty"`
+	// Average fill price in VND, rounded down; zero before the first fill.
+	AveragePrice int64 `protobuf:"varint,8,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	// new, partially_filled, filled, cancelled, rejected or expired.
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// Why the order was cancelled, rejected or expired.
+	Reason string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Unix time the order took its place in the queue.
+	PriorityAt int64 `protobuf:"varint,11,opt,name=priority_at,json=priorityAt,proto3" json:"priority_at,omitempty"`
+	CreatedAt  int64 `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  int64 `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Commission and tax charged on the fills so far, in VND.
+	Fees          int64 `protobuf:"varint,14,opt,name=fees,proto3" json:"fees,omitempty"`
+	Taxes         int64 `protobuf:"varint,15,opt,name=taxes,proto3" json:"taxes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_user_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Order) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Order) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *Order) GetTimeInForce() string {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return ""
+}
+
+func (x *Order) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Order) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetFilledQuantity() int64 {
+	if x != nil {
+		return x.FilledQuantity
+	}
+	return 0
+}
+
+func (x *Order) GetAveragePrice() int64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Order) GetPriorityAt() int64 {
+	if x != nil {
+		return x.PriorityAt
+	}
+	return 0
+}
+
+func (x *Order) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetFees() int64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *Order) GetTaxes() int64 {
+	if x != nil {
+		return x.Taxes
+	}
+	return 0
+}
+
+type OrderEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// placed, amended, filled, cancelled, rejected or expired.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Status of the order after the change.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Price and quantity of the order after a placement or amendment, of the
+	// fill for fills.
+	Price     int64  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity  int64  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason    string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Commission and tax charged on a fill, in VND.
+	Fee           int64 `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	Tax           int64 `protobuf:"varint,8,opt,name=tax,proto3" json:"tax,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_user_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_user_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OrderEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetTax() int64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+var File_user_order_proto protoreflect.FileDescriptor
+
+const file_user_order_proto_rawDesc = "" +
+	"\n" +
+	"\x10user/order.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\x84\x02\n" +
+	"\x11PlaceOrderRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1d\n" +
+	"\x04code\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\n" +
+	"R\x04code\x12$\n" +
+	"\x04side\x18\x03 \x01(\tB\x10\xfaB\rr\vR\x03buyR\x04sellR\x04side\x12?\n" +
+	"\rtime_in_force\x18\x04 \x01(\tB\x1b\xfaB\x18r\x16R\x00R\x03GTCR\x03DAYR\x03IOCR\x03FOKR\vtimeInForce\x12\x1d\n" +
+	"\x05price\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05price\x12#\n" +
+	"\bquantity\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bquantity\"y\n" +
+	"\x12PlaceOrderResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.stock_trading.user_service.OrderR\x04data\"\xd9\x01\n" +
+	"\x11ListOrdersRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12a\n" +
+	"\x06status\x18\x02 \x03(\tBI\xfaBF\x92\x01C\x10\x06\"?r=R\x03newR\x10partially_filledR\x06filledR\tcancelledR\brejectedR\aexpiredR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xa1\x01\n" +
+	"\x12ListOrdersResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x03(\v2!.stock_trading.user_service.OrderR\x04data\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\\\n" +
+	"\x0fGetOrderRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\"\n" +
+	"\border_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\"\xb7\x01\n" +
+	"\x10GetOrderResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.stock_trading.user_service.OrderR\x04data\x12>\n" +
+	"\x06events\x18\x04 \x03(\v2&.stock_trading.user_service.OrderEventR\x06events\"\xa2\x01\n" +
+	"\x11AmendOrderRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\"\n" +
+	"\border_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12\x1d\n" +
+	"\x05price\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x05price\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bquantity\"y\n" +
+	"\x12AmendOrderResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.stock_trading.user_service.OrderR\x04data\"_\n" +
+	"\x12CancelOrderRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\"\n" +
+	"\border_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\"z\n" +
+	"\x13CancelOrderResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.stock_trading.user_service.OrderR\x04data\"\xd8\x01\n" +
+	"\x16RecordOrderFillRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12,\n" +
+	"\fexecution_id\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\vexecutionId\x12\x1d\n" +
+	"\x05price\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05price\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bquantity\x12(\n" +
+	"\vexecuted_at\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"executedAt\"~\n" +
+	"\x17RecordOrderFillResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.stock_trading.user_service.OrderR\x04data\"\\\n" +
+	"\x12RejectOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aorderId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x06reason\"z\n" +
+	"\x13RejectOrderResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.stock_trading.user_service.OrderR\x04data\"\x9c\x03\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\"\n" +
+	"\rtime_in_force\x18\x04 \x01(\tR\vtimeInForce\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x03R\bquantity\x12'\n" +
+	"\x0ffilled_quantity\x18\a \x01(\x03R\x0efilledQuantity\x12#\n" +
+	"\raverage_price\x18\b \x01(\x03R\faveragePrice\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x1f\n" +
+	"\vpriority_at\x18\v \x01(\x03R\n" +
+	"priorityAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\x03R\tupdatedAt\x12\x12\n" +
+	"\x04fees\x18\x0e \x01(\x03R\x04fees\x12\x14\n" +
+	"\x05taxes\x18\x0f \x01(\x03R\x05taxes\"\xc5\x01\n" +
+	"\n" +
+	"OrderEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x10\n" +
+	"\x03fee\x18\a \x01(\x03R\x03fee\x12\x10\n" +
+	"\x03tax\x18\b \x01(\x03R\x03tax2\xfd\b\n" +
+	"\fOrderService\x12\x96\x01\n" +
+	"\n" +
+	"PlaceOrder\x12-.stock_trading.user_service.PlaceOrderRequest\x1a..stock_trading.user_service.PlaceOrderResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/user/{username}/orders\x12\x93\x01\n" +
+	"\n" +
+	"ListOrders\x12-.stock_trading.user_service.ListOrdersRequest\x1a..stock_trading.user_service.ListOrdersResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/user/{username}/orders\x12\x98\x01\n" +
+	"\bGetOrder\x12+.stock_trading.user_service.GetOrderRequest\x1a,.stock_trading.user_service.GetOrderResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/user/{username}/orders/{order_id}\x12\xa1\x01\n" +
+	"\n" +
+	"AmendOrder\x12-.stock_trading.user_service.AmendOrderRequest\x1a..stock_trading.user_service.AmendOrderResponse\"4\x82\xd3\xe4\x93\x02.:\x01*2)/api/v1/user/{username}/orders/{order_id}\x12\xab\x01\n" +
+	"\vCancelOrder\x12..stock_trading.user_service.CancelOrderRequest\x1a/.stock_trading.user_service.CancelOrderResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/user/{username}/orders/{order_id}/cancel\x12\xac\x01\n" +
+	"\x0fRecordOrderFill\x122.stock_trading.user_service.RecordOrderFillRequest\x1a3.stock_trading.user_service.RecordOrderFillResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/admin/orders/{order_id}/fills\x12\xa1\x01\n" +
+	"\vRejectOrder\x12..stock_trading.user_service.RejectOrderRequest\x1a/.stock_trading.user_service.RejectOrderResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/admin/orders/{order_id}/rejectB\xe2\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\n" +
+	"OrderProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_order_proto_rawDescOnce sync.Once
+	file_user_order_proto_rawDescData []byte
+)
+
+func file_user_order_proto_rawDescGZIP() []byte {
+	file_user_order_proto_rawDescOnce.Do(func() {
+		file_user_order_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_order_proto_rawDesc), len(file_user_order_proto_rawDesc)))
+	})
+	return file_user_order_proto_rawDescData
+}
+
+var file_user_order_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_user_order_proto_goTypes = []any{
+	(*PlaceOrderRequest)(nil),       // 0: stock_trading.user_service.PlaceOrderRequest
+	(*PlaceOrderResponse)(nil),      // 1: stock_trading.user_service.PlaceOrderResponse
+	(*ListOrdersRequest)(nil),       // 2: stock_trading.user_service.ListOrdersRequest
+	(*ListOrdersResponse)(nil),      // 3: stock_trading.user_service.ListOrdersResponse
+	(*GetOrderRequest)(nil),         // 4: stock_trading.user_service.GetOrderRequest
+	(*GetOrderResponse)(nil),        // 5: stock_trading.user_service.GetOrderResponse
+	(*AmendOrderRequest)(nil),       // 6: stock_trading.user_service.AmendOrderRequest
+	(*AmendOrderResponse)(nil),      // 7: stock_trading.user_service.AmendOrderResponse
+	(*CancelOrderRequest)(nil),      // 8: stock_trading.user_service.CancelOrderRequest
+	(*CancelOrderResponse)(nil),     // 9: stock_trading.user_service.CancelOrderResponse
+	(*RecordOrderFillRequest)(nil),  // 10: stock_trading.user_service.RecordOrderFillRequest
+	(*RecordOrderFillResponse)(nil), // 11: stock_trading.user_service.RecordOrderFillResponse
+	(*RejectOrderRequest)(nil),      // 12: stock_trading.user_service.RejectOrderRequest
+	(*RejectOrderResponse)(nil),     // 13: stock_trading.user_service.RejectOrderResponse
+	(*Order)(nil),                   // 14: stock_trading.user_service.Order
+	(*OrderEvent)(nil),              // 15: stock_trading.user_service.OrderEvent
+}
+var file_user_order_proto_depIdxs = []int32{
+	14, // 0: stock_trading.user_service.PlaceOrderResponse.data:type_name -> stock_trading.user_service.Order
+	14, // 1: stock_trading.user_service.ListOrdersResponse.data:type_name -> stock_trading.user_service.Order
+	14, // 2: stock_trading.user_service.GetOrderResponse.data:type_name -> stock_trading.user_service.Order
+	15, // 3: stock_trading.user_service.GetOrderResponse.events:type_name -> stock_trading.user_service.OrderEvent
+	14, // 4: stock_trading.user_service.AmendOrderResponse.data:type_name -> stock_trading.user_service.Order
+	14, // 5: stock_trading.user_service.CancelOrderResponse.data:type_name -> stock_trading.user_service.Order
+	14, // 6: stock_trading.user_service.RecordOrderFillResponse.data:type_name -> stock_trading.user_service.Order
+	14, // 7: stock_trading.user_service.RejectOrderResponse.data:type_name -> stock_trading.user_service.Order
+	0,  // 8: stock_trading.user_service.OrderService.PlaceOrder:input_type -> stock_trading.user_service.PlaceOrderRequest
+	2,  // 9: stock_trading.user_service.OrderService.ListOrders:input_type -> stock_trading.user_service.ListOrdersRequest
+	4,  // 10: stock_trading.user_service.OrderService.GetOrder:input_type -> stock_trading.user_service.GetOrderRequest
+	6,  // 11: stock_trading.user_service.OrderService.AmendOrder:input_type -> stock_trading.user_service.AmendOrderRequest
+	8,  // 12: stock_trading.user_service.OrderService.CancelOrder:input_type -> stock_trading.user_service.CancelOrderRequest
+	10, // 13: stock_trading.user_service.OrderService.RecordOrderFill:input_type -> stock_trading.user_service.RecordOrderFillRequest
+	12, // 14: stock_trading.user_service.OrderService.RejectOrder:input_type -> stock_trading.user_service.RejectOrderRequest
+	1,  // 15: stock_trading.user_service.OrderService.PlaceOrder:output_type -> stock_trading.user_service.PlaceOrderResponse
+	3,  // 16: stock_trading.user_service.OrderService.ListOrders:output_type -> stock_trading.user_service.ListOrdersResponse
+	5,  // 17: stock_trading.user_service.OrderService.GetOrder:output_type -> stock_trading.user_service.GetOrderResponse
+	7,  // 18: stock_trading.user_service.OrderService.AmendOrder:output_type -> stock_trading.user_service.AmendOrderResponse
+	9,  // 19: stock_trading.user_service.OrderService.CancelOrder:output_type -> stock_trading.user_service.CancelOrderResponse
+	11, // 20: stock_trading.user_service.OrderService.RecordOrderFill:output_type -> stock_trading.user_service.RecordOrderFillResponse
+	13, // 21: stock_trading.user_service.OrderService.RejectOrder:output_type -> stock_trading.user_service.RejectOrderResponse
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_user_order_proto_init() }
+func file_user_order_proto_init() {
+	if File_user_order_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_order_proto_rawDesc), len(file_user_order_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_order_proto_goTypes,
+		DependencyIndexes: file_user_order_proto_depIdxs,
+		MessageInfos:      file_user_order_proto_msgTypes,
+	}.Build()
+	File_user_order_proto = out.File
+	file_user_order_proto_goTypes = nil
+	file_user_order_proto_depIdxs = nil
+}
@@ -0,0 +1,2270 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/order.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PlaceOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlaceOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaceOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaceOrderRequestMultiError, or nil if none found.
+func (m *PlaceOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaceOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := PlaceOrderRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 10 {
+		err := PlaceOrderRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PlaceOrderRequest_Side_InLookup[m.GetSide()]; !ok {
+		err := PlaceOrderRequestValidationError{
+			field:  "Side",
+			reason: "value must be in list [buy sell]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PlaceOrderRequest_TimeInForce_InLookup[m.GetTimeInForce()]; !ok {
+		err := PlaceOrderRequestValidationError{
+			field:  "TimeInForce",
+			reason: "value must be in list [ GTC DAY IOC FOK]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() <= 0 {
+		err := PlaceOrderRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := PlaceOrderRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PlaceOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaceOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by PlaceOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PlaceOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaceOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaceOrderRequestMultiError) AllErrors() []error { return m }
+
+// PlaceOrderRequestValidationError is the validation error returned by
+// PlaceOrderRequest.Validate if the designated constraints aren't met.
+type PlaceOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaceOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaceOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaceOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaceOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaceOrderRequestValidationError) ErrorName() string {
+	return "PlaceOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaceOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaceOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaceOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaceOrderRequestValidationError{}
+
+var _PlaceOrderRequest_Side_InLookup = map[string]struct{}{
+	"buy":  {},
+	"sell": {},
+}
+
+var _PlaceOrderRequest_TimeInForce_InLookup = map[string]struct{}{
+	"":    {},
+	"GTC": {},
+	"DAY": {},
+	"IOC": {},
+	"FOK": {},
+}
+
+// Validate checks the field values on PlaceOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlaceOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaceOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaceOrderResponseMultiError, or nil if none found.
+func (m *PlaceOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaceOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaceOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaceOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaceOrderResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PlaceOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaceOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by PlaceOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PlaceOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaceOrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaceOrderResponseMultiError) AllErrors() []error { return m }
+
+// PlaceOrderResponseValidationError is the validation error returned by
+// PlaceOrderResponse.Validate if the designated constraints aren't met.
+type PlaceOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaceOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaceOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaceOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaceOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaceOrderResponseValidationError) ErrorName() string {
+	return "PlaceOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaceOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaceOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaceOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaceOrderResponseValidationError{}
+
+// Validate checks the field values on ListOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersRequestMultiError, or nil if none found.
+func (m *ListOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := ListOrdersRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetStatus()) > 6 {
+		err := ListOrdersRequestValidationError{
+			field:  "Status",
+			reason: "value must contain no more than 6 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetStatus() {
+		_, _ = idx, item
+
+		if _, ok := _ListOrdersRequest_Status_InLookup[item]; !ok {
+			err := ListOrdersRequestValidationError{
+				field:  fmt.Sprintf("Status[%v]", idx),
+				reason: "value must be in list [new partially_filled filled cancelled rejected expired]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ListOrdersRequestValidationError is the validation error returned by
+// ListOrdersRequest.Validate if the designated constraints aren't met.
+type ListOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersRequestValidationError) ErrorName() string {
+	return "ListOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersRequestValidationError{}
+
+var _ListOrdersRequest_Status_InLookup = map[string]struct{}{
+	"new":              {},
+	"partially_filled": {},
+	"filled":           {},
+	"cancelled":        {},
+	"rejected":         {},
+	"expired":          {},
+}
+
+// Validate checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersResponseMultiError, or nil if none found.
+func (m *ListOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ListOrdersResponseValidationError is the validation error returned by
+// ListOrdersResponse.Validate if the designated constraints aren't met.
+type ListOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersResponseValidationError) ErrorName() string {
+	return "ListOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersResponseValidationError{}
+
+// Validate checks the field values on GetOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderRequestMultiError, or nil if none found.
+func (m *GetOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := GetOrderRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderId() <= 0 {
+		err := GetOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderRequestValidationError is the validation error returned by
+// GetOrderRequest.Validate if the designated constraints aren't met.
+type GetOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderRequestValidationError) ErrorName() string { return "GetOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderRequestValidationError{}
+
+// Validate checks the field values on GetOrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderResponseMultiError, or nil if none found.
+func (m *GetOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOrderResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrderResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by GetOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderResponseMultiError) AllErrors() []error { return m }
+
+// GetOrderResponseValidationError is the validation error returned by
+// GetOrderResponse.Validate if the designated constraints aren't met.
+type GetOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderResponseValidationError) ErrorName() string { return "GetOrderResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderResponseValidationError{}
+
+// Validate checks the field values on AmendOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AmendOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AmendOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AmendOrderRequestMultiError, or nil if none found.
+func (m *AmendOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AmendOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := AmendOrderRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderId() <= 0 {
+		err := AmendOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() < 0 {
+		err := AmendOrderRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() < 0 {
+		err := AmendOrderRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AmendOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AmendOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by AmendOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AmendOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AmendOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AmendOrderRequestMultiError) AllErrors() []error { return m }
+
+// AmendOrderRequestValidationError is the validation error returned by
+// AmendOrderRequest.Validate if the designated constraints aren't met.
+type AmendOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AmendOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AmendOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AmendOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AmendOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AmendOrderRequestValidationError) ErrorName() string {
+	return "AmendOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AmendOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAmendOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AmendOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AmendOrderRequestValidationError{}
+
+// Validate checks the field values on AmendOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AmendOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AmendOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AmendOrderResponseMultiError, or nil if none found.
+func (m *AmendOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AmendOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AmendOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AmendOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AmendOrderResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AmendOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AmendOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by AmendOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AmendOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AmendOrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AmendOrderResponseMultiError) AllErrors() []error { return m }
+
+// AmendOrderResponseValidationError is the validation error returned by
+// AmendOrderResponse.Validate if the designated constraints aren't met.
+type AmendOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AmendOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AmendOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AmendOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AmendOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AmendOrderResponseValidationError) ErrorName() string {
+	return "AmendOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AmendOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAmendOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AmendOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AmendOrderResponseValidationError{}
+
+// Validate checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderRequestMultiError, or nil if none found.
+func (m *CancelOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := CancelOrderRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOrderId() <= 0 {
+		err := CancelOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOrderRequestMultiError) AllErrors() []error { return m }
+
+// CancelOrderRequestValidationError is the validation error returned by
+// CancelOrderRequest.Validate if the designated constraints aren't met.
+type CancelOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOrderRequestValidationError) ErrorName() string {
+	return "CancelOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOrderRequestValidationError{}
+
+// Validate checks the field values on CancelOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderResponseMultiError, or nil if none found.
+func (m *CancelOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelOrderResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOrderResponseMultiError) AllErrors() []error { return m }
+
+// CancelOrderResponseValidationError is the validation error returned by
+// CancelOrderResponse.Validate if the designated constraints aren't met.
+type CancelOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOrderResponseValidationError) ErrorName() string {
+	return "CancelOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOrderResponseValidationError{}
+
+// Validate checks the field values on RecordOrderFillRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordOrderFillRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordOrderFillRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordOrderFillRequestMultiError, or nil if none found.
+func (m *RecordOrderFillRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordOrderFillRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := RecordOrderFillRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetExecutionId()); l < 1 || l > 64 {
+		err := RecordOrderFillRequestValidationError{
+			field:  "ExecutionId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() <= 0 {
+		err := RecordOrderFillRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := RecordOrderFillRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExecutedAt() < 0 {
+		err := RecordOrderFillRequestValidationError{
+			field:  "ExecutedAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecordOrderFillRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordOrderFillRequestMultiError is an error wrapping multiple validation
+// errors returned by RecordOrderFillRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecordOrderFillRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordOrderFillRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordOrderFillRequestMultiError) AllErrors() []error { return m }
+
+// RecordOrderFillRequestValidationError is the validation error returned by
+// RecordOrderFillRequest.Validate if the designated constraints aren't met.
+type RecordOrderFillRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordOrderFillRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordOrderFillRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordOrderFillRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordOrderFillRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordOrderFillRequestValidationError) ErrorName() string {
+	return "RecordOrderFillRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordOrderFillRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordOrderFillRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordOrderFillRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordOrderFillRequestValidationError{}
+
+// Validate checks the field values on RecordOrderFillResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordOrderFillResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordOrderFillResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordOrderFillResponseMultiError, or nil if none found.
+func (m *RecordOrderFillResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordOrderFillResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecordOrderFillResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecordOrderFillResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordOrderFillResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecordOrderFillResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordOrderFillResponseMultiError is an error wrapping multiple validation
+// errors returned by RecordOrderFillResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RecordOrderFillResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordOrderFillResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordOrderFillResponseMultiError) AllErrors() []error { return m }
+
+// RecordOrderFillResponseValidationError is the validation error returned by
+// RecordOrderFillResponse.Validate if the designated constraints aren't met.
+type RecordOrderFillResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordOrderFillResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordOrderFillResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordOrderFillResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordOrderFillResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordOrderFillResponseValidationError) ErrorName() string {
+	return "RecordOrderFillResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordOrderFillResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordOrderFillResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordOrderFillResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordOrderFillResponseValidationError{}
+
+// Validate checks the field values on RejectOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectOrderRequestMultiError, or nil if none found.
+func (m *RejectOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := RejectOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := RejectOrderRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RejectOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by RejectOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RejectOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectOrderRequestMultiError) AllErrors() []error { return m }
+
+// RejectOrderRequestValidationError is the validation error returned by
+// RejectOrderRequest.Validate if the designated constraints aren't met.
+type RejectOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectOrderRequestValidationError) ErrorName() string {
+	return "RejectOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectOrderRequestValidationError{}
+
+// Validate checks the field values on RejectOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectOrderResponseMultiError, or nil if none found.
+func (m *RejectOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectOrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectOrderResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by RejectOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejectOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectOrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectOrderResponseMultiError) AllErrors() []error { return m }
+
+// RejectOrderResponseValidationError is the validation error returned by
+// RejectOrderResponse.Validate if the designated constraints aren't met.
+type RejectOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectOrderResponseValidationError) ErrorName() string {
+	return "RejectOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectOrderResponseValidationError{}
+
+// Validate checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Order) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in OrderMultiError, or nil if none found.
+func (m *Order) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Order) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Side
+
+	// no validation rules for TimeInForce
+
+	// no validation rules for Price
+
+	// no validation rules for Quantity
+
+	// no validation rules for FilledQuantity
+
+	// no validation rules for AveragePrice
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for PriorityAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for Fees
+
+	// no validation rules for Taxes
+
+	if len(errors) > 0 {
+		return OrderMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderMultiError is an error wrapping multiple validation errors returned by
+// Order.ValidateAll() if the designated constraints aren't met.
+type OrderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderMultiError) AllErrors() []error { return m }
+
+// OrderValidationError is the validation error returned by Order.Validate if
+// the designated constraints aren't met.
+type OrderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderValidationError) ErrorName() string { return "OrderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderValidationError{}
+
+// Validate checks the field values on OrderEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderEventMultiError, or
+// nil if none found.
+func (m *OrderEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Price
+
+	// no validation rules for Quantity
+
+	// no validation rules for Reason
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Fee
+
+	// no validation rules for Tax
+
+	if len(errors) > 0 {
+		return OrderEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderEventMultiError is an error wrapping multiple validation errors
+// returned by OrderEvent.ValidateAll() if the designated constraints aren't met.
+type OrderEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderEventMultiError) AllErrors() []error { return m }
+
+// OrderEventValidationError is the validation error returned by
+// OrderEvent.Validate if the designated constraints aren't met.
+type OrderEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderEventValidationError) ErrorName() string { return "OrderEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderEventValidationError{}
@@ -0,0 +1,1072 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/payment.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RequestDepositRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// VND.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Chosen by the client; requests with the same key create one payment.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RequestDepositRequest) Reset() {
+	*x = RequestDepositRequest{}
+	mi := &file_user_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDepositRequest) ProtoMessage() {}
+
+func (x *RequestDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDepositRequest.ProtoReflect.Descriptor instead.
+func (*RequestDepositRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestDepositRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RequestDepositRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RequestDepositRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RequestDepositResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Payment               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDepositResponse) Reset() {
+	*x = RequestDepositResponse{}
+	mi := &file_user_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDepositResponse) ProtoMessage() {}
+
+func (x *RequestDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDepositResponse.ProtoReflect.Descriptor instead.
+func (*RequestDepositResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestDepositResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RequestDepositResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RequestDepositResponse) GetData() *Payment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RequestWithdrawalRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// VND.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Chosen by the client; requests with the same key create one payment.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RequestWithdrawalRequest) Reset() {
+	*x = RequestWithdrawalRequest{}
+	mi := &file_user_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestWithdrawalRequest) ProtoMessage() {}
+
+func (x *RequestWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RequestWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestWithdrawalRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RequestWithdrawalRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RequestWithdrawalRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RequestWithdrawalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Payment               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestWithdrawalResponse) Reset() {
+	*x = RequestWithdrawalResponse{}
+	mi := &file_user_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestWithdrawalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestWithdrawalResponse) ProtoMessage() {}
+
+func (x *RequestWithdrawalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestWithdrawalResponse.ProtoReflect.Descriptor instead.
+func (*RequestWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RequestWithdrawalResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RequestWithdrawalResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RequestWithdrawalResponse) GetData() *Payment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListPaymentsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PageSize uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
+	mi := &file_user_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsRequest) ProtoMessage() {}
+
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPaymentsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPaymentsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    []*Payment             `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Token for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
+	mi := &file_user_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsResponse) ProtoMessage() {}
+
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPaymentsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListPaymentsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPaymentsResponse) GetData() []*Payment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListPaymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListPendingWithdrawalsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize uint32                 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingWithdrawalsRequest) Reset() {
+	*x = ListPendingWithdrawalsRequest{}
+	mi := &file_user_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingWithdrawalsRequest) ProtoMessage() {}
+
+func (x *ListPendingWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListPendingWithdrawalsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingWithdrawalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPendingWithdrawalsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    []*Payment             `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Token for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingWithdrawalsResponse) Reset() {
+	*x = ListPendingWithdrawalsResponse{}
+	mi := &file_user_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingWithdrawalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingWithdrawalsResponse) ProtoMessage() {}
+
+func (x *ListPendingWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPendingWithdrawalsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListPendingWithdrawalsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPendingWithdrawalsResponse) GetData() []*Payment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListPendingWithdrawalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ReviewWithdrawalRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Decision  string                 `protobuf:"bytes,2,opt,name=decision,proto3" json:"decision,omitempty"`
+	// Shown to the user; required for rejections.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewWithdrawalRequest) Reset() {
+	*x = ReviewWithdrawalRequest{}
+	mi := &file_user_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewWithdrawalRequest) ProtoMessage() {}
+
+func (x *ReviewWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*ReviewWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReviewWithdrawalRequest) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *ReviewWithdrawalRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ReviewWithdrawalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewWithdrawalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Payment               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewWithdrawalResponse) Reset() {
+	*x = ReviewWithdrawalResponse{}
+	mi := &file_user_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewWithdrawalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewWithdrawalResponse) ProtoMessage() {}
+
+func (x *ReviewWithdrawalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewWithdrawalResponse.ProtoReflect.Descriptor instead.
+func (*ReviewWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReviewWithdrawalResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReviewWithdrawalResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReviewWithdrawalResponse) GetData() *Payment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type HandlePaymentCallbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reference of the payment the gateway was given, "payment:<id>".
+	Reference        string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	GatewayReference string `protobuf:"bytes,2,opt,name=gateway_reference,json=gatewayReference,proto3" json:"gateway_reference,omitempty"`
+	Status           string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Why the payment failed.
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Signature     string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePaymentCallbackRequest) Reset() {
+	*x = HandlePaymentCallbackRequest{}
+	mi := &file_user_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePaymentCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentCallbackRequest) ProtoMessage() {}
+
+func (x *HandlePaymentCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentCallbackRequest.ProtoReflect.Descriptor instead.
+func (*HandlePaymentCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HandlePaymentCallbackRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *HandlePaymentCallbackRequest) GetGatewayReference() string {
+	if x != nil {
+		return x.GatewayReference
+	}
+	return ""
+}
+
+func (x *HandlePaymentCallbackRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HandlePaymentCallbackRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HandlePaymentCallbackRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type HandlePaymentCallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePaymentCallbackResponse) Reset() {
+	*x = HandlePaymentCallbackResponse{}
+	mi := &file_user_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePaymentCallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentCallbackResponse) ProtoMessage() {}
+
+func (x *HandlePaymentCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentCallbackResponse.ProtoReflect.Descriptor instead.
+func (*HandlePaymentCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HandlePaymentCallbackResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *HandlePaymentCallbackResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Payment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// deposit or withdrawal.
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// VND.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// pending, awaiting_approval, processing, completed, failed or rejected.
+	Status           string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	IdempotencyKey   string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	GatewayReference string `protobuf:"bytes,7,opt,name=gateway_reference,json=gatewayReference,proto3" json:"gateway_reference,omitempty"`
+	// Where the user completes a deposit with the gateway.
+	PaymentUrl string `protobuf:"bytes,8,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	// Why the payment failed or was rejected.
+	Reason    string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt int64  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// When the payment completed, failed or was rejected; 0 while it is open.
+	CompletedAt   int64 `protobuf:"varint,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_user_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_user_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Payment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Payment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *Payment) GetGatewayReference() string {
+	if x != nil {
+		return x.GatewayReference
+	}
+	return ""
+}
+
+func (x *Payment) GetPaymentUrl() string {
+	if x != nil {
+		return x.PaymentUrl
+	}
+	return ""
+}
+
+func (x *Payment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Payment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Payment) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Payment) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+var File_user_payment_proto protoreflect.FileDescriptor
+
+const file_user_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x12user/payment.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\x93\x01\n" +
+	"\x15RequestDepositRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1f\n" +
+	"\x06amount\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06amount\x122\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x0eidempotencyKey\"\x7f\n" +
+	"\x16RequestDepositResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
+	"\x04data\x18\x03 \x01(\v2#.stock_trading.user_service.PaymentR\x04data\"\x96\x01\n" +
+	"\x18RequestWithdrawalRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1f\n" +
+	"\x06amount\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06amount\x122\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x0eidempotencyKey\"\x82\x01\n" +
+	"\x19RequestWithdrawalResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
+	"\x04data\x18\x03 \x01(\v2#.stock_trading.user_service.PaymentR\x04data\"x\n" +
+	"\x13ListPaymentsRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa5\x01\n" +
+	"\x14ListPaymentsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
+	"\x04data\x18\x03 \x03(\v2#.stock_trading.user_service.PaymentR\x04data\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"[\n" +
+	"\x1dListPendingWithdrawalsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\xaf\x01\n" +
+	"\x1eListPendingWithdrawalsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
+	"\x04data\x18\x03 \x03(\v2#.stock_trading.user_service.PaymentR\x04data\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x9a\x01\n" +
+	"\x17ReviewWithdrawalRequest\x12&\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\tpaymentId\x125\n" +
+	"\bdecision\x18\x02 \x01(\tB\x19\xfaB\x16r\x14R\bapprovedR\brejectedR\bdecision\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06reason\"\x81\x01\n" +
+	"\x18ReviewWithdrawalResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
+	"\x04data\x18\x03 \x01(\v2#.stock_trading.user_service.PaymentR\x04data\"\xfe\x01\n" +
+	"\x1cHandlePaymentCallbackRequest\x12'\n" +
+	"\treference\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\treference\x127\n" +
+	"\x11gateway_reference\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\x10gatewayReference\x120\n" +
+	"\x06status\x18\x03 \x01(\tB\x18\xfaB\x15r\x13R\tconfirmedR\x06failedR\x06status\x12 \n" +
+	"\x06reason\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06reason\x12(\n" +
+	"\tsignature\x18\x05 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\tsignature\"M\n" +
+	"\x1dHandlePaymentCallbackResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xe6\x02\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12+\n" +
+	"\x11gateway_reference\x18\a \x01(\tR\x10gatewayReference\x12\x1f\n" +
+	"\vpayment_url\x18\b \x01(\tR\n" +
+	"paymentUrl\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\x12!\n" +
+	"\fcompleted_at\x18\f \x01(\x03R\vcompletedAt2\xac\b\n" +
+	"\x0ePaymentService\x12\xa4\x01\n" +
+	"\x0eRequestDeposit\x121.stock_trading.user_service.RequestDepositRequest\x1a2.stock_trading.user_service.RequestDepositResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/user/{username}/deposits\x12\xb0\x01\n" +
+	"\x11RequestWithdrawal\x124.stock_trading.user_service.RequestWithdrawalRequest\x1a5.stock_trading.user_service.RequestWithdrawalResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/user/{username}/withdrawals\x12\x9b\x01\n" +
+	"\fListPayments\x12/.stock_trading.user_service.ListPaymentsRequest\x1a0.stock_trading.user_service.ListPaymentsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/user/{username}/payments\x12\xb2\x01\n" +
+	"\x16ListPendingWithdrawals\x129.stock_trading.user_service.ListPendingWithdrawalsRequest\x1a:.stock_trading.user_service.ListPendingWithdrawalsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/admin/withdrawals\x12\xb7\x01\n" +
+	"\x10ReviewWithdrawal\x123.stock_trading.user_service.ReviewWithdrawalRequest\x1a4.stock_trading.user_service.ReviewWithdrawalResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/admin/withdrawals/{payment_id}/review\x12\xb2\x01\n" +
+	"\x15HandlePaymentCallback\x128.stock_trading.user_service.HandlePaymentCallbackRequest\x1a9.stock_trading.user_service.HandlePaymentCallbackResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/payments/callbackB\xe4\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\fPaymentProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_payment_proto_rawDescOnce sync.Once
+	file_user_payment_proto_rawDescData []byte
+)
+
+func file_user_payment_proto_rawDescGZIP() []byte {
+	file_user_payment_proto_rawDescOnce.Do(func() {
+		file_user_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_payment_proto_rawDesc), len(file_user_payment_proto_rawDesc)))
+	})
+	return file_user_payment_proto_rawDescData
+}
+
+var file_user_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_user_payment_proto_goTypes = []any{
+	(*RequestDepositRequest)(nil),          // 0: stock_trading.user_service.RequestDepositRequest
+	(*RequestDepositResponse)(nil),         // 1: stock_trading.user_service.RequestDepositResponse
+	(*RequestWithdrawalRequest)(nil),       // 2: stock_trading.user_service.RequestWithdrawalRequest
+	(*RequestWithdrawalResponse)(nil),      // 3: stock_trading.user_service.RequestWithdrawalResponse
+	(*ListPaymentsRequest)(nil),            // 4: stock_trading.user_service.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),           // 5: stock_trading.user_service.ListPaymentsResponse
+	(*ListPendingWithdrawalsRequest)(nil),  // 6: stock_trading.user_service.ListPendingWithdrawalsRequest
+	(*ListPendingWithdrawalsResponse)(nil), // 7: stock_trading.user_service.ListPendingWithdrawalsResponse
+	(*ReviewWithdrawalRequest)(nil),        // 8: stock_trading.user_service.ReviewWithdrawalRequest
+	(*ReviewWithdrawalResponse)(nil),       // 9: stock_trading.user_service.ReviewWithdrawalResponse
+	(*HandlePaymentCallbackRequest)(nil),   // 10: stock_trading.user_service.HandlePaymentCallbackRequest
+	(*HandlePaymentCallbackResponse)(nil),  // 11: stock_trading.user_service.HandlePaymentCallbackResponse
+	(*Payment)(nil),                        // 12: stock_trading.user_service.Payment
+}
+var file_user_payment_proto_depIdxs = []int32{
+	12, // 0: stock_trading.user_service.RequestDepositResponse.data:type_name -> stock_trading.user_service.Payment
+	12, // 1: stock_trading.user_service.RequestWithdrawalResponse.data:type_name -> stock_trading.user_service.Payment
+	12, // 2: stock_trading.user_service.ListPaymentsResponse.data:type_name -> stock_trading.user_service.Payment
+	12, // 3: stock_trading.user_service.ListPendingWithdrawalsResponse.data:type_name -> stock_trading.user_service.Payment
+	12, // 4: stock_trading.user_service.ReviewWithdrawalResponse.data:type_name -> stock_trading.user_service.Payment
+	0,  // 5: stock_trading.user_service.PaymentService.RequestDeposit:input_type -> stock_trading.user_service.RequestDepositRequest
+	2,  // 6: stock_trading.user_service.PaymentService.RequestWithdrawal:input_type -> stock_trading.user_service.RequestWithdrawalRequest
+	4,  // 7: stock_trading.user_service.PaymentService.ListPayments:input_type -> stock_trading.user_service.ListPaymentsRequest
+	6,  // 8: stock_trading.user_service.PaymentService.ListPendingWithdrawals:input_type -> stock_trading.user_service.ListPendingWithdrawalsRequest
+	8,  // 9: stock_trading.user_service.PaymentService.ReviewWithdrawal:input_type -> stock_trading.user_service.ReviewWithdrawalRequest
+	10, // 10: stock_trading.user_service.PaymentService.HandlePaymentCallback:input_type -> stock_trading.user_service.HandlePaymentCallbackRequest
+	1,  // 11: stock_trading.user_service.PaymentService.RequestDeposit:output_type -> stock_trading.user_service.RequestDepositResponse
+	3,  // 12: stock_trading.user_service.PaymentService.RequestWithdrawal:output_type -> stock_trading.user_service.RequestWithdrawalResponse
+	5,  // 13: stock_trading.user_service.PaymentService.ListPayments:output_type -> stock_trading.user_service.ListPaymentsResponse
+	7,  // 14: stock_trading.user_service.PaymentService.ListPendingWithdrawals:output_type -> stock_trading.user_service.ListPendingWithdrawalsResponse
+	9,  // 15: stock_trading.user_service.PaymentService.ReviewWithdrawal:output_type -> stock_trading.user_service.ReviewWithdrawalResponse
+	11, // 16: stock_trading.user_service.PaymentService.HandlePaymentCallback:output_type -> stock_trading.user_service.HandlePaymentCallbackResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_user_payment_proto_init() }
+func file_user_payment_proto_init() {
+	if File_user_payment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_payment_proto_rawDesc), len(file_user_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_payment_proto_goTypes,
+		DependencyIndexes: file_user_payment_proto_depIdxs,
+		MessageInfos:      file_user_payment_proto_msgTypes,
+	}.Build()
+	File_user_payment_proto = out.File
+	file_user_payment_proto_goTypes = nil
+	file_user_payment_proto_depIdxs = nil
+}
@@ -0,0 +1,1752 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/payment.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RequestDepositRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestDepositRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestDepositRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestDepositRequestMultiError, or nil if none found.
+func (m *RequestDepositRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestDepositRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := RequestDepositRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := RequestDepositRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetIdempotencyKey()); l < 1 || l > 64 {
+		err := RequestDepositRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestDepositRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestDepositRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestDepositRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestDepositRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestDepositRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestDepositRequestMultiError) AllErrors() []error { return m }
+
+// RequestDepositRequestValidationError is the validation error returned by
+// RequestDepositRequest.Validate if the designated constraints aren't met.
+type RequestDepositRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestDepositRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestDepositRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestDepositRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestDepositRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestDepositRequestValidationError) ErrorName() string {
+	return "RequestDepositRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestDepositRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestDepositRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestDepositRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestDepositRequestValidationError{}
+
+// Validate checks the field values on RequestDepositResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestDepositResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestDepositResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestDepositResponseMultiError, or nil if none found.
+func (m *RequestDepositResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestDepositResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestDepositResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestDepositResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestDepositResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestDepositResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestDepositResponseMultiError is an error wrapping multiple validation
+// errors returned by RequestDepositResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RequestDepositResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestDepositResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestDepositResponseMultiError) AllErrors() []error { return m }
+
+// RequestDepositResponseValidationError is the validation error returned by
+// RequestDepositResponse.Validate if the designated constraints aren't met.
+type RequestDepositResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestDepositResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestDepositResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestDepositResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestDepositResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestDepositResponseValidationError) ErrorName() string {
+	return "RequestDepositResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestDepositResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestDepositResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestDepositResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestDepositResponseValidationError{}
+
+// Validate checks the field values on RequestWithdrawalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestWithdrawalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestWithdrawalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestWithdrawalRequestMultiError, or nil if none found.
+func (m *RequestWithdrawalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestWithdrawalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := RequestWithdrawalRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := RequestWithdrawalRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetIdempotencyKey()); l < 1 || l > 64 {
+		err := RequestWithdrawalRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestWithdrawalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestWithdrawalRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestWithdrawalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestWithdrawalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestWithdrawalRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestWithdrawalRequestMultiError) AllErrors() []error { return m }
+
+// RequestWithdrawalRequestValidationError is the validation error returned by
+// RequestWithdrawalRequest.Validate if the designated constraints aren't met.
+type RequestWithdrawalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestWithdrawalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestWithdrawalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestWithdrawalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestWithdrawalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestWithdrawalRequestValidationError) ErrorName() string {
+	return "RequestWithdrawalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestWithdrawalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestWithdrawalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestWithdrawalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestWithdrawalRequestValidationError{}
+
+// Validate checks the field values on RequestWithdrawalResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestWithdrawalResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestWithdrawalResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestWithdrawalResponseMultiError, or nil if none found.
+func (m *RequestWithdrawalResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestWithdrawalResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestWithdrawalResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestWithdrawalResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestWithdrawalResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestWithdrawalResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestWithdrawalResponseMultiError is an error wrapping multiple validation
+// errors returned by RequestWithdrawalResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RequestWithdrawalResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestWithdrawalResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestWithdrawalResponseMultiError) AllErrors() []error { return m }
+
+// RequestWithdrawalResponseValidationError is the validation error returned by
+// RequestWithdrawalResponse.Validate if the designated constraints aren't met.
+type RequestWithdrawalResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestWithdrawalResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestWithdrawalResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestWithdrawalResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestWithdrawalResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestWithdrawalResponseValidationError) ErrorName() string {
+	return "RequestWithdrawalResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestWithdrawalResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestWithdrawalResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestWithdrawalResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestWithdrawalResponseValidationError{}
+
+// Validate checks the field values on ListPaymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPaymentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPaymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPaymentsRequestMultiError, or nil if none found.
+func (m *ListPaymentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPaymentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := ListPaymentsRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListPaymentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPaymentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPaymentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPaymentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPaymentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPaymentsRequestMultiError) AllErrors() []error { return m }
+
+// ListPaymentsRequestValidationError is the validation error returned by
+// ListPaymentsRequest.Validate if the designated constraints aren't met.
+type ListPaymentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPaymentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPaymentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPaymentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPaymentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPaymentsRequestValidationError) ErrorName() string {
+	return "ListPaymentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPaymentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPaymentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPaymentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPaymentsRequestValidationError{}
+
+// Validate checks the field values on ListPaymentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPaymentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPaymentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPaymentsResponseMultiError, or nil if none found.
+func (m *ListPaymentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPaymentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPaymentsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPaymentsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPaymentsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPaymentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPaymentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPaymentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPaymentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPaymentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPaymentsResponseMultiError) AllErrors() []error { return m }
+
+// ListPaymentsResponseValidationError is the validation error returned by
+// ListPaymentsResponse.Validate if the designated constraints aren't met.
+type ListPaymentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPaymentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPaymentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPaymentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPaymentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPaymentsResponseValidationError) ErrorName() string {
+	return "ListPaymentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPaymentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPaymentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPaymentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPaymentsResponseValidationError{}
+
+// Validate checks the field values on ListPendingWithdrawalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingWithdrawalsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingWithdrawalsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPendingWithdrawalsRequestMultiError, or nil if none found.
+func (m *ListPendingWithdrawalsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingWithdrawalsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListPendingWithdrawalsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingWithdrawalsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPendingWithdrawalsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListPendingWithdrawalsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingWithdrawalsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingWithdrawalsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingWithdrawalsRequestValidationError is the validation error
+// returned by ListPendingWithdrawalsRequest.Validate if the designated
+// constraints aren't met.
+type ListPendingWithdrawalsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingWithdrawalsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingWithdrawalsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingWithdrawalsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingWithdrawalsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingWithdrawalsRequestValidationError) ErrorName() string {
+	return "ListPendingWithdrawalsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingWithdrawalsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingWithdrawalsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingWithdrawalsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingWithdrawalsRequestValidationError{}
+
+// Validate checks the field values on ListPendingWithdrawalsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingWithdrawalsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingWithdrawalsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPendingWithdrawalsResponseMultiError, or nil if none found.
+func (m *ListPendingWithdrawalsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingWithdrawalsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingWithdrawalsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingWithdrawalsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingWithdrawalsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPendingWithdrawalsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingWithdrawalsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPendingWithdrawalsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListPendingWithdrawalsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingWithdrawalsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingWithdrawalsResponseMultiError) AllErrors() []error { return m }
+
+// ListPendingWithdrawalsResponseValidationError is the validation error
+// returned by ListPendingWithdrawalsResponse.Validate if the designated
+// constraints aren't met.
+type ListPendingWithdrawalsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingWithdrawalsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingWithdrawalsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingWithdrawalsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingWithdrawalsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingWithdrawalsResponseValidationError) ErrorName() string {
+	return "ListPendingWithdrawalsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingWithdrawalsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingWithdrawalsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingWithdrawalsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingWithdrawalsResponseValidationError{}
+
+// Validate checks the field values on ReviewWithdrawalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReviewWithdrawalRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewWithdrawalRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewWithdrawalRequestMultiError, or nil if none found.
+func (m *ReviewWithdrawalRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewWithdrawalRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPaymentId() <= 0 {
+		err := ReviewWithdrawalRequestValidationError{
+			field:  "PaymentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReviewWithdrawalRequest_Decision_InLookup[m.GetDecision()]; !ok {
+		err := ReviewWithdrawalRequestValidationError{
+			field:  "Decision",
+			reason: "value must be in list [approved rejected]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 255 {
+		err := ReviewWithdrawalRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReviewWithdrawalRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewWithdrawalRequestMultiError is an error wrapping multiple validation
+// errors returned by ReviewWithdrawalRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReviewWithdrawalRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewWithdrawalRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewWithdrawalRequestMultiError) AllErrors() []error { return m }
+
+// ReviewWithdrawalRequestValidationError is the validation error returned by
+// ReviewWithdrawalRequest.Validate if the designated constraints aren't met.
+type ReviewWithdrawalRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewWithdrawalRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewWithdrawalRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewWithdrawalRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewWithdrawalRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewWithdrawalRequestValidationError) ErrorName() string {
+	return "ReviewWithdrawalRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewWithdrawalRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewWithdrawalRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewWithdrawalRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewWithdrawalRequestValidationError{}
+
+var _ReviewWithdrawalRequest_Decision_InLookup = map[string]struct{}{
+	"approved": {},
+	"rejected": {},
+}
+
+// Validate checks the field values on ReviewWithdrawalResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReviewWithdrawalResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewWithdrawalResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewWithdrawalResponseMultiError, or nil if none found.
+func (m *ReviewWithdrawalResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewWithdrawalResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewWithdrawalResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewWithdrawalResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewWithdrawalResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewWithdrawalResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewWithdrawalResponseMultiError is an error wrapping multiple validation
+// errors returned by ReviewWithdrawalResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReviewWithdrawalResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewWithdrawalResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewWithdrawalResponseMultiError) AllErrors() []error { return m }
+
+// ReviewWithdrawalResponseValidationError is the validation error returned by
+// ReviewWithdrawalResponse.Validate if the designated constraints aren't met.
+type ReviewWithdrawalResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewWithdrawalResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewWithdrawalResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewWithdrawalResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewWithdrawalResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewWithdrawalResponseValidationError) ErrorName() string {
+	return "ReviewWithdrawalResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewWithdrawalResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewWithdrawalResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewWithdrawalResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewWithdrawalResponseValidationError{}
+
+// Validate checks the field values on HandlePaymentCallbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HandlePaymentCallbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandlePaymentCallbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HandlePaymentCallbackRequestMultiError, or nil if none found.
+func (m *HandlePaymentCallbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandlePaymentCallbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetReference()); l < 1 || l > 64 {
+		err := HandlePaymentCallbackRequestValidationError{
+			field:  "Reference",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetGatewayReference()); l < 1 || l > 128 {
+		err := HandlePaymentCallbackRequestValidationError{
+			field:  "GatewayReference",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _HandlePaymentCallbackRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := HandlePaymentCallbackRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [confirmed failed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 255 {
+		err := HandlePaymentCallbackRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSignature()); l < 1 || l > 256 {
+		err := HandlePaymentCallbackRequestValidationError{
+			field:  "Signature",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return HandlePaymentCallbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandlePaymentCallbackRequestMultiError is an error wrapping multiple
+// validation errors returned by HandlePaymentCallbackRequest.ValidateAll() if
+// the designated constraints aren't met.
+type HandlePaymentCallbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandlePaymentCallbackRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandlePaymentCallbackRequestMultiError) AllErrors() []error { return m }
+
+// HandlePaymentCallbackRequestValidationError is the validation error returned
+// by HandlePaymentCallbackRequest.Validate if the designated constraints
+// aren't met.
+type HandlePaymentCallbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandlePaymentCallbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandlePaymentCallbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandlePaymentCallbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandlePaymentCallbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandlePaymentCallbackRequestValidationError) ErrorName() string {
+	return "HandlePaymentCallbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HandlePaymentCallbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandlePaymentCallbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandlePaymentCallbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandlePaymentCallbackRequestValidationError{}
+
+var _HandlePaymentCallbackRequest_Status_InLookup = map[string]struct{}{
+	"confirmed": {},
+	"failed":    {},
+}
+
+// Validate checks the field values on HandlePaymentCallbackResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HandlePaymentCallbackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandlePaymentCallbackResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// HandlePaymentCallbackResponseMultiError, or nil if none found.
+func (m *HandlePaymentCallbackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandlePaymentCallbackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return HandlePaymentCallbackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandlePaymentCallbackResponseMultiError is an error wrapping multiple
+// validation errors returned by HandlePaymentCallbackResponse.ValidateAll()
+// if the designated constraints aren't met.
+type HandlePaymentCallbackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandlePaymentCallbackResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandlePaymentCallbackResponseMultiError) AllErrors() []error { return m }
+
+// HandlePaymentCallbackResponseValidationError is the validation error
+// returned by HandlePaymentCallbackResponse.Validate if the designated
+// constraints aren't met.
+type HandlePaymentCallbackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandlePaymentCallbackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandlePaymentCallbackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandlePaymentCallbackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandlePaymentCallbackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandlePaymentCallbackResponseValidationError) ErrorName() string {
+	return "HandlePaymentCallbackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HandlePaymentCallbackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandlePaymentCallbackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandlePaymentCallbackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandlePaymentCallbackResponseValidationError{}
+
+// Validate checks the field values on Payment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Payment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Payment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PaymentMultiError, or nil if none found.
+func (m *Payment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Payment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Kind
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for GatewayReference
+
+	// no validation rules for PaymentUrl
+
+	// no validation rules for Reason
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for CompletedAt
+
+	if len(errors) > 0 {
+		return PaymentMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentMultiError is an error wrapping multiple validation errors returned
+// by Payment.ValidateAll() if the designated constraints aren't met.
+type PaymentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentMultiError) AllErrors() []error { return m }
+
+// PaymentValidationError is the validation error returned by Payment.Validate
+// if the designated constraints aren't met.
+type PaymentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentValidationError) ErrorName() string { return "PaymentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentValidationError{}
@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: user/payment.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PaymentService_RequestDeposit_FullMethodName         = "/stock_trading.user_service.PaymentService/RequestDeposit"
+	PaymentService_RequestWithdrawal_FullMethodName      = "/stock_trading.user_service.PaymentService/RequestWithdrawal"
+	PaymentService_ListPayments_FullMethodName           = "/stock_trading.user_service.PaymentService/ListPayments"
+	PaymentService_ListPendingWithdrawals_FullMethodName = "/stock_trading.user_service.PaymentService/ListPendingWithdrawals"
+	PaymentService_ReviewWithdrawal_FullMethodName       = "/stock_trading.user_service.PaymentService/ReviewWithdrawal"
+	PaymentService_HandlePaymentCallback_FullMethodName  = "/stock_trading.user_service.PaymentService/HandlePaymentCallback"
+)
+
+// PaymentServiceClient is the client API for PaymentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PaymentService moves cash in and out of trading accounts through the
+// payment gateway. Requests carry an idempotency key so a retried request
+// creates one payment. Payments complete when the gateway confirms them on
+// the callback webhook; only then is the cash posted to the ledger.
+// Withdrawals above the approval threshold wait for an administrator.
+type PaymentServiceClient interface {
+	// RequestDeposit asks the gateway to bring cash into the caller's account.
+	// The payment carries the link where the user pays, when the gateway
+	// needs one.
+	RequestDeposit(ctx context.Context, in *RequestDepositRequest, opts ...grpc.CallOption) (*RequestDepositResponse, error)
+	// RequestWithdrawal asks the gateway to pay cash out of the caller's
+	// account. The amount must be free of open buy orders and withdrawals; it
+	// is held back from the buying power until the withdrawal completes or
+	// fails.
+	RequestWithdrawal(ctx context.Context, in *RequestWithdrawalRequest, opts ...grpc.CallOption) (*RequestWithdrawalResponse, error)
+	// ListPayments returns the caller's deposits and withdrawals, newest
+	// first.
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	// ListPendingWithdrawals returns the withdrawals awaiting approval, newest
+	// first. Administrators only.
+	ListPendingWithdrawals(ctx context.Context, in *ListPendingWithdrawalsRequest, opts ...grpc.CallOption) (*ListPendingWithdrawalsResponse, error)
+	// ReviewWithdrawal approves or rejects a withdrawal awaiting approval. An
+	// approved withdrawal is submitted to the gateway. Administrators only,
+	// and not for their own withdrawals.
+	ReviewWithdrawal(ctx context.Context, in *ReviewWithdrawalRequest, opts ...grpc.CallOption) (*ReviewWithdrawalResponse, error)
+	// HandlePaymentCallback is the webhook the payment gateway reports
+	// outcomes to. It needs no token; the callback is authenticated by its
+	// signature. Repeated callbacks are accepted.
+	HandlePaymentCallback(ctx context.Context, in *HandlePaymentCallbackRequest, opts ...grpc.CallOption) (*HandlePaymentCallbackResponse, error)
+}
+
+type paymentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
+	return &paymentServiceClient{cc}
+}
+
+func (c *paymentServiceClient) RequestDeposit(ctx context.Context, in *RequestDepositRequest, opts ...grpc.CallOption) (*RequestDepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDepositResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RequestDeposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RequestWithdrawal(ctx context.Context, in *RequestWithdrawalRequest, opts ...grpc.CallOption) (*RequestWithdrawalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestWithdrawalResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RequestWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListPendingWithdrawals(ctx context.Context, in *ListPendingWithdrawalsRequest, opts ...grpc.CallOption) (*ListPendingWithdrawalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListPendingWithdrawals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ReviewWithdrawal(ctx context.Context, in *ReviewWithdrawalRequest, opts ...grpc.CallOption) (*ReviewWithdrawalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewWithdrawalResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ReviewWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) HandlePaymentCallback(ctx context.Context, in *HandlePaymentCallbackRequest, opts ...grpc.CallOption) (*HandlePaymentCallbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandlePaymentCallbackResponse)
+	err := c.cc.Invoke(ctx, PaymentService_HandlePaymentCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentServiceServer is the server API for PaymentService service.
+// All implementations must embed UnimplementedPaymentServiceServer
+// for forward compatibility.
+//
+// PaymentService moves cash in and out of trading accounts through the
+// payment gateway. Requests carry an idempotency key so a retried request
+// creates one payment. Payments complete when the gateway confirms them on
+// the callback webhook; only then is the cash posted to the ledger.
+// Withdrawals above the approval threshold wait for an administrator.
+type PaymentServiceServer interface {
+	// RequestDeposit asks the gateway to bring cash into the caller's account.
+	// The payment carries the link where the user pays, when the gateway
+	// needs one.
+	RequestDeposit(context.Context, *RequestDepositRequest) (*RequestDepositResponse, error)
+	// RequestWithdrawal asks the gateway to pay cash out of the caller's
+	// account. The amount must be free of open buy orders and withdrawals; it
+	// is held back from the buying power until the withdrawal completes or
+	// fails.
+	RequestWithdrawal(context.Context, *RequestWithdrawalRequest) (*RequestWithdrawalResponse, error)
+	// ListPayments returns the caller's deposits and withdrawals, newest
+	// first.
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	// ListPendingWithdrawals returns the withdrawals awaiting approval, newest
+	// first. Administrators only.
+	ListPendingWithdrawals(context.Context, *ListPendingWithdrawalsRequest) (*ListPendingWithdrawalsResponse, error)
+	// ReviewWithdrawal approves or rejects a withdrawal awaiting approval. An
+	// approved withdrawal is submitted to the gateway. Administrators only,
+	// and not for their own withdrawals.
+	ReviewWithdrawal(context.Context, *ReviewWithdrawalRequest) (*ReviewWithdrawalResponse, error)
+	// HandlePaymentCallback is the webhook the payment gateway reports
+	// outcomes to. It needs no token; the callback is authenticated by its
+	// signature. Repeated callbacks are accepted.
+	HandlePaymentCallback(context.Context, *HandlePaymentCallbackRequest) (*HandlePaymentCallbackResponse, error)
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+// UnimplementedPaymentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentServiceServer struct{}
+
+func (UnimplementedPaymentServiceServer) RequestDeposit(context.Context, *RequestDepositRequest) (*RequestDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDeposit not implemented")
+}
+func (UnimplementedPaymentServiceServer) RequestWithdrawal(context.Context, *RequestWithdrawalRequest) (*RequestWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestWithdrawal not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayments not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListPendingWithdrawals(context.Context, *ListPendingWithdrawalsRequest) (*ListPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingWithdrawals not implemented")
+}
+func (UnimplementedPaymentServiceServer) ReviewWithdrawal(context.Context, *ReviewWithdrawalRequest) (*ReviewWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewWithdrawal not implemented")
+}
+func (UnimplementedPaymentServiceServer) HandlePaymentCallback(context.Context, *HandlePaymentCallbackRequest) (*HandlePaymentCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandlePaymentCallback not implemented")
+}
+func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
+func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentServiceServer will
+// result in compilation errors.
+type UnsafePaymentServiceServer interface {
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentService_ServiceDesc, srv)
+}
+
+func _PaymentService_RequestDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RequestDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RequestDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RequestDeposit(ctx, req.(*RequestDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RequestWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RequestWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RequestWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RequestWithdrawal(ctx, req.(*RequestWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListPendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListPendingWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPendingWithdrawals(ctx, req.(*ListPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ReviewWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ReviewWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ReviewWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ReviewWithdrawal(ctx, req.(*ReviewWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_HandlePaymentCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandlePaymentCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).HandlePaymentCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_HandlePaymentCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).HandlePaymentCallback(ctx, req.(*HandlePaymentCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stock_trading.user_service.PaymentService",
+	HandlerType: (*PaymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestDeposit",
+			Handler:    _PaymentService_RequestDeposit_Handler,
+		},
+		{
+			MethodName: "RequestWithdrawal",
+			Handler:    _PaymentService_RequestWithdrawal_Handler,
+		},
+		{
+			MethodName: "ListPayments",
+			Handler:    _PaymentService_ListPayments_Handler,
+		},
+		{
+			MethodName: "ListPendingWithdrawals",
+			Handler:    _PaymentService_ListPendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "ReviewWithdrawal",
+			Handler:    _PaymentService_ReviewWithdrawal_Handler,
+		},
+		{
+			MethodName: "HandlePaymentCallback",
+			Handler:    _PaymentService_HandlePaymentCallback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/payment.proto",
+}
@@ -0,0 +1,738 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/portfolio.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPortfolioPerformanceRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// fifo or average; empty takes the configured method.
+	CostMethod string `protobuf:"bytes,2,opt,name=cost_method,json=costMethod,proto3" json:"cost_method,omitempty"`
+	// Range of the history: 1w, 1m, 3m, 6m, ytd, 1y or all; empty is 1m.
+	Range         string `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioPerformanceRequest) Reset() {
+	*x = GetPortfolioPerformanceRequest{}
+	mi := &file_user_portfolio_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioPerformanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioPerformanceRequest) ProtoMessage() {}
+
+func (x *GetPortfolioPerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_portfolio_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioPerformanceRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioPerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_user_portfolio_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPortfolioPerformanceRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetPortfolioPerformanceRequest) GetCostMethod() string {
+	if x != nil {
+		return x.CostMethod
+	}
+	return ""
+}
+
+func (x *GetPortfolioPerformanceRequest) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+type GetPortfolioPerformanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *PortfolioPerformance  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioPerformanceResponse) Reset() {
+	*x = GetPortfolioPerformanceResponse{}
+	mi := &file_user_portfolio_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioPerformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioPerformanceResponse) ProtoMessage() {}
+
+func (x *GetPortfolioPerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_portfolio_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioPerformanceResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioPerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_user_portfolio_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPortfolioPerformanceResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetPortfolioPerformanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPortfolioPerformanceResponse) GetData() *PortfolioPerformance {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PortfolioPerformance struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CostMethod string                 `protobuf:"bytes,1,opt,name=cost_method,json=costMethod,proto3" json:"cost_method,omitempty"`
+	Range      string                 `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	AsOf       int64                  `protobuf:"varint,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// Amounts in VND.
+	Cash        int64 `protobuf:"varint,4,opt,name=cash,proto3" json:"cash,omitempty"`
+	MarketValue int64 `protobuf:"varint,5,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	TotalValue  int64 `protobuf:"varint,6,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	// Share of the total value held in cash, in basis points.
+	CashWeightBps int64 `protobuf:"varint,7,opt,name=cash_weight_bps,json=cashWeightBps,proto3" json:"cash_weight_bps,omitempty"`
+	// What sales made over the cost of the shares they gave up, net of their
+	// fees and taxes.
+	RealizedPnl int64 `protobuf:"varint,8,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	// Market value of the positions less their cost.
+	UnrealizedPnl int64 `protobuf:"varint,9,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	// Cash dividends received.
+	Dividends int64                `protobuf:"varint,10,opt,name=dividends,proto3" json:"dividends,omitempty"`
+	Fees      int64                `protobuf:"varint,11,opt,name=fees,proto3" json:"fees,omitempty"`
+	Taxes     int64                `protobuf:"varint,12,opt,name=taxes,proto3" json:"taxes,omitempty"`
+	Positions []*PortfolioPosition `protobuf:"bytes,13,rep,name=positions,proto3" json:"positions,omitempty"`
+	Sectors   []*SectorAllocation  `protobuf:"bytes,14,rep,name=sectors,proto3" json:"sectors,omitempty"`
+	// Time-weighted return over every range, shortest first.
+	Returns []*PerformanceReturn `protobuf:"bytes,15,rep,name=returns,proto3" json:"returns,omitempty"`
+	// One point per trading day of the range, the last one now.
+	History       []*PerformancePoint `protobuf:"bytes,16,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioPerformance) Reset() {
+	*x = PortfolioPerformance{}
+	mi := &file_user_portfolio_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioPerformance) ProtoMessage() {}
+
+func (x *PortfolioPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_user_portfolio_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioPerformance.ProtoReflect.Descriptor instead.
+func (*PortfolioPerformance) Descriptor() ([]byte, []int) {
+	return file_user_portfolio_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PortfolioPerformance) GetCostMethod() string {
+	if x != nil {
+		return x.CostMethod
+	}
+	return ""
+}
+
+func (x *PortfolioPerformance) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *PortfolioPerformance) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetCash() int64 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetMarketValue() int64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetTotalValue() int64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetCashWeightBps() int64 {
+	if x != nil {
+		return x.CashWeightBps
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetRealizedPnl() int64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetUnrealizedPnl() int64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetDividends() int64 {
+	if x != nil {
+		return x.Dividends
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetFees() int64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetTaxes() int64 {
+	if x != nil {
+		return x.Taxes
+	}
+	return 0
+}
+
+func (x *PortfolioPerformance) GetPositions() []*PortfolioPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *PortfolioPerformance) GetSectors() []*SectorAllocation {
+	if x != nil {
+		return x.Sectors
+	}
+	return nil
+}
+
+func (x *PortfolioPerformance) GetReturns() []*PerformanceReturn {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+func (x *PortfolioPerformance) GetHistory() []*PerformancePoint {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type PortfolioPosition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Empty for stocks that are not classified.
+	Sector   string `protobuf:"bytes,2,opt,name=sector,proto3" json:"sector,omitempty"`
+	Quantity int64  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// What the shares held cost, fees included; shares from stock dividends
+	// and splits cost nothing.
+	Cost int64 `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	// Latest price; 0 when none was recorded.
+	Price         int64 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	MarketValue   int64 `protobuf:"varint,6,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	UnrealizedPnl int64 `protobuf:"varint,7,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	RealizedPnl   int64 `protobuf:"varint,8,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	WeightBps     int64 `protobuf:"varint,9,opt,name=weight_bps,json=weightBps,proto3" json:"weight_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioPosition) Reset() {
+	*x = PortfolioPosition{}
+	mi := &file_user_portfolio_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioPosition) ProtoMessage() {}
+
+func (x *PortfolioPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_user_portfolio_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioPosition.ProtoReflect.Descriptor instead.
+func (*PortfolioPosition) Descriptor() ([]byte, []int) {
+	return file_user_portfolio_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PortfolioPosition) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PortfolioPosition) GetSector() string {
+	if x != nil {
+		return x.Sector
+	}
+	return ""
+}
+
+func (x *PortfolioPosition) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PortfolioPosition) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *PortfolioPosition) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PortfolioPosition) GetMarketValue() int64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *PortfolioPosition) GetUnrealizedPnl() int64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *PortfolioPosition) GetRealizedPnl() int64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *PortfolioPosition) GetWeightBps() int64 {
+	if x != nil {
+		return x.WeightBps
+	}
+	return 0
+}
+
+type SectorAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sector        string                 `protobuf:"bytes,1,opt,name=sector,proto3" json:"sector,omitempty"`
+	MarketValue   int64                  `protobuf:"varint,2,opt,name=market_value,json=marketValue,proto3" json:"market_value,omitempty"`
+	WeightBps     int64                  `protobuf:"varint,3,opt,name=weight_bps,json=weightBps,proto3" json:"weight_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectorAllocation) Reset() {
+	*x = SectorAllocation{}
+	mi := &file_user_portfolio_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectorAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectorAllocation) ProtoMessage() {}
+
+func (x *SectorAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_user_portfolio_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectorAllocation.ProtoReflect.Descriptor instead.
+func (*SectorAllocation) Descriptor() ([]byte, []int) {
+	return file_user_portfolio_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SectorAllocation) GetSector() string {
+	if x != nil {
+		return x.Sector
+	}
+	return ""
+}
+
+func (x *SectorAllocation) GetMarketValue() int64 {
+	if x != nil {
+		return x.MarketValue
+	}
+	return 0
+}
+
+func (x *SectorAllocation) GetWeightBps() int64 {
+	if x != nil {
+		return x.WeightBps
+	}
+	return 0
+}
+
+type PerformanceReturn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         string                 `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	ReturnBps     int64                  `protobuf:"varint,2,opt,name=return_bps,json=returnBps,proto3" json:"return_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerformanceReturn) Reset() {
+	*x = PerformanceReturn{}
+	mi := &file_user_portfolio_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformanceReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceReturn) ProtoMessage() {}
+
+func (x *PerformanceReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_user_portfolio_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceReturn.ProtoReflect.Descriptor instead.
+func (*PerformanceReturn) Descriptor() ([]byte, []int) {
+	return file_user_portfolio_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PerformanceReturn) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *PerformanceReturn) GetReturnBps() int64 {
+	if x != nil {
+		return x.ReturnBps
+	}
+	return 0
+}
+
+type PerformancePoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD.
+	Date  string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Deposited less withdrawn since the previous point.
+	NetFlow int64 `protobuf:"varint,3,opt,name=net_flow,json=netFlow,proto3" json:"net_flow,omitempty"`
+	// Time-weighted return from the start of the range to the point.
+	ReturnBps     int64 `protobuf:"varint,4,opt,name=return_bps,json=returnBps,proto3" json:"return_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerformancePoint) Reset() {
+	*x = PerformancePoint{}
+	mi := &file_user_portfolio_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformancePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformancePoint) ProtoMessage() {}
+
+func (x *PerformancePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_user_portfolio_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformancePoint.ProtoReflect.Descriptor instead.
+func (*PerformancePoint) Descriptor() ([]byte, []int) {
+	return file_user_portfolio_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PerformancePoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PerformancePoint) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PerformancePoint) GetNetFlow() int64 {
+	if x != nil {
+		return x.NetFlow
+	}
+	return 0
+}
+
+func (x *PerformancePoint) GetReturnBps() int64 {
+	if x != nil {
+		return x.ReturnBps
+	}
+	return 0
+}
+
+var File_user_portfolio_proto protoreflect.FileDescriptor
+
+const file_user_portfolio_proto_rawDesc = "" +
+	"\n" +
+	"\x14user/portfolio.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xbd\x01\n" +
+	"\x1eGetPortfolioPerformanceRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x127\n" +
+	"\vcost_method\x18\x02 \x01(\tB\x16\xfaB\x13r\x11R\x00R\x04fifoR\aaverageR\n" +
+	"costMethod\x12;\n" +
+	"\x05range\x18\x03 \x01(\tB%\xfaB\"r R\x00R\x021wR\x021mR\x023mR\x026mR\x03ytdR\x021yR\x03allR\x05range\"\x95\x01\n" +
+	"\x1fGetPortfolioPerformanceResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12D\n" +
+	"\x04data\x18\x03 \x01(\v20.stock_trading.user_service.PortfolioPerformanceR\x04data\"\x9a\x05\n" +
+	"\x14PortfolioPerformance\x12\x1f\n" +
+	"\vcost_method\x18\x01 \x01(\tR\n" +
+	"costMethod\x12\x14\n" +
+	"\x05range\x18\x02 \x01(\tR\x05range\x12\x13\n" +
+	"\x05as_of\x18\x03 \x01(\x03R\x04asOf\x12\x12\n" +
+	"\x04cash\x18\x04 \x01(\x03R\x04cash\x12!\n" +
+	"\fmarket_value\x18\x05 \x01(\x03R\vmarketValue\x12\x1f\n" +
+	"\vtotal_value\x18\x06 \x01(\x03R\n" +
+	"totalValue\x12&\n" +
+	"\x0fcash_weight_bps\x18\a \x01(\x03R\rcashWeightBps\x12!\n" +
+	"\frealized_pnl\x18\b \x01(\x03R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\t \x01(\x03R\runrealizedPnl\x12\x1c\n" +
+	"\tdividends\x18\n" +
+	" \x01(\x03R\tdividends\x12\x12\n" +
+	"\x04fees\x18\v \x01(\x03R\x04fees\x12\x14\n" +
+	"\x05taxes\x18\f \x01(\x03R\x05taxes\x12K\n" +
+	"\tpositions\x18\r \x03(\v2-.stock_trading.user_service.PortfolioPositionR\tpositions\x12F\n" +
+	"\asectors\x18\x0e \x03(\v2,.stock_trading.user_service.SectorAllocationR\asectors\x12G\n" +
+	"\areturns\x18\x0f \x03(\v2-.stock_trading.user_service.PerformanceReturnR\areturns\x12F\n" +
+	"\ahistory\x18\x10 \x03(\v2,.stock_trading.user_service.PerformancePointR\ahistory\"\x91\x02\n" +
+	"\x11PortfolioPosition\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06sector\x18\x02 \x01(\tR\x06sector\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x03R\x04cost\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12!\n" +
+	"\fmarket_value\x18\x06 \x01(\x03R\vmarketValue\x12%\n" +
+	"\x0eunrealized_pnl\x18\a \x01(\x03R\runrealizedPnl\x12!\n" +
+	"\frealized_pnl\x18\b \x01(\x03R\vrealizedPnl\x12\x1d\n" +
+	"\n" +
+	"weight_bps\x18\t \x01(\x03R\tweightBps\"l\n" +
+	"\x10SectorAllocation\x12\x16\n" +
+	"\x06sector\x18\x01 \x01(\tR\x06sector\x12!\n" +
+	"\fmarket_value\x18\x02 \x01(\x03R\vmarketValue\x12\x1d\n" +
+	"\n" +
+	"weight_bps\x18\x03 \x01(\x03R\tweightBps\"H\n" +
+	"\x11PerformanceReturn\x12\x14\n" +
+	"\x05range\x18\x01 \x01(\tR\x05range\x12\x1d\n" +
+	"\n" +
+	"return_bps\x18\x02 \x01(\x03R\treturnBps\"v\n" +
+	"\x10PerformancePoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\x12\x19\n" +
+	"\bnet_flow\x18\x03 \x01(\x03R\anetFlow\x12\x1d\n" +
+	"\n" +
+	"return_bps\x18\x04 \x01(\x03R\treturnBps2\xde\x01\n" +
+	"\x10PortfolioService\x12\xc9\x01\n" +
+	"\x17GetPortfolioPerformance\x12:.stock_trading.user_service.GetPortfolioPerformanceRequest\x1a;.stock_trading.user_service.GetPortfolioPerformanceResponse\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/user/{username}/portfolio/performanceB\xe6\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\x0ePortfolioProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_portfolio_proto_rawDescOnce sync.Once
+	file_user_portfolio_proto_rawDescData []byte
+)
+
+func file_user_portfolio_proto_rawDescGZIP() []byte {
+	file_user_portfolio_proto_rawDescOnce.Do(func() {
+		file_user_portfolio_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_portfolio_proto_rawDesc), len(file_user_portfolio_proto_rawDesc)))
+	})
+	return file_user_portfolio_proto_rawDescData
+}
+
+var file_user_portfolio_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_user_portfolio_proto_goTypes = []any{
+	(*GetPortfolioPerformanceRequest)(nil),  // 0: stock_trading.user_service.GetPortfolioPerformanceRequest
+	(*GetPortfolioPerformanceResponse)(nil), // 1: stock_trading.user_service.GetPortfolioPerformanceResponse
+	(*PortfolioPerformance)(nil),            // 2: stock_trading.user_service.PortfolioPerformance
+	(*PortfolioPosition)(nil),               // 3: stock_trading.user_service.PortfolioPosition
+	(*SectorAllocation)(nil),                // 4: stock_trading.user_service.SectorAllocation
+	(*PerformanceReturn)(nil),               // 5: stock_trading.user_service.PerformanceReturn
+	(*PerformancePoint)(nil),                // 6: stock_trading.user_service.PerformancePoint
+}
+var file_user_portfolio_proto_depIdxs = []int32{
+	2, // 0: stock_trading.user_service.GetPortfolioPerformanceResponse.data:type_name -> stock_trading.user_service.PortfolioPerformance
+	3, // 1: stock_trading.user_service.PortfolioPerformance.positions:type_name -> stock_trading.user_service.PortfolioPosition
+	4, // 2: stock_trading.user_service.PortfolioPerformance.sectors:type_name -> stock_trading.user_service.SectorAllocation
+	5, // 3: stock_trading.user_service.PortfolioPerformance.returns:type_name -> stock_trading.user_service.PerformanceReturn
+	6, // 4: stock_trading.user_service.PortfolioPerformance.history:type_name -> stock_trading.user_service.PerformancePoint
+	0, // 5: stock_trading.user_service.PortfolioService.GetPortfolioPerformance:input_type -> stock_trading.user_service.GetPortfolioPerformanceRequest
+	1, // 6: stock_trading.user_service.PortfolioService.GetPortfolioPerformance:output_type -> stock_trading.user_service.GetPortfolioPerformanceResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_user_portfolio_proto_init() }
+func file_user_portfolio_proto_init() {
+	if File_user_portfolio_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_portfolio_proto_rawDesc), len(file_user_portfolio_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_portfolio_proto_goTypes,
+		DependencyIndexes: file_user_portfolio_proto_depIdxs,
+		MessageInfos:      file_user_portfolio_proto_msgTypes,
+	}.Build()
+	File_user_portfolio_proto = out.File
+	file_user_portfolio_proto_goTypes = nil
+	file_user_portfolio_proto_depIdxs = nil
+}
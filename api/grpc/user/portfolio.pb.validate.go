@@ -0,0 +1,1027 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/portfolio.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetPortfolioPerformanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPortfolioPerformanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPortfolioPerformanceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetPortfolioPerformanceRequestMultiError, or nil if none found.
+func (m *GetPortfolioPerformanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPortfolioPerformanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := GetPortfolioPerformanceRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetPortfolioPerformanceRequest_CostMethod_InLookup[m.GetCostMethod()]; !ok {
+		err := GetPortfolioPerformanceRequestValidationError{
+			field:  "CostMethod",
+			reason: "value must be in list [ fifo average]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetPortfolioPerformanceRequest_Range_InLookup[m.GetRange()]; !ok {
+		err := GetPortfolioPerformanceRequestValidationError{
+			field:  "Range",
+			reason: "value must be in list [ 1w 1m 3m 6m ytd 1y all]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPortfolioPerformanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPortfolioPerformanceRequestMultiError is an error wrapping multiple
+// validation errors returned by GetPortfolioPerformanceRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetPortfolioPerformanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPortfolioPerformanceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPortfolioPerformanceRequestMultiError) AllErrors() []error { return m }
+
+// GetPortfolioPerformanceRequestValidationError is the validation error
+// returned by GetPortfolioPerformanceRequest.Validate if the designated
+// constraints aren't met.
+type GetPortfolioPerformanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPortfolioPerformanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPortfolioPerformanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPortfolioPerformanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPortfolioPerformanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPortfolioPerformanceRequestValidationError) ErrorName() string {
+	return "GetPortfolioPerformanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPortfolioPerformanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPortfolioPerformanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPortfolioPerformanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPortfolioPerformanceRequestValidationError{}
+
+var _GetPortfolioPerformanceRequest_CostMethod_InLookup = map[string]struct{}{
+	"":        {},
+	"fifo":    {},
+	"average": {},
+}
+
+var _GetPortfolioPerformanceRequest_Range_InLookup = map[string]struct{}{
+	"":    {},
+	"1w":  {},
+	"1m":  {},
+	"3m":  {},
+	"6m":  {},
+	"ytd": {},
+	"1y":  {},
+	"all": {},
+}
+
+// Validate checks the field values on GetPortfolioPerformanceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPortfolioPerformanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPortfolioPerformanceResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetPortfolioPerformanceResponseMultiError, or nil if none found.
+func (m *GetPortfolioPerformanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPortfolioPerformanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPortfolioPerformanceResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPortfolioPerformanceResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPortfolioPerformanceResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPortfolioPerformanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPortfolioPerformanceResponseMultiError is an error wrapping multiple
+// validation errors returned by GetPortfolioPerformanceResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetPortfolioPerformanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPortfolioPerformanceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPortfolioPerformanceResponseMultiError) AllErrors() []error { return m }
+
+// GetPortfolioPerformanceResponseValidationError is the validation error
+// returned by GetPortfolioPerformanceResponse.Validate if the designated
+// constraints aren't met.
+type GetPortfolioPerformanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPortfolioPerformanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPortfolioPerformanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPortfolioPerformanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPortfolioPerformanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPortfolioPerformanceResponseValidationError) ErrorName() string {
+	return "GetPortfolioPerformanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPortfolioPerformanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPortfolioPerformanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPortfolioPerformanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPortfolioPerformanceResponseValidationError{}
+
+// Validate checks the field values on PortfolioPerformance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PortfolioPerformance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortfolioPerformance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PortfolioPerformanceMultiError, or nil if none found.
+func (m *PortfolioPerformance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortfolioPerformance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CostMethod
+
+	// no validation rules for Range
+
+	// no validation rules for AsOf
+
+	// no validation rules for Cash
+
+	// no validation rules for MarketValue
+
+	// no validation rules for TotalValue
+
+	// no validation rules for CashWeightBps
+
+	// no validation rules for RealizedPnl
+
+	// no validation rules for UnrealizedPnl
+
+	// no validation rules for Dividends
+
+	// no validation rules for Fees
+
+	// no validation rules for Taxes
+
+	for idx, item := range m.GetPositions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PortfolioPerformanceValidationError{
+						field:  fmt.Sprintf("Positions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PortfolioPerformanceValidationError{
+						field:  fmt.Sprintf("Positions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PortfolioPerformanceValidationError{
+					field:  fmt.Sprintf("Positions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSectors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PortfolioPerformanceValidationError{
+						field:  fmt.Sprintf("Sectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PortfolioPerformanceValidationError{
+						field:  fmt.Sprintf("Sectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PortfolioPerformanceValidationError{
+					field:  fmt.Sprintf("Sectors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetReturns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PortfolioPerformanceValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PortfolioPerformanceValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PortfolioPerformanceValidationError{
+					field:  fmt.Sprintf("Returns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PortfolioPerformanceValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PortfolioPerformanceValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PortfolioPerformanceValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PortfolioPerformanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortfolioPerformanceMultiError is an error wrapping multiple validation
+// errors returned by PortfolioPerformance.ValidateAll() if the designated
+// constraints aren't met.
+type PortfolioPerformanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortfolioPerformanceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortfolioPerformanceMultiError) AllErrors() []error { return m }
+
+// PortfolioPerformanceValidationError is the validation error returned by
+// PortfolioPerformance.Validate if the designated constraints aren't met.
+type PortfolioPerformanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortfolioPerformanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortfolioPerformanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortfolioPerformanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortfolioPerformanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortfolioPerformanceValidationError) ErrorName() string {
+	return "PortfolioPerformanceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PortfolioPerformanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortfolioPerformance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortfolioPerformanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortfolioPerformanceValidationError{}
+
+// Validate checks the field values on PortfolioPosition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PortfolioPosition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PortfolioPosition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PortfolioPositionMultiError, or nil if none found.
+func (m *PortfolioPosition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PortfolioPosition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Sector
+
+	// no validation rules for Quantity
+
+	// no validation rules for Cost
+
+	// no validation rules for Price
+
+	// no validation rules for MarketValue
+
+	// no validation rules for UnrealizedPnl
+
+	// no validation rules for RealizedPnl
+
+	// no validation rules for WeightBps
+
+	if len(errors) > 0 {
+		return PortfolioPositionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PortfolioPositionMultiError is an error wrapping multiple validation errors
+// returned by PortfolioPosition.ValidateAll() if the designated constraints
+// aren't met.
+type PortfolioPositionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PortfolioPositionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PortfolioPositionMultiError) AllErrors() []error { return m }
+
+// PortfolioPositionValidationError is the validation error returned by
+// PortfolioPosition.Validate if the designated constraints aren't met.
+type PortfolioPositionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PortfolioPositionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PortfolioPositionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PortfolioPositionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PortfolioPositionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PortfolioPositionValidationError) ErrorName() string {
+	return "PortfolioPositionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PortfolioPositionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPortfolioPosition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PortfolioPositionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PortfolioPositionValidationError{}
+
+// Validate checks the field values on SectorAllocation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SectorAllocation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SectorAllocation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SectorAllocationMultiError, or nil if none found.
+func (m *SectorAllocation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SectorAllocation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sector
+
+	// no validation rules for MarketValue
+
+	// no validation rules for WeightBps
+
+	if len(errors) > 0 {
+		return SectorAllocationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SectorAllocationMultiError is an error wrapping multiple validation errors
+// returned by SectorAllocation.ValidateAll() if the designated constraints
+// aren't met.
+type SectorAllocationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SectorAllocationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SectorAllocationMultiError) AllErrors() []error { return m }
+
+// SectorAllocationValidationError is the validation error returned by
+// SectorAllocation.Validate if the designated constraints aren't met.
+type SectorAllocationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SectorAllocationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SectorAllocationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SectorAllocationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SectorAllocationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SectorAllocationValidationError) ErrorName() string { return "SectorAllocationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SectorAllocationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSectorAllocation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SectorAllocationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SectorAllocationValidationError{}
+
+// Validate checks the field values on PerformanceReturn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PerformanceReturn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PerformanceReturn with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PerformanceReturnMultiError, or nil if none found.
+func (m *PerformanceReturn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PerformanceReturn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Range
+
+	// no validation rules for ReturnBps
+
+	if len(errors) > 0 {
+		return PerformanceReturnMultiError(errors)
+	}
+
+	return nil
+}
+
+// PerformanceReturnMultiError is an error wrapping multiple validation errors
+// returned by PerformanceReturn.ValidateAll() if the designated constraints
+// aren't met.
+type PerformanceReturnMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PerformanceReturnMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PerformanceReturnMultiError) AllErrors() []error { return m }
+
+// PerformanceReturnValidationError is the validation error returned by
+// PerformanceReturn.Validate if the designated constraints aren't met.
+type PerformanceReturnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PerformanceReturnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PerformanceReturnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PerformanceReturnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PerformanceReturnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PerformanceReturnValidationError) ErrorName() string {
+	return "PerformanceReturnValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PerformanceReturnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPerformanceReturn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PerformanceReturnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PerformanceReturnValidationError{}
+
+// Validate checks the field values on PerformancePoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PerformancePoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PerformancePoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PerformancePointMultiError, or nil if none found.
+func (m *PerformancePoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PerformancePoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Value
+
+	// no validation rules for NetFlow
+
+	// no validation rules for ReturnBps
+
+	if len(errors) > 0 {
+		return PerformancePointMultiError(errors)
+	}
+
+	return nil
+}
+
+// PerformancePointMultiError is an error wrapping multiple validation errors
+// returned by PerformancePoint.ValidateAll() if the designated constraints
+// aren't met.
+type PerformancePointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PerformancePointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PerformancePointMultiError) AllErrors() []error { return m }
+
+// PerformancePointValidationError is the validation error returned by
+// PerformancePoint.Validate if the designated constraints aren't met.
+type PerformancePointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PerformancePointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PerformancePointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PerformancePointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PerformancePointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PerformancePointValidationError) ErrorName() string { return "PerformancePointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PerformancePointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPerformancePoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PerformancePointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PerformancePointValidationError{}
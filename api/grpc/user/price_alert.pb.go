@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/price_alert.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePriceAlertRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Stock code such as VNM.
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Condition string `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Target price in VND of above and below alerts.
+	TargetPrice int64 `protobuf:"varint,4,opt,name=target_price,json=targetPrice,proto3" json:"target_price,omitempty"`
+	// Move of move alerts in basis points: 500 is 5%.
+	ChangeBps     int64 `protobuf:"varint,5,opt,name=change_bps,json=changeBps,proto3" json:"change_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceAlertRequest) Reset() {
+	*x = CreatePriceAlertRequest{}
+	mi := &file_user_price_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceAlertRequest) ProtoMessage() {}
+
+func (x *CreatePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_price_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_user_price_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePriceAlertRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreatePriceAlertRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePriceAlertRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *CreatePriceAlertRequest) GetTargetPrice() int64 {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return 0
+}
+
+func (x *CreatePriceAlertRequest) GetChangeBps() int64 {
+	if x != nil {
+		return x.ChangeBps
+	}
+	return 0
+}
+
+type CreatePriceAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *PriceAlert            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceAlertResponse) Reset() {
+	*x = CreatePriceAlertResponse{}
+	mi := &file_user_price_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceAlertResponse) ProtoMessage() {}
+
+func (x *CreatePriceAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_price_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreatePriceAlertResponse) Descriptor() ([]byte, []int) {
+	return file_user_price_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePriceAlertResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreatePriceAlertResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreatePriceAlertResponse) GetData() *PriceAlert {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListPriceAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceAlertsRequest) Reset() {
+	*x = ListPriceAlertsRequest{}
+	mi := &file_user_price_alert_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceAlertsRequest) ProtoMessage() {}
+
+func (x *ListPriceAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_price_alert_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_user_price_alert_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPriceAlertsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ListPriceAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*PriceAlert          `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceAlertsResponse) Reset() {
+	*x = ListPriceAlertsResponse{}
+	mi := &file_user_price_alert_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceAlertsResponse) ProtoMessage() {}
+
+func (x *ListPriceAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_price_alert_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_user_price_alert_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPriceAlertsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListPriceAlertsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPriceAlertsResponse) GetData() []*PriceAlert {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	AlertId       int64                  `protobuf:"varint,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRequest) Reset() {
+	*x = DeleteAlertRequest{}
+	mi := &file_user_price_alert_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_price_alert_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRequest) Descriptor() ([]byte, []int) {
+	return file_user_price_alert_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteAlertRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeleteAlertRequest) GetAlertId() int64 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+type DeleteAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertResponse) Reset() {
+	*x = DeleteAlertResponse{}
+	mi := &file_user_price_alert_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertResponse) ProtoMessage() {}
+
+func (x *DeleteAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_price_alert_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertResponse) Descriptor() ([]byte, []int) {
+	return file_user_price_alert_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteAlertResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteAlertResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PriceAlert struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code  string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// above, below or move.
+	Condition   string `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	TargetPrice int64  `protobuf:"varint,4,opt,name=target_price,json=targetPrice,proto3" json:"target_price,omitempty"`
+	ChangeBps   int64  `protobuf:"varint,5,opt,name=change_bps,json=changeBps,proto3" json:"change_bps,omitempty"`
+	// Price in VND when a move alert was created.
+	BasePrice int64 `protobuf:"varint,6,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// active or triggered.
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// Price in VND that fired the alert.
+	TriggeredPrice int64 `protobuf:"varint,8,opt,name=triggered_price,json=triggeredPrice,proto3" json:"triggered_price,omitempty"`
+	// Unix time of the price that fired the alert; zero while active.
+	TriggeredAt   int64 `protobuf:"varint,9,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceAlert) Reset() {
+	*x = PriceAlert{}
+	mi := &file_user_price_alert_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAlert) ProtoMessage() {}
+
+func (x *PriceAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_user_price_alert_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAlert.ProtoReflect.Descriptor instead.
+func (*PriceAlert) Descriptor() ([]byte, []int) {
+	return file_user_price_alert_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriceAlert) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetTargetPrice() int64 {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetChangeBps() int64 {
+	if x != nil {
+		return x.ChangeBps
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetBasePrice() int64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetTriggeredPrice() int64 {
+	if x != nil {
+		return x.TriggeredPrice
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetTriggeredAt() int64 {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_user_price_alert_proto protoreflect.FileDescriptor
+
+const file_user_price_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x16user/price_alert.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"\xef\x01\n" +
+	"\x17CreatePriceAlertRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1d\n" +
+	"\x04code\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\n" +
+	"R\x04code\x127\n" +
+	"\tcondition\x18\x03 \x01(\tB\x19\xfaB\x16r\x14R\x05aboveR\x05belowR\x04moveR\tcondition\x12*\n" +
+	"\ftarget_price\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vtargetPrice\x12)\n" +
+	"\n" +
+	"change_bps\x18\x05 \x01(\x03B\n" +
+	"\xfaB\a\"\x05\x18\x90N(\x00R\tchangeBps\"\x84\x01\n" +
+	"\x18CreatePriceAlertResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\x04data\x18\x03 \x01(\v2&.stock_trading.user_service.PriceAlertR\x04data\"?\n" +
+	"\x16ListPriceAlertsRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\"\x83\x01\n" +
+	"\x17ListPriceAlertsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\x04data\x18\x03 \x03(\v2&.stock_trading.user_service.PriceAlertR\x04data\"_\n" +
+	"\x12DeleteAlertRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\"\n" +
+	"\balert_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aalertId\"C\n" +
+	"\x13DeleteAlertResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb2\x02\n" +
+	"\n" +
+	"PriceAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12!\n" +
+	"\ftarget_price\x18\x04 \x01(\x03R\vtargetPrice\x12\x1d\n" +
+	"\n" +
+	"change_bps\x18\x05 \x01(\x03R\tchangeBps\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\x06 \x01(\x03R\tbasePrice\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12'\n" +
+	"\x0ftriggered_price\x18\b \x01(\x03R\x0etriggeredPrice\x12!\n" +
+	"\ftriggered_at\x18\t \x01(\x03R\vtriggeredAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt2\x87\x04\n" +
+	"\x11PriceAlertService\x12\xa8\x01\n" +
+	"\x10CreatePriceAlert\x123.stock_trading.user_service.CreatePriceAlertRequest\x1a4.stock_trading.user_service.CreatePriceAlertResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/user/{username}/alerts\x12\xa2\x01\n" +
+	"\x0fListPriceAlerts\x122.stock_trading.user_service.ListPriceAlertsRequest\x1a3.stock_trading.user_service.ListPriceAlertsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/user/{username}/alerts\x12\xa1\x01\n" +
+	"\vDeleteAlert\x12..stock_trading.user_service.DeleteAlertRequest\x1a/.stock_trading.user_service.DeleteAlertResponse\"1\x82\xd3\xe4\x93\x02+*)/api/v1/user/{username}/alerts/{alert_id}B\xe7\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\x0fPriceAlertProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_price_alert_proto_rawDescOnce sync.Once
+	file_user_price_alert_proto_rawDescData []byte
+)
+
+func file_user_price_alert_proto_rawDescGZIP() []byte {
+	file_user_price_alert_proto_rawDescOnce.Do(func() {
+		file_user_price_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_price_alert_proto_rawDesc), len(file_user_price_alert_proto_rawDesc)))
+	})
+	return file_user_price_alert_proto_rawDescData
+}
+
+var file_user_price_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_user_price_alert_proto_goTypes = []any{
+	(*CreatePriceAlertRequest)(nil),  // 0: stock_trading.user_service.CreatePriceAlertRequest
+	(*CreatePriceAlertResponse)(nil), // 1: stock_trading.user_service.CreatePriceAlertResponse
+	(*ListPriceAlertsRequest)(nil),   // 2: stock_trading.user_service.ListPriceAlertsRequest
+	(*ListPriceAlertsResponse)(nil),  // 3: stock_trading.user_service.ListPriceAlertsResponse
+	(*DeleteAlertRequest)(nil),       // 4: stock_trading.user_service.DeleteAlertRequest
+	(*DeleteAlertResponse)(nil),      // 5: stock_trading.user_service.DeleteAlertResponse
+	(*PriceAlert)(nil),               // 6: stock_trading.user_service.PriceAlert
+}
+var file_user_price_alert_proto_depIdxs = []int32{
+	6, // 0: stock_trading.user_service.CreatePriceAlertResponse.data:type_name -> stock_trading.user_service.PriceAlert
+	6, // 1: stock_trading.user_service.ListPriceAlertsResponse.data:type_name -> stock_trading.user_service.PriceAlert
+	0, // 2: stock_trading.user_service.PriceAlertService.CreatePriceAlert:input_type -> stock_trading.user_service.CreatePriceAlertRequest
+	2, // 3: stock_trading.user_service.PriceAlertService.ListPriceAlerts:input_type -> stock_trading.user_service.ListPriceAlertsRequest
+	4, // 4: stock_trading.user_service.PriceAlertService.DeleteAlert:input_type -> stock_trading.user_service.DeleteAlertRequest
+	1, // 5: stock_trading.user_service.PriceAlertService.CreatePriceAlert:output_type -> stock_trading.user_service.CreatePriceAlertResponse
+	3, // 6: stock_trading.user_service.PriceAlertService.ListPriceAlerts:output_type -> stock_trading.user_service.ListPriceAlertsResponse
+	5, // 7: stock_trading.user_service.PriceAlertService.DeleteAlert:output_type -> stock_trading.user_service.DeleteAlertResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_user_price_alert_proto_init() }
+func file_user_price_alert_proto_init() {
+	if File_user_price_alert_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_price_alert_proto_rawDesc), len(file_user_price_alert_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_price_alert_proto_goTypes,
+		DependencyIndexes: file_user_price_alert_proto_depIdxs,
+		MessageInfos:      file_user_price_alert_proto_msgTypes,
+	}.Build()
+	File_user_price_alert_proto = out.File
+	file_user_price_alert_proto_goTypes = nil
+	file_user_price_alert_proto_depIdxs = nil
+}
@@ -0,0 +1,936 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/price_alert.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreatePriceAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePriceAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePriceAlertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePriceAlertRequestMultiError, or nil if none found.
+func (m *CreatePriceAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePriceAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := CreatePriceAlertRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 10 {
+		err := CreatePriceAlertRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreatePriceAlertRequest_Condition_InLookup[m.GetCondition()]; !ok {
+		err := CreatePriceAlertRequestValidationError{
+			field:  "Condition",
+			reason: "value must be in list [above below move]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetPrice() < 0 {
+		err := CreatePriceAlertRequestValidationError{
+			field:  "TargetPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetChangeBps(); val < 0 || val > 10000 {
+		err := CreatePriceAlertRequestValidationError{
+			field:  "ChangeBps",
+			reason: "value must be inside range [0, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreatePriceAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePriceAlertRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePriceAlertRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePriceAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePriceAlertRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePriceAlertRequestMultiError) AllErrors() []error { return m }
+
+// CreatePriceAlertRequestValidationError is the validation error returned by
+// CreatePriceAlertRequest.Validate if the designated constraints aren't met.
+type CreatePriceAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePriceAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePriceAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePriceAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePriceAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePriceAlertRequestValidationError) ErrorName() string {
+	return "CreatePriceAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePriceAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePriceAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePriceAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePriceAlertRequestValidationError{}
+
+var _CreatePriceAlertRequest_Condition_InLookup = map[string]struct{}{
+	"above": {},
+	"below": {},
+	"move":  {},
+}
+
+// Validate checks the field values on CreatePriceAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePriceAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePriceAlertResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePriceAlertResponseMultiError, or nil if none found.
+func (m *CreatePriceAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePriceAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePriceAlertResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePriceAlertResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePriceAlertResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePriceAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePriceAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePriceAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePriceAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePriceAlertResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePriceAlertResponseMultiError) AllErrors() []error { return m }
+
+// CreatePriceAlertResponseValidationError is the validation error returned by
+// CreatePriceAlertResponse.Validate if the designated constraints aren't met.
+type CreatePriceAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePriceAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePriceAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePriceAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePriceAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePriceAlertResponseValidationError) ErrorName() string {
+	return "CreatePriceAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePriceAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePriceAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePriceAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePriceAlertResponseValidationError{}
+
+// Validate checks the field values on ListPriceAlertsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPriceAlertsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPriceAlertsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPriceAlertsRequestMultiError, or nil if none found.
+func (m *ListPriceAlertsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPriceAlertsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := ListPriceAlertsRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPriceAlertsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPriceAlertsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPriceAlertsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPriceAlertsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPriceAlertsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPriceAlertsRequestMultiError) AllErrors() []error { return m }
+
+// ListPriceAlertsRequestValidationError is the validation error returned by
+// ListPriceAlertsRequest.Validate if the designated constraints aren't met.
+type ListPriceAlertsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPriceAlertsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPriceAlertsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPriceAlertsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPriceAlertsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPriceAlertsRequestValidationError) ErrorName() string {
+	return "ListPriceAlertsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPriceAlertsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPriceAlertsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPriceAlertsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPriceAlertsRequestValidationError{}
+
+// Validate checks the field values on ListPriceAlertsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPriceAlertsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPriceAlertsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPriceAlertsResponseMultiError, or nil if none found.
+func (m *ListPriceAlertsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPriceAlertsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPriceAlertsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPriceAlertsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPriceAlertsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPriceAlertsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPriceAlertsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPriceAlertsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPriceAlertsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPriceAlertsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPriceAlertsResponseMultiError) AllErrors() []error { return m }
+
+// ListPriceAlertsResponseValidationError is the validation error returned by
+// ListPriceAlertsResponse.Validate if the designated constraints aren't met.
+type ListPriceAlertsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPriceAlertsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPriceAlertsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPriceAlertsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPriceAlertsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPriceAlertsResponseValidationError) ErrorName() string {
+	return "ListPriceAlertsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPriceAlertsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPriceAlertsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPriceAlertsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPriceAlertsResponseValidationError{}
+
+// Validate checks the field values on DeleteAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAlertRequestMultiError, or nil if none found.
+func (m *DeleteAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := DeleteAlertRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAlertId() <= 0 {
+		err := DeleteAlertRequestValidationError{
+			field:  "AlertId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAlertRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAlertRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAlertRequestValidationError is the validation error returned by
+// DeleteAlertRequest.Validate if the designated constraints aren't met.
+type DeleteAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAlertRequestValidationError) ErrorName() string {
+	return "DeleteAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAlertRequestValidationError{}
+
+// Validate checks the field values on DeleteAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAlertResponseMultiError, or nil if none found.
+func (m *DeleteAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAlertResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAlertResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAlertResponseValidationError is the validation error returned by
+// DeleteAlertResponse.Validate if the designated constraints aren't met.
+type DeleteAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAlertResponseValidationError) ErrorName() string {
+	return "DeleteAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAlertResponseValidationError{}
+
+// Validate checks the field values on PriceAlert with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PriceAlert) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PriceAlert with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PriceAlertMultiError, or
+// nil if none found.
+func (m *PriceAlert) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PriceAlert) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Condition
+
+	// no validation rules for TargetPrice
+
+	// no validation rules for ChangeBps
+
+	// no validation rules for BasePrice
+
+	// no validation rules for Status
+
+	// no validation rules for TriggeredPrice
+
+	// no validation rules for TriggeredAt
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return PriceAlertMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriceAlertMultiError is an error wrapping multiple validation errors
+// returned by PriceAlert.ValidateAll() if the designated constraints aren't met.
+type PriceAlertMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriceAlertMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriceAlertMultiError) AllErrors() []error { return m }
+
+// PriceAlertValidationError is the validation error returned by
+// PriceAlert.Validate if the designated constraints aren't met.
+type PriceAlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriceAlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriceAlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriceAlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriceAlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriceAlertValidationError) ErrorName() string { return "PriceAlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PriceAlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPriceAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriceAlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriceAlertValidationError{}
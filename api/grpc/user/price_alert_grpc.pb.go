@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: user/price_alert.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PriceAlertService_CreatePriceAlert_FullMethodName = "/stock_trading.user_service.PriceAlertService/CreatePriceAlert"
+	PriceAlertService_ListPriceAlerts_FullMethodName  = "/stock_trading.user_service.PriceAlertService/ListPriceAlerts"
+	PriceAlertService_DeleteAlert_FullMethodName      = "/stock_trading.user_service.PriceAlertService/DeleteAlert"
+)
+
+// PriceAlertServiceClient is the client API for PriceAlertService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PriceAlertService notifies users when the price of a stock crosses a target
+// or moves by a percentage. Each alert fires once; the user is then emailed.
+type PriceAlertServiceClient interface {
+	// CreatePriceAlert adds an alert on a listed stock. "above" and "below"
+	// alerts need target_price; "move" alerts need change_bps and fire once
+	// the price moved that far, up or down, from the price at creation. A user
+	// may keep 50 alerts that have not fired.
+	CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*CreatePriceAlertResponse, error)
+	// ListPriceAlerts returns the caller's alerts, newest first, including
+	// those that fired.
+	ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error)
+	// DeleteAlert removes an alert, whether it fired or not.
+	DeleteAlert(ctx context.Context, in *DeleteAlertRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error)
+}
+
+type priceAlertServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPriceAlertServiceClient(cc grpc.ClientConnInterface) PriceAlertServiceClient {
+	return &priceAlertServiceClient{cc}
+}
+
+func (c *priceAlertServiceClient) CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*CreatePriceAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePriceAlertResponse)
+	err := c.cc.Invoke(ctx, PriceAlertService_CreatePriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceAlertServiceClient) ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceAlertsResponse)
+	err := c.cc.Invoke(ctx, PriceAlertService_ListPriceAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceAlertServiceClient) DeleteAlert(ctx context.Context, in *DeleteAlertRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertResponse)
+	err := c.cc.Invoke(ctx, PriceAlertService_DeleteAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriceAlertServiceServer is the server API for PriceAlertService service.
+// All implementations must embed UnimplementedPriceAlertServiceServer
+// for forward compatibility.
+//
+// PriceAlertService notifies users when the price of a stock crosses a target
+// or moves by a percentage. Each alert fires once; the user is then emailed.
+type PriceAlertServiceServer interface {
+	// CreatePriceAlert adds an alert on a listed stock. "above" and "below"
+	// alerts need target_price; "move" alerts need change_bps and fire once
+	// the price moved that far, up or down, from the price at creation. A user
+	// may keep 50 alerts that have not fired.
+	CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*CreatePriceAlertResponse, error)
+	// ListPriceAlerts returns the caller's alerts, newest first, including
+	// those that fired.
+	ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error)
+	// DeleteAlert removes an alert, whether it fired or not.
+	DeleteAlert(context.Context, *DeleteAlertRequest) (*DeleteAlertResponse, error)
+	mustEmbedUnimplementedPriceAlertServiceServer()
+}
+
+// UnimplementedPriceAlertServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPriceAlertServiceServer struct{}
+
+func (UnimplementedPriceAlertServiceServer) CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*CreatePriceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePriceAlert not implemented")
+}
+func (UnimplementedPriceAlertServiceServer) ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceAlerts not implemented")
+}
+func (UnimplementedPriceAlertServiceServer) DeleteAlert(context.Context, *DeleteAlertRequest) (*DeleteAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlert not implemented")
+}
+func (UnimplementedPriceAlertServiceServer) mustEmbedUnimplementedPriceAlertServiceServer() {}
+func (UnimplementedPriceAlertServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafePriceAlertServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PriceAlertServiceServer will
+// result in compilation errors.
+type UnsafePriceAlertServiceServer interface {
+	mustEmbedUnimplementedPriceAlertServiceServer()
+}
+
+func RegisterPriceAlertServiceServer(s grpc.ServiceRegistrar, srv PriceAlertServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPriceAlertServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PriceAlertService_ServiceDesc, srv)
+}
+
+func _PriceAlertService_CreatePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceAlertServiceServer).CreatePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceAlertService_CreatePriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceAlertServiceServer).CreatePriceAlert(ctx, req.(*CreatePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceAlertService_ListPriceAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceAlertServiceServer).ListPriceAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceAlertService_ListPriceAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceAlertServiceServer).ListPriceAlerts(ctx, req.(*ListPriceAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceAlertService_DeleteAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceAlertServiceServer).DeleteAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceAlertService_DeleteAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceAlertServiceServer).DeleteAlert(ctx, req.(*DeleteAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PriceAlertService_ServiceDesc is the grpc.ServiceDesc for PriceAlertService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PriceAlertService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stock_trading.user_service.PriceAlertService",
+	HandlerType: (*PriceAlertServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePriceAlert",
+			Handler:    _PriceAlertService_CreatePriceAlert_Handler,
+		},
+		{
+			MethodName: "ListPriceAlerts",
+			Handler:    _PriceAlertService_ListPriceAlerts_Handler,
+		},
+		{
+			MethodName: "DeleteAlert",
+			Handler:    _PriceAlertService_DeleteAlert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user/price_alert.proto",
+}
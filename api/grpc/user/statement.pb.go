@@ -0,0 +1,346 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/statement.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetStatementRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Month of the statement, such as "2026-09".
+	Period        string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementRequest) Reset() {
+	*x = GetStatementRequest{}
+	mi := &file_user_statement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementRequest) ProtoMessage() {}
+
+func (x *GetStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_statement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetStatementRequest) Descriptor() ([]byte, []int) {
+	return file_user_statement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetStatementRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetStatementRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type GetStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Statement             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatementResponse) Reset() {
+	*x = GetStatementResponse{}
+	mi := &file_user_statement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementResponse) ProtoMessage() {}
+
+func (x *GetStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_statement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetStatementResponse) Descriptor() ([]byte, []int) {
+	return file_user_statement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetStatementResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetStatementResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetStatementResponse) GetData() *Statement {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Statement struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Period string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// Unix seconds of the start of the month and of the next month.
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	// Amounts in VND.
+	OpeningCash int64 `protobuf:"varint,4,opt,name=opening_cash,json=openingCash,proto3" json:"opening_cash,omitempty"`
+	ClosingCash int64 `protobuf:"varint,5,opt,name=closing_cash,json=closingCash,proto3" json:"closing_cash,omitempty"`
+	Fees        int64 `protobuf:"varint,6,opt,name=fees,proto3" json:"fees,omitempty"`
+	// Signed URLs of the files, usable until urls_expire_at.
+	CsvUrl        string `protobuf:"bytes,7,opt,name=csv_url,json=csvUrl,proto3" json:"csv_url,omitempty"`
+	PdfUrl        string `protobuf:"bytes,8,opt,name=pdf_url,json=pdfUrl,proto3" json:"pdf_url,omitempty"`
+	UrlsExpireAt  int64  `protobuf:"varint,9,opt,name=urls_expire_at,json=urlsExpireAt,proto3" json:"urls_expire_at,omitempty"`
+	GeneratedAt   int64  `protobuf:"varint,10,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Taxes         int64  `protobuf:"varint,11,opt,name=taxes,proto3" json:"taxes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Statement) Reset() {
+	*x = Statement{}
+	mi := &file_user_statement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Statement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statement) ProtoMessage() {}
+
+func (x *Statement) ProtoReflect() protoreflect.Message {
+	mi := &file_user_statement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statement.ProtoReflect.Descriptor instead.
+func (*Statement) Descriptor() ([]byte, []int) {
+	return file_user_statement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Statement) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Statement) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *Statement) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *Statement) GetOpeningCash() int64 {
+	if x != nil {
+		return x.OpeningCash
+	}
+	return 0
+}
+
+func (x *Statement) GetClosingCash() int64 {
+	if x != nil {
+		return x.ClosingCash
+	}
+	return 0
+}
+
+func (x *Statement) GetFees() int64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *Statement) GetCsvUrl() string {
+	if x != nil {
+		return x.CsvUrl
+	}
+	return ""
+}
+
+func (x *Statement) GetPdfUrl() string {
+	if x != nil {
+		return x.PdfUrl
+	}
+	return ""
+}
+
+func (x *Statement) GetUrlsExpireAt() int64 {
+	if x != nil {
+		return x.UrlsExpireAt
+	}
+	return 0
+}
+
+func (x *Statement) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+func (x *Statement) GetTaxes() int64 {
+	if x != nil {
+		return x.Taxes
+	}
+	return 0
+}
+
+var File_user_statement_proto protoreflect.FileDescriptor
+
+const file_user_statement_proto_rawDesc = "" +
+	"\n" +
+	"\x14user/statement.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"^\n" +
+	"\x13GetStatementRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12 \n" +
+	"\x06period\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x98\x01\aR\x06period\"\x7f\n" +
+	"\x14GetStatementResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x04data\x18\x03 \x01(\v2%.stock_trading.user_service.StatementR\x04data\"\xb2\x02\n" +
+	"\tStatement\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12!\n" +
+	"\fopening_cash\x18\x04 \x01(\x03R\vopeningCash\x12!\n" +
+	"\fclosing_cash\x18\x05 \x01(\x03R\vclosingCash\x12\x12\n" +
+	"\x04fees\x18\x06 \x01(\x03R\x04fees\x12\x17\n" +
+	"\acsv_url\x18\a \x01(\tR\x06csvUrl\x12\x17\n" +
+	"\apdf_url\x18\b \x01(\tR\x06pdfUrl\x12$\n" +
+	"\x0eurls_expire_at\x18\t \x01(\x03R\furlsExpireAt\x12!\n" +
+	"\fgenerated_at\x18\n" +
+	" \x01(\x03R\vgeneratedAt\x12\x14\n" +
+	"\x05taxes\x18\v \x01(\x03R\x05taxes2\xbb\x01\n" +
+	"\x10StatementService\x12\xa6\x01\n" +
+	"\fGetStatement\x12/.stock_trading.user_service.GetStatementRequest\x1a0.stock_trading.user_service.GetStatementResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/user/{username}/statements/{period}B\xe6\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\x0eStatementProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_statement_proto_rawDescOnce sync.Once
+	file_user_statement_proto_rawDescData []byte
+)
+
+func file_user_statement_proto_rawDescGZIP() []byte {
+	file_user_statement_proto_rawDescOnce.Do(func() {
+		file_user_statement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_statement_proto_rawDesc), len(file_user_statement_proto_rawDesc)))
+	})
+	return file_user_statement_proto_rawDescData
+}
+
+var file_user_statement_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_user_statement_proto_goTypes = []any{
+	(*GetStatementRequest)(nil),  // 0: stock_trading.user_service.GetStatementRequest
+	(*GetStatementResponse)(nil), // 1: stock_trading.user_service.GetStatementResponse
+	(*Statement)(nil),            // 2: stock_trading.user_service.Statement
+}
+var file_user_statement_proto_depIdxs = []int32{
+	2, // 0: stock_trading.user_service.GetStatementResponse.data:type_name -> stock_trading.user_service.Statement
+	0, // 1: stock_trading.user_service.StatementService.GetStatement:input_type -> stock_trading.user_service.GetStatementRequest
+	1, // 2: stock_trading.user_service.StatementService.GetStatement:output_type -> stock_trading.user_service.GetStatementResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_user_statement_proto_init() }
+func file_user_statement_proto_init() {
+	if File_user_statement_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_statement_proto_rawDesc), len(file_user_statement_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_statement_proto_goTypes,
+		DependencyIndexes: file_user_statement_proto_depIdxs,
+		MessageInfos:      file_user_statement_proto_msgTypes,
+	}.Build()
+	File_user_statement_proto = out.File
+	file_user_statement_proto_goTypes = nil
+	file_user_statement_proto_depIdxs = nil
+}
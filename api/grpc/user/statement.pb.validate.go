@@ -0,0 +1,417 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/statement.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetStatementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStatementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStatementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStatementRequestMultiError, or nil if none found.
+func (m *GetStatementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStatementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := GetStatementRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPeriod()) != 7 {
+		err := GetStatementRequestValidationError{
+			field:  "Period",
+			reason: "value length must be 7 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return GetStatementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStatementRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStatementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStatementRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStatementRequestMultiError) AllErrors() []error { return m }
+
+// GetStatementRequestValidationError is the validation error returned by
+// GetStatementRequest.Validate if the designated constraints aren't met.
+type GetStatementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStatementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStatementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStatementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStatementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStatementRequestValidationError) ErrorName() string {
+	return "GetStatementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStatementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStatementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStatementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStatementRequestValidationError{}
+
+// Validate checks the field values on GetStatementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStatementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStatementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStatementResponseMultiError, or nil if none found.
+func (m *GetStatementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStatementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetStatementResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetStatementResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetStatementResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetStatementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStatementResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStatementResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStatementResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStatementResponseMultiError) AllErrors() []error { return m }
+
+// GetStatementResponseValidationError is the validation error returned by
+// GetStatementResponse.Validate if the designated constraints aren't met.
+type GetStatementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStatementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStatementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStatementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStatementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStatementResponseValidationError) ErrorName() string {
+	return "GetStatementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStatementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStatementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStatementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStatementResponseValidationError{}
+
+// Validate checks the field values on Statement with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Statement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Statement with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatementMultiError, or nil
+// if none found.
+func (m *Statement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Statement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Period
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for OpeningCash
+
+	// no validation rules for ClosingCash
+
+	// no validation rules for Fees
+
+	// no validation rules for CsvUrl
+
+	// no validation rules for PdfUrl
+
+	// no validation rules for UrlsExpireAt
+
+	// no validation rules for GeneratedAt
+
+	// no validation rules for Taxes
+
+	if len(errors) > 0 {
+		return StatementMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatementMultiError is an error wrapping multiple validation errors returned
+// by Statement.ValidateAll() if the designated constraints aren't met.
+type StatementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatementMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatementMultiError) AllErrors() []error { return m }
+
+// StatementValidationError is the validation error returned by
+// Statement.Validate if the designated constraints aren't met.
+type StatementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatementValidationError) ErrorName() string { return "StatementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatementValidationError{}
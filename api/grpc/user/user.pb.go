@@ -13,6 +13,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -386,9 +389,12 @@ func (x *VerifyUserResponse) GetData() *UserProfile {
 }
 
 type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Optional identifier the client keeps across sessions. It recognizes the
+	// device more reliably than the user agent.
+	DeviceId      string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -437,6 +443,13 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -742,11 +755,14 @@ func (x *DeleteRequest) GetUsername() string {
 }
 
 type DeleteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Unix time after which the account is anonymized; until then
+	// ReactivateAccount cancels the deletion.
+	DeletionScheduledAt int64 `protobuf:"varint,3,opt,name=deletion_scheduled_at,json=deletionScheduledAt,proto3" json:"deletion_scheduled_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *DeleteResponse) Reset() {
@@ -793,27 +809,34 @@ func (x *DeleteResponse) GetMessage() string {
 	return ""
 }
 
-type GetUserRequest struct {
+func (x *DeleteResponse) GetDeletionScheduledAt() int64 {
+	if x != nil {
+		return x.DeletionScheduledAt
+	}
+	return 0
+}
+
+type DeactivateAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
+func (x *DeactivateAccountRequest) Reset() {
+	*x = DeactivateAccountRequest{}
 	mi := &file_user_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *DeactivateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*DeactivateAccountRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+func (x *DeactivateAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -825,19 +848,19 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountRequest) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetUserRequest) GetUsername() string {
+func (x *DeactivateAccountRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-type GetUserResponse struct {
+type DeactivateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -846,20 +869,20 @@ type GetUserResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
+func (x *DeactivateAccountResponse) Reset() {
+	*x = DeactivateAccountResponse{}
 	mi := &file_user_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *DeactivateAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*DeactivateAccountResponse) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+func (x *DeactivateAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -871,60 +894,54 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateAccountResponse) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetUserResponse) GetCode() uint32 {
+func (x *DeactivateAccountResponse) GetCode() uint32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *GetUserResponse) GetMessage() string {
+func (x *DeactivateAccountResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetUserResponse) GetData() *UserProfile {
+func (x *DeactivateAccountResponse) GetData() *UserProfile {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type UpdateUserRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Username         string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Email            string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Cmnd             string                 `protobuf:"bytes,4,opt,name=cmnd,proto3" json:"cmnd,omitempty"`
-	Birthday         int64                  `protobuf:"varint,5,opt,name=birthday,proto3" json:"birthday,omitempty"`
-	Gender           bool                   `protobuf:"varint,6,opt,name=gender,proto3" json:"gender,omitempty"`
-	PermanentAddress string                 `protobuf:"bytes,7,opt,name=permanent_address,json=permanentAddress,proto3" json:"permanent_address,omitempty"`
-	PhoneNumber      string                 `protobuf:"bytes,8,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ReactivateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
+func (x *ReactivateAccountRequest) Reset() {
+	*x = ReactivateAccountRequest{}
 	mi := &file_user_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *ReactivateAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*ReactivateAccountRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *ReactivateAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,89 +953,48 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountRequest) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateUserRequest) GetUsername() string {
+func (x *ReactivateAccountRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetCmnd() string {
-	if x != nil {
-		return x.Cmnd
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetBirthday() int64 {
-	if x != nil {
-		return x.Birthday
-	}
-	return 0
-}
-
-func (x *UpdateUserRequest) GetGender() bool {
-	if x != nil {
-		return x.Gender
-	}
-	return false
-}
-
-func (x *UpdateUserRequest) GetPermanentAddress() string {
-	if x != nil {
-		return x.PermanentAddress
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetPhoneNumber() string {
+func (x *ReactivateAccountRequest) GetPassword() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Password
 	}
 	return ""
 }
 
-type UpdateUserResponse struct {
+type ReactivateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *UserProfile           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserResponse) Reset() {
-	*x = UpdateUserResponse{}
+func (x *ReactivateAccountResponse) Reset() {
+	*x = ReactivateAccountResponse{}
 	mi := &file_user_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserResponse) String() string {
+func (x *ReactivateAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserResponse) ProtoMessage() {}
+func (*ReactivateAccountResponse) ProtoMessage() {}
 
-func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *ReactivateAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1030,48 +1006,53 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactivateAccountResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateAccountResponse) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateUserResponse) GetCode() uint32 {
+func (x *ReactivateAccountResponse) GetCode() uint32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *UpdateUserResponse) GetMessage() string {
+func (x *ReactivateAccountResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ChangePasswordRequest struct {
+func (x *ReactivateAccountResponse) GetData() *UserProfile {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateAvatarUploadUrlRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordRequest) Reset() {
-	*x = ChangePasswordRequest{}
+func (x *CreateAvatarUploadUrlRequest) Reset() {
+	*x = CreateAvatarUploadUrlRequest{}
 	mi := &file_user_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordRequest) String() string {
+func (x *CreateAvatarUploadUrlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordRequest) ProtoMessage() {}
+func (*CreateAvatarUploadUrlRequest) ProtoMessage() {}
 
-func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateAvatarUploadUrlRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1083,54 +1064,45 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAvatarUploadUrlRequest.ProtoReflect.Descriptor instead.
+func (*CreateAvatarUploadUrlRequest) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ChangePasswordRequest) GetUsername() string {
+func (x *CreateAvatarUploadUrlRequest) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *ChangePasswordRequest) GetOldPassword() string {
-	if x != nil {
-		return x.OldPassword
-	}
-	return ""
-}
-
-func (x *ChangePasswordRequest) GetNewPassword() string {
-	if x != nil {
-		return x.NewPassword
-	}
-	return ""
-}
-
-type ChangePasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type CreateAvatarUploadUrlResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Signed URL accepting a single PUT with the image as the body.
+	UploadUrl     string `protobuf:"bytes,3,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	UploadKey     string `protobuf:"bytes,4,opt,name=upload_key,json=uploadKey,proto3" json:"upload_key,omitempty"`
+	ExpiresAt     int64  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxBytes      int64  `protobuf:"varint,6,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordResponse) Reset() {
-	*x = ChangePasswordResponse{}
+func (x *CreateAvatarUploadUrlResponse) Reset() {
+	*x = CreateAvatarUploadUrlResponse{}
 	mi := &file_user_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordResponse) String() string {
+func (x *CreateAvatarUploadUrlResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordResponse) ProtoMessage() {}
+func (*CreateAvatarUploadUrlResponse) ProtoMessage() {}
 
-func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateAvatarUploadUrlResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1142,47 +1114,75 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAvatarUploadUrlResponse.ProtoReflect.Descriptor instead.
+func (*CreateAvatarUploadUrlResponse) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ChangePasswordResponse) GetCode() uint32 {
+func (x *CreateAvatarUploadUrlResponse) GetCode() uint32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *ChangePasswordResponse) GetMessage() string {
+func (x *CreateAvatarUploadUrlResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ListUsersRequest struct {
+func (x *CreateAvatarUploadUrlResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *CreateAvatarUploadUrlResponse) GetUploadKey() string {
+	if x != nil {
+		return x.UploadKey
+	}
+	return ""
+}
+
+func (x *CreateAvatarUploadUrlResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateAvatarUploadUrlResponse) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+type UploadAvatarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	UploadKey     string                 `protobuf:"bytes,2,opt,name=upload_key,json=uploadKey,proto3" json:"upload_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
+func (x *UploadAvatarRequest) Reset() {
+	*x = UploadAvatarRequest{}
 	mi := &file_user_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersRequest) String() string {
+func (x *UploadAvatarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (*UploadAvatarRequest) ProtoMessage() {}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadAvatarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1194,51 +1194,48 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListUsersRequest) GetPage() uint32 {
+func (x *UploadAvatarRequest) GetUsername() string {
 	if x != nil {
-		return x.Page
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUsersRequest) GetPageSize() uint32 {
+func (x *UploadAvatarRequest) GetUploadKey() string {
 	if x != nil {
-		return x.PageSize
+		return x.UploadKey
 	}
-	return 0
+	return ""
 }
 
-type ListUsersResponse struct {
+type UploadAvatarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          []*UserProfile         `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	Total         uint64                 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Page          uint32                 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data          *UserProfile           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
+func (x *UploadAvatarResponse) Reset() {
+	*x = UploadAvatarResponse{}
 	mi := &file_user_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *UploadAvatarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*UploadAvatarResponse) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadAvatarResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1250,87 +1247,1770 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UploadAvatarResponse) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListUsersResponse) GetCode() uint32 {
+func (x *UploadAvatarResponse) GetCode() uint32 {
 	if x != nil {
 		return x.Code
 	}
 	return 0
 }
 
-func (x *ListUsersResponse) GetMessage() string {
+func (x *UploadAvatarResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ListUsersResponse) GetData() []*UserProfile {
+func (x *UploadAvatarResponse) GetData() *UserProfile {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ListUsersResponse) GetTotal() uint64 {
+type ExportMyDataRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// json (default) or zip.
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_user_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[22]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListUsersResponse) GetPage() uint32 {
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportMyDataRequest) GetUsername() string {
 	if x != nil {
-		return x.Page
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUsersResponse) GetPageSize() uint32 {
+func (x *ExportMyDataRequest) GetFormat() string {
 	if x != nil {
-		return x.PageSize
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-type UserProfile struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Email            string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Cmnd             string                 `protobuf:"bytes,5,opt,name=cmnd,proto3" json:"cmnd,omitempty"`
-	Birthday         int64                  `protobuf:"varint,6,opt,name=birthday,proto3" json:"birthday,omitempty"`
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *DataExport            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_user_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportMyDataResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ExportMyDataResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetData() *DataExport {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	ExportId      int64                  `protobuf:"varint,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_user_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDataExportRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetDataExportRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *DataExport            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	mi := &file_user_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDataExportResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetDataExportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDataExportResponse) GetData() *DataExport {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type EraseMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseMyDataRequest) Reset() {
+	*x = EraseMyDataRequest{}
+	mi := &file_user_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseMyDataRequest) ProtoMessage() {}
+
+func (x *EraseMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseMyDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EraseMyDataRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EraseMyDataRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type EraseMyDataResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Unix time from which the anonymization job erases the account.
+	ErasureScheduledAt int64 `protobuf:"varint,3,opt,name=erasure_scheduled_at,json=erasureScheduledAt,proto3" json:"erasure_scheduled_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EraseMyDataResponse) Reset() {
+	*x = EraseMyDataResponse{}
+	mi := &file_user_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseMyDataResponse) ProtoMessage() {}
+
+func (x *EraseMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseMyDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EraseMyDataResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EraseMyDataResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EraseMyDataResponse) GetErasureScheduledAt() int64 {
+	if x != nil {
+		return x.ErasureScheduledAt
+	}
+	return 0
+}
+
+type DataExport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// json or zip.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// pending, processing, ready or failed.
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt int64  `protobuf:"varint,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Unix time after which the export can no longer be downloaded.
+	ExpiresAt   int64  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Error       string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	FileName    string `protobuf:"bytes,8,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Set only when status is ready.
+	Content       []byte `protobuf:"bytes,10,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_user_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DataExport) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DataExport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DataExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataExport) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DataExport) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *DataExport) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *DataExport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DataExport) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DataExport) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DataExport) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_user_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *UserProfile           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_user_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetUserResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetUserResponse) GetData() *UserProfile {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Username         string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email            string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Cmnd             string                 `protobuf:"bytes,4,opt,name=cmnd,proto3" json:"cmnd,omitempty"`
+	Birthday         int64                  `protobuf:"varint,5,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Gender           bool                   `protobuf:"varint,6,opt,name=gender,proto3" json:"gender,omitempty"`
+	PermanentAddress string                 `protobuf:"bytes,7,opt,name=permanent_address,json=permanentAddress,proto3" json:"permanent_address,omitempty"`
+	PhoneNumber      string                 `protobuf:"bytes,8,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	// Fields to update, e.g. "name,birthday". When omitted only non-empty fields
+	// are updated; "*" replaces every field.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,9,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// ETag of the profile being updated. The If-Match header is used when empty.
+	Etag          string `protobuf:"bytes,10,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_user_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetCmnd() string {
+	if x != nil {
+		return x.Cmnd
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetBirthday() int64 {
+	if x != nil {
+		return x.Birthday
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *UpdateUserRequest) GetPermanentAddress() string {
+	if x != nil {
+		return x.PermanentAddress
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *UserProfile           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_user_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateUserResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateUserResponse) GetData() *UserProfile {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChangePasswordRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_user_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChangePasswordResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ChangePasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RequestEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	NewEmail      string                 `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_user_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RequestEmailChangeRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+type RequestEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeResponse) Reset() {
+	*x = RequestEmailChangeResponse{}
+	mi := &file_user_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RequestEmailChangeResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RequestEmailChangeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ConfirmEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_user_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ConfirmEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *UserProfile           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeResponse) Reset() {
+	*x = ConfirmEmailChangeResponse{}
+	mi := &file_user_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ConfirmEmailChangeResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ConfirmEmailChangeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfirmEmailChangeResponse) GetData() *UserProfile {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response. Takes precedence over page; the
+	// filters and order_by must be unchanged.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// "<field> [asc|desc]" where field is id, username, email, name or
+	// created_at. Defaults to "id asc".
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Case-insensitive substring match on username, email or name.
+	Query    string                `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	Verified *wrapperspb.BoolValue `protobuf:"bytes,6,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Inclusive lower bound on the account creation time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Exclusive upper bound on the account creation time.
+	CreatedBefore  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	EmailPrefix    string                 `protobuf:"bytes,9,opt,name=email_prefix,json=emailPrefix,proto3" json:"email_prefix,omitempty"`
+	UsernamePrefix string                 `protobuf:"bytes,10,opt,name=username_prefix,json=usernamePrefix,proto3" json:"username_prefix,omitempty"`
+	NamePrefix     string                 `protobuf:"bytes,11,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	// Exact match; phone_number and cmnd are looked up through blind indexes.
+	PhoneNumber string `protobuf:"bytes,12,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	// Account states to include; defaults to active only.
+	Statuses      []string `protobuf:"bytes,13,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Cmnd          string   `protobuf:"bytes,14,opt,name=cmnd,proto3" json:"cmnd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_user_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListUsersRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetVerified() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Verified
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetEmailPrefix() string {
+	if x != nil {
+		return x.EmailPrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetUsernamePrefix() string {
+	if x != nil {
+		return x.UsernamePrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetCmnd() string {
+	if x != nil {
+		return x.Cmnd
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Code     uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message  string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data     []*UserProfile         `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Total    uint64                 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Page     uint32                 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize uint32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_user_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListUsersResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListUsersResponse) GetData() []*UserProfile {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UserProfile struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email            string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Cmnd             string                 `protobuf:"bytes,5,opt,name=cmnd,proto3" json:"cmnd,omitempty"`
+	Birthday         int64                  `protobuf:"varint,6,opt,name=birthday,proto3" json:"birthday,omitempty"`
 	Gender           bool                   `protobuf:"varint,7,opt,name=gender,proto3" json:"gender,omitempty"`
 	PermanentAddress string                 `protobuf:"bytes,8,opt,name=permanent_address,json=permanentAddress,proto3" json:"permanent_address,omitempty"`
 	PhoneNumber      string                 `protobuf:"bytes,9,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
 	CreatedAt        int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt        int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Verified         bool                   `protobuf:"varint,12,opt,name=verified,proto3" json:"verified,omitempty"`
-	VerifiedAt       int64                  `protobuf:"varint,13,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	VerifiedAt       int64                  `protobuf:"varint,13,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	// Opaque version tag, also returned in the ETag header; send it back in
+	// If-Match (or UpdateUserRequest.etag) to avoid lost updates.
+	Etag string `protobuf:"bytes,14,opt,name=etag,proto3" json:"etag,omitempty"`
+	// active, deactivated, pending_deletion or deleted.
+	Status              string `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty"`
+	DeletionScheduledAt int64  `protobuf:"varint,16,opt,name=deletion_scheduled_at,json=deletionScheduledAt,proto3" json:"deletion_scheduled_at,omitempty"`
+	// Signed URL of the profile picture; empty without one. It expires, so
+	// re-read the profile rather than storing it.
+	AvatarUrl     string `protobuf:"bytes,17,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_user_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UserProfile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserProfile) GetCmnd() string {
+	if x != nil {
+		return x.Cmnd
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBirthday() int64 {
+	if x != nil {
+		return x.Birthday
+	}
+	return 0
+}
+
+func (x *UserProfile) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *UserProfile) GetPermanentAddress() string {
+	if x != nil {
+		return x.PermanentAddress
+	}
+	return ""
+}
+
+func (x *UserProfile) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UserProfile) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *UserProfile) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *UserProfile) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *UserProfile) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+func (x *UserProfile) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UserProfile) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDeletionScheduledAt() int64 {
+	if x != nil {
+		return x.DeletionScheduledAt
+	}
+	return 0
+}
+
+func (x *UserProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type GetLoginHistoryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PageSize uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginHistoryRequest) Reset() {
+	*x = GetLoginHistoryRequest{}
+	mi := &file_user_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginHistoryRequest) ProtoMessage() {}
+
+func (x *GetLoginHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLoginHistoryRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetLoginHistoryRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLoginHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetLoginHistoryResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    []*LoginRecord         `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Token for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginHistoryResponse) Reset() {
+	*x = GetLoginHistoryResponse{}
+	mi := &file_user_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginHistoryResponse) ProtoMessage() {}
+
+func (x *GetLoginHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLoginHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetLoginHistoryResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetLoginHistoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetLoginHistoryResponse) GetData() []*LoginRecord {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetLoginHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type LoginRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// login or logout.
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Ip        string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// True when the login came from a device or IP not used before.
+	NewDevice     bool  `protobuf:"varint,5,opt,name=new_device,json=newDevice,proto3" json:"new_device,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRecord) Reset() {
+	*x = LoginRecord{}
+	mi := &file_user_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRecord) ProtoMessage() {}
+
+func (x *LoginRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRecord.ProtoReflect.Descriptor instead.
+func (*LoginRecord) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LoginRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-func (x *UserProfile) Reset() {
-	*x = UserProfile{}
-	mi := &file_user_user_proto_msgTypes[22]
+func (x *LoginRecord) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LoginRecord) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoginRecord) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginRecord) GetNewDevice() bool {
+	if x != nil {
+		return x.NewDevice
+	}
+	return false
+}
+
+func (x *LoginRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Account the events are about.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// User who performed the actions.
+	ActorId int64    `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Actions []string `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Inclusive lower bound on the event time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Exclusive upper bound on the event time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of a previous response; the filters must be unchanged.
+	PageToken     string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_user_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserProfile) String() string {
+func (x *ListAuditEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfile) ProtoMessage() {}
+func (*ListAuditEventsRequest) ProtoMessage() {}
 
-func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[22]
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,100 +3021,313 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
-func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UserProfile) GetId() int64 {
+func (x *ListAuditEventsRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UserProfile) GetUsername() string {
+func (x *ListAuditEventsRequest) GetActorId() int64 {
 	if x != nil {
-		return x.Username
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *UserProfile) GetName() string {
+func (x *ListAuditEventsRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.Name
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *UserProfile) GetEmail() string {
+type ListAuditEventsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    []*AuditEvent          `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// Token for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_user_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[46]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListAuditEventsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UserProfile) GetCmnd() string {
+func (x *ListAuditEventsResponse) GetData() []*AuditEvent {
 	if x != nil {
-		return x.Cmnd
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *UserProfile) GetBirthday() int64 {
+type AuditEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Zero for anonymous requests such as Register.
+	ActorId int64  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action  string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Full gRPC method of the request.
+	Method    string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Ip        string `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent string `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	RequestId string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Changed fields; sensitive fields are only marked as redacted.
+	Changes       map[string]*AuditChange `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     int64                   `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_user_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[47]
 	if x != nil {
-		return x.Birthday
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AuditEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UserProfile) GetGender() bool {
+func (x *AuditEvent) GetUserId() int64 {
 	if x != nil {
-		return x.Gender
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-func (x *UserProfile) GetPermanentAddress() string {
+func (x *AuditEvent) GetActorId() int64 {
 	if x != nil {
-		return x.PermanentAddress
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
 	}
 	return ""
 }
 
-func (x *UserProfile) GetPhoneNumber() string {
+func (x *AuditEvent) GetMethod() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Method
 	}
 	return ""
 }
 
-func (x *UserProfile) GetCreatedAt() int64 {
+func (x *AuditEvent) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetChanges() map[string]*AuditChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *UserProfile) GetUpdatedAt() int64 {
+type AuditChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Redacted      bool                   `protobuf:"varint,3,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	mi := &file_user_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChange) ProtoMessage() {}
+
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[48]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserProfile) GetVerified() bool {
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AuditChange) GetFrom() string {
 	if x != nil {
-		return x.Verified
+		return x.From
 	}
-	return false
+	return ""
 }
 
-func (x *UserProfile) GetVerifiedAt() int64 {
+func (x *AuditChange) GetTo() string {
 	if x != nil {
-		return x.VerifiedAt
+		return x.To
 	}
-	return 0
+	return ""
+}
+
+func (x *AuditChange) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
 }
 
 type LoginResponse_Data struct {
@@ -1449,7 +3342,7 @@ type LoginResponse_Data struct {
 
 func (x *LoginResponse_Data) Reset() {
 	*x = LoginResponse_Data{}
-	mi := &file_user_user_proto_msgTypes[23]
+	mi := &file_user_user_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1461,7 +3354,7 @@ func (x *LoginResponse_Data) String() string {
 func (*LoginResponse_Data) ProtoMessage() {}
 
 func (x *LoginResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[23]
+	mi := &file_user_user_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1509,7 +3402,7 @@ var File_user_user_proto protoreflect.FileDescriptor
 
 const file_user_user_proto_rawDesc = "" +
 	"\n" +
-	"\x0fuser/user.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/rpc/error_details.proto\x1a,protoc-gen-swagger/options/annotations.proto\"\xcf\x02\n" +
+	"\x0fuser/user.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/rpc/error_details.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a,protoc-gen-swagger/options/annotations.proto\"\xcf\x02\n" +
 	"\x0fRegisterRequest\x12%\n" +
 	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12%\n" +
 	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\bpassword\x12!\n" +
@@ -1534,10 +3427,11 @@ const file_user_user_proto_rawDesc = "" +
 	"\x12VerifyUserResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
-	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"\\\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"\x83\x01\n" +
 	"\fLoginRequest\x12%\n" +
 	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12%\n" +
-	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\bpassword\"\xba\x02\n" +
+	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\bpassword\x12%\n" +
+	"\tdevice_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\bdeviceId\"\xba\x02\n" +
 	"\rLoginResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12B\n" +
@@ -1561,45 +3455,150 @@ const file_user_user_proto_rawDesc = "" +
 	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
 	"\rDeleteRequest\x12%\n" +
-	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\">\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\"r\n" +
 	"\x0eDeleteResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"7\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x15deletion_scheduled_at\x18\x03 \x01(\x03R\x13deletionScheduledAt\"A\n" +
+	"\x18DeactivateAccountRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\"\x86\x01\n" +
+	"\x19DeactivateAccountResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"h\n" +
+	"\x18ReactivateAccountRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\bpassword\"\x86\x01\n" +
+	"\x19ReactivateAccountResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"E\n" +
+	"\x1cCreateAvatarUploadUrlRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\"\xc7\x01\n" +
+	"\x1dCreateAvatarUploadUrlResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x03 \x01(\tR\tuploadUrl\x12\x1d\n" +
+	"\n" +
+	"upload_key\x18\x04 \x01(\tR\tuploadKey\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12\x1b\n" +
+	"\tmax_bytes\x18\x06 \x01(\x03R\bmaxBytes\"g\n" +
+	"\x13UploadAvatarRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12)\n" +
+	"\n" +
+	"upload_key\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\tuploadKey\"\x81\x01\n" +
+	"\x14UploadAvatarResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"h\n" +
+	"\x13ExportMyDataRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12*\n" +
+	"\x06format\x18\x02 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x04jsonR\x03zipR\x06format\"\x80\x01\n" +
+	"\x14ExportMyDataResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\x04data\x18\x03 \x01(\v2&.stock_trading.user_service.DataExportR\x04data\"c\n" +
+	"\x14GetDataExportRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12$\n" +
+	"\texport_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bexportId\"\x81\x01\n" +
+	"\x15GetDataExportResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\x04data\x18\x03 \x01(\v2&.stock_trading.user_service.DataExportR\x04data\"b\n" +
+	"\x12EraseMyDataRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\bpassword\"u\n" +
+	"\x13EraseMyDataResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x14erasure_scheduled_at\x18\x03 \x01(\x03R\x12erasureScheduledAt\"\x9d\x02\n" +
+	"\n" +
+	"DataExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\x05 \x01(\x03R\vcompletedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x1b\n" +
+	"\tfile_name\x18\b \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\t \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\n" +
+	" \x01(\fR\acontent\"7\n" +
 	"\x0eGetUserRequest\x12%\n" +
 	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\"|\n" +
 	"\x0fGetUserResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
-	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"\x94\x02\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"\xeb\x02\n" +
 	"\x11UpdateUserRequest\x12%\n" +
-	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12!\n" +
-	"\x05email\x18\x02 \x01(\tB\v\xfaB\br\x06\x10\x06\x18@`\x01R\x05email\x12\x1d\n" +
-	"\x04name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x03\x18@R\x04name\x12\x12\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12$\n" +
+	"\x05email\x18\x02 \x01(\tB\x0e\xfaB\vr\t\x10\x06\x18@\xd0\x01\x01`\x01R\x05email\x12 \n" +
+	"\x04name\x18\x03 \x01(\tB\f\xfaB\tr\a\x10\x03\x18@\xd0\x01\x01R\x04name\x12\x12\n" +
 	"\x04cmnd\x18\x04 \x01(\tR\x04cmnd\x12\x1a\n" +
 	"\bbirthday\x18\x05 \x01(\x03R\bbirthday\x12\x16\n" +
 	"\x06gender\x18\x06 \x01(\bR\x06gender\x12+\n" +
 	"\x11permanent_address\x18\a \x01(\tR\x10permanentAddress\x12!\n" +
-	"\fphone_number\x18\b \x01(\tR\vphoneNumber\"B\n" +
+	"\fphone_number\x18\b \x01(\tR\vphoneNumber\x12;\n" +
+	"\vupdate_mask\x18\t \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\n" +
+	" \x01(\tR\x04etag\"\x7f\n" +
 	"\x12UpdateUserResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x9a\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"\x9a\x01\n" +
 	"\x15ChangePasswordRequest\x12%\n" +
 	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12,\n" +
 	"\fold_password\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\voldPassword\x12,\n" +
 	"\fnew_password\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\vnewPassword\"F\n" +
 	"\x16ChangePasswordResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"C\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"l\n" +
+	"\x19RequestEmailChangeRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12(\n" +
+	"\tnew_email\x18\x02 \x01(\tB\v\xfaB\br\x06\x10\x06\x18@`\x01R\bnewEmail\"J\n" +
+	"\x1aRequestEmailChangeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"=\n" +
+	"\x19ConfirmEmailChangeRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x06\x18\x80\x01R\x05token\"\x87\x01\n" +
+	"\x1aConfirmEmailChangeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x01(\v2'.stock_trading.user_service.UserProfileR\x04data\"\xcb\x04\n" +
 	"\x10ListUsersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\rR\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"\xc5\x01\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x126\n" +
+	"\bverified\x18\x06 \x01(\v2\x1a.google.protobuf.BoolValueR\bverified\x12?\n" +
+	"\rcreated_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12!\n" +
+	"\femail_prefix\x18\t \x01(\tR\vemailPrefix\x12'\n" +
+	"\x0fusername_prefix\x18\n" +
+	" \x01(\tR\x0eusernamePrefix\x12\x1f\n" +
+	"\vname_prefix\x18\v \x01(\tR\n" +
+	"namePrefix\x12!\n" +
+	"\fphone_number\x18\f \x01(\tR\vphoneNumber\x12V\n" +
+	"\bstatuses\x18\r \x03(\tB:\xfaB7\x92\x014\"2r0R\x06activeR\vdeactivatedR\x10pending_deletionR\adeletedR\bstatuses\x12\x12\n" +
+	"\x04cmnd\x18\x0e \x01(\tR\x04cmnd\"\xed\x01\n" +
 	"\x11ListUsersResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
 	"\x04data\x18\x03 \x03(\v2'.stock_trading.user_service.UserProfileR\x04data\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x04R\x05total\x12\x12\n" +
 	"\x04page\x18\x05 \x01(\rR\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\rR\bpageSize\"\xf6\x02\n" +
+	"\tpage_size\x18\x06 \x01(\rR\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\a \x01(\tR\rnextPageToken\"\xf5\x03\n" +
 	"\vUserProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
@@ -1617,7 +3616,75 @@ const file_user_user_proto_rawDesc = "" +
 	"updated_at\x18\v \x01(\x03R\tupdatedAt\x12\x1a\n" +
 	"\bverified\x18\f \x01(\bR\bverified\x12\x1f\n" +
 	"\vverified_at\x18\r \x01(\x03R\n" +
-	"verifiedAt2\xfc\v\n" +
+	"verifiedAt\x12\x12\n" +
+	"\x04etag\x18\x0e \x01(\tR\x04etag\x12\x16\n" +
+	"\x06status\x18\x0f \x01(\tR\x06status\x122\n" +
+	"\x15deletion_scheduled_at\x18\x10 \x01(\x03R\x13deletionScheduledAt\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x11 \x01(\tR\tavatarUrl\"{\n" +
+	"\x16GetLoginHistoryRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xac\x01\n" +
+	"\x17GetLoginHistoryResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x04data\x18\x03 \x03(\v2'.stock_trading.user_service.LoginRecordR\x04data\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x9e\x01\n" +
+	"\vLoginRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"new_device\x18\x05 \x01(\bR\tnewDevice\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\xdc\x04\n" +
+	"\x16ListAuditEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12\xa5\x02\n" +
+	"\aactions\x18\x03 \x03(\tB\x8a\x02\xfaB\x86\x02\x92\x01\x82\x02\"\xff\x01r\xfc\x01R\bregisterR\x06verifyR\x05loginR\flogin_failedR\x06logoutR\x0fpassword_changeR\x0eprofile_updateR\femail_changeR\x06deleteR\x05eraseR\tanonymizeR\n" +
+	"deactivateR\n" +
+	"reactivateR\n" +
+	"kyc_submitR\n" +
+	"kyc_reviewR\ftrading_tierR\x11ledger_adjustmentR\x11withdrawal_reviewR\ffee_scheduleR\x10corporate_actionR\aactions\x12?\n" +
+	"\rcreated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12&\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x18@R\trequestId\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\"\xab\x01\n" +
+	"\x17ListAuditEventsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\x04data\x18\x03 \x03(\v2&.stock_trading.user_service.AuditEventR\x04data\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xa1\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x0e\n" +
+	"\x02ip\x18\x06 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\b \x01(\tR\trequestId\x12M\n" +
+	"\achanges\x18\t \x03(\v23.stock_trading.user_service.AuditEvent.ChangesEntryR\achanges\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x1ac\n" +
+	"\fChangesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
+	"\x05value\x18\x02 \x01(\v2'.stock_trading.user_service.AuditChangeR\x05value:\x028\x01\"M\n" +
+	"\vAuditChange\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1a\n" +
+	"\bredacted\x18\x03 \x01(\bR\bredacted2\xb4\x1a\n" +
 	"\vUserService\x12x\n" +
 	"\bRegister\x12+.stock_trading.user_service.RegisterRequest\x1a,.stock_trading.user_service.RegisterResponse\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/users\x12\xa4\x01\n" +
 	"\x12ResendVerification\x125.stock_trading.user_service.ResendVerificationRequest\x1a6.stock_trading.user_service.ResendVerificationResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/users/verify/resend\x12\x82\x01\n" +
@@ -1630,7 +3697,18 @@ const file_user_user_proto_rawDesc = "" +
 	"\x03Get\x12*.stock_trading.user_service.GetUserRequest\x1a+.stock_trading.user_service.GetUserResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/user/{username}\x12\x8b\x01\n" +
 	"\x06Update\x12-.stock_trading.user_service.UpdateUserRequest\x1a..stock_trading.user_service.UpdateUserResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/api/v1/user/{username}\x12\xa4\x01\n" +
 	"\x0eChangePassword\x121.stock_trading.user_service.ChangePasswordRequest\x1a2.stock_trading.user_service.ChangePasswordResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/user/{username}/password\x12z\n" +
-	"\x04List\x12,.stock_trading.user_service.ListUsersRequest\x1a-.stock_trading.user_service.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/usersB\xe1\x01\n" +
+	"\x04List\x12,.stock_trading.user_service.ListUsersRequest\x1a-.stock_trading.user_service.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12\xad\x01\n" +
+	"\x12RequestEmailChange\x125.stock_trading.user_service.RequestEmailChangeRequest\x1a6.stock_trading.user_service.RequestEmailChangeResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/user/{username}/email\x12\xa1\x01\n" +
+	"\x12ConfirmEmailChange\x125.stock_trading.user_service.ConfirmEmailChangeRequest\x1a6.stock_trading.user_service.ConfirmEmailChangeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/users/email/confirm\x12\xaf\x01\n" +
+	"\x11DeactivateAccount\x124.stock_trading.user_service.DeactivateAccountRequest\x1a5.stock_trading.user_service.DeactivateAccountResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/user/{username}/deactivate\x12\x9e\x01\n" +
+	"\x11ReactivateAccount\x124.stock_trading.user_service.ReactivateAccountRequest\x1a5.stock_trading.user_service.ReactivateAccountResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/users/reactivate\x12\x9d\x01\n" +
+	"\fExportMyData\x12/.stock_trading.user_service.ExportMyDataRequest\x1a0.stock_trading.user_service.ExportMyDataResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/user/{username}/exports\x12\xa9\x01\n" +
+	"\rGetDataExport\x120.stock_trading.user_service.GetDataExportRequest\x1a1.stock_trading.user_service.GetDataExportResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/user/{username}/exports/{export_id}\x12\x98\x01\n" +
+	"\vEraseMyData\x12..stock_trading.user_service.EraseMyDataRequest\x1a/.stock_trading.user_service.EraseMyDataResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/user/{username}/erase\x12\xa2\x01\n" +
+	"\x0fGetLoginHistory\x122.stock_trading.user_service.GetLoginHistoryRequest\x1a3.stock_trading.user_service.GetLoginHistoryResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/user/{username}/logins\x12\xc2\x01\n" +
+	"\x15CreateAvatarUploadUrl\x128.stock_trading.user_service.CreateAvatarUploadUrlRequest\x1a9.stock_trading.user_service.CreateAvatarUploadUrlResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/user/{username}/avatar/upload-url\x12\x9c\x01\n" +
+	"\fUploadAvatar\x12/.stock_trading.user_service.UploadAvatarRequest\x1a0.stock_trading.user_service.UploadAvatarResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/user/{username}/avatar\x12\x9e\x01\n" +
+	"\x0fListAuditEvents\x122.stock_trading.user_service.ListAuditEventsRequest\x1a3.stock_trading.user_service.ListAuditEventsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/admin/audit-eventsB\xe1\x01\n" +
 	"\x1ecom.stock_trading.user_serviceB\tUserProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
 
 var (
@@ -1645,66 +3723,135 @@ func file_user_user_proto_rawDescGZIP() []byte {
 	return file_user_user_proto_rawDescData
 }
 
-var file_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_user_user_proto_goTypes = []any{
-	(*RegisterRequest)(nil),            // 0: stock_trading.user_service.RegisterRequest
-	(*RegisterResponse)(nil),           // 1: stock_trading.user_service.RegisterResponse
-	(*ResendVerificationRequest)(nil),  // 2: stock_trading.user_service.ResendVerificationRequest
-	(*ResendVerificationResponse)(nil), // 3: stock_trading.user_service.ResendVerificationResponse
-	(*VerifyUserRequest)(nil),          // 4: stock_trading.user_service.VerifyUserRequest
-	(*VerifyUserResponse)(nil),         // 5: stock_trading.user_service.VerifyUserResponse
-	(*LoginRequest)(nil),               // 6: stock_trading.user_service.LoginRequest
-	(*LoginResponse)(nil),              // 7: stock_trading.user_service.LoginResponse
-	(*RefreshTokenRequest)(nil),        // 8: stock_trading.user_service.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),       // 9: stock_trading.user_service.RefreshTokenResponse
-	(*LogoutRequest)(nil),              // 10: stock_trading.user_service.LogoutRequest
-	(*LogoutResponse)(nil),             // 11: stock_trading.user_service.LogoutResponse
-	(*DeleteRequest)(nil),              // 12: stock_trading.user_service.DeleteRequest
-	(*DeleteResponse)(nil),             // 13: stock_trading.user_service.DeleteResponse
-	(*GetUserRequest)(nil),             // 14: stock_trading.user_service.GetUserRequest
-	(*GetUserResponse)(nil),            // 15: stock_trading.user_service.GetUserResponse
-	(*UpdateUserRequest)(nil),          // 16: stock_trading.user_service.UpdateUserRequest
-	(*UpdateUserResponse)(nil),         // 17: stock_trading.user_service.UpdateUserResponse
-	(*ChangePasswordRequest)(nil),      // 18: stock_trading.user_service.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil),     // 19: stock_trading.user_service.ChangePasswordResponse
-	(*ListUsersRequest)(nil),           // 20: stock_trading.user_service.ListUsersRequest
-	(*ListUsersResponse)(nil),          // 21: stock_trading.user_service.ListUsersResponse
-	(*UserProfile)(nil),                // 22: stock_trading.user_service.UserProfile
-	(*LoginResponse_Data)(nil),         // 23: stock_trading.user_service.LoginResponse.Data
+	(*RegisterRequest)(nil),               // 0: stock_trading.user_service.RegisterRequest
+	(*RegisterResponse)(nil),              // 1: stock_trading.user_service.RegisterResponse
+	(*ResendVerificationRequest)(nil),     // 2: stock_trading.user_service.ResendVerificationRequest
+	(*ResendVerificationResponse)(nil),    // 3: stock_trading.user_service.ResendVerificationResponse
+	(*VerifyUserRequest)(nil),             // 4: stock_trading.user_service.VerifyUserRequest
+	(*VerifyUserResponse)(nil),            // 5: stock_trading.user_service.VerifyUserResponse
+	(*LoginRequest)(nil),                  // 6: stock_trading.user_service.LoginRequest
+	(*LoginResponse)(nil),                 // 7: stock_trading.user_service.LoginResponse
+	(*RefreshTokenRequest)(nil),           // 8: stock_trading.user_service.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),          // 9: stock_trading.user_service.RefreshTokenResponse
+	(*LogoutRequest)(nil),                 // 10: stock_trading.user_service.LogoutRequest
+	(*LogoutResponse)(nil),                // 11: stock_trading.user_service.LogoutResponse
+	(*DeleteRequest)(nil),                 // 12: stock_trading.user_service.DeleteRequest
+	(*DeleteResponse)(nil),                // 13: stock_trading.user_service.DeleteResponse
+	(*DeactivateAccountRequest)(nil),      // 14: stock_trading.user_service.DeactivateAccountRequest
+	(*DeactivateAccountResponse)(nil),     // 15: stock_trading.user_service.DeactivateAccountResponse
+	(*ReactivateAccountRequest)(nil),      // 16: stock_trading.user_service.ReactivateAccountRequest
+	(*ReactivateAccountResponse)(nil),     // 17: stock_trading.user_service.ReactivateAccountResponse
+	(*CreateAvatarUploadUrlRequest)(nil),  // 18: stock_trading.user_service.CreateAvatarUploadUrlRequest
+	(*CreateAvatarUploadUrlResponse)(nil), // 19: stock_trading.user_service.CreateAvatarUploadUrlResponse
+	(*UploadAvatarRequest)(nil),           // 20: stock_trading.user_service.UploadAvatarRequest
+	(*UploadAvatarResponse)(nil),          // 21: stock_trading.user_service.UploadAvatarResponse
+	(*ExportMyDataRequest)(nil),           // 22: stock_trading.user_service.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),          // 23: stock_trading.user_service.ExportMyDataResponse
+	(*GetDataExportRequest)(nil),          // 24: stock_trading.user_service.GetDataExportRequest
+	(*GetDataExportResponse)(nil),         // 25: stock_trading.user_service.GetDataExportResponse
+	(*EraseMyDataRequest)(nil),            // 26: stock_trading.user_service.EraseMyDataRequest
+	(*EraseMyDataResponse)(nil),           // 27: stock_trading.user_service.EraseMyDataResponse
+	(*DataExport)(nil),                    // 28: stock_trading.user_service.DataExport
+	(*GetUserRequest)(nil),                // 29: stock_trading.user_service.GetUserRequest
+	(*GetUserResponse)(nil),               // 30: stock_trading.user_service.GetUserResponse
+	(*UpdateUserRequest)(nil),             // 31: stock_trading.user_service.UpdateUserRequest
+	(*UpdateUserResponse)(nil),            // 32: stock_trading.user_service.UpdateUserResponse
+	(*ChangePasswordRequest)(nil),         // 33: stock_trading.user_service.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),        // 34: stock_trading.user_service.ChangePasswordResponse
+	(*RequestEmailChangeRequest)(nil),     // 35: stock_trading.user_service.RequestEmailChangeRequest
+	(*RequestEmailChangeResponse)(nil),    // 36: stock_trading.user_service.RequestEmailChangeResponse
+	(*ConfirmEmailChangeRequest)(nil),     // 37: stock_trading.user_service.ConfirmEmailChangeRequest
+	(*ConfirmEmailChangeResponse)(nil),    // 38: stock_trading.user_service.ConfirmEmailChangeResponse
+	(*ListUsersRequest)(nil),              // 39: stock_trading.user_service.ListUsersRequest
+	(*ListUsersResponse)(nil),             // 40: stock_trading.user_service.ListUsersResponse
+	(*UserProfile)(nil),                   // 41: stock_trading.user_service.UserProfile
+	(*GetLoginHistoryRequest)(nil),        // 42: stock_trading.user_service.GetLoginHistoryRequest
+	(*GetLoginHistoryResponse)(nil),       // 43: stock_trading.user_service.GetLoginHistoryResponse
+	(*LoginRecord)(nil),                   // 44: stock_trading.user_service.LoginRecord
+	(*ListAuditEventsRequest)(nil),        // 45: stock_trading.user_service.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),       // 46: stock_trading.user_service.ListAuditEventsResponse
+	(*AuditEvent)(nil),                    // 47: stock_trading.user_service.AuditEvent
+	(*AuditChange)(nil),                   // 48: stock_trading.user_service.AuditChange
+	(*LoginResponse_Data)(nil),            // 49: stock_trading.user_service.LoginResponse.Data
+	nil,                                   // 50: stock_trading.user_service.AuditEvent.ChangesEntry
+	(*fieldmaskpb.FieldMask)(nil),         // 51: google.protobuf.FieldMask
+	(*wrapperspb.BoolValue)(nil),          // 52: google.protobuf.BoolValue
+	(*timestamppb.Timestamp)(nil),         // 53: google.protobuf.Timestamp
 }
 var file_user_user_proto_depIdxs = []int32{
-	22, // 0: stock_trading.user_service.VerifyUserResponse.data:type_name -> stock_trading.user_service.UserProfile
-	23, // 1: stock_trading.user_service.LoginResponse.data:type_name -> stock_trading.user_service.LoginResponse.Data
-	23, // 2: stock_trading.user_service.RefreshTokenResponse.data:type_name -> stock_trading.user_service.LoginResponse.Data
-	22, // 3: stock_trading.user_service.GetUserResponse.data:type_name -> stock_trading.user_service.UserProfile
-	22, // 4: stock_trading.user_service.ListUsersResponse.data:type_name -> stock_trading.user_service.UserProfile
-	0,  // 5: stock_trading.user_service.UserService.Register:input_type -> stock_trading.user_service.RegisterRequest
-	2,  // 6: stock_trading.user_service.UserService.ResendVerification:input_type -> stock_trading.user_service.ResendVerificationRequest
-	4,  // 7: stock_trading.user_service.UserService.VerifyUser:input_type -> stock_trading.user_service.VerifyUserRequest
-	6,  // 8: stock_trading.user_service.UserService.Login:input_type -> stock_trading.user_service.LoginRequest
-	8,  // 9: stock_trading.user_service.UserService.RefreshToken:input_type -> stock_trading.user_service.RefreshTokenRequest
-	10, // 10: stock_trading.user_service.UserService.Logout:input_type -> stock_trading.user_service.LogoutRequest
-	12, // 11: stock_trading.user_service.UserService.Delete:input_type -> stock_trading.user_service.DeleteRequest
-	14, // 12: stock_trading.user_service.UserService.Get:input_type -> stock_trading.user_service.GetUserRequest
-	16, // 13: stock_trading.user_service.UserService.Update:input_type -> stock_trading.user_service.UpdateUserRequest
-	18, // 14: stock_trading.user_service.UserService.ChangePassword:input_type -> stock_trading.user_service.ChangePasswordRequest
-	20, // 15: stock_trading.user_service.UserService.List:input_type -> stock_trading.user_service.ListUsersRequest
-	1,  // 16: stock_trading.user_service.UserService.Register:output_type -> stock_trading.user_service.RegisterResponse
-	3,  // 17: stock_trading.user_service.UserService.ResendVerification:output_type -> stock_trading.user_service.ResendVerificationResponse
-	5,  // 18: stock_trading.user_service.UserService.VerifyUser:output_type -> stock_trading.user_service.VerifyUserResponse
-	7,  // 19: stock_trading.user_service.UserService.Login:output_type -> stock_trading.user_service.LoginResponse
-	9,  // 20: stock_trading.user_service.UserService.RefreshToken:output_type -> stock_trading.user_service.RefreshTokenResponse
-	11, // 21: stock_trading.user_service.UserService.Logout:output_type -> stock_trading.user_service.LogoutResponse
-	13, // 22: stock_trading.user_service.UserService.Delete:output_type -> stock_trading.user_service.DeleteResponse
-	15, // 23: stock_trading.user_service.UserService.Get:output_type -> stock_trading.user_service.GetUserResponse
-	17, // 24: stock_trading.user_service.UserService.Update:output_type -> stock_trading.user_service.UpdateUserResponse
-	19, // 25: stock_trading.user_service.UserService.ChangePassword:output_type -> stock_trading.user_service.ChangePasswordResponse
-	21, // 26: stock_trading.user_service.UserService.List:output_type -> stock_trading.user_service.ListUsersResponse
-	16, // [16:27] is the sub-list for method output_type
-	5,  // [5:16] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	41, // 0: stock_trading.user_service.VerifyUserResponse.data:type_name -> stock_trading.user_service.UserProfile
+	49, // 1: stock_trading.user_service.LoginResponse.data:type_name -> stock_trading.user_service.LoginResponse.Data
+	49, // 2: stock_trading.user_service.RefreshTokenResponse.data:type_name -> stock_trading.user_service.LoginResponse.Data
+	41, // 3: stock_trading.user_service.DeactivateAccountResponse.data:type_name -> stock_trading.user_service.UserProfile
+	41, // 4: stock_trading.user_service.ReactivateAccountResponse.data:type_name -> stock_trading.user_service.UserProfile
+	41, // 5: stock_trading.user_service.UploadAvatarResponse.data:type_name -> stock_trading.user_service.UserProfile
+	28, // 6: stock_trading.user_service.ExportMyDataResponse.data:type_name -> stock_trading.user_service.DataExport
+	28, // 7: stock_trading.user_service.GetDataExportResponse.data:type_name -> stock_trading.user_service.DataExport
+	41, // 8: stock_trading.user_service.GetUserResponse.data:type_name -> stock_trading.user_service.UserProfile
+	51, // 9: stock_trading.user_service.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	41, // 10: stock_trading.user_service.UpdateUserResponse.data:type_name -> stock_trading.user_service.UserProfile
+	41, // 11: stock_trading.user_service.ConfirmEmailChangeResponse.data:type_name -> stock_trading.user_service.UserProfile
+	52, // 12: stock_trading.user_service.ListUsersRequest.verified:type_name -> google.protobuf.BoolValue
+	53, // 13: stock_trading.user_service.ListUsersRequest.created_after:type_name -> google.protobuf.Timestamp
+	53, // 14: stock_trading.user_service.ListUsersRequest.created_before:type_name -> google.protobuf.Timestamp
+	41, // 15: stock_trading.user_service.ListUsersResponse.data:type_name -> stock_trading.user_service.UserProfile
+	44, // 16: stock_trading.user_service.GetLoginHistoryResponse.data:type_name -> stock_trading.user_service.LoginRecord
+	53, // 17: stock_trading.user_service.ListAuditEventsRequest.created_after:type_name -> google.protobuf.Timestamp
+	53, // 18: stock_trading.user_service.ListAuditEventsRequest.created_before:type_name -> google.protobuf.Timestamp
+	47, // 19: stock_trading.user_service.ListAuditEventsResponse.data:type_name -> stock_trading.user_service.AuditEvent
+	50, // 20: stock_trading.user_service.AuditEvent.changes:type_name -> stock_trading.user_service.AuditEvent.ChangesEntry
+	48, // 21: stock_trading.user_service.AuditEvent.ChangesEntry.value:type_name -> stock_trading.user_service.AuditChange
+	0,  // 22: stock_trading.user_service.UserService.Register:input_type -> stock_trading.user_service.RegisterRequest
+	2,  // 23: stock_trading.user_service.UserService.ResendVerification:input_type -> stock_trading.user_service.ResendVerificationRequest
+	4,  // 24: stock_trading.user_service.UserService.VerifyUser:input_type -> stock_trading.user_service.VerifyUserRequest
+	6,  // 25: stock_trading.user_service.UserService.Login:input_type -> stock_trading.user_service.LoginRequest
+	8,  // 26: stock_trading.user_service.UserService.RefreshToken:input_type -> stock_trading.user_service.RefreshTokenRequest
+	10, // 27: stock_trading.user_service.UserService.Logout:input_type -> stock_trading.user_service.LogoutRequest
+	12, // 28: stock_trading.user_service.UserService.Delete:input_type -> stock_trading.user_service.DeleteRequest
+	29, // 29: stock_trading.user_service.UserService.Get:input_type -> stock_trading.user_service.GetUserRequest
+	31, // 30: stock_trading.user_service.UserService.Update:input_type -> stock_trading.user_service.UpdateUserRequest
+	33, // 31: stock_trading.user_service.UserService.ChangePassword:input_type -> stock_trading.user_service.ChangePasswordRequest
+	39, // 32: stock_trading.user_service.UserService.List:input_type -> stock_trading.user_service.ListUsersRequest
+	35, // 33: stock_trading.user_service.UserService.RequestEmailChange:input_type -> stock_trading.user_service.RequestEmailChangeRequest
+	37, // 34: stock_trading.user_service.UserService.ConfirmEmailChange:input_type -> stock_trading.user_service.ConfirmEmailChangeRequest
+	14, // 35: stock_trading.user_service.UserService.DeactivateAccount:input_type -> stock_trading.user_service.DeactivateAccountRequest
+	16, // 36: stock_trading.user_service.UserService.ReactivateAccount:input_type -> stock_trading.user_service.ReactivateAccountRequest
+	22, // 37: stock_trading.user_service.UserService.ExportMyData:input_type -> stock_trading.user_service.ExportMyDataRequest
+	24, // 38: stock_trading.user_service.UserService.GetDataExport:input_type -> stock_trading.user_service.GetDataExportRequest
+	26, // 39: stock_trading.user_service.UserService.EraseMyData:input_type -> stock_trading.user_service.EraseMyDataRequest
+	42, // 40: stock_trading.user_service.UserService.GetLoginHistory:input_type -> stock_trading.user_service.GetLoginHistoryRequest
+	18, // 41: stock_trading.user_service.UserService.CreateAvatarUploadUrl:input_type -> stock_trading.user_service.CreateAvatarUploadUrlRequest
+	20, // 42: stock_trading.user_service.UserService.UploadAvatar:input_type -> stock_trading.user_service.UploadAvatarRequest
+	45, // 43: stock_trading.user_service.UserService.ListAuditEvents:input_type -> stock_trading.user_service.ListAuditEventsRequest
+	1,  // 44: stock_trading.user_service.UserService.Register:output_type -> stock_trading.user_service.RegisterResponse
+	3,  // 45: stock_trading.user_service.UserService.ResendVerification:output_type -> stock_trading.user_service.ResendVerificationResponse
+	5,  // 46: stock_trading.user_service.UserService.VerifyUser:output_type -> stock_trading.user_service.VerifyUserResponse
+	7,  // 47: stock_trading.user_service.UserService.Login:output_type -> stock_trading.user_service.LoginResponse
+	9,  // 48: stock_trading.user_service.UserService.RefreshToken:output_type -> stock_trading.user_service.RefreshTokenResponse
+	11, // 49: stock_trading.user_service.UserService.Logout:output_type -> stock_trading.user_service.LogoutResponse
+	13, // 50: stock_trading.user_service.UserService.Delete:output_type -> stock_trading.user_service.DeleteResponse
+	30, // 51: stock_trading.user_service.UserService.Get:output_type -> stock_trading.user_service.GetUserResponse
+	32, // 52: stock_trading.user_service.UserService.Update:output_type -> stock_trading.user_service.UpdateUserResponse
+	34, // 53: stock_trading.user_service.UserService.ChangePassword:output_type -> stock_trading.user_service.ChangePasswordResponse
+	40, // 54: stock_trading.user_service.UserService.List:output_type -> stock_trading.user_service.ListUsersResponse
+	36, // 55: stock_trading.user_service.UserService.RequestEmailChange:output_type -> stock_trading.user_service.RequestEmailChangeResponse
+	38, // 56: stock_trading.user_service.UserService.ConfirmEmailChange:output_type -> stock_trading.user_service.ConfirmEmailChangeResponse
+	15, // 57: stock_trading.user_service.UserService.DeactivateAccount:output_type -> stock_trading.user_service.DeactivateAccountResponse
+	17, // 58: stock_trading.user_service.UserService.ReactivateAccount:output_type -> stock_trading.user_service.ReactivateAccountResponse
+	23, // 59: stock_trading.user_service.UserService.ExportMyData:output_type -> stock_trading.user_service.ExportMyDataResponse
+	25, // 60: stock_trading.user_service.UserService.GetDataExport:output_type -> stock_trading.user_service.GetDataExportResponse
+	27, // 61: stock_trading.user_service.UserService.EraseMyData:output_type -> stock_trading.user_service.EraseMyDataResponse
+	43, // 62: stock_trading.user_service.UserService.GetLoginHistory:output_type -> stock_trading.user_service.GetLoginHistoryResponse
+	19, // 63: stock_trading.user_service.UserService.CreateAvatarUploadUrl:output_type -> stock_trading.user_service.CreateAvatarUploadUrlResponse
+	21, // 64: stock_trading.user_service.UserService.UploadAvatar:output_type -> stock_trading.user_service.UploadAvatarResponse
+	46, // 65: stock_trading.user_service.UserService.ListAuditEvents:output_type -> stock_trading.user_service.ListAuditEventsResponse
+	44, // [44:66] is the sub-list for method output_type
+	22, // [22:44] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_user_user_proto_init() }
@@ -1718,7 +3865,7 @@ func file_user_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_user_proto_rawDesc), len(file_user_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
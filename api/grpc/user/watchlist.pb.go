@@ -0,0 +1,1238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: user/watchlist.proto
+
+package user
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListWatchlistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistsRequest) Reset() {
+	*x = ListWatchlistsRequest{}
+	mi := &file_user_watchlist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistsRequest) ProtoMessage() {}
+
+func (x *ListWatchlistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistsRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchlistsRequest) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListWatchlistsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ListWatchlistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*Watchlist           `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistsResponse) Reset() {
+	*x = ListWatchlistsResponse{}
+	mi := &file_user_watchlist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistsResponse) ProtoMessage() {}
+
+func (x *ListWatchlistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistsResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchlistsResponse) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListWatchlistsResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListWatchlistsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListWatchlistsResponse) GetData() []*Watchlist {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWatchlistRequest) Reset() {
+	*x = CreateWatchlistRequest{}
+	mi := &file_user_watchlist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWatchlistRequest) ProtoMessage() {}
+
+func (x *CreateWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*CreateWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateWatchlistRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateWatchlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Watchlist             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWatchlistResponse) Reset() {
+	*x = CreateWatchlistResponse{}
+	mi := &file_user_watchlist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWatchlistResponse) ProtoMessage() {}
+
+func (x *CreateWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*CreateWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateWatchlistResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateWatchlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateWatchlistResponse) GetData() *Watchlist {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RenameWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	WatchlistId   int64                  `protobuf:"varint,2,opt,name=watchlist_id,json=watchlistId,proto3" json:"watchlist_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameWatchlistRequest) Reset() {
+	*x = RenameWatchlistRequest{}
+	mi := &file_user_watchlist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWatchlistRequest) ProtoMessage() {}
+
+func (x *RenameWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*RenameWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RenameWatchlistRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RenameWatchlistRequest) GetWatchlistId() int64 {
+	if x != nil {
+		return x.WatchlistId
+	}
+	return 0
+}
+
+func (x *RenameWatchlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Watchlist             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameWatchlistResponse) Reset() {
+	*x = RenameWatchlistResponse{}
+	mi := &file_user_watchlist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWatchlistResponse) ProtoMessage() {}
+
+func (x *RenameWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*RenameWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RenameWatchlistResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RenameWatchlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RenameWatchlistResponse) GetData() *Watchlist {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	WatchlistId   int64                  `protobuf:"varint,2,opt,name=watchlist_id,json=watchlistId,proto3" json:"watchlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWatchlistRequest) Reset() {
+	*x = DeleteWatchlistRequest{}
+	mi := &file_user_watchlist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWatchlistRequest) ProtoMessage() {}
+
+func (x *DeleteWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteWatchlistRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeleteWatchlistRequest) GetWatchlistId() int64 {
+	if x != nil {
+		return x.WatchlistId
+	}
+	return 0
+}
+
+type DeleteWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWatchlistResponse) Reset() {
+	*x = DeleteWatchlistResponse{}
+	mi := &file_user_watchlist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWatchlistResponse) ProtoMessage() {}
+
+func (x *DeleteWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteWatchlistResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteWatchlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddWatchlistStockRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Username    string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	WatchlistId int64                  `protobuf:"varint,2,opt,name=watchlist_id,json=watchlistId,proto3" json:"watchlist_id,omitempty"`
+	// Stock code such as VNM.
+	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatchlistStockRequest) Reset() {
+	*x = AddWatchlistStockRequest{}
+	mi := &file_user_watchlist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatchlistStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchlistStockRequest) ProtoMessage() {}
+
+func (x *AddWatchlistStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchlistStockRequest.ProtoReflect.Descriptor instead.
+func (*AddWatchlistStockRequest) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddWatchlistStockRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddWatchlistStockRequest) GetWatchlistId() int64 {
+	if x != nil {
+		return x.WatchlistId
+	}
+	return 0
+}
+
+func (x *AddWatchlistStockRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type AddWatchlistStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Watchlist             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWatchlistStockResponse) Reset() {
+	*x = AddWatchlistStockResponse{}
+	mi := &file_user_watchlist_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWatchlistStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchlistStockResponse) ProtoMessage() {}
+
+func (x *AddWatchlistStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchlistStockResponse.ProtoReflect.Descriptor instead.
+func (*AddWatchlistStockResponse) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddWatchlistStockResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AddWatchlistStockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddWatchlistStockResponse) GetData() *Watchlist {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RemoveWatchlistStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	WatchlistId   int64                  `protobuf:"varint,2,opt,name=watchlist_id,json=watchlistId,proto3" json:"watchlist_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatchlistStockRequest) Reset() {
+	*x = RemoveWatchlistStockRequest{}
+	mi := &file_user_watchlist_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatchlistStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchlistStockRequest) ProtoMessage() {}
+
+func (x *RemoveWatchlistStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchlistStockRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWatchlistStockRequest) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RemoveWatchlistStockRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RemoveWatchlistStockRequest) GetWatchlistId() int64 {
+	if x != nil {
+		return x.WatchlistId
+	}
+	return 0
+}
+
+func (x *RemoveWatchlistStockRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RemoveWatchlistStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Watchlist             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWatchlistStockResponse) Reset() {
+	*x = RemoveWatchlistStockResponse{}
+	mi := &file_user_watchlist_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWatchlistStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchlistStockResponse) ProtoMessage() {}
+
+func (x *RemoveWatchlistStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchlistStockResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWatchlistStockResponse) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveWatchlistStockResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RemoveWatchlistStockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RemoveWatchlistStockResponse) GetData() *Watchlist {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReorderWatchlistRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Username    string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	WatchlistId int64                  `protobuf:"varint,2,opt,name=watchlist_id,json=watchlistId,proto3" json:"watchlist_id,omitempty"`
+	// Every code on the watchlist in the new order.
+	Codes         []string `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderWatchlistRequest) Reset() {
+	*x = ReorderWatchlistRequest{}
+	mi := &file_user_watchlist_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWatchlistRequest) ProtoMessage() {}
+
+func (x *ReorderWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*ReorderWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReorderWatchlistRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReorderWatchlistRequest) GetWatchlistId() int64 {
+	if x != nil {
+		return x.WatchlistId
+	}
+	return 0
+}
+
+func (x *ReorderWatchlistRequest) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type ReorderWatchlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          uint32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Watchlist             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderWatchlistResponse) Reset() {
+	*x = ReorderWatchlistResponse{}
+	mi := &file_user_watchlist_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWatchlistResponse) ProtoMessage() {}
+
+func (x *ReorderWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*ReorderWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReorderWatchlistResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReorderWatchlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReorderWatchlistResponse) GetData() *Watchlist {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WatchWatchlistQuotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	WatchlistId   int64                  `protobuf:"varint,2,opt,name=watchlist_id,json=watchlistId,proto3" json:"watchlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchWatchlistQuotesRequest) Reset() {
+	*x = WatchWatchlistQuotesRequest{}
+	mi := &file_user_watchlist_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchWatchlistQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWatchlistQuotesRequest) ProtoMessage() {}
+
+func (x *WatchWatchlistQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWatchlistQuotesRequest.ProtoReflect.Descriptor instead.
+func (*WatchWatchlistQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchWatchlistQuotesRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *WatchWatchlistQuotesRequest) GetWatchlistId() int64 {
+	if x != nil {
+		return x.WatchlistId
+	}
+	return 0
+}
+
+type WatchWatchlistQuotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Quotes that are new or changed since the previous message; the first
+	// message holds every known quote.
+	Quotes        []*StockQuote `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchWatchlistQuotesResponse) Reset() {
+	*x = WatchWatchlistQuotesResponse{}
+	mi := &file_user_watchlist_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchWatchlistQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWatchlistQuotesResponse) ProtoMessage() {}
+
+func (x *WatchWatchlistQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWatchlistQuotesResponse.ProtoReflect.Descriptor instead.
+func (*WatchWatchlistQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchWatchlistQuotesResponse) GetQuotes() []*StockQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+type Watchlist struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Stock codes in the user's order.
+	Codes         []string `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes,omitempty"`
+	CreatedAt     int64    `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64    `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Watchlist) Reset() {
+	*x = Watchlist{}
+	mi := &file_user_watchlist_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Watchlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Watchlist) ProtoMessage() {}
+
+func (x *Watchlist) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Watchlist.ProtoReflect.Descriptor instead.
+func (*Watchlist) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Watchlist) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Watchlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Watchlist) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *Watchlist) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Watchlist) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type StockQuote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Latest price in VND.
+	Price int64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Unix time of the price.
+	At            int64 `protobuf:"varint,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockQuote) Reset() {
+	*x = StockQuote{}
+	mi := &file_user_watchlist_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockQuote) ProtoMessage() {}
+
+func (x *StockQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_user_watchlist_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockQuote.ProtoReflect.Descriptor instead.
+func (*StockQuote) Descriptor() ([]byte, []int) {
+	return file_user_watchlist_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StockQuote) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StockQuote) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StockQuote) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+var File_user_watchlist_proto protoreflect.FileDescriptor
+
+const file_user_watchlist_proto_rawDesc = "" +
+	"\n" +
+	"\x14user/watchlist.proto\x12\x1astock_trading.user_service\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\">\n" +
+	"\x15ListWatchlistsRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\"\x81\x01\n" +
+	"\x16ListWatchlistsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x04data\x18\x03 \x03(\v2%.stock_trading.user_service.WatchlistR\x04data\"^\n" +
+	"\x16CreateWatchlistRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\"\x82\x01\n" +
+	"\x17CreateWatchlistResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x04data\x18\x03 \x01(\v2%.stock_trading.user_service.WatchlistR\x04data\"\x8a\x01\n" +
+	"\x16RenameWatchlistRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12*\n" +
+	"\fwatchlist_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vwatchlistId\x12\x1d\n" +
+	"\x04name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\"\x82\x01\n" +
+	"\x17RenameWatchlistResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x04data\x18\x03 \x01(\v2%.stock_trading.user_service.WatchlistR\x04data\"k\n" +
+	"\x16DeleteWatchlistRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12*\n" +
+	"\fwatchlist_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vwatchlistId\"G\n" +
+	"\x17DeleteWatchlistResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8c\x01\n" +
+	"\x18AddWatchlistStockRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12*\n" +
+	"\fwatchlist_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vwatchlistId\x12\x1d\n" +
+	"\x04code\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\n" +
+	"R\x04code\"\x84\x01\n" +
+	"\x19AddWatchlistStockResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x04data\x18\x03 \x01(\v2%.stock_trading.user_service.WatchlistR\x04data\"\x8f\x01\n" +
+	"\x1bRemoveWatchlistStockRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12*\n" +
+	"\fwatchlist_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vwatchlistId\x12\x1d\n" +
+	"\x04code\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\n" +
+	"R\x04code\"\x87\x01\n" +
+	"\x1cRemoveWatchlistStockResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x04data\x18\x03 \x01(\v2%.stock_trading.user_service.WatchlistR\x04data\"\x8c\x01\n" +
+	"\x17ReorderWatchlistRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12*\n" +
+	"\fwatchlist_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vwatchlistId\x12\x1e\n" +
+	"\x05codes\x18\x03 \x03(\tB\b\xfaB\x05\x92\x01\x02\x102R\x05codes\"\x83\x01\n" +
+	"\x18ReorderWatchlistResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\rR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x04data\x18\x03 \x01(\v2%.stock_trading.user_service.WatchlistR\x04data\"p\n" +
+	"\x1bWatchWatchlistQuotesRequest\x12%\n" +
+	"\busername\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\busername\x12*\n" +
+	"\fwatchlist_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vwatchlistId\"^\n" +
+	"\x1cWatchWatchlistQuotesResponse\x12>\n" +
+	"\x06quotes\x18\x01 \x03(\v2&.stock_trading.user_service.StockQuoteR\x06quotes\"\x83\x01\n" +
+	"\tWatchlist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05codes\x18\x03 \x03(\tR\x05codes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"F\n" +
+	"\n" +
+	"StockQuote\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x03R\x05price\x12\x0e\n" +
+	"\x02at\x18\x03 \x01(\x03R\x02at2\x89\f\n" +
+	"\x10WatchlistService\x12\xa3\x01\n" +
+	"\x0eListWatchlists\x121.stock_trading.user_service.ListWatchlistsRequest\x1a2.stock_trading.user_service.ListWatchlistsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/user/{username}/watchlists\x12\xa9\x01\n" +
+	"\x0fCreateWatchlist\x122.stock_trading.user_service.CreateWatchlistRequest\x1a3.stock_trading.user_service.CreateWatchlistResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/user/{username}/watchlists\x12\xb8\x01\n" +
+	"\x0fRenameWatchlist\x122.stock_trading.user_service.RenameWatchlistRequest\x1a3.stock_trading.user_service.RenameWatchlistResponse\"<\x82\xd3\xe4\x93\x026:\x01*21/api/v1/user/{username}/watchlists/{watchlist_id}\x12\xb5\x01\n" +
+	"\x0fDeleteWatchlist\x122.stock_trading.user_service.DeleteWatchlistRequest\x1a3.stock_trading.user_service.DeleteWatchlistResponse\"9\x82\xd3\xe4\x93\x023*1/api/v1/user/{username}/watchlists/{watchlist_id}\x12\xc5\x01\n" +
+	"\x11AddWatchlistStock\x124.stock_trading.user_service.AddWatchlistStockRequest\x1a5.stock_trading.user_service.AddWatchlistStockResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/api/v1/user/{username}/watchlists/{watchlist_id}/stocks\x12\xd2\x01\n" +
+	"\x14RemoveWatchlistStock\x127.stock_trading.user_service.RemoveWatchlistStockRequest\x1a8.stock_trading.user_service.RemoveWatchlistStockResponse\"G\x82\xd3\xe4\x93\x02A*?/api/v1/user/{username}/watchlists/{watchlist_id}/stocks/{code}\x12\xc2\x01\n" +
+	"\x10ReorderWatchlist\x123.stock_trading.user_service.ReorderWatchlistRequest\x1a4.stock_trading.user_service.ReorderWatchlistResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\x1a8/api/v1/user/{username}/watchlists/{watchlist_id}/stocks\x12\xcd\x01\n" +
+	"\x14WatchWatchlistQuotes\x127.stock_trading.user_service.WatchWatchlistQuotesRequest\x1a8.stock_trading.user_service.WatchWatchlistQuotesResponse\"@\x82\xd3\xe4\x93\x02:\x128/api/v1/user/{username}/watchlists/{watchlist_id}/quotes0\x01B\xe6\x01\n" +
+	"\x1ecom.stock_trading.user_serviceB\x0eWatchlistProtoP\x01Z3github.com/sinhnguyen1411/stock-trading-be/api/user\xa2\x02\x03SUX\xaa\x02\x18StockTrading.UserService\xca\x02\x18StockTrading\\UserService\xe2\x02$StockTrading\\UserService\\GPBMetadata\xea\x02\x19StockTrading::UserServiceb\x06proto3"
+
+var (
+	file_user_watchlist_proto_rawDescOnce sync.Once
+	file_user_watchlist_proto_rawDescData []byte
+)
+
+func file_user_watchlist_proto_rawDescGZIP() []byte {
+	file_user_watchlist_proto_rawDescOnce.Do(func() {
+		file_user_watchlist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_watchlist_proto_rawDesc), len(file_user_watchlist_proto_rawDesc)))
+	})
+	return file_user_watchlist_proto_rawDescData
+}
+
+var file_user_watchlist_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_user_watchlist_proto_goTypes = []any{
+	(*ListWatchlistsRequest)(nil),        // 0: stock_trading.user_service.ListWatchlistsRequest
+	(*ListWatchlistsResponse)(nil),       // 1: stock_trading.user_service.ListWatchlistsResponse
+	(*CreateWatchlistRequest)(nil),       // 2: stock_trading.user_service.CreateWatchlistRequest
+	(*CreateWatchlistResponse)(nil),      // 3: stock_trading.user_service.CreateWatchlistResponse
+	(*RenameWatchlistRequest)(nil),       // 4: stock_trading.user_service.RenameWatchlistRequest
+	(*RenameWatchlistResponse)(nil),      // 5: stock_trading.user_service.RenameWatchlistResponse
+	(*DeleteWatchlistRequest)(nil),       // 6: stock_trading.user_service.DeleteWatchlistRequest
+	(*DeleteWatchlistResponse)(nil),      // 7: stock_trading.user_service.DeleteWatchlistResponse
+	(*AddWatchlistStockRequest)(nil),     // 8: stock_trading.user_service.AddWatchlistStockRequest
+	(*AddWatchlistStockResponse)(nil),    // 9: stock_trading.user_service.AddWatchlistStockResponse
+	(*RemoveWatchlistStockRequest)(nil),  // 10: stock_trading.user_service.RemoveWatchlistStockRequest
+	(*RemoveWatchlistStockResponse)(nil), // 11: stock_trading.user_service.RemoveWatchlistStockResponse
+	(*ReorderWatchlistRequest)(nil),      // 12: stock_trading.user_service.ReorderWatchlistRequest
+	(*ReorderWatchlistResponse)(nil),     // 13: stock_trading.user_service.ReorderWatchlistResponse
+	(*WatchWatchlistQuotesRequest)(nil),  // 14: stock_trading.user_service.WatchWatchlistQuotesRequest
+	(*WatchWatchlistQuotesResponse)(nil), // 15: stock_trading.user_service.WatchWatchlistQuotesResponse
+	(*Watchlist)(nil),                    // 16: stock_trading.user_service.Watchlist
+	(*StockQuote)(nil),                   // 17: stock_trading.user_service.StockQuote
+}
+var file_user_watchlist_proto_depIdxs = []int32{
+	16, // 0: stock_trading.user_service.ListWatchlistsResponse.data:type_name -> stock_trading.user_service.Watchlist
+	16, // 1: stock_trading.user_service.CreateWatchlistResponse.data:type_name -> stock_trading.user_service.Watchlist
+	16, // 2: stock_trading.user_service.RenameWatchlistResponse.data:type_name -> stock_trading.user_service.Watchlist
+	16, // 3: stock_trading.user_service.AddWatchlistStockResponse.data:type_name -> stock_trading.user_service.Watchlist
+	16, // 4: stock_trading.user_service.RemoveWatchlistStockResponse.data:type_name -> stock_trading.user_service.Watchlist
+	16, // 5: stock_trading.user_service.ReorderWatchlistResponse.data:type_name -> stock_trading.user_service.Watchlist
+	17, // 6: stock_trading.user_service.WatchWatchlistQuotesResponse.quotes:type_name -> stock_trading.user_service.StockQuote
+	0,  // 7: stock_trading.user_service.WatchlistService.ListWatchlists:input_type -> stock_trading.user_service.ListWatchlistsRequest
+	2,  // 8: stock_trading.user_service.WatchlistService.CreateWatchlist:input_type -> stock_trading.user_service.CreateWatchlistRequest
+	4,  // 9: stock_trading.user_service.WatchlistService.RenameWatchlist:input_type -> stock_trading.user_service.RenameWatchlistRequest
+	6,  // 10: stock_trading.user_service.WatchlistService.DeleteWatchlist:input_type -> stock_trading.user_service.DeleteWatchlistRequest
+	8,  // 11: stock_trading.user_service.WatchlistService.AddWatchlistStock:input_type -> stock_trading.user_service.AddWatchlistStockRequest
+	10, // 12: stock_trading.user_service.WatchlistService.RemoveWatchlistStock:input_type -> stock_trading.user_service.RemoveWatchlistStockRequest
+	12, // 13: stock_trading.user_service.WatchlistService.ReorderWatchlist:input_type -> stock_trading.user_service.ReorderWatchlistRequest
+	14, // 14: stock_trading.user_service.WatchlistService.WatchWatchlistQuotes:input_type -> stock_trading.user_service.WatchWatchlistQuotesRequest
+	1,  // 15: stock_trading.user_service.WatchlistService.ListWatchlists:output_type -> stock_trading.user_service.ListWatchlistsResponse
+	3,  // 16: stock_trading.user_service.WatchlistService.CreateWatchlist:output_type -> stock_trading.user_service.CreateWatchlistResponse
+	5,  // 17: stock_trading.user_service.WatchlistService.RenameWatchlist:output_type -> stock_trading.user_service.RenameWatchlistResponse
+	7,  // 18: stock_trading.user_service.WatchlistService.DeleteWatchlist:output_type -> stock_trading.user_service.DeleteWatchlistResponse
+	9,  // 19: stock_trading.user_service.WatchlistService.AddWatchlistStock:output_type -> stock_trading.user_service.AddWatchlistStockResponse
+	11, // 20: stock_trading.user_service.WatchlistService.RemoveWatchlistStock:output_type -> stock_trading.user_service.RemoveWatchlistStockResponse
+	13, // 21: stock_trading.user_service.WatchlistService.ReorderWatchlist:output_type -> stock_trading.user_service.ReorderWatchlistResponse
+	15, // 22: stock_trading.user_service.WatchlistService.WatchWatchlistQuotes:output_type -> stock_trading.user_service.WatchWatchlistQuotesResponse
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_user_watchlist_proto_init() }
+func file_user_watchlist_proto_init() {
+	if File_user_watchlist_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_watchlist_proto_rawDesc), len(file_user_watchlist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_watchlist_proto_goTypes,
+		DependencyIndexes: file_user_watchlist_proto_depIdxs,
+		MessageInfos:      file_user_watchlist_proto_msgTypes,
+	}.Build()
+	File_user_watchlist_proto = out.File
+	file_user_watchlist_proto_goTypes = nil
+	file_user_watchlist_proto_depIdxs = nil
+}
@@ -0,0 +1,2334 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: user/watchlist.proto
+
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListWatchlistsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWatchlistsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWatchlistsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWatchlistsRequestMultiError, or nil if none found.
+func (m *ListWatchlistsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWatchlistsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := ListWatchlistsRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWatchlistsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWatchlistsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWatchlistsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWatchlistsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWatchlistsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWatchlistsRequestMultiError) AllErrors() []error { return m }
+
+// ListWatchlistsRequestValidationError is the validation error returned by
+// ListWatchlistsRequest.Validate if the designated constraints aren't met.
+type ListWatchlistsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWatchlistsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWatchlistsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWatchlistsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWatchlistsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWatchlistsRequestValidationError) ErrorName() string {
+	return "ListWatchlistsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWatchlistsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWatchlistsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWatchlistsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWatchlistsRequestValidationError{}
+
+// Validate checks the field values on ListWatchlistsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWatchlistsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWatchlistsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWatchlistsResponseMultiError, or nil if none found.
+func (m *ListWatchlistsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWatchlistsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWatchlistsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWatchlistsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWatchlistsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWatchlistsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWatchlistsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWatchlistsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWatchlistsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWatchlistsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWatchlistsResponseMultiError) AllErrors() []error { return m }
+
+// ListWatchlistsResponseValidationError is the validation error returned by
+// ListWatchlistsResponse.Validate if the designated constraints aren't met.
+type ListWatchlistsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWatchlistsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWatchlistsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWatchlistsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWatchlistsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWatchlistsResponseValidationError) ErrorName() string {
+	return "ListWatchlistsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWatchlistsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWatchlistsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWatchlistsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWatchlistsResponseValidationError{}
+
+// Validate checks the field values on CreateWatchlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWatchlistRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWatchlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWatchlistRequestMultiError, or nil if none found.
+func (m *CreateWatchlistRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWatchlistRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := CreateWatchlistRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateWatchlistRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateWatchlistRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWatchlistRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWatchlistRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWatchlistRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWatchlistRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWatchlistRequestMultiError) AllErrors() []error { return m }
+
+// CreateWatchlistRequestValidationError is the validation error returned by
+// CreateWatchlistRequest.Validate if the designated constraints aren't met.
+type CreateWatchlistRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWatchlistRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWatchlistRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWatchlistRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWatchlistRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWatchlistRequestValidationError) ErrorName() string {
+	return "CreateWatchlistRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWatchlistRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWatchlistRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWatchlistRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWatchlistRequestValidationError{}
+
+// Validate checks the field values on CreateWatchlistResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWatchlistResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWatchlistResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWatchlistResponseMultiError, or nil if none found.
+func (m *CreateWatchlistResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWatchlistResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWatchlistResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWatchlistResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWatchlistResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateWatchlistResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWatchlistResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWatchlistResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWatchlistResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWatchlistResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWatchlistResponseMultiError) AllErrors() []error { return m }
+
+// CreateWatchlistResponseValidationError is the validation error returned by
+// CreateWatchlistResponse.Validate if the designated constraints aren't met.
+type CreateWatchlistResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWatchlistResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWatchlistResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWatchlistResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWatchlistResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWatchlistResponseValidationError) ErrorName() string {
+	return "CreateWatchlistResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWatchlistResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWatchlistResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWatchlistResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWatchlistResponseValidationError{}
+
+// Validate checks the field values on RenameWatchlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameWatchlistRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameWatchlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameWatchlistRequestMultiError, or nil if none found.
+func (m *RenameWatchlistRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameWatchlistRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := RenameWatchlistRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWatchlistId() <= 0 {
+		err := RenameWatchlistRequestValidationError{
+			field:  "WatchlistId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := RenameWatchlistRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameWatchlistRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameWatchlistRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameWatchlistRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameWatchlistRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameWatchlistRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameWatchlistRequestMultiError) AllErrors() []error { return m }
+
+// RenameWatchlistRequestValidationError is the validation error returned by
+// RenameWatchlistRequest.Validate if the designated constraints aren't met.
+type RenameWatchlistRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameWatchlistRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameWatchlistRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameWatchlistRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameWatchlistRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameWatchlistRequestValidationError) ErrorName() string {
+	return "RenameWatchlistRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameWatchlistRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameWatchlistRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameWatchlistRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameWatchlistRequestValidationError{}
+
+// Validate checks the field values on RenameWatchlistResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameWatchlistResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameWatchlistResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameWatchlistResponseMultiError, or nil if none found.
+func (m *RenameWatchlistResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameWatchlistResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RenameWatchlistResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RenameWatchlistResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RenameWatchlistResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RenameWatchlistResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameWatchlistResponseMultiError is an error wrapping multiple validation
+// errors returned by RenameWatchlistResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenameWatchlistResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameWatchlistResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameWatchlistResponseMultiError) AllErrors() []error { return m }
+
+// RenameWatchlistResponseValidationError is the validation error returned by
+// RenameWatchlistResponse.Validate if the designated constraints aren't met.
+type RenameWatchlistResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameWatchlistResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameWatchlistResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameWatchlistResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameWatchlistResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameWatchlistResponseValidationError) ErrorName() string {
+	return "RenameWatchlistResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameWatchlistResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameWatchlistResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameWatchlistResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameWatchlistResponseValidationError{}
+
+// Validate checks the field values on DeleteWatchlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWatchlistRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWatchlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWatchlistRequestMultiError, or nil if none found.
+func (m *DeleteWatchlistRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWatchlistRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := DeleteWatchlistRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWatchlistId() <= 0 {
+		err := DeleteWatchlistRequestValidationError{
+			field:  "WatchlistId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteWatchlistRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWatchlistRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWatchlistRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWatchlistRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWatchlistRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWatchlistRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWatchlistRequestValidationError is the validation error returned by
+// DeleteWatchlistRequest.Validate if the designated constraints aren't met.
+type DeleteWatchlistRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWatchlistRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWatchlistRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWatchlistRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWatchlistRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWatchlistRequestValidationError) ErrorName() string {
+	return "DeleteWatchlistRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWatchlistRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWatchlistRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWatchlistRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWatchlistRequestValidationError{}
+
+// Validate checks the field values on DeleteWatchlistResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWatchlistResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWatchlistResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWatchlistResponseMultiError, or nil if none found.
+func (m *DeleteWatchlistResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWatchlistResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteWatchlistResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWatchlistResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWatchlistResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWatchlistResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWatchlistResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWatchlistResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWatchlistResponseValidationError is the validation error returned by
+// DeleteWatchlistResponse.Validate if the designated constraints aren't met.
+type DeleteWatchlistResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWatchlistResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWatchlistResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWatchlistResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWatchlistResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWatchlistResponseValidationError) ErrorName() string {
+	return "DeleteWatchlistResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWatchlistResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWatchlistResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWatchlistResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWatchlistResponseValidationError{}
+
+// Validate checks the field values on AddWatchlistStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddWatchlistStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddWatchlistStockRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddWatchlistStockRequestMultiError, or nil if none found.
+func (m *AddWatchlistStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddWatchlistStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := AddWatchlistStockRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWatchlistId() <= 0 {
+		err := AddWatchlistStockRequestValidationError{
+			field:  "WatchlistId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 10 {
+		err := AddWatchlistStockRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddWatchlistStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddWatchlistStockRequestMultiError is an error wrapping multiple validation
+// errors returned by AddWatchlistStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddWatchlistStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddWatchlistStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddWatchlistStockRequestMultiError) AllErrors() []error { return m }
+
+// AddWatchlistStockRequestValidationError is the validation error returned by
+// AddWatchlistStockRequest.Validate if the designated constraints aren't met.
+type AddWatchlistStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddWatchlistStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddWatchlistStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddWatchlistStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddWatchlistStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddWatchlistStockRequestValidationError) ErrorName() string {
+	return "AddWatchlistStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddWatchlistStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddWatchlistStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddWatchlistStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddWatchlistStockRequestValidationError{}
+
+// Validate checks the field values on AddWatchlistStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddWatchlistStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddWatchlistStockResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddWatchlistStockResponseMultiError, or nil if none found.
+func (m *AddWatchlistStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddWatchlistStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddWatchlistStockResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddWatchlistStockResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddWatchlistStockResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddWatchlistStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddWatchlistStockResponseMultiError is an error wrapping multiple validation
+// errors returned by AddWatchlistStockResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AddWatchlistStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddWatchlistStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddWatchlistStockResponseMultiError) AllErrors() []error { return m }
+
+// AddWatchlistStockResponseValidationError is the validation error returned by
+// AddWatchlistStockResponse.Validate if the designated constraints aren't met.
+type AddWatchlistStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddWatchlistStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddWatchlistStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddWatchlistStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddWatchlistStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddWatchlistStockResponseValidationError) ErrorName() string {
+	return "AddWatchlistStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddWatchlistStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddWatchlistStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddWatchlistStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddWatchlistStockResponseValidationError{}
+
+// Validate checks the field values on RemoveWatchlistStockRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveWatchlistStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveWatchlistStockRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveWatchlistStockRequestMultiError, or nil if none found.
+func (m *RemoveWatchlistStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveWatchlistStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := RemoveWatchlistStockRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWatchlistId() <= 0 {
+		err := RemoveWatchlistStockRequestValidationError{
+			field:  "WatchlistId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 10 {
+		err := RemoveWatchlistStockRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveWatchlistStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveWatchlistStockRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWatchlistStockRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveWatchlistStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveWatchlistStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveWatchlistStockRequestMultiError) AllErrors() []error { return m }
+
+// RemoveWatchlistStockRequestValidationError is the validation error returned
+// by RemoveWatchlistStockRequest.Validate if the designated constraints
+// aren't met.
+type RemoveWatchlistStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWatchlistStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWatchlistStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWatchlistStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWatchlistStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWatchlistStockRequestValidationError) ErrorName() string {
+	return "RemoveWatchlistStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWatchlistStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWatchlistStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWatchlistStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWatchlistStockRequestValidationError{}
+
+// Validate checks the field values on RemoveWatchlistStockResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveWatchlistStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveWatchlistStockResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveWatchlistStockResponseMultiError, or nil if none found.
+func (m *RemoveWatchlistStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveWatchlistStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveWatchlistStockResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveWatchlistStockResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveWatchlistStockResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveWatchlistStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveWatchlistStockResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWatchlistStockResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveWatchlistStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveWatchlistStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveWatchlistStockResponseMultiError) AllErrors() []error { return m }
+
+// RemoveWatchlistStockResponseValidationError is the validation error returned
+// by RemoveWatchlistStockResponse.Validate if the designated constraints
+// aren't met.
+type RemoveWatchlistStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWatchlistStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWatchlistStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWatchlistStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWatchlistStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWatchlistStockResponseValidationError) ErrorName() string {
+	return "RemoveWatchlistStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWatchlistStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWatchlistStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWatchlistStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWatchlistStockResponseValidationError{}
+
+// Validate checks the field values on ReorderWatchlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderWatchlistRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderWatchlistRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderWatchlistRequestMultiError, or nil if none found.
+func (m *ReorderWatchlistRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderWatchlistRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := ReorderWatchlistRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWatchlistId() <= 0 {
+		err := ReorderWatchlistRequestValidationError{
+			field:  "WatchlistId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCodes()) > 50 {
+		err := ReorderWatchlistRequestValidationError{
+			field:  "Codes",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReorderWatchlistRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderWatchlistRequestMultiError is an error wrapping multiple validation
+// errors returned by ReorderWatchlistRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReorderWatchlistRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderWatchlistRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderWatchlistRequestMultiError) AllErrors() []error { return m }
+
+// ReorderWatchlistRequestValidationError is the validation error returned by
+// ReorderWatchlistRequest.Validate if the designated constraints aren't met.
+type ReorderWatchlistRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderWatchlistRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderWatchlistRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderWatchlistRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderWatchlistRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderWatchlistRequestValidationError) ErrorName() string {
+	return "ReorderWatchlistRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderWatchlistRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderWatchlistRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderWatchlistRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderWatchlistRequestValidationError{}
+
+// Validate checks the field values on ReorderWatchlistResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderWatchlistResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderWatchlistResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderWatchlistResponseMultiError, or nil if none found.
+func (m *ReorderWatchlistResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderWatchlistResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReorderWatchlistResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReorderWatchlistResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReorderWatchlistResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReorderWatchlistResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderWatchlistResponseMultiError is an error wrapping multiple validation
+// errors returned by ReorderWatchlistResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReorderWatchlistResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderWatchlistResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderWatchlistResponseMultiError) AllErrors() []error { return m }
+
+// ReorderWatchlistResponseValidationError is the validation error returned by
+// ReorderWatchlistResponse.Validate if the designated constraints aren't met.
+type ReorderWatchlistResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderWatchlistResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderWatchlistResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderWatchlistResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderWatchlistResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderWatchlistResponseValidationError) ErrorName() string {
+	return "ReorderWatchlistResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderWatchlistResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderWatchlistResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderWatchlistResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderWatchlistResponseValidationError{}
+
+// Validate checks the field values on WatchWatchlistQuotesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchWatchlistQuotesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchWatchlistQuotesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchWatchlistQuotesRequestMultiError, or nil if none found.
+func (m *WatchWatchlistQuotesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchWatchlistQuotesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+		err := WatchWatchlistQuotesRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWatchlistId() <= 0 {
+		err := WatchWatchlistQuotesRequestValidationError{
+			field:  "WatchlistId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchWatchlistQuotesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchWatchlistQuotesRequestMultiError is an error wrapping multiple
+// validation errors returned by WatchWatchlistQuotesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type WatchWatchlistQuotesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchWatchlistQuotesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchWatchlistQuotesRequestMultiError) AllErrors() []error { return m }
+
+// WatchWatchlistQuotesRequestValidationError is the validation error returned
+// by WatchWatchlistQuotesRequest.Validate if the designated constraints
+// aren't met.
+type WatchWatchlistQuotesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchWatchlistQuotesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchWatchlistQuotesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchWatchlistQuotesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchWatchlistQuotesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchWatchlistQuotesRequestValidationError) ErrorName() string {
+	return "WatchWatchlistQuotesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchWatchlistQuotesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchWatchlistQuotesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchWatchlistQuotesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchWatchlistQuotesRequestValidationError{}
+
+// Validate checks the field values on WatchWatchlistQuotesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchWatchlistQuotesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchWatchlistQuotesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchWatchlistQuotesResponseMultiError, or nil if none found.
+func (m *WatchWatchlistQuotesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchWatchlistQuotesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQuotes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchWatchlistQuotesResponseValidationError{
+						field:  fmt.Sprintf("Quotes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchWatchlistQuotesResponseValidationError{
+						field:  fmt.Sprintf("Quotes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchWatchlistQuotesResponseValidationError{
+					field:  fmt.Sprintf("Quotes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchWatchlistQuotesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchWatchlistQuotesResponseMultiError is an error wrapping multiple
+// validation errors returned by WatchWatchlistQuotesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type WatchWatchlistQuotesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchWatchlistQuotesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchWatchlistQuotesResponseMultiError) AllErrors() []error { return m }
+
+// WatchWatchlistQuotesResponseValidationError is the validation error returned
+// by WatchWatchlistQuotesResponse.Validate if the designated constraints
+// aren't met.
+type WatchWatchlistQuotesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchWatchlistQuotesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchWatchlistQuotesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchWatchlistQuotesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchWatchlistQuotesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchWatchlistQuotesResponseValidationError) ErrorName() string {
+	return "WatchWatchlistQuotesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchWatchlistQuotesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchWatchlistQuotesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchWatchlistQuotesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchWatchlistQuotesResponseValidationError{}
+
+// Validate checks the field values on Watchlist with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Watchlist) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Watchlist with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchlistMultiError, or nil
+// if none found.
+func (m *Watchlist) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Watchlist) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return WatchlistMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchlistMultiError is an error wrapping multiple validation errors returned
+// by Watchlist.ValidateAll() if the designated constraints aren't met.
+type WatchlistMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchlistMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchlistMultiError) AllErrors() []error { return m }
+
+// WatchlistValidationError is the validation error returned by
+// Watchlist.Validate if the designated constraints aren't met.
+type WatchlistValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchlistValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchlistValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchlistValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchlistValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchlistValidationError) ErrorName() string { return "WatchlistValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchlistValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchlist.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchlistValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchlistValidationError{}
+
+// Validate checks the field values on StockQuote with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockQuote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockQuote with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockQuoteMultiError, or
+// nil if none found.
+func (m *StockQuote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockQuote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Price
+
+	// no validation rules for At
+
+	if len(errors) > 0 {
+		return StockQuoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockQuoteMultiError is an error wrapping multiple validation errors
+// returned by StockQuote.ValidateAll() if the designated constraints aren't met.
+type StockQuoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockQuoteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockQuoteMultiError) AllErrors() []error { return m }
+
+// StockQuoteValidationError is the validation error returned by
+// StockQuote.Validate if the designated constraints aren't met.
+type StockQuoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockQuoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockQuoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockQuoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockQuoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockQuoteValidationError) ErrorName() string { return "StockQuoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockQuoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockQuote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockQuoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockQuoteValidationError{}
@@ -0,0 +1,427 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: user/watchlist.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WatchlistService_ListWatchlists_FullMethodName       = "/stock_trading.user_service.WatchlistService/ListWatchlists"
+	WatchlistService_CreateWatchlist_FullMethodName      = "/stock_trading.user_service.WatchlistService/CreateWatchlist"
+	WatchlistService_RenameWatchlist_FullMethodName      = "/stock_trading.user_service.WatchlistService/RenameWatchlist"
+	WatchlistService_DeleteWatchlist_FullMethodName      = "/stock_trading.user_service.WatchlistService/DeleteWatchlist"
+	WatchlistService_AddWatchlistStock_FullMethodName    = "/stock_trading.user_service.WatchlistService/AddWatchlistStock"
+	WatchlistService_RemoveWatchlistStock_FullMethodName = "/stock_trading.user_service.WatchlistService/RemoveWatchlistStock"
+	WatchlistService_ReorderWatchlist_FullMethodName     = "/stock_trading.user_service.WatchlistService/ReorderWatchlist"
+	WatchlistService_WatchWatchlistQuotes_FullMethodName = "/stock_trading.user_service.WatchlistService/WatchWatchlistQuotes"
+)
+
+// WatchlistServiceClient is the client API for WatchlistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WatchlistService keeps named lists of stock codes per user. Codes must be
+// listed in the stocks catalog; they are upper-cased before use.
+type WatchlistServiceClient interface {
+	// ListWatchlists returns the caller's watchlists, oldest first.
+	ListWatchlists(ctx context.Context, in *ListWatchlistsRequest, opts ...grpc.CallOption) (*ListWatchlistsResponse, error)
+	// CreateWatchlist adds an empty watchlist. Names are unique per user,
+	// ignoring case; a user may keep 20 watchlists.
+	CreateWatchlist(ctx context.Context, in *CreateWatchlistRequest, opts ...grpc.CallOption) (*CreateWatchlistResponse, error)
+	// RenameWatchlist changes the name of a watchlist.
+	RenameWatchlist(ctx context.Context, in *RenameWatchlistRequest, opts ...grpc.CallOption) (*RenameWatchlistResponse, error)
+	// DeleteWatchlist removes a watchlist.
+	DeleteWatchlist(ctx context.Context, in *DeleteWatchlistRequest, opts ...grpc.CallOption) (*DeleteWatchlistResponse, error)
+	// AddWatchlistStock appends a stock code to the end of a watchlist, which
+	// holds at most 50 codes.
+	AddWatchlistStock(ctx context.Context, in *AddWatchlistStockRequest, opts ...grpc.CallOption) (*AddWatchlistStockResponse, error)
+	// RemoveWatchlistStock removes a stock code from a watchlist.
+	RemoveWatchlistStock(ctx context.Context, in *RemoveWatchlistStockRequest, opts ...grpc.CallOption) (*RemoveWatchlistStockResponse, error)
+	// ReorderWatchlist arranges the codes of a watchlist. The request must
+	// list every code on the watchlist exactly once.
+	ReorderWatchlist(ctx context.Context, in *ReorderWatchlistRequest, opts ...grpc.CallOption) (*ReorderWatchlistResponse, error)
+	// WatchWatchlistQuotes streams the latest price of every stock on a
+	// watchlist: first all known prices, then each change. Stocks added to the
+	// watchlist meanwhile are followed. The stream ends with NOT_FOUND when
+	// the watchlist is deleted. Over HTTP the messages arrive as
+	// newline-delimited JSON.
+	WatchWatchlistQuotes(ctx context.Context, in *WatchWatchlistQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchWatchlistQuotesResponse], error)
+}
+
+type watchlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchlistServiceClient(cc grpc.ClientConnInterface) WatchlistServiceClient {
+	return &watchlistServiceClient{cc}
+}
+
+func (c *watchlistServiceClient) ListWatchlists(ctx context.Context, in *ListWatchlistsRequest, opts ...grpc.CallOption) (*ListWatchlistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWatchlistsResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_ListWatchlists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) CreateWatchlist(ctx context.Context, in *CreateWatchlistRequest, opts ...grpc.CallOption) (*CreateWatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWatchlistResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_CreateWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) RenameWatchlist(ctx context.Context, in *RenameWatchlistRequest, opts ...grpc.CallOption) (*RenameWatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameWatchlistResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_RenameWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) DeleteWatchlist(ctx context.Context, in *DeleteWatchlistRequest, opts ...grpc.CallOption) (*DeleteWatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWatchlistResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_DeleteWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) AddWatchlistStock(ctx context.Context, in *AddWatchlistStockRequest, opts ...grpc.CallOption) (*AddWatchlistStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWatchlistStockResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_AddWatchlistStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) RemoveWatchlistStock(ctx context.Context, in *RemoveWatchlistStockRequest, opts ...grpc.CallOption) (*RemoveWatchlistStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWatchlistStockResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_RemoveWatchlistStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) ReorderWatchlist(ctx context.Context, in *ReorderWatchlistRequest, opts ...grpc.CallOption) (*ReorderWatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderWatchlistResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_ReorderWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) WatchWatchlistQuotes(ctx context.Context, in *WatchWatchlistQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchWatchlistQuotesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WatchlistService_ServiceDesc.Streams[0], WatchlistService_WatchWatchlistQuotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchWatchlistQuotesRequest, WatchWatchlistQuotesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WatchlistService_WatchWatchlistQuotesClient = grpc.ServerStreamingClient[WatchWatchlistQuotesResponse]
+
+// WatchlistServiceServer is the server API for WatchlistService service.
+// All implementations must embed UnimplementedWatchlistServiceServer
+// for forward compatibility.
+//
+// WatchlistService keeps named lists of stock codes per user. Codes must be
+// listed in the stocks catalog; they are upper-cased before use.
+type WatchlistServiceServer interface {
+	// ListWatchlists returns the caller's watchlists, oldest first.
+	ListWatchlists(context.Context, *ListWatchlistsRequest) (*ListWatchlistsResponse, error)
+	// CreateWatchlist adds an empty watchlist. Names are unique per user,
+	// ignoring case; a user may keep 20 watchlists.
+	CreateWatchlist(context.Context, *CreateWatchlistRequest) (*CreateWatchlistResponse, error)
+	// RenameWatchlist changes the name of a watchlist.
+	RenameWatchlist(context.Context, *RenameWatchlistRequest) (*RenameWatchlistResponse, error)
+	// DeleteWatchlist removes a watchlist.
+	DeleteWatchlist(context.Context, *DeleteWatchlistRequest) (*DeleteWatchlistResponse, error)
+	// AddWatchlistStock appends a stock code to the end of a watchlist, which
+	// holds at most 50 codes.
+	AddWatchlistStock(context.Context, *AddWatchlistStockRequest) (*AddWatchlistStockResponse, error)
+	// RemoveWatchlistStock removes a stock code from a watchlist.
+	RemoveWatchlistStock(context.Context, *RemoveWatchlistStockRequest) (*RemoveWatchlistStockResponse, error)
+	// ReorderWatchlist arranges the codes of a watchlist. The request must
+	// list every code on the watchlist exactly once.
+	ReorderWatchlist(context.Context, *ReorderWatchlistRequest) (*ReorderWatchlistResponse, error)
+	// WatchWatchlistQuotes streams the latest price of every stock on a
+	// watchlist: first all known prices, then each change. Stocks added to the
+	// watchlist meanwhile are followed. The stream ends with NOT_FOUND when
+	// the watchlist is deleted. Over HTTP the messages arrive as
+	// newline-delimited JSON.
+	WatchWatchlistQuotes(*WatchWatchlistQuotesRequest, grpc.ServerStreamingServer[WatchWatchlistQuotesResponse]) error
+	mustEmbedUnimplementedWatchlistServiceServer()
+}
+
+// UnimplementedWatchlistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWatchlistServiceServer struct{}
+
+func (UnimplementedWatchlistServiceServer) ListWatchlists(context.Context, *ListWatchlistsRequest) (*ListWatchlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchlists not implemented")
+}
+func (UnimplementedWatchlistServiceServer) CreateWatchlist(context.Context, *CreateWatchlistRequest) (*CreateWatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) RenameWatchlist(context.Context, *RenameWatchlistRequest) (*RenameWatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) DeleteWatchlist(context.Context, *DeleteWatchlistRequest) (*DeleteWatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) AddWatchlistStock(context.Context, *AddWatchlistStockRequest) (*AddWatchlistStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWatchlistStock not implemented")
+}
+func (UnimplementedWatchlistServiceServer) RemoveWatchlistStock(context.Context, *RemoveWatchlistStockRequest) (*RemoveWatchlistStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWatchlistStock not implemented")
+}
+func (UnimplementedWatchlistServiceServer) ReorderWatchlist(context.Context, *ReorderWatchlistRequest) (*ReorderWatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderWatchlist not implemented")
+}
+func (UnimplementedWatchlistServiceServer) WatchWatchlistQuotes(*WatchWatchlistQuotesRequest, grpc.ServerStreamingServer[WatchWatchlistQuotesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWatchlistQuotes not implemented")
+}
+func (UnimplementedWatchlistServiceServer) mustEmbedUnimplementedWatchlistServiceServer() {}
+func (UnimplementedWatchlistServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeWatchlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchlistServiceServer will
+// result in compilation errors.
+type UnsafeWatchlistServiceServer interface {
+	mustEmbedUnimplementedWatchlistServiceServer()
+}
+
+func RegisterWatchlistServiceServer(s grpc.ServiceRegistrar, srv WatchlistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWatchlistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WatchlistService_ServiceDesc, srv)
+}
+
+func _WatchlistService_ListWatchlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).ListWatchlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_ListWatchlists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).ListWatchlists(ctx, req.(*ListWatchlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_CreateWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).CreateWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_CreateWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).CreateWatchlist(ctx, req.(*CreateWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_RenameWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).RenameWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_RenameWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).RenameWatchlist(ctx, req.(*RenameWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_DeleteWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).DeleteWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_DeleteWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).DeleteWatchlist(ctx, req.(*DeleteWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_AddWatchlistStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWatchlistStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).AddWatchlistStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_AddWatchlistStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).AddWatchlistStock(ctx, req.(*AddWatchlistStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_RemoveWatchlistStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWatchlistStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).RemoveWatchlistStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_RemoveWatchlistStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).RemoveWatchlistStock(ctx, req.(*RemoveWatchlistStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_ReorderWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).ReorderWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_ReorderWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).ReorderWatchlist(ctx, req.(*ReorderWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_WatchWatchlistQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWatchlistQuotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchlistServiceServer).WatchWatchlistQuotes(m, &grpc.GenericServerStream[WatchWatchlistQuotesRequest, WatchWatchlistQuotesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WatchlistService_WatchWatchlistQuotesServer = grpc.ServerStreamingServer[WatchWatchlistQuotesResponse]
+
+// WatchlistService_ServiceDesc is the grpc.ServiceDesc for WatchlistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatchlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stock_trading.user_service.WatchlistService",
+	HandlerType: (*WatchlistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListWatchlists",
+			Handler:    _WatchlistService_ListWatchlists_Handler,
+		},
+		{
+			MethodName: "CreateWatchlist",
+			Handler:    _WatchlistService_CreateWatchlist_Handler,
+		},
+		{
+			MethodName: "RenameWatchlist",
+			Handler:    _WatchlistService_RenameWatchlist_Handler,
+		},
+		{
+			MethodName: "DeleteWatchlist",
+			Handler:    _WatchlistService_DeleteWatchlist_Handler,
+		},
+		{
+			MethodName: "AddWatchlistStock",
+			Handler:    _WatchlistService_AddWatchlistStock_Handler,
+		},
+		{
+			MethodName: "RemoveWatchlistStock",
+			Handler:    _WatchlistService_RemoveWatchlistStock_Handler,
+		},
+		{
+			MethodName: "ReorderWatchlist",
+			Handler:    _WatchlistService_ReorderWatchlist_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchWatchlistQuotes",
+			Handler:       _WatchlistService_WatchWatchlistQuotes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "user/watchlist.proto",
+}
@@ -21,6 +21,11 @@ func AppCommandLineInterface() *cli.App {
 
 	appCli.Commands = []*cli.Command{
 		server.StartServerCmd,
+		server.ReencryptPIICmd,
+		server.ImportNewsCmd,
+		server.SettleTradesCmd,
+		server.ApplyCorporateActionsCmd,
+		server.SnapshotPortfoliosCmd,
 	}
 
 	return appCli
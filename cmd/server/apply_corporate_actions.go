@@ -0,0 +1,72 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	usecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"github.com/urfave/cli/v2"
+)
+
+var ApplyCorporateActionsCmd = &cli.Command{
+	Name:   "apply-corporate-actions",
+	Usage:  "apply the dividends and splits whose ex-date has come",
+	Action: ApplyCorporateActionsAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "config",
+			Aliases:     []string{"c"},
+			Usage:       "Load configuration from file path`",
+			DefaultText: "./cmd/server/config/local.yaml",
+			Value:       "./cmd/server/config/local.yaml",
+			Required:    false,
+		},
+		&cli.StringFlag{
+			Name:  "date",
+			Usage: "day to apply actions through as YYYY-MM-DD in the market time zone; today when empty",
+		},
+	},
+}
+
+// ApplyCorporateActionsAction applies the corporate actions with an ex-date
+// on or before the day. It is meant to run once every day before the open,
+// so holders see their dividends and new shares and charts show adjusted
+// prices from the first trade of the ex-date; a missed run is caught up by
+// the next one.
+func ApplyCorporateActionsAction(cmdCLI *cli.Context) error {
+	cfgPath := cmdCLI.String("config")
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config from path\"%s\": %w", cfgPath, err)
+	}
+	calendar, err := buildTradingCalendar(cfg.Market)
+	if err != nil {
+		return fmt.Errorf("failed to build trading calendar: %w", err)
+	}
+	day := time.Now()
+	if date := cmdCLI.String("date"); date != "" {
+		if day, err = time.ParseInLocation(time.DateOnly, date, calendar.Location()); err != nil {
+			return fmt.Errorf("invalid date %q: %w", date, err)
+		}
+	}
+	// Holders' addresses are decrypted to notify them.
+	fields, err := buildFieldCipher(cfg.Encryption)
+	if err != nil {
+		return fmt.Errorf("failed to init field encryption: %w", err)
+	}
+	if err := database.ConnectDB(cfg.DB); err != nil {
+		return fmt.Errorf("failed to connect database: %w", err)
+	}
+	defer database.DB.Close()
+
+	repo := database.NewMysqlUserRepositoryWithCipher(database.DB, fields)
+	result, err := usecase.NewUserCorporateActionUseCase(repo, repo, calendar).ApplyDue(cmdCLI.Context, day)
+	if err != nil {
+		return err
+	}
+	slog.Info("CORPORATE ACTIONS APPLIED", "date", result.Date.Format(time.DateOnly), "actions", result.Applied, "holders", result.Holders)
+	return nil
+}
@@ -20,6 +20,13 @@ type Config struct {
     Auth         AuthConfig          `json:"auth" mapstructure:"auth"`
     Notification NotificationConfig  `json:"notification" mapstructure:"notification"`
     Verification VerificationConfig  `json:"verification" mapstructure:"verification"`
+    Account      AccountConfig       `json:"account" mapstructure:"account"`
+    Encryption   EncryptionConfig    `json:"encryption" mapstructure:"encryption"`
+    Blob         BlobConfig          `json:"blob" mapstructure:"blob"`
+    Market       MarketConfig        `json:"market" mapstructure:"market"`
+    Risk         RiskConfig          `json:"risk" mapstructure:"risk"`
+    Payments     PaymentsConfig      `json:"payments" mapstructure:"payments"`
+    Portfolio    PortfolioConfig     `json:"portfolio" mapstructure:"portfolio"`
 }
 
 type AuthConfig struct {
@@ -29,6 +36,8 @@ type AuthConfig struct {
 	RefreshTokenTTLMinutes int    `json:"refresh_token_ttl_minutes" mapstructure:"refresh_token_ttl_minutes" yaml:"refresh_token_ttl_minutes"`
 	Issuer                 string `json:"issuer" mapstructure:"issuer" yaml:"issuer"`
 	Audience               string `json:"audience" mapstructure:"audience" yaml:"audience"`
+	// AdminUserIDs lists the users allowed to call administrative methods.
+	AdminUserIDs []int64 `json:"admin_user_ids" mapstructure:"admin_user_ids" yaml:"admin_user_ids"`
 }
 
 type NotificationConfig struct {
@@ -48,6 +57,9 @@ type EmailConfig struct {
     // VerificationURLBase is the base URL used to compose the verification link.
     // Example: http://127.0.0.1:18080/users/verify?token=
     VerificationURLBase string `json:"verification_url_base" mapstructure:"verification_url_base" yaml:"verification_url_base"`
+    // EmailChangeURLBase is the base URL used to compose the email change confirmation link.
+    // Example: http://127.0.0.1:18080/users/email/confirm?token=
+    EmailChangeURLBase string `json:"email_change_url_base" mapstructure:"email_change_url_base" yaml:"email_change_url_base"`
 }
 
 type SMTPConfig struct {
@@ -67,12 +79,171 @@ type VerificationConfig struct {
     ResendCooldownSeconds int `json:"resend_cooldown_seconds" mapstructure:"resend_cooldown_seconds" yaml:"resend_cooldown_seconds"`
 }
 
+// AccountConfig groups the account deletion and personal data export settings.
+type AccountConfig struct {
+    // DeletionGraceHours is how long a deleted account can still be reactivated
+    // before its personal data is anonymized, in hours.
+    DeletionGraceHours int `json:"deletion_grace_hours" mapstructure:"deletion_grace_hours" yaml:"deletion_grace_hours"`
+    // AnonymizeIntervalMinutes is how often accounts past their grace period are
+    // anonymized, in minutes. Zero disables the job.
+    AnonymizeIntervalMinutes int `json:"anonymize_interval_minutes" mapstructure:"anonymize_interval_minutes" yaml:"anonymize_interval_minutes"`
+    // ExportTTLHours is how long a finished personal data export can be
+    // downloaded, in hours.
+    ExportTTLHours int `json:"export_ttl_hours" mapstructure:"export_ttl_hours" yaml:"export_ttl_hours"`
+    // ExportIntervalSeconds is how often queued personal data exports are
+    // built, in seconds. Zero disables the worker.
+    ExportIntervalSeconds int `json:"export_interval_seconds" mapstructure:"export_interval_seconds" yaml:"export_interval_seconds"`
+}
+
+// EncryptionConfig selects the master key provider for field-level encryption
+// of sensitive user columns.
+type EncryptionConfig struct {
+    // Provider is "none" (store plaintext), "file" or "env".
+    Provider string `json:"provider" mapstructure:"provider" yaml:"provider"`
+    // KeyFile is the JSON key file read by the "file" provider.
+    KeyFile string `json:"key_file" mapstructure:"key_file" yaml:"key_file"`
+    // EnvPrefix prefixes the CURRENT_KEY_ID, MASTER_KEYS and BLIND_INDEX_KEY
+    // variables read by the "env" provider.
+    EnvPrefix string `json:"env_prefix" mapstructure:"env_prefix" yaml:"env_prefix"`
+}
+
+// BlobConfig selects where uploaded files such as KYC document images and
+// avatars are stored and how their signed URLs are issued.
+type BlobConfig struct {
+    // Provider is "local" (a directory, shared between replicas) or "s3".
+    Provider string `json:"provider" mapstructure:"provider" yaml:"provider"`
+    // LocalRoot is the directory used by the "local" provider.
+    LocalRoot string `json:"local_root" mapstructure:"local_root" yaml:"local_root"`
+    // S3 addresses the bucket of the "s3" provider.
+    S3 S3Config `json:"s3" mapstructure:"s3" yaml:"s3"`
+    // URLSecret signs the upload and download URLs served by the HTTP
+    // gateway; at least 16 bytes.
+    URLSecret string `json:"url_secret" mapstructure:"url_secret" yaml:"url_secret"`
+    // PublicURL is the externally visible URL of the gateway's blob endpoint.
+    PublicURL string `json:"public_url" mapstructure:"public_url" yaml:"public_url"`
+}
+
+// S3Config addresses an Amazon S3 or S3 compatible (e.g. MinIO) bucket.
+type S3Config struct {
+    // Endpoint is the service URL, e.g. https://s3.ap-southeast-1.amazonaws.com.
+    Endpoint        string `json:"endpoint" mapstructure:"endpoint" yaml:"endpoint"`
+    Region          string `json:"region" mapstructure:"region" yaml:"region"`
+    Bucket          string `json:"bucket" mapstructure:"bucket" yaml:"bucket"`
+    AccessKeyID     string `json:"access_key_id" mapstructure:"access_key_id" yaml:"access_key_id"`
+    SecretAccessKey string `json:"secret_access_key" mapstructure:"secret_access_key" yaml:"secret_access_key"`
+}
+
+// MarketConfig groups the settings of the jobs that follow stock prices.
+type MarketConfig struct {
+    // AlertIntervalSeconds is how often newly recorded prices are evaluated
+    // against price alerts, in seconds. Zero disables the evaluator.
+    AlertIntervalSeconds int `json:"alert_interval_seconds" mapstructure:"alert_interval_seconds" yaml:"alert_interval_seconds"`
+    // TimeZone is the IANA zone of the trading sessions and holidays.
+    TimeZone string `json:"time_zone" mapstructure:"time_zone" yaml:"time_zone"`
+    // Sessions are the session windows of a trading day in time order.
+    Sessions []SessionWindowConfig `json:"sessions" mapstructure:"sessions" yaml:"sessions"`
+    // TradingDays are the weekdays the market trades, as mon to sun.
+    TradingDays []string `json:"trading_days" mapstructure:"trading_days" yaml:"trading_days"`
+    // Holidays are the dates, as YYYY-MM-DD, the market is closed on.
+    Holidays []string `json:"holidays" mapstructure:"holidays" yaml:"holidays"`
+    // SessionIntervalSeconds is how often the session scheduler runs call
+    // auctions and expires DAY orders, in seconds. Zero disables it; enable
+    // it on one replica only.
+    SessionIntervalSeconds int `json:"session_interval_seconds" mapstructure:"session_interval_seconds" yaml:"session_interval_seconds"`
+    // SettlementDays is the settlement cycle: fills settle that many
+    // trading days after the trade date (T+N).
+    SettlementDays int `json:"settlement_days" mapstructure:"settlement_days" yaml:"settlement_days"`
+    // ConfirmationIntervalSeconds is how often the confirmations of new
+    // fills are queued for email, in seconds. Zero disables it.
+    ConfirmationIntervalSeconds int `json:"confirmation_interval_seconds" mapstructure:"confirmation_interval_seconds" yaml:"confirmation_interval_seconds"`
+}
+
+// SessionWindowConfig is a session of the trading day: ato, continuous or
+// atc, from Start until End as HH:MM.
+type SessionWindowConfig struct {
+    Session string `json:"session" mapstructure:"session" yaml:"session"`
+    Start   string `json:"start" mapstructure:"start" yaml:"start"`
+    End     string `json:"end" mapstructure:"end" yaml:"end"`
+}
+
+// RiskConfig sets the pre-trade checks orders go through before they are
+// placed or amended.
+type RiskConfig struct {
+    // Rules are the checks to run, in order: lot_size, tick_size, price_band,
+    // max_order_value, daily_limits, buying_power and holdings. Empty runs
+    // every check.
+    Rules []string `json:"rules" mapstructure:"rules" yaml:"rules"`
+    // Default are the limits of every order.
+    Default RiskLimitsConfig `json:"default" mapstructure:"default" yaml:"default"`
+    // Stocks override the market rules of single stocks, by code. Codes are
+    // matched case-insensitively.
+    Stocks map[string]RiskLimitsConfig `json:"stocks" mapstructure:"stocks" yaml:"stocks"`
+    // Tiers override the limits of the users in a trading tier, by tier.
+    Tiers map[string]RiskLimitsConfig `json:"tiers" mapstructure:"tiers" yaml:"tiers"`
+}
+
+// RiskLimitsConfig are limits of the risk checks; zero leaves a limit
+// unchecked, or unchanged in an override.
+type RiskLimitsConfig struct {
+    // PriceBandBps is how far from the reference price, in basis points,
+    // prices may be.
+    PriceBandBps   int64            `json:"price_band_bps" mapstructure:"price_band_bps" yaml:"price_band_bps"`
+    LotSize        int64            `json:"lot_size" mapstructure:"lot_size" yaml:"lot_size"`
+    TickSizes      []TickSizeConfig `json:"tick_sizes" mapstructure:"tick_sizes" yaml:"tick_sizes"`
+    MaxOrderValue  int64            `json:"max_order_value" mapstructure:"max_order_value" yaml:"max_order_value"`
+    MaxDailyOrders int64            `json:"max_daily_orders" mapstructure:"max_daily_orders" yaml:"max_daily_orders"`
+    MaxDailyValue  int64            `json:"max_daily_value" mapstructure:"max_daily_value" yaml:"max_daily_value"`
+}
+
+// TickSizeConfig is the price step of prices from From, in VND.
+type TickSizeConfig struct {
+    From int64 `json:"from" mapstructure:"from" yaml:"from"`
+    Tick int64 `json:"tick" mapstructure:"tick" yaml:"tick"`
+}
+
+// PaymentsConfig selects the payment gateway deposits and withdrawals go
+// through.
+type PaymentsConfig struct {
+    // Gateway is "none" (payments are refused) or "simulated", a local
+    // stand-in that confirms payments on the callback webhook.
+    Gateway string `json:"gateway" mapstructure:"gateway" yaml:"gateway"`
+    // CallbackSecret signs the callbacks of the gateway; at least 16 bytes.
+    CallbackSecret string `json:"callback_secret" mapstructure:"callback_secret" yaml:"callback_secret"`
+    // WithdrawalApprovalThreshold is the largest withdrawal, in VND, that
+    // needs no administrator approval; 0 approves every withdrawal.
+    WithdrawalApprovalThreshold int64 `json:"withdrawal_approval_threshold" mapstructure:"withdrawal_approval_threshold" yaml:"withdrawal_approval_threshold"`
+    // Simulated configures the "simulated" gateway.
+    Simulated SimulatedGatewayConfig `json:"simulated" mapstructure:"simulated" yaml:"simulated"`
+}
+
+// SimulatedGatewayConfig configures the simulated payment gateway.
+type SimulatedGatewayConfig struct {
+    // CallbackURL is where the gateway posts outcomes: the HTTP gateway's
+    // /api/v1/payments/callback.
+    CallbackURL string `json:"callback_url" mapstructure:"callback_url" yaml:"callback_url"`
+    // PaymentURL is the base of the payment links returned for deposits.
+    PaymentURL string `json:"payment_url" mapstructure:"payment_url" yaml:"payment_url"`
+    // DelayMillis is how long the gateway takes to settle a payment.
+    DelayMillis int `json:"delay_ms" mapstructure:"delay_ms" yaml:"delay_ms"`
+    // DeclineAbove fails payments of a larger amount, in VND; 0 confirms
+    // every payment.
+    DeclineAbove int64 `json:"decline_above" mapstructure:"decline_above" yaml:"decline_above"`
+}
+
+// PortfolioConfig sets how portfolio performance is reported.
+type PortfolioConfig struct {
+    // CostMethod measures realized P&L when a request names none: "fifo"
+    // sells the earliest shares first, "average" at the average cost.
+    CostMethod string `json:"cost_method" mapstructure:"cost_method" yaml:"cost_method"`
+}
+
 func loadDefaultConfig() *Config {
     return &Config{
         Env: "local",
         GRPC: grpc_server.Config{
-            Host: "0.0.0.0",
-            Port: 9090,
+            Host:           "0.0.0.0",
+            Port:           9090,
+            TrustedProxies: []string{"127.0.0.1", "::1"},
         },
         HTTP: http_gateway.Config{
             Host: "0.0.0.0",
@@ -97,6 +268,63 @@ func loadDefaultConfig() *Config {
             TokenTTLHours:          24,
             ResendCooldownSeconds:  60,
         },
+        Account: AccountConfig{
+            DeletionGraceHours:       24 * 30,
+            AnonymizeIntervalMinutes: 60,
+            ExportTTLHours:           24 * 7,
+            ExportIntervalSeconds:    10,
+        },
+        Encryption: EncryptionConfig{
+            Provider:  "none",
+            EnvPrefix: "PII_",
+        },
+        Blob: BlobConfig{
+            Provider:  "local",
+            LocalRoot: "./data/blobs",
+            S3: S3Config{
+                Region: "us-east-1",
+            },
+            URLSecret: "change-me-in-production-blobs",
+            PublicURL: "http://127.0.0.1:8080/api/v1/blobs",
+        },
+        Market: MarketConfig{
+            AlertIntervalSeconds: 5,
+            TimeZone:             "Asia/Ho_Chi_Minh",
+            Sessions: []SessionWindowConfig{
+                {Session: "ato", Start: "09:00", End: "09:15"},
+                {Session: "continuous", Start: "09:15", End: "11:30"},
+                {Session: "continuous", Start: "13:00", End: "14:30"},
+                {Session: "atc", Start: "14:30", End: "14:45"},
+            },
+            TradingDays:            []string{"mon", "tue", "wed", "thu", "fri"},
+            SessionIntervalSeconds: 1,
+            SettlementDays:         2,
+
+            ConfirmationIntervalSeconds: 5,
+        },
+        Risk: RiskConfig{
+            Default: RiskLimitsConfig{
+                PriceBandBps: 700,
+                LotSize:      100,
+                TickSizes: []TickSizeConfig{
+                    {From: 0, Tick: 10},
+                    {From: 10000, Tick: 50},
+                    {From: 50000, Tick: 100},
+                },
+            },
+        },
+        Payments: PaymentsConfig{
+            Gateway:                     "simulated",
+            CallbackSecret:              "change-me-in-production-payments",
+            WithdrawalApprovalThreshold: 100_000_000,
+            Simulated: SimulatedGatewayConfig{
+                CallbackURL: "http://127.0.0.1:8080/api/v1/payments/callback",
+                DelayMillis: 2000,
+            },
+        },
+        Portfolio: PortfolioConfig{
+            CostMethod: "fifo",
+        },
         Notification: NotificationConfig{
             Kafka: KafkaConfig{
                 Brokers: []string{"localhost:29092"},
@@ -112,6 +340,7 @@ func loadDefaultConfig() *Config {
                     UseTLS: false,
                 },
                 VerificationURLBase: "http://127.0.0.1:18080/users/verify?token=",
+                EmailChangeURLBase:  "http://127.0.0.1:18080/users/email/confirm?token=",
             },
         },
     }
@@ -2,14 +2,29 @@ package server
 
 import (
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/blobstore"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/payment"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
 )
 
 type InfrastructureDependencies struct {
 	DB *sql.DB
+	// FieldCipher encrypts sensitive user columns; nil stores them in
+	// plaintext.
+	FieldCipher database.FieldCipher
+	// Blobs stores uploaded files such as KYC document images.
+	Blobs ports.BlobStore
+	// BlobURLs signs the blob URLs served by the HTTP gateway.
+	BlobURLs *blobstore.URLSigner
+	// Payments moves deposits and withdrawals; nil when payments are
+	// disabled.
+	Payments ports.PaymentGateway
 }
 
 // InitInfrastructure establishes connections to external infrastructure such as
@@ -17,20 +32,121 @@ type InfrastructureDependencies struct {
 // components can depend on its availability. When the connection cannot be
 // established, the returned struct will contain a nil DB pointer allowing
 // callers to gracefully fall back to in-memory repositories.
+//
+// Unlike the database, a misconfigured encryption key provider or blob store
+// is an error: running without it would write sensitive columns in plaintext
+// or lose uploads.
 func InitInfrastructure(cfg *config.Config) (*InfrastructureDependencies, error) {
+	fields, err := buildFieldCipher(cfg.Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init field encryption: %w", err)
+	}
+	blobs, err := buildBlobStore(cfg.Blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init blob store: %w", err)
+	}
+	blobURLs, err := blobstore.NewURLSigner(cfg.Blob.URLSecret, cfg.Blob.PublicURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init blob url signer: %w", err)
+	}
+	payments, err := buildPaymentGateway(cfg.Payments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init payment gateway: %w", err)
+	}
+	infra := &InfrastructureDependencies{FieldCipher: fields, Blobs: blobs, BlobURLs: blobURLs, Payments: payments}
 	if err := database.ConnectDB(cfg.DB); err != nil {
 		// Database connection failed; proceed with nil DB so callers can
 		// decide to use an alternative implementation.
-		return &InfrastructureDependencies{DB: nil}, nil
+		return infra, nil
+	}
+	infra.DB = database.DB
+	return infra, nil
+}
+
+// buildBlobStore opens the configured blob store.
+func buildBlobStore(cfg config.BlobConfig) (ports.BlobStore, error) {
+	switch cfg.Provider {
+	case "", "local":
+		return blobstore.NewLocalBlobStore(cfg.LocalRoot)
+	case "s3":
+		return blobstore.NewS3BlobStore(blobstore.S3Config{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+		})
+	default:
+		return nil, fmt.Errorf("unknown blob store provider %q", cfg.Provider)
+	}
+}
+
+// buildPaymentGateway builds the configured payment gateway. It returns nil
+// when payments are disabled.
+func buildPaymentGateway(cfg config.PaymentsConfig) (ports.PaymentGateway, error) {
+	switch cfg.Gateway {
+	case "", "none":
+		return nil, nil
+	case "simulated":
+		return payment.NewSimulatedGateway(payment.SimulatedConfig{
+			Secret:       cfg.CallbackSecret,
+			CallbackURL:  cfg.Simulated.CallbackURL,
+			PaymentURL:   cfg.Simulated.PaymentURL,
+			Delay:        time.Duration(cfg.Simulated.DelayMillis) * time.Millisecond,
+			DeclineAbove: cfg.Simulated.DeclineAbove,
+		})
+	default:
+		return nil, fmt.Errorf("unknown payment gateway %q", cfg.Gateway)
+	}
+}
+
+// buildFieldCipher loads the master keys of the configured provider. It
+// returns nil when encryption is disabled.
+func buildFieldCipher(cfg config.EncryptionConfig) (database.FieldCipher, error) {
+	var (
+		keyring *security.Keyring
+		err     error
+	)
+	switch cfg.Provider {
+	case "", "none":
+		return nil, nil
+	case "file":
+		keyring, err = security.LoadKeyringFile(cfg.KeyFile)
+	case "env":
+		keyring, err = security.LoadKeyringEnv(cfg.EnvPrefix)
+	default:
+		return nil, fmt.Errorf("unknown key provider %q", cfg.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+	fields, err := security.NewFieldCipher(keyring)
+	if err != nil {
+		return nil, err
 	}
-	return &InfrastructureDependencies{DB: database.DB}, nil
+	return fields, nil
 }
 
 // Adapters groups concrete implementations that satisfy the application's
 // ports. These can be backed by real infrastructure or in-memory fallbacks.
 type Adapters struct {
-	UserRepository   ports.UserRepository
-	OutboxRepository ports.OutboxRepository
+	UserRepository            ports.UserRepository
+	OutboxRepository          ports.OutboxRepository
+	DataExportRepository      ports.DataExportRepository
+	AuditRepository           ports.AuditRepository
+	LoginHistoryRepository    ports.LoginHistoryRepository
+	KycRepository             ports.KycRepository
+	StockRepository           ports.StockRepository
+	WatchlistRepository       ports.WatchlistRepository
+	PriceAlertRepository      ports.PriceAlertRepository
+	NewsRepository            ports.NewsRepository
+	OrderRepository           ports.OrderRepository
+	AccountRepository         ports.TradingAccountRepository
+	PaymentRepository         ports.PaymentRepository
+	StatementRepository       ports.StatementRepository
+	FeeScheduleRepository     ports.FeeScheduleRepository
+	CorporateActionRepository ports.CorporateActionRepository
+	PortfolioRepository       ports.PortfolioRepository
 }
 
 // NewAdapters wires repositories based on available infrastructure
@@ -39,15 +155,45 @@ type Adapters struct {
 // mode.
 func NewAdapters(infra *InfrastructureDependencies) (*Adapters, error) {
 	if infra.DB != nil {
-		repo := database.NewMysqlUserRepository(infra.DB)
+		repo := database.NewMysqlUserRepositoryWithCipher(infra.DB, infra.FieldCipher)
 		return &Adapters{
-			UserRepository:   repo,
-			OutboxRepository: repo,
+			UserRepository:            repo,
+			OutboxRepository:          repo,
+			DataExportRepository:      repo,
+			AuditRepository:           repo,
+			LoginHistoryRepository:    repo,
+			KycRepository:             repo,
+			StockRepository:           repo,
+			WatchlistRepository:       repo,
+			PriceAlertRepository:      repo,
+			NewsRepository:            repo,
+			OrderRepository:           repo,
+			AccountRepository:         repo,
+			PaymentRepository:         repo,
+			StatementRepository:       repo,
+			FeeScheduleRepository:     repo,
+			CorporateActionRepository: repo,
+			PortfolioRepository:       repo,
 		}, nil
 	}
 	memRepo := database.NewInMemoryUserRepository()
 	return &Adapters{
-		UserRepository:   memRepo,
-		OutboxRepository: memRepo,
+		UserRepository:            memRepo,
+		OutboxRepository:          memRepo,
+		DataExportRepository:      memRepo,
+		AuditRepository:           memRepo,
+		LoginHistoryRepository:    memRepo,
+		KycRepository:             memRepo,
+		StockRepository:           memRepo,
+		WatchlistRepository:       memRepo,
+		PriceAlertRepository:      memRepo,
+		NewsRepository:            memRepo,
+		OrderRepository:           memRepo,
+		AccountRepository:         memRepo,
+		PaymentRepository:         memRepo,
+		StatementRepository:       memRepo,
+		FeeScheduleRepository:     memRepo,
+		CorporateActionRepository: memRepo,
+		PortfolioRepository:       memRepo,
 	}, nil
 }
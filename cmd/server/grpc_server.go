@@ -2,11 +2,25 @@ package server
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
 	grpcadapter "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/accounts"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/alerts"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/corporateactions"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/fees"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/kyc"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/market"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/news"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/orders"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/payments"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/portfolios"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/statements"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/users"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server/watchlists"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
 	usecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
 )
@@ -17,10 +31,44 @@ func NewGrpcServices(cfg config.Config, accessTokens security.AccessTokenManager
 		return nil, fmt.Errorf("failed to new user service: %w", err)
 	}
 
-	return []grpcadapter.Service{userService}, nil
+	kycService := kyc.NewKycService(usecase.NewUserKycUseCase(adapters.UserRepository, adapters.KycRepository, infra.Blobs))
+	watchlistService := watchlists.NewWatchlistService(usecase.NewUserWatchlistUseCase(adapters.UserRepository, adapters.WatchlistRepository, adapters.StockRepository))
+
+	alertService := alerts.NewPriceAlertService(usecase.NewUserPriceAlertUseCase(adapters.UserRepository, adapters.PriceAlertRepository, adapters.StockRepository))
+	newsService := news.NewNewsService(usecase.NewUserNewsUseCase(adapters.NewsRepository))
+
+	calendar, err := buildTradingCalendar(cfg.Market)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trading calendar: %w", err)
+	}
+	risk, err := buildRiskChain(cfg.Risk, adapters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build risk checks: %w", err)
+	}
+	feeEngine := usecase.NewFeeEngine(adapters.FeeScheduleRepository, adapters.AccountRepository, adapters.OrderRepository, calendar)
+	orderService := orders.NewOrderService(usecase.NewUserOrderUseCase(adapters.UserRepository, adapters.OrderRepository, usecase.OrderConfig{Calendar: &calendar, Risk: &risk, Fees: &feeEngine}))
+	marketService := market.NewMarketService(usecase.NewUserTradingSessionUseCase(adapters.UserRepository, adapters.OrderRepository, adapters.StockRepository, calendar, usecase.TradingSessionConfig{Fees: &feeEngine}))
+	accountService := accounts.NewAccountService(usecase.NewUserTradingAccountUseCase(adapters.UserRepository, adapters.AccountRepository, adapters.OrderRepository, adapters.FeeScheduleRepository, risk.Config()))
+
+	paymentService := payments.NewPaymentService(usecase.NewUserPaymentUseCase(adapters.UserRepository, adapters.PaymentRepository, adapters.FeeScheduleRepository, infra.Payments, usecase.PaymentConfig{
+		WithdrawalApprovalThreshold: cfg.Payments.WithdrawalApprovalThreshold,
+	}))
+	statementService := statements.NewStatementService(usecase.NewUserStatementUseCase(adapters.UserRepository, adapters.AccountRepository, adapters.OrderRepository, adapters.StockRepository, adapters.StatementRepository, infra.Blobs, infra.BlobURLs, calendar))
+
+	feeService := fees.NewFeeService(usecase.NewUserFeeScheduleUseCase(adapters.FeeScheduleRepository, risk.Config()))
+
+	corporateActionService := corporateactions.NewCorporateActionService(usecase.NewUserCorporateActionUseCase(adapters.CorporateActionRepository, adapters.StockRepository, calendar))
+
+	portfolioConfig, err := buildPortfolioConfig(cfg.Portfolio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build portfolio settings: %w", err)
+	}
+	portfolioService := portfolios.NewPortfolioService(usecase.NewUserPortfolioUseCase(adapters.UserRepository, adapters.PortfolioRepository, adapters.OrderRepository, adapters.StockRepository, adapters.CorporateActionRepository, calendar, portfolioConfig))
+
+	return []grpcadapter.Service{userService, kycService, watchlistService, alertService, newsService, orderService, marketService, accountService, paymentService, statementService, feeService, corporateActionService, portfolioService}, nil
 }
 
-func NewUserService(cfg config.Config, _ *InfrastructureDependencies, adapters *Adapters, accessTokens security.AccessTokenManager, refreshTokens security.RefreshTokenManager) (*users.UserService, error) {
+func NewUserService(cfg config.Config, infra *InfrastructureDependencies, adapters *Adapters, accessTokens security.AccessTokenManager, refreshTokens security.RefreshTokenManager) (*users.UserService, error) {
     repo := adapters.UserRepository
 
     vTTL := time.Duration(cfg.Verification.TokenTTLHours) * time.Hour
@@ -29,14 +77,20 @@ func NewUserService(cfg config.Config, _ *InfrastructureDependencies, adapters *
     registerUseCase := usecase.NewUserRegisterUseCaseWithTTL(repo, vTTL)
     resendUseCase := usecase.NewUserVerificationResendUseCaseWithConfig(repo, vTTL, vCooldown)
     verifyUseCase := usecase.NewUserVerifyUseCase(repo)
-	loginUseCase := usecase.NewUserLoginUseCase(repo, accessTokens, refreshTokens)
-	refreshUseCase := usecase.NewUserTokenRefreshUseCase(accessTokens, refreshTokens)
-	logoutUseCase := usecase.NewUserLogoutUseCase(refreshTokens)
-	deleteUseCase := usecase.NewUserDeleteUseCase(repo)
+	loginUseCase := usecase.NewUserLoginUseCaseWithHistory(repo, adapters.LoginHistoryRepository, accessTokens, refreshTokens)
+	refreshUseCase := usecase.NewUserTokenRefreshUseCaseWithRepository(repo, accessTokens, refreshTokens)
+	logoutUseCase := usecase.NewUserLogoutUseCaseWithHistory(refreshTokens, adapters.LoginHistoryRepository)
+	deleteUseCase := usecase.NewUserDeleteUseCaseWithGracePeriod(repo, time.Duration(cfg.Account.DeletionGraceHours)*time.Hour)
 	getUseCase := usecase.NewUserGetUseCase(repo)
 	listUseCase := usecase.NewUserListUseCase(repo)
 	updateUseCase := usecase.NewUserUpdateUseCase(repo)
 	changePasswordUseCase := usecase.NewUserChangePasswordUseCase(repo)
+	emailChangeUseCase := usecase.NewUserEmailChangeUseCaseWithTTL(repo, vTTL)
+	accountStatusUseCase := usecase.NewUserAccountStatusUseCase(repo)
+	dataExportUseCase := usecase.NewUserDataExportUseCaseWithTTL(repo, adapters.DataExportRepository, time.Duration(cfg.Account.ExportTTLHours)*time.Hour)
+	auditUseCase := usecase.NewUserAuditUseCase(adapters.AuditRepository)
+	loginHistoryUseCase := usecase.NewUserLoginHistoryUseCase(repo, adapters.LoginHistoryRepository)
+	avatarUseCase := usecase.NewUserAvatarUseCase(repo, infra.Blobs, infra.BlobURLs)
 
 	userService := users.NewUserService(
 		registerUseCase,
@@ -50,10 +104,76 @@ func NewUserService(cfg config.Config, _ *InfrastructureDependencies, adapters *
 		listUseCase,
 		updateUseCase,
 		changePasswordUseCase,
+		emailChangeUseCase,
+		accountStatusUseCase,
+		dataExportUseCase,
+		auditUseCase,
+		loginHistoryUseCase,
+		avatarUseCase,
 	)
 	return userService, nil
 }
 
+// buildTradingCalendar builds the trading calendar of the market settings.
+func buildTradingCalendar(cfg config.MarketConfig) (usecase.TradingCalendar, error) {
+	windows := make([]usecase.TradingWindow, 0, len(cfg.Sessions))
+	for _, session := range cfg.Sessions {
+		windows = append(windows, usecase.TradingWindow{Session: session.Session, Start: session.Start, End: session.End})
+	}
+	return usecase.NewTradingCalendar(usecase.TradingCalendarConfig{
+		TimeZone:       cfg.TimeZone,
+		Windows:        windows,
+		TradingDays:    cfg.TradingDays,
+		Holidays:       cfg.Holidays,
+		SettlementDays: cfg.SettlementDays,
+	})
+}
+
+// buildRiskChain builds the pre-trade checks of the risk settings.
+func buildRiskChain(cfg config.RiskConfig, adapters *Adapters) (usecase.RiskChain, error) {
+	rules, err := usecase.NewRiskRules(cfg.Rules, adapters.OrderRepository, adapters.StockRepository, adapters.FeeScheduleRepository)
+	if err != nil {
+		return usecase.RiskChain{}, err
+	}
+	limits := usecase.RiskConfig{
+		Default: riskLimits(cfg.Default),
+		Stocks:  make(map[string]usecase.RiskLimits, len(cfg.Stocks)),
+		Tiers:   make(map[string]usecase.RiskLimits, len(cfg.Tiers)),
+	}
+	// Viper lower-cases map keys; stock codes are upper case.
+	for code, stock := range cfg.Stocks {
+		limits.Stocks[strings.ToUpper(code)] = riskLimits(stock)
+	}
+	for tier, overrides := range cfg.Tiers {
+		limits.Tiers[strings.ToLower(tier)] = riskLimits(overrides)
+	}
+	return usecase.NewRiskChain(limits, rules...)
+}
+
+func riskLimits(cfg config.RiskLimitsConfig) usecase.RiskLimits {
+	ticks := make([]usecase.TickSize, 0, len(cfg.TickSizes))
+	for _, size := range cfg.TickSizes {
+		ticks = append(ticks, usecase.TickSize{From: size.From, Tick: size.Tick})
+	}
+	return usecase.RiskLimits{
+		PriceBandBps:   cfg.PriceBandBps,
+		LotSize:        cfg.LotSize,
+		TickSizes:      ticks,
+		MaxOrderValue:  cfg.MaxOrderValue,
+		MaxDailyOrders: cfg.MaxDailyOrders,
+		MaxDailyValue:  cfg.MaxDailyValue,
+	}
+}
+
+// buildPortfolioConfig checks the portfolio settings.
+func buildPortfolioConfig(cfg config.PortfolioConfig) (usecase.PortfolioConfig, error) {
+	method := userentity.CostMethod(strings.ToLower(cfg.CostMethod))
+	if method != "" && !method.Valid() {
+		return usecase.PortfolioConfig{}, fmt.Errorf("unknown cost method %q", cfg.CostMethod)
+	}
+	return usecase.PortfolioConfig{CostMethod: method}, nil
+}
+
 func buildTokenManagers(cfg config.AuthConfig) (security.AccessTokenManager, security.RefreshTokenManager, error) {
 	accessTTL := time.Duration(cfg.AccessTokenTTLMinutes) * time.Minute
 	access, err := security.NewJWTManager(cfg.AccessTokenSecret, cfg.Issuer, cfg.Audience, accessTTL)
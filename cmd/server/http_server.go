@@ -5,12 +5,25 @@ import (
 	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
 
 	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway"
+	accountsgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/accounts"
+	alertsgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/alerts"
+	blobsgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/blobs"
+	corporateactionsgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/corporateactions"
+	feesgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/fees"
+	kycgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/kyc"
+	marketgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/market"
+	newsgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/news"
+	ordersgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/orders"
+	paymentsgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/payments"
+	portfoliosgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/portfolios"
+	statementsgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/statements"
 	usersgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/users"
+	watchlistsgw "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway/watchlists"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-func NewHTTPGatewayServices(cfg config.Config, _ *InfrastructureDependencies) ([]http_gateway.GrpcGatewayServices, error) {
+func NewHTTPGatewayServices(cfg config.Config, infra *InfrastructureDependencies) ([]http_gateway.GrpcGatewayServices, error) {
 	grpcHost := cfg.GRPC.Host
 	if grpcHost == "0.0.0.0" {
 		grpcHost = "127.0.0.1"
@@ -26,8 +39,34 @@ func NewHTTPGatewayServices(cfg config.Config, _ *InfrastructureDependencies) ([
 
 	// new http gateway services
 	userHttpGwService := usersgw.NewUserGatewayService(grpcServerConn)
+	kycHttpGwService := kycgw.NewKycGatewayService(grpcServerConn)
+	blobHttpGwService := blobsgw.NewBlobGatewayService(infra.Blobs, infra.BlobURLs)
+	watchlistHttpGwService := watchlistsgw.NewWatchlistGatewayService(grpcServerConn)
+	alertHttpGwService := alertsgw.NewPriceAlertGatewayService(grpcServerConn)
+	newsHttpGwService := newsgw.NewNewsGatewayService(grpcServerConn)
+	orderHttpGwService := ordersgw.NewOrderGatewayService(grpcServerConn)
+	marketHttpGwService := marketgw.NewMarketGatewayService(grpcServerConn)
+	accountHttpGwService := accountsgw.NewAccountGatewayService(grpcServerConn)
+	paymentHttpGwService := paymentsgw.NewPaymentGatewayService(grpcServerConn)
+	statementHttpGwService := statementsgw.NewStatementGatewayService(grpcServerConn)
+	feeHttpGwService := feesgw.NewFeeGatewayService(grpcServerConn)
+	corporateActionHttpGwService := corporateactionsgw.NewCorporateActionGatewayService(grpcServerConn)
+	portfolioHttpGwService := portfoliosgw.NewPortfolioGatewayService(grpcServerConn)
 
 	return []http_gateway.GrpcGatewayServices{
 		userHttpGwService,
+		kycHttpGwService,
+		blobHttpGwService,
+		watchlistHttpGwService,
+		alertHttpGwService,
+		newsHttpGwService,
+		orderHttpGwService,
+		marketHttpGwService,
+		accountHttpGwService,
+		paymentHttpGwService,
+		statementHttpGwService,
+		feeHttpGwService,
+		corporateActionHttpGwService,
+		portfolioHttpGwService,
 	}, nil
 }
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/newsfeed"
+	usecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"github.com/urfave/cli/v2"
+)
+
+var ImportNewsCmd = &cli.Command{
+	Name:      "import-news",
+	Usage:     "import news items from JSON files or RSS feeds",
+	ArgsUsage: "FILE...",
+	Action:    ImportNewsAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "config",
+			Aliases:     []string{"c"},
+			Usage:       "Load configuration from file path`",
+			DefaultText: "./cmd/server/config/local.yaml",
+			Value:       "./cmd/server/config/local.yaml",
+			Required:    false,
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "json or rss; guessed from the file extension when empty",
+		},
+		&cli.StringFlag{
+			Name:  "category",
+			Usage: "category of items that do not name one",
+			Value: "market",
+		},
+		&cli.StringFlag{
+			Name:  "source",
+			Usage: "source of items that do not name one",
+		},
+	},
+}
+
+// ImportNewsAction publishes the items of every file. Items carrying an
+// external id (the RSS guid or link) update the item imported before, so a
+// feed can be imported again. Failed items are logged and make the command
+// fail after every file was read.
+func ImportNewsAction(cmdCLI *cli.Context) error {
+	if cmdCLI.NArg() == 0 {
+		return errors.New("at least one news file is required")
+	}
+	cfgPath := cmdCLI.String("config")
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config from path\"%s\": %w", cfgPath, err)
+	}
+	if err := database.ConnectDB(cfg.DB); err != nil {
+		return fmt.Errorf("failed to connect database: %w", err)
+	}
+	defer database.DB.Close()
+
+	uc := usecase.NewUserNewsUseCase(database.NewMysqlUserRepository(database.DB))
+	defaults := newsfeed.Defaults{Category: cmdCLI.String("category"), Source: cmdCLI.String("source")}
+	failed := 0
+	for _, path := range cmdCLI.Args().Slice() {
+		result, err := importNewsFile(cmdCLI.Context, uc, path, newsfeed.Format(cmdCLI.String("format")), defaults)
+		if err != nil {
+			return err
+		}
+		for _, failure := range result.Failed {
+			slog.Warn("NEWS ITEM NOT IMPORTED", "file", path, "index", failure.Index, "external_id", failure.ExternalID, "error", failure.Err)
+		}
+		failed += len(result.Failed)
+		slog.Info("NEWS FILE IMPORTED", "file", path, "created", result.Created, "updated", result.Updated, "failed", len(result.Failed))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d news items were not imported", failed)
+	}
+	return nil
+}
+
+func importNewsFile(ctx context.Context, uc usecase.UserNewsUseCase, path string, format newsfeed.Format, defaults newsfeed.Defaults) (usecase.NewsImportResult, error) {
+	if format == "" {
+		var err error
+		if format, err = newsfeed.FormatOf(path); err != nil {
+			return usecase.NewsImportResult{}, err
+		}
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return usecase.NewsImportResult{}, fmt.Errorf("open news file: %w", err)
+	}
+	defer file.Close()
+
+	items, err := newsfeed.Parse(format, file, defaults)
+	if err != nil {
+		return usecase.NewsImportResult{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return uc.Import(ctx, items)
+}
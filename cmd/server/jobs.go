@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	usecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+)
+
+// startPeriodicJob runs job once at startup and then every interval until the
+// returned function is called; that function stops the loop and waits for it
+// to exit. A non-positive interval disables the job.
+func startPeriodicJob(name string, interval time.Duration, job func(ctx context.Context, now time.Time)) func() {
+	if interval <= 0 {
+		slog.Info("JOB DISABLED", "job", name)
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			job(ctx, time.Now().UTC())
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// startAccountAnonymizer anonymizes accounts whose deletion grace period has
+// ended. Running it on several replicas is safe: each account is anonymized
+// under a row lock. The KYC document images of anonymized accounts are
+// removed from blobs.
+func startAccountAnonymizer(repo ports.UserRepository, kyc ports.KycRepository, blobs ports.BlobStore, interval time.Duration) func() {
+	uc := usecase.NewUserAnonymizeUseCaseWithDocuments(repo, kyc, blobs)
+	return startPeriodicJob("account-anonymizer", interval, func(ctx context.Context, now time.Time) {
+		count, err := uc.AnonymizeDue(ctx, now)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("account anonymization failed", "error", err)
+		}
+		if count > 0 {
+			slog.Info("accounts anonymized", "count", count)
+		}
+	})
+}
+
+// startDataExporter builds queued personal data exports and removes expired
+// ones. Exports are claimed one at a time, so several replicas can share the
+// queue.
+func startDataExporter(uc usecase.UserDataExportUseCase, interval time.Duration) func() {
+	return startPeriodicJob("data-exporter", interval, func(ctx context.Context, now time.Time) {
+		for ctx.Err() == nil {
+			processed, err := uc.ProcessNext(ctx, now)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("data export failed", "error", err)
+			}
+			if !processed || err != nil {
+				break
+			}
+		}
+		removed, err := uc.DeleteExpired(ctx, now)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("expired data export cleanup failed", "error", err)
+		}
+		if removed > 0 {
+			slog.Info("expired data exports removed", "count", removed)
+		}
+	})
+}
+
+// startPriceAlertEvaluator fires price alerts as prices are recorded. It
+// starts at the newest price, so prices recorded while no evaluator runs are
+// not evaluated. Replicas evaluate the same prices; each alert still fires
+// only once.
+func startPriceAlertEvaluator(uc usecase.UserPriceAlertUseCase, interval time.Duration) func() {
+	var (
+		cursor  int64
+		started bool
+	)
+	return startPeriodicJob("price-alert-evaluator", interval, func(ctx context.Context, now time.Time) {
+		if !started {
+			last, err := uc.LastQuoteID(ctx)
+			if err != nil {
+				if !errors.Is(err, context.Canceled) {
+					slog.Error("price alert evaluation failed", "error", err)
+				}
+				return
+			}
+			cursor, started = last, true
+		}
+		next, fired, err := uc.EvaluateQuotesAfter(ctx, cursor)
+		cursor = next
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("price alert evaluation failed", "error", err)
+		}
+		if fired > 0 {
+			slog.Info("price alerts fired", "count", fired)
+		}
+	})
+}
+
+// startTradingSessionScheduler follows the trading calendar: it runs the
+// call auctions of the sessions that end and expires the DAY orders of
+// trading days that closed. It starts at the current time, so auctions due
+// while no scheduler runs are skipped. Run it on one replica.
+func startTradingSessionScheduler(uc usecase.UserTradingSessionUseCase, interval time.Duration) func() {
+	var last time.Time
+	return startPeriodicJob("trading-session-scheduler", interval, func(ctx context.Context, now time.Time) {
+		if last.IsZero() {
+			last = now
+		}
+		report, err := uc.Advance(ctx, last, now)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				slog.Error("trading session scheduling failed", "error", err)
+			}
+			// The same changes are handled again on the next run; auctions
+			// record each match once.
+			return
+		}
+		last = now
+		for _, transition := range report.Transitions {
+			slog.Info("trading session changed", "from", transition.From, "to", transition.To, "at", transition.At)
+		}
+		if report.Matches > 0 {
+			slog.Info("call auction matches recorded", "count", report.Matches)
+		}
+		if report.Expired > 0 {
+			slog.Info("day orders expired", "count", report.Expired)
+		}
+	})
+}
+
+// startTradeConfirmationSender queues an email confirmation of every new
+// fill. Replicas may run it together; each fill is confirmed once.
+func startTradeConfirmationSender(uc usecase.UserTradeConfirmationUseCase, interval time.Duration) func() {
+	return startPeriodicJob("trade-confirmation-sender", interval, func(ctx context.Context, now time.Time) {
+		confirmed, err := uc.ConfirmPending(ctx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("trade confirmation failed", "error", err)
+		}
+		if confirmed > 0 {
+			slog.Info("trades confirmed", "count", confirmed)
+		}
+	})
+}
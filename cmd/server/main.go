@@ -10,11 +10,13 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/notification"
 	grpcadapter "github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway"
+	usecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
 	"github.com/urfave/cli/v2"
 )
 
@@ -86,7 +88,15 @@ func StartHTTPServer(cfg *config.Config) error {
 		return fmt.Errorf("failed to new grpc services: %w", err)
 	}
 
-	grpcStop, cgrpcerr := grpcadapter.StartServer(cfg.GRPC, accessTokens, grpcServices...)
+	trustedProxies, err := grpcadapter.ParseTrustedProxies(cfg.GRPC.TrustedProxies)
+	if err != nil {
+		return fmt.Errorf("failed to parse trusted proxies: %w", err)
+	}
+	grpcStop, cgrpcerr := grpcadapter.StartServer(cfg.GRPC, accessTokens, grpcadapter.ServerOptions{
+		AdminUserIDs:    cfg.Auth.AdminUserIDs,
+		AuditRepository: adapters.AuditRepository,
+		TrustedProxies:  trustedProxies,
+	}, grpcServices...)
 	go func() {
 		for gerr := range cgrpcerr {
 			cerr <- fmt.Errorf("grpc server error: %w", gerr)
@@ -141,6 +151,38 @@ func StartHTTPServer(cfg *config.Config) error {
 	}
 	defer notificationShutdown()
 
+	anonymizerStop := startAccountAnonymizer(adapters.UserRepository, adapters.KycRepository, infra.Blobs, time.Duration(cfg.Account.AnonymizeIntervalMinutes)*time.Minute)
+	defer anonymizerStop()
+
+	exporterStop := startDataExporter(
+		usecase.NewUserDataExportUseCaseWithTTL(adapters.UserRepository, adapters.DataExportRepository, time.Duration(cfg.Account.ExportTTLHours)*time.Hour),
+		time.Duration(cfg.Account.ExportIntervalSeconds)*time.Second,
+	)
+	defer exporterStop()
+
+	alertEvaluatorStop := startPriceAlertEvaluator(
+		usecase.NewUserPriceAlertUseCase(adapters.UserRepository, adapters.PriceAlertRepository, adapters.StockRepository),
+		time.Duration(cfg.Market.AlertIntervalSeconds)*time.Second,
+	)
+	defer alertEvaluatorStop()
+
+	calendar, err := buildTradingCalendar(cfg.Market)
+	if err != nil {
+		return fmt.Errorf("failed to build trading calendar: %w", err)
+	}
+	feeEngine := usecase.NewFeeEngine(adapters.FeeScheduleRepository, adapters.AccountRepository, adapters.OrderRepository, calendar)
+	sessionSchedulerStop := startTradingSessionScheduler(
+		usecase.NewUserTradingSessionUseCase(adapters.UserRepository, adapters.OrderRepository, adapters.StockRepository, calendar, usecase.TradingSessionConfig{Fees: &feeEngine}),
+		time.Duration(cfg.Market.SessionIntervalSeconds)*time.Second,
+	)
+	defer sessionSchedulerStop()
+
+	confirmationSenderStop := startTradeConfirmationSender(
+		usecase.NewUserTradeConfirmationUseCase(adapters.OrderRepository),
+		time.Duration(cfg.Market.ConfirmationIntervalSeconds)*time.Second,
+	)
+	defer confirmationSenderStop()
+
 	slog.Info("SERVER STARTED")
 	<-stop
 	slog.Info("SERVER STOPPING")
@@ -166,6 +208,7 @@ func buildEmailSender(cfg config.EmailConfig) (notification.EmailSender, error)
             From:                cfg.SMTP.From,
             UseTLS:              cfg.SMTP.UseTLS,
             VerificationURLBase: strings.TrimSpace(strings.ReplaceAll(cfg.VerificationURLBase, "\"", "")),
+            EmailChangeURLBase:  strings.TrimSpace(strings.ReplaceAll(cfg.EmailChangeURLBase, "\"", "")),
         })
         if err != nil {
             return nil, err
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	"github.com/urfave/cli/v2"
+)
+
+var ReencryptPIICmd = &cli.Command{
+	Name:   "reencrypt-pii",
+	Usage:  "re-encrypt sensitive user and KYC columns under the current master key and rebuild blind indexes",
+	Action: ReencryptPIIAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "config",
+			Aliases:     []string{"c"},
+			Usage:       "Load configuration from file path`",
+			DefaultText: "./cmd/server/config/local.yaml",
+			Value:       "./cmd/server/config/local.yaml",
+			Required:    false,
+		},
+		&cli.IntFlag{
+			Name:  "batch-size",
+			Usage: "number of rows read per batch",
+			Value: 200,
+		},
+	},
+}
+
+// ReencryptPIIAction rewrites every user and KYC submission row that is still
+// plaintext or sealed under a retired master key. Run it after adding a new
+// current key. Rows written by instances still running with the old key are
+// sealed under it, so remove the old key only after every instance has been
+// restarted with the new one and a run has completed after that.
+func ReencryptPIIAction(cmdCLI *cli.Context) error {
+	cfgPath := cmdCLI.String("config")
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config from path\"%s\": %w", cfgPath, err)
+	}
+	fields, err := buildFieldCipher(cfg.Encryption)
+	if err != nil {
+		return fmt.Errorf("failed to init field encryption: %w", err)
+	}
+	if fields == nil {
+		return errors.New("encryption.provider is not configured")
+	}
+	if err := database.ConnectDB(cfg.DB); err != nil {
+		return fmt.Errorf("failed to connect database: %w", err)
+	}
+	defer database.DB.Close()
+
+	repo := database.NewMysqlUserRepositoryWithCipher(database.DB, fields)
+	ctx := context.Background()
+	users, err := repo.ReencryptUsers(ctx, cmdCLI.Int("batch-size"))
+	if err != nil {
+		return fmt.Errorf("reencrypt users (%d rewritten): %w", users, err)
+	}
+	submissions, err := repo.ReencryptKycSubmissions(ctx, cmdCLI.Int("batch-size"))
+	if err != nil {
+		return fmt.Errorf("reencrypt kyc submissions (%d rewritten): %w", submissions, err)
+	}
+	slog.Info("REENCRYPT PII DONE", "users", users, "kyc_submissions", submissions)
+	return nil
+}
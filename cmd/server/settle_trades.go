@@ -0,0 +1,70 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	usecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"github.com/urfave/cli/v2"
+)
+
+var SettleTradesCmd = &cli.Command{
+	Name:   "settle-trades",
+	Usage:  "settle the trades due on a trading day",
+	Action: SettleTradesAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "config",
+			Aliases:     []string{"c"},
+			Usage:       "Load configuration from file path`",
+			DefaultText: "./cmd/server/config/local.yaml",
+			Value:       "./cmd/server/config/local.yaml",
+			Required:    false,
+		},
+		&cli.StringFlag{
+			Name:  "date",
+			Usage: "day to settle as YYYY-MM-DD in the market time zone; today when empty",
+		},
+	},
+}
+
+// SettleTradesAction brings the cash and shares of trades due on or before
+// the day into the ledger. It is meant to run once every day after the
+// close; on weekends and holidays it settles nothing, and the next trading
+// day catches up on runs that were missed.
+func SettleTradesAction(cmdCLI *cli.Context) error {
+	cfgPath := cmdCLI.String("config")
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config from path\"%s\": %w", cfgPath, err)
+	}
+	calendar, err := buildTradingCalendar(cfg.Market)
+	if err != nil {
+		return fmt.Errorf("failed to build trading calendar: %w", err)
+	}
+	day := time.Now()
+	if date := cmdCLI.String("date"); date != "" {
+		if day, err = time.ParseInLocation(time.DateOnly, date, calendar.Location()); err != nil {
+			return fmt.Errorf("invalid date %q: %w", date, err)
+		}
+	}
+	if err := database.ConnectDB(cfg.DB); err != nil {
+		return fmt.Errorf("failed to connect database: %w", err)
+	}
+	defer database.DB.Close()
+
+	uc := usecase.NewUserSettlementUseCase(database.NewMysqlUserRepository(database.DB), calendar)
+	result, err := uc.Settle(cmdCLI.Context, day)
+	if err != nil {
+		return err
+	}
+	if !result.TradingDay {
+		slog.Info("NOT A TRADING DAY, NOTHING SETTLED", "date", result.Date.Format(time.DateOnly))
+		return nil
+	}
+	slog.Info("TRADES SETTLED", "date", result.Date.Format(time.DateOnly), "settlements", result.Settled)
+	return nil
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/cmd/server/config"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	usecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"github.com/urfave/cli/v2"
+)
+
+var SnapshotPortfoliosCmd = &cli.Command{
+	Name:   "snapshot-portfolios",
+	Usage:  "store the daily portfolio values performance history is charted from",
+	Action: SnapshotPortfoliosAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "config",
+			Aliases:     []string{"c"},
+			Usage:       "Load configuration from file path`",
+			DefaultText: "./cmd/server/config/local.yaml",
+			Value:       "./cmd/server/config/local.yaml",
+			Required:    false,
+		},
+		&cli.StringFlag{
+			Name:  "date",
+			Usage: "last day to snapshot as YYYY-MM-DD in the market time zone; yesterday when empty",
+		},
+	},
+}
+
+// SnapshotPortfoliosAction stores the value of every account at the end of
+// each trading day through the day. It is meant to run once every day after
+// midnight; days that have not ended are left to the next run, which also
+// catches up on runs that were missed.
+func SnapshotPortfoliosAction(cmdCLI *cli.Context) error {
+	cfgPath := cmdCLI.String("config")
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config from path\"%s\": %w", cfgPath, err)
+	}
+	calendar, err := buildTradingCalendar(cfg.Market)
+	if err != nil {
+		return fmt.Errorf("failed to build trading calendar: %w", err)
+	}
+	portfolioConfig, err := buildPortfolioConfig(cfg.Portfolio)
+	if err != nil {
+		return fmt.Errorf("failed to build portfolio settings: %w", err)
+	}
+	day := time.Now()
+	if date := cmdCLI.String("date"); date != "" {
+		if day, err = time.ParseInLocation(time.DateOnly, date, calendar.Location()); err != nil {
+			return fmt.Errorf("invalid date %q: %w", date, err)
+		}
+	}
+	if err := database.ConnectDB(cfg.DB); err != nil {
+		return fmt.Errorf("failed to connect database: %w", err)
+	}
+	defer database.DB.Close()
+
+	repo := database.NewMysqlUserRepository(database.DB)
+	result, err := usecase.NewUserPortfolioUseCase(repo, repo, repo, repo, repo, calendar, portfolioConfig).Snapshot(cmdCLI.Context, day)
+	if err != nil {
+		return err
+	}
+	slog.Info("PORTFOLIOS SNAPSHOTTED", "through", result.Date.Format(time.DateOnly), "users", result.Users, "snapshots", result.Snapshots)
+	return nil
+}
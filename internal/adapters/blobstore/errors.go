@@ -0,0 +1,15 @@
+package blobstore
+
+import "github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+
+// Errors returned by the blob store implementations.
+var (
+	ErrBlobNotFound   = apperrors.New(apperrors.ErrNotFound, "BLOB_NOT_FOUND", "file not found")
+	ErrInvalidBlobKey = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_BLOB_KEY", "invalid file key")
+)
+
+// Errors returned when checking signed URLs.
+var (
+	ErrInvalidBlobSignature = apperrors.New(apperrors.ErrPermissionDenied, "INVALID_BLOB_SIGNATURE", "invalid file link signature")
+	ErrBlobURLExpired       = apperrors.New(apperrors.ErrPermissionDenied, "BLOB_URL_EXPIRED", "file link has expired")
+)
@@ -0,0 +1,125 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// LocalBlobStore keeps blobs as files below a root directory. It suits a
+// single instance or a shared volume; the content type is not stored.
+type LocalBlobStore struct {
+	root string
+}
+
+var _ ports.BlobStore = (*LocalBlobStore)(nil)
+
+// NewLocalBlobStore creates root if needed and stores blobs below it.
+func NewLocalBlobStore(root string) (*LocalBlobStore, error) {
+	if strings.TrimSpace(root) == "" {
+		return nil, errors.New("blob store root is required")
+	}
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("resolve blob store root: %w", err)
+	}
+	if err := os.MkdirAll(abs, 0o750); err != nil {
+		return nil, fmt.Errorf("create blob store root: %w", err)
+	}
+	return &LocalBlobStore{root: abs}, nil
+}
+
+// Put writes content to a temporary file and renames it into place, so
+// readers never see a partial blob.
+func (s *LocalBlobStore) Put(ctx context.Context, key string, content io.Reader, _ string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return fmt.Errorf("create blob directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("create blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, readerWithContext{ctx: ctx, r: content}); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close blob file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("store blob: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	_ = ctx
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("open blob: %w", err)
+	}
+	return f, nil
+}
+
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	_ = ctx
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete blob: %w", err)
+	}
+	return nil
+}
+
+// path maps key to a file below the root.
+func (s *LocalBlobStore) path(key string) (string, error) {
+	if err := checkKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// checkKey rejects keys that are absolute or not clean relative paths, so no
+// provider can be made to escape its root.
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || path.Clean(key) != key || key == "." || strings.HasPrefix(key, "../") || key == ".." {
+		return ErrInvalidBlobKey
+	}
+	return nil
+}
+
+// readerWithContext stops a copy once ctx is done.
+type readerWithContext struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r readerWithContext) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
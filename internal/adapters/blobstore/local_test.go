@@ -0,0 +1,57 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+
+	if err := store.Put(ctx, "kyc/1/front", strings.NewReader("first"), "image/png"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if err := store.Put(ctx, "kyc/1/front", strings.NewReader("second"), "image/png"); err != nil {
+		t.Fatalf("replace: %v", err)
+	}
+	rc, err := store.Get(ctx, "kyc/1/front")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	content, err := io.ReadAll(rc)
+	_ = rc.Close()
+	if err != nil || string(content) != "second" {
+		t.Fatalf("content = %q, %v; want second", content, err)
+	}
+
+	if err := store.Delete(ctx, "kyc/1/front"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := store.Delete(ctx, "kyc/1/front"); err != nil {
+		t.Fatalf("delete missing: %v", err)
+	}
+	if _, err := store.Get(ctx, "kyc/1/front"); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("get deleted = %v; want not found", err)
+	}
+}
+
+func TestLocalBlobStoreRejectsEscapingKeys(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	for _, key := range []string{"", "/etc/passwd", "../outside", "kyc/../../outside", "kyc//1", `kyc\1`, "."} {
+		if err := store.Put(context.Background(), key, strings.NewReader("x"), ""); !errors.Is(err, ErrInvalidBlobKey) {
+			t.Errorf("Put(%q) = %v; want ErrInvalidBlobKey", key, err)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// s3RequestTimeout bounds a single S3 request including the transfer.
+const s3RequestTimeout = 60 * time.Second
+
+const (
+	amzDateFormat   = "20060102T150405Z"
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+	s3ServiceName   = "s3"
+	emptyBodySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// S3Config addresses a bucket of Amazon S3 or a compatible service such as
+// MinIO.
+type S3Config struct {
+	// Endpoint is the service URL, e.g. "https://s3.ap-southeast-1.amazonaws.com"
+	// or "http://127.0.0.1:9000". Buckets are addressed path style.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3BlobStore keeps blobs as objects of an S3 bucket. Requests are signed
+// with AWS Signature Version 4; the bucket must exist.
+type S3BlobStore struct {
+	cfg      S3Config
+	endpoint *url.URL
+	client   *http.Client
+}
+
+var _ ports.BlobStore = (*S3BlobStore)(nil)
+
+func NewS3BlobStore(cfg S3Config) (*S3BlobStore, error) {
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+	switch {
+	case cfg.Region == "":
+		return nil, errors.New("s3 region is required")
+	case cfg.Bucket == "":
+		return nil, errors.New("s3 bucket is required")
+	case cfg.AccessKeyID == "" || cfg.SecretAccessKey == "":
+		return nil, errors.New("s3 credentials are required")
+	}
+	return &S3BlobStore{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: s3RequestTimeout},
+	}, nil
+}
+
+// Put buffers content to sign its hash; uploads are bounded by the callers.
+func (s *S3BlobStore) Put(ctx context.Context, key string, content io.Reader, contentType string) error {
+	body, err := io.ReadAll(readerWithContext{ctx: ctx, r: content})
+	if err != nil {
+		return fmt.Errorf("read blob: %w", err)
+	}
+	req, err := s.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := s.do(req, body)
+	if err != nil {
+		return fmt.Errorf("put blob: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("put blob: %w", s3Error(resp))
+	}
+	return nil
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get blob: %w", err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrBlobNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("get blob: %w", s3Error(resp))
+	}
+}
+
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return fmt.Errorf("delete blob: %w", err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusNoContent, http.StatusOK, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("delete blob: %w", s3Error(resp))
+	}
+}
+
+func (s *S3BlobStore) newRequest(ctx context.Context, method, key string, body []byte) (*http.Request, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	u := *s.endpoint
+	u.Path = s.endpoint.Path + "/" + s.cfg.Bucket + "/" + key
+	u.RawPath = s.endpoint.EscapedPath() + "/" + awsURIEncode(s.cfg.Bucket, true) + "/" + awsURIEncode(key, false)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build s3 request: %w", err)
+	}
+	req.ContentLength = int64(len(body))
+	if body == nil {
+		req.Body = http.NoBody
+	}
+	return req, nil
+}
+
+func (s *S3BlobStore) do(req *http.Request, body []byte) (*http.Response, error) {
+	payloadHash := emptyBodySHA256
+	if len(body) > 0 {
+		sum := sha256.Sum256(body)
+		payloadHash = hex.EncodeToString(sum[:])
+	}
+	signV4(req, s.cfg, payloadHash, time.Now().UTC())
+	return s.client.Do(req)
+}
+
+// s3Error reads the code of an S3 XML error response.
+func s3Error(resp *http.Response) error {
+	var body struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if xml.Unmarshal(data, &body) == nil && body.Code != "" {
+		return fmt.Errorf("s3 status %d: %s: %s", resp.StatusCode, body.Code, body.Message)
+	}
+	return fmt.Errorf("s3 status %d", resp.StatusCode)
+}
+
+// signV4 adds the X-Amz-Date, X-Amz-Content-Sha256 and Authorization headers
+// of AWS Signature Version 4. Every header already set on req is signed.
+func signV4(req *http.Request, cfg S3Config, payloadHash string, now time.Time) {
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := []string{"host"}
+	for name := range req.Header {
+		signedHeaders = append(signedHeaders, strings.ToLower(name))
+	}
+	sort.Strings(signedHeaders)
+
+	scope, signature := sigV4Signature(req, cfg, signedHeaders, payloadHash)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, cfg.AccessKeyID, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+// sigV4Signature returns the credential scope and the signature of req over
+// the sorted signedHeaders. The time is taken from the X-Amz-Date header.
+func sigV4Signature(req *http.Request, cfg S3Config, signedHeaders []string, payloadHash string) (string, string) {
+	amzDate := req.Header.Get("X-Amz-Date")
+	day := amzDate
+	if len(day) > 8 {
+		day = day[:8]
+	}
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	var canonical strings.Builder
+	canonical.WriteString(req.Method + "\n")
+	canonical.WriteString(req.URL.EscapedPath() + "\n")
+	canonical.WriteString(canonicalQuery(req.URL.Query()) + "\n")
+	for _, name := range signedHeaders {
+		value := host
+		if name != "host" {
+			value = strings.Join(req.Header.Values(name), ",")
+		}
+		canonical.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	canonical.WriteString("\n" + strings.Join(signedHeaders, ";") + "\n" + payloadHash)
+
+	scope := day + "/" + cfg.Region + "/" + s3ServiceName + "/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonical.String()))
+	stringToSign := sigV4Algorithm + "\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+cfg.SecretAccessKey), day)
+	key = hmacSHA256(key, cfg.Region)
+	key = hmacSHA256(key, s3ServiceName)
+	key = hmacSHA256(key, "aws4_request")
+	return scope, hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func canonicalQuery(query url.Values) string {
+	pairs := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, awsURIEncode(name, true)+"="+awsURIEncode(value, true))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// awsURIEncode percent-encodes everything but the RFC 3986 unreserved
+// characters, and slashes unless encodeSlash is set.
+func awsURIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,103 @@
+package blobstore
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// minURLSecretSize is the shortest accepted URL signing secret.
+const minURLSecretSize = 16
+
+// URLSigner signs URLs of the HTTP gateway's blob endpoint with HMAC-SHA256.
+// The signature covers the method, the key, the expiry and for uploads the
+// upload policy, so none of them can be changed by the holder of a URL.
+type URLSigner struct {
+	secret  []byte
+	baseURL string
+}
+
+var _ ports.BlobURLSigner = (*URLSigner)(nil)
+
+// NewURLSigner signs URLs below baseURL, for example
+// "https://api.example.com/api/v1/blobs" or just "/api/v1/blobs".
+func NewURLSigner(secret, baseURL string) (*URLSigner, error) {
+	if len(secret) < minURLSecretSize {
+		return nil, fmt.Errorf("blob url secret must be at least %d bytes", minURLSecretSize)
+	}
+	if strings.TrimSpace(baseURL) == "" {
+		return nil, errors.New("blob url base is required")
+	}
+	return &URLSigner{secret: []byte(secret), baseURL: strings.TrimRight(baseURL, "/")}, nil
+}
+
+func (s *URLSigner) DownloadURL(key string, expires time.Time) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	return s.url(http.MethodGet, key, query)
+}
+
+func (s *URLSigner) UploadURL(key string, expires time.Time, policy ports.BlobUploadPolicy) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("max_bytes", strconv.FormatInt(policy.MaxBytes, 10))
+	query.Set("content_types", strings.Join(policy.ContentTypes, ","))
+	return s.url(http.MethodPut, key, query)
+}
+
+// Verify checks that query, taken from a request for key, carries a valid
+// signature for method that has not expired at now. For uploads it returns
+// the signed upload policy.
+func (s *URLSigner) Verify(method, key string, query url.Values, now time.Time) (ports.BlobUploadPolicy, error) {
+	got, err := base64.RawURLEncoding.DecodeString(query.Get("signature"))
+	if err != nil || !hmac.Equal(got, s.sign(method, key, query)) {
+		return ports.BlobUploadPolicy{}, ErrInvalidBlobSignature
+	}
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return ports.BlobUploadPolicy{}, ErrInvalidBlobSignature
+	}
+	if !now.Before(time.Unix(expires, 0)) {
+		return ports.BlobUploadPolicy{}, ErrBlobURLExpired
+	}
+	if method != http.MethodPut {
+		return ports.BlobUploadPolicy{}, nil
+	}
+
+	maxBytes, err := strconv.ParseInt(query.Get("max_bytes"), 10, 64)
+	if err != nil || maxBytes <= 0 {
+		return ports.BlobUploadPolicy{}, ErrInvalidBlobSignature
+	}
+	policy := ports.BlobUploadPolicy{MaxBytes: maxBytes}
+	if types := query.Get("content_types"); types != "" {
+		policy.ContentTypes = strings.Split(types, ",")
+	}
+	return policy, nil
+}
+
+func (s *URLSigner) url(method, key string, query url.Values) string {
+	query.Set("signature", base64.RawURLEncoding.EncodeToString(s.sign(method, key, query)))
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return s.baseURL + "/" + strings.Join(segments, "/") + "?" + query.Encode()
+}
+
+func (s *URLSigner) sign(method, key string, query url.Values) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	for _, part := range []string{method, key, query.Get("expires"), query.Get("max_bytes"), query.Get("content_types")} {
+		mac.Write([]byte(part))
+		mac.Write([]byte{'\n'})
+	}
+	return mac.Sum(nil)
+}
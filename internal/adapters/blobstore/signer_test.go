@@ -0,0 +1,62 @@
+package blobstore
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func TestURLSigner(t *testing.T) {
+	signer, err := NewURLSigner("0123456789abcdef0123", "https://api.example.com/api/v1/blobs/")
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+	now := time.Unix(1_700_000_000, 0)
+	policy := ports.BlobUploadPolicy{MaxBytes: 1024, ContentTypes: []string{"image/png", "image/jpeg"}}
+
+	upload, err := url.Parse(signer.UploadURL("avatars/7/a b", now.Add(time.Minute), policy))
+	if err != nil {
+		t.Fatalf("parse upload url: %v", err)
+	}
+	if upload.EscapedPath() != "/api/v1/blobs/avatars/7/a%20b" {
+		t.Fatalf("upload path = %q", upload.EscapedPath())
+	}
+	got, err := signer.Verify(http.MethodPut, "avatars/7/a b", upload.Query(), now)
+	if err != nil {
+		t.Fatalf("verify upload: %v", err)
+	}
+	if got.MaxBytes != policy.MaxBytes || strings.Join(got.ContentTypes, ",") != "image/png,image/jpeg" {
+		t.Fatalf("policy = %+v; want %+v", got, policy)
+	}
+
+	if _, err := signer.Verify(http.MethodGet, "avatars/7/a b", upload.Query(), now); !errors.Is(err, ErrInvalidBlobSignature) {
+		t.Errorf("upload url used for download = %v; want ErrInvalidBlobSignature", err)
+	}
+	if _, err := signer.Verify(http.MethodPut, "avatars/8/a b", upload.Query(), now); !errors.Is(err, ErrInvalidBlobSignature) {
+		t.Errorf("upload url used for another key = %v; want ErrInvalidBlobSignature", err)
+	}
+	tampered := upload.Query()
+	tampered.Set("max_bytes", "1048576")
+	if _, err := signer.Verify(http.MethodPut, "avatars/7/a b", tampered, now); !errors.Is(err, ErrInvalidBlobSignature) {
+		t.Errorf("raised size limit = %v; want ErrInvalidBlobSignature", err)
+	}
+	if _, err := signer.Verify(http.MethodPut, "avatars/7/a b", upload.Query(), now.Add(time.Minute)); !errors.Is(err, ErrBlobURLExpired) {
+		t.Errorf("expired upload url = %v; want ErrBlobURLExpired", err)
+	}
+
+	download, err := url.Parse(signer.DownloadURL("avatars/7/a b", now.Add(time.Hour)))
+	if err != nil {
+		t.Fatalf("parse download url: %v", err)
+	}
+	if _, err := signer.Verify(http.MethodGet, "avatars/7/a b", download.Query(), now); err != nil {
+		t.Errorf("verify download: %v", err)
+	}
+	if _, err := NewURLSigner("short", "/api/v1/blobs"); err == nil {
+		t.Error("short secret accepted")
+	}
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// anonymizedBirthday replaces the birthday of anonymized accounts; the column
+// is NOT NULL so it cannot simply be cleared.
+var anonymizedBirthday = time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// anonymizedIdentity returns the placeholder username and email of an
+// anonymized account. They are unique per id and free the original values for
+// new registrations.
+func anonymizedIdentity(userID int64) (string, string) {
+	username := fmt.Sprintf("deleted-%d", userID)
+	return username, username + "@deleted.invalid"
+}
+
+// redactedOutboxPayload replaces the payload of an anonymized user's outbox
+// events. The notifier skips payloads without an email, so the rewrite
+// captured by CDC does not resend anything.
+const redactedOutboxPayload = `{"redacted":true}`
+
+// changeableAccountStatus reports whether ChangeAccountStatus may move an
+// account to status; deleted is only reachable through AnonymizeUser.
+func changeableAccountStatus(status userentity.AccountStatus) bool {
+	switch status {
+	case userentity.AccountStatusActive, userentity.AccountStatusDeactivated, userentity.AccountStatusPendingDeletion:
+		return true
+	}
+	return false
+}
+
+func containsAccountStatus(statuses []userentity.AccountStatus, status userentity.AccountStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// listStatuses returns the statuses a ListUsers filter selects.
+func listStatuses(statuses []userentity.AccountStatus) []userentity.AccountStatus {
+	if len(statuses) == 0 {
+		return []userentity.AccountStatus{userentity.AccountStatusActive}
+	}
+	return statuses
+}
+
+func validDataExportFormat(format userentity.DataExportFormat) bool {
+	return format == userentity.DataExportFormatJSON || format == userentity.DataExportFormatZIP
+}
+
+// dataExportInProgress reports whether an export still waits for the worker.
+func dataExportInProgress(status userentity.DataExportStatus) bool {
+	return status == userentity.DataExportStatusPending || status == userentity.DataExportStatusProcessing
+}
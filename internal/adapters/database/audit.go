@@ -0,0 +1,61 @@
+package database
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// Column limits of user_events; longer request metadata is truncated rather
+// than failing the audited request.
+const (
+	auditMethodMaxLen    = 128
+	auditIPMaxLen        = 64
+	auditUserAgentMaxLen = 255
+	auditRequestIDMaxLen = 64
+)
+
+// auditListLimit returns the page size ListAuditEvents uses for limit.
+func auditListLimit(limit int) int {
+	if limit <= 0 {
+		return 50
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+// normalizeAuditEvent validates event and truncates its request metadata to
+// the column limits.
+func normalizeAuditEvent(event userentity.AuditEvent) (userentity.AuditEvent, error) {
+	if event.UserID <= 0 || strings.TrimSpace(string(event.Action)) == "" {
+		return userentity.AuditEvent{}, ErrInvalidAuditEvent
+	}
+	event.Method = truncate(event.Method, auditMethodMaxLen)
+	event.IP = truncate(event.IP, auditIPMaxLen)
+	event.UserAgent = truncate(event.UserAgent, auditUserAgentMaxLen)
+	event.RequestID = truncate(event.RequestID, auditRequestIDMaxLen)
+	return event, nil
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8 sequence.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func containsAuditAction(actions []userentity.AuditAction, action userentity.AuditAction) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func validCorporateAction(action userentity.CorporateAction) bool {
+	if action.Code == "" || action.ExDate.IsZero() || action.RecordDate.Before(action.ExDate) {
+		return false
+	}
+	switch action.Kind {
+	case userentity.CorporateActionCashDividend:
+		return action.CashPerShare > 0 && action.OldShares == 0 && action.NewShares == 0
+	case userentity.CorporateActionStockDividend, userentity.CorporateActionSplit:
+		return action.CashPerShare == 0 && action.OldShares > 0 && action.NewShares > action.OldShares
+	}
+	return false
+}
+
+// corporateActionReference is the ledger reference of the entries an action
+// posts.
+func corporateActionReference(actionID int64) string {
+	return fmt.Sprintf("corporate_action:%d", actionID)
+}
+
+// corporateActionEntries checks the entries action posts at at and sets the
+// stock of those moving shares, which must be shares of the action's stock.
+func corporateActionEntries(action userentity.CorporateAction, entries []userentity.LedgerEntry, at time.Time) ([]userentity.LedgerEntry, bool) {
+	checked := make([]userentity.LedgerEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.UserID <= 0 || entry.Amount == 0 || !entry.Type.Valid() || len(entry.Note) > ledgerNoteMaxLen {
+			return nil, false
+		}
+		entry.StockID, entry.CreatedAt = 0, at
+		if !entry.Cash() {
+			if entry.Code != action.Code {
+				return nil, false
+			}
+			entry.StockID = action.StockID
+		}
+		checked = append(checked, entry)
+	}
+	return checked, true
+}
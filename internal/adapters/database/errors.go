@@ -0,0 +1,68 @@
+package database
+
+import (
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// Errors returned by the repository implementations. Callers classify them
+// with errors.Is against the apperrors kinds (or these values directly).
+var (
+	ErrUserNotFound              = apperrors.New(apperrors.ErrNotFound, "USER_NOT_FOUND", "user not found")
+	ErrUserAlreadyExists         = apperrors.New(apperrors.ErrConflict, "USER_ALREADY_EXISTS", "username or email already exists")
+	ErrUserVersionConflict       = apperrors.New(apperrors.ErrAborted, "USER_VERSION_CONFLICT", "user was modified concurrently")
+	ErrVerificationTokenNotFound = apperrors.New(apperrors.ErrNotFound, "VERIFICATION_TOKEN_NOT_FOUND", "verification token not found")
+	ErrVerificationTokenConsumed = apperrors.New(apperrors.ErrFailedPrecondition, "VERIFICATION_TOKEN_USED", "verification token already used or not found")
+	ErrOutboxEventNotFound       = apperrors.New(apperrors.ErrNotFound, "OUTBOX_EVENT_NOT_FOUND", "outbox event not found")
+	ErrInvalidOutboxStatus       = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_OUTBOX_STATUS", "invalid outbox status")
+	ErrInvalidAccountStatus      = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ACCOUNT_STATUS", "invalid account status transition")
+	ErrAccountStatusConflict     = apperrors.New(apperrors.ErrFailedPrecondition, "ACCOUNT_STATUS_CONFLICT", "account status does not allow this change")
+	ErrAccountNotEmpty           = apperrors.New(apperrors.ErrFailedPrecondition, "ACCOUNT_NOT_EMPTY", "account still holds cash or shares, or has trades, orders or withdrawals in progress")
+	ErrDataExportNotFound        = apperrors.New(apperrors.ErrNotFound, "DATA_EXPORT_NOT_FOUND", "data export not found")
+	ErrDataExportInProgress      = apperrors.New(apperrors.ErrConflict, "DATA_EXPORT_IN_PROGRESS", "a data export is already in progress")
+	ErrDataExportNotProcessing   = apperrors.New(apperrors.ErrFailedPrecondition, "DATA_EXPORT_NOT_PROCESSING", "data export is not being processed")
+	ErrInvalidDataExport         = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_DATA_EXPORT", "invalid data export format or status")
+	ErrInvalidAuditEvent         = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_AUDIT_EVENT", "audit event needs a user and an action")
+	ErrInvalidLoginRecord        = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_LOGIN_RECORD", "login record needs a user")
+	ErrInvalidKycDocument        = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_KYC_DOCUMENT", "document needs a user, a kind and a file")
+	ErrInvalidKycSubmission      = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_KYC_SUBMISSION", "invalid KYC submission or review")
+	ErrKycDocumentNotFound       = apperrors.New(apperrors.ErrNotFound, "KYC_DOCUMENT_NOT_FOUND", "document not found or already submitted")
+	ErrKycSubmissionNotFound     = apperrors.New(apperrors.ErrNotFound, "KYC_SUBMISSION_NOT_FOUND", "KYC submission not found")
+	ErrKycStatusConflict         = apperrors.New(apperrors.ErrFailedPrecondition, "KYC_STATUS_CONFLICT", "identity verification status does not allow this change")
+	ErrStockNotFound             = ports.ErrStockNotFound
+	ErrInvalidWatchlist          = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_WATCHLIST", "watchlist needs a user and a name")
+	ErrWatchlistNotFound         = apperrors.New(apperrors.ErrNotFound, "WATCHLIST_NOT_FOUND", "watchlist not found")
+	ErrWatchlistNameTaken        = apperrors.New(apperrors.ErrConflict, "WATCHLIST_NAME_TAKEN", "a watchlist with this name already exists")
+	ErrWatchlistStockExists      = apperrors.New(apperrors.ErrConflict, "WATCHLIST_STOCK_EXISTS", "stock is already on the watchlist")
+	ErrWatchlistStockNotFound    = apperrors.New(apperrors.ErrNotFound, "WATCHLIST_STOCK_NOT_FOUND", "stock is not on the watchlist")
+	ErrWatchlistOrderMismatch    = apperrors.New(apperrors.ErrFailedPrecondition, "WATCHLIST_ORDER_MISMATCH", "new order must list exactly the stocks on the watchlist")
+	ErrInvalidPriceAlert         = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_PRICE_ALERT", "price alert needs a user, a stock and a valid condition")
+	ErrPriceAlertNotFound        = apperrors.New(apperrors.ErrNotFound, "PRICE_ALERT_NOT_FOUND", "price alert not found")
+	ErrInvalidNews               = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_NEWS", "news needs a category, a title and a publication time")
+	ErrNewsNotFound              = apperrors.New(apperrors.ErrNotFound, "NEWS_NOT_FOUND", "news not found")
+	ErrNewsExternalIDTaken       = apperrors.New(apperrors.ErrConflict, "NEWS_EXTERNAL_ID_TAKEN", "news with this external id already exists")
+	ErrInvalidOrder              = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER", "order needs a user, a stock, a side, a time in force, a price and a quantity")
+	ErrOrderNotFound             = apperrors.New(apperrors.ErrNotFound, "ORDER_NOT_FOUND", "order not found")
+	ErrOrderNotOpen              = apperrors.New(apperrors.ErrFailedPrecondition, "ORDER_NOT_OPEN", "order is filled, cancelled, rejected or expired")
+	ErrOrderQuantityBelowFilled  = apperrors.New(apperrors.ErrFailedPrecondition, "ORDER_QUANTITY_BELOW_FILLED", "order quantity must exceed the filled quantity")
+	ErrOrderOverfill             = apperrors.New(apperrors.ErrFailedPrecondition, "ORDER_OVERFILL", "fill exceeds the remaining quantity of the order")
+	ErrInvalidOrderMatch         = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER_MATCH", "a match needs a buy and a sell order of the same stock")
+	ErrInvalidLedgerEntry        = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_LEDGER_ENTRY", "ledger entries need a reference of at most 64 characters, a user, a type and a non-zero amount")
+	ErrInvalidTradingTier        = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_TRADING_TIER", "trading tier must be 1 to 32 characters")
+	ErrInvalidPayment            = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_PAYMENT", "payment needs a user, a kind, a positive amount and an idempotency key of at most 64 characters")
+	ErrPaymentNotFound           = apperrors.New(apperrors.ErrNotFound, "PAYMENT_NOT_FOUND", "payment not found")
+	ErrPaymentStatusConflict     = apperrors.New(apperrors.ErrFailedPrecondition, "PAYMENT_STATUS_CONFLICT", "payment status does not allow this change")
+	ErrOrderFillNotFound         = apperrors.New(apperrors.ErrNotFound, "ORDER_FILL_NOT_FOUND", "order fill not found")
+	ErrInvalidStatement          = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_STATEMENT", "statement needs a user, a period, its bounds and the keys of its files")
+	ErrStatementNotFound         = apperrors.New(apperrors.ErrNotFound, "STATEMENT_NOT_FOUND", "statement not found")
+	ErrInvalidFeeSchedule        = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_FEE_SCHEDULE", "fee schedule needs a tier, an effective date, rates of at most 10000 basis points and volume tiers in ascending order")
+	ErrFeeScheduleExists         = apperrors.New(apperrors.ErrConflict, "FEE_SCHEDULE_EXISTS", "the tier already has a fee schedule taking effect at this time")
+	ErrFeeScheduleNotFound       = apperrors.New(apperrors.ErrNotFound, "FEE_SCHEDULE_NOT_FOUND", "fee schedule not found")
+	ErrFeeScheduleInEffect       = apperrors.New(apperrors.ErrFailedPrecondition, "FEE_SCHEDULE_IN_EFFECT", "fee schedule has taken effect already")
+	ErrInvalidCorporateAction    = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_CORPORATE_ACTION", "corporate action needs a stock, a kind with its terms, an ex-date and a record date on or after it")
+	ErrCorporateActionExists     = apperrors.New(apperrors.ErrConflict, "CORPORATE_ACTION_EXISTS", "the stock already has a corporate action of this kind on the ex-date")
+	ErrCorporateActionNotFound   = apperrors.New(apperrors.ErrNotFound, "CORPORATE_ACTION_NOT_FOUND", "corporate action not found")
+	ErrCorporateActionApplied    = apperrors.New(apperrors.ErrFailedPrecondition, "CORPORATE_ACTION_APPLIED", "corporate action has been applied already")
+	ErrInvalidPortfolioSnapshot  = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_PORTFOLIO_SNAPSHOT", "portfolio snapshot needs a user and a date")
+	ErrPortfolioSnapshotNotFound = apperrors.New(apperrors.ErrNotFound, "PORTFOLIO_SNAPSHOT_NOT_FOUND", "portfolio snapshot not found")
+)
@@ -0,0 +1,27 @@
+package database
+
+import userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+
+// feeRateMaxBps caps every rate of a fee schedule at the whole value of a
+// fill.
+const feeRateMaxBps = 10000
+
+func validFeeSchedule(schedule userentity.FeeSchedule) bool {
+	if !validTradingTier(schedule.Tier) || schedule.EffectiveFrom.IsZero() ||
+		!validFeeRate(schedule.BuyRateBps) || !validFeeRate(schedule.SellRateBps) || !validFeeRate(schedule.SellTaxBps) ||
+		schedule.MinFee < 0 || schedule.MaxFee < 0 || (schedule.MaxFee > 0 && schedule.MaxFee < schedule.MinFee) {
+		return false
+	}
+	var floor int64
+	for _, tier := range schedule.VolumeTiers {
+		if tier.MinMonthlyValue <= floor || !validFeeRate(tier.BuyRateBps) || !validFeeRate(tier.SellRateBps) {
+			return false
+		}
+		floor = tier.MinMonthlyValue
+	}
+	return true
+}
+
+func validFeeRate(bps int64) bool {
+	return bps >= 0 && bps <= feeRateMaxBps
+}
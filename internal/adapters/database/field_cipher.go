@@ -0,0 +1,135 @@
+package database
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
+)
+
+// Sensitive users columns. The names double as the additional data bound to
+// each encrypted value.
+const (
+	columnCmnd             = "cmnd"
+	columnBirthday         = "birthday"
+	columnPermanentAddress = "permanent_address"
+	columnPhoneNumber      = "phone_number"
+)
+
+// FieldCipher encrypts the sensitive users columns and derives the blind
+// indexes used for equality lookups on cmnd and phone_number.
+type FieldCipher interface {
+	Encrypt(field, plaintext string) (string, error)
+	// Decrypt must return values that were never encrypted unchanged.
+	Decrypt(field, value string) (string, error)
+	BlindIndex(field, value string) string
+	NeedsReencrypt(value string) bool
+}
+
+var _ FieldCipher = (*security.FieldCipher)(nil)
+
+// plaintextFields stores the sensitive columns as is. Its blind indexes are
+// unkeyed hashes, so lookups go through the index columns either way.
+type plaintextFields struct{}
+
+func (plaintextFields) Encrypt(_, plaintext string) (string, error) { return plaintext, nil }
+
+func (plaintextFields) Decrypt(_, value string) (string, error) { return value, nil }
+
+func (plaintextFields) BlindIndex(field, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(field + "\x00" + value))
+	return hex.EncodeToString(sum[:16])
+}
+
+func (plaintextFields) NeedsReencrypt(string) bool { return false }
+
+// sealedUser is the stored form of a user's sensitive columns.
+type sealedUser struct {
+	cmnd             string
+	birthday         string
+	permanentAddress string
+	phoneNumber      string
+	cmndIndex        sql.NullString
+	phoneNumberIndex sql.NullString
+}
+
+func sealUser(fields FieldCipher, u userentity.User) (sealedUser, error) {
+	var (
+		s   sealedUser
+		err error
+	)
+	if s.cmnd, err = fields.Encrypt(columnCmnd, u.DocumentID); err != nil {
+		return sealedUser{}, fmt.Errorf("encrypt %s: %w", columnCmnd, err)
+	}
+	if s.birthday, err = fields.Encrypt(columnBirthday, formatBirthday(u.Birthday)); err != nil {
+		return sealedUser{}, fmt.Errorf("encrypt %s: %w", columnBirthday, err)
+	}
+	if s.permanentAddress, err = fields.Encrypt(columnPermanentAddress, u.PermanentAddress); err != nil {
+		return sealedUser{}, fmt.Errorf("encrypt %s: %w", columnPermanentAddress, err)
+	}
+	if s.phoneNumber, err = fields.Encrypt(columnPhoneNumber, u.PhoneNumber); err != nil {
+		return sealedUser{}, fmt.Errorf("encrypt %s: %w", columnPhoneNumber, err)
+	}
+	s.cmndIndex = blindIndex(fields, columnCmnd, u.DocumentID)
+	s.phoneNumberIndex = blindIndex(fields, columnPhoneNumber, u.PhoneNumber)
+	return s, nil
+}
+
+// openUser decrypts the sensitive fields of u in place; birthday is the stored
+// birthday column.
+func openUser(fields FieldCipher, u *userentity.User, birthday string) error {
+	var err error
+	if u.DocumentID, err = fields.Decrypt(columnCmnd, u.DocumentID); err != nil {
+		return err
+	}
+	if u.PermanentAddress, err = fields.Decrypt(columnPermanentAddress, u.PermanentAddress); err != nil {
+		return err
+	}
+	if u.PhoneNumber, err = fields.Decrypt(columnPhoneNumber, u.PhoneNumber); err != nil {
+		return err
+	}
+	if birthday, err = fields.Decrypt(columnBirthday, birthday); err != nil {
+		return err
+	}
+	if u.Birthday, err = parseBirthday(birthday); err != nil {
+		return err
+	}
+	return nil
+}
+
+func blindIndex(fields FieldCipher, field, value string) sql.NullString {
+	index := fields.BlindIndex(field, value)
+	return sql.NullString{String: index, Valid: index != ""}
+}
+
+func formatBirthday(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.DateOnly)
+}
+
+// parseBirthday accepts the date formats a birthday column may hold: dates
+// written by formatBirthday and values converted from the former DATE column.
+func parseBirthday(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if len(value) > len(time.DateOnly) {
+		value = value[:len(time.DateOnly)]
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse %s: %w", columnBirthday, err)
+	}
+	return t, nil
+}
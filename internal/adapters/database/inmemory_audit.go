@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// AppendAuditEvents stores the events; all of them or none are stored.
+func (r *InMemoryUserRepository) AppendAuditEvents(ctx context.Context, events []userentity.AuditEvent) error {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	normalized := make([]userentity.AuditEvent, 0, len(events))
+	for _, event := range events {
+		event, err := normalizeAuditEvent(event)
+		if err != nil {
+			return err
+		}
+		if _, ok := r.usersByID[event.UserID]; !ok {
+			return ErrUserNotFound
+		}
+		if event.CreatedAt.IsZero() {
+			event.CreatedAt = time.Now().UTC()
+		}
+		event.Changes = copyAuditChanges(event.Changes)
+		normalized = append(normalized, event)
+	}
+	for _, event := range normalized {
+		r.nextAuditID++
+		event.ID = r.nextAuditID
+		r.auditEvents = append(r.auditEvents, event)
+	}
+	return nil
+}
+
+// ListAuditEvents returns matching events, newest first.
+func (r *InMemoryUserRepository) ListAuditEvents(ctx context.Context, params ports.ListAuditEventsParams) ([]userentity.AuditEvent, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	limit := auditListLimit(params.Limit)
+	result := make([]userentity.AuditEvent, 0, limit)
+	for i := len(r.auditEvents) - 1; i >= 0 && len(result) < limit; i-- {
+		event := r.auditEvents[i]
+		if params.BeforeID > 0 && event.ID >= params.BeforeID {
+			continue
+		}
+		if !matchesAuditFilter(event, params.Filter) {
+			continue
+		}
+		event.Changes = copyAuditChanges(event.Changes)
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+func matchesAuditFilter(e userentity.AuditEvent, f ports.AuditEventFilter) bool {
+	if f.UserID != 0 && e.UserID != f.UserID {
+		return false
+	}
+	if f.ActorID != 0 && e.ActorID != f.ActorID {
+		return false
+	}
+	if len(f.Actions) > 0 && !containsAuditAction(f.Actions, e.Action) {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && e.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !e.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	return f.RequestID == "" || e.RequestID == f.RequestID
+}
+
+func copyAuditChanges(changes map[string]userentity.AuditChange) map[string]userentity.AuditChange {
+	if len(changes) == 0 {
+		return nil
+	}
+	out := make(map[string]userentity.AuditChange, len(changes))
+	for k, v := range changes {
+		out[k] = v
+	}
+	return out
+}
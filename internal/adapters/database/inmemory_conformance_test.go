@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports/repotest"
+)
+
+func TestInMemoryUserRepositoryConformance(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		repo := NewInMemoryUserRepository()
+		return repotest.Repositories{
+			Users:       repo,
+			Outbox:      repo,
+			DataExports: repo,
+			Audit:       repo,
+			Logins:      repo,
+			Kyc:         repo,
+			Stocks:      repo,
+			Watchlists:  repo,
+			PriceAlerts: repo,
+			News:        repo,
+			Orders:      repo,
+			Accounts:    repo,
+			Payments:    repo,
+			Statements:  repo,
+			Fees:        repo,
+			Actions:     repo,
+			Portfolios:  repo,
+			LatestOutboxEventID: func(ctx context.Context, aggregateID int64) (int64, error) {
+				repo.mu.RLock()
+				defer repo.mu.RUnlock()
+				for i := len(repo.outboxEvents) - 1; i >= 0; i-- {
+					if repo.outboxEvents[i].AggregateID == aggregateID {
+						return repo.outboxEvents[i].ID, nil
+					}
+				}
+				return 0, fmt.Errorf("outbox event not found")
+			},
+			AddStock: func(t *testing.T, stock userentity.Stock) userentity.Stock {
+				return repo.AddStock(stock)
+			},
+			RecordStockPrice: func(t *testing.T, code string, price int64, at time.Time) {
+				if err := repo.RecordStockPrice(code, price, at); err != nil {
+					t.Fatalf("record stock price: %v", err)
+				}
+			},
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func (r *InMemoryUserRepository) CreateCorporateAction(ctx context.Context, action userentity.CorporateAction) (userentity.CorporateAction, error) {
+	_ = ctx
+	if !validCorporateAction(action) {
+		return userentity.CorporateAction{}, ErrInvalidCorporateAction
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stockID, ok := r.stockByCode[action.Code]
+	if !ok {
+		return userentity.CorporateAction{}, ErrStockNotFound
+	}
+	action.StockID = stockID
+	action.ExDate, action.RecordDate = settlementDate(action.ExDate), settlementDate(action.RecordDate)
+	for _, existing := range r.corpActions {
+		if existing.StockID == stockID && existing.Kind == action.Kind && existing.ExDate.Equal(action.ExDate) {
+			return userentity.CorporateAction{}, ErrCorporateActionExists
+		}
+	}
+	action.Status = userentity.CorporateActionStatusScheduled
+	action.Adjustment = userentity.PriceAdjustment{}
+	action.AppliedAt = time.Time{}
+	action.CreatedAt = orNow(action.CreatedAt)
+	r.nextActionID++
+	action.ID = r.nextActionID
+	r.corpActions[action.ID] = action
+	return action, nil
+}
+
+func (r *InMemoryUserRepository) GetCorporateAction(ctx context.Context, actionID int64) (userentity.CorporateAction, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	action, ok := r.corpActions[actionID]
+	if !ok {
+		return userentity.CorporateAction{}, ErrCorporateActionNotFound
+	}
+	return action, nil
+}
+
+func (r *InMemoryUserRepository) ListCorporateActions(ctx context.Context, code string) ([]userentity.CorporateAction, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	actions := make([]userentity.CorporateAction, 0)
+	for _, action := range r.corpActions {
+		if code == "" || action.Code == code {
+			actions = append(actions, action)
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		if !actions[i].ExDate.Equal(actions[j].ExDate) {
+			return actions[i].ExDate.After(actions[j].ExDate)
+		}
+		return actions[i].ID > actions[j].ID
+	})
+	return actions, nil
+}
+
+func (r *InMemoryUserRepository) ListDueCorporateActions(ctx context.Context, through time.Time) ([]userentity.CorporateAction, error) {
+	_ = ctx
+	through = settlementDate(through)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	due := make([]userentity.CorporateAction, 0)
+	for _, action := range r.corpActions {
+		if action.Status == userentity.CorporateActionStatusScheduled && !action.ExDate.After(through) {
+			due = append(due, action)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].ExDate.Equal(due[j].ExDate) {
+			return due[i].ExDate.Before(due[j].ExDate)
+		}
+		return due[i].ID < due[j].ID
+	})
+	return due, nil
+}
+
+func (r *InMemoryUserRepository) DeleteCorporateAction(ctx context.Context, actionID int64) error {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	action, ok := r.corpActions[actionID]
+	if !ok {
+		return ErrCorporateActionNotFound
+	}
+	if action.Status != userentity.CorporateActionStatusScheduled {
+		return ErrCorporateActionApplied
+	}
+	delete(r.corpActions, actionID)
+	return nil
+}
+
+func (r *InMemoryUserRepository) ListStockHolders(ctx context.Context, stockID int64, at time.Time) ([]ports.StockHolder, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Settlement entries are left out: the settlements they post are counted
+	// from the trade on.
+	shares := make(map[int64]int64)
+	for _, entry := range r.ledger {
+		if entry.StockID == stockID && entry.Type != userentity.LedgerEntrySettlement && entry.CreatedAt.Before(at) {
+			shares[entry.UserID] += entry.Amount
+		}
+	}
+	for _, settlement := range r.settlements {
+		if settlement.StockID == stockID && settlement.CreatedAt.Before(at) {
+			shares[settlement.UserID] += settlement.Amount
+		}
+	}
+	holders := make([]ports.StockHolder, 0)
+	for userID, quantity := range shares {
+		owner, ok := r.users[r.usersByID[userID]]
+		if !ok || quantity <= 0 {
+			continue
+		}
+		holders = append(holders, ports.StockHolder{Owner: owner, Shares: quantity})
+	}
+	sort.Slice(holders, func(i, j int) bool { return holders[i].Owner.Id < holders[j].Owner.Id })
+	return holders, nil
+}
+
+func (r *InMemoryUserRepository) ApplyCorporateAction(ctx context.Context, params ports.ApplyCorporateActionParams) (bool, error) {
+	_ = ctx
+	at := orNow(params.At)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	action, ok := r.corpActions[params.ActionID]
+	if !ok || action.Status != userentity.CorporateActionStatusScheduled {
+		return false, nil
+	}
+	entries, ok := corporateActionEntries(action, params.Entries, at)
+	if !ok || params.Adjustment.Numerator <= 0 || params.Adjustment.Denominator <= 0 {
+		return false, ErrInvalidCorporateAction
+	}
+	for _, entry := range entries {
+		if _, ok := r.usersByID[entry.UserID]; !ok {
+			return false, ErrUserNotFound
+		}
+	}
+
+	r.appendLedgerEntries(entries, corporateActionReference(action.ID))
+	if !params.Adjustment.Identity() {
+		for i, quote := range r.stockPrices {
+			if quote.StockID == action.StockID && quote.At.Before(params.PricesBefore) {
+				r.stockPrices[i].Price = params.Adjustment.Apply(quote.Price)
+			}
+		}
+		if latest, ok := r.stockQuotes[action.StockID]; ok && latest.At.Before(params.PricesBefore) {
+			latest.Price = params.Adjustment.Apply(latest.Price)
+			r.stockQuotes[action.StockID] = latest
+		}
+	}
+	for _, event := range params.Events {
+		r.appendOutboxEvent(event.AggregateID, event, at)
+	}
+	action.Status = userentity.CorporateActionStatusApplied
+	action.Adjustment = params.Adjustment
+	action.AppliedAt = at
+	r.corpActions[action.ID] = action
+	return true, nil
+}
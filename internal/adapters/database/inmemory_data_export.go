@@ -0,0 +1,181 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// CreateDataExport queues a pending export unless one is already in progress.
+func (r *InMemoryUserRepository) CreateDataExport(ctx context.Context, userID int64, format userentity.DataExportFormat, at time.Time) (userentity.DataExport, error) {
+	_ = ctx
+	if !validDataExportFormat(format) {
+		return userentity.DataExport{}, ErrInvalidDataExport
+	}
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[userID]; !ok {
+		return userentity.DataExport{}, ErrUserNotFound
+	}
+	for _, export := range r.dataExports {
+		if export.UserID == userID && dataExportInProgress(export.Status) {
+			return userentity.DataExport{}, ErrDataExportInProgress
+		}
+	}
+
+	r.nextExportID++
+	export := userentity.DataExport{
+		ID:        r.nextExportID,
+		UserID:    userID,
+		Format:    format,
+		Status:    userentity.DataExportStatusPending,
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+	r.dataExports[export.ID] = export
+	return export, nil
+}
+
+// GetDataExport returns an export owned by the user.
+func (r *InMemoryUserRepository) GetDataExport(ctx context.Context, userID, exportID int64) (userentity.DataExport, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	export, ok := r.dataExports[exportID]
+	if !ok || export.UserID != userID {
+		return userentity.DataExport{}, ErrDataExportNotFound
+	}
+	export.Content = append([]byte(nil), export.Content...)
+	return export, nil
+}
+
+// ClaimDataExport hands the oldest claimable export to the caller.
+func (r *InMemoryUserRepository) ClaimDataExport(ctx context.Context, at, staleBefore time.Time) (userentity.DataExport, error) {
+	_ = ctx
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]int64, 0, len(r.dataExports))
+	for id := range r.dataExports {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		export := r.dataExports[id]
+		if export.Status == userentity.DataExportStatusPending ||
+			(export.Status == userentity.DataExportStatusProcessing && export.UpdatedAt.Before(staleBefore)) {
+			export.Status = userentity.DataExportStatusProcessing
+			export.UpdatedAt = at
+			r.dataExports[id] = export
+			return export, nil
+		}
+	}
+	return userentity.DataExport{}, ErrDataExportNotFound
+}
+
+// FinishDataExport stores the outcome of a processing export.
+func (r *InMemoryUserRepository) FinishDataExport(ctx context.Context, params ports.FinishDataExportParams) error {
+	_ = ctx
+	if params.Status != userentity.DataExportStatusReady && params.Status != userentity.DataExportStatusFailed {
+		return ErrInvalidDataExport
+	}
+	if params.At.IsZero() {
+		params.At = time.Now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	export, ok := r.dataExports[params.ExportID]
+	if !ok {
+		return ErrDataExportNotFound
+	}
+	if export.Status != userentity.DataExportStatusProcessing {
+		return ErrDataExportNotProcessing
+	}
+	export.Status = params.Status
+	export.Content = nil
+	export.Error = ""
+	if params.Status == userentity.DataExportStatusReady {
+		export.Content = append([]byte(nil), params.Content...)
+	} else {
+		export.Error = params.Error
+	}
+	export.CompletedAt = params.At
+	export.ExpiresAt = params.ExpiresAt
+	export.UpdatedAt = params.At
+	r.dataExports[export.ID] = export
+	return nil
+}
+
+// DeleteExpiredDataExports drops finished exports past their expiry.
+func (r *InMemoryUserRepository) DeleteExpiredDataExports(ctx context.Context, before time.Time) (int64, error) {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var removed int64
+	for id, export := range r.dataExports {
+		if !dataExportInProgress(export.Status) && !export.ExpiresAt.IsZero() && !export.ExpiresAt.After(before) {
+			delete(r.dataExports, id)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// GetPersonalData copies the user, its verification tokens, outbox events,
+// login history and KYC submissions.
+func (r *InMemoryUserRepository) GetPersonalData(ctx context.Context, userID int64) (ports.PersonalData, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	username, ok := r.usersByID[userID]
+	if !ok {
+		return ports.PersonalData{}, ErrUserNotFound
+	}
+	data := ports.PersonalData{User: r.users[username]}
+	for _, token := range r.tokensByID {
+		if token.UserID == userID {
+			data.VerificationTokens = append(data.VerificationTokens, token)
+		}
+	}
+	sort.Slice(data.VerificationTokens, func(i, j int) bool {
+		return data.VerificationTokens[i].ID < data.VerificationTokens[j].ID
+	})
+	for _, event := range r.outboxEvents {
+		if event.AggregateID == userID {
+			event.Payload = append([]byte(nil), event.Payload...)
+			data.OutboxEvents = append(data.OutboxEvents, event)
+		}
+	}
+	for _, record := range r.loginRecords {
+		if record.UserID == userID {
+			data.LoginHistory = append(data.LoginHistory, record)
+		}
+	}
+	for _, submission := range r.kycSubmits {
+		if submission.UserID == userID {
+			submission.Documents = r.kycSubmissionDocuments(submission.ID)
+			data.KycSubmissions = append(data.KycSubmissions, submission)
+		}
+	}
+	sort.Slice(data.KycSubmissions, func(i, j int) bool {
+		return data.KycSubmissions[i].ID < data.KycSubmissions[j].ID
+	})
+	return data, nil
+}
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func (r *InMemoryUserRepository) CreateFeeSchedule(ctx context.Context, schedule userentity.FeeSchedule) (userentity.FeeSchedule, error) {
+	_ = ctx
+	if !validFeeSchedule(schedule) {
+		return userentity.FeeSchedule{}, ErrInvalidFeeSchedule
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.feeSchedules {
+		if existing.Tier == schedule.Tier && existing.EffectiveFrom.Equal(schedule.EffectiveFrom) {
+			return userentity.FeeSchedule{}, ErrFeeScheduleExists
+		}
+	}
+	schedule.VolumeTiers = append([]userentity.FeeVolumeTier(nil), schedule.VolumeTiers...)
+	schedule.CreatedAt = orNow(schedule.CreatedAt)
+	r.nextFeeID++
+	schedule.ID = r.nextFeeID
+	r.feeSchedules[schedule.ID] = schedule
+	return schedule, nil
+}
+
+func (r *InMemoryUserRepository) ListFeeSchedules(ctx context.Context, tier string) ([]userentity.FeeSchedule, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedules := make([]userentity.FeeSchedule, 0)
+	for _, schedule := range r.feeSchedules {
+		if tier == "" || schedule.Tier == tier {
+			schedules = append(schedules, schedule)
+		}
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		if schedules[i].Tier != schedules[j].Tier {
+			return schedules[i].Tier < schedules[j].Tier
+		}
+		return schedules[i].EffectiveFrom.Before(schedules[j].EffectiveFrom)
+	})
+	return schedules, nil
+}
+
+func (r *InMemoryUserRepository) FeeScheduleAt(ctx context.Context, tier string, at time.Time) (userentity.FeeSchedule, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var found userentity.FeeSchedule
+	for _, schedule := range r.feeSchedules {
+		if schedule.Tier == tier && !schedule.EffectiveFrom.After(at) &&
+			(found.ID == 0 || schedule.EffectiveFrom.After(found.EffectiveFrom)) {
+			found = schedule
+		}
+	}
+	if found.ID == 0 {
+		return userentity.FeeSchedule{}, ErrFeeScheduleNotFound
+	}
+	return found, nil
+}
+
+func (r *InMemoryUserRepository) DeleteFeeSchedule(ctx context.Context, scheduleID int64, at time.Time) error {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schedule, ok := r.feeSchedules[scheduleID]
+	if !ok {
+		return ErrFeeScheduleNotFound
+	}
+	if !schedule.EffectiveFrom.After(at) {
+		return ErrFeeScheduleInEffect
+	}
+	delete(r.feeSchedules, scheduleID)
+	return nil
+}
@@ -0,0 +1,213 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// CreateKycDocument stores an unsubmitted document image.
+func (r *InMemoryUserRepository) CreateKycDocument(ctx context.Context, document userentity.KycDocument) (userentity.KycDocument, error) {
+	_ = ctx
+	if !validKycDocument(document) {
+		return userentity.KycDocument{}, ErrInvalidKycDocument
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[document.UserID]; !ok {
+		return userentity.KycDocument{}, ErrUserNotFound
+	}
+	if document.CreatedAt.IsZero() {
+		document.CreatedAt = time.Now().UTC()
+	}
+	r.nextKycDocID++
+	document.ID = r.nextKycDocID
+	document.SubmissionID = 0
+	r.kycDocuments[document.ID] = document
+	return document, nil
+}
+
+func (r *InMemoryUserRepository) GetKycDocument(ctx context.Context, documentID int64) (userentity.KycDocument, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	document, ok := r.kycDocuments[documentID]
+	if !ok {
+		return userentity.KycDocument{}, ErrKycDocumentNotFound
+	}
+	return document, nil
+}
+
+func (r *InMemoryUserRepository) ListKycDocuments(ctx context.Context, userID int64) ([]userentity.KycDocument, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.kycDocumentsWhere(func(d userentity.KycDocument) bool { return d.UserID == userID }), nil
+}
+
+// SubmitKyc stores a pending submission and moves the user to pending.
+func (r *InMemoryUserRepository) SubmitKyc(ctx context.Context, params ports.SubmitKycParams) (userentity.KycSubmission, error) {
+	_ = ctx
+	submission, err := normalizeKycSubmission(params)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	username, ok := r.usersByID[submission.UserID]
+	if !ok {
+		return userentity.KycSubmission{}, ErrUserNotFound
+	}
+	user := r.users[username]
+	if !kycSubmittable(user.KycStatus) {
+		return userentity.KycSubmission{}, ErrKycStatusConflict
+	}
+	for _, id := range params.DocumentIDs {
+		document, ok := r.kycDocuments[id]
+		if !ok || document.UserID != submission.UserID || document.SubmissionID != 0 {
+			return userentity.KycSubmission{}, ErrKycDocumentNotFound
+		}
+	}
+
+	now := time.Now().UTC()
+	if submission.CreatedAt.IsZero() {
+		submission.CreatedAt = now
+	}
+	submission.UpdatedAt = submission.CreatedAt
+	r.nextKycSubID++
+	submission.ID = r.nextKycSubID
+	submission.Documents = nil
+	r.kycSubmits[submission.ID] = submission
+	for _, id := range params.DocumentIDs {
+		document := r.kycDocuments[id]
+		document.SubmissionID = submission.ID
+		r.kycDocuments[id] = document
+	}
+
+	user.KycStatus = userentity.KycStatusPending
+	user.UpdatedAt = submission.CreatedAt
+	r.users[username] = user
+	r.appendOutboxEvent(submission.UserID, params.OutboxEvent, submission.CreatedAt)
+
+	submission.Documents = r.kycSubmissionDocuments(submission.ID)
+	return submission, nil
+}
+
+func (r *InMemoryUserRepository) GetKycSubmission(ctx context.Context, submissionID int64) (userentity.KycSubmission, userentity.User, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	submission, ok := r.kycSubmits[submissionID]
+	if !ok {
+		return userentity.KycSubmission{}, userentity.User{}, ErrKycSubmissionNotFound
+	}
+	username, ok := r.usersByID[submission.UserID]
+	if !ok {
+		return userentity.KycSubmission{}, userentity.User{}, ErrUserNotFound
+	}
+	submission.Documents = r.kycSubmissionDocuments(submission.ID)
+	return submission, r.users[username], nil
+}
+
+func (r *InMemoryUserRepository) GetLatestKycSubmission(ctx context.Context, userID int64) (userentity.KycSubmission, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var latest userentity.KycSubmission
+	for _, submission := range r.kycSubmits {
+		if submission.UserID == userID && submission.ID > latest.ID {
+			latest = submission
+		}
+	}
+	if latest.ID == 0 {
+		return userentity.KycSubmission{}, ErrKycSubmissionNotFound
+	}
+	latest.Documents = r.kycSubmissionDocuments(latest.ID)
+	return latest, nil
+}
+
+func (r *InMemoryUserRepository) ListKycSubmissions(ctx context.Context, params ports.ListKycSubmissionsParams) ([]userentity.KycSubmission, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]userentity.KycSubmission, 0)
+	for _, submission := range r.kycSubmits {
+		if params.Status != "" && submission.Status != params.Status {
+			continue
+		}
+		if submission.ID <= params.AfterID {
+			continue
+		}
+		result = append(result, submission)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if limit := kycSubmissionsLimit(params.Limit); len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// ReviewKycSubmission records the decision on a pending submission.
+func (r *InMemoryUserRepository) ReviewKycSubmission(ctx context.Context, params ports.ReviewKycParams) (userentity.KycSubmission, error) {
+	_ = ctx
+	params, err := normalizeKycReview(params)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	submission, ok := r.kycSubmits[params.SubmissionID]
+	if !ok {
+		return userentity.KycSubmission{}, ErrKycSubmissionNotFound
+	}
+	if submission.Status != userentity.KycStatusPending {
+		return userentity.KycSubmission{}, ErrKycStatusConflict
+	}
+	submission.Status = params.Status
+	submission.RejectionReason = params.Reason
+	submission.ReviewerID = params.ReviewerID
+	submission.ReviewedAt = params.At
+	submission.UpdatedAt = params.At
+	r.kycSubmits[submission.ID] = submission
+
+	if username, ok := r.usersByID[submission.UserID]; ok {
+		user := r.users[username]
+		user.KycStatus = params.Status
+		user.UpdatedAt = params.At
+		r.users[username] = user
+	}
+	r.appendOutboxEvent(submission.UserID, params.OutboxEvent, params.At)
+
+	submission.Documents = r.kycSubmissionDocuments(submission.ID)
+	return submission, nil
+}
+
+func (r *InMemoryUserRepository) kycSubmissionDocuments(submissionID int64) []userentity.KycDocument {
+	return r.kycDocumentsWhere(func(d userentity.KycDocument) bool { return d.SubmissionID == submissionID })
+}
+
+// kycDocumentsWhere returns the documents matching keep, oldest first.
+func (r *InMemoryUserRepository) kycDocumentsWhere(keep func(userentity.KycDocument) bool) []userentity.KycDocument {
+	documents := make([]userentity.KycDocument, 0)
+	for _, document := range r.kycDocuments {
+		if keep(document) {
+			documents = append(documents, document)
+		}
+	}
+	sort.Slice(documents, func(i, j int) bool { return documents[i].ID < documents[j].ID })
+	return documents
+}
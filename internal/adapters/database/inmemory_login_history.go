@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RecordLogin stores a login and, from a new device, its outbox event.
+func (r *InMemoryUserRepository) RecordLogin(ctx context.Context, params ports.RecordLoginParams) (userentity.LoginRecord, error) {
+	_ = ctx
+	record, err := normalizeLoginRecord(params.Record, userentity.LoginRecordTypeLogin)
+	if err != nil {
+		return userentity.LoginRecord{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[record.UserID]; !ok {
+		return userentity.LoginRecord{}, ErrUserNotFound
+	}
+	var seenBefore, seenDevice, seenIP bool
+	for _, previous := range r.loginRecords {
+		if previous.UserID != record.UserID || previous.Type != userentity.LoginRecordTypeLogin {
+			continue
+		}
+		seenBefore = true
+		seenDevice = seenDevice || previous.DeviceFingerprint == record.DeviceFingerprint
+		seenIP = seenIP || previous.IP == record.IP
+	}
+	record.NewDevice = seenBefore && (!seenDevice || !seenIP)
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now().UTC()
+	}
+	r.nextLoginID++
+	record.ID = r.nextLoginID
+	r.loginRecords = append(r.loginRecords, record)
+
+	if record.NewDevice {
+		r.appendOutboxEvent(record.UserID, params.NewLoginEvent, record.CreatedAt)
+	}
+	return record, nil
+}
+
+// RecordLogout stores a logout record.
+func (r *InMemoryUserRepository) RecordLogout(ctx context.Context, record userentity.LoginRecord) error {
+	_ = ctx
+	record, err := normalizeLoginRecord(record, userentity.LoginRecordTypeLogout)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[record.UserID]; !ok {
+		return ErrUserNotFound
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now().UTC()
+	}
+	r.nextLoginID++
+	record.ID = r.nextLoginID
+	r.loginRecords = append(r.loginRecords, record)
+	return nil
+}
+
+// ListLoginHistory returns records of the user, newest first.
+func (r *InMemoryUserRepository) ListLoginHistory(ctx context.Context, params ports.ListLoginHistoryParams) ([]userentity.LoginRecord, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	limit := loginHistoryLimit(params.Limit)
+	result := make([]userentity.LoginRecord, 0, limit)
+	for i := len(r.loginRecords) - 1; i >= 0 && len(result) < limit; i-- {
+		record := r.loginRecords[i]
+		if record.UserID != params.UserID {
+			continue
+		}
+		if params.BeforeID > 0 && record.ID >= params.BeforeID {
+			continue
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
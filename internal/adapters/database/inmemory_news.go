@@ -0,0 +1,190 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func (r *InMemoryUserRepository) CreateNews(ctx context.Context, news userentity.News) (userentity.News, error) {
+	_ = ctx
+	if !validNews(news) {
+		return userentity.News{}, ErrInvalidNews
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkNewsCodes(news.Codes); err != nil {
+		return userentity.News{}, err
+	}
+	if news.ExternalID != "" {
+		if _, ok := r.newsByExternalID(news.ExternalID); ok {
+			return userentity.News{}, ErrNewsExternalIDTaken
+		}
+	}
+	if news.Status == "" {
+		news.Status = userentity.NewsStatusPublished
+	}
+	news.Codes = newsCodes(news.Codes)
+	news.CreatedAt = orNow(news.CreatedAt)
+	news.UpdatedAt = news.CreatedAt
+	r.nextNewsID++
+	news.ID = r.nextNewsID
+	r.news[news.ID] = news
+	return copyNews(news), nil
+}
+
+func (r *InMemoryUserRepository) GetNews(ctx context.Context, newsID int64) (userentity.News, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	news, ok := r.news[newsID]
+	if !ok {
+		return userentity.News{}, ErrNewsNotFound
+	}
+	return copyNews(news), nil
+}
+
+func (r *InMemoryUserRepository) GetNewsByExternalID(ctx context.Context, externalID string) (userentity.News, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if externalID == "" {
+		return userentity.News{}, ErrNewsNotFound
+	}
+	news, ok := r.newsByExternalID(externalID)
+	if !ok {
+		return userentity.News{}, ErrNewsNotFound
+	}
+	return copyNews(news), nil
+}
+
+func (r *InMemoryUserRepository) UpdateNews(ctx context.Context, news userentity.News) (userentity.News, error) {
+	_ = ctx
+	if !validNews(news) {
+		return userentity.News{}, ErrInvalidNews
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.news[news.ID]
+	if !ok {
+		return userentity.News{}, ErrNewsNotFound
+	}
+	if err := r.checkNewsCodes(news.Codes); err != nil {
+		return userentity.News{}, err
+	}
+	current.Category = news.Category
+	current.Title = news.Title
+	current.Summary = news.Summary
+	current.Content = news.Content
+	current.Source = news.Source
+	current.URL = news.URL
+	current.Codes = newsCodes(news.Codes)
+	current.PublishedAt = news.PublishedAt
+	current.UpdatedAt = orNow(news.UpdatedAt)
+	r.news[current.ID] = current
+	return copyNews(current), nil
+}
+
+func (r *InMemoryUserRepository) SetNewsStatus(ctx context.Context, newsID int64, status userentity.NewsStatus, at time.Time) (userentity.News, error) {
+	_ = ctx
+	if status != userentity.NewsStatusPublished && status != userentity.NewsStatusUnpublished {
+		return userentity.News{}, ErrInvalidNews
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	news, ok := r.news[newsID]
+	if !ok {
+		return userentity.News{}, ErrNewsNotFound
+	}
+	news.Status = status
+	news.UpdatedAt = orNow(at)
+	r.news[newsID] = news
+	return copyNews(news), nil
+}
+
+func (r *InMemoryUserRepository) ListNews(ctx context.Context, params ports.ListNewsParams) ([]userentity.News, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	query := newsTerms(params.Query)
+	items := make([]userentity.News, 0)
+	for _, news := range r.news {
+		if params.Status != "" && news.Status != params.Status {
+			continue
+		}
+		if params.Category != "" && news.Category != params.Category {
+			continue
+		}
+		if params.Code != "" && !containsCode(news.Codes, params.Code) {
+			continue
+		}
+		if len(query) > 0 && !matchesNewsQuery(newsDocumentTerms(news), query) {
+			continue
+		}
+		if params.BeforeID > 0 && !newsBefore(news, params.BeforePublishedAt, params.BeforeID) {
+			continue
+		}
+		items = append(items, copyNews(news))
+	}
+	sort.Slice(items, func(i, j int) bool { return newsBefore(items[j], items[i].PublishedAt, items[i].ID) })
+	if limit := newsListLimit(params.Limit); len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+// newsBefore reports whether news comes after the position (publishedAt, id)
+// in newest-first order.
+func newsBefore(news userentity.News, publishedAt time.Time, id int64) bool {
+	if news.PublishedAt.Equal(publishedAt) {
+		return news.ID < id
+	}
+	return news.PublishedAt.Before(publishedAt)
+}
+
+// checkNewsCodes fails when a code is not in the catalog. The caller holds
+// r.mu.
+func (r *InMemoryUserRepository) checkNewsCodes(codes []string) error {
+	for _, code := range codes {
+		if _, ok := r.stockByCode[code]; !ok {
+			return ErrStockNotFound
+		}
+	}
+	return nil
+}
+
+// newsByExternalID looks up an imported item. The caller holds r.mu.
+func (r *InMemoryUserRepository) newsByExternalID(externalID string) (userentity.News, bool) {
+	for _, news := range r.news {
+		if news.ExternalID == externalID {
+			return news, true
+		}
+	}
+	return userentity.News{}, false
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func copyNews(news userentity.News) userentity.News {
+	news.Codes = append([]string{}, news.Codes...)
+	return news
+}
@@ -0,0 +1,462 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func (r *InMemoryUserRepository) CreateOrder(ctx context.Context, order userentity.Order, check ports.OrderCheck) (userentity.Order, error) {
+	if !validOrder(order) {
+		return userentity.Order{}, ErrInvalidOrder
+	}
+	if check != nil {
+		r.checking.Lock()
+		defer r.checking.Unlock()
+		if err := r.checkOrder(ctx, order, check); err != nil {
+			return userentity.Order{}, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[order.UserID]; !ok {
+		return userentity.Order{}, ErrUserNotFound
+	}
+	stockID, ok := r.stockByCode[order.Code]
+	if !ok {
+		return userentity.Order{}, ErrStockNotFound
+	}
+	order.StockID = stockID
+	order.FilledQuantity = 0
+	order.FilledValue = 0
+	order.Status = userentity.OrderStatusNew
+	order.Reason = ""
+	order.CreatedAt = orNow(order.CreatedAt)
+	order.UpdatedAt = order.CreatedAt
+	order.PriorityAt = order.CreatedAt
+	r.nextOrderID++
+	order.ID = r.nextOrderID
+	r.orders[order.ID] = order
+	r.appendOrderEvent(order, userentity.OrderEventPlaced, orderEventData{Price: order.Price, Quantity: order.Quantity}, order.CreatedAt)
+	return order, nil
+}
+
+func (r *InMemoryUserRepository) GetOrder(ctx context.Context, orderID int64) (userentity.Order, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	order, ok := r.orders[orderID]
+	if !ok {
+		return userentity.Order{}, ErrOrderNotFound
+	}
+	return order, nil
+}
+
+func (r *InMemoryUserRepository) ListOrders(ctx context.Context, params ports.ListOrdersParams) ([]userentity.Order, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	orders := make([]userentity.Order, 0)
+	for _, order := range r.orders {
+		if order.UserID != params.UserID || !orderHasStatus(params.Statuses, order.Status) {
+			continue
+		}
+		if params.BeforeID > 0 && order.ID >= params.BeforeID {
+			continue
+		}
+		orders = append(orders, order)
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i].ID > orders[j].ID })
+	if limit := orderListLimit(params.Limit); len(orders) > limit {
+		orders = orders[:limit]
+	}
+	return orders, nil
+}
+
+func (r *InMemoryUserRepository) ListOrderEvents(ctx context.Context, orderID int64) ([]userentity.OrderEvent, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.orders[orderID]; !ok {
+		return nil, ErrOrderNotFound
+	}
+	events := make([]userentity.OrderEvent, 0)
+	for _, event := range r.orderEvents {
+		if event.OrderID == orderID {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (r *InMemoryUserRepository) AmendOrder(ctx context.Context, params ports.AmendOrderParams) (userentity.Order, error) {
+	if params.Price <= 0 || params.Quantity <= 0 {
+		return userentity.Order{}, ErrInvalidOrder
+	}
+	at := orNow(params.At)
+	if params.Check != nil {
+		r.checking.Lock()
+		defer r.checking.Unlock()
+		r.mu.RLock()
+		order, err := r.openOrder(params.OrderID)
+		r.mu.RUnlock()
+		if err != nil {
+			return userentity.Order{}, err
+		}
+		if params.Quantity > order.FilledQuantity {
+			if err := r.checkOrder(ctx, order.Amended(params.Quantity, params.Price, at), params.Check); err != nil {
+				return userentity.Order{}, err
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order, err := r.openOrder(params.OrderID)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if params.Quantity <= order.FilledQuantity {
+		return userentity.Order{}, ErrOrderQuantityBelowFilled
+	}
+	order = order.Amended(params.Quantity, params.Price, at)
+	r.orders[order.ID] = order
+	r.appendOrderEvent(order, userentity.OrderEventAmended, orderEventData{Price: order.Price, Quantity: order.Quantity}, at)
+	return order, nil
+}
+
+func (r *InMemoryUserRepository) RecordOrderFill(ctx context.Context, params ports.RecordOrderFillParams) (userentity.Order, error) {
+	if !validOrderFill(params) {
+		return userentity.Order{}, ErrInvalidOrder
+	}
+	at := orNow(params.At)
+	if params.Pricer != nil {
+		r.checking.Lock()
+		defer r.checking.Unlock()
+		order, err := r.GetOrder(ctx, params.OrderID)
+		if err != nil {
+			return userentity.Order{}, err
+		}
+		if params.Charges, err = priceFill(ctx, order, params.Pricer, params.Price, params.Quantity, at); err != nil {
+			return userentity.Order{}, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order, ok := r.orders[params.OrderID]
+	if !ok {
+		return userentity.Order{}, ErrOrderNotFound
+	}
+	if r.orderFillRecorded(order.ID, params.ExecutionID) {
+		return order, nil
+	}
+	if err := fillableOrder(order, params.Quantity); err != nil {
+		return userentity.Order{}, err
+	}
+	return r.fillOrder(order, params, at), nil
+}
+
+func (r *InMemoryUserRepository) RecordOrderMatch(ctx context.Context, params ports.RecordOrderMatchParams) (userentity.Order, userentity.Order, error) {
+	if !validOrderMatch(params) {
+		return userentity.Order{}, userentity.Order{}, ErrInvalidOrder
+	}
+	at := orNow(params.At)
+	if params.Pricer != nil {
+		r.checking.Lock()
+		defer r.checking.Unlock()
+		buy, err := r.GetOrder(ctx, params.BuyOrderID)
+		if err != nil {
+			return userentity.Order{}, userentity.Order{}, err
+		}
+		sell, err := r.GetOrder(ctx, params.SellOrderID)
+		if err != nil {
+			return userentity.Order{}, userentity.Order{}, err
+		}
+		if params.BuyCharges, err = priceFill(ctx, buy, params.Pricer, params.Price, params.Quantity, at); err != nil {
+			return userentity.Order{}, userentity.Order{}, err
+		}
+		if params.SellCharges, err = priceFill(ctx, sell, params.Pricer, params.Price, params.Quantity, at); err != nil {
+			return userentity.Order{}, userentity.Order{}, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	buy, ok := r.orders[params.BuyOrderID]
+	if !ok {
+		return userentity.Order{}, userentity.Order{}, ErrOrderNotFound
+	}
+	sell, ok := r.orders[params.SellOrderID]
+	if !ok {
+		return userentity.Order{}, userentity.Order{}, ErrOrderNotFound
+	}
+	if buy.Side != userentity.OrderSideBuy || sell.Side != userentity.OrderSideSell || buy.StockID != sell.StockID {
+		return userentity.Order{}, userentity.Order{}, ErrInvalidOrderMatch
+	}
+	if r.orderFillRecorded(buy.ID, params.ExecutionID) {
+		return buy, sell, nil
+	}
+	if err := fillableOrder(buy, params.Quantity); err != nil {
+		return userentity.Order{}, userentity.Order{}, err
+	}
+	if err := fillableOrder(sell, params.Quantity); err != nil {
+		return userentity.Order{}, userentity.Order{}, err
+	}
+	buy = r.fillOrder(buy, orderMatchFill(params, userentity.OrderSideBuy), at)
+	sell = r.fillOrder(sell, orderMatchFill(params, userentity.OrderSideSell), at)
+	return buy, sell, nil
+}
+
+func (r *InMemoryUserRepository) ListOpenOrders(ctx context.Context, userID int64) ([]userentity.Order, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	orders := make([]userentity.Order, 0)
+	for _, order := range r.orders {
+		if order.Status.Open() && (userID == 0 || order.UserID == userID) {
+			orders = append(orders, order)
+		}
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		if !orders[i].PriorityAt.Equal(orders[j].PriorityAt) {
+			return orders[i].PriorityAt.Before(orders[j].PriorityAt)
+		}
+		return orders[i].ID < orders[j].ID
+	})
+	return orders, nil
+}
+
+func (r *InMemoryUserRepository) OrderTotalsSince(ctx context.Context, userID int64, since time.Time) (ports.OrderTotals, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var totals ports.OrderTotals
+	for _, order := range r.orders {
+		if order.UserID == userID && !order.CreatedAt.Before(since) {
+			totals.Count++
+			totals.Value += order.Price * order.Quantity
+		}
+	}
+	return totals, nil
+}
+
+func (r *InMemoryUserRepository) FilledValueSince(ctx context.Context, userID int64, since time.Time) (int64, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var value int64
+	for _, fill := range r.orderFills {
+		if r.orders[fill.OrderID].UserID == userID && !fill.CreatedAt.Before(since) {
+			value += fill.Price * fill.Quantity
+		}
+	}
+	return value, nil
+}
+
+func (r *InMemoryUserRepository) CloseOrder(ctx context.Context, params ports.CloseOrderParams) (userentity.Order, error) {
+	_ = ctx
+	if !validOrderClose(params.Status, params.Reason) {
+		return userentity.Order{}, ErrInvalidOrder
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order, err := r.openOrder(params.OrderID)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	return r.closeOrder(order, params.Status, params.Reason, orNow(params.At)), nil
+}
+
+func (r *InMemoryUserRepository) ExpireOrders(ctx context.Context, tif userentity.TimeInForce, placedBefore time.Time, reason string, at time.Time) (int, error) {
+	_ = ctx
+	if !tif.Valid() || len(reason) > orderReasonMaxLen {
+		return 0, ErrInvalidOrder
+	}
+	at = orNow(at)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	expiring := make([]userentity.Order, 0)
+	for _, order := range r.orders {
+		if order.TimeInForce == tif && order.Status.Open() && order.CreatedAt.Before(placedBefore) {
+			expiring = append(expiring, order)
+		}
+	}
+	sort.Slice(expiring, func(i, j int) bool { return expiring[i].ID < expiring[j].ID })
+	for _, order := range expiring {
+		r.closeOrder(order, userentity.OrderStatusExpired, reason, at)
+	}
+	return len(expiring), nil
+}
+
+func (r *InMemoryUserRepository) ListTrades(ctx context.Context, params ports.ListTradesParams) ([]ports.Trade, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	trades := make([]ports.Trade, 0)
+	for _, fill := range r.orderFills {
+		order := r.orders[fill.OrderID]
+		if order.UserID != params.UserID || fill.CreatedAt.Before(params.From) || !fill.CreatedAt.Before(params.To) {
+			continue
+		}
+		trades = append(trades, ports.Trade{Order: order, Fill: fill})
+	}
+	sort.SliceStable(trades, func(i, j int) bool { return trades[i].Fill.CreatedAt.Before(trades[j].Fill.CreatedAt) })
+	return trades, nil
+}
+
+func (r *InMemoryUserRepository) ListUnconfirmedTrades(ctx context.Context, limit int) ([]ports.UnconfirmedTrade, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	trades := make([]ports.UnconfirmedTrade, 0)
+	for _, fill := range r.orderFills {
+		if len(trades) == limit {
+			break
+		}
+		if _, ok := r.fillConfirms[fill.ID]; ok {
+			continue
+		}
+		order := r.orders[fill.OrderID]
+		trades = append(trades, ports.UnconfirmedTrade{Order: order, Fill: fill, Owner: r.users[r.usersByID[order.UserID]]})
+	}
+	return trades, nil
+}
+
+func (r *InMemoryUserRepository) ConfirmTrade(ctx context.Context, params ports.ConfirmTradeParams) (bool, error) {
+	_ = ctx
+	at := orNow(params.At)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var fill userentity.OrderFill
+	for _, candidate := range r.orderFills {
+		if candidate.ID == params.FillID {
+			fill = candidate
+		}
+	}
+	if fill.ID == 0 {
+		return false, ErrOrderFillNotFound
+	}
+	if _, ok := r.fillConfirms[fill.ID]; ok {
+		return false, nil
+	}
+	r.fillConfirms[fill.ID] = at
+	if params.Event.EventType != "" {
+		r.appendOutboxEvent(r.orders[fill.OrderID].UserID, params.Event, at)
+	}
+	return true, nil
+}
+
+// openOrder returns an order that can still change. The caller holds r.mu.
+func (r *InMemoryUserRepository) openOrder(orderID int64) (userentity.Order, error) {
+	order, ok := r.orders[orderID]
+	if !ok {
+		return userentity.Order{}, ErrOrderNotFound
+	}
+	if !order.Status.Open() {
+		return userentity.Order{}, ErrOrderNotOpen
+	}
+	return order, nil
+}
+
+// orderFillRecorded reports whether a fill with the execution id was
+// recorded for the order. The caller holds r.mu.
+func (r *InMemoryUserRepository) orderFillRecorded(orderID int64, executionID string) bool {
+	for _, fill := range r.orderFills {
+		if fill.OrderID == orderID && fill.ExecutionID == executionID {
+			return true
+		}
+	}
+	return false
+}
+
+// fillOrder records a checked fill of order and posts its trade. The caller
+// holds r.mu for writing.
+func (r *InMemoryUserRepository) fillOrder(order userentity.Order, params ports.RecordOrderFillParams, at time.Time) userentity.Order {
+	order = order.Filled(params.Quantity, params.Price, params.Charges, at)
+	r.orders[order.ID] = order
+	r.nextFillID++
+	r.orderFills = append(r.orderFills, userentity.OrderFill{
+		ID:          r.nextFillID,
+		OrderID:     order.ID,
+		ExecutionID: params.ExecutionID,
+		Price:       params.Price,
+		Quantity:    params.Quantity,
+		Fee:         params.Charges.Fee,
+		Tax:         params.Charges.Tax,
+		CreatedAt:   at,
+	})
+	r.appendOrderEvent(order, userentity.OrderEventFilled, orderFillEventData(params), at)
+	entries, settlements := orderFillEntries(order, params, at)
+	r.appendLedgerEntries(entries, orderFillReference(r.nextFillID))
+	r.appendSettlements(settlements, orderFillReference(r.nextFillID))
+	return order
+}
+
+// closeOrder moves an open order to a final status. The caller holds r.mu
+// for writing.
+func (r *InMemoryUserRepository) closeOrder(order userentity.Order, status userentity.OrderStatus, reason string, at time.Time) userentity.Order {
+	order.Status = status
+	order.Reason = reason
+	order.UpdatedAt = at
+	r.orders[order.ID] = order
+	r.appendOrderEvent(order, orderCloseEvent(status), orderEventData{}, at)
+	return order
+}
+
+// appendOrderEvent records a change of order, which already has its new
+// status. The caller holds r.mu for writing.
+func (r *InMemoryUserRepository) appendOrderEvent(order userentity.Order, eventType userentity.OrderEventType, data orderEventData, at time.Time) {
+	r.nextEventID++
+	event := userentity.OrderEvent{
+		ID:        r.nextEventID,
+		OrderID:   order.ID,
+		Type:      eventType,
+		Status:    order.Status,
+		Price:     data.Price,
+		Quantity:  data.Quantity,
+		Fee:       data.Fee,
+		Tax:       data.Tax,
+		CreatedAt: at,
+	}
+	if !order.Status.Open() {
+		event.Reason = order.Reason
+	}
+	r.orderEvents = append(r.orderEvents, event)
+}
+
+// checkOrder runs check on order with the account and open orders of its
+// owner. The caller holds r.checking.
+func (r *InMemoryUserRepository) checkOrder(ctx context.Context, order userentity.Order, check ports.OrderCheck) error {
+	account, err := r.GetTradingAccount(ctx, order.UserID)
+	if err != nil {
+		return err
+	}
+	open, err := r.ListOpenOrders(ctx, order.UserID)
+	if err != nil {
+		return err
+	}
+	return check(ctx, order, account, open)
+}
@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func (r *InMemoryUserRepository) CreatePayment(ctx context.Context, payment userentity.Payment, check ports.PaymentCheck) (userentity.Payment, bool, error) {
+	if !validPayment(payment) {
+		return userentity.Payment{}, false, ErrInvalidPayment
+	}
+	if check != nil {
+		r.checking.Lock()
+		defer r.checking.Unlock()
+		if existing, err := r.FindPayment(ctx, payment.UserID, payment.IdempotencyKey); err == nil {
+			return existing, false, nil
+		}
+		account, err := r.GetTradingAccount(ctx, payment.UserID)
+		if err != nil {
+			return userentity.Payment{}, false, err
+		}
+		open, err := r.ListOpenOrders(ctx, payment.UserID)
+		if err != nil {
+			return userentity.Payment{}, false, err
+		}
+		if err := check(ctx, payment, account, open); err != nil {
+			return userentity.Payment{}, false, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[payment.UserID]; !ok {
+		return userentity.Payment{}, false, ErrUserNotFound
+	}
+	for _, existing := range r.payments {
+		if existing.UserID == payment.UserID && existing.IdempotencyKey == payment.IdempotencyKey {
+			return existing, false, nil
+		}
+	}
+	payment.GatewayReference, payment.PaymentURL, payment.Reason = "", "", ""
+	payment.ReviewerID = 0
+	payment.CreatedAt = orNow(payment.CreatedAt)
+	payment.UpdatedAt = payment.CreatedAt
+	payment.CompletedAt = time.Time{}
+	r.nextPayID++
+	payment.ID = r.nextPayID
+	r.payments[payment.ID] = payment
+	return payment, true, nil
+}
+
+func (r *InMemoryUserRepository) GetPayment(ctx context.Context, paymentID int64) (userentity.Payment, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	payment, ok := r.payments[paymentID]
+	if !ok {
+		return userentity.Payment{}, ErrPaymentNotFound
+	}
+	return payment, nil
+}
+
+func (r *InMemoryUserRepository) FindPayment(ctx context.Context, userID int64, idempotencyKey string) (userentity.Payment, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, payment := range r.payments {
+		if payment.UserID == userID && payment.IdempotencyKey == idempotencyKey {
+			return payment, nil
+		}
+	}
+	return userentity.Payment{}, ErrPaymentNotFound
+}
+
+func (r *InMemoryUserRepository) ListPayments(ctx context.Context, params ports.ListPaymentsParams) ([]userentity.Payment, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	payments := make([]userentity.Payment, 0)
+	for _, payment := range r.payments {
+		switch {
+		case params.UserID != 0 && payment.UserID != params.UserID:
+		case params.Status != "" && payment.Status != params.Status:
+		case params.BeforeID > 0 && payment.ID >= params.BeforeID:
+		default:
+			payments = append(payments, payment)
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool { return payments[i].ID > payments[j].ID })
+	if limit := orderListLimit(params.Limit); len(payments) > limit {
+		payments = payments[:limit]
+	}
+	return payments, nil
+}
+
+func (r *InMemoryUserRepository) UpdatePayment(ctx context.Context, params ports.UpdatePaymentParams) (userentity.Payment, error) {
+	_ = ctx
+	if !validPaymentUpdate(params) {
+		return userentity.Payment{}, ErrInvalidPayment
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	payment, ok := r.payments[params.PaymentID]
+	if !ok {
+		return userentity.Payment{}, ErrPaymentNotFound
+	}
+	payment, err := updatedPayment(payment, params)
+	if err != nil {
+		return userentity.Payment{}, err
+	}
+	if payment.Status == userentity.PaymentStatusCompleted {
+		entry := payment.LedgerEntry(payment.CompletedAt)
+		r.appendLedgerEntries([]userentity.LedgerEntry{entry}, entry.Reference)
+	}
+	r.payments[payment.ID] = payment
+	return payment, nil
+}
@@ -0,0 +1,114 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func (r *InMemoryUserRepository) ListTradeDateEntries(ctx context.Context, userID int64, before time.Time) ([]userentity.LedgerEntry, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]userentity.LedgerEntry, 0)
+	for _, entry := range r.ledger {
+		if entry.UserID == userID && entry.CreatedAt.Before(before) {
+			entries = append(entries, entry)
+		}
+	}
+	settlements := make([]userentity.Settlement, 0)
+	for _, settlement := range r.settlements {
+		if settlement.UserID == userID && settlement.CreatedAt.Before(before) {
+			settlements = append(settlements, settlement)
+		}
+	}
+	return tradeDateEntries(entries, settlements), nil
+}
+
+func (r *InMemoryUserRepository) ListPortfolioOwners(ctx context.Context, before time.Time) ([]int64, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[int64]struct{})
+	owners := make([]int64, 0)
+	for _, entry := range r.ledger {
+		if _, ok := seen[entry.UserID]; ok || !entry.CreatedAt.Before(before) {
+			continue
+		}
+		seen[entry.UserID] = struct{}{}
+		owners = append(owners, entry.UserID)
+	}
+	sort.Slice(owners, func(i, j int) bool { return owners[i] < owners[j] })
+	return owners, nil
+}
+
+func (r *InMemoryUserRepository) SavePortfolioSnapshots(ctx context.Context, snapshots []userentity.PortfolioSnapshot) error {
+	_ = ctx
+	for _, snapshot := range snapshots {
+		if !validPortfolioSnapshot(snapshot) {
+			return ErrInvalidPortfolioSnapshot
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, snapshot := range snapshots {
+		if _, ok := r.usersByID[snapshot.UserID]; !ok {
+			return ErrUserNotFound
+		}
+	}
+	for _, snapshot := range snapshots {
+		snapshot.Date = settlementDate(snapshot.Date)
+		snapshot.CreatedAt = orNow(snapshot.CreatedAt)
+		replaced := false
+		for i, existing := range r.snapshots {
+			if existing.UserID == snapshot.UserID && existing.Date.Equal(snapshot.Date) {
+				r.snapshots[i], replaced = snapshot, true
+				break
+			}
+		}
+		if !replaced {
+			r.snapshots = append(r.snapshots, snapshot)
+		}
+	}
+	return nil
+}
+
+func (r *InMemoryUserRepository) ListPortfolioSnapshots(ctx context.Context, userID int64, from time.Time) ([]userentity.PortfolioSnapshot, error) {
+	_ = ctx
+	from = settlementDate(from)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	snapshots := make([]userentity.PortfolioSnapshot, 0)
+	for _, snapshot := range r.snapshots {
+		if snapshot.UserID == userID && !snapshot.Date.Before(from) {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Date.Before(snapshots[j].Date) })
+	return snapshots, nil
+}
+
+func (r *InMemoryUserRepository) LastPortfolioSnapshot(ctx context.Context, userID int64) (userentity.PortfolioSnapshot, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var last userentity.PortfolioSnapshot
+	for _, snapshot := range r.snapshots {
+		if snapshot.UserID == userID && snapshot.Date.After(last.Date) {
+			last = snapshot
+		}
+	}
+	if last.UserID == 0 {
+		return userentity.PortfolioSnapshot{}, ErrPortfolioSnapshotNotFound
+	}
+	return last, nil
+}
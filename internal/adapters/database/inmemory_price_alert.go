@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// CreatePriceAlert stores an active alert on a listed stock.
+func (r *InMemoryUserRepository) CreatePriceAlert(ctx context.Context, alert userentity.PriceAlert) (userentity.PriceAlert, error) {
+	_ = ctx
+	if !validPriceAlert(alert) {
+		return userentity.PriceAlert{}, ErrInvalidPriceAlert
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[alert.UserID]; !ok {
+		return userentity.PriceAlert{}, ErrUserNotFound
+	}
+	stockID, ok := r.stockByCode[alert.Code]
+	if !ok {
+		return userentity.PriceAlert{}, ErrStockNotFound
+	}
+	alert.StockID = stockID
+	alert.Status = userentity.PriceAlertStatusActive
+	alert.TriggeredPrice = 0
+	alert.TriggeredAt = time.Time{}
+	alert.CreatedAt = orNow(alert.CreatedAt)
+	r.nextAlertID++
+	alert.ID = r.nextAlertID
+	r.priceAlerts[alert.ID] = alert
+	return alert, nil
+}
+
+func (r *InMemoryUserRepository) GetPriceAlert(ctx context.Context, alertID int64) (userentity.PriceAlert, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	alert, ok := r.priceAlerts[alertID]
+	if !ok {
+		return userentity.PriceAlert{}, ErrPriceAlertNotFound
+	}
+	return alert, nil
+}
+
+func (r *InMemoryUserRepository) ListPriceAlerts(ctx context.Context, userID int64) ([]userentity.PriceAlert, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	alerts := make([]userentity.PriceAlert, 0)
+	for _, alert := range r.priceAlerts {
+		if alert.UserID == userID {
+			alerts = append(alerts, alert)
+		}
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].ID > alerts[j].ID })
+	return alerts, nil
+}
+
+func (r *InMemoryUserRepository) DeletePriceAlert(ctx context.Context, alertID int64) error {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.priceAlerts[alertID]; !ok {
+		return ErrPriceAlertNotFound
+	}
+	delete(r.priceAlerts, alertID)
+	return nil
+}
+
+func (r *InMemoryUserRepository) ListActivePriceAlerts(ctx context.Context, stockID int64) ([]ports.ActivePriceAlert, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	active := make([]ports.ActivePriceAlert, 0)
+	for _, alert := range r.priceAlerts {
+		if alert.StockID != stockID || alert.Status != userentity.PriceAlertStatusActive {
+			continue
+		}
+		owner, ok := r.users[r.usersByID[alert.UserID]]
+		if !ok {
+			continue
+		}
+		active = append(active, ports.ActivePriceAlert{Alert: alert, Owner: owner})
+	}
+	sort.Slice(active, func(i, j int) bool { return active[i].Alert.ID < active[j].Alert.ID })
+	return active, nil
+}
+
+// TriggerPriceAlert fires an active alert and writes its outbox event.
+func (r *InMemoryUserRepository) TriggerPriceAlert(ctx context.Context, params ports.TriggerPriceAlertParams) (bool, error) {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	alert, ok := r.priceAlerts[params.AlertID]
+	if !ok || alert.Status != userentity.PriceAlertStatusActive {
+		return false, nil
+	}
+	alert.Status = userentity.PriceAlertStatusTriggered
+	alert.TriggeredPrice = params.Price
+	alert.TriggeredAt = orNow(params.At)
+	r.priceAlerts[alert.ID] = alert
+	r.appendOutboxEvent(alert.UserID, params.Event, alert.TriggeredAt)
+	return true, nil
+}
@@ -16,7 +16,12 @@ import (
 // of UserRepository. It is primarily used in local development or tests when a
 // real database is not available.
 type InMemoryUserRepository struct {
-	mu           sync.RWMutex
+	mu sync.RWMutex
+	// checking is held while a ports.OrderCheck, ports.PaymentCheck or
+	// ports.FillPricer runs and its order, payment or fill is stored, in
+	// place of the user row locks of the MySQL repository. They read
+	// through the repository, so mu cannot be held for them.
+	checking     sync.Mutex
 	users        map[string]userentity.User
 	usersByID    map[int64]string
 	logins       map[string]userentity.LoginMethodPassword
@@ -25,11 +30,70 @@ type InMemoryUserRepository struct {
 	tokenByValue map[string]int64
 	tokenByUser  map[int64]int64
 	outboxEvents []userentity.OutboxEvent
+	dataExports  map[int64]userentity.DataExport
+	auditEvents  []userentity.AuditEvent
+	loginRecords []userentity.LoginRecord
+	kycDocuments map[int64]userentity.KycDocument
+	kycSubmits   map[int64]userentity.KycSubmission
+	stocks       map[int64]userentity.Stock
+	stockByCode  map[string]int64
+	stockQuotes  map[int64]userentity.StockQuote
+	stockPrices  []userentity.StockQuote
+	watchlists   map[int64]userentity.Watchlist
+	priceAlerts  map[int64]userentity.PriceAlert
+	news         map[int64]userentity.News
+	orders       map[int64]userentity.Order
+	orderEvents  []userentity.OrderEvent
+	orderFills   []userentity.OrderFill
+	fillConfirms map[int64]time.Time
+	ledger       []userentity.LedgerEntry
+	settlements  []userentity.Settlement
+	tiers        map[int64]string
+	payments     map[int64]userentity.Payment
+	statements   map[int64]userentity.Statement
+	feeSchedules map[int64]userentity.FeeSchedule
+	corpActions  map[int64]userentity.CorporateAction
+	snapshots    []userentity.PortfolioSnapshot
 	nextUserID   int64
 	nextTokenID  int64
+	nextExportID int64
+	nextAuditID  int64
+	nextLoginID  int64
+	nextKycDocID int64
+	nextKycSubID int64
+	nextStockID  int64
+	nextWatchID  int64
+	nextAlertID  int64
+	nextNewsID   int64
+	nextOrderID  int64
+	nextFillID   int64
+	nextEventID  int64 // order events
+	nextEntryID  int64 // ledger entries
+	nextSettleID int64
+	nextPayID    int64
+	nextStmtID   int64
+	nextFeeID    int64 // fee schedules
+	nextActionID int64 // corporate actions
 }
 
-var _ ports.UserRepository = (*InMemoryUserRepository)(nil)
+var (
+	_ ports.UserRepository            = (*InMemoryUserRepository)(nil)
+	_ ports.DataExportRepository      = (*InMemoryUserRepository)(nil)
+	_ ports.AuditRepository           = (*InMemoryUserRepository)(nil)
+	_ ports.LoginHistoryRepository    = (*InMemoryUserRepository)(nil)
+	_ ports.KycRepository             = (*InMemoryUserRepository)(nil)
+	_ ports.StockRepository           = (*InMemoryUserRepository)(nil)
+	_ ports.WatchlistRepository       = (*InMemoryUserRepository)(nil)
+	_ ports.PriceAlertRepository      = (*InMemoryUserRepository)(nil)
+	_ ports.NewsRepository            = (*InMemoryUserRepository)(nil)
+	_ ports.OrderRepository           = (*InMemoryUserRepository)(nil)
+	_ ports.TradingAccountRepository  = (*InMemoryUserRepository)(nil)
+	_ ports.PaymentRepository         = (*InMemoryUserRepository)(nil)
+	_ ports.StatementRepository       = (*InMemoryUserRepository)(nil)
+	_ ports.FeeScheduleRepository     = (*InMemoryUserRepository)(nil)
+	_ ports.CorporateActionRepository = (*InMemoryUserRepository)(nil)
+	_ ports.PortfolioRepository       = (*InMemoryUserRepository)(nil)
+)
 
 // NewInMemoryUserRepository creates a new instance of the repository.
 func NewInMemoryUserRepository() *InMemoryUserRepository {
@@ -42,6 +106,22 @@ func NewInMemoryUserRepository() *InMemoryUserRepository {
 		tokenByValue: make(map[string]int64),
 		tokenByUser:  make(map[int64]int64),
 		outboxEvents: make([]userentity.OutboxEvent, 0),
+		dataExports:  make(map[int64]userentity.DataExport),
+		kycDocuments: make(map[int64]userentity.KycDocument),
+		kycSubmits:   make(map[int64]userentity.KycSubmission),
+		stocks:       make(map[int64]userentity.Stock),
+		stockByCode:  make(map[string]int64),
+		stockQuotes:  make(map[int64]userentity.StockQuote),
+		watchlists:   make(map[int64]userentity.Watchlist),
+		priceAlerts:  make(map[int64]userentity.PriceAlert),
+		news:         make(map[int64]userentity.News),
+		orders:       make(map[int64]userentity.Order),
+		tiers:        make(map[int64]string),
+		fillConfirms: make(map[int64]time.Time),
+		payments:     make(map[int64]userentity.Payment),
+		statements:   make(map[int64]userentity.Statement),
+		feeSchedules: make(map[int64]userentity.FeeSchedule),
+		corpActions:  make(map[int64]userentity.CorporateAction),
 		nextUserID:   0,
 		nextTokenID:  0,
 	}
@@ -62,11 +142,11 @@ func (r *InMemoryUserRepository) CheckUserNameAndEmailIsExist(ctx context.Contex
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	if _, ok := r.users[userName]; ok {
-		return fmt.Errorf("username or email already exists")
+		return ErrUserAlreadyExists
 	}
 	if email != "" {
 		if _, ok := r.emailIndex[email]; ok {
-			return fmt.Errorf("username or email already exists")
+			return ErrUserAlreadyExists
 		}
 	}
 	_ = ctx
@@ -79,11 +159,11 @@ func (r *InMemoryUserRepository) CreateUserWithVerification(ctx context.Context,
 	defer r.mu.Unlock()
 
 	if _, exists := r.users[params.Login.UserName]; exists {
-		return userentity.User{}, fmt.Errorf("username or email already exists")
+		return userentity.User{}, ErrUserAlreadyExists
 	}
 	if params.User.Email != "" {
 		if _, exists := r.emailIndex[params.User.Email]; exists {
-			return userentity.User{}, fmt.Errorf("username or email already exists")
+			return userentity.User{}, ErrUserAlreadyExists
 		}
 	}
 
@@ -96,6 +176,9 @@ func (r *InMemoryUserRepository) CreateUserWithVerification(ctx context.Context,
 	user.Verified = false
 	user.CreatedAt = now
 	user.UpdatedAt = now
+	user.Version = 1
+	user.Status = userentity.AccountStatusActive
+	user.KycStatus = userentity.KycStatusNone
 
 	r.users[user.Username] = user
 	r.usersByID[id] = user.Username
@@ -151,7 +234,7 @@ func (r *InMemoryUserRepository) RotateVerificationToken(ctx context.Context, pa
 
 	username, ok := r.usersByID[params.UserID]
 	if !ok {
-		return fmt.Errorf("user not found")
+		return ErrUserNotFound
 	}
 
 	now := time.Now().UTC()
@@ -211,12 +294,12 @@ func (r *InMemoryUserRepository) FindVerificationToken(ctx context.Context, toke
 
 	tokenID, ok := r.tokenByValue[token]
 	if !ok {
-		return userentity.VerificationToken{}, userentity.User{}, fmt.Errorf("verification token not found")
+		return userentity.VerificationToken{}, userentity.User{}, ErrVerificationTokenNotFound
 	}
 	vt := r.tokensByID[tokenID]
 	username, ok := r.usersByID[vt.UserID]
 	if !ok {
-		return userentity.VerificationToken{}, userentity.User{}, fmt.Errorf("user not found")
+		return userentity.VerificationToken{}, userentity.User{}, ErrUserNotFound
 	}
 	user := r.users[username]
 	_ = ctx
@@ -230,10 +313,10 @@ func (r *InMemoryUserRepository) VerifyUserWithToken(ctx context.Context, tokenI
 
 	token, ok := r.tokensByID[tokenID]
 	if !ok {
-		return userentity.User{}, fmt.Errorf("verification token not found")
+		return userentity.User{}, ErrVerificationTokenNotFound
 	}
 	if token.ConsumedAt != nil {
-		return userentity.User{}, fmt.Errorf("verification token already used or not found")
+		return userentity.User{}, ErrVerificationTokenConsumed
 	}
 	token.ConsumedAt = &verifiedAt
 	token.UpdatedAt = verifiedAt
@@ -241,93 +324,352 @@ func (r *InMemoryUserRepository) VerifyUserWithToken(ctx context.Context, tokenI
 
 	username, ok := r.usersByID[userID]
 	if !ok {
-		return userentity.User{}, fmt.Errorf("user not found")
+		return userentity.User{}, ErrUserNotFound
 	}
 	user := r.users[username]
 	user.Verified = true
 	user.VerifiedAt = verifiedAt
 	user.UpdatedAt = verifiedAt
+	user.Version++
 	r.users[username] = user
 
 	_ = ctx
 	return user, nil
 }
 
+// RequestEmailChange stores a pending email change token and its outbox events.
+func (r *InMemoryUserRepository) RequestEmailChange(ctx context.Context, params ports.RequestEmailChangeParams) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	username, ok := r.usersByID[params.UserID]
+	if !ok {
+		return ErrUserNotFound
+	}
+	if owner, exists := r.emailIndex[params.Token.NewEmail]; exists && owner != username {
+		return ErrUserAlreadyExists
+	}
+
+	now := time.Now().UTC()
+	if params.Token.CreatedAt.IsZero() {
+		params.Token.CreatedAt = now
+	}
+	for id, token := range r.tokensByID {
+		if token.UserID == params.UserID && token.Purpose == userentity.VerificationPurposeEmailChange && token.ConsumedAt == nil {
+			consumedAt := params.Token.CreatedAt
+			token.ConsumedAt = &consumedAt
+			token.UpdatedAt = consumedAt
+			r.tokensByID[id] = token
+		}
+	}
+
+	token := params.Token
+	token.ID = r.nextToken()
+	token.UserID = params.UserID
+	token.Purpose = userentity.VerificationPurposeEmailChange
+	token.UpdatedAt = token.CreatedAt
+	r.tokensByID[token.ID] = token
+	r.tokenByValue[token.Token] = token.ID
+
+	for _, event := range params.OutboxEvents {
+		event.ID = int64(len(r.outboxEvents) + 1)
+		event.AggregateID = params.UserID
+		if event.Status == "" {
+			event.Status = userentity.OutboxEventStatusPending
+		}
+		if event.AggregateType == "" {
+			event.AggregateType = "user"
+		}
+		if event.CreatedAt.IsZero() {
+			event.CreatedAt = token.CreatedAt
+		}
+		if event.UpdatedAt.IsZero() {
+			event.UpdatedAt = event.CreatedAt
+		}
+		r.outboxEvents = append(r.outboxEvents, event)
+	}
+
+	_ = ctx
+	return nil
+}
+
+// ConfirmEmailChange consumes an email change token and applies its new email.
+func (r *InMemoryUserRepository) ConfirmEmailChange(ctx context.Context, tokenID int64, userID int64, confirmedAt time.Time) (userentity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokensByID[tokenID]
+	if !ok || token.UserID != userID || token.Purpose != userentity.VerificationPurposeEmailChange {
+		return userentity.User{}, ErrVerificationTokenNotFound
+	}
+	if token.ConsumedAt != nil {
+		return userentity.User{}, ErrVerificationTokenConsumed
+	}
+	username, ok := r.usersByID[userID]
+	if !ok {
+		return userentity.User{}, ErrUserNotFound
+	}
+	if owner, exists := r.emailIndex[token.NewEmail]; exists && owner != username {
+		return userentity.User{}, ErrUserAlreadyExists
+	}
+
+	token.ConsumedAt = &confirmedAt
+	token.UpdatedAt = confirmedAt
+	r.tokensByID[tokenID] = token
+
+	user := r.users[username]
+	delete(r.emailIndex, user.Email)
+	user.Email = token.NewEmail
+	user.UpdatedAt = confirmedAt
+	user.Version++
+	r.users[username] = user
+	r.emailIndex[user.Email] = username
+
+	_ = ctx
+	return user, nil
+}
+
 // GetLoginInfo returns login and user information for given username.
 func (r *InMemoryUserRepository) GetLoginInfo(ctx context.Context, userName string) (userentity.LoginMethodPassword, userentity.User, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	login, ok1 := r.logins[userName]
 	user, ok2 := r.users[userName]
-	if !ok1 || !ok2 {
-		return userentity.LoginMethodPassword{}, userentity.User{}, fmt.Errorf("user not found")
+	if !ok1 || !ok2 || user.Status == userentity.AccountStatusDeleted {
+		return userentity.LoginMethodPassword{}, userentity.User{}, ErrUserNotFound
 	}
 	_ = ctx
 	return login, user, nil
 }
 
-// DeleteUser removes a user from the in-memory repository.
-func (r *InMemoryUserRepository) DeleteUser(ctx context.Context, userName string) error {
+// ChangeAccountStatus moves the user to params.To when its status is in params.From.
+func (r *InMemoryUserRepository) ChangeAccountStatus(ctx context.Context, params ports.ChangeAccountStatusParams) (userentity.User, error) {
+	_ = ctx
+	if !changeableAccountStatus(params.To) || len(params.From) == 0 {
+		return userentity.User{}, ErrInvalidAccountStatus
+	}
+	if params.To == userentity.AccountStatusPendingDeletion && params.DeletionScheduledAt.IsZero() {
+		return userentity.User{}, ErrInvalidAccountStatus
+	}
+	at := params.At
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	user, ok := r.users[userName]
+
+	username, ok := r.usersByID[params.UserID]
 	if !ok {
-		return fmt.Errorf("user not found")
+		return userentity.User{}, ErrUserNotFound
 	}
-	delete(r.users, userName)
-	delete(r.logins, userName)
-	delete(r.emailIndex, user.Email)
-	delete(r.usersByID, user.Id)
-	if tokenID, ok := r.tokenByUser[user.Id]; ok {
-		token := r.tokensByID[tokenID]
-		delete(r.tokenByValue, token.Token)
-		delete(r.tokensByID, tokenID)
-		delete(r.tokenByUser, user.Id)
+	user := r.users[username]
+	if !containsAccountStatus(params.From, user.Status) {
+		return userentity.User{}, ErrAccountStatusConflict
+	}
+	if params.To == userentity.AccountStatusPendingDeletion && !r.tradingAccount(user.Id).Empty() {
+		return userentity.User{}, ErrAccountNotEmpty
+	}
+
+	user.Status = params.To
+	user.StatusChangedAt = at
+	user.DeletionScheduledAt = time.Time{}
+	if params.To == userentity.AccountStatusPendingDeletion {
+		user.DeletionScheduledAt = params.DeletionScheduledAt
+	}
+	user.UpdatedAt = at
+	user.Version++
+	r.users[username] = user
+	if params.To != userentity.AccountStatusActive {
+		open := make([]userentity.Order, 0)
+		for _, order := range r.orders {
+			if order.UserID == user.Id && order.Status.Open() {
+				open = append(open, order)
+			}
+		}
+		sort.Slice(open, func(i, j int) bool { return open[i].ID < open[j].ID })
+		for _, order := range open {
+			r.closeOrder(order, userentity.OrderStatusCancelled, orderReasonAccountInactive, at)
+		}
 	}
+	return user, nil
+}
+
+// ListUsersDueForAnonymization returns due pending_deletion users, oldest schedule first.
+func (r *InMemoryUserRepository) ListUsersDueForAnonymization(ctx context.Context, before time.Time, limit int) ([]userentity.User, error) {
 	_ = ctx
-	return nil
+	if limit <= 0 {
+		limit = 100
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var due []userentity.User
+	for _, u := range r.users {
+		if u.Status == userentity.AccountStatusPendingDeletion && !u.DeletionScheduledAt.After(before) {
+			due = append(due, u)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].DeletionScheduledAt.Equal(due[j].DeletionScheduledAt) {
+			return due[i].DeletionScheduledAt.Before(due[j].DeletionScheduledAt)
+		}
+		return due[i].Id < due[j].Id
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// AnonymizeUser scrubs a due pending_deletion user and re-keys it under its
+// placeholder username.
+func (r *InMemoryUserRepository) AnonymizeUser(ctx context.Context, userID int64, at time.Time) (userentity.User, error) {
+	_ = ctx
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	username, ok := r.usersByID[userID]
+	if !ok {
+		return userentity.User{}, ErrUserNotFound
+	}
+	user := r.users[username]
+	if user.Status != userentity.AccountStatusPendingDeletion || user.DeletionScheduledAt.After(at) {
+		return userentity.User{}, ErrAccountStatusConflict
+	}
+	if !r.tradingAccount(userID).Empty() {
+		return userentity.User{}, ErrAccountNotEmpty
+	}
+	for _, order := range r.orders {
+		if order.UserID == userID && order.Status.Open() {
+			return userentity.User{}, ErrAccountNotEmpty
+		}
+	}
+
+	for id, token := range r.tokensByID {
+		if token.UserID == userID {
+			delete(r.tokenByValue, token.Token)
+			delete(r.tokensByID, id)
+		}
+	}
+	delete(r.tokenByUser, userID)
+	for id, export := range r.dataExports {
+		if export.UserID == userID {
+			delete(r.dataExports, id)
+		}
+	}
+	for i := range r.outboxEvents {
+		if r.outboxEvents[i].AggregateID == userID {
+			r.outboxEvents[i].Payload = []byte(redactedOutboxPayload)
+			r.outboxEvents[i].UpdatedAt = at
+		}
+	}
+	// Details are stored inline; clearing them stands in for deleting the
+	// user_event_details rows.
+	for i := range r.auditEvents {
+		if r.auditEvents[i].UserID == userID {
+			r.auditEvents[i].Changes = nil
+			r.auditEvents[i].IP = ""
+			r.auditEvents[i].UserAgent = ""
+		}
+	}
+	r.nextAuditID++
+	r.auditEvents = append(r.auditEvents, userentity.AuditEvent{ID: r.nextAuditID, UserID: userID, Action: userentity.AuditActionAnonymize, CreatedAt: at})
+	for i := range r.loginRecords {
+		if r.loginRecords[i].UserID == userID {
+			r.loginRecords[i].IP = ""
+			r.loginRecords[i].UserAgent = ""
+			r.loginRecords[i].DeviceFingerprint = ""
+		}
+	}
+	for id, document := range r.kycDocuments {
+		if document.UserID == userID {
+			delete(r.kycDocuments, id)
+		}
+	}
+	for id, submission := range r.kycSubmits {
+		if submission.UserID == userID {
+			submission.DocumentNumber = ""
+			submission.FullName = ""
+			submission.UpdatedAt = at
+			r.kycSubmits[id] = submission
+		}
+	}
+	delete(r.users, username)
+	delete(r.logins, username)
+	delete(r.emailIndex, user.Email)
+
+	user.Username, user.Email = anonymizedIdentity(userID)
+	user.Name = ""
+	user.DocumentID = ""
+	user.Birthday = anonymizedBirthday
+	user.PermanentAddress = ""
+	user.PhoneNumber = ""
+	user.Status = userentity.AccountStatusDeleted
+	user.StatusChangedAt = at
+	user.DeletionScheduledAt = time.Time{}
+	user.AvatarKey = ""
+	user.UpdatedAt = at
+	user.Version++
+
+	r.users[user.Username] = user
+	r.usersByID[userID] = user.Username
+	r.emailIndex[user.Email] = user.Username
+	return user, nil
 }
 
-// GetUser retrieves a user profile from the in-memory repository.
 func (r *InMemoryUserRepository) GetUser(ctx context.Context, userName string) (userentity.User, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	user, ok := r.users[userName]
-	if !ok {
-		return userentity.User{}, fmt.Errorf("user not found")
+	if !ok || user.Status != userentity.AccountStatusActive {
+		return userentity.User{}, ErrUserNotFound
 	}
 	_ = ctx
 	return user, nil
 }
 
-// GetUserByEmail retrieves a user by email.
+// GetUserByEmail retrieves an active user by email.
 func (r *InMemoryUserRepository) GetUserByEmail(ctx context.Context, email string) (userentity.User, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	username, ok := r.emailIndex[email]
 	if !ok {
-		return userentity.User{}, fmt.Errorf("user not found")
+		return userentity.User{}, ErrUserNotFound
 	}
 	user := r.users[username]
+	if user.Status != userentity.AccountStatusActive {
+		return userentity.User{}, ErrUserNotFound
+	}
 	_ = ctx
 	return user, nil
 }
 
-// ListUsers returns a slice of users respecting pagination parameters.
+// ListUsers returns a slice of users respecting filter, sort and pagination parameters.
 func (r *InMemoryUserRepository) ListUsers(ctx context.Context, params ports.ListUsersParams) ([]userentity.User, int64, error) {
 	_ = ctx
 
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	usernames := make([]string, 0, len(r.users))
-	for username := range r.users {
-		usernames = append(usernames, username)
+	matched := make([]userentity.User, 0, len(r.users))
+	for _, u := range r.users {
+		if matchesListFilter(u, params.Filter) {
+			matched = append(matched, u)
+		}
 	}
-	sort.Strings(usernames)
+	// Mirror the MySQL ordering: sort column, then id in the same direction.
+	sort.Slice(matched, func(i, j int) bool {
+		return compareListKey(matched[i], listCursorOf(matched[j]), params.Sort) < 0
+	})
 
-	total := int64(len(usernames))
+	total := int64(len(matched))
 
 	limit := params.Limit
 	if limit <= 0 {
@@ -340,21 +682,101 @@ func (r *InMemoryUserRepository) ListUsers(ctx context.Context, params ports.Lis
 	if offset < 0 {
 		offset = 0
 	}
-	if offset >= len(usernames) {
+	if params.After != nil {
+		offset = sort.Search(len(matched), func(i int) bool {
+			return compareListKey(matched[i], *params.After, params.Sort) > 0
+		})
+	}
+	if offset >= len(matched) {
 		return []userentity.User{}, total, nil
 	}
 
 	end := offset + limit
-	if end > len(usernames) {
-		end = len(usernames)
+	if end > len(matched) {
+		end = len(matched)
+	}
+
+	result := make([]userentity.User, end-offset)
+	copy(result, matched[offset:end])
+	return result, total, nil
+}
+
+func matchesListFilter(u userentity.User, f ports.ListUsersFilter) bool {
+	if !containsAccountStatus(listStatuses(f.Statuses), u.Status) {
+		return false
+	}
+	if f.Verified != nil && u.Verified != *f.Verified {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && u.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !u.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	if !hasFoldPrefix(u.Email, f.EmailPrefix) ||
+		!hasFoldPrefix(u.Username, f.UsernamePrefix) ||
+		!hasFoldPrefix(u.Name, f.NamePrefix) {
+		return false
+	}
+	if !matchesExact(u.PhoneNumber, f.PhoneNumber) || !matchesExact(u.DocumentID, f.DocumentID) {
+		return false
+	}
+	if f.Query != "" {
+		q := strings.ToLower(f.Query)
+		found := false
+		for _, v := range []string{u.Username, u.Email, u.Name} {
+			if strings.Contains(strings.ToLower(v), q) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
 	}
+	return true
+}
 
-	result := make([]userentity.User, 0, end-offset)
-	for _, username := range usernames[offset:end] {
-		result = append(result, r.users[username])
+func hasFoldPrefix(s, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(s), strings.ToLower(prefix))
+}
+
+func listCursorOf(u userentity.User) ports.ListUsersCursor {
+	return ports.ListUsersCursor{
+		ID:        u.Id,
+		Username:  u.Username,
+		Email:     u.Email,
+		Name:      u.Name,
+		CreatedAt: u.CreatedAt,
 	}
+}
 
-	return result, total, nil
+// compareListKey orders u relative to the keyset position c under s.
+func compareListKey(u userentity.User, c ports.ListUsersCursor, s ports.ListUsersSort) int {
+	var cmp int
+	switch s.Field {
+	case ports.ListUsersSortUsername:
+		cmp = strings.Compare(u.Username, c.Username)
+	case ports.ListUsersSortEmail:
+		cmp = strings.Compare(u.Email, c.Email)
+	case ports.ListUsersSortName:
+		cmp = strings.Compare(u.Name, c.Name)
+	case ports.ListUsersSortCreatedAt:
+		cmp = u.CreatedAt.Compare(c.CreatedAt)
+	}
+	if cmp == 0 {
+		switch {
+		case u.Id < c.ID:
+			cmp = -1
+		case u.Id > c.ID:
+			cmp = 1
+		}
+	}
+	if s.Desc {
+		cmp = -cmp
+	}
+	return cmp
 }
 
 // UpdateUser updates an existing user in the in-memory repository.
@@ -363,13 +785,16 @@ func (r *InMemoryUserRepository) UpdateUser(ctx context.Context, userName string
 	defer r.mu.Unlock()
 
 	current, ok := r.users[userName]
-	if !ok {
-		return fmt.Errorf("user not found")
+	if !ok || current.Status != userentity.AccountStatusActive {
+		return ErrUserNotFound
+	}
+	if updated.Version != 0 && updated.Version != current.Version {
+		return ErrUserVersionConflict
 	}
 
 	if updated.Email != "" {
 		if owner, exists := r.emailIndex[updated.Email]; exists && owner != userName {
-			return fmt.Errorf("username or email already exists")
+			return ErrUserAlreadyExists
 		}
 	}
 
@@ -378,6 +803,10 @@ func (r *InMemoryUserRepository) UpdateUser(ctx context.Context, userName string
 	updated.Verified = current.Verified
 	updated.VerifiedAt = current.VerifiedAt
 	updated.CreatedAt = current.CreatedAt
+	updated.Status = current.Status
+	updated.StatusChangedAt = current.StatusChangedAt
+	updated.DeletionScheduledAt = current.DeletionScheduledAt
+	updated.Version = current.Version + 1
 	if updated.UpdatedAt.IsZero() {
 		updated.UpdatedAt = time.Now().UTC()
 	}
@@ -393,14 +822,39 @@ func (r *InMemoryUserRepository) UpdateUser(ctx context.Context, userName string
 	return nil
 }
 
+// UpdateAvatar sets the avatar key of an active user.
+func (r *InMemoryUserRepository) UpdateAvatar(ctx context.Context, userID int64, avatarKey string, at time.Time) (userentity.User, error) {
+	_ = ctx
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	username, ok := r.usersByID[userID]
+	if !ok {
+		return userentity.User{}, ErrUserNotFound
+	}
+	user := r.users[username]
+	if user.Status != userentity.AccountStatusActive {
+		return userentity.User{}, ErrUserNotFound
+	}
+	user.AvatarKey = avatarKey
+	user.UpdatedAt = at
+	user.Version++
+	r.users[username] = user
+	return user, nil
+}
+
 // UpdatePassword updates the password hash for a user in memory.
 func (r *InMemoryUserRepository) UpdatePassword(ctx context.Context, userName, hashedPassword string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	login, ok := r.logins[userName]
-	if !ok {
-		return fmt.Errorf("user not found")
+	if !ok || r.users[userName].Status != userentity.AccountStatusActive {
+		return ErrUserNotFound
 	}
 	login.Password = hashedPassword
 	r.logins[userName] = login
@@ -414,7 +868,7 @@ func (r *InMemoryUserRepository) UpdateOutboxStatus(ctx context.Context, eventID
 	switch status {
 	case "pending", "processed", "failed":
 	default:
-		return fmt.Errorf("invalid outbox status: %s", status)
+		return fmt.Errorf("%w: %s", ErrInvalidOutboxStatus, status)
 	}
 
 	r.mu.Lock()
@@ -435,7 +889,27 @@ func (r *InMemoryUserRepository) UpdateOutboxStatus(ctx context.Context, eventID
 			return nil
 		}
 	}
-	return fmt.Errorf("outbox event not found")
+	return ErrOutboxEventNotFound
+}
+
+// appendOutboxEvent stores event for the user with the defaults the MySQL
+// schema applies. The caller holds the write lock.
+func (r *InMemoryUserRepository) appendOutboxEvent(userID int64, event userentity.OutboxEvent, at time.Time) {
+	event.ID = int64(len(r.outboxEvents) + 1)
+	event.AggregateID = userID
+	if event.Status == "" {
+		event.Status = userentity.OutboxEventStatusPending
+	}
+	if event.AggregateType == "" {
+		event.AggregateType = "user"
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = at
+	}
+	if event.UpdatedAt.IsZero() {
+		event.UpdatedAt = event.CreatedAt
+	}
+	r.outboxEvents = append(r.outboxEvents, event)
 }
 
 // GetLatestVerificationToken returns the latest verification token for the user.
@@ -445,11 +919,18 @@ func (r *InMemoryUserRepository) GetLatestVerificationToken(ctx context.Context,
 
 	tokenID, ok := r.tokenByUser[userID]
 	if !ok {
-		return userentity.VerificationToken{}, fmt.Errorf("verification token not found")
+		return userentity.VerificationToken{}, ErrVerificationTokenNotFound
 	}
 	token, exists := r.tokensByID[tokenID]
 	if !exists {
-		return userentity.VerificationToken{}, fmt.Errorf("verification token not found")
+		return userentity.VerificationToken{}, ErrVerificationTokenNotFound
 	}
 	return token, nil
 }
+
+// matchesExact mirrors the blind index lookups of the MySQL repository, which
+// compare whitespace-trimmed values.
+func matchesExact(value, want string) bool {
+	want = strings.TrimSpace(want)
+	return want == "" || strings.TrimSpace(value) == want
+}
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func (r *InMemoryUserRepository) CreateStatement(ctx context.Context, statement userentity.Statement) (userentity.Statement, error) {
+	_ = ctx
+	if !validStatement(statement) {
+		return userentity.Statement{}, ErrInvalidStatement
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[statement.UserID]; !ok {
+		return userentity.Statement{}, ErrUserNotFound
+	}
+	if existing, ok := r.statement(statement.UserID, statement.Period); ok {
+		return existing, nil
+	}
+	statement.CreatedAt = orNow(statement.CreatedAt)
+	r.nextStmtID++
+	statement.ID = r.nextStmtID
+	r.statements[statement.ID] = statement
+	return statement, nil
+}
+
+func (r *InMemoryUserRepository) GetStatement(ctx context.Context, userID int64, period string) (userentity.Statement, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	statement, ok := r.statement(userID, period)
+	if !ok {
+		return userentity.Statement{}, ErrStatementNotFound
+	}
+	return statement, nil
+}
+
+// statement finds the statement of the user for period. The caller holds
+// r.mu.
+func (r *InMemoryUserRepository) statement(userID int64, period string) (userentity.Statement, bool) {
+	for _, statement := range r.statements {
+		if statement.UserID == userID && statement.Period == period {
+			return statement, true
+		}
+	}
+	return userentity.Statement{}, false
+}
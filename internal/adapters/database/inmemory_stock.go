@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// AddStock lists a stock in the in-memory catalog, or updates the names of an
+// already listed code. The MySQL catalog is maintained outside this service,
+// so the ports have no write API for it.
+func (r *InMemoryUserRepository) AddStock(stock userentity.Stock) userentity.Stock {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if id, ok := r.stockByCode[stock.Code]; ok {
+		stock.ID = id
+	} else {
+		r.nextStockID++
+		stock.ID = r.nextStockID
+		r.stockByCode[stock.Code] = stock.ID
+	}
+	r.stocks[stock.ID] = stock
+	return stock
+}
+
+// RecordStockPrice records a new price of a listed stock.
+func (r *InMemoryUserRepository) RecordStockPrice(code string, price int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id, ok := r.stockByCode[code]
+	if !ok {
+		return ErrStockNotFound
+	}
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	quote := userentity.StockQuote{ID: int64(len(r.stockPrices) + 1), StockID: id, Code: code, Price: price, At: at}
+	r.stockPrices = append(r.stockPrices, quote)
+	r.stockQuotes[id] = quote
+	return nil
+}
+
+func (r *InMemoryUserRepository) GetStocksByCodes(ctx context.Context, codes []string) ([]userentity.Stock, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stocks := make([]userentity.Stock, 0, len(codes))
+	for _, code := range codes {
+		if id, ok := r.stockByCode[code]; ok {
+			stocks = append(stocks, r.stocks[id])
+		}
+	}
+	return stocks, nil
+}
+
+func (r *InMemoryUserRepository) LatestStockQuotes(ctx context.Context, codes []string) ([]userentity.StockQuote, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	quotes := make([]userentity.StockQuote, 0, len(codes))
+	for _, code := range codes {
+		if quote, ok := r.stockQuotes[r.stockByCode[code]]; ok {
+			quotes = append(quotes, quote)
+		}
+	}
+	return quotes, nil
+}
+
+func (r *InMemoryUserRepository) LatestStockQuotesBefore(ctx context.Context, codes []string, before time.Time) ([]userentity.StockQuote, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	latest := make(map[int64]userentity.StockQuote)
+	for _, quote := range r.stockPrices {
+		if quote.At.Before(before) {
+			latest[quote.StockID] = quote
+		}
+	}
+	quotes := make([]userentity.StockQuote, 0, len(codes))
+	for _, code := range codes {
+		if id, ok := r.stockByCode[code]; ok {
+			if quote, ok := latest[id]; ok {
+				quotes = append(quotes, quote)
+			}
+		}
+	}
+	return quotes, nil
+}
+
+func (r *InMemoryUserRepository) ListStockQuotesAfter(ctx context.Context, afterID int64, limit int) ([]userentity.StockQuote, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Price records are never removed, so a record's ID is its position.
+	if afterID < 0 {
+		afterID = 0
+	}
+	quotes := make([]userentity.StockQuote, 0)
+	if afterID < int64(len(r.stockPrices)) {
+		end := afterID + int64(stockQuotesLimit(limit))
+		if end > int64(len(r.stockPrices)) {
+			end = int64(len(r.stockPrices))
+		}
+		quotes = append(quotes, r.stockPrices[afterID:end]...)
+	}
+	return quotes, nil
+}
+
+func (r *InMemoryUserRepository) LastStockQuoteID(ctx context.Context) (int64, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.stockPrices)), nil
+}
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func (r *InMemoryUserRepository) GetTradingAccount(ctx context.Context, userID int64) (userentity.TradingAccount, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.usersByID[userID]; !ok {
+		return userentity.TradingAccount{}, ErrUserNotFound
+	}
+	return r.tradingAccount(userID), nil
+}
+
+func (r *InMemoryUserRepository) SetTradingTier(ctx context.Context, userID int64, tier string, at time.Time) (userentity.TradingAccount, error) {
+	_ = ctx
+	_ = at
+	if !validTradingTier(tier) {
+		return userentity.TradingAccount{}, ErrInvalidTradingTier
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[userID]; !ok {
+		return userentity.TradingAccount{}, ErrUserNotFound
+	}
+	r.tiers[userID] = tier
+	return r.tradingAccount(userID), nil
+}
+
+func (r *InMemoryUserRepository) PostLedgerEntries(ctx context.Context, reference string, entries []userentity.LedgerEntry) ([]userentity.LedgerEntry, error) {
+	_ = ctx
+	if !validLedgerEntries(reference, entries) {
+		return nil, ErrInvalidLedgerEntry
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	posted := make([]userentity.LedgerEntry, 0, len(entries))
+	for _, entry := range r.ledger {
+		if entry.Reference == reference {
+			posted = append(posted, entry)
+		}
+	}
+	if len(posted) > 0 {
+		return posted, nil
+	}
+	checked := make([]userentity.LedgerEntry, 0, len(entries))
+	for _, entry := range entries {
+		if _, ok := r.usersByID[entry.UserID]; !ok {
+			return nil, ErrUserNotFound
+		}
+		entry.StockID = 0
+		if !entry.Cash() {
+			stockID, ok := r.stockByCode[entry.Code]
+			if !ok {
+				return nil, ErrStockNotFound
+			}
+			entry.StockID = stockID
+		}
+		entry.CreatedAt = orNow(entry.CreatedAt)
+		checked = append(checked, entry)
+	}
+	return r.appendLedgerEntries(checked, reference), nil
+}
+
+func (r *InMemoryUserRepository) ListPendingSettlements(ctx context.Context, userID int64) ([]userentity.Settlement, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pending := make([]userentity.Settlement, 0)
+	for _, settlement := range r.settlements {
+		if settlement.UserID == userID && settlement.Status == userentity.SettlementStatusPending {
+			pending = append(pending, settlement)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return pending[i].SettlesOn.Before(pending[j].SettlesOn) })
+	return pending, nil
+}
+
+func (r *InMemoryUserRepository) SettleDue(ctx context.Context, through time.Time, at time.Time) (int, error) {
+	_ = ctx
+	through = settlementDate(through)
+	at = orNow(at)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	settled := 0
+	for i, settlement := range r.settlements {
+		if settlement.Status != userentity.SettlementStatusPending || settlement.SettlesOn.After(through) {
+			continue
+		}
+		entry := settlementEntry(settlement, at)
+		r.appendLedgerEntries([]userentity.LedgerEntry{entry}, entry.Reference)
+		r.settlements[i].Status = userentity.SettlementStatusSettled
+		r.settlements[i].SettledAt = at
+		settled++
+	}
+	return settled, nil
+}
+
+func (r *InMemoryUserRepository) ListLedgerEntries(ctx context.Context, params ports.ListLedgerEntriesParams) ([]userentity.LedgerEntry, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]userentity.LedgerEntry, 0)
+	for _, entry := range r.ledger {
+		if entry.UserID == params.UserID && !entry.CreatedAt.Before(params.From) && entry.CreatedAt.Before(params.To) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].CreatedAt.Before(entries[j].CreatedAt) })
+	return entries, nil
+}
+
+// appendSettlements schedules settlements of the trade with reference. The
+// caller holds r.mu for writing.
+func (r *InMemoryUserRepository) appendSettlements(settlements []userentity.Settlement, reference string) {
+	for _, settlement := range settlements {
+		r.nextSettleID++
+		settlement.ID = r.nextSettleID
+		settlement.Reference = reference
+		r.settlements = append(r.settlements, settlement)
+	}
+}
+
+// appendLedgerEntries stores checked entries under reference. The caller
+// holds r.mu for writing.
+func (r *InMemoryUserRepository) appendLedgerEntries(entries []userentity.LedgerEntry, reference string) []userentity.LedgerEntry {
+	posted := make([]userentity.LedgerEntry, 0, len(entries))
+	for _, entry := range entries {
+		r.nextEntryID++
+		entry.ID = r.nextEntryID
+		entry.Reference = reference
+		r.ledger = append(r.ledger, entry)
+		posted = append(posted, entry)
+	}
+	return posted
+}
+
+// tradingAccount sums the ledger, the pending settlements and the open
+// withdrawals of a user. The caller holds r.mu.
+func (r *InMemoryUserRepository) tradingAccount(userID int64) userentity.TradingAccount {
+	account := userentity.TradingAccount{UserID: userID, Tier: userentity.DefaultTradingTier, Positions: make([]userentity.Position, 0)}
+	if tier, ok := r.tiers[userID]; ok {
+		account.Tier = tier
+	}
+	positions := make(map[int64]userentity.Position)
+	for _, entry := range r.ledger {
+		switch {
+		case entry.UserID != userID:
+		case entry.Cash():
+			account.Cash += entry.Amount
+		default:
+			position := positions[entry.StockID]
+			position.Quantity += entry.Amount
+			positions[entry.StockID] = position
+		}
+	}
+	for _, settlement := range r.settlements {
+		switch {
+		case settlement.UserID != userID || settlement.Status != userentity.SettlementStatusPending:
+		case settlement.Cash():
+			account.PendingCash += settlement.Amount
+		default:
+			position := positions[settlement.StockID]
+			position.Pending += settlement.Amount
+			positions[settlement.StockID] = position
+		}
+	}
+	for _, payment := range r.payments {
+		if payment.UserID == userID && payment.Kind == userentity.PaymentKindWithdrawal && payment.Status.Open() {
+			account.Withheld += payment.Amount
+		}
+	}
+	for stockID, position := range positions {
+		if position.Quantity != 0 || position.Pending != 0 {
+			position.StockID, position.Code = stockID, r.stocks[stockID].Code
+			account.Positions = append(account.Positions, position)
+		}
+	}
+	sort.Slice(account.Positions, func(i, j int) bool { return account.Positions[i].Code < account.Positions[j].Code })
+	return account
+}
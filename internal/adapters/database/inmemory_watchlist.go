@@ -0,0 +1,181 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// CreateWatchlist stores an empty watchlist.
+func (r *InMemoryUserRepository) CreateWatchlist(ctx context.Context, watchlist userentity.Watchlist) (userentity.Watchlist, error) {
+	_ = ctx
+	if !validWatchlist(watchlist) {
+		return userentity.Watchlist{}, ErrInvalidWatchlist
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.usersByID[watchlist.UserID]; !ok {
+		return userentity.Watchlist{}, ErrUserNotFound
+	}
+	if r.watchlistNameTaken(watchlist.UserID, 0, watchlist.Name) {
+		return userentity.Watchlist{}, ErrWatchlistNameTaken
+	}
+	watchlist.CreatedAt = orNow(watchlist.CreatedAt)
+	watchlist.UpdatedAt = watchlist.CreatedAt
+	watchlist.Codes = []string{}
+	r.nextWatchID++
+	watchlist.ID = r.nextWatchID
+	r.watchlists[watchlist.ID] = watchlist
+	return copyWatchlist(watchlist), nil
+}
+
+func (r *InMemoryUserRepository) GetWatchlist(ctx context.Context, watchlistID int64) (userentity.Watchlist, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	watchlist, ok := r.watchlists[watchlistID]
+	if !ok {
+		return userentity.Watchlist{}, ErrWatchlistNotFound
+	}
+	return copyWatchlist(watchlist), nil
+}
+
+func (r *InMemoryUserRepository) ListWatchlists(ctx context.Context, userID int64) ([]userentity.Watchlist, error) {
+	_ = ctx
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	watchlists := make([]userentity.Watchlist, 0)
+	for _, watchlist := range r.watchlists {
+		if watchlist.UserID == userID {
+			watchlists = append(watchlists, copyWatchlist(watchlist))
+		}
+	}
+	sort.Slice(watchlists, func(i, j int) bool { return watchlists[i].ID < watchlists[j].ID })
+	return watchlists, nil
+}
+
+func (r *InMemoryUserRepository) RenameWatchlist(ctx context.Context, watchlistID int64, name string, at time.Time) (userentity.Watchlist, error) {
+	_ = ctx
+	if !validWatchlistName(name) {
+		return userentity.Watchlist{}, ErrInvalidWatchlist
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	watchlist, ok := r.watchlists[watchlistID]
+	if !ok {
+		return userentity.Watchlist{}, ErrWatchlistNotFound
+	}
+	if r.watchlistNameTaken(watchlist.UserID, watchlist.ID, name) {
+		return userentity.Watchlist{}, ErrWatchlistNameTaken
+	}
+	watchlist.Name = name
+	watchlist.UpdatedAt = orNow(at)
+	r.watchlists[watchlist.ID] = watchlist
+	return copyWatchlist(watchlist), nil
+}
+
+func (r *InMemoryUserRepository) DeleteWatchlist(ctx context.Context, watchlistID int64) error {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.watchlists[watchlistID]; !ok {
+		return ErrWatchlistNotFound
+	}
+	delete(r.watchlists, watchlistID)
+	return nil
+}
+
+func (r *InMemoryUserRepository) AddWatchlistStock(ctx context.Context, watchlistID int64, code string, at time.Time) (userentity.Watchlist, error) {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	watchlist, ok := r.watchlists[watchlistID]
+	if !ok {
+		return userentity.Watchlist{}, ErrWatchlistNotFound
+	}
+	if _, ok := r.stockByCode[code]; !ok {
+		return userentity.Watchlist{}, ErrStockNotFound
+	}
+	for _, existing := range watchlist.Codes {
+		if existing == code {
+			return userentity.Watchlist{}, ErrWatchlistStockExists
+		}
+	}
+	watchlist.Codes = append(append([]string{}, watchlist.Codes...), code)
+	watchlist.UpdatedAt = orNow(at)
+	r.watchlists[watchlist.ID] = watchlist
+	return copyWatchlist(watchlist), nil
+}
+
+func (r *InMemoryUserRepository) RemoveWatchlistStock(ctx context.Context, watchlistID int64, code string, at time.Time) (userentity.Watchlist, error) {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	watchlist, ok := r.watchlists[watchlistID]
+	if !ok {
+		return userentity.Watchlist{}, ErrWatchlistNotFound
+	}
+	if _, ok := r.stockByCode[code]; !ok {
+		return userentity.Watchlist{}, ErrStockNotFound
+	}
+	codes := make([]string, 0, len(watchlist.Codes))
+	for _, existing := range watchlist.Codes {
+		if existing != code {
+			codes = append(codes, existing)
+		}
+	}
+	if len(codes) == len(watchlist.Codes) {
+		return userentity.Watchlist{}, ErrWatchlistStockNotFound
+	}
+	watchlist.Codes = codes
+	watchlist.UpdatedAt = orNow(at)
+	r.watchlists[watchlist.ID] = watchlist
+	return copyWatchlist(watchlist), nil
+}
+
+func (r *InMemoryUserRepository) ReorderWatchlist(ctx context.Context, watchlistID int64, codes []string, at time.Time) (userentity.Watchlist, error) {
+	_ = ctx
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	watchlist, ok := r.watchlists[watchlistID]
+	if !ok {
+		return userentity.Watchlist{}, ErrWatchlistNotFound
+	}
+	if !sameCodes(watchlist.Codes, codes) {
+		return userentity.Watchlist{}, ErrWatchlistOrderMismatch
+	}
+	watchlist.Codes = append([]string{}, codes...)
+	watchlist.UpdatedAt = orNow(at)
+	r.watchlists[watchlist.ID] = watchlist
+	return copyWatchlist(watchlist), nil
+}
+
+// watchlistNameTaken reports whether another watchlist of the user than
+// exceptID has name. Like the MySQL collation it ignores case.
+func (r *InMemoryUserRepository) watchlistNameTaken(userID, exceptID int64, name string) bool {
+	for _, watchlist := range r.watchlists {
+		if watchlist.UserID == userID && watchlist.ID != exceptID && strings.EqualFold(watchlist.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyWatchlist keeps callers from modifying the stored codes.
+func copyWatchlist(watchlist userentity.Watchlist) userentity.Watchlist {
+	watchlist.Codes = append([]string{}, watchlist.Codes...)
+	return watchlist
+}
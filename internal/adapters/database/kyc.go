@@ -0,0 +1,84 @@
+package database
+
+import (
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// columnKycDocumentNumber is the encrypted kyc_submissions column; the name is
+// bound to its values like the users columns in field_cipher.go.
+const columnKycDocumentNumber = "kyc_document_number"
+
+// Column limits of kyc_submissions.
+const (
+	kycFullNameMaxLen        = 255
+	kycRejectionReasonMaxLen = 255
+)
+
+// kycSubmissionsLimit returns the page size ListKycSubmissions uses for limit.
+func kycSubmissionsLimit(limit int) int {
+	if limit <= 0 {
+		return 50
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+func validKycDocument(document userentity.KycDocument) bool {
+	if document.UserID <= 0 || document.BlobKey == "" {
+		return false
+	}
+	switch document.Kind {
+	case userentity.KycDocumentKindFront, userentity.KycDocumentKindBack, userentity.KycDocumentKindSelfie:
+		return true
+	}
+	return false
+}
+
+// normalizeKycSubmission validates params and returns the pending submission
+// to store.
+func normalizeKycSubmission(params ports.SubmitKycParams) (userentity.KycSubmission, error) {
+	submission := params.Submission
+	switch submission.DocumentType {
+	case userentity.KycDocumentTypeIDCard, userentity.KycDocumentTypePassport:
+	default:
+		return userentity.KycSubmission{}, ErrInvalidKycSubmission
+	}
+	if submission.UserID <= 0 || submission.DocumentNumber == "" || len(params.DocumentIDs) == 0 {
+		return userentity.KycSubmission{}, ErrInvalidKycSubmission
+	}
+	submission.FullName = truncate(submission.FullName, kycFullNameMaxLen)
+	submission.Status = userentity.KycStatusPending
+	submission.RejectionReason = ""
+	submission.ReviewerID = 0
+	submission.ReviewedAt = time.Time{}
+	return submission, nil
+}
+
+// normalizeKycReview validates params; only rejections keep a reason.
+func normalizeKycReview(params ports.ReviewKycParams) (ports.ReviewKycParams, error) {
+	switch params.Status {
+	case userentity.KycStatusApproved:
+		params.Reason = ""
+	case userentity.KycStatusRejected:
+		params.Reason = truncate(params.Reason, kycRejectionReasonMaxLen)
+	default:
+		return ports.ReviewKycParams{}, ErrInvalidKycSubmission
+	}
+	if params.SubmissionID <= 0 || params.ReviewerID <= 0 {
+		return ports.ReviewKycParams{}, ErrInvalidKycSubmission
+	}
+	if params.At.IsZero() {
+		params.At = time.Now().UTC()
+	}
+	return params, nil
+}
+
+// kycSubmittable reports whether a user with the given KYC status may submit.
+func kycSubmittable(status userentity.KycStatus) bool {
+	return status == "" || status == userentity.KycStatusNone || status == userentity.KycStatusRejected
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// Column limits of user_logging.
+const (
+	loginIPMaxLen          = 64
+	loginUserAgentMaxLen   = 255
+	loginFingerprintMaxLen = 64
+)
+
+// loginHistoryLimit returns the page size ListLoginHistory uses for limit.
+func loginHistoryLimit(limit int) int {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+// normalizeLoginRecord validates record and truncates its client details to
+// the column limits.
+func normalizeLoginRecord(record userentity.LoginRecord, recordType userentity.LoginRecordType) (userentity.LoginRecord, error) {
+	if record.UserID <= 0 {
+		return userentity.LoginRecord{}, ErrInvalidLoginRecord
+	}
+	record.Type = recordType
+	record.IP = truncate(record.IP, loginIPMaxLen)
+	record.UserAgent = truncate(record.UserAgent, loginUserAgentMaxLen)
+	record.DeviceFingerprint = truncate(record.DeviceFingerprint, loginFingerprintMaxLen)
+	if recordType == userentity.LoginRecordTypeLogout {
+		record.NewDevice = false
+	}
+	return record, nil
+}
@@ -59,12 +59,26 @@ func ConnectDB(cfg Config) error {
 	return nil
 }
 
-type MysqlUserRepository struct{ db *sql.DB }
+// MysqlUserRepository stores users in MySQL. The sensitive columns cmnd,
+// birthday, permanent_address and phone_number go through a FieldCipher.
+type MysqlUserRepository struct {
+	db     *sql.DB
+	fields FieldCipher
+}
 
 var _ ports.UserRepository = MysqlUserRepository{}
 
+// NewMysqlUserRepository stores sensitive columns in plaintext.
 func NewMysqlUserRepository(db *sql.DB) MysqlUserRepository {
-	return MysqlUserRepository{db: db}
+	return NewMysqlUserRepositoryWithCipher(db, plaintextFields{})
+}
+
+// NewMysqlUserRepositoryWithCipher encrypts sensitive columns with fields.
+func NewMysqlUserRepositoryWithCipher(db *sql.DB, fields FieldCipher) MysqlUserRepository {
+	if fields == nil {
+		fields = plaintextFields{}
+	}
+	return MysqlUserRepository{db: db, fields: fields}
 }
 
 // CheckUserNameAndEmailIsExist check username and email is existed in system
@@ -82,7 +96,7 @@ func (r MysqlUserRepository) CheckUserNameAndEmailIsExist(ctx context.Context, u
 		}
 		return fmt.Errorf("check username/email exists failed: %w", err)
 	}
-	return fmt.Errorf("username or email already exists")
+	return ErrUserAlreadyExists
 }
 
 func genderString(isMale bool) string {
@@ -109,15 +123,21 @@ func (r MysqlUserRepository) CreateUserWithVerification(ctx context.Context, par
 	}()
 
 	gender := genderString(params.User.Gender)
+	sealed, err := sealUser(r.fields, params.User)
+	if err != nil {
+		return userentity.User{}, err
+	}
 	res, err := tx.ExecContext(ctx,
-		`INSERT INTO users (name, cmnd, birthday, gender, permanent_address, phone_number, username, password_hash, email, is_verified)
-         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, 0)`,
+		`INSERT INTO users (name, cmnd, cmnd_bidx, birthday, gender, permanent_address, phone_number, phone_number_bidx, username, password_hash, email, is_verified)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0)`,
 		params.User.Name,
-		params.User.DocumentID,
-		params.User.Birthday,
+		sealed.cmnd,
+		sealed.cmndIndex,
+		sealed.birthday,
 		gender,
-		params.User.PermanentAddress,
-		params.User.PhoneNumber,
+		sealed.permanentAddress,
+		sealed.phoneNumber,
+		sealed.phoneNumberIndex,
 		params.Login.UserName,
 		params.Login.Password,
 		params.User.Email,
@@ -125,7 +145,7 @@ func (r MysqlUserRepository) CreateUserWithVerification(ctx context.Context, par
 	if err != nil {
 		var me *mysql.MySQLError
 		if errors.As(err, &me) && me.Number == 1062 {
-			err = fmt.Errorf("username or email already exists")
+			err = ErrUserAlreadyExists
 		} else {
 			err = fmt.Errorf("insert user: %w", err)
 		}
@@ -184,6 +204,8 @@ func (r MysqlUserRepository) CreateUserWithVerification(ctx context.Context, par
 	created.Username = params.Login.UserName
 	created.Verified = false
 	created.VerifiedAt = time.Time{}
+	created.Version = 1
+	created.Status = userentity.AccountStatusActive
 	return created, nil
 }
 
@@ -263,75 +285,50 @@ func (r MysqlUserRepository) RotateVerificationToken(ctx context.Context, params
 func (r MysqlUserRepository) FindVerificationToken(ctx context.Context, token string) (userentity.VerificationToken, userentity.User, error) {
 	var (
 		vt         userentity.VerificationToken
-		u          userentity.User
+		ur         userRow
 		purpose    string
+		newEmail   sql.NullString
 		consumedAt sql.NullTime
-		birthday   sql.NullTime
-		gender     string
-		verifiedAt sql.NullTime
-		createdAt  sql.NullTime
-		updatedAt  sql.NullTime
 	)
 
-	err := r.db.QueryRowContext(ctx,
-		`SELECT t.id, t.user_id, t.token, t.purpose, t.expires_at, t.consumed_at, t.created_at, t.updated_at,
-                u.id, u.username, u.name, u.cmnd, u.birthday, u.gender,
-                u.permanent_address, u.phone_number, u.email, u.is_verified,
-                u.verified_at, u.created_at, u.updated_at
-         FROM user_verification_tokens t
-         JOIN users u ON u.id = t.user_id
-         WHERE t.token = ?
-         ORDER BY t.id DESC
-         LIMIT 1`,
-		token,
-	).Scan(
+	dest := append([]any{
 		&vt.ID,
 		&vt.UserID,
 		&vt.Token,
 		&purpose,
+		&newEmail,
 		&vt.ExpiresAt,
 		&consumedAt,
 		&vt.CreatedAt,
 		&vt.UpdatedAt,
-		&u.Id,
-		&u.Username,
-		&u.Name,
-		&u.DocumentID,
-		&birthday,
-		&gender,
-		&u.PermanentAddress,
-		&u.PhoneNumber,
-		&u.Email,
-		&u.Verified,
-		&verifiedAt,
-		&createdAt,
-		&updatedAt,
-	)
+	}, ur.dest()...)
+	err := r.db.QueryRowContext(ctx,
+		`SELECT t.id, t.user_id, t.token, t.purpose, t.new_email, t.expires_at, t.consumed_at, t.created_at, t.updated_at,
+                `+userColumns("u")+`
+         FROM user_verification_tokens t
+         JOIN users u ON u.id = t.user_id
+         WHERE t.token = ?
+         ORDER BY t.id DESC
+         LIMIT 1`,
+		token,
+	).Scan(dest...)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return vt, u, fmt.Errorf("verification token not found")
+			return vt, userentity.User{}, ErrVerificationTokenNotFound
 		}
-		return vt, u, fmt.Errorf("query verification token: %w", err)
+		return vt, userentity.User{}, fmt.Errorf("query verification token: %w", err)
 	}
 
 	vt.Purpose = userentity.VerificationPurpose(purpose)
+	vt.NewEmail = newEmail.String
 	if consumedAt.Valid {
 		vt.ConsumedAt = &consumedAt.Time
 	}
-	if birthday.Valid {
-		u.Birthday = birthday.Time
-	}
-	u.Gender = parseGender(gender)
-	if verifiedAt.Valid {
-		u.VerifiedAt = verifiedAt.Time
-	}
-	if createdAt.Valid {
-		u.CreatedAt = createdAt.Time
-	}
-	if updatedAt.Valid {
-		u.UpdatedAt = updatedAt.Time
-	}
 
+	u, err := ur.user(r.fields)
+	if err != nil {
+		return vt, userentity.User{}, err
+	}
 	return vt, u, nil
 }
 
@@ -346,7 +343,7 @@ func (r MysqlUserRepository) GetLatestVerificationToken(ctx context.Context, use
 	err := r.db.QueryRowContext(ctx,
 		`SELECT id, user_id, token, purpose, expires_at, consumed_at, created_at, updated_at
          FROM user_verification_tokens
-         WHERE user_id = ?
+         WHERE user_id = ? AND purpose <> 'email_change'
          ORDER BY id DESC
          LIMIT 1`,
 		userID,
@@ -362,7 +359,7 @@ func (r MysqlUserRepository) GetLatestVerificationToken(ctx context.Context, use
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return vt, fmt.Errorf("verification token not found")
+			return vt, ErrVerificationTokenNotFound
 		}
 		return vt, fmt.Errorf("query latest verification token: %w", err)
 	}
@@ -394,11 +391,11 @@ func (r MysqlUserRepository) VerifyUserWithToken(ctx context.Context, tokenID in
 		return userentity.User{}, fmt.Errorf("consume token: %w", err)
 	}
 	if rows, _ := res.RowsAffected(); rows == 0 {
-		return userentity.User{}, fmt.Errorf("verification token already used or not found")
+		return userentity.User{}, ErrVerificationTokenConsumed
 	}
 
 	res, err = tx.ExecContext(ctx,
-		`UPDATE users SET is_verified = 1, verified_at = ?, updated_at = ? WHERE id = ?`,
+		`UPDATE users SET is_verified = 1, verified_at = ?, updated_at = ?, version = version + 1 WHERE id = ?`,
 		verifiedAt,
 		verifiedAt,
 		userID,
@@ -407,214 +404,591 @@ func (r MysqlUserRepository) VerifyUserWithToken(ctx context.Context, tokenID in
 		return userentity.User{}, fmt.Errorf("update user verified flag: %w", err)
 	}
 	if rows, _ := res.RowsAffected(); rows == 0 {
-		return userentity.User{}, fmt.Errorf("user not found")
+		return userentity.User{}, ErrUserNotFound
 	}
 
-	var (
-		u              userentity.User
-		birthday       sql.NullTime
-		gender         string
-		verifiedAtNull sql.NullTime
-		createdAt      sql.NullTime
-		updatedAt      sql.NullTime
+	var ur userRow
+	err = tx.QueryRowContext(ctx,
+		`SELECT `+userColumns("")+` FROM users WHERE id = ?`,
+		userID,
+	).Scan(ur.dest()...)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("load verified user: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return userentity.User{}, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return ur.user(r.fields)
+}
+
+// RequestEmailChange stores a pending email change token together with its
+// outbox events, consuming the user's earlier pending email change tokens.
+func (r MysqlUserRepository) RequestEmailChange(ctx context.Context, params ports.RequestEmailChangeParams) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var userID int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM users WHERE id = ? FOR UPDATE", params.UserID).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrUserNotFound
+			return err
+		}
+		return fmt.Errorf("lock user: %w", err)
+	}
+
+	var one int
+	err = tx.QueryRowContext(ctx, "SELECT 1 FROM users WHERE email = ? AND id <> ? LIMIT 1", params.Token.NewEmail, userID).Scan(&one)
+	switch {
+	case err == nil:
+		err = ErrUserAlreadyExists
+		return err
+	case err != sql.ErrNoRows:
+		return fmt.Errorf("check email: %w", err)
+	}
+	err = nil
+
+	now := params.Token.CreatedAt
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+	_, err = tx.ExecContext(ctx,
+		`UPDATE user_verification_tokens
+         SET consumed_at = ?
+         WHERE user_id = ? AND purpose = 'email_change' AND consumed_at IS NULL`,
+		now,
+		userID,
 	)
+	if err != nil {
+		return fmt.Errorf("expire pending email change tokens: %w", err)
+	}
 
-	err = tx.QueryRowContext(ctx,
-		`SELECT id, username, name, cmnd, birthday, gender,
-                permanent_address, phone_number, email, is_verified,
-                verified_at, created_at, updated_at
-         FROM users WHERE id = ?`,
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO user_verification_tokens (user_id, token, purpose, new_email, expires_at)
+         VALUES (?, ?, ?, ?, ?)`,
 		userID,
-	).Scan(
-		&u.Id,
-		&u.Username,
-		&u.Name,
-		&u.DocumentID,
-		&birthday,
-		&gender,
-		&u.PermanentAddress,
-		&u.PhoneNumber,
-		&u.Email,
-		&u.Verified,
-		&verifiedAtNull,
-		&createdAt,
-		&updatedAt,
+		params.Token.Token,
+		string(userentity.VerificationPurposeEmailChange),
+		params.Token.NewEmail,
+		params.Token.ExpiresAt,
 	)
 	if err != nil {
-		return userentity.User{}, fmt.Errorf("load verified user: %w", err)
+		return fmt.Errorf("insert email change token: %w", err)
+	}
+
+	for _, event := range params.OutboxEvents {
+		if err = insertOutboxEvent(ctx, tx, userID, event); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+// ConfirmEmailChange consumes an email change token and applies its new email.
+func (r MysqlUserRepository) ConfirmEmailChange(ctx context.Context, tokenID int64, userID int64, confirmedAt time.Time) (userentity.User, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var newEmail sql.NullString
+	err = tx.QueryRowContext(ctx,
+		`SELECT new_email FROM user_verification_tokens
+         WHERE id = ? AND user_id = ? AND purpose = 'email_change'`,
+		tokenID,
+		userID,
+	).Scan(&newEmail)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrVerificationTokenNotFound
+			return userentity.User{}, err
+		}
+		return userentity.User{}, fmt.Errorf("load email change token: %w", err)
 	}
 
-	if birthday.Valid {
-		u.Birthday = birthday.Time
+	res, err := tx.ExecContext(ctx,
+		`UPDATE user_verification_tokens SET consumed_at = ? WHERE id = ? AND consumed_at IS NULL`,
+		confirmedAt,
+		tokenID,
+	)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("consume token: %w", err)
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		err = ErrVerificationTokenConsumed
+		return userentity.User{}, err
 	}
-	u.Gender = parseGender(gender)
-	if verifiedAtNull.Valid {
-		u.VerifiedAt = verifiedAtNull.Time
+
+	res, err = tx.ExecContext(ctx,
+		`UPDATE users SET email = ?, updated_at = ?, version = version + 1 WHERE id = ?`,
+		newEmail.String,
+		confirmedAt,
+		userID,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1062 {
+			err = ErrUserAlreadyExists
+			return userentity.User{}, err
+		}
+		return userentity.User{}, fmt.Errorf("update user email: %w", err)
 	}
-	if createdAt.Valid {
-		u.CreatedAt = createdAt.Time
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		err = ErrUserNotFound
+		return userentity.User{}, err
 	}
-	if updatedAt.Valid {
-		u.UpdatedAt = updatedAt.Time
+
+	var ur userRow
+	err = tx.QueryRowContext(ctx, `SELECT `+userColumns("")+` FROM users WHERE id = ?`, userID).Scan(ur.dest()...)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("load user: %w", err)
 	}
 
 	if err = tx.Commit(); err != nil {
 		return userentity.User{}, fmt.Errorf("commit tx: %w", err)
 	}
+	return ur.user(r.fields)
+}
 
-	return u, nil
+// insertOutboxEvent writes an outbox event for the user within tx.
+func insertOutboxEvent(ctx context.Context, tx *sql.Tx, userID int64, event userentity.OutboxEvent) error {
+	status := event.Status
+	if status == "" {
+		status = userentity.OutboxEventStatusPending
+	}
+	aggregateType := event.AggregateType
+	if aggregateType == "" {
+		aggregateType = "user"
+	}
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO user_outbox_events (aggregate_id, aggregate_type, event_type, payload, status)
+         VALUES (?, ?, ?, ?, ?)`,
+		userID,
+		aggregateType,
+		event.EventType,
+		event.Payload,
+		string(status),
+	)
+	if err != nil {
+		return fmt.Errorf("insert outbox event: %w", err)
+	}
+	return nil
 }
 
-// GetLoginInfo returns login and user information for given username
+// GetLoginInfo returns login and user information for given username, for any
+// account that has not been anonymized.
 func (r MysqlUserRepository) GetLoginInfo(ctx context.Context, userName string) (userentity.LoginMethodPassword, userentity.User, error) {
 	var login userentity.LoginMethodPassword
-	var u userentity.User
-	var gender string
-	var birthday sql.NullTime
-	var verifiedAt sql.NullTime
-	var createdAt sql.NullTime
-	var updatedAt sql.NullTime
+	var ur userRow
 	err := r.db.QueryRowContext(
 		ctx,
-		`SELECT id, username, password_hash, name, cmnd, birthday, gender, permanent_address, phone_number, email,
-                is_verified, verified_at, created_at, updated_at
-         FROM users WHERE username = ?`,
+		`SELECT password_hash, `+userColumns("")+`
+         FROM users WHERE username = ? AND status <> 'deleted'`,
 		userName,
-	).Scan(
-		&u.Id,
-		&login.UserName,
-		&login.Password,
-		&u.Name,
-		&u.DocumentID,
-		&birthday,
-		&gender,
-		&u.PermanentAddress,
-		&u.PhoneNumber,
-		&u.Email,
-		&u.Verified,
-		&verifiedAt,
-		&createdAt,
-		&updatedAt,
-	)
+	).Scan(append([]any{&login.Password}, ur.dest()...)...)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return login, u, fmt.Errorf("user not found")
+			return login, userentity.User{}, ErrUserNotFound
 		}
-		return login, u, fmt.Errorf("query login info failed: %w", err)
+		return login, userentity.User{}, fmt.Errorf("query login info failed: %w", err)
 	}
-	if birthday.Valid {
-		u.Birthday = birthday.Time
+	u, err := ur.user(r.fields)
+	if err != nil {
+		return login, userentity.User{}, err
 	}
-	if verifiedAt.Valid {
-		u.VerifiedAt = verifiedAt.Time
+	login.UserName = u.Username
+	return login, u, nil
+}
+
+// ChangeAccountStatus moves the account between the non-deleted states when
+// its current status is one of params.From, cancelling its open orders when
+// it leaves active. Only empty accounts can be scheduled for deletion.
+func (r MysqlUserRepository) ChangeAccountStatus(ctx context.Context, params ports.ChangeAccountStatusParams) (userentity.User, error) {
+	if !changeableAccountStatus(params.To) || len(params.From) == 0 {
+		return userentity.User{}, ErrInvalidAccountStatus
+	}
+	var scheduled sql.NullTime
+	if params.To == userentity.AccountStatusPendingDeletion {
+		if params.DeletionScheduledAt.IsZero() {
+			return userentity.User{}, ErrInvalidAccountStatus
+		}
+		scheduled = sql.NullTime{Time: params.DeletionScheduledAt, Valid: true}
 	}
-	if createdAt.Valid {
-		u.CreatedAt = createdAt.Time
+	at := params.At
+	if at.IsZero() {
+		at = time.Now().UTC()
 	}
-	if updatedAt.Valid {
-		u.UpdatedAt = updatedAt.Time
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("begin tx: %w", err)
 	}
-	u.Gender = parseGender(gender)
-	return login, u, nil
-}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
 
-// DeleteUser removes a user by username from MySQL repository.
-func (r MysqlUserRepository) DeleteUser(ctx context.Context, userName string) error {
-	res, err := r.db.ExecContext(ctx, "DELETE FROM users WHERE username = ?", userName)
+	// The open orders of an account leaving active are cancelled. They are
+	// locked before the user row, in the order fills take the locks.
+	var open []int64
+	if params.To != userentity.AccountStatusActive {
+		if open, err = lockOpenOrderIDs(ctx, tx, params.UserID); err != nil {
+			return userentity.User{}, err
+		}
+	}
+
+	var status string
+	err = tx.QueryRowContext(ctx, "SELECT status FROM users WHERE id = ? FOR UPDATE", params.UserID).Scan(&status)
 	if err != nil {
-		return fmt.Errorf("delete user failed: %w", err)
+		if err == sql.ErrNoRows {
+			return userentity.User{}, ErrUserNotFound
+		}
+		return userentity.User{}, fmt.Errorf("lock user: %w", err)
 	}
-	if rows, _ := res.RowsAffected(); rows == 0 {
-		return fmt.Errorf("user not found")
+	if !containsAccountStatus(params.From, userentity.AccountStatus(status)) {
+		err = ErrAccountStatusConflict
+		return userentity.User{}, err
 	}
-	return nil
+
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE users
+         SET status = ?, status_changed_at = ?, deletion_scheduled_at = ?, updated_at = ?, version = version + 1
+         WHERE id = ?`,
+		string(params.To), at, scheduled, at, params.UserID,
+	); err != nil {
+		return userentity.User{}, fmt.Errorf("update account status: %w", err)
+	}
+	for _, id := range open {
+		var order userentity.Order
+		if order, err = loadOrder(ctx, tx, id); err != nil {
+			return userentity.User{}, err
+		}
+		if _, err = closeOrder(ctx, tx, order, userentity.OrderStatusCancelled, orderReasonAccountInactive, at); err != nil {
+			return userentity.User{}, err
+		}
+	}
+	if params.To == userentity.AccountStatusPendingDeletion {
+		if err = checkAccountEmpty(ctx, tx, params.UserID); err != nil {
+			return userentity.User{}, err
+		}
+	}
+
+	var ur userRow
+	if err = tx.QueryRowContext(ctx, `SELECT `+userColumns("")+` FROM users WHERE id = ?`, params.UserID).Scan(ur.dest()...); err != nil {
+		return userentity.User{}, fmt.Errorf("reload user: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.User{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return ur.user(r.fields)
 }
 
-func (r MysqlUserRepository) scanUserByRow(row *sql.Row) (userentity.User, error) {
-	var (
-		u          userentity.User
-		birthday   sql.NullTime
-		gender     string
-		verifiedAt sql.NullTime
-		createdAt  sql.NullTime
-		updatedAt  sql.NullTime
+// ListUsersDueForAnonymization returns pending_deletion accounts whose grace
+// period ended at or before the given time, oldest schedule first.
+func (r MysqlUserRepository) ListUsersDueForAnonymization(ctx context.Context, before time.Time, limit int) ([]userentity.User, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+userColumns("")+`
+         FROM users
+         WHERE status = 'pending_deletion' AND deletion_scheduled_at <= ?
+         ORDER BY deletion_scheduled_at ASC, id ASC
+         LIMIT ?`,
+		before, limit,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("list users due for anonymization: %w", err)
+	}
+	defer rows.Close()
+
+	var users []userentity.User
+	for rows.Next() {
+		var ur userRow
+		if err := rows.Scan(ur.dest()...); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		u, err := ur.user(r.fields)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate users: %w", err)
+	}
+	return users, nil
+}
+
+// AnonymizeUser replaces the personal data of a due pending_deletion account
+// with placeholders, clears its password and deletes its verification tokens,
+// data exports, audit event details and KYC document records, then appends an
+// anonymize audit event. Outbox events are kept as delivery history with their
+// payloads redacted; transactions and assets keep referencing the row.
+func (r MysqlUserRepository) AnonymizeUser(ctx context.Context, userID int64, at time.Time) (userentity.User, error) {
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
 
-	err := row.Scan(
-		&u.Id,
-		&u.Username,
-		&u.Name,
-		&u.DocumentID,
-		&birthday,
-		&gender,
-		&u.PermanentAddress,
-		&u.PhoneNumber,
-		&u.Email,
-		&u.Verified,
-		&verifiedAt,
-		&createdAt,
-		&updatedAt,
+	var (
+		status    string
+		scheduled sql.NullTime
 	)
+	err = tx.QueryRowContext(ctx, "SELECT status, deletion_scheduled_at FROM users WHERE id = ? FOR UPDATE", userID).Scan(&status, &scheduled)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.User{}, ErrUserNotFound
+		}
+		return userentity.User{}, fmt.Errorf("lock user: %w", err)
+	}
+	if userentity.AccountStatus(status) != userentity.AccountStatusPendingDeletion || !scheduled.Valid || scheduled.Time.After(at) {
+		err = ErrAccountStatusConflict
+		return userentity.User{}, err
+	}
+	// The account may have received cash or shares during the grace period.
+	if err = checkAccountEmpty(ctx, tx, userID); err != nil {
+		return userentity.User{}, err
+	}
+	var open []userentity.Order
+	if open, err = listOpenOrders(ctx, tx, userID); err != nil {
+		return userentity.User{}, err
+	}
+	if len(open) > 0 {
+		err = ErrAccountNotEmpty
+		return userentity.User{}, err
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM user_verification_tokens WHERE user_id = ?", userID); err != nil {
+		return userentity.User{}, fmt.Errorf("delete verification tokens: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, "DELETE FROM user_data_exports WHERE user_id = ?", userID); err != nil {
+		return userentity.User{}, fmt.Errorf("delete data exports: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx,
+		"UPDATE user_outbox_events SET payload = ?, updated_at = ? WHERE aggregate_id = ?",
+		redactedOutboxPayload, at, userID,
+	); err != nil {
+		return userentity.User{}, fmt.Errorf("redact outbox events: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, "DELETE FROM user_event_details WHERE user_id = ?", userID); err != nil {
+		return userentity.User{}, fmt.Errorf("delete audit event details: %w", err)
+	}
+	if err = insertAuditEvent(ctx, tx, userentity.AuditEvent{UserID: userID, Action: userentity.AuditActionAnonymize, CreatedAt: at}); err != nil {
 		return userentity.User{}, err
 	}
-	if birthday.Valid {
-		u.Birthday = birthday.Time
+	if _, err = tx.ExecContext(ctx,
+		"UPDATE user_logging SET ip = '', user_agent = '', device_fingerprint = '', updated_at = ? WHERE user_id = ?",
+		at, userID,
+	); err != nil {
+		return userentity.User{}, fmt.Errorf("scrub login history: %w", err)
 	}
-	u.Gender = parseGender(gender)
-	if verifiedAt.Valid {
-		u.VerifiedAt = verifiedAt.Time
+	if _, err = tx.ExecContext(ctx, "DELETE FROM kyc_documents WHERE user_id = ?", userID); err != nil {
+		return userentity.User{}, fmt.Errorf("delete kyc documents: %w", err)
 	}
-	if createdAt.Valid {
-		u.CreatedAt = createdAt.Time
+	if _, err = tx.ExecContext(ctx,
+		"UPDATE kyc_submissions SET document_number = '', full_name = '', updated_at = ? WHERE user_id = ?",
+		at, userID,
+	); err != nil {
+		return userentity.User{}, fmt.Errorf("scrub kyc submissions: %w", err)
 	}
-	if updatedAt.Valid {
-		u.UpdatedAt = updatedAt.Time
+	username, email := anonymizedIdentity(userID)
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE users
+         SET username = ?, email = ?, name = '', cmnd = '', cmnd_bidx = NULL, birthday = ?, permanent_address = '',
+             phone_number = '', phone_number_bidx = NULL, password_hash = '', status = 'deleted', status_changed_at = ?, deletion_scheduled_at = NULL,
+             avatar_key = '', updated_at = ?, version = version + 1
+         WHERE id = ?`,
+		username, email, formatBirthday(anonymizedBirthday), at, at, userID,
+	); err != nil {
+		return userentity.User{}, fmt.Errorf("anonymize user: %w", err)
+	}
+
+	var ur userRow
+	if err = tx.QueryRowContext(ctx, `SELECT `+userColumns("")+` FROM users WHERE id = ?`, userID).Scan(ur.dest()...); err != nil {
+		return userentity.User{}, fmt.Errorf("reload user: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.User{}, fmt.Errorf("commit tx: %w", err)
 	}
+	return ur.user(r.fields)
+}
+
+// requireUserExists distinguishes "no rows changed" from "no such active user"
+// after an UPDATE, since MySQL reports zero affected rows when values are
+// unchanged.
+func (r MysqlUserRepository) requireUserExists(ctx context.Context, userName string) error {
+	var one int
+	err := r.db.QueryRowContext(ctx, "SELECT 1 FROM users WHERE username = ? AND status = 'active'", userName).Scan(&one)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("check user exists failed: %w", err)
+	}
+	return nil
+}
+
+// userColumnNames lists the users columns scanned by userRow, in order.
+var userColumnNames = []string{
+	"id", "username", "name", "cmnd", "birthday", "gender",
+	"permanent_address", "phone_number", "email", "is_verified",
+	"verified_at", "created_at", "updated_at", "version",
+	"status", "status_changed_at", "deletion_scheduled_at", "kyc_status",
+	"avatar_key",
+}
+
+// userColumns returns the comma separated userRow columns, qualified with
+// alias when it is not empty.
+func userColumns(alias string) string {
+	if alias == "" {
+		return strings.Join(userColumnNames, ", ")
+	}
+	qualified := make([]string, len(userColumnNames))
+	for i, c := range userColumnNames {
+		qualified[i] = alias + "." + c
+	}
+	return strings.Join(qualified, ", ")
+}
+
+// userRow holds the scan targets for userColumns.
+type userRow struct {
+	u                   userentity.User
+	birthday            string
+	gender              string
+	verifiedAt          sql.NullTime
+	createdAt           sql.NullTime
+	updatedAt           sql.NullTime
+	status              string
+	statusChangedAt     sql.NullTime
+	deletionScheduledAt sql.NullTime
+	kycStatus           string
+}
+
+func (ur *userRow) dest() []any {
+	return []any{
+		&ur.u.Id,
+		&ur.u.Username,
+		&ur.u.Name,
+		&ur.u.DocumentID,
+		&ur.birthday,
+		&ur.gender,
+		&ur.u.PermanentAddress,
+		&ur.u.PhoneNumber,
+		&ur.u.Email,
+		&ur.u.Verified,
+		&ur.verifiedAt,
+		&ur.createdAt,
+		&ur.updatedAt,
+		&ur.u.Version,
+		&ur.status,
+		&ur.statusChangedAt,
+		&ur.deletionScheduledAt,
+		&ur.kycStatus,
+		&ur.u.AvatarKey,
+	}
+}
+
+// user maps the scanned row to a user, decrypting its sensitive fields.
+func (ur *userRow) user(fields FieldCipher) (userentity.User, error) {
+	u := ur.u
+	if err := openUser(fields, &u, ur.birthday); err != nil {
+		return userentity.User{}, fmt.Errorf("decrypt user %d: %w", u.Id, err)
+	}
+	u.Gender = parseGender(ur.gender)
+	if ur.verifiedAt.Valid {
+		u.VerifiedAt = ur.verifiedAt.Time
+	}
+	if ur.createdAt.Valid {
+		u.CreatedAt = ur.createdAt.Time
+	}
+	if ur.updatedAt.Valid {
+		u.UpdatedAt = ur.updatedAt.Time
+	}
+	u.Status = userentity.AccountStatus(ur.status)
+	if ur.statusChangedAt.Valid {
+		u.StatusChangedAt = ur.statusChangedAt.Time
+	}
+	if ur.deletionScheduledAt.Valid {
+		u.DeletionScheduledAt = ur.deletionScheduledAt.Time
+	}
+	u.KycStatus = userentity.KycStatus(ur.kycStatus)
 	return u, nil
 }
 
-// GetUser retrieves a user profile by username.
+func (r MysqlUserRepository) scanUserByRow(row *sql.Row) (userentity.User, error) {
+	var ur userRow
+	if err := row.Scan(ur.dest()...); err != nil {
+		return userentity.User{}, err
+	}
+	return ur.user(r.fields)
+}
+
+// GetUser retrieves an active user profile by username.
 func (r MysqlUserRepository) GetUser(ctx context.Context, userName string) (userentity.User, error) {
 	row := r.db.QueryRowContext(
 		ctx,
-		`SELECT id, username, name, cmnd, birthday, gender,
-                permanent_address, phone_number, email, is_verified,
-                verified_at, created_at, updated_at
-         FROM users WHERE username = ?`,
+		`SELECT `+userColumns("")+` FROM users WHERE username = ? AND status = 'active'`,
 		userName,
 	)
 	user, err := r.scanUserByRow(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return userentity.User{}, fmt.Errorf("user not found")
+			return userentity.User{}, ErrUserNotFound
 		}
 		return userentity.User{}, fmt.Errorf("query user failed: %w", err)
 	}
 	return user, nil
 }
 
-// GetUserByEmail retrieves a user by email.
+// GetUserByEmail retrieves an active user by email.
 func (r MysqlUserRepository) GetUserByEmail(ctx context.Context, email string) (userentity.User, error) {
 	row := r.db.QueryRowContext(
 		ctx,
-		`SELECT id, username, name, cmnd, birthday, gender,
-                permanent_address, phone_number, email, is_verified,
-                verified_at, created_at, updated_at
-         FROM users WHERE email = ?`,
+		`SELECT `+userColumns("")+` FROM users WHERE email = ? AND status = 'active'`,
 		email,
 	)
 	user, err := r.scanUserByRow(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return userentity.User{}, fmt.Errorf("user not found")
+			return userentity.User{}, ErrUserNotFound
 		}
 		return userentity.User{}, fmt.Errorf("query user by email failed: %w", err)
 	}
 	return user, nil
 }
 
-// ListUsers returns users with pagination support and total count.
+// ListUsers returns a filtered, sorted page of users and the number of users
+// matching the filter. Pages after a cursor use a keyset condition on the sort
+// column and id instead of OFFSET.
 func (r MysqlUserRepository) ListUsers(ctx context.Context, params ports.ListUsersParams) ([]userentity.User, int64, error) {
 	limit := params.Limit
 	if limit <= 0 {
@@ -624,19 +998,40 @@ func (r MysqlUserRepository) ListUsers(ctx context.Context, params ports.ListUse
 		limit = 100
 	}
 	offset := params.Offset
-	if offset < 0 {
+	if offset < 0 || params.After != nil {
 		offset = 0
 	}
 
-	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, username, name, cmnd, birthday, gender,
-                permanent_address, phone_number, email, is_verified,
-                verified_at, created_at, updated_at
-         FROM users
-         ORDER BY id ASC
-         LIMIT ? OFFSET ?`,
-		limit, offset,
-	)
+	column, ok := listUsersSortColumns[params.Sort.Field]
+	if !ok {
+		column = "id"
+	}
+	dir, cmp := "ASC", ">"
+	if params.Sort.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	where, args := listUsersWhere(r.fields, params.Filter)
+	pageWhere, pageArgs := where, args
+	if params.After != nil {
+		cond := "id " + cmp + " ?"
+		keyArgs := []any{params.After.ID}
+		if column != "id" {
+			value := listUsersCursorValue(params.After, params.Sort.Field)
+			cond = "(" + column + " " + cmp + " ? OR (" + column + " = ? AND id " + cmp + " ?))"
+			keyArgs = []any{value, value, params.After.ID}
+		}
+		pageWhere = append(append([]string{}, where...), cond)
+		pageArgs = append(append([]any{}, args...), keyArgs...)
+	}
+
+	order := "id " + dir
+	if column != "id" {
+		order = column + " " + dir + ", id " + dir
+	}
+	query := `SELECT ` + userColumns("") + ` FROM users` + whereClause(pageWhere) +
+		` ORDER BY ` + order + ` LIMIT ? OFFSET ?`
+	rows, err := r.db.QueryContext(ctx, query, append(pageArgs, limit, offset)...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("list users query failed: %w", err)
 	}
@@ -644,47 +1039,14 @@ func (r MysqlUserRepository) ListUsers(ctx context.Context, params ports.ListUse
 
 	users := make([]userentity.User, 0, limit)
 	for rows.Next() {
-		var (
-			u          userentity.User
-			birthday   sql.NullTime
-			gender     string
-			verifiedAt sql.NullTime
-			createdAt  sql.NullTime
-			updatedAt  sql.NullTime
-		)
-
-		if err := rows.Scan(
-			&u.Id,
-			&u.Username,
-			&u.Name,
-			&u.DocumentID,
-			&birthday,
-			&gender,
-			&u.PermanentAddress,
-			&u.PhoneNumber,
-			&u.Email,
-			&u.Verified,
-			&verifiedAt,
-			&createdAt,
-			&updatedAt,
-		); err != nil {
+		var ur userRow
+		if err := rows.Scan(ur.dest()...); err != nil {
 			return nil, 0, fmt.Errorf("scan user: %w", err)
 		}
-
-		if birthday.Valid {
-			u.Birthday = birthday.Time
-		}
-		if verifiedAt.Valid {
-			u.VerifiedAt = verifiedAt.Time
-		}
-		if createdAt.Valid {
-			u.CreatedAt = createdAt.Time
-		}
-		if updatedAt.Valid {
-			u.UpdatedAt = updatedAt.Time
+		u, err := ur.user(r.fields)
+		if err != nil {
+			return nil, 0, err
 		}
-		u.Gender = parseGender(gender)
-
 		users = append(users, u)
 	}
 	if err := rows.Err(); err != nil {
@@ -692,56 +1054,183 @@ func (r MysqlUserRepository) ListUsers(ctx context.Context, params ports.ListUse
 	}
 
 	var total int64
-	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users").Scan(&total); err != nil {
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users"+whereClause(where), args...).Scan(&total); err != nil {
 		return nil, 0, fmt.Errorf("count users failed: %w", err)
 	}
 
 	return users, total, nil
 }
 
-// UpdateUser updates profile data for the given username.
+// listUsersSortColumns maps sort fields to users columns. The values are
+// interpolated into SQL, so only this fixed set is allowed.
+var listUsersSortColumns = map[ports.ListUsersSortField]string{
+	ports.ListUsersSortID:        "id",
+	ports.ListUsersSortUsername:  "username",
+	ports.ListUsersSortEmail:     "email",
+	ports.ListUsersSortName:      "name",
+	ports.ListUsersSortCreatedAt: "created_at",
+}
+
+func listUsersCursorValue(c *ports.ListUsersCursor, field ports.ListUsersSortField) any {
+	switch field {
+	case ports.ListUsersSortUsername:
+		return c.Username
+	case ports.ListUsersSortEmail:
+		return c.Email
+	case ports.ListUsersSortName:
+		return c.Name
+	case ports.ListUsersSortCreatedAt:
+		return c.CreatedAt
+	default:
+		return c.ID
+	}
+}
+
+// listUsersWhere builds the conditions and arguments for filter. Phone number
+// and document id match through their blind indexes.
+func listUsersWhere(fields FieldCipher, filter ports.ListUsersFilter) ([]string, []any) {
+	statuses := listStatuses(filter.Statuses)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(statuses)), ", ")
+	conds := []string{"status IN (" + placeholders + ")"}
+	args := make([]any, 0, len(statuses))
+	for _, st := range statuses {
+		args = append(args, string(st))
+	}
+	if filter.Verified != nil {
+		conds = append(conds, "is_verified = ?")
+		args = append(args, *filter.Verified)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		conds = append(conds, "created_at >= ?")
+		args = append(args, filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		conds = append(conds, "created_at < ?")
+		args = append(args, filter.CreatedBefore)
+	}
+	prefixes := []struct{ column, value string }{
+		{"email", filter.EmailPrefix},
+		{"username", filter.UsernamePrefix},
+		{"name", filter.NamePrefix},
+	}
+	for _, p := range prefixes {
+		if p.value != "" {
+			conds = append(conds, p.column+" LIKE ? ESCAPE '!'")
+			args = append(args, escapeLike(p.value)+"%")
+		}
+	}
+	indexes := []struct{ column, field, value string }{
+		{"phone_number_bidx", columnPhoneNumber, filter.PhoneNumber},
+		{"cmnd_bidx", columnCmnd, filter.DocumentID},
+	}
+	for _, ix := range indexes {
+		if strings.TrimSpace(ix.value) != "" {
+			conds = append(conds, ix.column+" = ?")
+			args = append(args, fields.BlindIndex(ix.field, ix.value))
+		}
+	}
+	if filter.Query != "" {
+		pattern := "%" + escapeLike(filter.Query) + "%"
+		conds = append(conds, "(username LIKE ? ESCAPE '!' OR email LIKE ? ESCAPE '!' OR name LIKE ? ESCAPE '!')")
+		args = append(args, pattern, pattern, pattern)
+	}
+	return conds, args
+}
+
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conds, " AND ")
+}
+
+// escapeLike escapes LIKE wildcards so s matches literally. Patterns use
+// ESCAPE '!' so they do not depend on NO_BACKSLASH_ESCAPES.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`!`, `!!`, `%`, `!%`, `_`, `!_`).Replace(s)
+}
+
+// UpdateUser updates profile data for the given username. A non-zero
+// updated.Version must match the stored version; every update bumps it.
 func (r MysqlUserRepository) UpdateUser(ctx context.Context, userName string, updated userentity.User) error {
 	gender := genderString(updated.Gender)
 	updatedAt := updated.UpdatedAt
 	if updatedAt.IsZero() {
 		updatedAt = time.Now().UTC()
 	}
+	sealed, err := sealUser(r.fields, updated)
+	if err != nil {
+		return err
+	}
 
 	res, err := r.db.ExecContext(ctx,
 		`UPDATE users
-         SET name = ?, cmnd = ?, birthday = ?, gender = ?, permanent_address = ?, phone_number = ?, email = ?, updated_at = ?
-         WHERE username = ?`,
+         SET name = ?, cmnd = ?, cmnd_bidx = ?, birthday = ?, gender = ?, permanent_address = ?, phone_number = ?,
+             phone_number_bidx = ?, email = ?, updated_at = ?, version = version + 1
+         WHERE username = ? AND status = 'active' AND (? = 0 OR version = ?)`,
 		updated.Name,
-		updated.DocumentID,
-		updated.Birthday,
+		sealed.cmnd,
+		sealed.cmndIndex,
+		sealed.birthday,
 		gender,
-		updated.PermanentAddress,
-		updated.PhoneNumber,
+		sealed.permanentAddress,
+		sealed.phoneNumber,
+		sealed.phoneNumberIndex,
 		updated.Email,
 		updatedAt,
 		userName,
+		updated.Version,
+		updated.Version,
 	)
 	if err != nil {
 		var me *mysql.MySQLError
 		if errors.As(err, &me) && me.Number == 1062 {
-			return fmt.Errorf("username or email already exists")
+			return ErrUserAlreadyExists
 		}
 		return fmt.Errorf("update user failed: %w", err)
 	}
 	if rows, _ := res.RowsAffected(); rows == 0 {
-		return fmt.Errorf("user not found")
+		if err := r.requireUserExists(ctx, userName); err != nil {
+			return err
+		}
+		return ErrUserVersionConflict
 	}
 	return nil
 }
 
+// UpdateAvatar sets the avatar key of an active user and returns the user.
+func (r MysqlUserRepository) UpdateAvatar(ctx context.Context, userID int64, avatarKey string, at time.Time) (userentity.User, error) {
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE users SET avatar_key = ?, updated_at = ?, version = version + 1 WHERE id = ? AND status = 'active'",
+		avatarKey, at, userID,
+	)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("update avatar: %w", err)
+	}
+	// The version bump always changes the row, so zero rows means no such
+	// active user.
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return userentity.User{}, ErrUserNotFound
+	}
+
+	var ur userRow
+	if err := r.db.QueryRowContext(ctx, `SELECT `+userColumns("")+` FROM users WHERE id = ?`, userID).Scan(ur.dest()...); err != nil {
+		return userentity.User{}, fmt.Errorf("reload user: %w", err)
+	}
+	return ur.user(r.fields)
+}
+
 // UpdatePassword replaces the stored password hash for the given username.
 func (r MysqlUserRepository) UpdatePassword(ctx context.Context, userName, hashedPassword string) error {
-	res, err := r.db.ExecContext(ctx, "UPDATE users SET password_hash = ? WHERE username = ?", hashedPassword, userName)
+	res, err := r.db.ExecContext(ctx, "UPDATE users SET password_hash = ? WHERE username = ? AND status = 'active'", hashedPassword, userName)
 	if err != nil {
 		return fmt.Errorf("update password failed: %w", err)
 	}
 	if rows, _ := res.RowsAffected(); rows == 0 {
-		return fmt.Errorf("user not found")
+		return r.requireUserExists(ctx, userName)
 	}
 	return nil
 }
@@ -752,7 +1241,7 @@ func (r MysqlUserRepository) UpdateOutboxStatus(ctx context.Context, eventID int
 	switch status {
 	case "pending", "processed", "failed":
 	default:
-		return fmt.Errorf("invalid outbox status: %s", status)
+		return fmt.Errorf("%w: %s", ErrInvalidOutboxStatus, status)
 	}
 
 	now := time.Now().UTC()
@@ -776,7 +1265,7 @@ func (r MysqlUserRepository) UpdateOutboxStatus(ctx context.Context, eventID int
 		return fmt.Errorf("update outbox status failed: %w", err)
 	}
 	if rows, _ := res.RowsAffected(); rows == 0 {
-		return fmt.Errorf("outbox event not found")
+		return ErrOutboxEventNotFound
 	}
 	return nil
 }
@@ -0,0 +1,171 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.AuditRepository = MysqlUserRepository{}
+
+// AppendAuditEvents inserts the events in one transaction. The changes, IP
+// and user agent of an event go to user_event_details so that anonymization
+// can delete them without touching user_events.
+func (r MysqlUserRepository) AppendAuditEvents(ctx context.Context, events []userentity.AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	normalized := make([]userentity.AuditEvent, 0, len(events))
+	for _, event := range events {
+		event, err := normalizeAuditEvent(event)
+		if err != nil {
+			return err
+		}
+		if event.CreatedAt.IsZero() {
+			event.CreatedAt = now
+		}
+		normalized = append(normalized, event)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, event := range normalized {
+		if err = insertAuditEvent(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+// insertAuditEvent inserts a normalized event and, when it has any, its
+// details.
+func insertAuditEvent(ctx context.Context, tx *sql.Tx, event userentity.AuditEvent) error {
+	actorID := sql.NullInt64{Int64: event.ActorID, Valid: event.ActorID != 0}
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO user_events (user_id, actor_id, action, method, request_id, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		event.UserID, actorID, string(event.Action), event.Method, event.RequestID, event.CreatedAt, event.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1452 {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("insert audit event: %w", err)
+	}
+	if len(event.Changes) == 0 && event.IP == "" && event.UserAgent == "" {
+		return nil
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("audit event id: %w", err)
+	}
+	var changes []byte
+	if len(event.Changes) > 0 {
+		if changes, err = json.Marshal(event.Changes); err != nil {
+			return fmt.Errorf("marshal audit changes: %w", err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO user_event_details (event_id, user_id, changed_data, ip, user_agent) VALUES (?, ?, ?, ?, ?)`,
+		id, event.UserID, changes, event.IP, event.UserAgent,
+	); err != nil {
+		return fmt.Errorf("insert audit event details: %w", err)
+	}
+	return nil
+}
+
+// ListAuditEvents returns matching events, newest first.
+func (r MysqlUserRepository) ListAuditEvents(ctx context.Context, params ports.ListAuditEventsParams) ([]userentity.AuditEvent, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	f := params.Filter
+	if f.UserID != 0 {
+		conds = append(conds, "e.user_id = ?")
+		args = append(args, f.UserID)
+	}
+	if f.ActorID != 0 {
+		conds = append(conds, "e.actor_id = ?")
+		args = append(args, f.ActorID)
+	}
+	if len(f.Actions) > 0 {
+		conds = append(conds, "e.action IN ("+strings.TrimSuffix(strings.Repeat("?, ", len(f.Actions)), ", ")+")")
+		for _, action := range f.Actions {
+			args = append(args, string(action))
+		}
+	}
+	if !f.CreatedAfter.IsZero() {
+		conds = append(conds, "e.created_at >= ?")
+		args = append(args, f.CreatedAfter)
+	}
+	if !f.CreatedBefore.IsZero() {
+		conds = append(conds, "e.created_at < ?")
+		args = append(args, f.CreatedBefore)
+	}
+	if f.RequestID != "" {
+		conds = append(conds, "e.request_id = ?")
+		args = append(args, f.RequestID)
+	}
+	if params.BeforeID > 0 {
+		conds = append(conds, "e.id < ?")
+		args = append(args, params.BeforeID)
+	}
+	args = append(args, auditListLimit(params.Limit))
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT e.id, e.user_id, e.actor_id, e.action, d.changed_data, e.method, COALESCE(d.ip, ''), COALESCE(d.user_agent, ''), e.request_id, e.created_at
+         FROM user_events e LEFT JOIN user_event_details d ON d.event_id = e.id`+whereClause(conds)+` ORDER BY e.id DESC LIMIT ?`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query audit events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]userentity.AuditEvent, 0)
+	for rows.Next() {
+		var (
+			event   userentity.AuditEvent
+			actorID sql.NullInt64
+			action  string
+			changes []byte
+		)
+		if err := rows.Scan(&event.ID, &event.UserID, &actorID, &action, &changes, &event.Method,
+			&event.IP, &event.UserAgent, &event.RequestID, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan audit event: %w", err)
+		}
+		event.ActorID = actorID.Int64
+		event.Action = userentity.AuditAction(action)
+		if len(changes) > 0 {
+			if err := json.Unmarshal(changes, &event.Changes); err != nil {
+				return nil, fmt.Errorf("decode audit changes: %w", err)
+			}
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate audit events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,169 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports/repotest"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
+)
+
+// mysqlConformanceDSNEnv names the environment variable holding the DSN of a
+// disposable MySQL-compatible database. The suite is skipped when it is unset.
+// Every table touched by the repository is emptied between sub-tests.
+const mysqlConformanceDSNEnv = "REPOTEST_MYSQL_DSN"
+
+// mysqlConformanceSerialEnv skips the concurrency tests when set to a true
+// value, for embedded MySQL stand-ins that lack real transaction isolation.
+const mysqlConformanceSerialEnv = "REPOTEST_MYSQL_SERIAL"
+
+func TestMysqlUserRepositoryConformance(t *testing.T) {
+	dsn := strings.TrimSpace(os.Getenv(mysqlConformanceDSNEnv))
+	if dsn == "" {
+		t.Skipf("%s not set; skipping MySQL conformance suite", mysqlConformanceDSNEnv)
+	}
+
+	serial, _ := strconv.ParseBool(os.Getenv(mysqlConformanceSerialEnv))
+	db := openConformanceDB(t, dsn)
+	applyConformanceSchema(t, db)
+	fields := newConformanceCipher(t, "k1")
+
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		truncateConformanceTables(t, db)
+		repo := NewMysqlUserRepositoryWithCipher(db, fields)
+		return repotest.Repositories{
+			Users:       repo,
+			Outbox:      repo,
+			DataExports: repo,
+			Audit:       repo,
+			Logins:      repo,
+			Kyc:         repo,
+			Stocks:      repo,
+			Watchlists:  repo,
+			PriceAlerts: repo,
+			News:        repo,
+			Orders:      repo,
+			Accounts:    repo,
+			Payments:    repo,
+			Statements:  repo,
+			Fees:        repo,
+			Actions:     repo,
+			Portfolios:  repo,
+			LatestOutboxEventID: func(ctx context.Context, aggregateID int64) (int64, error) {
+				var id int64
+				err := db.QueryRowContext(ctx,
+					"SELECT id FROM user_outbox_events WHERE aggregate_id = ? ORDER BY id DESC LIMIT 1",
+					aggregateID,
+				).Scan(&id)
+				return id, err
+			},
+			AddStock: func(t *testing.T, stock userentity.Stock) userentity.Stock {
+				res, err := db.Exec("INSERT INTO stocks (code, name, company_name, sector) VALUES (?, ?, ?, ?)", stock.Code, stock.Name, stock.CompanyName, stock.Sector)
+				if err != nil {
+					t.Fatalf("insert stock: %v", err)
+				}
+				if stock.ID, err = res.LastInsertId(); err != nil {
+					t.Fatalf("stock id: %v", err)
+				}
+				return stock
+			},
+			RecordStockPrice: func(t *testing.T, code string, price int64, at time.Time) {
+				if _, err := db.Exec(
+					"INSERT INTO stock_prices (stock_id, prices, created_at) SELECT id, ?, ? FROM stocks WHERE code = ?",
+					price, at, code,
+				); err != nil {
+					t.Fatalf("insert stock price: %v", err)
+				}
+			},
+			SkipConcurrency: serial,
+		}
+	})
+}
+
+// newConformanceCipher returns a field cipher whose current master key is
+// currentID; the keyring also holds every other test key so values sealed
+// under them stay readable.
+func newConformanceCipher(t *testing.T, currentID string) *security.FieldCipher {
+	t.Helper()
+	keys := map[string][]byte{
+		"k1": []byte("conformance-master-key-one-32byt"),
+		"k2": []byte("conformance-master-key-two-32byt"),
+	}
+	keyring, err := security.NewKeyring(currentID, keys, []byte("conformance-blind-index-key-32by"))
+	if err != nil {
+		t.Fatalf("new keyring: %v", err)
+	}
+	fields, err := security.NewFieldCipher(keyring)
+	if err != nil {
+		t.Fatalf("new field cipher: %v", err)
+	}
+	return fields
+}
+
+func openConformanceDB(t *testing.T, dsn string) *sql.DB {
+	t.Helper()
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatalf("parse %s: %v", mysqlConformanceDSNEnv, err)
+	}
+	cfg.ParseTime = true
+	cfg.Loc = time.UTC
+
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		t.Fatalf("open mysql: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		t.Fatalf("ping mysql: %v", err)
+	}
+	return db
+}
+
+func applyConformanceSchema(t *testing.T, db *sql.DB) {
+	t.Helper()
+	schema, err := os.ReadFile("schema_verification.sql")
+	if err != nil {
+		t.Fatalf("read schema: %v", err)
+	}
+	for _, stmt := range strings.Split(string(schema), ";") {
+		if strings.TrimSpace(stripSQLComments(stmt)) == "" {
+			continue
+		}
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("apply schema statement %q: %v", strings.TrimSpace(stmt), err)
+		}
+	}
+}
+
+func truncateConformanceTables(t *testing.T, db *sql.DB) {
+	t.Helper()
+	// Children first so foreign keys stay satisfied without toggling checks.
+	for _, table := range []string{"corporate_actions", "fee_schedules", "portfolio_snapshots", "statements", "payments", "settlements", "ledger_entries", "trading_accounts", "order_fills", "order_events", "orders", "news_terms", "news_stocks", "news", "price_alerts", "watchlist_stocks", "watchlists", "stock_events", "stock_prices", "stocks", "kyc_documents", "kyc_submissions", "user_logging", "user_event_details", "user_events", "user_data_exports", "user_outbox_events", "user_verification_tokens", "users"} {
+		if _, err := db.Exec("DELETE FROM " + table); err != nil {
+			t.Fatalf("clear %s: %v", table, err)
+		}
+	}
+}
+
+func stripSQLComments(stmt string) string {
+	lines := strings.Split(stmt, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "--") {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.Join(kept, "\n")
+}
@@ -0,0 +1,275 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.CorporateActionRepository = MysqlUserRepository{}
+
+const (
+	corporateActionColumns = `a.id, a.stock_id, s.code, a.kind, a.cash_per_share, a.old_shares, a.new_shares, a.ex_date, a.record_date,
+         a.status, a.price_numerator, a.price_denominator, a.created_by, a.created_at, a.applied_at`
+	corporateActionFrom = ` FROM corporate_actions a JOIN stocks s ON s.id = a.stock_id`
+)
+
+// stockEventData is the JSON stored in stock_events.changed_data when a
+// corporate action is applied.
+type stockEventData struct {
+	CorporateActionID int64  `json:"corporate_action_id"`
+	Kind              string `json:"kind"`
+	PriceNumerator    int64  `json:"price_numerator"`
+	PriceDenominator  int64  `json:"price_denominator"`
+}
+
+// CreateCorporateAction relies on uq_corporate_actions_ex_date to keep one
+// action of a kind per stock and ex-date.
+func (r MysqlUserRepository) CreateCorporateAction(ctx context.Context, action userentity.CorporateAction) (userentity.CorporateAction, error) {
+	if !validCorporateAction(action) {
+		return userentity.CorporateAction{}, ErrInvalidCorporateAction
+	}
+	stockID, err := stockIDByCode(ctx, r.db, action.Code)
+	if err != nil {
+		return userentity.CorporateAction{}, err
+	}
+
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO corporate_actions (stock_id, kind, cash_per_share, old_shares, new_shares, ex_date, record_date, status, created_by, created_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		stockID, string(action.Kind), action.CashPerShare, action.OldShares, action.NewShares,
+		settlementDate(action.ExDate), settlementDate(action.RecordDate), string(userentity.CorporateActionStatusScheduled),
+		action.CreatedBy, orNow(action.CreatedAt),
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1062 {
+			return userentity.CorporateAction{}, ErrCorporateActionExists
+		}
+		return userentity.CorporateAction{}, fmt.Errorf("insert corporate action: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return userentity.CorporateAction{}, fmt.Errorf("corporate action id: %w", err)
+	}
+	return r.GetCorporateAction(ctx, id)
+}
+
+func (r MysqlUserRepository) GetCorporateAction(ctx context.Context, actionID int64) (userentity.CorporateAction, error) {
+	return corporateAction(ctx, r.db, `WHERE a.id = ?`, actionID)
+}
+
+func (r MysqlUserRepository) ListCorporateActions(ctx context.Context, code string) ([]userentity.CorporateAction, error) {
+	return queryCorporateActions(ctx, r.db, `WHERE (? = '' OR s.code = ?) ORDER BY a.ex_date DESC, a.id DESC`, code, code)
+}
+
+func (r MysqlUserRepository) ListDueCorporateActions(ctx context.Context, through time.Time) ([]userentity.CorporateAction, error) {
+	return queryCorporateActions(ctx, r.db,
+		`WHERE a.status = ? AND a.ex_date <= ? ORDER BY a.ex_date, a.id`,
+		string(userentity.CorporateActionStatusScheduled), settlementDate(through),
+	)
+}
+
+func (r MysqlUserRepository) DeleteCorporateAction(ctx context.Context, actionID int64) error {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM corporate_actions WHERE id = ? AND status = ?`,
+		actionID, string(userentity.CorporateActionStatusScheduled),
+	)
+	if err != nil {
+		return fmt.Errorf("delete corporate action: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("delete corporate action: %w", err)
+	} else if n > 0 {
+		return nil
+	}
+	if _, err := r.GetCorporateAction(ctx, actionID); err != nil {
+		return err
+	}
+	return ErrCorporateActionApplied
+}
+
+// ListStockHolders counts the shares of settlements from the trade on and
+// leaves out the settlement entries they post, so shares bought before at
+// count once whenever they settle.
+func (r MysqlUserRepository) ListStockHolders(ctx context.Context, stockID int64, at time.Time) ([]ports.StockHolder, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT h.shares, `+userColumns("u")+`
+         FROM (
+             SELECT b.user_id, CAST(SUM(b.amount) AS SIGNED) AS shares
+             FROM (
+                 SELECT user_id, amount FROM ledger_entries
+                 WHERE stock_id = ? AND entry_type <> 'settlement' AND created_at < ?
+                 UNION ALL
+                 SELECT user_id, amount FROM settlements WHERE stock_id = ? AND created_at < ?
+             ) b
+             GROUP BY b.user_id
+         ) h JOIN users u ON u.id = h.user_id
+         WHERE h.shares > 0
+         ORDER BY u.id`,
+		stockID, at, stockID, at,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query stock holders: %w", err)
+	}
+	defer rows.Close()
+
+	holders := make([]ports.StockHolder, 0)
+	for rows.Next() {
+		var (
+			holder ports.StockHolder
+			ur     userRow
+		)
+		if err := rows.Scan(append([]any{&holder.Shares}, ur.dest()...)...); err != nil {
+			return nil, fmt.Errorf("scan stock holder: %w", err)
+		}
+		if holder.Owner, err = ur.user(r.fields); err != nil {
+			return nil, err
+		}
+		holders = append(holders, holder)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate stock holders: %w", err)
+	}
+	return holders, nil
+}
+
+// ApplyCorporateAction applies the action under its row lock, so of several
+// runs racing on the same action only one posts its entries. Applying an
+// action is recorded as a stock event.
+func (r MysqlUserRepository) ApplyCorporateAction(ctx context.Context, params ports.ApplyCorporateActionParams) (applied bool, err error) {
+	at := orNow(params.At)
+	if params.Adjustment.Numerator <= 0 || params.Adjustment.Denominator <= 0 {
+		return false, ErrInvalidCorporateAction
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil || !applied {
+			_ = tx.Rollback()
+		}
+	}()
+
+	action, err := corporateAction(ctx, tx, `WHERE a.id = ? FOR UPDATE`, params.ActionID)
+	if errors.Is(err, ErrCorporateActionNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if action.Status != userentity.CorporateActionStatusScheduled {
+		return false, nil
+	}
+	entries, ok := corporateActionEntries(action, params.Entries, at)
+	if !ok {
+		return false, ErrInvalidCorporateAction
+	}
+
+	if _, err = insertLedgerEntries(ctx, tx, corporateActionReference(action.ID), entries); err != nil {
+		return false, err
+	}
+	if !params.Adjustment.Identity() {
+		if _, err = tx.ExecContext(ctx,
+			`UPDATE stock_prices SET prices = (prices * ? + ?) DIV ? WHERE stock_id = ? AND created_at < ?`,
+			params.Adjustment.Numerator, params.Adjustment.Denominator/2, params.Adjustment.Denominator, action.StockID, params.PricesBefore,
+		); err != nil {
+			return false, fmt.Errorf("adjust stock prices: %w", err)
+		}
+	}
+	changed, err := json.Marshal(stockEventData{
+		CorporateActionID: action.ID,
+		Kind:              string(action.Kind),
+		PriceNumerator:    params.Adjustment.Numerator,
+		PriceDenominator:  params.Adjustment.Denominator,
+	})
+	if err != nil {
+		return false, fmt.Errorf("marshal stock event: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx,
+		`INSERT INTO stock_events (stock_id, changed_data, reason_changed, created_at) VALUES (?, ?, ?, ?)`,
+		action.StockID, string(changed), "corporate_action", at,
+	); err != nil {
+		return false, fmt.Errorf("insert stock event: %w", err)
+	}
+	for _, event := range params.Events {
+		if err = insertOutboxEvent(ctx, tx, event.AggregateID, event); err != nil {
+			return false, err
+		}
+	}
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE corporate_actions SET status = ?, price_numerator = ?, price_denominator = ?, applied_at = ? WHERE id = ?`,
+		string(userentity.CorporateActionStatusApplied), params.Adjustment.Numerator, params.Adjustment.Denominator, at, action.ID,
+	); err != nil {
+		return false, fmt.Errorf("apply corporate action: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit tx: %w", err)
+	}
+	return true, nil
+}
+
+// corporateAction returns the action selected by the clause that follows the
+// FROM of corporate_actions a joined to its stock s.
+func corporateAction(ctx context.Context, q queryer, clause string, args ...any) (userentity.CorporateAction, error) {
+	action, err := scanCorporateAction(q.QueryRowContext(ctx, `SELECT `+corporateActionColumns+corporateActionFrom+` `+clause, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return userentity.CorporateAction{}, ErrCorporateActionNotFound
+	}
+	return action, err
+}
+
+// queryCorporateActions returns the actions selected by the clause that
+// follows the FROM of corporate_actions a joined to its stock s.
+func queryCorporateActions(ctx context.Context, q queryer, clause string, args ...any) ([]userentity.CorporateAction, error) {
+	rows, err := q.QueryContext(ctx, `SELECT `+corporateActionColumns+corporateActionFrom+` `+clause, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query corporate actions: %w", err)
+	}
+	defer rows.Close()
+
+	actions := make([]userentity.CorporateAction, 0)
+	for rows.Next() {
+		action, err := scanCorporateAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate corporate actions: %w", err)
+	}
+	return actions, nil
+}
+
+func scanCorporateAction(row interface{ Scan(...any) error }) (userentity.CorporateAction, error) {
+	var (
+		action       userentity.CorporateAction
+		kind, status string
+		appliedAt    sql.NullTime
+	)
+	err := row.Scan(
+		&action.ID, &action.StockID, &action.Code, &kind, &action.CashPerShare, &action.OldShares, &action.NewShares,
+		&action.ExDate, &action.RecordDate, &status, &action.Adjustment.Numerator, &action.Adjustment.Denominator,
+		&action.CreatedBy, &action.CreatedAt, &appliedAt,
+	)
+	if err == sql.ErrNoRows {
+		return userentity.CorporateAction{}, err
+	}
+	if err != nil {
+		return userentity.CorporateAction{}, fmt.Errorf("scan corporate action: %w", err)
+	}
+	action.Kind = userentity.CorporateActionKind(kind)
+	action.Status = userentity.CorporateActionStatus(status)
+	action.AppliedAt = appliedAt.Time
+	return action, nil
+}
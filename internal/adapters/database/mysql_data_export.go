@@ -0,0 +1,345 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.DataExportRepository = MysqlUserRepository{}
+
+// dataExportColumns lists the user_data_exports columns scanned by
+// scanDataExport, in order.
+const dataExportColumns = `id, user_id, format, status, content, error, created_at, updated_at, completed_at, expires_at`
+
+// claimDataExportAttempts bounds how often ClaimDataExport retries when other
+// workers claim the candidate first.
+const claimDataExportAttempts = 5
+
+func scanDataExport(row interface{ Scan(...any) error }) (userentity.DataExport, error) {
+	var (
+		export      userentity.DataExport
+		format      string
+		status      string
+		content     []byte
+		errText     sql.NullString
+		completedAt sql.NullTime
+		expiresAt   sql.NullTime
+	)
+	if err := row.Scan(&export.ID, &export.UserID, &format, &status, &content, &errText,
+		&export.CreatedAt, &export.UpdatedAt, &completedAt, &expiresAt); err != nil {
+		return userentity.DataExport{}, err
+	}
+	export.Format = userentity.DataExportFormat(format)
+	export.Status = userentity.DataExportStatus(status)
+	export.Content = content
+	export.Error = errText.String
+	if completedAt.Valid {
+		export.CompletedAt = completedAt.Time
+	}
+	if expiresAt.Valid {
+		export.ExpiresAt = expiresAt.Time
+	}
+	return export, nil
+}
+
+// CreateDataExport inserts a pending export while holding the user row lock,
+// so concurrent requests of the same user cannot both pass the in-progress
+// check.
+func (r MysqlUserRepository) CreateDataExport(ctx context.Context, userID int64, format userentity.DataExportFormat, at time.Time) (userentity.DataExport, error) {
+	if !validDataExportFormat(format) {
+		return userentity.DataExport{}, ErrInvalidDataExport
+	}
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.DataExport{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var one int
+	if err = tx.QueryRowContext(ctx, "SELECT 1 FROM users WHERE id = ? FOR UPDATE", userID).Scan(&one); err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.DataExport{}, ErrUserNotFound
+		}
+		return userentity.DataExport{}, fmt.Errorf("lock user: %w", err)
+	}
+	var inProgress int
+	if err = tx.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM user_data_exports WHERE user_id = ? AND status IN ('pending','processing')",
+		userID,
+	).Scan(&inProgress); err != nil {
+		return userentity.DataExport{}, fmt.Errorf("count data exports: %w", err)
+	}
+	if inProgress > 0 {
+		err = ErrDataExportInProgress
+		return userentity.DataExport{}, err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO user_data_exports (user_id, format, status, created_at, updated_at)
+         VALUES (?, ?, 'pending', ?, ?)`,
+		userID, string(format), at, at,
+	)
+	if err != nil {
+		return userentity.DataExport{}, fmt.Errorf("insert data export: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return userentity.DataExport{}, fmt.Errorf("get data export id: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.DataExport{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return r.GetDataExport(ctx, userID, id)
+}
+
+// GetDataExport returns an export owned by the user.
+func (r MysqlUserRepository) GetDataExport(ctx context.Context, userID, exportID int64) (userentity.DataExport, error) {
+	export, err := scanDataExport(r.db.QueryRowContext(ctx,
+		`SELECT `+dataExportColumns+` FROM user_data_exports WHERE id = ? AND user_id = ?`,
+		exportID, userID,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.DataExport{}, ErrDataExportNotFound
+		}
+		return userentity.DataExport{}, fmt.Errorf("query data export: %w", err)
+	}
+	return export, nil
+}
+
+// ClaimDataExport picks the oldest claimable export and claims it with a
+// conditional update; when another worker wins the race the next candidate is
+// tried.
+func (r MysqlUserRepository) ClaimDataExport(ctx context.Context, at, staleBefore time.Time) (userentity.DataExport, error) {
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	for attempt := 0; attempt < claimDataExportAttempts; attempt++ {
+		var (
+			id        int64
+			userID    int64
+			status    string
+			updatedAt time.Time
+		)
+		err := r.db.QueryRowContext(ctx,
+			`SELECT id, user_id, status, updated_at FROM user_data_exports
+             WHERE status = 'pending' OR (status = 'processing' AND updated_at < ?)
+             ORDER BY id
+             LIMIT 1`,
+			staleBefore,
+		).Scan(&id, &userID, &status, &updatedAt)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return userentity.DataExport{}, ErrDataExportNotFound
+			}
+			return userentity.DataExport{}, fmt.Errorf("query claimable data export: %w", err)
+		}
+
+		res, err := r.db.ExecContext(ctx,
+			`UPDATE user_data_exports SET status = 'processing', updated_at = ?
+             WHERE id = ? AND status = ? AND updated_at = ?`,
+			at, id, status, updatedAt,
+		)
+		if err != nil {
+			return userentity.DataExport{}, fmt.Errorf("claim data export: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return userentity.DataExport{}, fmt.Errorf("claim data export rows affected: %w", err)
+		} else if n == 1 {
+			return r.GetDataExport(ctx, userID, id)
+		}
+	}
+	return userentity.DataExport{}, ErrDataExportNotFound
+}
+
+// FinishDataExport stores the content or error of a processing export.
+func (r MysqlUserRepository) FinishDataExport(ctx context.Context, params ports.FinishDataExportParams) error {
+	if params.Status != userentity.DataExportStatusReady && params.Status != userentity.DataExportStatusFailed {
+		return ErrInvalidDataExport
+	}
+	if params.At.IsZero() {
+		params.At = time.Now().UTC()
+	}
+	var (
+		content []byte
+		errText sql.NullString
+	)
+	if params.Status == userentity.DataExportStatusReady {
+		content = params.Content
+	} else {
+		errText = sql.NullString{String: params.Error, Valid: true}
+	}
+	var expiresAt sql.NullTime
+	if !params.ExpiresAt.IsZero() {
+		expiresAt = sql.NullTime{Time: params.ExpiresAt, Valid: true}
+	}
+
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE user_data_exports
+         SET status = ?, content = ?, error = ?, completed_at = ?, expires_at = ?, updated_at = ?
+         WHERE id = ? AND status = 'processing'`,
+		string(params.Status), content, errText, params.At, expiresAt, params.At, params.ExportID,
+	)
+	if err != nil {
+		return fmt.Errorf("finish data export: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("finish data export rows affected: %w", err)
+	}
+	if n == 0 {
+		var status string
+		err := r.db.QueryRowContext(ctx, "SELECT status FROM user_data_exports WHERE id = ?", params.ExportID).Scan(&status)
+		if err == sql.ErrNoRows {
+			return ErrDataExportNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("query data export status: %w", err)
+		}
+		return ErrDataExportNotProcessing
+	}
+	return nil
+}
+
+// DeleteExpiredDataExports removes finished exports past their expiry.
+func (r MysqlUserRepository) DeleteExpiredDataExports(ctx context.Context, before time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM user_data_exports
+         WHERE status IN ('ready','failed') AND expires_at IS NOT NULL AND expires_at <= ?`,
+		before,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired data exports: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("delete expired data exports rows affected: %w", err)
+	}
+	return n, nil
+}
+
+// GetPersonalData reads the user row, its verification tokens, outbox events,
+// login history and KYC submissions.
+func (r MysqlUserRepository) GetPersonalData(ctx context.Context, userID int64) (ports.PersonalData, error) {
+	var ur userRow
+	if err := r.db.QueryRowContext(ctx, `SELECT `+userColumns("")+` FROM users WHERE id = ?`, userID).Scan(ur.dest()...); err != nil {
+		if err == sql.ErrNoRows {
+			return ports.PersonalData{}, ErrUserNotFound
+		}
+		return ports.PersonalData{}, fmt.Errorf("query user: %w", err)
+	}
+	u, err := ur.user(r.fields)
+	if err != nil {
+		return ports.PersonalData{}, err
+	}
+	data := ports.PersonalData{User: u}
+
+	tokens, err := r.db.QueryContext(ctx,
+		`SELECT id, user_id, token, purpose, new_email, expires_at, consumed_at, created_at, updated_at
+         FROM user_verification_tokens WHERE user_id = ? ORDER BY id`,
+		userID,
+	)
+	if err != nil {
+		return ports.PersonalData{}, fmt.Errorf("query verification tokens: %w", err)
+	}
+	defer tokens.Close()
+	for tokens.Next() {
+		var (
+			vt         userentity.VerificationToken
+			purpose    string
+			newEmail   sql.NullString
+			consumedAt sql.NullTime
+		)
+		if err := tokens.Scan(&vt.ID, &vt.UserID, &vt.Token, &purpose, &newEmail, &vt.ExpiresAt, &consumedAt, &vt.CreatedAt, &vt.UpdatedAt); err != nil {
+			return ports.PersonalData{}, fmt.Errorf("scan verification token: %w", err)
+		}
+		vt.Purpose = userentity.VerificationPurpose(purpose)
+		vt.NewEmail = newEmail.String
+		if consumedAt.Valid {
+			vt.ConsumedAt = &consumedAt.Time
+		}
+		data.VerificationTokens = append(data.VerificationTokens, vt)
+	}
+	if err := tokens.Err(); err != nil {
+		return ports.PersonalData{}, fmt.Errorf("iterate verification tokens: %w", err)
+	}
+
+	events, err := r.db.QueryContext(ctx,
+		`SELECT id, aggregate_id, aggregate_type, event_type, payload, status, created_at, updated_at, processed_at
+         FROM user_outbox_events WHERE aggregate_id = ? ORDER BY id`,
+		userID,
+	)
+	if err != nil {
+		return ports.PersonalData{}, fmt.Errorf("query outbox events: %w", err)
+	}
+	defer events.Close()
+	for events.Next() {
+		var (
+			event       userentity.OutboxEvent
+			payload     []byte
+			status      string
+			processedAt sql.NullTime
+		)
+		if err := events.Scan(&event.ID, &event.AggregateID, &event.AggregateType, &event.EventType, &payload, &status, &event.CreatedAt, &event.UpdatedAt, &processedAt); err != nil {
+			return ports.PersonalData{}, fmt.Errorf("scan outbox event: %w", err)
+		}
+		event.Payload = payload
+		event.Status = userentity.OutboxEventStatus(status)
+		if processedAt.Valid {
+			event.ProcessedAt = &processedAt.Time
+		}
+		data.OutboxEvents = append(data.OutboxEvents, event)
+	}
+	if err := events.Err(); err != nil {
+		return ports.PersonalData{}, fmt.Errorf("iterate outbox events: %w", err)
+	}
+
+	logins, err := r.db.QueryContext(ctx,
+		`SELECT `+loginRecordColumns+` FROM user_logging WHERE user_id = ? ORDER BY id`,
+		userID,
+	)
+	if err != nil {
+		return ports.PersonalData{}, fmt.Errorf("query login history: %w", err)
+	}
+	defer logins.Close()
+	if data.LoginHistory, err = scanLoginRecords(logins); err != nil {
+		return ports.PersonalData{}, err
+	}
+
+	submissions, err := r.db.QueryContext(ctx,
+		`SELECT `+kycSubmissionColumns+` FROM kyc_submissions WHERE user_id = ? ORDER BY id`,
+		userID,
+	)
+	if err != nil {
+		return ports.PersonalData{}, fmt.Errorf("query kyc submissions: %w", err)
+	}
+	defer submissions.Close()
+	if data.KycSubmissions, err = r.scanKycSubmissions(submissions); err != nil {
+		return ports.PersonalData{}, err
+	}
+	documents, err := r.ListKycDocuments(ctx, userID)
+	if err != nil {
+		return ports.PersonalData{}, err
+	}
+	for i := range data.KycSubmissions {
+		for _, document := range documents {
+			if document.SubmissionID == data.KycSubmissions[i].ID {
+				data.KycSubmissions[i].Documents = append(data.KycSubmissions[i].Documents, document)
+			}
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.FeeScheduleRepository = MysqlUserRepository{}
+
+const feeScheduleColumns = `id, tier, buy_rate_bps, sell_rate_bps, min_fee, max_fee, sell_tax_bps, volume_tiers, effective_from, created_by, created_at`
+
+// feeVolumeTierData is an element of the JSON stored in
+// fee_schedules.volume_tiers.
+type feeVolumeTierData struct {
+	MinMonthlyValue int64 `json:"min_monthly_value"`
+	BuyRateBps      int64 `json:"buy_rate_bps"`
+	SellRateBps     int64 `json:"sell_rate_bps"`
+}
+
+// CreateFeeSchedule relies on uq_fee_schedules_effective to keep one
+// schedule per tier and effective time.
+func (r MysqlUserRepository) CreateFeeSchedule(ctx context.Context, schedule userentity.FeeSchedule) (userentity.FeeSchedule, error) {
+	if !validFeeSchedule(schedule) {
+		return userentity.FeeSchedule{}, ErrInvalidFeeSchedule
+	}
+	schedule.CreatedAt = orNow(schedule.CreatedAt)
+
+	var tiers sql.NullString
+	if len(schedule.VolumeTiers) > 0 {
+		data := make([]feeVolumeTierData, 0, len(schedule.VolumeTiers))
+		for _, tier := range schedule.VolumeTiers {
+			data = append(data, feeVolumeTierData(tier))
+		}
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return userentity.FeeSchedule{}, fmt.Errorf("encode volume tiers: %w", err)
+		}
+		tiers = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO fee_schedules (tier, buy_rate_bps, sell_rate_bps, min_fee, max_fee, sell_tax_bps, volume_tiers, effective_from, created_by, created_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		schedule.Tier, schedule.BuyRateBps, schedule.SellRateBps, schedule.MinFee, schedule.MaxFee, schedule.SellTaxBps,
+		tiers, schedule.EffectiveFrom, schedule.CreatedBy, schedule.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1062 {
+			return userentity.FeeSchedule{}, ErrFeeScheduleExists
+		}
+		return userentity.FeeSchedule{}, fmt.Errorf("insert fee schedule: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return userentity.FeeSchedule{}, fmt.Errorf("fee schedule id: %w", err)
+	}
+	return r.feeSchedule(ctx, `id = ?`, id)
+}
+
+func (r MysqlUserRepository) ListFeeSchedules(ctx context.Context, tier string) ([]userentity.FeeSchedule, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+feeScheduleColumns+` FROM fee_schedules WHERE (? = '' OR tier = ?) ORDER BY tier, effective_from`,
+		tier, tier,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query fee schedules: %w", err)
+	}
+	defer rows.Close()
+
+	schedules := make([]userentity.FeeSchedule, 0)
+	for rows.Next() {
+		schedule, err := scanFeeSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate fee schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+func (r MysqlUserRepository) FeeScheduleAt(ctx context.Context, tier string, at time.Time) (userentity.FeeSchedule, error) {
+	return r.feeSchedule(ctx, `tier = ? AND effective_from <= ? ORDER BY effective_from DESC LIMIT 1`, tier, at)
+}
+
+func (r MysqlUserRepository) DeleteFeeSchedule(ctx context.Context, scheduleID int64, at time.Time) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM fee_schedules WHERE id = ? AND effective_from > ?`, scheduleID, at)
+	if err != nil {
+		return fmt.Errorf("delete fee schedule: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("delete fee schedule: %w", err)
+	} else if n > 0 {
+		return nil
+	}
+	if _, err := r.feeSchedule(ctx, `id = ?`, scheduleID); err != nil {
+		return err
+	}
+	return ErrFeeScheduleInEffect
+}
+
+// feeSchedule returns the first schedule matching where.
+func (r MysqlUserRepository) feeSchedule(ctx context.Context, where string, args ...any) (userentity.FeeSchedule, error) {
+	schedule, err := scanFeeSchedule(r.db.QueryRowContext(ctx, `SELECT `+feeScheduleColumns+` FROM fee_schedules WHERE `+where, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return userentity.FeeSchedule{}, ErrFeeScheduleNotFound
+	}
+	return schedule, err
+}
+
+func scanFeeSchedule(row interface{ Scan(...any) error }) (userentity.FeeSchedule, error) {
+	var (
+		schedule userentity.FeeSchedule
+		tiers    []byte
+	)
+	err := row.Scan(
+		&schedule.ID, &schedule.Tier, &schedule.BuyRateBps, &schedule.SellRateBps, &schedule.MinFee, &schedule.MaxFee,
+		&schedule.SellTaxBps, &tiers, &schedule.EffectiveFrom, &schedule.CreatedBy, &schedule.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return userentity.FeeSchedule{}, err
+	}
+	if err != nil {
+		return userentity.FeeSchedule{}, fmt.Errorf("scan fee schedule: %w", err)
+	}
+	if len(tiers) > 0 {
+		var data []feeVolumeTierData
+		if err := json.Unmarshal(tiers, &data); err != nil {
+			return userentity.FeeSchedule{}, fmt.Errorf("decode volume tiers: %w", err)
+		}
+		for _, tier := range data {
+			schedule.VolumeTiers = append(schedule.VolumeTiers, userentity.FeeVolumeTier(tier))
+		}
+	}
+	return schedule, nil
+}
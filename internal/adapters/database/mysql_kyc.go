@@ -0,0 +1,395 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.KycRepository = MysqlUserRepository{}
+
+const (
+	kycDocumentColumns   = `id, user_id, submission_id, kind, blob_key, content_type, size_bytes, created_at`
+	kycSubmissionColumns = `id, user_id, document_type, document_number, full_name, issued_on, expires_on, status,
+        rejection_reason, reviewer_id, reviewed_at, created_at, updated_at`
+)
+
+// queryer is implemented by both *sql.DB and *sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// CreateKycDocument stores an unsubmitted document image.
+func (r MysqlUserRepository) CreateKycDocument(ctx context.Context, document userentity.KycDocument) (userentity.KycDocument, error) {
+	if !validKycDocument(document) {
+		return userentity.KycDocument{}, ErrInvalidKycDocument
+	}
+	if document.CreatedAt.IsZero() {
+		document.CreatedAt = time.Now().UTC()
+	}
+	document.SubmissionID = 0
+
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO kyc_documents (user_id, kind, blob_key, content_type, size_bytes, created_at)
+         VALUES (?, ?, ?, ?, ?, ?)`,
+		document.UserID, string(document.Kind), document.BlobKey, document.ContentType, document.SizeBytes, document.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1452 {
+			return userentity.KycDocument{}, ErrUserNotFound
+		}
+		return userentity.KycDocument{}, fmt.Errorf("insert kyc document: %w", err)
+	}
+	if document.ID, err = res.LastInsertId(); err != nil {
+		return userentity.KycDocument{}, fmt.Errorf("kyc document id: %w", err)
+	}
+	return document, nil
+}
+
+func (r MysqlUserRepository) GetKycDocument(ctx context.Context, documentID int64) (userentity.KycDocument, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+kycDocumentColumns+` FROM kyc_documents WHERE id = ?`, documentID)
+	if err != nil {
+		return userentity.KycDocument{}, fmt.Errorf("query kyc document: %w", err)
+	}
+	defer rows.Close()
+	documents, err := scanKycDocuments(rows)
+	if err != nil {
+		return userentity.KycDocument{}, err
+	}
+	if len(documents) == 0 {
+		return userentity.KycDocument{}, ErrKycDocumentNotFound
+	}
+	return documents[0], nil
+}
+
+func (r MysqlUserRepository) ListKycDocuments(ctx context.Context, userID int64) ([]userentity.KycDocument, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+kycDocumentColumns+` FROM kyc_documents WHERE user_id = ? ORDER BY id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query kyc documents: %w", err)
+	}
+	defer rows.Close()
+	return scanKycDocuments(rows)
+}
+
+// SubmitKyc stores a pending submission under the user row lock, so two
+// submissions of the same user cannot both pass the status check.
+func (r MysqlUserRepository) SubmitKyc(ctx context.Context, params ports.SubmitKycParams) (userentity.KycSubmission, error) {
+	submission, err := normalizeKycSubmission(params)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+	if submission.CreatedAt.IsZero() {
+		submission.CreatedAt = time.Now().UTC()
+	}
+	documentNumber, err := r.fields.Encrypt(columnKycDocumentNumber, submission.DocumentNumber)
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("encrypt %s: %w", columnKycDocumentNumber, err)
+	}
+	documentIDs := uniqueIDs(params.DocumentIDs)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var kycStatus string
+	err = tx.QueryRowContext(ctx, "SELECT kyc_status FROM users WHERE id = ? FOR UPDATE", submission.UserID).Scan(&kycStatus)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrUserNotFound
+			return userentity.KycSubmission{}, err
+		}
+		return userentity.KycSubmission{}, fmt.Errorf("lock user: %w", err)
+	}
+	if !kycSubmittable(userentity.KycStatus(kycStatus)) {
+		err = ErrKycStatusConflict
+		return userentity.KycSubmission{}, err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO kyc_submissions (user_id, document_type, document_number, full_name, issued_on, expires_on, status, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?, ?, 'pending', ?, ?)`,
+		submission.UserID, string(submission.DocumentType), documentNumber, submission.FullName,
+		nullDate(submission.IssuedOn), nullDate(submission.ExpiresOn), submission.CreatedAt, submission.CreatedAt,
+	)
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("insert kyc submission: %w", err)
+	}
+	if submission.ID, err = res.LastInsertId(); err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("kyc submission id: %w", err)
+	}
+
+	args := []any{submission.ID, submission.UserID}
+	for _, id := range documentIDs {
+		args = append(args, id)
+	}
+	res, err = tx.ExecContext(ctx,
+		`UPDATE kyc_documents SET submission_id = ?
+         WHERE user_id = ? AND submission_id IS NULL AND id IN (?`+strings.Repeat(", ?", len(documentIDs)-1)+`)`,
+		args...,
+	)
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("attach kyc documents: %w", err)
+	}
+	attached, err := res.RowsAffected()
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("attach kyc documents rows affected: %w", err)
+	}
+	if attached != int64(len(documentIDs)) {
+		err = ErrKycDocumentNotFound
+		return userentity.KycSubmission{}, err
+	}
+
+	if _, err = tx.ExecContext(ctx,
+		"UPDATE users SET kyc_status = 'pending', updated_at = ? WHERE id = ?",
+		submission.CreatedAt, submission.UserID,
+	); err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("update kyc status: %w", err)
+	}
+	if err = insertOutboxEvent(ctx, tx, submission.UserID, params.OutboxEvent); err != nil {
+		return userentity.KycSubmission{}, err
+	}
+
+	stored, err := r.loadKycSubmission(ctx, tx, `WHERE id = ?`, submission.ID)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return stored, nil
+}
+
+func (r MysqlUserRepository) GetKycSubmission(ctx context.Context, submissionID int64) (userentity.KycSubmission, userentity.User, error) {
+	submission, err := r.loadKycSubmission(ctx, r.db, `WHERE id = ?`, submissionID)
+	if err != nil {
+		return userentity.KycSubmission{}, userentity.User{}, err
+	}
+	var ur userRow
+	if err := r.db.QueryRowContext(ctx, `SELECT `+userColumns("")+` FROM users WHERE id = ?`, submission.UserID).Scan(ur.dest()...); err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.KycSubmission{}, userentity.User{}, ErrUserNotFound
+		}
+		return userentity.KycSubmission{}, userentity.User{}, fmt.Errorf("query user: %w", err)
+	}
+	u, err := ur.user(r.fields)
+	if err != nil {
+		return userentity.KycSubmission{}, userentity.User{}, err
+	}
+	return submission, u, nil
+}
+
+func (r MysqlUserRepository) GetLatestKycSubmission(ctx context.Context, userID int64) (userentity.KycSubmission, error) {
+	return r.loadKycSubmission(ctx, r.db, `WHERE user_id = ? ORDER BY id DESC LIMIT 1`, userID)
+}
+
+func (r MysqlUserRepository) ListKycSubmissions(ctx context.Context, params ports.ListKycSubmissionsParams) ([]userentity.KycSubmission, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if params.Status != "" {
+		conds = append(conds, "status = ?")
+		args = append(args, string(params.Status))
+	}
+	if params.AfterID > 0 {
+		conds = append(conds, "id > ?")
+		args = append(args, params.AfterID)
+	}
+	args = append(args, kycSubmissionsLimit(params.Limit))
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+kycSubmissionColumns+` FROM kyc_submissions`+whereClause(conds)+` ORDER BY id ASC LIMIT ?`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list kyc submissions: %w", err)
+	}
+	defer rows.Close()
+	return r.scanKycSubmissions(rows)
+}
+
+// ReviewKycSubmission records the decision under the submission row lock so
+// concurrent reviews of the same submission cannot both succeed.
+func (r MysqlUserRepository) ReviewKycSubmission(ctx context.Context, params ports.ReviewKycParams) (userentity.KycSubmission, error) {
+	params, err := normalizeKycReview(params)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var (
+		userID int64
+		status string
+	)
+	err = tx.QueryRowContext(ctx, "SELECT user_id, status FROM kyc_submissions WHERE id = ? FOR UPDATE", params.SubmissionID).Scan(&userID, &status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrKycSubmissionNotFound
+			return userentity.KycSubmission{}, err
+		}
+		return userentity.KycSubmission{}, fmt.Errorf("lock kyc submission: %w", err)
+	}
+	if userentity.KycStatus(status) != userentity.KycStatusPending {
+		err = ErrKycStatusConflict
+		return userentity.KycSubmission{}, err
+	}
+
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE kyc_submissions
+         SET status = ?, rejection_reason = ?, reviewer_id = ?, reviewed_at = ?, updated_at = ?
+         WHERE id = ?`,
+		string(params.Status), params.Reason, params.ReviewerID, params.At, params.At, params.SubmissionID,
+	); err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("update kyc submission: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx,
+		"UPDATE users SET kyc_status = ?, updated_at = ? WHERE id = ?",
+		string(params.Status), params.At, userID,
+	); err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("update kyc status: %w", err)
+	}
+	if err = insertOutboxEvent(ctx, tx, userID, params.OutboxEvent); err != nil {
+		return userentity.KycSubmission{}, err
+	}
+
+	reviewed, err := r.loadKycSubmission(ctx, tx, `WHERE id = ?`, params.SubmissionID)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return reviewed, nil
+}
+
+// loadKycSubmission reads the first submission matching where, with its
+// documents.
+func (r MysqlUserRepository) loadKycSubmission(ctx context.Context, q queryer, where string, args ...any) (userentity.KycSubmission, error) {
+	rows, err := q.QueryContext(ctx, `SELECT `+kycSubmissionColumns+` FROM kyc_submissions `+where, args...)
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("query kyc submission: %w", err)
+	}
+	submissions, err := r.scanKycSubmissions(rows)
+	rows.Close()
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+	if len(submissions) == 0 {
+		return userentity.KycSubmission{}, ErrKycSubmissionNotFound
+	}
+	submission := submissions[0]
+
+	documents, err := q.QueryContext(ctx, `SELECT `+kycDocumentColumns+` FROM kyc_documents WHERE submission_id = ? ORDER BY id`, submission.ID)
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("query kyc documents: %w", err)
+	}
+	defer documents.Close()
+	if submission.Documents, err = scanKycDocuments(documents); err != nil {
+		return userentity.KycSubmission{}, err
+	}
+	return submission, nil
+}
+
+func (r MysqlUserRepository) scanKycSubmissions(rows *sql.Rows) ([]userentity.KycSubmission, error) {
+	submissions := make([]userentity.KycSubmission, 0)
+	for rows.Next() {
+		var (
+			s                     userentity.KycSubmission
+			documentType, status  string
+			issuedOn, expiresOn   sql.NullTime
+			reviewerID            sql.NullInt64
+			reviewedAt, createdAt sql.NullTime
+			updatedAt             sql.NullTime
+		)
+		if err := rows.Scan(&s.ID, &s.UserID, &documentType, &s.DocumentNumber, &s.FullName, &issuedOn, &expiresOn, &status,
+			&s.RejectionReason, &reviewerID, &reviewedAt, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("scan kyc submission: %w", err)
+		}
+		number, err := r.fields.Decrypt(columnKycDocumentNumber, s.DocumentNumber)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt kyc submission %d: %w", s.ID, err)
+		}
+		s.DocumentNumber = number
+		s.DocumentType = userentity.KycDocumentType(documentType)
+		s.Status = userentity.KycStatus(status)
+		s.IssuedOn = issuedOn.Time
+		s.ExpiresOn = expiresOn.Time
+		s.ReviewerID = reviewerID.Int64
+		s.ReviewedAt = reviewedAt.Time
+		s.CreatedAt = createdAt.Time
+		s.UpdatedAt = updatedAt.Time
+		submissions = append(submissions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate kyc submissions: %w", err)
+	}
+	return submissions, nil
+}
+
+func scanKycDocuments(rows *sql.Rows) ([]userentity.KycDocument, error) {
+	documents := make([]userentity.KycDocument, 0)
+	for rows.Next() {
+		var (
+			d            userentity.KycDocument
+			submissionID sql.NullInt64
+			kind         string
+			createdAt    sql.NullTime
+		)
+		if err := rows.Scan(&d.ID, &d.UserID, &submissionID, &kind, &d.BlobKey, &d.ContentType, &d.SizeBytes, &createdAt); err != nil {
+			return nil, fmt.Errorf("scan kyc document: %w", err)
+		}
+		d.SubmissionID = submissionID.Int64
+		d.Kind = userentity.KycDocumentKind(kind)
+		d.CreatedAt = createdAt.Time
+		documents = append(documents, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate kyc documents: %w", err)
+	}
+	return documents, nil
+}
+
+// nullDate stores the date of t, or NULL when t is zero.
+func nullDate(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: t.UTC().Format(time.DateOnly), Valid: true}
+}
+
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,160 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.LoginHistoryRepository = MysqlUserRepository{}
+
+const loginRecordColumns = `id, user_id, type, ip, user_agent, device_fingerprint, new_device, created_at`
+
+// RecordLogin stores a login and, from a new device, its outbox event. The
+// user row is locked so concurrent logins compare against each other.
+func (r MysqlUserRepository) RecordLogin(ctx context.Context, params ports.RecordLoginParams) (userentity.LoginRecord, error) {
+	record, err := normalizeLoginRecord(params.Record, userentity.LoginRecordTypeLogin)
+	if err != nil {
+		return userentity.LoginRecord{}, err
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now().UTC()
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.LoginRecord{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var userID int64
+	err = tx.QueryRowContext(ctx, "SELECT id FROM users WHERE id = ? FOR UPDATE", record.UserID).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrUserNotFound
+			return userentity.LoginRecord{}, err
+		}
+		return userentity.LoginRecord{}, fmt.Errorf("lock user: %w", err)
+	}
+
+	var previous, sameDevice, sameIP int64
+	err = tx.QueryRowContext(ctx,
+		`SELECT COUNT(*),
+                COALESCE(SUM(CASE WHEN device_fingerprint = ? THEN 1 ELSE 0 END), 0),
+                COALESCE(SUM(CASE WHEN ip = ? THEN 1 ELSE 0 END), 0)
+         FROM user_logging WHERE user_id = ? AND type = 'login'`,
+		record.DeviceFingerprint, record.IP, userID,
+	).Scan(&previous, &sameDevice, &sameIP)
+	if err != nil {
+		return userentity.LoginRecord{}, fmt.Errorf("query previous logins: %w", err)
+	}
+	record.NewDevice = previous > 0 && (sameDevice == 0 || sameIP == 0)
+
+	if record.ID, err = insertLoginRecord(ctx, tx, record); err != nil {
+		return userentity.LoginRecord{}, err
+	}
+	if record.NewDevice {
+		if err = insertOutboxEvent(ctx, tx, userID, params.NewLoginEvent); err != nil {
+			return userentity.LoginRecord{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return userentity.LoginRecord{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return record, nil
+}
+
+// RecordLogout stores a logout record.
+func (r MysqlUserRepository) RecordLogout(ctx context.Context, record userentity.LoginRecord) error {
+	record, err := normalizeLoginRecord(record, userentity.LoginRecordTypeLogout)
+	if err != nil {
+		return err
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now().UTC()
+	}
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO user_logging (user_id, type, ip, user_agent, device_fingerprint, new_device, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.UserID, string(record.Type), record.IP, record.UserAgent, record.DeviceFingerprint, record.NewDevice,
+		record.CreatedAt, record.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1452 {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("insert login record: %w", err)
+	}
+	return nil
+}
+
+// insertLoginRecord writes a login record within tx and returns its id.
+func insertLoginRecord(ctx context.Context, tx *sql.Tx, record userentity.LoginRecord) (int64, error) {
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO user_logging (user_id, type, ip, user_agent, device_fingerprint, new_device, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.UserID, string(record.Type), record.IP, record.UserAgent, record.DeviceFingerprint, record.NewDevice,
+		record.CreatedAt, record.CreatedAt,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("insert login record: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("login record id: %w", err)
+	}
+	return id, nil
+}
+
+// ListLoginHistory returns records of the user, newest first.
+func (r MysqlUserRepository) ListLoginHistory(ctx context.Context, params ports.ListLoginHistoryParams) ([]userentity.LoginRecord, error) {
+	conds := []string{"user_id = ?"}
+	args := []any{params.UserID}
+	if params.BeforeID > 0 {
+		conds = append(conds, "id < ?")
+		args = append(args, params.BeforeID)
+	}
+	args = append(args, loginHistoryLimit(params.Limit))
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+loginRecordColumns+` FROM user_logging`+whereClause(conds)+` ORDER BY id DESC LIMIT ?`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query login history: %w", err)
+	}
+	defer rows.Close()
+	return scanLoginRecords(rows)
+}
+
+func scanLoginRecords(rows *sql.Rows) ([]userentity.LoginRecord, error) {
+	records := make([]userentity.LoginRecord, 0)
+	for rows.Next() {
+		var (
+			record     userentity.LoginRecord
+			recordType string
+		)
+		if err := rows.Scan(&record.ID, &record.UserID, &recordType, &record.IP, &record.UserAgent,
+			&record.DeviceFingerprint, &record.NewDevice, &record.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan login record: %w", err)
+		}
+		record.Type = userentity.LoginRecordType(recordType)
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate login history: %w", err)
+	}
+	return records, nil
+}
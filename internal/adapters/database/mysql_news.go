@@ -0,0 +1,339 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.NewsRepository = MysqlUserRepository{}
+
+// The news table predates this code; catalog holds the category and sumary
+// the summary.
+const newsColumns = `n.id, n.external_id, n.metadata, n.catalog, n.title, n.sumary, n.content, n.status,
+        n.published_at, n.created_at, n.updated_at`
+
+// newsMetadata is the JSON stored in news.metadata.
+type newsMetadata struct {
+	Source string `json:"source,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+// CreateNews stores the item with its related stocks and search words in one
+// transaction; uq_news_external_id rejects a repeated external id.
+func (r MysqlUserRepository) CreateNews(ctx context.Context, news userentity.News) (created userentity.News, err error) {
+	if !validNews(news) {
+		return userentity.News{}, ErrInvalidNews
+	}
+	if news.Status == "" {
+		news.Status = userentity.NewsStatusPublished
+	}
+	news.CreatedAt = orNow(news.CreatedAt)
+	metadata, err := json.Marshal(newsMetadata{Source: news.Source, URL: news.URL})
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("encode news metadata: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO news (external_id, metadata, catalog, title, sumary, content, status, published_at, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		sql.NullString{String: news.ExternalID, Valid: news.ExternalID != ""}, string(metadata), string(news.Category),
+		news.Title, news.Summary, news.Content, string(news.Status), news.PublishedAt, news.CreatedAt, news.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1062 {
+			return userentity.News{}, ErrNewsExternalIDTaken
+		}
+		return userentity.News{}, fmt.Errorf("insert news: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("news id: %w", err)
+	}
+	news.ID = id
+	if err = writeNewsLinks(ctx, tx, news); err != nil {
+		return userentity.News{}, err
+	}
+	created, err = r.loadNews(ctx, tx, `n.id = ?`, id)
+	if err != nil {
+		return userentity.News{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.News{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return created, nil
+}
+
+func (r MysqlUserRepository) GetNews(ctx context.Context, newsID int64) (userentity.News, error) {
+	return r.loadNews(ctx, r.db, `n.id = ?`, newsID)
+}
+
+func (r MysqlUserRepository) GetNewsByExternalID(ctx context.Context, externalID string) (userentity.News, error) {
+	if externalID == "" {
+		return userentity.News{}, ErrNewsNotFound
+	}
+	return r.loadNews(ctx, r.db, `n.external_id = ?`, externalID)
+}
+
+// UpdateNews rewrites the item, its related stocks and its search words under
+// the item's row lock.
+func (r MysqlUserRepository) UpdateNews(ctx context.Context, news userentity.News) (updated userentity.News, err error) {
+	if !validNews(news) {
+		return userentity.News{}, ErrInvalidNews
+	}
+	metadata, err := json.Marshal(newsMetadata{Source: news.Source, URL: news.URL})
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("encode news metadata: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = lockNews(ctx, tx, news.ID); err != nil {
+		return userentity.News{}, err
+	}
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE news SET metadata = ?, catalog = ?, title = ?, sumary = ?, content = ?, published_at = ?, updated_at = ?
+         WHERE id = ?`,
+		string(metadata), string(news.Category), news.Title, news.Summary, news.Content, news.PublishedAt,
+		orNow(news.UpdatedAt), news.ID,
+	); err != nil {
+		return userentity.News{}, fmt.Errorf("update news: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM news_stocks WHERE news_id = ?`, news.ID); err != nil {
+		return userentity.News{}, fmt.Errorf("delete news stocks: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM news_terms WHERE news_id = ?`, news.ID); err != nil {
+		return userentity.News{}, fmt.Errorf("delete news terms: %w", err)
+	}
+	if err = writeNewsLinks(ctx, tx, news); err != nil {
+		return userentity.News{}, err
+	}
+	updated, err = r.loadNews(ctx, tx, `n.id = ?`, news.ID)
+	if err != nil {
+		return userentity.News{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.News{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return updated, nil
+}
+
+func (r MysqlUserRepository) SetNewsStatus(ctx context.Context, newsID int64, status userentity.NewsStatus, at time.Time) (userentity.News, error) {
+	if status != userentity.NewsStatusPublished && status != userentity.NewsStatusUnpublished {
+		return userentity.News{}, ErrInvalidNews
+	}
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE news SET status = ?, updated_at = ? WHERE id = ?`, string(status), orNow(at), newsID,
+	); err != nil {
+		return userentity.News{}, fmt.Errorf("update news status: %w", err)
+	}
+	// Setting the current status affects no rows, so existence is checked by
+	// reading the item back.
+	return r.loadNews(ctx, r.db, `n.id = ?`, newsID)
+}
+
+// ListNews filters on the related stock and each search word with EXISTS
+// subqueries; a search word matches the indexed words it starts.
+func (r MysqlUserRepository) ListNews(ctx context.Context, params ports.ListNewsParams) ([]userentity.News, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if params.Status != "" {
+		where = append(where, `n.status = ?`)
+		args = append(args, string(params.Status))
+	}
+	if params.Category != "" {
+		where = append(where, `n.catalog = ?`)
+		args = append(args, string(params.Category))
+	}
+	if params.Code != "" {
+		where = append(where, `EXISTS (SELECT 1 FROM news_stocks ns JOIN stocks s ON s.id = ns.stock_id
+             WHERE ns.news_id = n.id AND s.code = ?)`)
+		args = append(args, params.Code)
+	}
+	for _, term := range newsTerms(params.Query) {
+		// Terms are letters and digits only, so they need no LIKE escaping.
+		where = append(where, `EXISTS (SELECT 1 FROM news_terms t WHERE t.news_id = n.id AND t.term LIKE ?)`)
+		args = append(args, term+"%")
+	}
+	if params.BeforeID > 0 {
+		where = append(where, `(n.published_at < ? OR (n.published_at = ? AND n.id < ?))`)
+		args = append(args, params.BeforePublishedAt, params.BeforePublishedAt, params.BeforeID)
+	}
+	query := `SELECT ` + newsColumns + ` FROM news n`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, ` AND `)
+	}
+	query += ` ORDER BY n.published_at DESC, n.id DESC LIMIT ?`
+	args = append(args, newsListLimit(params.Limit))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query news: %w", err)
+	}
+	items, err := scanNews(rows)
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	if err := loadNewsCodes(ctx, r.db, items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func lockNews(ctx context.Context, tx *sql.Tx, newsID int64) error {
+	var id int64
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM news WHERE id = ? FOR UPDATE`, newsID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNewsNotFound
+		}
+		return fmt.Errorf("lock news: %w", err)
+	}
+	return nil
+}
+
+// writeNewsLinks inserts the related stocks and the search words of an item
+// that has none. Words are inserted with IGNORE because the column collation
+// may compare two distinct words as equal.
+func writeNewsLinks(ctx context.Context, tx *sql.Tx, news userentity.News) error {
+	for _, code := range newsCodes(news.Codes) {
+		stockID, err := stockIDByCode(ctx, tx, code)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO news_stocks (news_id, stock_id) VALUES (?, ?)`, news.ID, stockID); err != nil {
+			return fmt.Errorf("insert news stock: %w", err)
+		}
+	}
+	for _, term := range newsDocumentTerms(news) {
+		if _, err := tx.ExecContext(ctx, `INSERT IGNORE INTO news_terms (news_id, term) VALUES (?, ?)`, news.ID, term); err != nil {
+			return fmt.Errorf("insert news term: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r MysqlUserRepository) loadNews(ctx context.Context, q queryer, cond string, arg any) (userentity.News, error) {
+	rows, err := q.QueryContext(ctx, `SELECT `+newsColumns+` FROM news n WHERE `+cond, arg)
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("query news: %w", err)
+	}
+	items, err := scanNews(rows)
+	rows.Close()
+	if err != nil {
+		return userentity.News{}, err
+	}
+	if len(items) == 0 {
+		return userentity.News{}, ErrNewsNotFound
+	}
+	if err := loadNewsCodes(ctx, q, items); err != nil {
+		return userentity.News{}, err
+	}
+	return items[0], nil
+}
+
+// loadNewsCodes fills in the related codes of items.
+func loadNewsCodes(ctx context.Context, q queryer, items []userentity.News) error {
+	if len(items) == 0 {
+		return nil
+	}
+	placeholders := make([]string, len(items))
+	args := make([]any, len(items))
+	index := make(map[int64]int, len(items))
+	for i, news := range items {
+		placeholders[i] = "?"
+		args[i] = news.ID
+		index[news.ID] = i
+	}
+	rows, err := q.QueryContext(ctx,
+		`SELECT ns.news_id, s.code FROM news_stocks ns JOIN stocks s ON s.id = ns.stock_id
+         WHERE ns.news_id IN (`+strings.Join(placeholders, ", ")+`)
+         ORDER BY ns.news_id, s.code`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("query news stocks: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			newsID int64
+			code   string
+		)
+		if err := rows.Scan(&newsID, &code); err != nil {
+			return fmt.Errorf("scan news stock: %w", err)
+		}
+		i := index[newsID]
+		items[i].Codes = append(items[i].Codes, code)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate news stocks: %w", err)
+	}
+	return nil
+}
+
+// scanNews reads news rows with empty code lists.
+func scanNews(rows *sql.Rows) ([]userentity.News, error) {
+	items := make([]userentity.News, 0)
+	for rows.Next() {
+		var (
+			news       = userentity.News{Codes: []string{}}
+			externalID sql.NullString
+			metadata   []byte
+			category   string
+			summary    sql.NullString
+			content    sql.NullString
+			status     string
+		)
+		if err := rows.Scan(&news.ID, &externalID, &metadata, &category, &news.Title, &summary, &content, &status,
+			&news.PublishedAt, &news.CreatedAt, &news.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan news: %w", err)
+		}
+		if len(metadata) > 0 {
+			var meta newsMetadata
+			if err := json.Unmarshal(metadata, &meta); err != nil {
+				return nil, fmt.Errorf("decode news metadata: %w", err)
+			}
+			news.Source, news.URL = meta.Source, meta.URL
+		}
+		news.ExternalID = externalID.String
+		news.Category = userentity.NewsCategory(category)
+		news.Summary = summary.String
+		news.Content = content.String
+		news.Status = userentity.NewsStatus(status)
+		items = append(items, news)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate news: %w", err)
+	}
+	return items, nil
+}
@@ -0,0 +1,853 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.OrderRepository = MysqlUserRepository{}
+
+const (
+	orderColumns = `o.id, o.user_id, o.stock_id, s.code, o.side, o.time_in_force, o.price, o.quantity,
+        o.filled_quantity, o.filled_value, o.fees, o.taxes, o.status, o.reason, o.priority_at, o.created_at, o.updated_at`
+	orderFrom = ` FROM orders o JOIN stocks s ON s.id = o.stock_id`
+	// orderFillColumns are the columns of an order fill f joined to its
+	// order.
+	orderFillColumns = `f.id, f.order_id, f.execution_id, f.price, f.quantity, f.fee, f.tax, f.created_at`
+	// orderOpenStatuses is the SQL list of the statuses of open orders.
+	orderOpenStatuses = `('new','partially_filled')`
+)
+
+func (r MysqlUserRepository) CreateOrder(ctx context.Context, order userentity.Order, check ports.OrderCheck) (created userentity.Order, err error) {
+	if !validOrder(order) {
+		return userentity.Order{}, ErrInvalidOrder
+	}
+	order.CreatedAt = orNow(order.CreatedAt)
+
+	tx, err := r.db.BeginTx(ctx, checkTxOptions)
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	stockID, err := stockIDByCode(ctx, tx, order.Code)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if err = checkOrder(ctx, tx, order, check); err != nil {
+		return userentity.Order{}, err
+	}
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO orders (user_id, stock_id, side, time_in_force, price, quantity, status, reason, priority_at, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?, '', ?, ?, ?)`,
+		order.UserID, stockID, string(order.Side), string(order.TimeInForce), order.Price, order.Quantity,
+		string(userentity.OrderStatusNew), order.CreatedAt, order.CreatedAt, order.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1452 {
+			return userentity.Order{}, ErrUserNotFound
+		}
+		return userentity.Order{}, fmt.Errorf("insert order: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("order id: %w", err)
+	}
+	created, err = loadOrder(ctx, tx, id)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if err = insertOrderEvent(ctx, tx, created, userentity.OrderEventPlaced, orderEventData{Price: created.Price, Quantity: created.Quantity}, created.CreatedAt); err != nil {
+		return userentity.Order{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.Order{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return created, nil
+}
+
+func (r MysqlUserRepository) GetOrder(ctx context.Context, orderID int64) (userentity.Order, error) {
+	return loadOrder(ctx, r.db, orderID)
+}
+
+func (r MysqlUserRepository) ListOrders(ctx context.Context, params ports.ListOrdersParams) ([]userentity.Order, error) {
+	where := []string{"o.user_id = ?"}
+	args := []any{params.UserID}
+	if len(params.Statuses) > 0 {
+		marks := make([]string, 0, len(params.Statuses))
+		for _, status := range params.Statuses {
+			marks = append(marks, "?")
+			args = append(args, string(status))
+		}
+		where = append(where, "o.status IN ("+strings.Join(marks, ", ")+")")
+	}
+	if params.BeforeID > 0 {
+		where = append(where, "o.id < ?")
+		args = append(args, params.BeforeID)
+	}
+	args = append(args, orderListLimit(params.Limit))
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+orderColumns+orderFrom+` WHERE `+strings.Join(where, " AND ")+` ORDER BY o.id DESC LIMIT ?`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := make([]userentity.Order, 0)
+	for rows.Next() {
+		var row orderRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("scan order: %w", err)
+		}
+		orders = append(orders, row.order())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate orders: %w", err)
+	}
+	return orders, nil
+}
+
+func (r MysqlUserRepository) ListOrderEvents(ctx context.Context, orderID int64) ([]userentity.OrderEvent, error) {
+	if _, err := loadOrder(ctx, r.db, orderID); err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, order_id, event_type, status, changed_data, reason_changed, created_at
+         FROM order_events WHERE order_id = ? ORDER BY id`,
+		orderID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query order events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]userentity.OrderEvent, 0)
+	for rows.Next() {
+		var (
+			event     userentity.OrderEvent
+			eventType string
+			status    string
+			data      sql.NullString
+			reason    sql.NullString
+		)
+		if err := rows.Scan(&event.ID, &event.OrderID, &eventType, &status, &data, &reason, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan order event: %w", err)
+		}
+		event.Type = userentity.OrderEventType(eventType)
+		event.Status = userentity.OrderStatus(status)
+		event.Reason = reason.String
+		if data.Valid && data.String != "" {
+			var changed orderEventData
+			if err := json.Unmarshal([]byte(data.String), &changed); err != nil {
+				return nil, fmt.Errorf("decode order event data: %w", err)
+			}
+			event.Price, event.Quantity = changed.Price, changed.Quantity
+			event.Fee, event.Tax = changed.Fee, changed.Tax
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate order events: %w", err)
+	}
+	return events, nil
+}
+
+// AmendOrder applies the amendment under the order's row lock.
+func (r MysqlUserRepository) AmendOrder(ctx context.Context, params ports.AmendOrderParams) (amended userentity.Order, err error) {
+	if params.Price <= 0 || params.Quantity <= 0 {
+		return userentity.Order{}, ErrInvalidOrder
+	}
+	at := orNow(params.At)
+
+	tx, err := r.db.BeginTx(ctx, checkTxOptions)
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	order, err := lockOrder(ctx, tx, params.OrderID)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if !order.Status.Open() {
+		return userentity.Order{}, ErrOrderNotOpen
+	}
+	if params.Quantity <= order.FilledQuantity {
+		return userentity.Order{}, ErrOrderQuantityBelowFilled
+	}
+	amended = order.Amended(params.Quantity, params.Price, at)
+	if err = checkOrder(ctx, tx, amended, params.Check); err != nil {
+		return userentity.Order{}, err
+	}
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE orders SET price = ?, quantity = ?, priority_at = ?, updated_at = ? WHERE id = ?`,
+		amended.Price, amended.Quantity, amended.PriorityAt, at, amended.ID,
+	); err != nil {
+		return userentity.Order{}, fmt.Errorf("amend order: %w", err)
+	}
+	if err = insertOrderEvent(ctx, tx, amended, userentity.OrderEventAmended, orderEventData{Price: amended.Price, Quantity: amended.Quantity}, at); err != nil {
+		return userentity.Order{}, err
+	}
+	if amended, err = loadOrder(ctx, tx, amended.ID); err != nil {
+		return userentity.Order{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.Order{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return amended, nil
+}
+
+// RecordOrderFill stores the fill under the order's row lock;
+// uq_order_fills_execution keeps an execution from being recorded twice.
+func (r MysqlUserRepository) RecordOrderFill(ctx context.Context, params ports.RecordOrderFillParams) (filled userentity.Order, err error) {
+	if !validOrderFill(params) {
+		return userentity.Order{}, ErrInvalidOrder
+	}
+	at := orNow(params.At)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	order, err := lockOrder(ctx, tx, params.OrderID)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	recorded, err := orderFillRecorded(ctx, tx, order.ID, params.ExecutionID)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if recorded {
+		if err = tx.Commit(); err != nil {
+			return userentity.Order{}, fmt.Errorf("commit tx: %w", err)
+		}
+		return order, nil
+	}
+	if err = fillableOrder(order, params.Quantity); err != nil {
+		return userentity.Order{}, err
+	}
+	if params.Pricer != nil {
+		if err = lockUserRows(ctx, tx, order.UserID); err != nil {
+			return userentity.Order{}, err
+		}
+		if params.Charges, err = priceFill(ctx, order, params.Pricer, params.Price, params.Quantity, at); err != nil {
+			return userentity.Order{}, err
+		}
+	}
+	if filled, err = fillOrder(ctx, tx, order, params, at); err != nil {
+		return userentity.Order{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.Order{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return filled, nil
+}
+
+// RecordOrderMatch locks both orders, lower id first so concurrent matches
+// cannot deadlock, and fills them in one transaction.
+func (r MysqlUserRepository) RecordOrderMatch(ctx context.Context, params ports.RecordOrderMatchParams) (buy userentity.Order, sell userentity.Order, err error) {
+	if !validOrderMatch(params) {
+		return userentity.Order{}, userentity.Order{}, ErrInvalidOrder
+	}
+	at := orNow(params.At)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.Order{}, userentity.Order{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	first, second := params.BuyOrderID, params.SellOrderID
+	if first > second {
+		first, second = second, first
+	}
+	locked := make(map[int64]userentity.Order, 2)
+	for _, id := range []int64{first, second} {
+		order, err := lockOrder(ctx, tx, id)
+		if err != nil {
+			return userentity.Order{}, userentity.Order{}, err
+		}
+		locked[id] = order
+	}
+	buy, sell = locked[params.BuyOrderID], locked[params.SellOrderID]
+	if buy.Side != userentity.OrderSideBuy || sell.Side != userentity.OrderSideSell || buy.StockID != sell.StockID {
+		return userentity.Order{}, userentity.Order{}, ErrInvalidOrderMatch
+	}
+	recorded, err := orderFillRecorded(ctx, tx, buy.ID, params.ExecutionID)
+	if err != nil {
+		return userentity.Order{}, userentity.Order{}, err
+	}
+	if recorded {
+		if err = tx.Commit(); err != nil {
+			return userentity.Order{}, userentity.Order{}, fmt.Errorf("commit tx: %w", err)
+		}
+		return buy, sell, nil
+	}
+	if err = fillableOrder(buy, params.Quantity); err != nil {
+		return userentity.Order{}, userentity.Order{}, err
+	}
+	if err = fillableOrder(sell, params.Quantity); err != nil {
+		return userentity.Order{}, userentity.Order{}, err
+	}
+	if params.Pricer != nil {
+		if err = lockUserRows(ctx, tx, buy.UserID, sell.UserID); err != nil {
+			return userentity.Order{}, userentity.Order{}, err
+		}
+		if params.BuyCharges, err = priceFill(ctx, buy, params.Pricer, params.Price, params.Quantity, at); err != nil {
+			return userentity.Order{}, userentity.Order{}, err
+		}
+		if params.SellCharges, err = priceFill(ctx, sell, params.Pricer, params.Price, params.Quantity, at); err != nil {
+			return userentity.Order{}, userentity.Order{}, err
+		}
+	}
+	if buy, err = fillOrder(ctx, tx, buy, orderMatchFill(params, userentity.OrderSideBuy), at); err != nil {
+		return userentity.Order{}, userentity.Order{}, err
+	}
+	if sell, err = fillOrder(ctx, tx, sell, orderMatchFill(params, userentity.OrderSideSell), at); err != nil {
+		return userentity.Order{}, userentity.Order{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.Order{}, userentity.Order{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return buy, sell, nil
+}
+
+func (r MysqlUserRepository) ListOpenOrders(ctx context.Context, userID int64) ([]userentity.Order, error) {
+	return listOpenOrders(ctx, r.db, userID)
+}
+
+func listOpenOrders(ctx context.Context, q queryer, userID int64) ([]userentity.Order, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT `+orderColumns+orderFrom+`
+         WHERE o.status IN `+orderOpenStatuses+` AND (? = 0 OR o.user_id = ?)
+         ORDER BY o.priority_at, o.id`,
+		userID, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query open orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := make([]userentity.Order, 0)
+	for rows.Next() {
+		var row orderRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("scan order: %w", err)
+		}
+		orders = append(orders, row.order())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate open orders: %w", err)
+	}
+	return orders, nil
+}
+
+func (r MysqlUserRepository) OrderTotalsSince(ctx context.Context, userID int64, since time.Time) (ports.OrderTotals, error) {
+	var totals ports.OrderTotals
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*), CAST(COALESCE(SUM(price * quantity), 0) AS SIGNED) FROM orders WHERE user_id = ? AND created_at >= ?`,
+		userID, since,
+	).Scan(&totals.Count, &totals.Value)
+	if err != nil {
+		return ports.OrderTotals{}, fmt.Errorf("query order totals: %w", err)
+	}
+	return totals, nil
+}
+
+func (r MysqlUserRepository) FilledValueSince(ctx context.Context, userID int64, since time.Time) (int64, error) {
+	var value int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT CAST(COALESCE(SUM(f.price * f.quantity), 0) AS SIGNED)
+         FROM order_fills f JOIN orders o ON o.id = f.order_id
+         WHERE o.user_id = ? AND f.created_at >= ?`,
+		userID, since,
+	).Scan(&value)
+	if err != nil {
+		return 0, fmt.Errorf("query filled value: %w", err)
+	}
+	return value, nil
+}
+
+func (r MysqlUserRepository) CloseOrder(ctx context.Context, params ports.CloseOrderParams) (closed userentity.Order, err error) {
+	if !validOrderClose(params.Status, params.Reason) {
+		return userentity.Order{}, ErrInvalidOrder
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	order, err := lockOrder(ctx, tx, params.OrderID)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if !order.Status.Open() {
+		return userentity.Order{}, ErrOrderNotOpen
+	}
+	if closed, err = closeOrder(ctx, tx, order, params.Status, params.Reason, orNow(params.At)); err != nil {
+		return userentity.Order{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.Order{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return closed, nil
+}
+
+// ExpireOrders locks the expiring orders in id order and closes them in one
+// transaction.
+func (r MysqlUserRepository) ExpireOrders(ctx context.Context, tif userentity.TimeInForce, placedBefore time.Time, reason string, at time.Time) (count int, err error) {
+	if !tif.Valid() || len(reason) > orderReasonMaxLen {
+		return 0, ErrInvalidOrder
+	}
+	at = orNow(at)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT id FROM orders WHERE status IN `+orderOpenStatuses+` AND time_in_force = ? AND created_at < ?
+         ORDER BY id FOR UPDATE`,
+		string(tif), placedBefore,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("lock expiring orders: %w", err)
+	}
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scan expiring order: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		rows.Close()
+		return 0, fmt.Errorf("iterate expiring orders: %w", err)
+	}
+	rows.Close()
+
+	for _, id := range ids {
+		order, err := loadOrder(ctx, tx, id)
+		if err != nil {
+			return 0, err
+		}
+		if _, err = closeOrder(ctx, tx, order, userentity.OrderStatusExpired, reason, at); err != nil {
+			return 0, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+	return len(ids), nil
+}
+
+func (r MysqlUserRepository) ListTrades(ctx context.Context, params ports.ListTradesParams) ([]ports.Trade, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+orderColumns+`, `+orderFillColumns+orderFrom+`
+         JOIN order_fills f ON f.order_id = o.id
+         WHERE o.user_id = ? AND f.created_at >= ? AND f.created_at < ?
+         ORDER BY f.created_at, f.id`,
+		params.UserID, params.From, params.To,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query trades: %w", err)
+	}
+	defer rows.Close()
+
+	trades := make([]ports.Trade, 0)
+	for rows.Next() {
+		var (
+			row  orderRow
+			fill userentity.OrderFill
+		)
+		if err := rows.Scan(append(row.dest(), fillDest(&fill)...)...); err != nil {
+			return nil, fmt.Errorf("scan trade: %w", err)
+		}
+		trades = append(trades, ports.Trade{Order: row.order(), Fill: fill})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate trades: %w", err)
+	}
+	return trades, nil
+}
+
+func (r MysqlUserRepository) ListUnconfirmedTrades(ctx context.Context, limit int) ([]ports.UnconfirmedTrade, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+orderColumns+`, `+orderFillColumns+`, `+userColumns("u")+orderFrom+`
+         JOIN order_fills f ON f.order_id = o.id
+         JOIN users u ON u.id = o.user_id
+         WHERE f.confirmed_at IS NULL
+         ORDER BY f.id
+         LIMIT ?`,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query unconfirmed trades: %w", err)
+	}
+	defer rows.Close()
+
+	trades := make([]ports.UnconfirmedTrade, 0)
+	for rows.Next() {
+		var (
+			row  orderRow
+			fill userentity.OrderFill
+			ur   userRow
+		)
+		if err := rows.Scan(append(append(row.dest(), fillDest(&fill)...), ur.dest()...)...); err != nil {
+			return nil, fmt.Errorf("scan unconfirmed trade: %w", err)
+		}
+		owner, err := ur.user(r.fields)
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, ports.UnconfirmedTrade{Order: row.order(), Fill: fill, Owner: owner})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate unconfirmed trades: %w", err)
+	}
+	return trades, nil
+}
+
+// ConfirmTrade confirms the fill under its row lock, so of several senders
+// racing on the same fill only one writes the event.
+func (r MysqlUserRepository) ConfirmTrade(ctx context.Context, params ports.ConfirmTradeParams) (confirmed bool, err error) {
+	at := orNow(params.At)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil || !confirmed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var (
+		orderID     int64
+		confirmedAt sql.NullTime
+	)
+	err = tx.QueryRowContext(ctx, `SELECT order_id, confirmed_at FROM order_fills WHERE id = ? FOR UPDATE`, params.FillID).Scan(&orderID, &confirmedAt)
+	if err == sql.ErrNoRows {
+		return false, ErrOrderFillNotFound
+	}
+	if err != nil {
+		return false, fmt.Errorf("lock order fill: %w", err)
+	}
+	if confirmedAt.Valid {
+		return false, nil
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE order_fills SET confirmed_at = ? WHERE id = ?`, at, params.FillID); err != nil {
+		return false, fmt.Errorf("confirm order fill: %w", err)
+	}
+	if params.Event.EventType != "" {
+		var userID int64
+		if err = tx.QueryRowContext(ctx, `SELECT user_id FROM orders WHERE id = ?`, orderID).Scan(&userID); err != nil {
+			return false, fmt.Errorf("query order owner: %w", err)
+		}
+		if err = insertOutboxEvent(ctx, tx, userID, params.Event); err != nil {
+			return false, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit tx: %w", err)
+	}
+	return true, nil
+}
+
+// lockOrder takes the row lock of an order and returns it. Only the orders
+// row is locked, so orders on the same stock do not wait for each other.
+func lockOrder(ctx context.Context, tx *sql.Tx, orderID int64) (userentity.Order, error) {
+	var id int64
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM orders WHERE id = ? FOR UPDATE`, orderID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.Order{}, ErrOrderNotFound
+		}
+		return userentity.Order{}, fmt.Errorf("lock order: %w", err)
+	}
+	return loadOrder(ctx, tx, id)
+}
+
+// checkTxOptions are the options of the transactions that run an
+// ports.OrderCheck or ports.PaymentCheck. Under READ COMMITTED the reads
+// made once the owner is locked see what the previous holder of the lock
+// committed; REPEATABLE READ would keep the snapshot of the first read of
+// the transaction.
+var checkTxOptions = &sql.TxOptions{Isolation: sql.LevelReadCommitted}
+
+// checkOrder runs check, if not nil, on order with the account and open
+// orders of its owner read under the owner's row lock. It is taken after
+// any order row lock, as fills do.
+func checkOrder(ctx context.Context, tx *sql.Tx, order userentity.Order, check ports.OrderCheck) error {
+	if check == nil {
+		return nil
+	}
+	account, open, err := lockOwner(ctx, tx, order.UserID)
+	if err != nil {
+		return err
+	}
+	return check(ctx, order, account, open)
+}
+
+// lockOwner takes the row lock of a user and reads their account and open
+// orders. Order placements and amendments and withdrawals of a user take
+// the lock, so they are checked one at a time.
+func lockOwner(ctx context.Context, tx *sql.Tx, userID int64) (userentity.TradingAccount, []userentity.Order, error) {
+	if err := lockUserRow(ctx, tx, userID); err != nil {
+		return userentity.TradingAccount{}, nil, err
+	}
+	account, err := loadTradingAccount(ctx, tx, userID)
+	if err != nil {
+		return userentity.TradingAccount{}, nil, err
+	}
+	open, err := listOpenOrders(ctx, tx, userID)
+	if err != nil {
+		return userentity.TradingAccount{}, nil, err
+	}
+	return account, open, nil
+}
+
+// lockOpenOrderIDs takes the row locks of the open orders of a user and
+// returns their ids, lowest first.
+func lockOpenOrderIDs(ctx context.Context, tx *sql.Tx, userID int64) ([]int64, error) {
+	rows, err := tx.QueryContext(ctx,
+		`SELECT id FROM orders WHERE user_id = ? AND status IN `+orderOpenStatuses+` ORDER BY id FOR UPDATE`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("lock open orders: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan open order: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate open orders: %w", err)
+	}
+	return ids, nil
+}
+
+// lockUserRows takes the row locks of users, lower id first so concurrent
+// matches cannot deadlock. Priced fills take them after the locks of their
+// orders, as order checks do.
+func lockUserRows(ctx context.Context, tx *sql.Tx, userIDs ...int64) error {
+	sorted := append([]int64(nil), userIDs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	for i, id := range sorted {
+		if i > 0 && id == sorted[i-1] {
+			continue
+		}
+		if err := lockUserRow(ctx, tx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockUserRow takes the row lock of a user.
+func lockUserRow(ctx context.Context, tx *sql.Tx, userID int64) error {
+	var id int64
+	if err := tx.QueryRowContext(ctx, "SELECT id FROM users WHERE id = ? FOR UPDATE", userID).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("lock user: %w", err)
+	}
+	return nil
+}
+
+// orderFillRecorded reports whether a fill with the execution id was
+// recorded for the order.
+func orderFillRecorded(ctx context.Context, tx *sql.Tx, orderID int64, executionID string) (bool, error) {
+	var recorded int
+	err := tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM order_fills WHERE order_id = ? AND execution_id = ?`, orderID, executionID,
+	).Scan(&recorded)
+	if err != nil {
+		return false, fmt.Errorf("query order fill: %w", err)
+	}
+	return recorded > 0, nil
+}
+
+// fillOrder records a checked fill of a locked order, posts its trade and
+// schedules its settlements.
+func fillOrder(ctx context.Context, tx *sql.Tx, order userentity.Order, params ports.RecordOrderFillParams, at time.Time) (userentity.Order, error) {
+	filled := order.Filled(params.Quantity, params.Price, params.Charges, at)
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO order_fills (order_id, execution_id, price, quantity, fee, tax, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		order.ID, params.ExecutionID, params.Price, params.Quantity, params.Charges.Fee, params.Charges.Tax, at,
+	)
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("insert order fill: %w", err)
+	}
+	fillID, err := res.LastInsertId()
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("order fill id: %w", err)
+	}
+	entries, settlements := orderFillEntries(order, params, at)
+	if _, err := insertLedgerEntries(ctx, tx, orderFillReference(fillID), entries); err != nil {
+		return userentity.Order{}, err
+	}
+	if err := insertSettlements(ctx, tx, orderFillReference(fillID), settlements); err != nil {
+		return userentity.Order{}, err
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE orders SET filled_quantity = ?, filled_value = ?, fees = ?, taxes = ?, status = ?, updated_at = ? WHERE id = ?`,
+		filled.FilledQuantity, filled.FilledValue, filled.Fees, filled.Taxes, string(filled.Status), at, filled.ID,
+	); err != nil {
+		return userentity.Order{}, fmt.Errorf("fill order: %w", err)
+	}
+	if err := insertOrderEvent(ctx, tx, filled, userentity.OrderEventFilled, orderFillEventData(params), at); err != nil {
+		return userentity.Order{}, err
+	}
+	return loadOrder(ctx, tx, filled.ID)
+}
+
+// closeOrder moves a locked, open order to a final status.
+func closeOrder(ctx context.Context, tx *sql.Tx, order userentity.Order, status userentity.OrderStatus, reason string, at time.Time) (userentity.Order, error) {
+	order.Status = status
+	order.Reason = reason
+	order.UpdatedAt = at
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE orders SET status = ?, reason = ?, updated_at = ? WHERE id = ?`,
+		string(status), reason, at, order.ID,
+	); err != nil {
+		return userentity.Order{}, fmt.Errorf("close order: %w", err)
+	}
+	if err := insertOrderEvent(ctx, tx, order, orderCloseEvent(status), orderEventData{}, at); err != nil {
+		return userentity.Order{}, err
+	}
+	return loadOrder(ctx, tx, order.ID)
+}
+
+// insertOrderEvent records a change of order, which already has its new
+// status.
+func insertOrderEvent(ctx context.Context, tx *sql.Tx, order userentity.Order, eventType userentity.OrderEventType, changed orderEventData, at time.Time) error {
+	var data sql.NullString
+	if changed != (orderEventData{}) {
+		encoded, err := json.Marshal(changed)
+		if err != nil {
+			return fmt.Errorf("encode order event data: %w", err)
+		}
+		data = sql.NullString{String: string(encoded), Valid: true}
+	}
+	var reason sql.NullString
+	if !order.Status.Open() {
+		reason = sql.NullString{String: order.Reason, Valid: true}
+	}
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO order_events (order_id, event_type, status, changed_data, reason_changed, created_at)
+         VALUES (?, ?, ?, ?, ?, ?)`,
+		order.ID, string(eventType), string(order.Status), data, reason, at,
+	); err != nil {
+		return fmt.Errorf("insert order event: %w", err)
+	}
+	return nil
+}
+
+func loadOrder(ctx context.Context, q queryer, orderID int64) (userentity.Order, error) {
+	var row orderRow
+	err := q.QueryRowContext(ctx, `SELECT `+orderColumns+orderFrom+` WHERE o.id = ?`, orderID).Scan(row.dest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.Order{}, ErrOrderNotFound
+		}
+		return userentity.Order{}, fmt.Errorf("query order: %w", err)
+	}
+	return row.order(), nil
+}
+
+type orderRow struct {
+	o           userentity.Order
+	side        string
+	timeInForce string
+	status      string
+}
+
+func (row *orderRow) dest() []any {
+	return []any{
+		&row.o.ID,
+		&row.o.UserID,
+		&row.o.StockID,
+		&row.o.Code,
+		&row.side,
+		&row.timeInForce,
+		&row.o.Price,
+		&row.o.Quantity,
+		&row.o.FilledQuantity,
+		&row.o.FilledValue,
+		&row.o.Fees,
+		&row.o.Taxes,
+		&row.status,
+		&row.o.Reason,
+		&row.o.PriorityAt,
+		&row.o.CreatedAt,
+		&row.o.UpdatedAt,
+	}
+}
+
+func (row *orderRow) order() userentity.Order {
+	order := row.o
+	order.Side = userentity.OrderSide(row.side)
+	order.TimeInForce = userentity.TimeInForce(row.timeInForce)
+	order.Status = userentity.OrderStatus(row.status)
+	return order
+}
+
+// fillDest returns the scan destinations of orderFillColumns.
+func fillDest(fill *userentity.OrderFill) []any {
+	return []any{&fill.ID, &fill.OrderID, &fill.ExecutionID, &fill.Price, &fill.Quantity, &fill.Fee, &fill.Tax, &fill.CreatedAt}
+}
@@ -0,0 +1,249 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.PaymentRepository = MysqlUserRepository{}
+
+const paymentColumns = `id, user_id, kind, amount, status, idempotency_key, gateway_reference, payment_url,
+        reason, reviewer_id, created_at, updated_at, completed_at`
+
+func (r MysqlUserRepository) CreatePayment(ctx context.Context, payment userentity.Payment, check ports.PaymentCheck) (stored userentity.Payment, created bool, err error) {
+	if !validPayment(payment) {
+		return userentity.Payment{}, false, ErrInvalidPayment
+	}
+	payment.CreatedAt = orNow(payment.CreatedAt)
+
+	tx, err := r.db.BeginTx(ctx, checkTxOptions)
+	if err != nil {
+		return userentity.Payment{}, false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil || !created {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if check != nil {
+		if stored, err = checkPayment(ctx, tx, payment, check); err != nil || stored.ID != 0 {
+			return stored, false, err
+		}
+	}
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO payments (user_id, kind, amount, status, idempotency_key, created_at, updated_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		payment.UserID, string(payment.Kind), payment.Amount, string(payment.Status), payment.IdempotencyKey,
+		payment.CreatedAt, payment.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) {
+			switch me.Number {
+			case 1452:
+				return userentity.Payment{}, false, ErrUserNotFound
+			case 1062:
+				stored, err = r.FindPayment(ctx, payment.UserID, payment.IdempotencyKey)
+				return stored, false, err
+			}
+		}
+		return userentity.Payment{}, false, fmt.Errorf("insert payment: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return userentity.Payment{}, false, fmt.Errorf("payment id: %w", err)
+	}
+	if stored, err = loadPayment(ctx, tx, id); err != nil {
+		return userentity.Payment{}, false, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.Payment{}, false, fmt.Errorf("commit tx: %w", err)
+	}
+	return stored, true, nil
+}
+
+func (r MysqlUserRepository) GetPayment(ctx context.Context, paymentID int64) (userentity.Payment, error) {
+	return loadPayment(ctx, r.db, paymentID)
+}
+
+func (r MysqlUserRepository) FindPayment(ctx context.Context, userID int64, idempotencyKey string) (userentity.Payment, error) {
+	return findPayment(ctx, r.db, userID, idempotencyKey)
+}
+
+func (r MysqlUserRepository) ListPayments(ctx context.Context, params ports.ListPaymentsParams) ([]userentity.Payment, error) {
+	where := []string{"1 = 1"}
+	args := []any{}
+	if params.UserID != 0 {
+		where = append(where, "user_id = ?")
+		args = append(args, params.UserID)
+	}
+	if params.Status != "" {
+		where = append(where, "status = ?")
+		args = append(args, string(params.Status))
+	}
+	if params.BeforeID > 0 {
+		where = append(where, "id < ?")
+		args = append(args, params.BeforeID)
+	}
+	args = append(args, orderListLimit(params.Limit))
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+paymentColumns+` FROM payments WHERE `+strings.Join(where, " AND ")+` ORDER BY id DESC LIMIT ?`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query payments: %w", err)
+	}
+	defer rows.Close()
+
+	payments := make([]userentity.Payment, 0)
+	for rows.Next() {
+		var row paymentRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("scan payment: %w", err)
+		}
+		payments = append(payments, row.payment())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate payments: %w", err)
+	}
+	return payments, nil
+}
+
+func (r MysqlUserRepository) UpdatePayment(ctx context.Context, params ports.UpdatePaymentParams) (updated userentity.Payment, err error) {
+	if !validPaymentUpdate(params) {
+		return userentity.Payment{}, ErrInvalidPayment
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.Payment{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	current, err := loadPaymentForUpdate(ctx, tx, params.PaymentID)
+	if err != nil {
+		return userentity.Payment{}, err
+	}
+	if updated, err = updatedPayment(current, params); err != nil {
+		return userentity.Payment{}, err
+	}
+	var (
+		reviewerID  sql.NullInt64
+		completedAt sql.NullTime
+	)
+	if updated.ReviewerID != 0 {
+		reviewerID = sql.NullInt64{Int64: updated.ReviewerID, Valid: true}
+	}
+	if !updated.CompletedAt.IsZero() {
+		completedAt = sql.NullTime{Time: updated.CompletedAt, Valid: true}
+	}
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE payments SET status = ?, gateway_reference = ?, payment_url = ?, reason = ?, reviewer_id = ?, updated_at = ?, completed_at = ?
+         WHERE id = ?`,
+		string(updated.Status), updated.GatewayReference, updated.PaymentURL, updated.Reason, reviewerID, updated.UpdatedAt, completedAt,
+		updated.ID,
+	); err != nil {
+		return userentity.Payment{}, fmt.Errorf("update payment: %w", err)
+	}
+	if updated.Status == userentity.PaymentStatusCompleted {
+		entry := updated.LedgerEntry(updated.CompletedAt)
+		if _, err = insertLedgerEntries(ctx, tx, entry.Reference, []userentity.LedgerEntry{entry}); err != nil {
+			return userentity.Payment{}, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.Payment{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return loadPayment(ctx, r.db, updated.ID)
+}
+
+func loadPayment(ctx context.Context, q queryer, paymentID int64) (userentity.Payment, error) {
+	return scanPayment(q.QueryRowContext(ctx, `SELECT `+paymentColumns+` FROM payments WHERE id = ?`, paymentID))
+}
+
+func findPayment(ctx context.Context, q queryer, userID int64, idempotencyKey string) (userentity.Payment, error) {
+	return scanPayment(q.QueryRowContext(ctx,
+		`SELECT `+paymentColumns+` FROM payments WHERE user_id = ? AND idempotency_key = ?`, userID, idempotencyKey,
+	))
+}
+
+// checkPayment runs check on payment with the account and open orders of
+// its owner read under the owner's row lock. When the owner created a
+// payment with the same idempotency key before, that payment is returned
+// instead and check is not run: a request repeating the key of one being
+// created waits for the lock and is answered with its payment.
+func checkPayment(ctx context.Context, tx *sql.Tx, payment userentity.Payment, check ports.PaymentCheck) (userentity.Payment, error) {
+	account, open, err := lockOwner(ctx, tx, payment.UserID)
+	if err != nil {
+		return userentity.Payment{}, err
+	}
+	existing, err := findPayment(ctx, tx, payment.UserID, payment.IdempotencyKey)
+	if !errors.Is(err, ErrPaymentNotFound) {
+		return existing, err
+	}
+	return userentity.Payment{}, check(ctx, payment, account, open)
+}
+
+// loadPaymentForUpdate takes the row lock of a payment and returns it.
+func loadPaymentForUpdate(ctx context.Context, tx *sql.Tx, paymentID int64) (userentity.Payment, error) {
+	return scanPayment(tx.QueryRowContext(ctx, `SELECT `+paymentColumns+` FROM payments WHERE id = ? FOR UPDATE`, paymentID))
+}
+
+func scanPayment(row *sql.Row) (userentity.Payment, error) {
+	var scanned paymentRow
+	if err := row.Scan(scanned.dest()...); err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.Payment{}, ErrPaymentNotFound
+		}
+		return userentity.Payment{}, fmt.Errorf("query payment: %w", err)
+	}
+	return scanned.payment(), nil
+}
+
+type paymentRow struct {
+	p           userentity.Payment
+	kind        string
+	status      string
+	reviewerID  sql.NullInt64
+	completedAt sql.NullTime
+}
+
+func (row *paymentRow) dest() []any {
+	return []any{
+		&row.p.ID,
+		&row.p.UserID,
+		&row.kind,
+		&row.p.Amount,
+		&row.status,
+		&row.p.IdempotencyKey,
+		&row.p.GatewayReference,
+		&row.p.PaymentURL,
+		&row.p.Reason,
+		&row.reviewerID,
+		&row.p.CreatedAt,
+		&row.p.UpdatedAt,
+		&row.completedAt,
+	}
+}
+
+func (row *paymentRow) payment() userentity.Payment {
+	payment := row.p
+	payment.Kind = userentity.PaymentKind(row.kind)
+	payment.Status = userentity.PaymentStatus(row.status)
+	payment.ReviewerID = row.reviewerID.Int64
+	payment.CompletedAt = row.completedAt.Time
+	return payment
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.PortfolioRepository = MysqlUserRepository{}
+
+const portfolioSnapshotColumns = `user_id, snapshot_date, cash, market_value, net_flow, created_at`
+
+func (r MysqlUserRepository) ListTradeDateEntries(ctx context.Context, userID int64, before time.Time) ([]userentity.LedgerEntry, error) {
+	entries, err := queryLedgerEntries(ctx, r.db,
+		`WHERE l.user_id = ? AND l.entry_type <> 'settlement' AND l.created_at < ? ORDER BY l.created_at, l.id`,
+		userID, before,
+	)
+	if err != nil {
+		return nil, err
+	}
+	settlements, err := querySettlements(ctx, r.db,
+		`WHERE st.user_id = ? AND st.created_at < ? ORDER BY st.created_at, st.id`,
+		userID, before,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tradeDateEntries(entries, settlements), nil
+}
+
+func (r MysqlUserRepository) ListPortfolioOwners(ctx context.Context, before time.Time) ([]int64, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT DISTINCT user_id FROM ledger_entries WHERE created_at < ? ORDER BY user_id`, before,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query portfolio owners: %w", err)
+	}
+	defer rows.Close()
+
+	owners := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan portfolio owner: %w", err)
+		}
+		owners = append(owners, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate portfolio owners: %w", err)
+	}
+	return owners, nil
+}
+
+func (r MysqlUserRepository) SavePortfolioSnapshots(ctx context.Context, snapshots []userentity.PortfolioSnapshot) (err error) {
+	for _, snapshot := range snapshots {
+		if !validPortfolioSnapshot(snapshot) {
+			return ErrInvalidPortfolioSnapshot
+		}
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, snapshot := range snapshots {
+		if _, err = tx.ExecContext(ctx,
+			`INSERT INTO portfolio_snapshots (`+portfolioSnapshotColumns+`) VALUES (?, ?, ?, ?, ?, ?)
+             ON DUPLICATE KEY UPDATE cash = VALUES(cash), market_value = VALUES(market_value), net_flow = VALUES(net_flow), created_at = VALUES(created_at)`,
+			snapshot.UserID, settlementDate(snapshot.Date), snapshot.Cash, snapshot.MarketValue, snapshot.NetFlow, orNow(snapshot.CreatedAt),
+		); err != nil {
+			var me *mysql.MySQLError
+			if errors.As(err, &me) && me.Number == 1452 {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("insert portfolio snapshot: %w", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+func (r MysqlUserRepository) ListPortfolioSnapshots(ctx context.Context, userID int64, from time.Time) ([]userentity.PortfolioSnapshot, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+portfolioSnapshotColumns+` FROM portfolio_snapshots WHERE user_id = ? AND snapshot_date >= ? ORDER BY snapshot_date`,
+		userID, settlementDate(from),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query portfolio snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	snapshots := make([]userentity.PortfolioSnapshot, 0)
+	for rows.Next() {
+		snapshot, err := scanPortfolioSnapshot(rows)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate portfolio snapshots: %w", err)
+	}
+	return snapshots, nil
+}
+
+func (r MysqlUserRepository) LastPortfolioSnapshot(ctx context.Context, userID int64) (userentity.PortfolioSnapshot, error) {
+	snapshot, err := scanPortfolioSnapshot(r.db.QueryRowContext(ctx,
+		`SELECT `+portfolioSnapshotColumns+` FROM portfolio_snapshots WHERE user_id = ? ORDER BY snapshot_date DESC LIMIT 1`, userID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return userentity.PortfolioSnapshot{}, ErrPortfolioSnapshotNotFound
+	}
+	return snapshot, err
+}
+
+func scanPortfolioSnapshot(row interface{ Scan(...any) error }) (userentity.PortfolioSnapshot, error) {
+	var snapshot userentity.PortfolioSnapshot
+	err := row.Scan(&snapshot.UserID, &snapshot.Date, &snapshot.Cash, &snapshot.MarketValue, &snapshot.NetFlow, &snapshot.CreatedAt)
+	if err == sql.ErrNoRows {
+		return userentity.PortfolioSnapshot{}, err
+	}
+	if err != nil {
+		return userentity.PortfolioSnapshot{}, fmt.Errorf("scan portfolio snapshot: %w", err)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,211 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.PriceAlertRepository = MysqlUserRepository{}
+
+const (
+	priceAlertColumns = `a.id, a.user_id, a.stock_id, s.code, a.alert_condition, a.target_price, a.change_bps,
+        a.base_price, a.status, a.triggered_price, a.triggered_at, a.created_at`
+	priceAlertFrom = ` FROM price_alerts a JOIN stocks s ON s.id = a.stock_id`
+)
+
+func (r MysqlUserRepository) CreatePriceAlert(ctx context.Context, alert userentity.PriceAlert) (userentity.PriceAlert, error) {
+	if !validPriceAlert(alert) {
+		return userentity.PriceAlert{}, ErrInvalidPriceAlert
+	}
+	alert.CreatedAt = orNow(alert.CreatedAt)
+
+	stockID, err := stockIDByCode(ctx, r.db, alert.Code)
+	if err != nil {
+		return userentity.PriceAlert{}, err
+	}
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO price_alerts (user_id, stock_id, alert_condition, target_price, change_bps, base_price, status, created_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		alert.UserID, stockID, string(alert.Condition), alert.TargetPrice, alert.ChangeBps, alert.BasePrice,
+		string(userentity.PriceAlertStatusActive), alert.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1452 {
+			return userentity.PriceAlert{}, ErrUserNotFound
+		}
+		return userentity.PriceAlert{}, fmt.Errorf("insert price alert: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return userentity.PriceAlert{}, fmt.Errorf("price alert id: %w", err)
+	}
+	return r.GetPriceAlert(ctx, id)
+}
+
+func (r MysqlUserRepository) GetPriceAlert(ctx context.Context, alertID int64) (userentity.PriceAlert, error) {
+	var row priceAlertRow
+	err := r.db.QueryRowContext(ctx, `SELECT `+priceAlertColumns+priceAlertFrom+` WHERE a.id = ?`, alertID).Scan(row.dest()...)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.PriceAlert{}, ErrPriceAlertNotFound
+		}
+		return userentity.PriceAlert{}, fmt.Errorf("query price alert: %w", err)
+	}
+	return row.alert(), nil
+}
+
+func (r MysqlUserRepository) ListPriceAlerts(ctx context.Context, userID int64) ([]userentity.PriceAlert, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+priceAlertColumns+priceAlertFrom+` WHERE a.user_id = ? ORDER BY a.id DESC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query price alerts: %w", err)
+	}
+	defer rows.Close()
+
+	alerts := make([]userentity.PriceAlert, 0)
+	for rows.Next() {
+		var row priceAlertRow
+		if err := rows.Scan(row.dest()...); err != nil {
+			return nil, fmt.Errorf("scan price alert: %w", err)
+		}
+		alerts = append(alerts, row.alert())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate price alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+func (r MysqlUserRepository) DeletePriceAlert(ctx context.Context, alertID int64) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM price_alerts WHERE id = ?`, alertID)
+	if err != nil {
+		return fmt.Errorf("delete price alert: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete price alert rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrPriceAlertNotFound
+	}
+	return nil
+}
+
+func (r MysqlUserRepository) ListActivePriceAlerts(ctx context.Context, stockID int64) ([]ports.ActivePriceAlert, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+priceAlertColumns+`, `+userColumns("u")+priceAlertFrom+`
+         JOIN users u ON u.id = a.user_id
+         WHERE a.stock_id = ? AND a.status = ?
+         ORDER BY a.id`,
+		stockID, string(userentity.PriceAlertStatusActive),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query active price alerts: %w", err)
+	}
+	defer rows.Close()
+
+	active := make([]ports.ActivePriceAlert, 0)
+	for rows.Next() {
+		var (
+			row priceAlertRow
+			ur  userRow
+		)
+		if err := rows.Scan(append(row.dest(), ur.dest()...)...); err != nil {
+			return nil, fmt.Errorf("scan active price alert: %w", err)
+		}
+		owner, err := ur.user(r.fields)
+		if err != nil {
+			return nil, err
+		}
+		active = append(active, ports.ActivePriceAlert{Alert: row.alert(), Owner: owner})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate active price alerts: %w", err)
+	}
+	return active, nil
+}
+
+// TriggerPriceAlert fires the alert under its row lock, so of several
+// evaluators racing on the same alert only one writes the event.
+func (r MysqlUserRepository) TriggerPriceAlert(ctx context.Context, params ports.TriggerPriceAlertParams) (triggered bool, err error) {
+	at := orNow(params.At)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil || !triggered {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var (
+		userID int64
+		status string
+	)
+	err = tx.QueryRowContext(ctx, `SELECT user_id, status FROM price_alerts WHERE id = ? FOR UPDATE`, params.AlertID).Scan(&userID, &status)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("lock price alert: %w", err)
+	}
+	if userentity.PriceAlertStatus(status) != userentity.PriceAlertStatusActive {
+		return false, nil
+	}
+
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE price_alerts SET status = ?, triggered_price = ?, triggered_at = ? WHERE id = ?`,
+		string(userentity.PriceAlertStatusTriggered), params.Price, at, params.AlertID,
+	); err != nil {
+		return false, fmt.Errorf("trigger price alert: %w", err)
+	}
+	if err = insertOutboxEvent(ctx, tx, userID, params.Event); err != nil {
+		return false, err
+	}
+	if err = tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit tx: %w", err)
+	}
+	return true, nil
+}
+
+type priceAlertRow struct {
+	a           userentity.PriceAlert
+	condition   string
+	status      string
+	triggeredAt sql.NullTime
+}
+
+func (row *priceAlertRow) dest() []any {
+	return []any{
+		&row.a.ID,
+		&row.a.UserID,
+		&row.a.StockID,
+		&row.a.Code,
+		&row.condition,
+		&row.a.TargetPrice,
+		&row.a.ChangeBps,
+		&row.a.BasePrice,
+		&row.status,
+		&row.a.TriggeredPrice,
+		&row.triggeredAt,
+		&row.a.CreatedAt,
+	}
+}
+
+func (row *priceAlertRow) alert() userentity.PriceAlert {
+	alert := row.a
+	alert.Condition = userentity.PriceAlertCondition(row.condition)
+	alert.Status = userentity.PriceAlertStatus(row.status)
+	if row.triggeredAt.Valid {
+		alert.TriggeredAt = row.triggeredAt.Time
+	}
+	return alert
+}
@@ -0,0 +1,229 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// defaultReencryptBatchSize is used when ReencryptUsers or
+// ReencryptKycSubmissions gets no batch size.
+const defaultReencryptBatchSize = 200
+
+// ReencryptUsers rewrites the sensitive columns of every user whose values are
+// plaintext or sealed under a retired master key, and refreshes blind indexes
+// that do not match. Rows are walked in id order, batchSize at a time. Each row
+// is updated only if its sensitive columns are unchanged since they were read,
+// so concurrent profile updates are never overwritten; such rows are already
+// sealed under the current key. It returns the number of rows rewritten.
+func (r MysqlUserRepository) ReencryptUsers(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = defaultReencryptBatchSize
+	}
+	var (
+		afterID   int64
+		rewritten int
+	)
+	for {
+		rows, err := r.reencryptBatch(ctx, afterID, batchSize)
+		if err != nil {
+			return rewritten, err
+		}
+		for _, row := range rows {
+			changed, err := r.reencryptUser(ctx, row)
+			if err != nil {
+				return rewritten, fmt.Errorf("reencrypt user %d: %w", row.id, err)
+			}
+			if changed {
+				rewritten++
+			}
+		}
+		if len(rows) < batchSize {
+			return rewritten, nil
+		}
+		afterID = rows[len(rows)-1].id
+	}
+}
+
+// storedSensitive is the stored form of a user's sensitive columns as read by
+// ReencryptUsers.
+type storedSensitive struct {
+	id               int64
+	cmnd             string
+	birthday         string
+	permanentAddress string
+	phoneNumber      string
+	cmndIndex        sql.NullString
+	phoneNumberIndex sql.NullString
+}
+
+func (r MysqlUserRepository) reencryptBatch(ctx context.Context, afterID int64, limit int) ([]storedSensitive, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, cmnd, birthday, permanent_address, phone_number, cmnd_bidx, phone_number_bidx
+         FROM users WHERE id > ? ORDER BY id LIMIT ?`,
+		afterID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query users: %w", err)
+	}
+	defer rows.Close()
+	var batch []storedSensitive
+	for rows.Next() {
+		var s storedSensitive
+		if err := rows.Scan(&s.id, &s.cmnd, &s.birthday, &s.permanentAddress, &s.phoneNumber, &s.cmndIndex, &s.phoneNumberIndex); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		batch = append(batch, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate users: %w", err)
+	}
+	return batch, nil
+}
+
+func (r MysqlUserRepository) reencryptUser(ctx context.Context, stored storedSensitive) (bool, error) {
+	values := map[string]string{
+		columnCmnd:             stored.cmnd,
+		columnBirthday:         stored.birthday,
+		columnPermanentAddress: stored.permanentAddress,
+		columnPhoneNumber:      stored.phoneNumber,
+	}
+	plain := make(map[string]string, len(values))
+	stale := false
+	for field, value := range values {
+		v, err := r.fields.Decrypt(field, value)
+		if err != nil {
+			return false, err
+		}
+		plain[field] = v
+		stale = stale || r.fields.NeedsReencrypt(value)
+	}
+	cmndIndex := blindIndex(r.fields, columnCmnd, plain[columnCmnd])
+	phoneNumberIndex := blindIndex(r.fields, columnPhoneNumber, plain[columnPhoneNumber])
+	if !stale && cmndIndex == stored.cmndIndex && phoneNumberIndex == stored.phoneNumberIndex {
+		return false, nil
+	}
+
+	sealed := make(map[string]string, len(plain))
+	for field, v := range plain {
+		if field == columnBirthday && v != "" {
+			// Normalise values converted from the former DATE column.
+			birthday, err := parseBirthday(v)
+			if err != nil {
+				return false, err
+			}
+			v = formatBirthday(birthday)
+		}
+		s, err := r.fields.Encrypt(field, v)
+		if err != nil {
+			return false, fmt.Errorf("encrypt %s: %w", field, err)
+		}
+		sealed[field] = s
+	}
+
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE users
+         SET cmnd = ?, cmnd_bidx = ?, birthday = ?, permanent_address = ?, phone_number = ?, phone_number_bidx = ?
+         WHERE id = ? AND cmnd = ? AND birthday = ? AND permanent_address = ? AND phone_number = ?`,
+		sealed[columnCmnd], cmndIndex, sealed[columnBirthday], sealed[columnPermanentAddress],
+		sealed[columnPhoneNumber], phoneNumberIndex,
+		stored.id, stored.cmnd, stored.birthday, stored.permanentAddress, stored.phoneNumber,
+	)
+	if err != nil {
+		return false, fmt.Errorf("update user: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("update user rows affected: %w", err)
+	}
+	return n == 1, nil
+}
+
+// ReencryptKycSubmissions rewrites the document number of every KYC submission
+// that is plaintext or sealed under a retired master key, batchSize rows at a
+// time in id order. Like ReencryptUsers it only updates rows whose value is
+// unchanged since it was read. It returns the number of rows rewritten.
+func (r MysqlUserRepository) ReencryptKycSubmissions(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = defaultReencryptBatchSize
+	}
+	var (
+		afterID   int64
+		rewritten int
+	)
+	for {
+		rows, err := r.reencryptKycBatch(ctx, afterID, batchSize)
+		if err != nil {
+			return rewritten, err
+		}
+		for _, row := range rows {
+			changed, err := r.reencryptKycSubmission(ctx, row)
+			if err != nil {
+				return rewritten, fmt.Errorf("reencrypt kyc submission %d: %w", row.id, err)
+			}
+			if changed {
+				rewritten++
+			}
+		}
+		if len(rows) < batchSize {
+			return rewritten, nil
+		}
+		afterID = rows[len(rows)-1].id
+	}
+}
+
+// storedKycNumber is the stored document number of a KYC submission as read
+// by ReencryptKycSubmissions.
+type storedKycNumber struct {
+	id             int64
+	documentNumber string
+}
+
+func (r MysqlUserRepository) reencryptKycBatch(ctx context.Context, afterID int64, limit int) ([]storedKycNumber, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id, document_number FROM kyc_submissions WHERE id > ? ORDER BY id LIMIT ?",
+		afterID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query kyc submissions: %w", err)
+	}
+	defer rows.Close()
+	var batch []storedKycNumber
+	for rows.Next() {
+		var s storedKycNumber
+		if err := rows.Scan(&s.id, &s.documentNumber); err != nil {
+			return nil, fmt.Errorf("scan kyc submission: %w", err)
+		}
+		batch = append(batch, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate kyc submissions: %w", err)
+	}
+	return batch, nil
+}
+
+func (r MysqlUserRepository) reencryptKycSubmission(ctx context.Context, stored storedKycNumber) (bool, error) {
+	if !r.fields.NeedsReencrypt(stored.documentNumber) {
+		return false, nil
+	}
+	plain, err := r.fields.Decrypt(columnKycDocumentNumber, stored.documentNumber)
+	if err != nil {
+		return false, err
+	}
+	sealed, err := r.fields.Encrypt(columnKycDocumentNumber, plain)
+	if err != nil {
+		return false, fmt.Errorf("encrypt %s: %w", columnKycDocumentNumber, err)
+	}
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE kyc_submissions SET document_number = ? WHERE id = ? AND document_number = ?",
+		sealed, stored.id, stored.documentNumber,
+	)
+	if err != nil {
+		return false, fmt.Errorf("update kyc submission: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("update kyc submission rows affected: %w", err)
+	}
+	return n == 1, nil
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func TestMysqlReencryptPII(t *testing.T) {
+	dsn := strings.TrimSpace(os.Getenv(mysqlConformanceDSNEnv))
+	if dsn == "" {
+		t.Skipf("%s not set; skipping MySQL re-encryption test", mysqlConformanceDSNEnv)
+	}
+	db := openConformanceDB(t, dsn)
+	applyConformanceSchema(t, db)
+	truncateConformanceTables(t, db)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	birthday := time.Date(1990, time.July, 1, 0, 0, 0, 0, time.UTC)
+	_, err := NewMysqlUserRepository(db).CreateUserWithVerification(ctx, ports.CreateUserWithVerificationParams{
+		User: userentity.User{
+			Username:         "legacy01",
+			Name:             "Legacy User",
+			Email:            "legacy01@example.com",
+			DocumentID:       "079090000001",
+			Birthday:         birthday,
+			PermanentAddress: "1 Legacy Street",
+			PhoneNumber:      "0900000001",
+		},
+		Login: userentity.LoginMethodPassword{UserName: "legacy01", Password: "hashed"},
+		Token: userentity.VerificationToken{
+			Token:     "legacy-token",
+			Purpose:   userentity.VerificationPurposeRegister,
+			ExpiresAt: now.Add(time.Hour),
+			CreatedAt: now,
+		},
+		OutboxEvent: userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     "user.verification.register",
+			Payload:       []byte(`{}`),
+			Status:        userentity.OutboxEventStatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		},
+	})
+	if err != nil {
+		t.Fatalf("create plaintext user: %v", err)
+	}
+
+	if _, err := db.ExecContext(ctx,
+		`INSERT INTO kyc_submissions (user_id, document_type, document_number, full_name, status)
+         SELECT id, 'id_card', '079090000001', 'Legacy User', 'pending' FROM users WHERE username = 'legacy01'`,
+	); err != nil {
+		t.Fatalf("create plaintext kyc submission: %v", err)
+	}
+	storedDocumentNumber := func() string {
+		t.Helper()
+		var number string
+		if err := db.QueryRowContext(ctx, "SELECT document_number FROM kyc_submissions").Scan(&number); err != nil {
+			t.Fatalf("read document_number: %v", err)
+		}
+		return number
+	}
+
+	storedCmnd := func() string {
+		t.Helper()
+		var cmnd string
+		if err := db.QueryRowContext(ctx, "SELECT cmnd FROM users WHERE username = 'legacy01'").Scan(&cmnd); err != nil {
+			t.Fatalf("read cmnd: %v", err)
+		}
+		return cmnd
+	}
+	if got := storedCmnd(); got != "079090000001" {
+		t.Fatalf("plaintext repository stored cmnd %q", got)
+	}
+
+	for _, step := range []struct {
+		keyID  string
+		prefix string
+	}{
+		{"k1", "enc:v1:k1:"},
+		{"k2", "enc:v1:k2:"},
+	} {
+		repo := NewMysqlUserRepositoryWithCipher(db, newConformanceCipher(t, step.keyID))
+		rewritten, err := repo.ReencryptUsers(ctx, 1)
+		if err != nil {
+			t.Fatalf("reencrypt under %s: %v", step.keyID, err)
+		}
+		if rewritten != 1 {
+			t.Fatalf("reencrypt under %s rewrote %d rows, want 1", step.keyID, rewritten)
+		}
+		if got := storedCmnd(); !strings.HasPrefix(got, step.prefix) {
+			t.Fatalf("cmnd after reencrypt under %s = %q", step.keyID, got)
+		}
+		if rewritten, err = repo.ReencryptUsers(ctx, 1); err != nil || rewritten != 0 {
+			t.Fatalf("second run under %s = %d, %v; want 0, nil", step.keyID, rewritten, err)
+		}
+
+		rewritten, err = repo.ReencryptKycSubmissions(ctx, 1)
+		if err != nil {
+			t.Fatalf("reencrypt kyc under %s: %v", step.keyID, err)
+		}
+		if rewritten != 1 {
+			t.Fatalf("reencrypt kyc under %s rewrote %d rows, want 1", step.keyID, rewritten)
+		}
+		if got := storedDocumentNumber(); !strings.HasPrefix(got, step.prefix) {
+			t.Fatalf("document_number after reencrypt under %s = %q", step.keyID, got)
+		}
+		if rewritten, err = repo.ReencryptKycSubmissions(ctx, 1); err != nil || rewritten != 0 {
+			t.Fatalf("second kyc run under %s = %d, %v; want 0, nil", step.keyID, rewritten, err)
+		}
+
+		u, err := repo.GetUser(ctx, "legacy01")
+		if err != nil {
+			t.Fatalf("get user: %v", err)
+		}
+		if u.DocumentID != "079090000001" || u.PhoneNumber != "0900000001" ||
+			u.PermanentAddress != "1 Legacy Street" || !u.Birthday.Equal(birthday) {
+			t.Fatalf("decrypted user = %+v", u)
+		}
+		submission, err := repo.GetLatestKycSubmission(ctx, u.Id)
+		if err != nil {
+			t.Fatalf("get kyc submission: %v", err)
+		}
+		if submission.DocumentNumber != "079090000001" {
+			t.Fatalf("decrypted document number = %q", submission.DocumentNumber)
+		}
+		users, _, err := repo.ListUsers(ctx, ports.ListUsersParams{
+			Limit:  10,
+			Filter: ports.ListUsersFilter{DocumentID: "079090000001", PhoneNumber: "0900000001"},
+		})
+		if err != nil {
+			t.Fatalf("list by blind index: %v", err)
+		}
+		if len(users) != 1 || users[0].Username != "legacy01" {
+			t.Fatalf("list by blind index = %+v", users)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.StatementRepository = MysqlUserRepository{}
+
+const statementColumns = `id, user_id, period, period_from, period_to, opening_cash, closing_cash, fees, taxes, csv_key, pdf_key, created_at`
+
+// CreateStatement relies on uq_statements_period to keep one statement per
+// user and period.
+func (r MysqlUserRepository) CreateStatement(ctx context.Context, statement userentity.Statement) (userentity.Statement, error) {
+	if !validStatement(statement) {
+		return userentity.Statement{}, ErrInvalidStatement
+	}
+	statement.CreatedAt = orNow(statement.CreatedAt)
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO statements (user_id, period, period_from, period_to, opening_cash, closing_cash, fees, taxes, csv_key, pdf_key, created_at)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		statement.UserID, statement.Period, statement.From, statement.To, statement.OpeningCash, statement.ClosingCash,
+		statement.Fees, statement.Taxes, statement.CSVKey, statement.PDFKey, statement.CreatedAt,
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		switch {
+		case errors.As(err, &me) && me.Number == 1452:
+			return userentity.Statement{}, ErrUserNotFound
+		case errors.As(err, &me) && me.Number == 1062:
+			// Generated concurrently; the stored statement wins.
+		default:
+			return userentity.Statement{}, fmt.Errorf("insert statement: %w", err)
+		}
+	}
+	return r.GetStatement(ctx, statement.UserID, statement.Period)
+}
+
+func (r MysqlUserRepository) GetStatement(ctx context.Context, userID int64, period string) (userentity.Statement, error) {
+	var statement userentity.Statement
+	err := r.db.QueryRowContext(ctx,
+		`SELECT `+statementColumns+` FROM statements WHERE user_id = ? AND period = ?`, userID, period,
+	).Scan(
+		&statement.ID, &statement.UserID, &statement.Period, &statement.From, &statement.To, &statement.OpeningCash,
+		&statement.ClosingCash, &statement.Fees, &statement.Taxes, &statement.CSVKey, &statement.PDFKey, &statement.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.Statement{}, ErrStatementNotFound
+		}
+		return userentity.Statement{}, fmt.Errorf("query statement: %w", err)
+	}
+	return statement, nil
+}
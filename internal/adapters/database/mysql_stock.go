@@ -0,0 +1,146 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.StockRepository = MysqlUserRepository{}
+
+func (r MysqlUserRepository) GetStocksByCodes(ctx context.Context, codes []string) ([]userentity.Stock, error) {
+	if len(codes) == 0 {
+		return []userentity.Stock{}, nil
+	}
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, code, name, company_name, sector FROM stocks WHERE code IN (?`+strings.Repeat(", ?", len(codes)-1)+`)`,
+		stringArgs(codes)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query stocks: %w", err)
+	}
+	defer rows.Close()
+
+	byCode := make(map[string]userentity.Stock, len(codes))
+	for rows.Next() {
+		var stock userentity.Stock
+		if err := rows.Scan(&stock.ID, &stock.Code, &stock.Name, &stock.CompanyName, &stock.Sector); err != nil {
+			return nil, fmt.Errorf("scan stock: %w", err)
+		}
+		byCode[stock.Code] = stock
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate stocks: %w", err)
+	}
+
+	stocks := make([]userentity.Stock, 0, len(byCode))
+	for _, code := range codes {
+		if stock, ok := byCode[code]; ok {
+			stocks = append(stocks, stock)
+		}
+	}
+	return stocks, nil
+}
+
+// LatestStockQuotes reads the newest stock_prices row of every stock.
+func (r MysqlUserRepository) LatestStockQuotes(ctx context.Context, codes []string) ([]userentity.StockQuote, error) {
+	return r.latestStockQuotes(ctx, codes, "", nil)
+}
+
+// LatestStockQuotesBefore reads the newest stock_prices row of every stock
+// created before the given time.
+func (r MysqlUserRepository) LatestStockQuotesBefore(ctx context.Context, codes []string, before time.Time) ([]userentity.StockQuote, error) {
+	return r.latestStockQuotes(ctx, codes, " AND created_at < ?", []any{before})
+}
+
+// latestStockQuotes reads the newest stock_prices row of every stock among
+// the rows matching the extra condition on stock_prices.
+func (r MysqlUserRepository) latestStockQuotes(ctx context.Context, codes []string, condition string, conditionArgs []any) ([]userentity.StockQuote, error) {
+	if len(codes) == 0 {
+		return []userentity.StockQuote{}, nil
+	}
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT p.id, s.id, s.code, p.prices, p.created_at
+         FROM stocks s JOIN stock_prices p ON p.stock_id = s.id
+         WHERE s.code IN (?`+strings.Repeat(", ?", len(codes)-1)+`)
+           AND p.id = (SELECT MAX(id) FROM stock_prices WHERE stock_id = s.id`+condition+`)`,
+		append(stringArgs(codes), conditionArgs...)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query stock quotes: %w", err)
+	}
+	defer rows.Close()
+
+	byCode := make(map[string]userentity.StockQuote, len(codes))
+	for rows.Next() {
+		var quote userentity.StockQuote
+		if err := rows.Scan(&quote.ID, &quote.StockID, &quote.Code, &quote.Price, &quote.At); err != nil {
+			return nil, fmt.Errorf("scan stock quote: %w", err)
+		}
+		byCode[quote.Code] = quote
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate stock quotes: %w", err)
+	}
+
+	quotes := make([]userentity.StockQuote, 0, len(byCode))
+	for _, code := range codes {
+		if quote, ok := byCode[code]; ok {
+			quotes = append(quotes, quote)
+		}
+	}
+	return quotes, nil
+}
+
+func (r MysqlUserRepository) ListStockQuotesAfter(ctx context.Context, afterID int64, limit int) ([]userentity.StockQuote, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT p.id, s.id, s.code, p.prices, p.created_at
+         FROM stock_prices p JOIN stocks s ON s.id = p.stock_id
+         WHERE p.id > ?
+         ORDER BY p.id
+         LIMIT ?`,
+		afterID, stockQuotesLimit(limit),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query stock prices: %w", err)
+	}
+	defer rows.Close()
+
+	quotes := make([]userentity.StockQuote, 0)
+	for rows.Next() {
+		var quote userentity.StockQuote
+		if err := rows.Scan(&quote.ID, &quote.StockID, &quote.Code, &quote.Price, &quote.At); err != nil {
+			return nil, fmt.Errorf("scan stock price: %w", err)
+		}
+		quotes = append(quotes, quote)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate stock prices: %w", err)
+	}
+	return quotes, nil
+}
+
+func (r MysqlUserRepository) LastStockQuoteID(ctx context.Context) (int64, error) {
+	var id int64
+	err := r.db.QueryRowContext(ctx, `SELECT id FROM stock_prices ORDER BY id DESC LIMIT 1`).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("query last stock price: %w", err)
+	}
+	return id, nil
+}
+
+func stringArgs(values []string) []any {
+	args := make([]any, 0, len(values))
+	for _, v := range values {
+		args = append(args, v)
+	}
+	return args
+}
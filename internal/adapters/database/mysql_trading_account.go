@@ -0,0 +1,317 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.TradingAccountRepository = MysqlUserRepository{}
+
+func (r MysqlUserRepository) GetTradingAccount(ctx context.Context, userID int64) (userentity.TradingAccount, error) {
+	return loadTradingAccount(ctx, r.db, userID)
+}
+
+func (r MysqlUserRepository) SetTradingTier(ctx context.Context, userID int64, tier string, at time.Time) (userentity.TradingAccount, error) {
+	if !validTradingTier(tier) {
+		return userentity.TradingAccount{}, ErrInvalidTradingTier
+	}
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO trading_accounts (user_id, tier, updated_at) VALUES (?, ?, ?)
+         ON DUPLICATE KEY UPDATE tier = VALUES(tier), updated_at = VALUES(updated_at)`,
+		userID, tier, orNow(at),
+	)
+	if err != nil {
+		var me *mysql.MySQLError
+		if errors.As(err, &me) && me.Number == 1452 {
+			return userentity.TradingAccount{}, ErrUserNotFound
+		}
+		return userentity.TradingAccount{}, fmt.Errorf("set trading tier: %w", err)
+	}
+	return loadTradingAccount(ctx, r.db, userID)
+}
+
+func (r MysqlUserRepository) PostLedgerEntries(ctx context.Context, reference string, entries []userentity.LedgerEntry) (posted []userentity.LedgerEntry, err error) {
+	if !validLedgerEntries(reference, entries) {
+		return nil, ErrInvalidLedgerEntry
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if posted, err = ledgerEntriesByReference(ctx, tx, reference); err != nil {
+		return nil, err
+	}
+	if len(posted) == 0 {
+		entries = append([]userentity.LedgerEntry(nil), entries...)
+		for i := range entries {
+			entries[i].StockID = 0
+			if !entries[i].Cash() {
+				if entries[i].StockID, err = stockIDByCode(ctx, tx, entries[i].Code); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if posted, err = insertLedgerEntries(ctx, tx, reference, entries); err != nil {
+			var me *mysql.MySQLError
+			if errors.As(err, &me) && me.Number == 1062 {
+				// Posted concurrently under the same reference.
+				_ = tx.Rollback()
+				return ledgerEntriesByReference(ctx, r.db, reference)
+			}
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+	return posted, nil
+}
+
+func (r MysqlUserRepository) ListPendingSettlements(ctx context.Context, userID int64) ([]userentity.Settlement, error) {
+	return querySettlements(ctx, r.db, `WHERE st.user_id = ? AND st.status = 'pending' ORDER BY st.settles_on, st.id`, userID)
+}
+
+func (r MysqlUserRepository) SettleDue(ctx context.Context, through time.Time, at time.Time) (settled int, err error) {
+	through = settlementDate(through)
+	at = orNow(at)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	due, err := querySettlements(ctx, tx, `WHERE st.status = 'pending' AND st.settles_on <= ? ORDER BY st.id FOR UPDATE`, through)
+	if err != nil {
+		return 0, err
+	}
+	for _, settlement := range due {
+		entry := settlementEntry(settlement, at)
+		if _, err = insertLedgerEntries(ctx, tx, entry.Reference, []userentity.LedgerEntry{entry}); err != nil {
+			return 0, err
+		}
+		if _, err = tx.ExecContext(ctx,
+			`UPDATE settlements SET status = 'settled', settled_at = ? WHERE id = ?`,
+			at, settlement.ID,
+		); err != nil {
+			return 0, fmt.Errorf("settle: %w", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+	return len(due), nil
+}
+
+func (r MysqlUserRepository) ListLedgerEntries(ctx context.Context, params ports.ListLedgerEntriesParams) ([]userentity.LedgerEntry, error) {
+	return queryLedgerEntries(ctx, r.db,
+		`WHERE l.user_id = ? AND l.created_at >= ? AND l.created_at < ? ORDER BY l.created_at, l.id`,
+		params.UserID, params.From, params.To,
+	)
+}
+
+// insertSettlements schedules settlements, whose stock ids are set, of the
+// trade with reference.
+func insertSettlements(ctx context.Context, tx *sql.Tx, reference string, settlements []userentity.Settlement) error {
+	for leg, settlement := range settlements {
+		var stockID sql.NullInt64
+		if settlement.StockID != 0 {
+			stockID = sql.NullInt64{Int64: settlement.StockID, Valid: true}
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO settlements (user_id, stock_id, amount, reference, leg, settles_on, status, created_at)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			settlement.UserID, stockID, settlement.Amount, reference, leg, settlement.SettlesOn, string(settlement.Status), orNow(settlement.CreatedAt),
+		); err != nil {
+			return fmt.Errorf("insert settlement: %w", err)
+		}
+	}
+	return nil
+}
+
+// querySettlements returns the settlements selected by the clause that
+// follows the FROM of settlements st.
+func querySettlements(ctx context.Context, q queryer, clause string, args ...any) ([]userentity.Settlement, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT st.id, st.user_id, COALESCE(st.stock_id, 0), COALESCE(s.code, ''), st.amount, st.reference, st.settles_on, st.status, st.settled_at, st.created_at
+         FROM settlements st LEFT JOIN stocks s ON s.id = st.stock_id `+clause,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query settlements: %w", err)
+	}
+	defer rows.Close()
+
+	settlements := make([]userentity.Settlement, 0)
+	for rows.Next() {
+		var (
+			settlement userentity.Settlement
+			status     string
+			settledAt  sql.NullTime
+		)
+		if err := rows.Scan(&settlement.ID, &settlement.UserID, &settlement.StockID, &settlement.Code, &settlement.Amount, &settlement.Reference, &settlement.SettlesOn, &status, &settledAt, &settlement.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan settlement: %w", err)
+		}
+		settlement.Status = userentity.SettlementStatus(status)
+		settlement.SettledAt = settledAt.Time
+		settlements = append(settlements, settlement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate settlements: %w", err)
+	}
+	return settlements, nil
+}
+
+// insertLedgerEntries stores entries, whose stock ids are set, under
+// reference.
+func insertLedgerEntries(ctx context.Context, tx *sql.Tx, reference string, entries []userentity.LedgerEntry) ([]userentity.LedgerEntry, error) {
+	posted := make([]userentity.LedgerEntry, 0, len(entries))
+	for leg, entry := range entries {
+		entry.Reference = reference
+		entry.CreatedAt = orNow(entry.CreatedAt)
+		var stockID sql.NullInt64
+		if entry.StockID != 0 {
+			stockID = sql.NullInt64{Int64: entry.StockID, Valid: true}
+		}
+		res, err := tx.ExecContext(ctx,
+			`INSERT INTO ledger_entries (user_id, stock_id, amount, entry_type, reference, leg, note, created_at)
+             VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			entry.UserID, stockID, entry.Amount, string(entry.Type), reference, leg, entry.Note, entry.CreatedAt,
+		)
+		if err != nil {
+			var me *mysql.MySQLError
+			if errors.As(err, &me) && me.Number == 1452 {
+				return nil, ErrUserNotFound
+			}
+			return nil, fmt.Errorf("insert ledger entry: %w", err)
+		}
+		if entry.ID, err = res.LastInsertId(); err != nil {
+			return nil, fmt.Errorf("ledger entry id: %w", err)
+		}
+		posted = append(posted, entry)
+	}
+	return posted, nil
+}
+
+func ledgerEntriesByReference(ctx context.Context, q queryer, reference string) ([]userentity.LedgerEntry, error) {
+	return queryLedgerEntries(ctx, q, `WHERE l.reference = ? ORDER BY l.leg`, reference)
+}
+
+// queryLedgerEntries returns the entries selected by the clause that follows
+// the FROM of ledger_entries l.
+func queryLedgerEntries(ctx context.Context, q queryer, clause string, args ...any) ([]userentity.LedgerEntry, error) {
+	rows, err := q.QueryContext(ctx,
+		`SELECT l.id, l.user_id, COALESCE(l.stock_id, 0), COALESCE(s.code, ''), l.amount, l.entry_type, l.reference, l.note, l.created_at
+         FROM ledger_entries l LEFT JOIN stocks s ON s.id = l.stock_id `+clause,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]userentity.LedgerEntry, 0)
+	for rows.Next() {
+		var (
+			entry     userentity.LedgerEntry
+			entryType string
+		)
+		if err := rows.Scan(&entry.ID, &entry.UserID, &entry.StockID, &entry.Code, &entry.Amount, &entryType, &entry.Reference, &entry.Note, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan ledger entry: %w", err)
+		}
+		entry.Type = userentity.LedgerEntryType(entryType)
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ledger entries: %w", err)
+	}
+	return entries, nil
+}
+
+// loadTradingAccount sums the ledger, the pending settlements and the open
+// withdrawals of a user.
+// checkAccountEmpty fails with ErrAccountNotEmpty unless the account of a
+// locked user is empty, so erasing it strands nothing.
+func checkAccountEmpty(ctx context.Context, q queryer, userID int64) error {
+	account, err := loadTradingAccount(ctx, q, userID)
+	if err != nil {
+		return err
+	}
+	if !account.Empty() {
+		return ErrAccountNotEmpty
+	}
+	return nil
+}
+
+func loadTradingAccount(ctx context.Context, q queryer, userID int64) (userentity.TradingAccount, error) {
+	account := userentity.TradingAccount{UserID: userID, Positions: make([]userentity.Position, 0)}
+	err := q.QueryRowContext(ctx,
+		`SELECT COALESCE(a.tier, ?) FROM users u LEFT JOIN trading_accounts a ON a.user_id = u.id WHERE u.id = ?`,
+		userentity.DefaultTradingTier, userID,
+	).Scan(&account.Tier)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return userentity.TradingAccount{}, ErrUserNotFound
+		}
+		return userentity.TradingAccount{}, fmt.Errorf("query trading account: %w", err)
+	}
+
+	rows, err := q.QueryContext(ctx,
+		`SELECT b.stock_id, COALESCE(s.code, ''), CAST(SUM(b.settled) AS SIGNED), CAST(SUM(b.pending) AS SIGNED)
+         FROM (
+             SELECT COALESCE(stock_id, 0) AS stock_id, amount AS settled, 0 AS pending FROM ledger_entries WHERE user_id = ?
+             UNION ALL
+             SELECT COALESCE(stock_id, 0), 0, amount FROM settlements WHERE user_id = ? AND status = 'pending'
+         ) b LEFT JOIN stocks s ON s.id = b.stock_id
+         GROUP BY b.stock_id, s.code
+         ORDER BY s.code`,
+		userID, userID,
+	)
+	if err != nil {
+		return userentity.TradingAccount{}, fmt.Errorf("query balances: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var position userentity.Position
+		if err := rows.Scan(&position.StockID, &position.Code, &position.Quantity, &position.Pending); err != nil {
+			return userentity.TradingAccount{}, fmt.Errorf("scan balance: %w", err)
+		}
+		switch {
+		case position.StockID == 0:
+			account.Cash, account.PendingCash = position.Quantity, position.Pending
+		case position.Quantity != 0 || position.Pending != 0:
+			account.Positions = append(account.Positions, position)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return userentity.TradingAccount{}, fmt.Errorf("iterate balances: %w", err)
+	}
+
+	err = q.QueryRowContext(ctx,
+		`SELECT CAST(COALESCE(SUM(amount), 0) AS SIGNED) FROM payments
+         WHERE user_id = ? AND kind = 'withdrawal' AND status IN ('pending','awaiting_approval','processing')`,
+		userID,
+	).Scan(&account.Withheld)
+	if err != nil {
+		return userentity.TradingAccount{}, fmt.Errorf("query withheld cash: %w", err)
+	}
+	return account, nil
+}
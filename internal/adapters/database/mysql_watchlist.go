@@ -0,0 +1,361 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	mysql "github.com/go-sql-driver/mysql"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var _ ports.WatchlistRepository = MysqlUserRepository{}
+
+const watchlistColumns = `id, user_id, name, created_at, updated_at`
+
+// CreateWatchlist stores an empty watchlist; uq_watchlists_user_name rejects
+// duplicate names.
+func (r MysqlUserRepository) CreateWatchlist(ctx context.Context, watchlist userentity.Watchlist) (userentity.Watchlist, error) {
+	if !validWatchlist(watchlist) {
+		return userentity.Watchlist{}, ErrInvalidWatchlist
+	}
+	watchlist.CreatedAt = orNow(watchlist.CreatedAt)
+
+	res, err := r.db.ExecContext(ctx,
+		`INSERT INTO watchlists (user_id, name, created_at, updated_at) VALUES (?, ?, ?, ?)`,
+		watchlist.UserID, watchlist.Name, watchlist.CreatedAt, watchlist.CreatedAt,
+	)
+	if err != nil {
+		return userentity.Watchlist{}, watchlistWriteError("insert watchlist", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("watchlist id: %w", err)
+	}
+	return r.loadWatchlist(ctx, r.db, id)
+}
+
+func (r MysqlUserRepository) GetWatchlist(ctx context.Context, watchlistID int64) (userentity.Watchlist, error) {
+	return r.loadWatchlist(ctx, r.db, watchlistID)
+}
+
+func (r MysqlUserRepository) ListWatchlists(ctx context.Context, userID int64) ([]userentity.Watchlist, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+watchlistColumns+` FROM watchlists WHERE user_id = ? ORDER BY id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query watchlists: %w", err)
+	}
+	defer rows.Close()
+	watchlists, err := scanWatchlists(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(watchlists) == 0 {
+		return watchlists, nil
+	}
+
+	codeRows, err := r.db.QueryContext(ctx,
+		`SELECT ws.watchlist_id, s.code
+         FROM watchlist_stocks ws
+         JOIN watchlists w ON w.id = ws.watchlist_id
+         JOIN stocks s ON s.id = ws.stock_id
+         WHERE w.user_id = ?
+         ORDER BY ws.watchlist_id, ws.sort_order`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query watchlist stocks: %w", err)
+	}
+	defer codeRows.Close()
+	codes := make(map[int64][]string, len(watchlists))
+	for codeRows.Next() {
+		var (
+			watchlistID int64
+			code        string
+		)
+		if err := codeRows.Scan(&watchlistID, &code); err != nil {
+			return nil, fmt.Errorf("scan watchlist stock: %w", err)
+		}
+		codes[watchlistID] = append(codes[watchlistID], code)
+	}
+	if err := codeRows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate watchlist stocks: %w", err)
+	}
+	for i := range watchlists {
+		if c, ok := codes[watchlists[i].ID]; ok {
+			watchlists[i].Codes = c
+		}
+	}
+	return watchlists, nil
+}
+
+func (r MysqlUserRepository) RenameWatchlist(ctx context.Context, watchlistID int64, name string, at time.Time) (userentity.Watchlist, error) {
+	if !validWatchlistName(name) {
+		return userentity.Watchlist{}, ErrInvalidWatchlist
+	}
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE watchlists SET name = ?, updated_at = ? WHERE id = ?`,
+		name, orNow(at), watchlistID,
+	); err != nil {
+		return userentity.Watchlist{}, watchlistWriteError("rename watchlist", err)
+	}
+	// A rename to the current name affects no rows, so existence is checked
+	// by reading the watchlist back.
+	return r.loadWatchlist(ctx, r.db, watchlistID)
+}
+
+func (r MysqlUserRepository) DeleteWatchlist(ctx context.Context, watchlistID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM watchlist_stocks WHERE watchlist_id = ?`, watchlistID); err != nil {
+		return fmt.Errorf("delete watchlist stocks: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM watchlists WHERE id = ?`, watchlistID)
+	if err != nil {
+		return fmt.Errorf("delete watchlist: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete watchlist rows affected: %w", err)
+	}
+	if deleted == 0 {
+		err = ErrWatchlistNotFound
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+// AddWatchlistStock appends the code under the watchlist row lock, so
+// concurrent additions get distinct positions.
+func (r MysqlUserRepository) AddWatchlistStock(ctx context.Context, watchlistID int64, code string, at time.Time) (userentity.Watchlist, error) {
+	return r.updateWatchlistStocks(ctx, watchlistID, at, func(tx *sql.Tx, at time.Time) error {
+		stockID, err := stockIDByCode(ctx, tx, code)
+		if err != nil {
+			return err
+		}
+		var position int64
+		if err := tx.QueryRowContext(ctx,
+			`SELECT COALESCE(MAX(sort_order), 0) FROM watchlist_stocks WHERE watchlist_id = ?`, watchlistID,
+		).Scan(&position); err != nil {
+			return fmt.Errorf("query watchlist order: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO watchlist_stocks (watchlist_id, stock_id, sort_order, created_at) VALUES (?, ?, ?, ?)`,
+			watchlistID, stockID, position+1, at,
+		); err != nil {
+			var me *mysql.MySQLError
+			if errors.As(err, &me) && me.Number == 1062 {
+				return ErrWatchlistStockExists
+			}
+			return fmt.Errorf("insert watchlist stock: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r MysqlUserRepository) RemoveWatchlistStock(ctx context.Context, watchlistID int64, code string, at time.Time) (userentity.Watchlist, error) {
+	return r.updateWatchlistStocks(ctx, watchlistID, at, func(tx *sql.Tx, _ time.Time) error {
+		stockID, err := stockIDByCode(ctx, tx, code)
+		if err != nil {
+			return err
+		}
+		res, err := tx.ExecContext(ctx, `DELETE FROM watchlist_stocks WHERE watchlist_id = ? AND stock_id = ?`, watchlistID, stockID)
+		if err != nil {
+			return fmt.Errorf("delete watchlist stock: %w", err)
+		}
+		removed, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("delete watchlist stock rows affected: %w", err)
+		}
+		if removed == 0 {
+			return ErrWatchlistStockNotFound
+		}
+		return nil
+	})
+}
+
+// ReorderWatchlist renumbers the positions under the watchlist row lock, so
+// the check against the current codes cannot race with additions.
+func (r MysqlUserRepository) ReorderWatchlist(ctx context.Context, watchlistID int64, codes []string, at time.Time) (userentity.Watchlist, error) {
+	return r.updateWatchlistStocks(ctx, watchlistID, at, func(tx *sql.Tx, _ time.Time) error {
+		rows, err := tx.QueryContext(ctx,
+			`SELECT ws.stock_id, s.code FROM watchlist_stocks ws JOIN stocks s ON s.id = ws.stock_id
+             WHERE ws.watchlist_id = ? ORDER BY ws.sort_order`,
+			watchlistID,
+		)
+		if err != nil {
+			return fmt.Errorf("query watchlist stocks: %w", err)
+		}
+		var current []string
+		stockIDs := make(map[string]int64)
+		for rows.Next() {
+			var (
+				stockID int64
+				code    string
+			)
+			if err := rows.Scan(&stockID, &code); err != nil {
+				rows.Close()
+				return fmt.Errorf("scan watchlist stock: %w", err)
+			}
+			current = append(current, code)
+			stockIDs[code] = stockID
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("iterate watchlist stocks: %w", err)
+		}
+		if !sameCodes(current, codes) {
+			return ErrWatchlistOrderMismatch
+		}
+
+		for i, code := range codes {
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE watchlist_stocks SET sort_order = ? WHERE watchlist_id = ? AND stock_id = ?`,
+				i+1, watchlistID, stockIDs[code],
+			); err != nil {
+				return fmt.Errorf("update watchlist order: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// updateWatchlistStocks runs change with the watchlist row locked, touches
+// updated_at and returns the changed watchlist. change gets at, defaulted to
+// the current time.
+func (r MysqlUserRepository) updateWatchlistStocks(ctx context.Context, watchlistID int64, at time.Time, change func(tx *sql.Tx, at time.Time) error) (userentity.Watchlist, error) {
+	at = orNow(at)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM watchlists WHERE id = ? FOR UPDATE`, watchlistID).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrWatchlistNotFound
+			return userentity.Watchlist{}, err
+		}
+		return userentity.Watchlist{}, fmt.Errorf("lock watchlist: %w", err)
+	}
+	if err = change(tx, at); err != nil {
+		return userentity.Watchlist{}, err
+	}
+	if err = touchWatchlist(ctx, tx, watchlistID, at); err != nil {
+		return userentity.Watchlist{}, err
+	}
+
+	watchlist, err := r.loadWatchlist(ctx, tx, watchlistID)
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("commit tx: %w", err)
+	}
+	return watchlist, nil
+}
+
+func touchWatchlist(ctx context.Context, tx *sql.Tx, watchlistID int64, at time.Time) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE watchlists SET updated_at = ? WHERE id = ?`, at, watchlistID); err != nil {
+		return fmt.Errorf("touch watchlist: %w", err)
+	}
+	return nil
+}
+
+func (r MysqlUserRepository) loadWatchlist(ctx context.Context, q queryer, watchlistID int64) (userentity.Watchlist, error) {
+	rows, err := q.QueryContext(ctx, `SELECT `+watchlistColumns+` FROM watchlists WHERE id = ?`, watchlistID)
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("query watchlist: %w", err)
+	}
+	watchlists, err := scanWatchlists(rows)
+	rows.Close()
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	if len(watchlists) == 0 {
+		return userentity.Watchlist{}, ErrWatchlistNotFound
+	}
+	watchlist := watchlists[0]
+
+	codeRows, err := q.QueryContext(ctx,
+		`SELECT s.code FROM watchlist_stocks ws JOIN stocks s ON s.id = ws.stock_id
+         WHERE ws.watchlist_id = ? ORDER BY ws.sort_order`,
+		watchlistID,
+	)
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("query watchlist stocks: %w", err)
+	}
+	defer codeRows.Close()
+	for codeRows.Next() {
+		var code string
+		if err := codeRows.Scan(&code); err != nil {
+			return userentity.Watchlist{}, fmt.Errorf("scan watchlist stock: %w", err)
+		}
+		watchlist.Codes = append(watchlist.Codes, code)
+	}
+	if err := codeRows.Err(); err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("iterate watchlist stocks: %w", err)
+	}
+	return watchlist, nil
+}
+
+// scanWatchlists reads watchlist rows with empty code lists.
+func scanWatchlists(rows *sql.Rows) ([]userentity.Watchlist, error) {
+	watchlists := make([]userentity.Watchlist, 0)
+	for rows.Next() {
+		watchlist := userentity.Watchlist{Codes: []string{}}
+		if err := rows.Scan(&watchlist.ID, &watchlist.UserID, &watchlist.Name, &watchlist.CreatedAt, &watchlist.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan watchlist: %w", err)
+		}
+		watchlists = append(watchlists, watchlist)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate watchlists: %w", err)
+	}
+	return watchlists, nil
+}
+
+func stockIDByCode(ctx context.Context, q queryer, code string) (int64, error) {
+	var id int64
+	if err := q.QueryRowContext(ctx, `SELECT id FROM stocks WHERE code = ?`, code).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ErrStockNotFound
+		}
+		return 0, fmt.Errorf("query stock: %w", err)
+	}
+	return id, nil
+}
+
+// watchlistWriteError maps duplicate names and unknown users of a watchlists
+// insert or update.
+func watchlistWriteError(op string, err error) error {
+	var me *mysql.MySQLError
+	if errors.As(err, &me) {
+		switch me.Number {
+		case 1062:
+			return ErrWatchlistNameTaken
+		case 1452:
+			return ErrUserNotFound
+		}
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
@@ -0,0 +1,109 @@
+package database
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+const (
+	// newsTitleMaxLen is the length of the news.title column.
+	newsTitleMaxLen = 255
+	// newsTermMinLen and newsTermMaxLen bound the words kept in the search
+	// index; newsTermMaxLen is the length of the news_terms.term column.
+	newsTermMinLen = 2
+	newsTermMaxLen = 64
+)
+
+func validNews(news userentity.News) bool {
+	return news.Category.Valid() &&
+		news.Title != "" && utf8.RuneCountInString(news.Title) <= newsTitleMaxLen &&
+		!news.PublishedAt.IsZero()
+}
+
+// newsCodes returns the distinct codes, sorted.
+func newsCodes(codes []string) []string {
+	seen := make(map[string]bool, len(codes))
+	out := make([]string, 0, len(codes))
+	for _, code := range codes {
+		if !seen[code] {
+			seen[code] = true
+			out = append(out, code)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// newsFolder strips diacritics, so "Ngân hàng" and "ngan hang" index the
+// same words.
+var newsFolder = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// newsTerms splits text into the distinct lower-case, diacritic-free words of
+// the search index. Words shorter than newsTermMinLen are dropped; longer
+// than newsTermMaxLen are cut.
+func newsTerms(text string) []string {
+	text = strings.NewReplacer("đ", "d", "Đ", "d").Replace(text)
+	if folded, _, err := transform.String(newsFolder, text); err == nil {
+		text = folded
+	}
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]bool, len(words))
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if utf8.RuneCountInString(word) < newsTermMinLen {
+			continue
+		}
+		if r := []rune(word); len(r) > newsTermMaxLen {
+			word = string(r[:newsTermMaxLen])
+		}
+		if !seen[word] {
+			seen[word] = true
+			terms = append(terms, word)
+		}
+	}
+	sort.Strings(terms)
+	return terms
+}
+
+// newsDocumentTerms returns the indexed words of an item.
+func newsDocumentTerms(news userentity.News) []string {
+	return newsTerms(news.Title + " " + news.Summary)
+}
+
+// matchesNewsQuery reports whether every query word starts one of terms.
+func matchesNewsQuery(terms, query []string) bool {
+	for _, q := range query {
+		found := false
+		for _, term := range terms {
+			if strings.HasPrefix(term, q) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// newsListLimit bounds a page of ListNews.
+func newsListLimit(limit int) int {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
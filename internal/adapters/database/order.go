@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	// orderReasonMaxLen is the length of the orders.reason column.
+	orderReasonMaxLen = 255
+	// orderReasonAccountInactive is the reason of the orders cancelled when
+	// their owner's account leaves active.
+	orderReasonAccountInactive = "account is no longer active"
+	// orderExecutionIDMaxLen is the length of the order_fills.execution_id
+	// column.
+	orderExecutionIDMaxLen = 64
+)
+
+func validOrder(order userentity.Order) bool {
+	return order.UserID > 0 && order.Code != "" &&
+		order.Side.Valid() && order.TimeInForce.Valid() &&
+		order.Price > 0 && order.Quantity > 0
+}
+
+// orderEventData is what an order event carries besides its type and
+// status; it is stored as JSON in order_events.changed_data.
+type orderEventData struct {
+	Price    int64 `json:"price,omitempty"`
+	Quantity int64 `json:"quantity,omitempty"`
+	Fee      int64 `json:"fee,omitempty"`
+	Tax      int64 `json:"tax,omitempty"`
+}
+
+// orderFillEventData is the data of the filled event of a fill.
+func orderFillEventData(params ports.RecordOrderFillParams) orderEventData {
+	return orderEventData{Price: params.Price, Quantity: params.Quantity, Fee: params.Charges.Fee, Tax: params.Charges.Tax}
+}
+
+func validOrderFill(params ports.RecordOrderFillParams) bool {
+	return params.ExecutionID != "" && len(params.ExecutionID) <= orderExecutionIDMaxLen &&
+		params.Price > 0 && params.Quantity > 0 &&
+		params.Charges.Fee >= 0 && params.Charges.Tax >= 0
+}
+
+func validOrderMatch(params ports.RecordOrderMatchParams) bool {
+	return params.BuyOrderID > 0 && params.SellOrderID > 0 && params.BuyOrderID != params.SellOrderID &&
+		validOrderFill(orderMatchFill(params, userentity.OrderSideBuy)) &&
+		validOrderFill(orderMatchFill(params, userentity.OrderSideSell))
+}
+
+// orderMatchFill is the fill a match records on its order on side.
+func orderMatchFill(params ports.RecordOrderMatchParams, side userentity.OrderSide) ports.RecordOrderFillParams {
+	fill := ports.RecordOrderFillParams{
+		OrderID:     params.BuyOrderID,
+		ExecutionID: params.ExecutionID,
+		Price:       params.Price,
+		Quantity:    params.Quantity,
+		Charges:     params.BuyCharges,
+		At:          params.At,
+		SettlesOn:   params.SettlesOn,
+	}
+	if side == userentity.OrderSideSell {
+		fill.OrderID, fill.Charges = params.SellOrderID, params.SellCharges
+	}
+	return fill
+}
+
+// priceFill runs pricer on a fill of order and checks what it charges.
+func priceFill(ctx context.Context, order userentity.Order, pricer ports.FillPricer, price, quantity int64, at time.Time) (userentity.FeeCharges, error) {
+	charges, err := pricer(ctx, order, price, quantity, at)
+	if err != nil {
+		return userentity.FeeCharges{}, err
+	}
+	if charges.Fee < 0 || charges.Tax < 0 {
+		return userentity.FeeCharges{}, ErrInvalidOrder
+	}
+	return charges, nil
+}
+
+// fillableOrder checks that order can take a fill of quantity.
+func fillableOrder(order userentity.Order, quantity int64) error {
+	if !order.Status.Open() {
+		return ErrOrderNotOpen
+	}
+	if quantity > order.Remaining() {
+		return ErrOrderOverfill
+	}
+	return nil
+}
+
+// validOrderClose reports whether status is a final status an open order
+// can be moved to by CloseOrder.
+func validOrderClose(status userentity.OrderStatus, reason string) bool {
+	switch status {
+	case userentity.OrderStatusCancelled, userentity.OrderStatusRejected, userentity.OrderStatusExpired:
+		return len(reason) <= orderReasonMaxLen
+	}
+	return false
+}
+
+// orderCloseEvent is the event type recorded when an order moves to status.
+func orderCloseEvent(status userentity.OrderStatus) userentity.OrderEventType {
+	switch status {
+	case userentity.OrderStatusCancelled:
+		return userentity.OrderEventCancelled
+	case userentity.OrderStatusRejected:
+		return userentity.OrderEventRejected
+	}
+	return userentity.OrderEventExpired
+}
+
+// orderHasStatus reports whether status is one of statuses; an empty
+// statuses matches every status.
+func orderHasStatus(statuses []userentity.OrderStatus, status userentity.OrderStatus) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func orderListLimit(limit int) int {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	// paymentIdempotencyKeyMaxLen is the length of the
+	// payments.idempotency_key column.
+	paymentIdempotencyKeyMaxLen = 64
+	// paymentGatewayReferenceMaxLen is the length of the
+	// payments.gateway_reference column.
+	paymentGatewayReferenceMaxLen = 128
+	// paymentURLMaxLen is the length of the payments.payment_url column.
+	paymentURLMaxLen = 512
+	// paymentReasonMaxLen is the length of the payments.reason column.
+	paymentReasonMaxLen = 255
+)
+
+func validPayment(payment userentity.Payment) bool {
+	return payment.UserID > 0 && payment.Kind.Valid() && payment.Amount > 0 &&
+		(payment.Status == userentity.PaymentStatusPending || payment.Status == userentity.PaymentStatusAwaitingApproval) &&
+		payment.IdempotencyKey != "" && len(payment.IdempotencyKey) <= paymentIdempotencyKeyMaxLen
+}
+
+func validPaymentUpdate(params ports.UpdatePaymentParams) bool {
+	return params.Status.Valid() &&
+		len(params.GatewayReference) <= paymentGatewayReferenceMaxLen &&
+		len(params.PaymentURL) <= paymentURLMaxLen &&
+		len(params.Reason) <= paymentReasonMaxLen
+}
+
+// updatedPayment applies params to payment, checking the move is allowed.
+func updatedPayment(payment userentity.Payment, params ports.UpdatePaymentParams) (userentity.Payment, error) {
+	if !payment.Status.CanMoveTo(params.Status) {
+		return userentity.Payment{}, ErrPaymentStatusConflict
+	}
+	at := orNow(params.At)
+	payment.Status = params.Status
+	payment.UpdatedAt = at
+	if params.GatewayReference != "" {
+		payment.GatewayReference = params.GatewayReference
+	}
+	if params.PaymentURL != "" {
+		payment.PaymentURL = params.PaymentURL
+	}
+	if params.Reason != "" {
+		payment.Reason = params.Reason
+	}
+	if params.ReviewerID != 0 {
+		payment.ReviewerID = params.ReviewerID
+	}
+	if !payment.Status.Open() {
+		payment.CompletedAt = at
+	}
+	return payment, nil
+}
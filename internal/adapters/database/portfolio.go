@@ -0,0 +1,36 @@
+package database
+
+import (
+	"sort"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func validPortfolioSnapshot(snapshot userentity.PortfolioSnapshot) bool {
+	return snapshot.UserID != 0 && !snapshot.Date.IsZero()
+}
+
+// tradeDateEntries merges the ledger entries, settlement entries left out,
+// with the settlements of trades, as entries without an id posted when
+// they were scheduled, oldest first.
+func tradeDateEntries(entries []userentity.LedgerEntry, settlements []userentity.Settlement) []userentity.LedgerEntry {
+	merged := make([]userentity.LedgerEntry, 0, len(entries)+len(settlements))
+	for _, entry := range entries {
+		if entry.Type != userentity.LedgerEntrySettlement {
+			merged = append(merged, entry)
+		}
+	}
+	for _, settlement := range settlements {
+		merged = append(merged, userentity.LedgerEntry{
+			UserID:    settlement.UserID,
+			StockID:   settlement.StockID,
+			Code:      settlement.Code,
+			Amount:    settlement.Amount,
+			Type:      userentity.LedgerEntrySettlement,
+			Reference: settlement.Reference,
+			CreatedAt: settlement.CreatedAt,
+		})
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].CreatedAt.Before(merged[j].CreatedAt) })
+	return merged
+}
@@ -0,0 +1,29 @@
+package database
+
+import (
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func validPriceAlert(alert userentity.PriceAlert) bool {
+	if alert.UserID <= 0 || alert.Code == "" {
+		return false
+	}
+	switch alert.Condition {
+	case userentity.PriceAlertAbove, userentity.PriceAlertBelow:
+		return alert.TargetPrice > 0
+	case userentity.PriceAlertMove:
+		return alert.ChangeBps > 0 && alert.BasePrice > 0
+	}
+	return false
+}
+
+// stockQuotesLimit bounds a page of ListStockQuotesAfter.
+func stockQuotesLimit(limit int) int {
+	if limit <= 0 {
+		return 100
+	}
+	if limit > 1000 {
+		return 1000
+	}
+	return limit
+}
@@ -4,25 +4,39 @@
 CREATE TABLE IF NOT EXISTS users (
     id BIGINT AUTO_INCREMENT PRIMARY KEY,
     name VARCHAR(255) NOT NULL,
-    cmnd VARCHAR(50) NOT NULL,
-    birthday DATE NOT NULL,
+    cmnd VARCHAR(512) NOT NULL,
+    cmnd_bidx CHAR(32) NULL,
+    birthday VARCHAR(255) NOT NULL,
     gender ENUM('male','female') NOT NULL,
-    permanent_address VARCHAR(255) NOT NULL,
-    phone_number VARCHAR(20) NOT NULL,
+    permanent_address VARCHAR(1024) NOT NULL,
+    phone_number VARCHAR(512) NOT NULL,
+    phone_number_bidx CHAR(32) NULL,
     username VARCHAR(255) NOT NULL UNIQUE,
     password_hash VARCHAR(255) NOT NULL,
     email VARCHAR(255) NOT NULL UNIQUE,
     is_verified TINYINT(1) NOT NULL DEFAULT 0,
     verified_at TIMESTAMP NULL DEFAULT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    version BIGINT NOT NULL DEFAULT 1,
+    status ENUM('active','deactivated','pending_deletion','deleted') NOT NULL DEFAULT 'active',
+    status_changed_at TIMESTAMP NULL DEFAULT NULL,
+    deletion_scheduled_at TIMESTAMP NULL DEFAULT NULL,
+    kyc_status ENUM('none','pending','approved','rejected') NOT NULL DEFAULT 'none',
+    avatar_key VARCHAR(255) NOT NULL DEFAULT '',
+    INDEX idx_users_created_at (created_at, id),
+    INDEX idx_users_name (name, id),
+    INDEX idx_users_cmnd_bidx (cmnd_bidx),
+    INDEX idx_users_phone_number_bidx (phone_number_bidx),
+    INDEX idx_users_deletion (status, deletion_scheduled_at)
 );
 
 CREATE TABLE IF NOT EXISTS user_verification_tokens (
     id BIGINT AUTO_INCREMENT PRIMARY KEY,
     user_id BIGINT NOT NULL,
     token CHAR(36) NOT NULL UNIQUE,
-    purpose ENUM('register','resend') NOT NULL,
+    purpose ENUM('register','resend','email_change') NOT NULL,
+    new_email VARCHAR(255) NULL DEFAULT NULL,
     expires_at TIMESTAMP NOT NULL,
     consumed_at TIMESTAMP NULL DEFAULT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
@@ -43,3 +57,371 @@ CREATE TABLE IF NOT EXISTS user_outbox_events (
     CONSTRAINT fk_user_outbox_events_user FOREIGN KEY (aggregate_id) REFERENCES users(id)
 );
 
+CREATE TABLE IF NOT EXISTS user_data_exports (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    format ENUM('json','zip') NOT NULL,
+    status ENUM('pending','processing','ready','failed') NOT NULL DEFAULT 'pending',
+    content LONGBLOB NULL,
+    error VARCHAR(255) NULL DEFAULT NULL,
+    completed_at TIMESTAMP NULL DEFAULT NULL,
+    expires_at TIMESTAMP NULL DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_user_data_exports_user (user_id, status),
+    INDEX idx_user_data_exports_status (status, id),
+    CONSTRAINT fk_user_data_exports_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS user_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    actor_id BIGINT NULL DEFAULT NULL,
+    action VARCHAR(32) NOT NULL DEFAULT '',
+    method VARCHAR(128) NOT NULL DEFAULT '',
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_user_events_user (user_id, id),
+    INDEX idx_user_events_actor (actor_id, id),
+    INDEX idx_user_events_action (action, id),
+    INDEX idx_user_events_request (request_id),
+    CONSTRAINT fk_user_events_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS user_event_details (
+    event_id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    changed_data JSON,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    INDEX idx_user_event_details_user (user_id),
+    CONSTRAINT fk_user_event_details_event FOREIGN KEY (event_id) REFERENCES user_events(id)
+);
+
+CREATE TABLE IF NOT EXISTS user_logging (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    type ENUM('login','logout') NOT NULL,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    device_fingerprint VARCHAR(64) NOT NULL DEFAULT '',
+    new_device TINYINT(1) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_user_logging_user (user_id, id),
+    INDEX idx_user_logging_device (user_id, device_fingerprint),
+    INDEX idx_user_logging_ip (user_id, ip),
+    CONSTRAINT fk_user_logging_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS kyc_submissions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    document_type ENUM('id_card','passport') NOT NULL,
+    document_number VARCHAR(512) NOT NULL,
+    full_name VARCHAR(255) NOT NULL DEFAULT '',
+    issued_on DATE NULL DEFAULT NULL,
+    expires_on DATE NULL DEFAULT NULL,
+    status ENUM('pending','approved','rejected') NOT NULL DEFAULT 'pending',
+    rejection_reason VARCHAR(255) NOT NULL DEFAULT '',
+    reviewer_id BIGINT NULL DEFAULT NULL,
+    reviewed_at TIMESTAMP NULL DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_kyc_submissions_status (status, id),
+    INDEX idx_kyc_submissions_user (user_id, id),
+    CONSTRAINT fk_kyc_submissions_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS kyc_documents (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    submission_id BIGINT NULL DEFAULT NULL,
+    kind ENUM('front','back','selfie') NOT NULL,
+    blob_key VARCHAR(255) NOT NULL,
+    content_type VARCHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_kyc_documents_user (user_id, id),
+    INDEX idx_kyc_documents_submission (submission_id),
+    CONSTRAINT fk_kyc_documents_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_kyc_documents_submission FOREIGN KEY (submission_id) REFERENCES kyc_submissions(id)
+);
+
+CREATE TABLE IF NOT EXISTS stocks (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    company_name VARCHAR(255) NOT NULL,
+    sector VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_stocks_code (code)
+);
+
+CREATE TABLE IF NOT EXISTS stock_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    stock_id BIGINT NOT NULL,
+    changed_data JSON,
+    reason_changed VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT fk_stock_events_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS stock_prices (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    stock_id BIGINT NOT NULL,
+    prices INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_stock_prices_stock (stock_id, id),
+    CONSTRAINT fk_stock_prices_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS watchlists (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_watchlists_user_name (user_id, name),
+    CONSTRAINT fk_watchlists_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS watchlist_stocks (
+    watchlist_id BIGINT NOT NULL,
+    stock_id BIGINT NOT NULL,
+    sort_order INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (watchlist_id, stock_id),
+    INDEX idx_watchlist_stocks_order (watchlist_id, sort_order),
+    CONSTRAINT fk_watchlist_stocks_watchlist FOREIGN KEY (watchlist_id) REFERENCES watchlists(id),
+    CONSTRAINT fk_watchlist_stocks_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS price_alerts (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    stock_id BIGINT NOT NULL,
+    alert_condition ENUM('above','below','move') NOT NULL,
+    target_price BIGINT NOT NULL DEFAULT 0,
+    change_bps INT NOT NULL DEFAULT 0,
+    base_price BIGINT NOT NULL DEFAULT 0,
+    status ENUM('active','triggered') NOT NULL DEFAULT 'active',
+    triggered_price BIGINT NOT NULL DEFAULT 0,
+    triggered_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_price_alerts_user (user_id, id),
+    INDEX idx_price_alerts_stock_status (stock_id, status),
+    CONSTRAINT fk_price_alerts_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_price_alerts_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS news (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(255) NULL,
+    metadata JSON,
+    catalog ENUM('market','company','economy','analysis','announcement') NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    sumary TEXT,
+    content MEDIUMTEXT,
+    status ENUM('published','unpublished') NOT NULL DEFAULT 'published',
+    published_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_news_external_id (external_id),
+    INDEX idx_news_published (status, published_at, id)
+);
+
+CREATE TABLE IF NOT EXISTS news_stocks (
+    news_id BIGINT NOT NULL,
+    stock_id BIGINT NOT NULL,
+    PRIMARY KEY (news_id, stock_id),
+    INDEX idx_news_stocks_stock (stock_id, news_id),
+    CONSTRAINT fk_news_stocks_news FOREIGN KEY (news_id) REFERENCES news(id),
+    CONSTRAINT fk_news_stocks_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS news_terms (
+    news_id BIGINT NOT NULL,
+    term VARCHAR(64) NOT NULL,
+    PRIMARY KEY (news_id, term),
+    INDEX idx_news_terms_term (term, news_id),
+    CONSTRAINT fk_news_terms_news FOREIGN KEY (news_id) REFERENCES news(id)
+);
+
+CREATE TABLE IF NOT EXISTS orders (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    stock_id BIGINT NOT NULL,
+    side ENUM('buy','sell') NOT NULL,
+    time_in_force ENUM('GTC','DAY','IOC','FOK') NOT NULL,
+    price BIGINT NOT NULL,
+    quantity BIGINT NOT NULL,
+    filled_quantity BIGINT NOT NULL DEFAULT 0,
+    filled_value BIGINT NOT NULL DEFAULT 0,
+    fees BIGINT NOT NULL DEFAULT 0,
+    taxes BIGINT NOT NULL DEFAULT 0,
+    status ENUM('new','partially_filled','filled','cancelled','rejected','expired') NOT NULL DEFAULT 'new',
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    priority_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_orders_user (user_id, id),
+    INDEX idx_orders_open (status, time_in_force, created_at),
+    CONSTRAINT fk_orders_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_orders_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS order_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    order_id BIGINT NOT NULL,
+    event_type ENUM('placed','amended','filled','cancelled','rejected','expired') NOT NULL,
+    status ENUM('new','partially_filled','filled','cancelled','rejected','expired') NOT NULL,
+    changed_data JSON,
+    reason_changed VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_order_events_order (order_id, id),
+    CONSTRAINT fk_order_events_order FOREIGN KEY (order_id) REFERENCES orders(id)
+);
+
+CREATE TABLE IF NOT EXISTS order_fills (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    order_id BIGINT NOT NULL,
+    execution_id VARCHAR(64) NOT NULL,
+    price BIGINT NOT NULL,
+    quantity BIGINT NOT NULL,
+    fee BIGINT NOT NULL DEFAULT 0,
+    tax BIGINT NOT NULL DEFAULT 0,
+    confirmed_at TIMESTAMP NULL DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_order_fills_execution (order_id, execution_id),
+    INDEX idx_order_fills_unconfirmed (confirmed_at, id),
+    CONSTRAINT fk_order_fills_order FOREIGN KEY (order_id) REFERENCES orders(id)
+);
+
+CREATE TABLE IF NOT EXISTS trading_accounts (
+    user_id BIGINT PRIMARY KEY,
+    tier VARCHAR(32) NOT NULL DEFAULT 'standard',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT fk_trading_accounts_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    stock_id BIGINT NULL,
+    amount BIGINT NOT NULL,
+    entry_type ENUM('deposit','withdrawal','trade','adjustment','settlement','fee','tax','corporate_action') NOT NULL,
+    reference VARCHAR(64) NOT NULL,
+    leg INT NOT NULL,
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_ledger_entries_reference (reference, leg),
+    INDEX idx_ledger_entries_user (user_id, stock_id),
+    INDEX idx_ledger_entries_user_time (user_id, created_at),
+    CONSTRAINT fk_ledger_entries_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_ledger_entries_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS settlements (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    stock_id BIGINT NULL,
+    amount BIGINT NOT NULL,
+    reference VARCHAR(64) NOT NULL,
+    leg INT NOT NULL,
+    settles_on DATE NOT NULL,
+    status ENUM('pending','settled') NOT NULL DEFAULT 'pending',
+    settled_at TIMESTAMP NULL DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_settlements_reference (reference, leg),
+    INDEX idx_settlements_due (status, settles_on, id),
+    INDEX idx_settlements_user (user_id, status),
+    CONSTRAINT fk_settlements_user FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_settlements_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS payments (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    kind ENUM('deposit','withdrawal') NOT NULL,
+    amount BIGINT NOT NULL,
+    status ENUM('pending','awaiting_approval','processing','completed','failed','rejected') NOT NULL,
+    idempotency_key VARCHAR(64) NOT NULL,
+    gateway_reference VARCHAR(128) NOT NULL DEFAULT '',
+    payment_url VARCHAR(512) NOT NULL DEFAULT '',
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    reviewer_id BIGINT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP NULL DEFAULT NULL,
+    UNIQUE KEY uq_payments_idempotency (user_id, idempotency_key),
+    INDEX idx_payments_status (status, id),
+    CONSTRAINT fk_payments_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS statements (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    period CHAR(7) NOT NULL,
+    period_from TIMESTAMP NOT NULL,
+    period_to TIMESTAMP NOT NULL,
+    opening_cash BIGINT NOT NULL,
+    closing_cash BIGINT NOT NULL,
+    fees BIGINT NOT NULL,
+    taxes BIGINT NOT NULL DEFAULT 0,
+    csv_key VARCHAR(255) NOT NULL,
+    pdf_key VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_statements_period (user_id, period),
+    CONSTRAINT fk_statements_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS fee_schedules (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    tier VARCHAR(32) NOT NULL,
+    buy_rate_bps INT NOT NULL,
+    sell_rate_bps INT NOT NULL,
+    min_fee BIGINT NOT NULL DEFAULT 0,
+    max_fee BIGINT NOT NULL DEFAULT 0,
+    sell_tax_bps INT NOT NULL DEFAULT 0,
+    volume_tiers JSON,
+    effective_from TIMESTAMP NOT NULL,
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_fee_schedules_effective (tier, effective_from)
+);
+
+CREATE TABLE IF NOT EXISTS corporate_actions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    stock_id BIGINT NOT NULL,
+    kind ENUM('cash_dividend','stock_dividend','split') NOT NULL,
+    cash_per_share BIGINT NOT NULL DEFAULT 0,
+    old_shares BIGINT NOT NULL DEFAULT 0,
+    new_shares BIGINT NOT NULL DEFAULT 0,
+    ex_date DATE NOT NULL,
+    record_date DATE NOT NULL,
+    status ENUM('scheduled','applied') NOT NULL DEFAULT 'scheduled',
+    price_numerator BIGINT NOT NULL DEFAULT 0,
+    price_denominator BIGINT NOT NULL DEFAULT 0,
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    applied_at TIMESTAMP NULL DEFAULT NULL,
+    UNIQUE KEY uq_corporate_actions_ex_date (stock_id, kind, ex_date),
+    INDEX idx_corporate_actions_due (status, ex_date, id),
+    CONSTRAINT fk_corporate_actions_stock FOREIGN KEY (stock_id) REFERENCES stocks(id)
+);
+
+CREATE TABLE IF NOT EXISTS portfolio_snapshots (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    snapshot_date DATE NOT NULL,
+    cash BIGINT NOT NULL,
+    market_value BIGINT NOT NULL,
+    net_flow BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_portfolio_snapshots_date (user_id, snapshot_date),
+    CONSTRAINT fk_portfolio_snapshots_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
@@ -0,0 +1,21 @@
+package database
+
+import (
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// statementKeyMaxLen is the length of the statements.csv_key and
+// statements.pdf_key columns.
+const statementKeyMaxLen = 255
+
+func validStatement(statement userentity.Statement) bool {
+	if _, err := time.Parse(userentity.StatementPeriodLayout, statement.Period); err != nil {
+		return false
+	}
+	return statement.UserID > 0 && len(statement.Period) == len(userentity.StatementPeriodLayout) &&
+		statement.From.Before(statement.To) &&
+		statement.CSVKey != "" && len(statement.CSVKey) <= statementKeyMaxLen &&
+		statement.PDFKey != "" && len(statement.PDFKey) <= statementKeyMaxLen
+}
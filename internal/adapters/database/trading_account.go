@@ -0,0 +1,107 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	// ledgerReferenceMaxLen is the length of the ledger_entries.reference
+	// column.
+	ledgerReferenceMaxLen = 64
+	// ledgerNoteMaxLen is the length of the ledger_entries.note column.
+	ledgerNoteMaxLen = 255
+	// tradingTierMaxLen is the length of the trading_accounts.tier column.
+	tradingTierMaxLen = 32
+)
+
+func validLedgerEntries(reference string, entries []userentity.LedgerEntry) bool {
+	if reference == "" || len(reference) > ledgerReferenceMaxLen || len(entries) == 0 {
+		return false
+	}
+	for _, entry := range entries {
+		if entry.UserID <= 0 || entry.Amount == 0 || !entry.Type.Valid() || len(entry.Note) > ledgerNoteMaxLen {
+			return false
+		}
+	}
+	return true
+}
+
+func validTradingTier(tier string) bool {
+	return tier != "" && len(tier) <= tradingTierMaxLen
+}
+
+// orderFillReference is the ledger reference of the trade of a fill.
+func orderFillReference(fillID int64) string {
+	return fmt.Sprintf("order_fill:%d", fillID)
+}
+
+// settlementReference is the ledger reference of the entry a settlement
+// posts.
+func settlementReference(settlementID int64) string {
+	return fmt.Sprintf("settlement:%d", settlementID)
+}
+
+// orderFillEntries are the postings of a fill of order: the cash paid or
+// received, the shares received or delivered and the fee and tax charged.
+// With a settlement date what the trade delivers is returned as a
+// settlement instead of an entry.
+func orderFillEntries(order userentity.Order, params ports.RecordOrderFillParams, at time.Time) ([]userentity.LedgerEntry, []userentity.Settlement) {
+	value, shares := -params.Price*params.Quantity, params.Quantity
+	if order.Side == userentity.OrderSideSell {
+		value, shares = -value, -shares
+	}
+	legs := []userentity.LedgerEntry{
+		{UserID: order.UserID, Amount: value, Type: userentity.LedgerEntryTrade, CreatedAt: at},
+		{UserID: order.UserID, StockID: order.StockID, Code: order.Code, Amount: shares, Type: userentity.LedgerEntryTrade, CreatedAt: at},
+	}
+	if params.Charges.Fee > 0 {
+		legs = append(legs, userentity.LedgerEntry{UserID: order.UserID, Amount: -params.Charges.Fee, Type: userentity.LedgerEntryFee, CreatedAt: at})
+	}
+	if params.Charges.Tax > 0 {
+		legs = append(legs, userentity.LedgerEntry{UserID: order.UserID, Amount: -params.Charges.Tax, Type: userentity.LedgerEntryTax, CreatedAt: at})
+	}
+	if params.SettlesOn.IsZero() {
+		return legs, nil
+	}
+	entries := make([]userentity.LedgerEntry, 0, len(legs)-1)
+	settlements := make([]userentity.Settlement, 0, 1)
+	for _, leg := range legs {
+		if leg.Amount < 0 {
+			entries = append(entries, leg)
+			continue
+		}
+		settlements = append(settlements, userentity.Settlement{
+			UserID:    leg.UserID,
+			StockID:   leg.StockID,
+			Code:      leg.Code,
+			Amount:    leg.Amount,
+			SettlesOn: settlementDate(params.SettlesOn),
+			Status:    userentity.SettlementStatusPending,
+			CreatedAt: at,
+		})
+	}
+	return entries, settlements
+}
+
+// settlementDate is the date of t, at midnight UTC.
+func settlementDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// settlementEntry is the ledger entry settlement posts when it settles.
+func settlementEntry(settlement userentity.Settlement, at time.Time) userentity.LedgerEntry {
+	return userentity.LedgerEntry{
+		UserID:    settlement.UserID,
+		StockID:   settlement.StockID,
+		Code:      settlement.Code,
+		Amount:    settlement.Amount,
+		Type:      userentity.LedgerEntrySettlement,
+		Reference: settlementReference(settlement.ID),
+		CreatedAt: at,
+	}
+}
@@ -0,0 +1,46 @@
+package database
+
+import (
+	"time"
+	"unicode/utf8"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// watchlistNameMaxLen is the length of the watchlists.name column.
+const watchlistNameMaxLen = 64
+
+func validWatchlistName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= watchlistNameMaxLen
+}
+
+func validWatchlist(watchlist userentity.Watchlist) bool {
+	return watchlist.UserID > 0 && validWatchlistName(watchlist.Name)
+}
+
+// sameCodes reports whether codes lists every code of current exactly once,
+// in any order.
+func sameCodes(current, codes []string) bool {
+	if len(current) != len(codes) {
+		return false
+	}
+	remaining := make(map[string]bool, len(current))
+	for _, code := range current {
+		remaining[code] = true
+	}
+	for _, code := range codes {
+		if !remaining[code] {
+			return false
+		}
+		delete(remaining, code)
+	}
+	return true
+}
+
+// orNow returns at, or the current time when at is zero.
+func orNow(at time.Time) time.Time {
+	if at.IsZero() {
+		return time.Now().UTC()
+	}
+	return at
+}
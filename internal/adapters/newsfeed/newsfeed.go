@@ -0,0 +1,211 @@
+// Package newsfeed reads news items to import from JSON files and RSS 2.0
+// feeds.
+package newsfeed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	usecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+)
+
+// Format is the layout of a news file.
+type Format string
+
+const (
+	// FormatJSON is an array of items, or an object with an "items" array,
+	// whose fields are named like those of jsonItem.
+	FormatJSON Format = "json"
+	// FormatRSS is an RSS 2.0 feed.
+	FormatRSS Format = "rss"
+)
+
+// Defaults fill in what a file does not say about its items.
+type Defaults struct {
+	Category string
+	Source   string
+}
+
+// FormatOf guesses the format of a file from its extension.
+func FormatOf(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, nil
+	case ".rss", ".xml":
+		return FormatRSS, nil
+	}
+	return "", fmt.Errorf("unknown news file format of %q: use .json, .rss or .xml", path)
+}
+
+// Parse reads the items of a file. Items are returned in file order and are
+// validated by the import, not here.
+func Parse(format Format, r io.Reader, defaults Defaults) ([]usecase.NewsInput, error) {
+	var (
+		items []usecase.NewsInput
+		err   error
+	)
+	switch format {
+	case FormatJSON:
+		items, err = parseJSON(r)
+	case FormatRSS:
+		items, err = parseRSS(r)
+	default:
+		return nil, fmt.Errorf("unknown news file format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if strings.TrimSpace(items[i].Category) == "" {
+			items[i].Category = defaults.Category
+		}
+		if strings.TrimSpace(items[i].Source) == "" {
+			items[i].Source = defaults.Source
+		}
+	}
+	return items, nil
+}
+
+type jsonItem struct {
+	ExternalID  string    `json:"external_id"`
+	Category    string    `json:"category"`
+	Title       string    `json:"title"`
+	Summary     string    `json:"summary"`
+	Content     string    `json:"content"`
+	Source      string    `json:"source"`
+	URL         string    `json:"url"`
+	Codes       []string  `json:"codes"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+func parseJSON(r io.Reader) ([]usecase.NewsInput, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read news file: %w", err)
+	}
+	var items []jsonItem
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "{") {
+		var wrapped struct {
+			Items []jsonItem `json:"items"`
+		}
+		err = json.Unmarshal(data, &wrapped)
+		items = wrapped.Items
+	} else {
+		err = json.Unmarshal(data, &items)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode news json: %w", err)
+	}
+
+	inputs := make([]usecase.NewsInput, 0, len(items))
+	for _, item := range items {
+		inputs = append(inputs, usecase.NewsInput{
+			ExternalID:  item.ExternalID,
+			Category:    item.Category,
+			Title:       item.Title,
+			Summary:     item.Summary,
+			Content:     item.Content,
+			Source:      item.Source,
+			URL:         item.URL,
+			Codes:       item.Codes,
+			PublishedAt: item.PublishedAt,
+		})
+	}
+	return inputs, nil
+}
+
+type rssFeed struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Description string        `xml:"description"`
+	Content     string        `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	GUID        string        `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Categories  []rssCategory `xml:"category"`
+}
+
+// rssCategory is a <category> of an item. Categories in the "stock" domain
+// name related stock codes; others set the news category when they name one.
+type rssCategory struct {
+	Domain string `xml:"domain,attr"`
+	Value  string `xml:",chardata"`
+}
+
+// rssDateLayouts are the pubDate layouts seen in the wild, RFC 822 first.
+var rssDateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	time.RFC3339,
+}
+
+func parseRSS(r io.Reader) ([]usecase.NewsInput, error) {
+	var feed rssFeed
+	if err := xml.NewDecoder(r).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("decode rss feed: %w", err)
+	}
+
+	inputs := make([]usecase.NewsInput, 0, len(feed.Channel.Items))
+	for _, item := range feed.Channel.Items {
+		input := usecase.NewsInput{
+			ExternalID: strings.TrimSpace(item.GUID),
+			Title:      plainText(item.Title),
+			Summary:    plainText(item.Description),
+			Content:    plainText(item.Content),
+			Source:     strings.TrimSpace(feed.Channel.Title),
+			URL:        strings.TrimSpace(item.Link),
+		}
+		if input.ExternalID == "" {
+			input.ExternalID = input.URL
+		}
+		for _, category := range item.Categories {
+			value := strings.TrimSpace(category.Value)
+			if strings.EqualFold(category.Domain, "stock") {
+				input.Codes = append(input.Codes, value)
+			} else if userentity.NewsCategory(strings.ToLower(value)).Valid() {
+				input.Category = value
+			}
+		}
+		// An unreadable date leaves the item to be published at import time.
+		date := strings.TrimSpace(item.PubDate)
+		for _, layout := range rssDateLayouts {
+			if at, err := time.Parse(layout, date); err == nil {
+				input.PublishedAt = at
+				break
+			}
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+var (
+	htmlTag          = regexp.MustCompile(`<[^>]*>`)
+	whitespace       = regexp.MustCompile(`\s+`)
+	spaceBeforePunct = regexp.MustCompile(`\s+([.,;:!?])`)
+)
+
+// plainText drops the markup feeds commonly put into titles and
+// descriptions.
+func plainText(s string) string {
+	s = html.UnescapeString(htmlTag.ReplaceAllString(s, " "))
+	s = spaceBeforePunct.ReplaceAllString(whitespace.ReplaceAllString(s, " "), "$1")
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,99 @@
+package newsfeed
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRSS(t *testing.T) {
+	feed := `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/">
+  <channel>
+    <title>Market Wire</title>
+    <item>
+      <title>Banks &amp; brokers rally</title>
+      <link>https://example.com/a</link>
+      <guid>wire-1</guid>
+      <description>&lt;p&gt;Lenders  &lt;b&gt;led&lt;/b&gt; gains.&lt;/p&gt;</description>
+      <content:encoded><![CDATA[<p>Full <i>story</i>.</p>]]></content:encoded>
+      <pubDate>Tue, 03 Mar 2026 09:15:00 +0700</pubDate>
+      <category>Company</category>
+      <category domain="stock">VCB</category>
+      <category>Vietnam</category>
+    </item>
+    <item>
+      <title>No guid</title>
+      <link>https://example.com/b</link>
+      <pubDate>yesterday</pubDate>
+    </item>
+  </channel>
+</rss>`
+	items, err := Parse(FormatRSS, strings.NewReader(feed), Defaults{Category: "market"})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("items = %d, want 2", len(items))
+	}
+
+	first := items[0]
+	if first.ExternalID != "wire-1" || first.Title != "Banks & brokers rally" || first.URL != "https://example.com/a" {
+		t.Fatalf("first item = %+v", first)
+	}
+	if first.Summary != "Lenders led gains." || first.Content != "Full story." {
+		t.Fatalf("texts = %q, %q", first.Summary, first.Content)
+	}
+	if first.Category != "Company" || len(first.Codes) != 1 || first.Codes[0] != "VCB" {
+		t.Fatalf("category %q, codes %v", first.Category, first.Codes)
+	}
+	if first.Source != "Market Wire" {
+		t.Fatalf("source = %q", first.Source)
+	}
+	want := time.Date(2026, 3, 3, 2, 15, 0, 0, time.UTC)
+	if !first.PublishedAt.Equal(want) {
+		t.Fatalf("published at %v, want %v", first.PublishedAt, want)
+	}
+
+	second := items[1]
+	if second.ExternalID != "https://example.com/b" {
+		t.Fatalf("external id falls back to the link, got %q", second.ExternalID)
+	}
+	if second.Category != "market" || !second.PublishedAt.IsZero() {
+		t.Fatalf("defaults not applied: %+v", second)
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	for name, data := range map[string]string{
+		"array":   `[{"external_id":"x1","title":"Rates cut","codes":["VCB"],"published_at":"2026-03-03T02:15:00Z"}]`,
+		"wrapped": `{"items":[{"external_id":"x1","title":"Rates cut","codes":["VCB"],"published_at":"2026-03-03T02:15:00Z"}]}`,
+	} {
+		items, err := Parse(FormatJSON, strings.NewReader(data), Defaults{Category: "economy", Source: "Desk"})
+		if err != nil {
+			t.Fatalf("%s: parse: %v", name, err)
+		}
+		if len(items) != 1 || items[0].ExternalID != "x1" || items[0].Category != "economy" || items[0].Source != "Desk" {
+			t.Fatalf("%s: items = %+v", name, items)
+		}
+		if !items[0].PublishedAt.Equal(time.Date(2026, 3, 3, 2, 15, 0, 0, time.UTC)) {
+			t.Fatalf("%s: published at %v", name, items[0].PublishedAt)
+		}
+	}
+
+	if _, err := Parse(FormatJSON, strings.NewReader(`{"items":`), Defaults{}); err == nil {
+		t.Fatal("expected a decode error")
+	}
+}
+
+func TestFormatOf(t *testing.T) {
+	for path, want := range map[string]Format{"a.json": FormatJSON, "feed.RSS": FormatRSS, "feed.xml": FormatRSS} {
+		got, err := FormatOf(path)
+		if err != nil || got != want {
+			t.Fatalf("FormatOf(%q) = %q, %v", path, got, err)
+		}
+	}
+	if _, err := FormatOf("news.csv"); err == nil {
+		t.Fatal("expected an error for .csv")
+	}
+}
@@ -1,6 +1,9 @@
 package notification
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type NoopSender struct{}
 
@@ -13,3 +16,48 @@ func (n *NoopSender) SendVerificationEmail(ctx context.Context, email, token, pu
 	_ = purpose
 	return nil
 }
+
+func (n *NoopSender) SendEmailChangeNotice(ctx context.Context, email, newEmail string) error {
+	_ = ctx
+	_ = email
+	_ = newEmail
+	return nil
+}
+
+func (n *NoopSender) SendNewLoginAlert(ctx context.Context, email, ip, userAgent string, at time.Time) error {
+	_ = ctx
+	_ = email
+	_ = ip
+	_ = userAgent
+	_ = at
+	return nil
+}
+
+func (n *NoopSender) SendKycStatusNotice(ctx context.Context, email, status, reason string) error {
+	_ = ctx
+	_ = email
+	_ = status
+	_ = reason
+	return nil
+}
+
+func (n *NoopSender) SendPriceAlert(ctx context.Context, email string, alert PriceAlertNotice) error {
+	_ = ctx
+	_ = email
+	_ = alert
+	return nil
+}
+
+func (n *NoopSender) SendTradeConfirmation(ctx context.Context, email string, trade TradeConfirmationNotice) error {
+	_ = ctx
+	_ = email
+	_ = trade
+	return nil
+}
+
+func (n *NoopSender) SendCorporateActionNotice(ctx context.Context, email string, action CorporateActionNotice) error {
+	_ = ctx
+	_ = email
+	_ = action
+	return nil
+}
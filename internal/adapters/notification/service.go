@@ -3,8 +3,10 @@ package notification
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
@@ -12,12 +14,112 @@ import (
 
 type EmailSender interface {
 	SendVerificationEmail(ctx context.Context, email, token, purpose string) error
+	// SendEmailChangeNotice tells the current address that a change to
+	// (the masked) newEmail was requested.
+	SendEmailChangeNotice(ctx context.Context, email, newEmail string) error
+	// SendNewLoginAlert tells the user about a login from a device or IP
+	// they have not used before.
+	SendNewLoginAlert(ctx context.Context, email, ip, userAgent string, at time.Time) error
+	// SendKycStatusNotice tells the user that their identity verification is
+	// pending review, approved or rejected for reason.
+	SendKycStatusNotice(ctx context.Context, email, status, reason string) error
+	// SendPriceAlert tells the user that one of their price alerts fired.
+	SendPriceAlert(ctx context.Context, email string, alert PriceAlertNotice) error
+	// SendTradeConfirmation confirms a fill of one of the user's orders.
+	SendTradeConfirmation(ctx context.Context, email string, trade TradeConfirmationNotice) error
+	// SendCorporateActionNotice tells a holder what a dividend or split of
+	// one of their stocks gave them.
+	SendCorporateActionNotice(ctx context.Context, email string, action CorporateActionNotice) error
+}
+
+// PriceAlertNotice describes a fired price alert. Prices are in VND.
+type PriceAlertNotice struct {
+	Code        string
+	Condition   string
+	TargetPrice int64
+	ChangeBps   int64
+	BasePrice   int64
+	Price       int64
+	TriggeredAt time.Time
+}
+
+// TradeConfirmationNotice describes a fill of an order. Price, the value,
+// Fee and Tax are in VND.
+type TradeConfirmationNotice struct {
+	OrderID     int64
+	ExecutionID string
+	Code        string
+	Side        string
+	Price       int64
+	Quantity    int64
+	Fee         int64
+	Tax         int64
+	FilledAt    time.Time
+}
+
+// CorporateActionNotice describes a corporate action applied to a holding.
+// Kind is cash_dividend, stock_dividend or split; CashPerShare and Cash are
+// in VND; OldShares held became NewShares. Dates are YYYY-MM-DD.
+type CorporateActionNotice struct {
+	Code           string
+	Kind           string
+	CashPerShare   int64
+	OldShares      int64
+	NewShares      int64
+	ExDate         string
+	RecordDate     string
+	HeldShares     int64
+	Cash           int64
+	ReceivedShares int64
+}
+
+// PriceAlertNotifier delivers fired price alerts on one channel, such as
+// email or push notifications.
+type PriceAlertNotifier interface {
+	NotifyPriceAlert(ctx context.Context, email string, alert PriceAlertNotice) error
+}
+
+// emailAlertNotifier delivers price alerts by email.
+type emailAlertNotifier struct {
+	sender EmailSender
+}
+
+func (n emailAlertNotifier) NotifyPriceAlert(ctx context.Context, email string, alert PriceAlertNotice) error {
+	return n.sender.SendPriceAlert(ctx, email, alert)
+}
+
+// Purposes of outbox payloads that carry no token.
+const (
+	// emailChangeNoticePurpose is delivered through SendEmailChangeNotice.
+	emailChangeNoticePurpose = "email_change_notice"
+	// newLoginPurpose is delivered through SendNewLoginAlert.
+	newLoginPurpose = "new_login"
+	// kycStatusPurpose is delivered through SendKycStatusNotice.
+	kycStatusPurpose = "kyc_status"
+	// priceAlertPurpose is delivered through the price alert notifiers.
+	priceAlertPurpose = "price_alert"
+	// tradeConfirmationPurpose is delivered through SendTradeConfirmation.
+	tradeConfirmationPurpose = "trade_confirmation"
+	// corporateActionPurpose is delivered through SendCorporateActionNotice.
+	corporateActionPurpose = "corporate_action"
+)
+
+// tokenlessPurposes are the purposes whose payloads carry no token. The
+// other purposes are delivered through SendVerificationEmail.
+var tokenlessPurposes = map[string]bool{
+	emailChangeNoticePurpose: true,
+	newLoginPurpose:          true,
+	kycStatusPurpose:         true,
+	priceAlertPurpose:        true,
+	tradeConfirmationPurpose: true,
+	corporateActionPurpose:   true,
 }
 
 type Service struct {
-	reader      *kafka.Reader
-	repo        ports.OutboxRepository
-	emailSender EmailSender
+	reader         *kafka.Reader
+	repo           ports.OutboxRepository
+	emailSender    EmailSender
+	alertNotifiers []PriceAlertNotifier
 }
 
 type Options struct {
@@ -29,9 +131,48 @@ type Options struct {
 }
 
 type outboxPayload struct {
-	Email   string `json:"email"`
-	Token   string `json:"token"`
-	Purpose string `json:"purpose"`
+	Email      string    `json:"email"`
+	Token      string    `json:"token"`
+	Purpose    string    `json:"purpose"`
+	NewEmail   string    `json:"new_email"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	LoggedInAt time.Time `json:"logged_in_at"`
+	Status     string    `json:"status"`
+	Reason     string    `json:"reason"`
+	// Price alert fields.
+	Code        string    `json:"code"`
+	Condition   string    `json:"condition"`
+	TargetPrice int64     `json:"target_price"`
+	ChangeBps   int64     `json:"change_bps"`
+	BasePrice   int64     `json:"base_price"`
+	Price       int64     `json:"price"`
+	TriggeredAt time.Time `json:"triggered_at"`
+	// Trade confirmation fields; Code and Price are shared with price
+	// alerts.
+	OrderID     int64     `json:"order_id"`
+	ExecutionID string    `json:"execution_id"`
+	Side        string    `json:"side"`
+	Quantity    int64     `json:"quantity"`
+	Fee         int64     `json:"fee"`
+	Tax         int64     `json:"tax"`
+	FilledAt    time.Time `json:"filled_at"`
+	// Corporate action fields; Code is shared with price alerts.
+	ActionKind     string `json:"action_kind"`
+	CashPerShare   int64  `json:"cash_per_share"`
+	OldShares      int64  `json:"old_shares"`
+	NewShares      int64  `json:"new_shares"`
+	ExDate         string `json:"ex_date"`
+	RecordDate     string `json:"record_date"`
+	HeldShares     int64  `json:"held_shares"`
+	Cash           int64  `json:"cash"`
+	ReceivedShares int64  `json:"received_shares"`
+}
+
+// deliverable reports whether p has an address and, unless its purpose
+// carries none, a token.
+func (p outboxPayload) deliverable() bool {
+	return p.Email != "" && (p.Token != "" || tokenlessPurposes[p.Purpose])
 }
 
 type outboxMessage struct {
@@ -69,12 +210,19 @@ func NewService(opts Options, repo ports.OutboxRepository, emailSender EmailSend
 	})
 
 	return &Service{
-		reader:      reader,
-		repo:        repo,
-		emailSender: emailSender,
+		reader:         reader,
+		repo:           repo,
+		emailSender:    emailSender,
+		alertNotifiers: []PriceAlertNotifier{emailAlertNotifier{sender: emailSender}},
 	}, nil
 }
 
+// AddPriceAlertNotifier delivers price alerts on another channel besides
+// email. It must be called before Start.
+func (s *Service) AddPriceAlertNotifier(notifier PriceAlertNotifier) {
+	s.alertNotifiers = append(s.alertNotifiers, notifier)
+}
+
 func (s *Service) Start(ctx context.Context) error {
 	slog.Info("EMAIL NOTIFIER STARTED")
 	defer slog.Info("EMAIL NOTIFIER STOPPED")
@@ -133,12 +281,12 @@ func (s *Service) handleMessage(ctx context.Context, msg kafka.Message) error {
 		return fmt.Errorf("decode payload: %w", err)
 	}
 
-	if payload.Email == "" || payload.Token == "" {
+	if !payload.deliverable() {
 		slog.Warn("EMAIL NOTIFIER SKIP", "reason", "missing email/token", "event_id", evt.ID)
 		return nil
 	}
 
-	if err := s.emailSender.SendVerificationEmail(ctx, payload.Email, payload.Token, payload.Purpose); err != nil {
+	if err := s.send(ctx, payload); err != nil {
 		if updateErr := s.repo.UpdateOutboxStatus(ctx, evt.ID, "failed"); updateErr != nil {
 			slog.Error("EMAIL OUTBOX UPDATE FAILED", "error", updateErr, "event_id", evt.ID)
 		}
@@ -153,6 +301,66 @@ func (s *Service) handleMessage(ctx context.Context, msg kafka.Message) error {
 	return nil
 }
 
+func (s *Service) send(ctx context.Context, payload outboxPayload) error {
+	switch payload.Purpose {
+	case emailChangeNoticePurpose:
+		return s.emailSender.SendEmailChangeNotice(ctx, payload.Email, payload.NewEmail)
+	case newLoginPurpose:
+		return s.emailSender.SendNewLoginAlert(ctx, payload.Email, payload.IP, payload.UserAgent, payload.LoggedInAt)
+	case kycStatusPurpose:
+		return s.emailSender.SendKycStatusNotice(ctx, payload.Email, payload.Status, payload.Reason)
+	case priceAlertPurpose:
+		return s.notifyPriceAlert(ctx, payload)
+	case tradeConfirmationPurpose:
+		return s.emailSender.SendTradeConfirmation(ctx, payload.Email, TradeConfirmationNotice{
+			OrderID:     payload.OrderID,
+			ExecutionID: payload.ExecutionID,
+			Code:        payload.Code,
+			Side:        payload.Side,
+			Price:       payload.Price,
+			Quantity:    payload.Quantity,
+			Fee:         payload.Fee,
+			Tax:         payload.Tax,
+			FilledAt:    payload.FilledAt,
+		})
+	case corporateActionPurpose:
+		return s.emailSender.SendCorporateActionNotice(ctx, payload.Email, CorporateActionNotice{
+			Code:           payload.Code,
+			Kind:           payload.ActionKind,
+			CashPerShare:   payload.CashPerShare,
+			OldShares:      payload.OldShares,
+			NewShares:      payload.NewShares,
+			ExDate:         payload.ExDate,
+			RecordDate:     payload.RecordDate,
+			HeldShares:     payload.HeldShares,
+			Cash:           payload.Cash,
+			ReceivedShares: payload.ReceivedShares,
+		})
+	}
+	return s.emailSender.SendVerificationEmail(ctx, payload.Email, payload.Token, payload.Purpose)
+}
+
+// notifyPriceAlert delivers the alert on every channel. A failing channel
+// does not keep the others from delivering; its error is returned.
+func (s *Service) notifyPriceAlert(ctx context.Context, payload outboxPayload) error {
+	alert := PriceAlertNotice{
+		Code:        payload.Code,
+		Condition:   payload.Condition,
+		TargetPrice: payload.TargetPrice,
+		ChangeBps:   payload.ChangeBps,
+		BasePrice:   payload.BasePrice,
+		Price:       payload.Price,
+		TriggeredAt: payload.TriggeredAt,
+	}
+	var errs []error
+	for _, notifier := range s.alertNotifiers {
+		if err := notifier.NotifyPriceAlert(ctx, payload.Email, alert); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (s *Service) Close() error {
 	return s.reader.Close()
 }
@@ -0,0 +1,165 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sentEmail is one call to a recordingSender: the method called and the
+// arguments the template is rendered from.
+type sentEmail struct {
+	method string
+	email  string
+	args   []any
+}
+
+// recordingSender records the emails it is asked to send.
+type recordingSender struct {
+	sent []sentEmail
+}
+
+func (r *recordingSender) record(method, email string, args ...any) error {
+	r.sent = append(r.sent, sentEmail{method: method, email: email, args: args})
+	return nil
+}
+
+func (r *recordingSender) SendVerificationEmail(_ context.Context, email, token, purpose string) error {
+	return r.record("SendVerificationEmail", email, token, purpose)
+}
+
+func (r *recordingSender) SendEmailChangeNotice(_ context.Context, email, newEmail string) error {
+	return r.record("SendEmailChangeNotice", email, newEmail)
+}
+
+func (r *recordingSender) SendNewLoginAlert(_ context.Context, email, ip, userAgent string, at time.Time) error {
+	return r.record("SendNewLoginAlert", email, ip, userAgent, at)
+}
+
+func (r *recordingSender) SendKycStatusNotice(_ context.Context, email, status, reason string) error {
+	return r.record("SendKycStatusNotice", email, status, reason)
+}
+
+func (r *recordingSender) SendPriceAlert(_ context.Context, email string, alert PriceAlertNotice) error {
+	return r.record("SendPriceAlert", email, alert)
+}
+
+func (r *recordingSender) SendTradeConfirmation(_ context.Context, email string, trade TradeConfirmationNotice) error {
+	return r.record("SendTradeConfirmation", email, trade)
+}
+
+func (r *recordingSender) SendCorporateActionNotice(_ context.Context, email string, action CorporateActionNotice) error {
+	return r.record("SendCorporateActionNotice", email, action)
+}
+
+// failingNotifier is a price alert channel that cannot deliver.
+type failingNotifier struct{}
+
+func (failingNotifier) NotifyPriceAlert(context.Context, string, PriceAlertNotice) error {
+	return errors.New("push unavailable")
+}
+
+func newTestService(sender *recordingSender) *Service {
+	return &Service{emailSender: sender, alertNotifiers: []PriceAlertNotifier{emailAlertNotifier{sender: sender}}}
+}
+
+func TestServiceSend(t *testing.T) {
+	at := time.Date(2026, 10, 19, 2, 15, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		payload outboxPayload
+		want    sentEmail
+	}{
+		{
+			name:    "registration",
+			payload: outboxPayload{Email: "a@example.com", Token: "t1", Purpose: "register"},
+			want:    sentEmail{method: "SendVerificationEmail", email: "a@example.com", args: []any{"t1", "register"}},
+		},
+		{
+			name:    "email change",
+			payload: outboxPayload{Email: "b@example.com", Token: "t2", Purpose: "email_change"},
+			want:    sentEmail{method: "SendVerificationEmail", email: "b@example.com", args: []any{"t2", "email_change"}},
+		},
+		{
+			name:    "email change notice",
+			payload: outboxPayload{Email: "a@example.com", Purpose: emailChangeNoticePurpose, NewEmail: "b***@example.com"},
+			want:    sentEmail{method: "SendEmailChangeNotice", email: "a@example.com", args: []any{"b***@example.com"}},
+		},
+		{
+			name:    "new login",
+			payload: outboxPayload{Email: "a@example.com", Purpose: newLoginPurpose, IP: "203.0.113.7", UserAgent: "curl/8", LoggedInAt: at},
+			want:    sentEmail{method: "SendNewLoginAlert", email: "a@example.com", args: []any{"203.0.113.7", "curl/8", at}},
+		},
+		{
+			name:    "kyc status",
+			payload: outboxPayload{Email: "a@example.com", Purpose: kycStatusPurpose, Status: "rejected", Reason: "blurry"},
+			want:    sentEmail{method: "SendKycStatusNotice", email: "a@example.com", args: []any{"rejected", "blurry"}},
+		},
+		{
+			name: "price alert",
+			payload: outboxPayload{Email: "a@example.com", Purpose: priceAlertPurpose, Code: "VNM", Condition: "above",
+				TargetPrice: 80000, Price: 80100, TriggeredAt: at},
+			want: sentEmail{method: "SendPriceAlert", email: "a@example.com", args: []any{PriceAlertNotice{
+				Code: "VNM", Condition: "above", TargetPrice: 80000, Price: 80100, TriggeredAt: at,
+			}}},
+		},
+		{
+			name: "trade confirmation",
+			payload: outboxPayload{Email: "a@example.com", Purpose: tradeConfirmationPurpose, OrderID: 7, ExecutionID: "e1",
+				Code: "VNM", Side: "buy", Price: 75000, Quantity: 100, Fee: 11250, FilledAt: at},
+			want: sentEmail{method: "SendTradeConfirmation", email: "a@example.com", args: []any{TradeConfirmationNotice{
+				OrderID: 7, ExecutionID: "e1", Code: "VNM", Side: "buy", Price: 75000, Quantity: 100, Fee: 11250, FilledAt: at,
+			}}},
+		},
+		{
+			name: "corporate action",
+			payload: outboxPayload{Email: "a@example.com", Purpose: corporateActionPurpose, Code: "FPT", ActionKind: "cash_dividend",
+				CashPerShare: 2000, ExDate: "2026-10-20", RecordDate: "2026-10-21", HeldShares: 100, Cash: 200000},
+			want: sentEmail{method: "SendCorporateActionNotice", email: "a@example.com", args: []any{CorporateActionNotice{
+				Code: "FPT", Kind: "cash_dividend", CashPerShare: 2000, ExDate: "2026-10-20", RecordDate: "2026-10-21",
+				HeldShares: 100, Cash: 200000,
+			}}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sender := &recordingSender{}
+			require.NoError(t, newTestService(sender).send(context.Background(), tc.payload))
+			assert.Equal(t, []sentEmail{tc.want}, sender.sent)
+		})
+	}
+}
+
+func TestServiceSend_TokenlessPurposesHaveTemplates(t *testing.T) {
+	for purpose := range tokenlessPurposes {
+		sender := &recordingSender{}
+		require.NoError(t, newTestService(sender).send(context.Background(), outboxPayload{Email: "a@example.com", Purpose: purpose}))
+		require.Len(t, sender.sent, 1, purpose)
+		assert.NotEqual(t, "SendVerificationEmail", sender.sent[0].method, "%s carries no token to verify", purpose)
+	}
+}
+
+func TestServiceSend_PriceAlertChannels(t *testing.T) {
+	sender := &recordingSender{}
+	service := newTestService(sender)
+	service.AddPriceAlertNotifier(failingNotifier{})
+
+	err := service.send(context.Background(), outboxPayload{Email: "a@example.com", Purpose: priceAlertPurpose, Code: "VNM"})
+	assert.Error(t, err)
+	assert.Len(t, sender.sent, 1, "email is delivered although another channel failed")
+}
+
+func TestOutboxPayloadDeliverable(t *testing.T) {
+	assert.True(t, outboxPayload{Email: "a@example.com", Token: "t"}.deliverable())
+	assert.False(t, outboxPayload{Email: "a@example.com", Purpose: "register"}.deliverable(), "verification needs a token")
+	assert.False(t, outboxPayload{Email: "a@example.com", Purpose: "email_change"}.deliverable())
+	assert.False(t, outboxPayload{Token: "t"}.deliverable(), "no address")
+	for purpose := range tokenlessPurposes {
+		assert.True(t, outboxPayload{Email: "a@example.com", Purpose: purpose}.deliverable(), purpose)
+		assert.False(t, outboxPayload{Purpose: purpose}.deliverable(), purpose)
+	}
+}
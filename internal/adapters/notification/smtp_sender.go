@@ -21,6 +21,7 @@ type SMTPSender struct {
     useTLS   bool
     timeout  time.Duration
     verifyURLBase string
+    emailChangeURLBase string
 }
 
 type SMTPSenderConfig struct {
@@ -34,6 +35,9 @@ type SMTPSenderConfig struct {
     // VerificationURLBase should end with "token=" so the token can be appended.
     // Example: http://127.0.0.1:18080/users/verify?token=
     VerificationURLBase string
+    // EmailChangeURLBase is the confirmation link base for email changes.
+    // Example: http://127.0.0.1:18080/users/email/confirm?token=
+    EmailChangeURLBase string
 }
 
 // NewSMTPSender constructs an SMTPSender using provided configuration.
@@ -63,6 +67,7 @@ func NewSMTPSender(cfg SMTPSenderConfig) (*SMTPSender, error) {
         useTLS:   cfg.UseTLS,
         timeout:  cfg.Timeout,
         verifyURLBase: cfg.VerificationURLBase,
+        emailChangeURLBase: cfg.EmailChangeURLBase,
     }, nil
 }
 
@@ -73,19 +78,138 @@ func (s *SMTPSender) address() string {
 // SendVerificationEmail composes and sends a verification email.
 func (s *SMTPSender) SendVerificationEmail(ctx context.Context, email, token, purpose string) error {
     subject := "Verify your account"
-    if purpose != "" && purpose != "register" {
+    intro := "Please verify your account using the information below:"
+    linkBase := s.verifyURLBase
+    switch {
+    case purpose == "email_change":
+        subject = "Confirm your new email address"
+        intro = "Please confirm your new email address using the information below:"
+        linkBase = s.emailChangeURLBase
+    case purpose != "" && purpose != "register":
         subject = fmt.Sprintf("User verification (%s)", purpose)
     }
     var link string
-    if s.verifyURLBase != "" {
+    if linkBase != "" {
         // Best effort URL composition. Expect base like: http://host/users/verify?token=
-        link = s.verifyURLBase + url.QueryEscape(token)
+        link = linkBase + url.QueryEscape(token)
     }
     body := fmt.Sprintf(
-        "Hello,\n\nPlease verify your account using the information below:\n\nToken: %s\n%s\n\nThank you.\n",
+        "Hello,\n\n%s\n\nToken: %s\n%s\n\nThank you.\n",
+        intro,
         token,
         func() string { if link != "" { return "Link: " + link } ; return "" }(),
     )
+    return s.send(ctx, email, subject, body)
+}
+
+// SendEmailChangeNotice warns the current address about a requested change.
+func (s *SMTPSender) SendEmailChangeNotice(ctx context.Context, email, newEmail string) error {
+    body := fmt.Sprintf(
+        "Hello,\n\nA request was made to change the email address of your account to %s.\nThe change only takes effect once it is confirmed from the new address.\nIf you did not request this, please change your password and contact support.\n\nThank you.\n",
+        newEmail,
+    )
+    return s.send(ctx, email, "Email change requested", body)
+}
+
+// SendNewLoginAlert warns the user about a login from a new device or IP.
+func (s *SMTPSender) SendNewLoginAlert(ctx context.Context, email, ip, userAgent string, at time.Time) error {
+    device := userAgent
+    if device == "" {
+        device = "unknown device"
+    }
+    body := fmt.Sprintf(
+        "Hello,\n\nYour account was just signed in to from a new device or location.\n\nTime: %s\nIP address: %s\nDevice: %s\n\nIf this was you, no action is needed. Otherwise, please change your password immediately and contact support.\n\nThank you.\n",
+        at.UTC().Format(time.RFC1123),
+        ip,
+        device,
+    )
+    return s.send(ctx, email, "New sign-in to your account", body)
+}
+
+// SendKycStatusNotice tells the user how their identity verification went.
+func (s *SMTPSender) SendKycStatusNotice(ctx context.Context, email, status, reason string) error {
+    var subject, body string
+    switch status {
+    case "approved":
+        subject = "Your identity has been verified"
+        body = "Hello,\n\nYour identity documents have been approved. You can now place orders.\n\nThank you.\n"
+    case "rejected":
+        subject = "Your identity verification was rejected"
+        body = fmt.Sprintf("Hello,\n\nWe could not verify your identity documents.\n\nReason: %s\n\nPlease upload new images and submit them again.\n\nThank you.\n", reason)
+    default:
+        subject = "We received your identity documents"
+        body = "Hello,\n\nWe received your identity documents and will review them shortly. We will email you once the review is done.\n\nThank you.\n"
+    }
+    return s.send(ctx, email, subject, body)
+}
+
+// SendPriceAlert tells the user that the price of a stock met their alert.
+func (s *SMTPSender) SendPriceAlert(ctx context.Context, email string, alert PriceAlertNotice) error {
+    var condition string
+    switch alert.Condition {
+    case "above":
+        condition = fmt.Sprintf("at or above your target of %d VND", alert.TargetPrice)
+    case "below":
+        condition = fmt.Sprintf("at or below your target of %d VND", alert.TargetPrice)
+    default:
+        condition = fmt.Sprintf("%.2f%% away from %d VND when you set the alert", float64(alert.ChangeBps)/100, alert.BasePrice)
+    }
+    body := fmt.Sprintf(
+        "Hello,\n\n%s traded at %d VND, %s.\n\nTime: %s\n\nThe alert has fired and will not fire again. Create a new alert to keep watching this stock.\n\nThank you.\n",
+        alert.Code,
+        alert.Price,
+        condition,
+        alert.TriggeredAt.UTC().Format(time.RFC1123),
+    )
+    return s.send(ctx, email, fmt.Sprintf("Price alert: %s at %d VND", alert.Code, alert.Price), body)
+}
+
+// SendTradeConfirmation confirms a fill of one of the user's orders.
+func (s *SMTPSender) SendTradeConfirmation(ctx context.Context, email string, trade TradeConfirmationNotice) error {
+    action := "bought"
+    if trade.Side == "sell" {
+        action = "sold"
+    }
+    body := fmt.Sprintf(
+        "Hello,\n\nYou %s %d shares of %s at %d VND.\n\nOrder: %d\nExecution: %s\nValue: %d VND\nFee: %d VND\nTax: %d VND\nTime: %s\n\nThe trade appears on your monthly statement.\n\nThank you.\n",
+        action,
+        trade.Quantity,
+        trade.Code,
+        trade.Price,
+        trade.OrderID,
+        trade.ExecutionID,
+        trade.Price*trade.Quantity,
+        trade.Fee,
+        trade.Tax,
+        trade.FilledAt.UTC().Format(time.RFC1123),
+    )
+    return s.send(ctx, email, fmt.Sprintf("Trade confirmation: %s %d %s at %d VND", action, trade.Quantity, trade.Code, trade.Price), body)
+}
+
+// SendCorporateActionNotice tells a holder what a corporate action gave them.
+func (s *SMTPSender) SendCorporateActionNotice(ctx context.Context, email string, action CorporateActionNotice) error {
+    var subject, detail string
+    switch action.Kind {
+    case "cash_dividend":
+        subject = fmt.Sprintf("Cash dividend paid: %s", action.Code)
+        detail = fmt.Sprintf("%s paid a cash dividend of %d VND per share. For your %d shares, %d VND was credited to your cash balance.", action.Code, action.CashPerShare, action.HeldShares, action.Cash)
+    case "split":
+        subject = fmt.Sprintf("Stock split: %s", action.Code)
+        detail = fmt.Sprintf("%s split every %d shares into %d. Your %d shares earned %d new shares, and past prices were adjusted to match.", action.Code, action.OldShares, action.NewShares, action.HeldShares, action.ReceivedShares)
+    default:
+        subject = fmt.Sprintf("Stock dividend paid: %s", action.Code)
+        detail = fmt.Sprintf("%s paid a stock dividend of %d new shares for every %d held. Your %d shares earned %d new shares, and past prices were adjusted to match.", action.Code, action.NewShares-action.OldShares, action.OldShares, action.HeldShares, action.ReceivedShares)
+    }
+    body := fmt.Sprintf(
+        "Hello,\n\n%s\n\nEx-date: %s\nRecord date: %s\n\nThe entry appears on your monthly statement.\n\nThank you.\n",
+        detail,
+        action.ExDate,
+        action.RecordDate,
+    )
+    return s.send(ctx, email, subject, body)
+}
+
+func (s *SMTPSender) send(ctx context.Context, email, subject, body string) error {
     msg := buildMessage(s.from, email, subject, body)
 
 	d := net.Dialer{Timeout: s.timeout}
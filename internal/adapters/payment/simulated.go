@@ -0,0 +1,207 @@
+// Package payment holds the payment gateways deposits and withdrawals go
+// through.
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	// minCallbackSecretSize is the shortest accepted callback signing secret.
+	minCallbackSecretSize = 16
+
+	// callbackAttempts is how many times a callback is posted before the
+	// simulated gateway gives up on it.
+	callbackAttempts = 3
+
+	// Callback statuses as posted to the webhook.
+	StatusConfirmed = "confirmed"
+	StatusFailed    = "failed"
+
+	// declineReason explains the payments the simulated gateway declines.
+	declineReason = "declined by the simulated gateway"
+)
+
+// ErrInvalidCallbackSignature is returned for callbacks the gateway did not
+// sign.
+var ErrInvalidCallbackSignature = errors.New("invalid payment callback signature")
+
+// SimulatedConfig configures a SimulatedGateway.
+type SimulatedConfig struct {
+	// Secret signs callbacks; at least 16 bytes.
+	Secret string
+	// CallbackURL is the webhook outcomes are posted to, for example
+	// "http://localhost:8080/api/v1/payments/callback".
+	CallbackURL string
+	// PaymentURL is the base of the payment page links returned for
+	// deposits. Empty returns no links.
+	PaymentURL string
+	// Delay is how long the gateway takes to settle a payment.
+	Delay time.Duration
+	// DeclineAbove makes payments of a larger amount fail, to exercise the
+	// failure path. Zero confirms every payment.
+	DeclineAbove int64
+	// Client posts the callbacks; nil uses a client with a 10 second
+	// timeout.
+	Client *http.Client
+}
+
+// SimulatedGateway is a stand-in for a bank gateway in development and
+// tests. It accepts every instruction and, after the configured delay,
+// posts a signed outcome to the webhook the way a real gateway would.
+type SimulatedGateway struct {
+	config  SimulatedConfig
+	secret  []byte
+	client  *http.Client
+	mu      sync.Mutex
+	started map[string]ports.PaymentInitiation
+	pending sync.WaitGroup
+}
+
+var _ ports.PaymentGateway = (*SimulatedGateway)(nil)
+
+// callbackBody is the JSON posted to the webhook. Its fields are those of
+// the HandlePaymentCallback request.
+type callbackBody struct {
+	Reference        string `json:"reference"`
+	GatewayReference string `json:"gateway_reference"`
+	Status           string `json:"status"`
+	Reason           string `json:"reason,omitempty"`
+	Signature        string `json:"signature"`
+}
+
+func NewSimulatedGateway(config SimulatedConfig) (*SimulatedGateway, error) {
+	if len(config.Secret) < minCallbackSecretSize {
+		return nil, fmt.Errorf("payment callback secret must be at least %d bytes", minCallbackSecretSize)
+	}
+	if strings.TrimSpace(config.CallbackURL) == "" {
+		return nil, errors.New("payment callback url is required")
+	}
+	client := config.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &SimulatedGateway{
+		config:  config,
+		secret:  []byte(config.Secret),
+		client:  client,
+		started: make(map[string]ports.PaymentInitiation),
+	}, nil
+}
+
+// Initiate accepts the instruction and schedules its callback. An
+// instruction for a reference seen before schedules nothing more.
+func (g *SimulatedGateway) Initiate(ctx context.Context, instruction ports.PaymentInstruction) (ports.PaymentInitiation, error) {
+	_ = ctx
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if initiation, ok := g.started[instruction.Reference]; ok {
+		return initiation, nil
+	}
+	initiation := ports.PaymentInitiation{GatewayReference: "sim-" + uuid.NewString()}
+	if g.config.PaymentURL != "" && instruction.Kind == userentity.PaymentKindDeposit {
+		initiation.PaymentURL = strings.TrimRight(g.config.PaymentURL, "/") + "/" + initiation.GatewayReference
+	}
+	g.started[instruction.Reference] = initiation
+
+	callback := ports.PaymentCallback{Reference: instruction.Reference, GatewayReference: initiation.GatewayReference, Succeeded: true}
+	if g.config.DeclineAbove > 0 && instruction.Amount > g.config.DeclineAbove {
+		callback.Succeeded, callback.Reason = false, declineReason
+	}
+	callback.Signature = g.Sign(callback)
+	g.pending.Add(1)
+	go g.deliver(callback)
+	return initiation, nil
+}
+
+// VerifyCallback checks the signature of a callback.
+func (g *SimulatedGateway) VerifyCallback(callback ports.PaymentCallback) error {
+	got, err := base64.RawURLEncoding.DecodeString(callback.Signature)
+	if err != nil || !hmac.Equal(got, g.sign(callback)) {
+		return ErrInvalidCallbackSignature
+	}
+	return nil
+}
+
+// Sign returns the signature of a callback.
+func (g *SimulatedGateway) Sign(callback ports.PaymentCallback) string {
+	return base64.RawURLEncoding.EncodeToString(g.sign(callback))
+}
+
+// Wait blocks until the scheduled callbacks are delivered or given up.
+func (g *SimulatedGateway) Wait() {
+	g.pending.Wait()
+}
+
+func (g *SimulatedGateway) sign(callback ports.PaymentCallback) []byte {
+	mac := hmac.New(sha256.New, g.secret)
+	for _, part := range []string{callback.Reference, callback.GatewayReference, callbackStatus(callback), callback.Reason} {
+		mac.Write([]byte(part))
+		mac.Write([]byte{'\n'})
+	}
+	return mac.Sum(nil)
+}
+
+// deliver posts a callback to the webhook after the delay, retrying with a
+// growing pause until the webhook answers 2xx.
+func (g *SimulatedGateway) deliver(callback ports.PaymentCallback) {
+	defer g.pending.Done()
+	body, err := json.Marshal(callbackBody{
+		Reference:        callback.Reference,
+		GatewayReference: callback.GatewayReference,
+		Status:           callbackStatus(callback),
+		Reason:           callback.Reason,
+		Signature:        callback.Signature,
+	})
+	if err != nil {
+		slog.Error("PAYMENT CALLBACK ENCODE FAILED", "reference", callback.Reference, "error", err)
+		return
+	}
+
+	pause := g.config.Delay
+	for attempt := 1; attempt <= callbackAttempts; attempt++ {
+		time.Sleep(pause)
+		if err = g.post(body); err == nil {
+			return
+		}
+		slog.Warn("PAYMENT CALLBACK FAILED", "reference", callback.Reference, "attempt", attempt, "error", err)
+		pause = max(2*pause, time.Second)
+	}
+}
+
+func (g *SimulatedGateway) post(body []byte) error {
+	resp, err := g.client.Post(g.config.CallbackURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return nil
+}
+
+func callbackStatus(callback ports.PaymentCallback) string {
+	if callback.Succeeded {
+		return StatusConfirmed
+	}
+	return StatusFailed
+}
@@ -0,0 +1,105 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func TestSimulatedGateway(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		callbacks []callbackBody
+		failures  = 1
+	)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failures > 0 {
+			failures--
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		var body callbackBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode callback: %v", err)
+		}
+		callbacks = append(callbacks, body)
+	}))
+	defer webhook.Close()
+
+	gateway, err := NewSimulatedGateway(SimulatedConfig{
+		Secret:       "0123456789abcdef0123",
+		CallbackURL:  webhook.URL,
+		PaymentURL:   "https://pay.example.com/",
+		DeclineAbove: 1_000_000,
+	})
+	if err != nil {
+		t.Fatalf("new gateway: %v", err)
+	}
+	ctx := context.Background()
+	deposit, err := gateway.Initiate(ctx, ports.PaymentInstruction{Reference: "payment:1", UserID: 7, Kind: userentity.PaymentKindDeposit, Amount: 500_000})
+	if err != nil {
+		t.Fatalf("initiate deposit: %v", err)
+	}
+	if !strings.HasPrefix(deposit.GatewayReference, "sim-") || deposit.PaymentURL != "https://pay.example.com/"+deposit.GatewayReference {
+		t.Fatalf("deposit initiation = %+v", deposit)
+	}
+	again, err := gateway.Initiate(ctx, ports.PaymentInstruction{Reference: "payment:1", UserID: 7, Kind: userentity.PaymentKindDeposit, Amount: 500_000})
+	if err != nil || again != deposit {
+		t.Fatalf("repeated initiation = %+v, %v; want %+v", again, err, deposit)
+	}
+	gateway.Wait()
+	withdrawal, err := gateway.Initiate(ctx, ports.PaymentInstruction{Reference: "payment:2", UserID: 7, Kind: userentity.PaymentKindWithdrawal, Amount: 2_000_000})
+	if err != nil {
+		t.Fatalf("initiate withdrawal: %v", err)
+	}
+	if withdrawal.PaymentURL != "" {
+		t.Errorf("withdrawal payment url = %q; want none", withdrawal.PaymentURL)
+	}
+	gateway.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(callbacks) != 2 {
+		t.Fatalf("callbacks = %+v; want one per reference, the first retried", callbacks)
+	}
+	if callbacks[0].Reference != "payment:1" || callbacks[0].GatewayReference != deposit.GatewayReference || callbacks[0].Status != StatusConfirmed {
+		t.Errorf("deposit callback = %+v", callbacks[0])
+	}
+	if callbacks[1].Status != StatusFailed || callbacks[1].Reason == "" {
+		t.Errorf("withdrawal above DeclineAbove = %+v; want failed with a reason", callbacks[1])
+	}
+
+	for _, body := range callbacks {
+		callback := ports.PaymentCallback{
+			Reference:        body.Reference,
+			GatewayReference: body.GatewayReference,
+			Succeeded:        body.Status == StatusConfirmed,
+			Reason:           body.Reason,
+			Signature:        body.Signature,
+		}
+		if err := gateway.VerifyCallback(callback); err != nil {
+			t.Errorf("verify %s: %v", body.Reference, err)
+		}
+		callback.Succeeded = !callback.Succeeded
+		if err := gateway.VerifyCallback(callback); !errors.Is(err, ErrInvalidCallbackSignature) {
+			t.Errorf("flipped outcome of %s = %v; want ErrInvalidCallbackSignature", body.Reference, err)
+		}
+	}
+
+	if _, err := NewSimulatedGateway(SimulatedConfig{Secret: "short", CallbackURL: webhook.URL}); err == nil {
+		t.Error("short secret accepted")
+	}
+	if _, err := NewSimulatedGateway(SimulatedConfig{Secret: "0123456789abcdef0123"}); err == nil {
+		t.Error("missing callback url accepted")
+	}
+}
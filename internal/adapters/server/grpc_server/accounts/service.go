@@ -0,0 +1,123 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccountService implements the AccountService gRPC API. Access to the admin
+// methods is enforced by the grpc_server authorizer; errors are mapped to
+// statuses by the grpc_server error interceptors.
+type AccountService struct {
+	user.UnimplementedAccountServiceServer
+	accountUseCase userusecase.UserTradingAccountUseCase
+}
+
+func NewAccountService(accountUseCase userusecase.UserTradingAccountUseCase) *AccountService {
+	return &AccountService{accountUseCase: accountUseCase}
+}
+
+func (s *AccountService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterAccountServiceServer(server, s)
+}
+
+func (s *AccountService) GetTradingAccount(ctx context.Context, req *user.GetTradingAccountRequest) (*user.GetTradingAccountResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	result, err := s.accountUseCase.Get(ctx, uid, req.GetUsername())
+	if err != nil {
+		return nil, fmt.Errorf("get trading account: %w", err)
+	}
+
+	data := toTradingAccount(result.Account)
+	data.BuyingPower = result.BuyingPower
+	for _, position := range data.Positions {
+		position.Available = result.Available[position.Code]
+	}
+	for _, settlement := range result.Settlements {
+		data.Settlements = append(data.Settlements, toSettlement(settlement))
+	}
+	return &user.GetTradingAccountResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    data,
+	}, nil
+}
+
+func (s *AccountService) SetTradingTier(ctx context.Context, req *user.SetTradingTierRequest) (*user.SetTradingTierResponse, error) {
+	account, err := s.accountUseCase.SetTier(ctx, req.GetUsername(), req.GetTier())
+	if err != nil {
+		return nil, fmt.Errorf("set trading tier: %w", err)
+	}
+
+	return &user.SetTradingTierResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toTradingAccount(account),
+	}, nil
+}
+
+func (s *AccountService) AdjustBalance(ctx context.Context, req *user.AdjustBalanceRequest) (*user.AdjustBalanceResponse, error) {
+	entry, err := s.accountUseCase.Adjust(ctx, req.GetUsername(), userusecase.LedgerAdjustmentInput{
+		Code:      req.GetCode(),
+		Amount:    req.GetAmount(),
+		Reference: req.GetReference(),
+		Note:      req.GetNote(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("adjust balance: %w", err)
+	}
+
+	return &user.AdjustBalanceResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toLedgerEntry(entry),
+	}, nil
+}
+
+func toTradingAccount(account userentity.TradingAccount) *user.TradingAccount {
+	positions := make([]*user.Position, 0, len(account.Positions))
+	for _, position := range account.Positions {
+		positions = append(positions, &user.Position{Code: position.Code, Quantity: position.Quantity, Pending: position.Pending})
+	}
+	return &user.TradingAccount{
+		Tier:        account.Tier,
+		Cash:        account.Cash,
+		PendingCash: account.PendingCash,
+		Withheld:    account.Withheld,
+		Positions:   positions,
+	}
+}
+
+func toSettlement(settlement userentity.Settlement) *user.Settlement {
+	return &user.Settlement{
+		Id:        settlement.ID,
+		Code:      settlement.Code,
+		Amount:    settlement.Amount,
+		Reference: settlement.Reference,
+		SettlesOn: settlement.SettlesOn.Format(time.DateOnly),
+	}
+}
+
+func toLedgerEntry(entry userentity.LedgerEntry) *user.LedgerEntry {
+	return &user.LedgerEntry{
+		Id:        entry.ID,
+		Code:      entry.Code,
+		Amount:    entry.Amount,
+		Type:      string(entry.Type),
+		Reference: entry.Reference,
+		Note:      entry.Note,
+		CreatedAt: entry.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,105 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PriceAlertService implements the PriceAlertService gRPC API. Errors are
+// mapped to statuses by the grpc_server error interceptors.
+type PriceAlertService struct {
+	user.UnimplementedPriceAlertServiceServer
+	alertUseCase userusecase.UserPriceAlertUseCase
+}
+
+func NewPriceAlertService(alertUseCase userusecase.UserPriceAlertUseCase) *PriceAlertService {
+	return &PriceAlertService{alertUseCase: alertUseCase}
+}
+
+func (s *PriceAlertService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterPriceAlertServiceServer(server, s)
+}
+
+func (s *PriceAlertService) CreatePriceAlert(ctx context.Context, req *user.CreatePriceAlertRequest) (*user.CreatePriceAlertResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	alert, err := s.alertUseCase.Create(ctx, uid, req.GetUsername(), userusecase.CreatePriceAlertInput{
+		Code:        req.GetCode(),
+		Condition:   req.GetCondition(),
+		TargetPrice: req.GetTargetPrice(),
+		ChangeBps:   req.GetChangeBps(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create price alert: %w", err)
+	}
+
+	return &user.CreatePriceAlertResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toPriceAlert(alert),
+	}, nil
+}
+
+func (s *PriceAlertService) ListPriceAlerts(ctx context.Context, req *user.ListPriceAlertsRequest) (*user.ListPriceAlertsResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	alerts, err := s.alertUseCase.List(ctx, uid, req.GetUsername())
+	if err != nil {
+		return nil, fmt.Errorf("list price alerts: %w", err)
+	}
+
+	data := make([]*user.PriceAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		data = append(data, toPriceAlert(alert))
+	}
+	return &user.ListPriceAlertsResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    data,
+	}, nil
+}
+
+func (s *PriceAlertService) DeleteAlert(ctx context.Context, req *user.DeleteAlertRequest) (*user.DeleteAlertResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	if err := s.alertUseCase.Delete(ctx, uid, req.GetUsername(), req.GetAlertId()); err != nil {
+		return nil, fmt.Errorf("delete price alert: %w", err)
+	}
+
+	return &user.DeleteAlertResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+	}, nil
+}
+
+func toPriceAlert(alert userentity.PriceAlert) *user.PriceAlert {
+	pb := &user.PriceAlert{
+		Id:             alert.ID,
+		Code:           alert.Code,
+		Condition:      string(alert.Condition),
+		TargetPrice:    alert.TargetPrice,
+		ChangeBps:      alert.ChangeBps,
+		BasePrice:      alert.BasePrice,
+		Status:         string(alert.Status),
+		TriggeredPrice: alert.TriggeredPrice,
+		CreatedAt:      alert.CreatedAt.Unix(),
+	}
+	if !alert.TriggeredAt.IsZero() {
+		pb.TriggeredAt = alert.TriggeredAt.Unix()
+	}
+	return pb
+}
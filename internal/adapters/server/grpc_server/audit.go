@@ -0,0 +1,174 @@
+package grpc_server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// AuditUnaryServerInterceptor gives every call an audit.Recorder and stores
+// the events the use cases recorded once the handler returns, whether it
+// succeeded or not (failed logins are audited too). Events are completed with
+// the caller, method, client IP, user agent and request ID. Storing them is
+// best effort: the audited action has already happened, so a failing write is
+// logged instead of failing the call.
+//
+// It must run after AuthUnaryServerInterceptor so the caller is known, and
+// after ClientInfoUnaryServerInterceptor so proxies are trusted.
+func AuditUnaryServerInterceptor(repo ports.AuditRepository) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, rec := audit.NewContext(ctx)
+		resp, err := handler(ctx, req)
+
+		events := rec.Events()
+		if len(events) == 0 {
+			return resp, err
+		}
+		actorID, _ := UserIDFromContext(ctx)
+		client := ClientInfoFromContext(ctx)
+		md, _ := metadata.FromIncomingContext(ctx)
+		requestID := firstMetadata(md, "x-request-id")
+		for i := range events {
+			if events[i].UserID == 0 {
+				events[i].UserID = actorID
+			}
+			if events[i].ActorID == 0 {
+				events[i].ActorID = actorID
+			}
+			events[i].Method = info.FullMethod
+			events[i].IP = client.IP
+			events[i].UserAgent = client.UserAgent
+			events[i].RequestID = requestID
+		}
+		if werr := repo.AppendAuditEvents(context.WithoutCancel(ctx), events); werr != nil {
+			slog.ErrorContext(ctx, "AUDIT WRITE FAILED", "method", info.FullMethod, "events", len(events), "error", werr)
+		}
+		return resp, err
+	}
+}
+
+// TrustedProxies are the networks of the proxies whose X-Forwarded-For hops
+// are believed, such as the HTTP gateway.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses proxy addresses given as IPs, such as
+// "127.0.0.1", or CIDRs, such as "10.0.0.0/8".
+func ParseTrustedProxies(specs []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(specs))
+	for _, spec := range specs {
+		spec = strings.TrimSpace(spec)
+		if ip := net.ParseIP(spec); ip != nil {
+			bits := 8 * net.IPv6len
+			if v4 := ip.To4(); v4 != nil {
+				ip, bits = v4, 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", spec)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+func (p TrustedProxies) contains(ip net.IP) bool {
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+type ctxKeyClientInfo struct{}
+
+// ClientInfoUnaryServerInterceptor resolves the client of every call once,
+// so the audit log and the login history agree on it. Hops of
+// X-Forwarded-For are only believed when they were added by proxies.
+func ClientInfoUnaryServerInterceptor(proxies TrustedProxies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(context.WithValue(ctx, ctxKeyClientInfo{}, resolveClientInfo(ctx, proxies)), req)
+	}
+}
+
+// ClientInfoFromContext returns the IP and user agent of the client that sent
+// the request, as used by the audit log. Without
+// ClientInfoUnaryServerInterceptor no proxy is trusted.
+func ClientInfoFromContext(ctx context.Context) userentity.ClientInfo {
+	if client, ok := ctx.Value(ctxKeyClientInfo{}).(userentity.ClientInfo); ok {
+		return client
+	}
+	return resolveClientInfo(ctx, nil)
+}
+
+// resolveClientInfo returns the client IP and user agent of a call. The IP is
+// the peer address unless the peer is a trusted proxy: then X-Forwarded-For
+// is read from the right, where each proxy appends the address it was called
+// from, and the first hop not added by a trusted proxy is the client. Hops
+// further left were sent by the client and are never believed. Calls through
+// the HTTP gateway carry the browser's user agent in grpcgateway-user-agent;
+// direct gRPC calls fall back to the gRPC user agent.
+func resolveClientInfo(ctx context.Context, proxies TrustedProxies) userentity.ClientInfo {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var ip net.IP
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = addrIP(p.Addr)
+	}
+	if ip != nil && proxies.contains(ip) {
+		var hops []string
+		for _, value := range md.Get("x-forwarded-for") {
+			hops = append(hops, strings.Split(value, ",")...)
+		}
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := net.ParseIP(strings.TrimSpace(hops[i]))
+			if hop == nil {
+				break
+			}
+			ip = hop
+			if !proxies.contains(hop) {
+				break
+			}
+		}
+	}
+
+	var client userentity.ClientInfo
+	if ip != nil {
+		client.IP = ip.String()
+	}
+	client.UserAgent = firstMetadata(md, "grpcgateway-user-agent")
+	if client.UserAgent == "" {
+		client.UserAgent = firstMetadata(md, "user-agent")
+	}
+	return client
+}
+
+// addrIP returns the IP of a peer address, nil when it has none.
+func addrIP(addr net.Addr) net.IP {
+	if tcp, ok := addr.(*net.TCPAddr); ok {
+		return tcp.IP
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		host = addr.String()
+	}
+	return net.ParseIP(host)
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if vals := md.Get(key); len(vals) > 0 {
+		return strings.TrimSpace(vals[0])
+	}
+	return ""
+}
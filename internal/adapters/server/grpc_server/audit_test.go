@@ -0,0 +1,191 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	userpb "github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type fakeAuditRepository struct {
+	events []userentity.AuditEvent
+	err    error
+}
+
+func (r *fakeAuditRepository) AppendAuditEvents(_ context.Context, events []userentity.AuditEvent) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *fakeAuditRepository) ListAuditEvents(context.Context, ports.ListAuditEventsParams) ([]userentity.AuditEvent, error) {
+	return r.events, nil
+}
+
+func TestAuditUnaryServerInterceptor(t *testing.T) {
+	repo := &fakeAuditRepository{}
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/Update"}
+	// The gateway appends the address it was called from to what the client
+	// sent.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-forwarded-for", "198.51.100.1, 203.0.113.7",
+		"grpcgateway-user-agent", "Mozilla/5.0",
+		"user-agent", "grpc-go/1.0",
+		"x-request-id", "req-42",
+	))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 40000}})
+	ctx = context.WithValue(ctx, ctxKeyClaims{}, &security.AccessTokenClaims{UserID: 7})
+	handlerErr := errors.New("boom")
+	proxies, err := ParseTrustedProxies([]string{"127.0.0.1"})
+	require.NoError(t, err)
+
+	_, err = ClientInfoUnaryServerInterceptor(proxies)(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return AuditUnaryServerInterceptor(repo)(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			audit.Record(ctx, userentity.AuditEvent{Action: userentity.AuditActionProfileUpdate})
+			audit.Record(ctx, userentity.AuditEvent{UserID: 9, Action: userentity.AuditActionDeactivate})
+			return nil, handlerErr
+		})
+	})
+	require.ErrorIs(t, err, handlerErr, "the handler result is returned unchanged")
+
+	require.Len(t, repo.events, 2, "events are stored even when the call fails")
+	self, other := repo.events[0], repo.events[1]
+	require.Equal(t, int64(7), self.UserID)
+	require.Equal(t, int64(7), self.ActorID)
+	require.Equal(t, int64(9), other.UserID)
+	require.Equal(t, int64(7), other.ActorID)
+	for _, event := range repo.events {
+		require.Equal(t, info.FullMethod, event.Method)
+		require.Equal(t, "203.0.113.7", event.IP)
+		require.Equal(t, "Mozilla/5.0", event.UserAgent)
+		require.Equal(t, "req-42", event.RequestID)
+	}
+}
+
+func TestAuditUnaryServerInterceptorDirectCall(t *testing.T) {
+	repo := &fakeAuditRepository{}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "grpc-go/1.0"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.4"), Port: 51234}})
+
+	_, err := AuditUnaryServerInterceptor(repo)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/user.UserService/Register"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		audit.Record(ctx, userentity.AuditEvent{UserID: 3, Action: userentity.AuditActionRegister})
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	require.Len(t, repo.events, 1)
+	require.Zero(t, repo.events[0].ActorID, "anonymous calls have no actor")
+	require.Equal(t, "198.51.100.4", repo.events[0].IP)
+	require.Equal(t, "grpc-go/1.0", repo.events[0].UserAgent)
+}
+
+func TestClientInfoFromContext(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"127.0.0.1", "10.0.0.0/8"})
+	require.NoError(t, err)
+	_, err = ParseTrustedProxies([]string{"gateway"})
+	require.Error(t, err)
+
+	cases := []struct {
+		name      string
+		peer      string
+		forwarded string
+		want      string
+	}{
+		{name: "direct call", peer: "198.51.100.4", want: "198.51.100.4"},
+		{name: "direct call with a forged header", peer: "198.51.100.4", forwarded: "203.0.113.9", want: "198.51.100.4"},
+		{name: "through the gateway", peer: "127.0.0.1", forwarded: "203.0.113.7", want: "203.0.113.7"},
+		{name: "forged hop before the gateway's", peer: "127.0.0.1", forwarded: "203.0.113.9, 203.0.113.7", want: "203.0.113.7"},
+		{name: "through a trusted load balancer", peer: "127.0.0.1", forwarded: "203.0.113.9, 203.0.113.7, 10.1.2.3", want: "203.0.113.7"},
+		{name: "malformed hop", peer: "127.0.0.1", forwarded: "203.0.113.9, not-an-ip, 10.1.2.3", want: "10.1.2.3"},
+		{name: "gateway without a header", peer: "127.0.0.1", want: "127.0.0.1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.forwarded != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", tc.forwarded))
+			}
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tc.peer), Port: 40000}})
+			_, err := ClientInfoUnaryServerInterceptor(proxies)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				require.Equal(t, tc.want, ClientInfoFromContext(ctx).IP)
+				return nil, nil
+			})
+			require.NoError(t, err)
+		})
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "203.0.113.9"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 40000}})
+	require.Equal(t, "127.0.0.1", ClientInfoFromContext(ctx).IP, "no proxy is trusted without the interceptor")
+}
+
+func TestAuditUnaryServerInterceptorWriteFailure(t *testing.T) {
+	repo := &fakeAuditRepository{err: errors.New("database down")}
+	resp, err := AuditUnaryServerInterceptor(repo)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/user.UserService/Logout"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		audit.Record(ctx, userentity.AuditEvent{UserID: 1, Action: userentity.AuditActionLogout})
+		return "ok", nil
+	})
+	require.NoError(t, err, "a failed audit write must not fail the call")
+	require.Equal(t, "ok", resp)
+}
+
+func TestAuthUnaryServerInterceptorAdminOnly(t *testing.T) {
+	tokens, err := security.NewJWTManager("unit-test-secret-must-be-long-123456", "test-issuer", "test-aud", 15*time.Minute)
+	require.NoError(t, err)
+	interceptor := AuthUnaryServerInterceptor(tokens, []int64{1})
+	info := &grpc.UnaryServerInfo{FullMethod: userpb.UserService_ListAuditEvents_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	call := func(userID int64) error {
+		token, _, err := tokens.GenerateAccessToken(userID, "someone")
+		require.NoError(t, err)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		_, err = interceptor(ctx, nil, info, handler)
+		return err
+	}
+
+	require.NoError(t, call(1))
+	require.Equal(t, codes.PermissionDenied, status.Code(call(2)))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s fakeServerStream) Context() context.Context { return s.ctx }
+
+func TestAuthStreamServerInterceptor(t *testing.T) {
+	tokens, err := security.NewJWTManager("unit-test-secret-must-be-long-123456", "test-issuer", "test-aud", 15*time.Minute)
+	require.NoError(t, err)
+	interceptor := AuthStreamServerInterceptor(tokens, nil)
+	info := &grpc.StreamServerInfo{FullMethod: userpb.WatchlistService_WatchWatchlistQuotes_FullMethodName, IsServerStream: true}
+
+	var uid int64
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		uid, _ = UserIDFromContext(ss.Context())
+		return nil
+	}
+	token, _, err := tokens.GenerateAccessToken(7, "someone")
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	require.NoError(t, interceptor(nil, fakeServerStream{ctx: ctx}, info, handler))
+	require.Equal(t, int64(7), uid, "handlers see the caller like unary handlers")
+
+	err = interceptor(nil, fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.MD{})}, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -15,18 +15,78 @@ import (
 )
 
 // AuthUnaryServerInterceptor validates Authorization: Bearer <token> for protected methods.
-func AuthUnaryServerInterceptor(tokenValidator security.AccessTokenManager) grpc.UnaryServerInterceptor {
+// Administrative methods are further restricted to the users in adminUserIDs.
+func AuthUnaryServerInterceptor(tokenValidator security.AccessTokenManager, adminUserIDs []int64) grpc.UnaryServerInterceptor {
+	authorize := newAuthorizer(tokenValidator, adminUserIDs)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamServerInterceptor applies the checks of AuthUnaryServerInterceptor
+// to streaming methods.
+func AuthStreamServerInterceptor(tokenValidator security.AccessTokenManager, adminUserIDs []int64) grpc.StreamServerInterceptor {
+	authorize := newAuthorizer(tokenValidator, adminUserIDs)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// newAuthorizer returns a function that authenticates a call to method and
+// returns ctx carrying the token claims.
+func newAuthorizer(tokenValidator security.AccessTokenManager, adminUserIDs []int64) func(ctx context.Context, method string) (context.Context, error) {
 	// Public methods that do not require authentication
 	public := map[string]struct{}{
 		userpb.UserService_Login_FullMethodName:              {},
 		userpb.UserService_Register_FullMethodName:           {},
 		userpb.UserService_ResendVerification_FullMethodName: {},
 		userpb.UserService_VerifyUser_FullMethodName:         {},
+		userpb.UserService_ConfirmEmailChange_FullMethodName: {},
+		userpb.UserService_ReactivateAccount_FullMethodName:  {},
+		userpb.NewsService_ListNews_FullMethodName:           {},
+		userpb.NewsService_GetNews_FullMethodName:            {},
+		userpb.MarketService_GetMarketSession_FullMethodName: {},
+		// The payment gateway authenticates callbacks with their signature.
+		userpb.PaymentService_HandlePaymentCallback_FullMethodName: {},
+	}
+	// Methods only administrators may call
+	adminOnly := map[string]struct{}{
+		userpb.UserService_ListAuditEvents_FullMethodName:                  {},
+		userpb.KycService_ListKycSubmissions_FullMethodName:                {},
+		userpb.KycService_GetKycSubmission_FullMethodName:                  {},
+		userpb.KycService_GetKycDocument_FullMethodName:                    {},
+		userpb.KycService_ReviewKycSubmission_FullMethodName:               {},
+		userpb.NewsService_PublishNews_FullMethodName:                      {},
+		userpb.NewsService_UpdateNews_FullMethodName:                       {},
+		userpb.NewsService_UnpublishNews_FullMethodName:                    {},
+		userpb.AccountService_SetTradingTier_FullMethodName:                {},
+		userpb.AccountService_AdjustBalance_FullMethodName:                 {},
+		userpb.PaymentService_ListPendingWithdrawals_FullMethodName:        {},
+		userpb.PaymentService_ReviewWithdrawal_FullMethodName:              {},
+		userpb.FeeService_CreateFeeSchedule_FullMethodName:                 {},
+		userpb.FeeService_ListFeeSchedules_FullMethodName:                  {},
+		userpb.FeeService_DeleteFeeSchedule_FullMethodName:                 {},
+		userpb.CorporateActionService_CreateCorporateAction_FullMethodName: {},
+		userpb.CorporateActionService_DeleteCorporateAction_FullMethodName: {},
+		userpb.OrderService_RecordOrderFill_FullMethodName:                 {},
+		userpb.OrderService_RejectOrder_FullMethodName:                     {},
+	}
+	admins := make(map[int64]struct{}, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		admins[id] = struct{}{}
 	}
 
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if _, ok := public[info.FullMethod]; ok {
-			return handler(ctx, req)
+	return func(ctx context.Context, method string) (context.Context, error) {
+		if _, ok := public[method]; ok {
+			return ctx, nil
 		}
 
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -55,13 +115,28 @@ func AuthUnaryServerInterceptor(tokenValidator security.AccessTokenManager) grpc
 			return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid token: %v", err))
 		}
 
-		ctx = context.WithValue(ctx, ctxKeyClaims{}, claims)
-		slog.Debug("AUTH OK", "method", info.FullMethod, "uid", claims.UserID)
+		if _, ok := adminOnly[method]; ok {
+			if _, ok := admins[claims.UserID]; !ok {
+				return nil, status.Error(codes.PermissionDenied, "administrator access required")
+			}
+		}
 
-		return handler(ctx, req)
+		slog.Debug("AUTH OK", "method", method, "uid", claims.UserID)
+		return context.WithValue(ctx, ctxKeyClaims{}, claims), nil
 	}
 }
 
+// contextServerStream replaces the context of a server stream, like the
+// unary interceptors pass a new context to the handler.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
 type ctxKeyClaims struct{}
 
 // UserIDFromContext returns the user ID parsed from Authorization token by the interceptor.
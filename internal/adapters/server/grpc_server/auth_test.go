@@ -0,0 +1,43 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	userpb "github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorizerAdminOnlyMethods(t *testing.T) {
+	tokens, err := security.NewJWTManager("0123456789abcdef0123456789abcdef", "issuer", "audience", time.Minute)
+	require.NoError(t, err)
+	authorize := newAuthorizer(tokens, []int64{1})
+	withToken := func(userID int64) context.Context {
+		t.Helper()
+		token, _, err := tokens.GenerateAccessToken(userID, "someone")
+		require.NoError(t, err)
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	for _, method := range []string{
+		userpb.OrderService_RecordOrderFill_FullMethodName,
+		userpb.OrderService_RejectOrder_FullMethodName,
+	} {
+		_, err := authorize(withToken(2), method)
+		require.Equal(t, codes.PermissionDenied, status.Code(err), method)
+
+		ctx, err := authorize(withToken(1), method)
+		require.NoError(t, err, method)
+		uid, ok := UserIDFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, int64(1), uid)
+	}
+
+	_, err = authorize(withToken(2), userpb.OrderService_PlaceOrder_FullMethodName)
+	require.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package corporateactions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CorporateActionService implements the CorporateActionService gRPC API.
+// Access to its admin methods is enforced by the grpc_server authorizer;
+// errors are mapped to statuses by the grpc_server error interceptors.
+type CorporateActionService struct {
+	user.UnimplementedCorporateActionServiceServer
+	actionUseCase userusecase.UserCorporateActionUseCase
+}
+
+func NewCorporateActionService(actionUseCase userusecase.UserCorporateActionUseCase) *CorporateActionService {
+	return &CorporateActionService{actionUseCase: actionUseCase}
+}
+
+func (s *CorporateActionService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterCorporateActionServiceServer(server, s)
+}
+
+func (s *CorporateActionService) CreateCorporateAction(ctx context.Context, req *user.CreateCorporateActionRequest) (*user.CreateCorporateActionResponse, error) {
+	creatorID, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	action, err := s.actionUseCase.Create(ctx, creatorID, userusecase.CorporateActionInput{
+		Code:         req.GetCode(),
+		Kind:         userentity.CorporateActionKind(req.GetKind()),
+		CashPerShare: req.GetCashPerShare(),
+		OldShares:    req.GetOldShares(),
+		NewShares:    req.GetNewShares(),
+		ExDate:       req.GetExDate(),
+		RecordDate:   req.GetRecordDate(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create corporate action: %w", err)
+	}
+
+	return &user.CreateCorporateActionResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toCorporateAction(action),
+	}, nil
+}
+
+func (s *CorporateActionService) ListCorporateActions(ctx context.Context, req *user.ListCorporateActionsRequest) (*user.ListCorporateActionsResponse, error) {
+	actions, err := s.actionUseCase.List(ctx, req.GetCode())
+	if err != nil {
+		return nil, fmt.Errorf("list corporate actions: %w", err)
+	}
+
+	data := make([]*user.CorporateAction, 0, len(actions))
+	for _, action := range actions {
+		data = append(data, toCorporateAction(action))
+	}
+	return &user.ListCorporateActionsResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    data,
+	}, nil
+}
+
+func (s *CorporateActionService) DeleteCorporateAction(ctx context.Context, req *user.DeleteCorporateActionRequest) (*user.DeleteCorporateActionResponse, error) {
+	if err := s.actionUseCase.Delete(ctx, req.GetActionId()); err != nil {
+		return nil, fmt.Errorf("delete corporate action: %w", err)
+	}
+
+	return &user.DeleteCorporateActionResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+	}, nil
+}
+
+func toCorporateAction(action userentity.CorporateAction) *user.CorporateAction {
+	var appliedAt int64
+	if !action.AppliedAt.IsZero() {
+		appliedAt = action.AppliedAt.Unix()
+	}
+	return &user.CorporateAction{
+		Id:               action.ID,
+		Code:             action.Code,
+		Kind:             string(action.Kind),
+		CashPerShare:     action.CashPerShare,
+		OldShares:        action.OldShares,
+		NewShares:        action.NewShares,
+		ExDate:           action.ExDate.Format(time.DateOnly),
+		RecordDate:       action.RecordDate.Format(time.DateOnly),
+		Status:           string(action.Status),
+		PriceNumerator:   action.Adjustment.Numerator,
+		PriceDenominator: action.Adjustment.Denominator,
+		CreatedBy:        action.CreatedBy,
+		CreatedAt:        action.CreatedAt.Unix(),
+		AppliedAt:        appliedAt,
+	}
+}
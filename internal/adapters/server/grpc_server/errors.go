@@ -0,0 +1,139 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain is reported in google.rpc.ErrorInfo details.
+const ErrorDomain = "stock-trading-be"
+
+var errorKindCodes = []struct {
+	kind error
+	code codes.Code
+}{
+	{apperrors.ErrInvalidArgument, codes.InvalidArgument},
+	{apperrors.ErrNotFound, codes.NotFound},
+	{apperrors.ErrConflict, codes.AlreadyExists},
+	{apperrors.ErrUnauthenticated, codes.Unauthenticated},
+	{apperrors.ErrPermissionDenied, codes.PermissionDenied},
+	{apperrors.ErrFailedPrecondition, codes.FailedPrecondition},
+	{apperrors.ErrResourceExhausted, codes.ResourceExhausted},
+	{apperrors.ErrAborted, codes.Aborted},
+}
+
+// ErrorMappingUnaryServerInterceptor converts domain errors returned by handlers
+// into gRPC statuses carrying google.rpc.ErrorInfo and google.rpc.LocalizedMessage
+// details, so the HTTP gateway answers with consistent 400/401/403/404/409/429
+// responses in the caller's language.
+//
+// Errors that already carry a gRPC status (for example from the auth or
+// validation interceptors) keep their code and message; only the missing
+// details are added.
+func ErrorMappingUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		return nil, toStatusError(ctx, info.FullMethod, err)
+	}
+}
+
+// ErrorMappingStreamServerInterceptor converts the errors of streaming
+// handlers like ErrorMappingUnaryServerInterceptor.
+func ErrorMappingStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return toStatusError(ss.Context(), info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+// StatusError converts err like ErrorMappingUnaryServerInterceptor does, for
+// HTTP gateway routes that answer without calling a gRPC method.
+func StatusError(ctx context.Context, route string, err error) error {
+	return toStatusError(ctx, route, err)
+}
+
+func toStatusError(ctx context.Context, method string, err error) error {
+	if st, ok := status.FromError(err); ok {
+		return withErrorDetails(ctx, st, "", nil).Err()
+	}
+	if errors.Is(err, context.Canceled) {
+		return withErrorDetails(ctx, status.New(codes.Canceled, err.Error()), "", nil).Err()
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return withErrorDetails(ctx, status.New(codes.DeadlineExceeded, err.Error()), "", nil).Err()
+	}
+
+	code := codes.Internal
+	for _, m := range errorKindCodes {
+		if errors.Is(err, m.kind) {
+			code = m.code
+			break
+		}
+	}
+
+	message := err.Error()
+	if code == codes.Internal {
+		slog.ErrorContext(ctx, "GRPC INTERNAL ERROR", "method", method, "error", err)
+		message = "internal error"
+	}
+
+	return withErrorDetails(ctx, status.New(code, message), apperrors.ReasonOf(err), apperrors.MetadataOf(err)).Err()
+}
+
+// withErrorDetails adds the ErrorInfo and LocalizedMessage details st is
+// missing. An empty reason keeps the ErrorInfo already present or falls back to
+// one derived from the status code. metadata, such as the limit a rejected
+// order exceeded, goes into a new ErrorInfo.
+func withErrorDetails(ctx context.Context, st *status.Status, reason string, metadata map[string]string) *status.Status {
+	var hasInfo, hasLocalized bool
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			hasInfo = true
+			if reason == "" {
+				reason = d.GetReason()
+			}
+		case *errdetails.LocalizedMessage:
+			hasLocalized = true
+		}
+	}
+	if reason == "" {
+		reason = i18n.CodeReason(st.Code())
+	}
+
+	var details []protoadapt.MessageV1
+	if !hasInfo {
+		details = append(details, &errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain, Metadata: metadata})
+	}
+	if !hasLocalized {
+		locale := i18n.LocaleFromIncomingContext(ctx)
+		msg, ok := i18n.Message(locale, reason)
+		if !ok {
+			msg, ok = i18n.Message(locale, i18n.CodeReason(st.Code()))
+		}
+		if ok {
+			details = append(details, &errdetails.LocalizedMessage{Locale: locale, Message: msg})
+		}
+	}
+	if len(details) == 0 {
+		return st
+	}
+	if enriched, err := st.WithDetails(details...); err == nil {
+		return enriched
+	}
+	return st
+}
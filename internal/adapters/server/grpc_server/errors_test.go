@@ -0,0 +1,128 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func runMapping(ctx context.Context, handlerErr error) *status.Status {
+	_, err := ErrorMappingUnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, handlerErr
+	})
+	st, _ := status.FromError(err)
+	return st
+}
+
+func errorDetails(t *testing.T, st *status.Status) (*errdetails.ErrorInfo, *errdetails.LocalizedMessage) {
+	t.Helper()
+	var info *errdetails.ErrorInfo
+	var localized *errdetails.LocalizedMessage
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			require.Nil(t, info, "duplicate ErrorInfo")
+			info = d
+		case *errdetails.LocalizedMessage:
+			require.Nil(t, localized, "duplicate LocalizedMessage")
+			localized = d
+		}
+	}
+	require.NotNil(t, info)
+	require.NotNil(t, localized)
+	return info, localized
+}
+
+func TestErrorMappingUnaryServerInterceptor(t *testing.T) {
+	notFound := apperrors.New(apperrors.ErrNotFound, "USER_NOT_FOUND", "user not found")
+
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason string
+		wantMsg    string
+	}{
+		{"typed wrapped", fmt.Errorf("get user: %w", notFound), codes.NotFound, "USER_NOT_FOUND", "get user: user not found"},
+		{"bare kind", fmt.Errorf("dup: %w", apperrors.ErrConflict), codes.AlreadyExists, "ALREADY_EXISTS", "dup: already exists"},
+		{"unauthenticated", apperrors.New(apperrors.ErrUnauthenticated, "INVALID_CREDENTIALS", "invalid credentials"), codes.Unauthenticated, "INVALID_CREDENTIALS", "invalid credentials"},
+		{"unknown", errors.New("db exploded"), codes.Internal, "INTERNAL", "internal error"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			st := runMapping(context.Background(), tc.err)
+			require.Equal(t, tc.wantCode, st.Code())
+			require.Equal(t, tc.wantMsg, st.Message())
+			info, localized := errorDetails(t, st)
+			require.Equal(t, tc.wantReason, info.GetReason())
+			require.Equal(t, ErrorDomain, info.GetDomain())
+			require.Equal(t, "vi", localized.GetLocale())
+			require.NotEmpty(t, localized.GetMessage())
+		})
+	}
+}
+
+func TestErrorMappingLocalizesFromMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "en-US,en;q=0.9"))
+	st := runMapping(ctx, apperrors.New(apperrors.ErrNotFound, "USER_NOT_FOUND", "user not found"))
+
+	_, localized := errorDetails(t, st)
+	require.Equal(t, "en", localized.GetLocale())
+	require.Equal(t, "User not found.", localized.GetMessage())
+}
+
+func TestErrorMappingCarriesMetadata(t *testing.T) {
+	limit := apperrors.New(apperrors.ErrFailedPrecondition, "ORDER_VALUE_LIMIT_EXCEEDED", "order value exceeds the limit")
+	err := fmt.Errorf("place order: %w", limit.WithMetadata(map[string]string{"limit": "1000"}))
+	require.ErrorIs(t, err, limit)
+
+	st := runMapping(context.Background(), err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	info, _ := errorDetails(t, st)
+	require.Equal(t, "ORDER_VALUE_LIMIT_EXCEEDED", info.GetReason())
+	require.Equal(t, map[string]string{"limit": "1000"}, info.GetMetadata())
+}
+
+func TestErrorMappingEnrichesStatusErrors(t *testing.T) {
+	original := status.Error(codes.Unauthenticated, "missing authorization header")
+	st := runMapping(context.Background(), original)
+
+	require.Equal(t, codes.Unauthenticated, st.Code())
+	require.Equal(t, "missing authorization header", st.Message())
+	info, _ := errorDetails(t, st)
+	require.Equal(t, "UNAUTHENTICATED", info.GetReason())
+}
+
+func TestErrorMappingKeepsValidationDetails(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "en"))
+	st := runMapping(ctx, parserErrorValidation(fakeViolation{field: "email", reason: "value must be a valid email address"}))
+
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	info, localized := errorDetails(t, st)
+	require.Equal(t, invalidRequestReason, info.GetReason())
+	require.Equal(t, "The request is invalid.", localized.GetMessage())
+
+	var br *errdetails.BadRequest
+	for _, d := range st.Details() {
+		if v, ok := d.(*errdetails.BadRequest); ok {
+			br = v
+		}
+	}
+	require.NotNil(t, br)
+	require.Equal(t, "email", br.GetFieldViolations()[0].GetField())
+}
+
+type fakeViolation struct{ field, reason string }
+
+func (v fakeViolation) Field() string  { return v.field }
+func (v fakeViolation) Reason() string { return v.reason }
@@ -0,0 +1,117 @@
+package fees
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FeeService implements the FeeService gRPC API. Access to its admin
+// methods is enforced by the grpc_server authorizer; errors are mapped to
+// statuses by the grpc_server error interceptors.
+type FeeService struct {
+	user.UnimplementedFeeServiceServer
+	feeUseCase userusecase.UserFeeScheduleUseCase
+}
+
+func NewFeeService(feeUseCase userusecase.UserFeeScheduleUseCase) *FeeService {
+	return &FeeService{feeUseCase: feeUseCase}
+}
+
+func (s *FeeService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterFeeServiceServer(server, s)
+}
+
+func (s *FeeService) CreateFeeSchedule(ctx context.Context, req *user.CreateFeeScheduleRequest) (*user.CreateFeeScheduleResponse, error) {
+	creatorID, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	tiers := make([]userentity.FeeVolumeTier, 0, len(req.GetVolumeTiers()))
+	for _, tier := range req.GetVolumeTiers() {
+		tiers = append(tiers, userentity.FeeVolumeTier{
+			MinMonthlyValue: tier.GetMinMonthlyValue(),
+			BuyRateBps:      tier.GetBuyRateBps(),
+			SellRateBps:     tier.GetSellRateBps(),
+		})
+	}
+	schedule, err := s.feeUseCase.Create(ctx, creatorID, userusecase.FeeScheduleInput{
+		Tier:          req.GetTier(),
+		BuyRateBps:    req.GetBuyRateBps(),
+		SellRateBps:   req.GetSellRateBps(),
+		MinFee:        req.GetMinFee(),
+		MaxFee:        req.GetMaxFee(),
+		SellTaxBps:    req.GetSellTaxBps(),
+		VolumeTiers:   tiers,
+		EffectiveFrom: time.Unix(req.GetEffectiveFrom(), 0).UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create fee schedule: %w", err)
+	}
+
+	return &user.CreateFeeScheduleResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toFeeSchedule(schedule),
+	}, nil
+}
+
+func (s *FeeService) ListFeeSchedules(ctx context.Context, req *user.ListFeeSchedulesRequest) (*user.ListFeeSchedulesResponse, error) {
+	schedules, err := s.feeUseCase.List(ctx, req.GetTier())
+	if err != nil {
+		return nil, fmt.Errorf("list fee schedules: %w", err)
+	}
+
+	data := make([]*user.FeeSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		data = append(data, toFeeSchedule(schedule))
+	}
+	return &user.ListFeeSchedulesResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    data,
+	}, nil
+}
+
+func (s *FeeService) DeleteFeeSchedule(ctx context.Context, req *user.DeleteFeeScheduleRequest) (*user.DeleteFeeScheduleResponse, error) {
+	if err := s.feeUseCase.Delete(ctx, req.GetScheduleId()); err != nil {
+		return nil, fmt.Errorf("delete fee schedule: %w", err)
+	}
+
+	return &user.DeleteFeeScheduleResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+	}, nil
+}
+
+func toFeeSchedule(schedule userentity.FeeSchedule) *user.FeeSchedule {
+	tiers := make([]*user.FeeVolumeTier, 0, len(schedule.VolumeTiers))
+	for _, tier := range schedule.VolumeTiers {
+		tiers = append(tiers, &user.FeeVolumeTier{
+			MinMonthlyValue: tier.MinMonthlyValue,
+			BuyRateBps:      tier.BuyRateBps,
+			SellRateBps:     tier.SellRateBps,
+		})
+	}
+	return &user.FeeSchedule{
+		Id:            schedule.ID,
+		Tier:          schedule.Tier,
+		BuyRateBps:    schedule.BuyRateBps,
+		SellRateBps:   schedule.SellRateBps,
+		MinFee:        schedule.MinFee,
+		MaxFee:        schedule.MaxFee,
+		SellTaxBps:    schedule.SellTaxBps,
+		VolumeTiers:   tiers,
+		EffectiveFrom: schedule.EffectiveFrom.Unix(),
+		CreatedBy:     schedule.CreatedBy,
+		CreatedAt:     schedule.CreatedAt.Unix(),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"syscall"
 
 	_ "github.com/prometheus/client_golang/prometheus"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -17,21 +18,49 @@ import (
 type Config struct {
 	Host string `json:"host" mapstructure:"host" yaml:"host"`
 	Port int    `json:"port" mapstructure:"port" yaml:"port"`
+	// TrustedProxies are the IPs or CIDRs of the proxies, such as the HTTP
+	// gateway, whose X-Forwarded-For hops name the client. Other peers are
+	// recorded by their own address.
+	TrustedProxies []string `json:"trusted_proxies" mapstructure:"trusted_proxies" yaml:"trusted_proxies"`
+}
+
+// ServerOptions configures the authorization and auditing interceptors.
+type ServerOptions struct {
+	// AdminUserIDs may call the administrative methods.
+	AdminUserIDs []int64
+	// AuditRepository stores the audit events recorded by the use cases. Nil
+	// disables the audit log.
+	AuditRepository ports.AuditRepository
+	// TrustedProxies are parsed from Config.TrustedProxies.
+	TrustedProxies TrustedProxies
 }
 
 type Service interface {
 	RegisterService(s grpc.ServiceRegistrar)
 }
 
-func StartServer(grpcCfg Config, tokenValidator security.AccessTokenManager, services ...Service) (gracefulStop func(), cerr chan error) {
+func StartServer(grpcCfg Config, tokenValidator security.AccessTokenManager, opts ServerOptions, services ...Service) (gracefulStop func(), cerr chan error) {
 	grpc_prometheus.EnableHandlingTimeHistogram()
+	interceptors := []grpc.UnaryServerInterceptor{
+		grpc_prometheus.UnaryServerInterceptor,
+		ErrorMappingUnaryServerInterceptor(),
+		ClientInfoUnaryServerInterceptor(opts.TrustedProxies),
+		AuthUnaryServerInterceptor(tokenValidator, opts.AdminUserIDs),
+	}
+	if opts.AuditRepository != nil {
+		interceptors = append(interceptors, AuditUnaryServerInterceptor(opts.AuditRepository))
+	}
+	interceptors = append(interceptors, RequestValidationUnaryServerInterceptor())
+	// Streams are read-only and therefore not audited.
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_prometheus.StreamServerInterceptor,
+		ErrorMappingStreamServerInterceptor(),
+		AuthStreamServerInterceptor(tokenValidator, opts.AdminUserIDs),
+		RequestValidationStreamServerInterceptor(),
+	}
 	grpcService := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			grpc_prometheus.UnaryServerInterceptor,
-			AuthUnaryServerInterceptor(tokenValidator),
-			RequestValidationUnaryServerInterceptor(),
-		),
-		grpc.ChainStreamInterceptor(grpc_prometheus.StreamServerInterceptor),
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 		grpc.MaxConcurrentStreams(1000),
 		grpc.MaxRecvMsgSize(1024*1024*50), // 50MB
 	)
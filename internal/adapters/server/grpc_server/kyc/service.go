@@ -0,0 +1,206 @@
+package kyc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KycService implements the KycService gRPC API. Like UserService it returns
+// use-case errors wrapped with context and leaves the status mapping to
+// grpc_server.ErrorMappingUnaryServerInterceptor.
+type KycService struct {
+	user.UnimplementedKycServiceServer
+	kycUseCase userusecase.UserKycUseCase
+}
+
+func NewKycService(kycUseCase userusecase.UserKycUseCase) *KycService {
+	return &KycService{kycUseCase: kycUseCase}
+}
+
+func (s *KycService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterKycServiceServer(server, s)
+}
+
+func (s *KycService) UploadKycDocument(ctx context.Context, req *user.UploadKycDocumentRequest) (*user.UploadKycDocumentResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	document, err := s.kycUseCase.UploadDocument(ctx, uid, req.GetUsername(), req.GetKind(), req.GetContent())
+	if err != nil {
+		return nil, fmt.Errorf("upload kyc document: %w", err)
+	}
+
+	return &user.UploadKycDocumentResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toKycDocument(document),
+	}, nil
+}
+
+func (s *KycService) SubmitKyc(ctx context.Context, req *user.SubmitKycRequest) (*user.SubmitKycResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	submission, err := s.kycUseCase.Submit(ctx, uid, req.GetUsername(), userusecase.SubmitKycInput{
+		DocumentType:   req.GetDocumentType(),
+		DocumentNumber: req.GetDocumentNumber(),
+		FullName:       req.GetFullName(),
+		IssuedOn:       fromUnix(req.GetIssuedOn()),
+		ExpiresOn:      fromUnix(req.GetExpiresOn()),
+		DocumentIDs:    req.GetDocumentIds(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("submit kyc: %w", err)
+	}
+
+	return &user.SubmitKycResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toKycSubmission(submission),
+	}, nil
+}
+
+func (s *KycService) GetKycStatus(ctx context.Context, req *user.GetKycStatusRequest) (*user.GetKycStatusResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	result, err := s.kycUseCase.Status(ctx, uid, req.GetUsername())
+	if err != nil {
+		return nil, fmt.Errorf("get kyc status: %w", err)
+	}
+
+	resp := &user.GetKycStatusResponse{
+		Code:      uint32(codes.OK),
+		Message:   codes.OK.String(),
+		KycStatus: string(result.Status),
+	}
+	if result.Submission != nil {
+		resp.Submission = toKycSubmission(*result.Submission)
+	}
+	return resp, nil
+}
+
+func (s *KycService) ListKycSubmissions(ctx context.Context, req *user.ListKycSubmissionsRequest) (*user.ListKycSubmissionsResponse, error) {
+	result, err := s.kycUseCase.List(ctx, req.GetStatus(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("list kyc submissions: %w", err)
+	}
+
+	submissions := make([]*user.KycSubmission, 0, len(result.Submissions))
+	for _, submission := range result.Submissions {
+		submissions = append(submissions, toKycSubmission(submission))
+	}
+
+	return &user.ListKycSubmissionsResponse{
+		Code:          uint32(codes.OK),
+		Message:       codes.OK.String(),
+		Data:          submissions,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *KycService) GetKycSubmission(ctx context.Context, req *user.GetKycSubmissionRequest) (*user.GetKycSubmissionResponse, error) {
+	submission, owner, err := s.kycUseCase.Get(ctx, req.GetSubmissionId())
+	if err != nil {
+		return nil, fmt.Errorf("get kyc submission: %w", err)
+	}
+
+	return &user.GetKycSubmissionResponse{
+		Code:     uint32(codes.OK),
+		Message:  codes.OK.String(),
+		Data:     toKycSubmission(submission),
+		Username: owner.Username,
+		Email:    owner.Email,
+	}, nil
+}
+
+func (s *KycService) GetKycDocument(ctx context.Context, req *user.GetKycDocumentRequest) (*user.GetKycDocumentResponse, error) {
+	document, content, err := s.kycUseCase.Document(ctx, req.GetDocumentId())
+	if err != nil {
+		return nil, fmt.Errorf("get kyc document: %w", err)
+	}
+
+	return &user.GetKycDocumentResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toKycDocument(document),
+		Content: content,
+	}, nil
+}
+
+func (s *KycService) ReviewKycSubmission(ctx context.Context, req *user.ReviewKycSubmissionRequest) (*user.ReviewKycSubmissionResponse, error) {
+	reviewerID, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	submission, err := s.kycUseCase.Review(ctx, reviewerID, req.GetSubmissionId(), req.GetDecision(), req.GetReason())
+	if err != nil {
+		return nil, fmt.Errorf("review kyc submission: %w", err)
+	}
+
+	return &user.ReviewKycSubmissionResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toKycSubmission(submission),
+	}, nil
+}
+
+func toKycDocument(document userentity.KycDocument) *user.KycDocument {
+	return &user.KycDocument{
+		Id:           document.ID,
+		Kind:         string(document.Kind),
+		ContentType:  document.ContentType,
+		SizeBytes:    document.SizeBytes,
+		SubmissionId: document.SubmissionID,
+		CreatedAt:    document.CreatedAt.Unix(),
+	}
+}
+
+func toKycSubmission(submission userentity.KycSubmission) *user.KycSubmission {
+	out := &user.KycSubmission{
+		Id:              submission.ID,
+		UserId:          submission.UserID,
+		DocumentType:    string(submission.DocumentType),
+		DocumentNumber:  submission.DocumentNumber,
+		FullName:        submission.FullName,
+		IssuedOn:        toUnix(submission.IssuedOn),
+		ExpiresOn:       toUnix(submission.ExpiresOn),
+		Status:          string(submission.Status),
+		RejectionReason: submission.RejectionReason,
+		ReviewerId:      submission.ReviewerID,
+		ReviewedAt:      toUnix(submission.ReviewedAt),
+		CreatedAt:       submission.CreatedAt.Unix(),
+		Documents:       make([]*user.KycDocument, 0, len(submission.Documents)),
+	}
+	for _, document := range submission.Documents {
+		out.Documents = append(out.Documents, toKycDocument(document))
+	}
+	return out
+}
+
+// toUnix maps the zero time to 0 rather than to a negative Unix time.
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func fromUnix(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0).UTC()
+}
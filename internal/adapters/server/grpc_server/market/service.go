@@ -0,0 +1,45 @@
+package market
+
+import (
+	"context"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// MarketService implements the MarketService gRPC API. Its methods are
+// public; see the grpc_server authorizer.
+type MarketService struct {
+	user.UnimplementedMarketServiceServer
+	sessionUseCase userusecase.UserTradingSessionUseCase
+}
+
+func NewMarketService(sessionUseCase userusecase.UserTradingSessionUseCase) *MarketService {
+	return &MarketService{sessionUseCase: sessionUseCase}
+}
+
+func (s *MarketService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterMarketServiceServer(server, s)
+}
+
+func (s *MarketService) GetMarketSession(ctx context.Context, req *user.GetMarketSessionRequest) (*user.GetMarketSessionResponse, error) {
+	session := s.sessionUseCase.Session(time.Now().UTC())
+	data := &user.MarketSession{
+		Session:    string(session.Session),
+		TradingDay: session.TradingDay,
+		TimeZone:   session.TimeZone,
+	}
+	if !session.Next.At.IsZero() {
+		data.NextSession = string(session.Next.To)
+		data.NextSessionAt = session.Next.At.Unix()
+	}
+
+	return &user.GetMarketSessionResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    data,
+	}, nil
+}
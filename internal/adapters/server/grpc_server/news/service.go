@@ -0,0 +1,136 @@
+package news
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// NewsService implements the NewsService gRPC API. Access to the admin
+// methods is enforced by the grpc_server authorizer; errors are mapped to
+// statuses by the grpc_server error interceptors.
+type NewsService struct {
+	user.UnimplementedNewsServiceServer
+	newsUseCase userusecase.UserNewsUseCase
+}
+
+func NewNewsService(newsUseCase userusecase.UserNewsUseCase) *NewsService {
+	return &NewsService{newsUseCase: newsUseCase}
+}
+
+func (s *NewsService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterNewsServiceServer(server, s)
+}
+
+func (s *NewsService) PublishNews(ctx context.Context, req *user.PublishNewsRequest) (*user.PublishNewsResponse, error) {
+	news, err := s.newsUseCase.Publish(ctx, toNewsInput(req.GetNews()))
+	if err != nil {
+		return nil, fmt.Errorf("publish news: %w", err)
+	}
+
+	return &user.PublishNewsResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toNews(news),
+	}, nil
+}
+
+func (s *NewsService) UpdateNews(ctx context.Context, req *user.UpdateNewsRequest) (*user.UpdateNewsResponse, error) {
+	news, err := s.newsUseCase.Update(ctx, req.GetNewsId(), toNewsInput(req.GetNews()))
+	if err != nil {
+		return nil, fmt.Errorf("update news: %w", err)
+	}
+
+	return &user.UpdateNewsResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toNews(news),
+	}, nil
+}
+
+func (s *NewsService) UnpublishNews(ctx context.Context, req *user.UnpublishNewsRequest) (*user.UnpublishNewsResponse, error) {
+	news, err := s.newsUseCase.Unpublish(ctx, req.GetNewsId())
+	if err != nil {
+		return nil, fmt.Errorf("unpublish news: %w", err)
+	}
+
+	return &user.UnpublishNewsResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toNews(news),
+	}, nil
+}
+
+func (s *NewsService) ListNews(ctx context.Context, req *user.ListNewsRequest) (*user.ListNewsResponse, error) {
+	result, err := s.newsUseCase.List(ctx, userusecase.NewsFilter{
+		Category: req.GetCategory(),
+		Code:     req.GetCode(),
+		Query:    req.GetQuery(),
+	}, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("list news: %w", err)
+	}
+
+	data := make([]*user.News, 0, len(result.Items))
+	for _, news := range result.Items {
+		data = append(data, toNews(news))
+	}
+	return &user.ListNewsResponse{
+		Code:          uint32(codes.OK),
+		Message:       codes.OK.String(),
+		Data:          data,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *NewsService) GetNews(ctx context.Context, req *user.GetNewsRequest) (*user.GetNewsResponse, error) {
+	news, err := s.newsUseCase.Get(ctx, req.GetNewsId())
+	if err != nil {
+		return nil, fmt.Errorf("get news: %w", err)
+	}
+
+	return &user.GetNewsResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toNews(news),
+	}, nil
+}
+
+func toNewsInput(content *user.NewsContent) userusecase.NewsInput {
+	input := userusecase.NewsInput{
+		Category: content.GetCategory(),
+		Title:    content.GetTitle(),
+		Summary:  content.GetSummary(),
+		Content:  content.GetContent(),
+		Source:   content.GetSource(),
+		URL:      content.GetUrl(),
+		Codes:    content.GetCodes(),
+	}
+	if at := content.GetPublishedAt(); at > 0 {
+		input.PublishedAt = time.Unix(at, 0).UTC()
+	}
+	return input
+}
+
+func toNews(news userentity.News) *user.News {
+	return &user.News{
+		Id:          news.ID,
+		Category:    string(news.Category),
+		Title:       news.Title,
+		Summary:     news.Summary,
+		Content:     news.Content,
+		Source:      news.Source,
+		Url:         news.URL,
+		Codes:       news.Codes,
+		Status:      string(news.Status),
+		PublishedAt: news.PublishedAt.Unix(),
+		CreatedAt:   news.CreatedAt.Unix(),
+		UpdatedAt:   news.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,202 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderService implements the OrderService gRPC API. Errors are mapped to
+// statuses by the grpc_server error interceptors.
+type OrderService struct {
+	user.UnimplementedOrderServiceServer
+	orderUseCase userusecase.UserOrderUseCase
+}
+
+func NewOrderService(orderUseCase userusecase.UserOrderUseCase) *OrderService {
+	return &OrderService{orderUseCase: orderUseCase}
+}
+
+func (s *OrderService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterOrderServiceServer(server, s)
+}
+
+func (s *OrderService) PlaceOrder(ctx context.Context, req *user.PlaceOrderRequest) (*user.PlaceOrderResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	order, err := s.orderUseCase.Place(ctx, uid, req.GetUsername(), userusecase.PlaceOrderInput{
+		Code:        req.GetCode(),
+		Side:        req.GetSide(),
+		TimeInForce: req.GetTimeInForce(),
+		Price:       req.GetPrice(),
+		Quantity:    req.GetQuantity(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("place order: %w", err)
+	}
+
+	return &user.PlaceOrderResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toOrder(order),
+	}, nil
+}
+
+func (s *OrderService) ListOrders(ctx context.Context, req *user.ListOrdersRequest) (*user.ListOrdersResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	result, err := s.orderUseCase.List(ctx, uid, req.GetUsername(), req.GetStatus(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("list orders: %w", err)
+	}
+
+	data := make([]*user.Order, 0, len(result.Orders))
+	for _, order := range result.Orders {
+		data = append(data, toOrder(order))
+	}
+	return &user.ListOrdersResponse{
+		Code:          uint32(codes.OK),
+		Message:       codes.OK.String(),
+		Data:          data,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *OrderService) GetOrder(ctx context.Context, req *user.GetOrderRequest) (*user.GetOrderResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	order, events, err := s.orderUseCase.Get(ctx, uid, req.GetUsername(), req.GetOrderId())
+	if err != nil {
+		return nil, fmt.Errorf("get order: %w", err)
+	}
+
+	history := make([]*user.OrderEvent, 0, len(events))
+	for _, event := range events {
+		history = append(history, &user.OrderEvent{
+			Type:      string(event.Type),
+			Status:    string(event.Status),
+			Price:     event.Price,
+			Quantity:  event.Quantity,
+			Reason:    event.Reason,
+			CreatedAt: event.CreatedAt.Unix(),
+			Fee:       event.Fee,
+			Tax:       event.Tax,
+		})
+	}
+	return &user.GetOrderResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toOrder(order),
+		Events:  history,
+	}, nil
+}
+
+func (s *OrderService) AmendOrder(ctx context.Context, req *user.AmendOrderRequest) (*user.AmendOrderResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	order, err := s.orderUseCase.Amend(ctx, uid, req.GetUsername(), req.GetOrderId(), userusecase.AmendOrderInput{
+		Price:    req.GetPrice(),
+		Quantity: req.GetQuantity(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("amend order: %w", err)
+	}
+
+	return &user.AmendOrderResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toOrder(order),
+	}, nil
+}
+
+func (s *OrderService) CancelOrder(ctx context.Context, req *user.CancelOrderRequest) (*user.CancelOrderResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	order, err := s.orderUseCase.Cancel(ctx, uid, req.GetUsername(), req.GetOrderId())
+	if err != nil {
+		return nil, fmt.Errorf("cancel order: %w", err)
+	}
+
+	return &user.CancelOrderResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toOrder(order),
+	}, nil
+}
+
+// RecordOrderFill is called by the market; authorization restricts it to
+// administrators.
+func (s *OrderService) RecordOrderFill(ctx context.Context, req *user.RecordOrderFillRequest) (*user.RecordOrderFillResponse, error) {
+	input := userusecase.RecordFillInput{
+		OrderID:     req.GetOrderId(),
+		ExecutionID: req.GetExecutionId(),
+		Price:       req.GetPrice(),
+		Quantity:    req.GetQuantity(),
+	}
+	if req.GetExecutedAt() > 0 {
+		input.At = time.Unix(req.GetExecutedAt(), 0).UTC()
+	}
+	order, err := s.orderUseCase.RecordFill(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("record order fill: %w", err)
+	}
+
+	return &user.RecordOrderFillResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toOrder(order),
+	}, nil
+}
+
+// RejectOrder is called by the market; authorization restricts it to
+// administrators.
+func (s *OrderService) RejectOrder(ctx context.Context, req *user.RejectOrderRequest) (*user.RejectOrderResponse, error) {
+	order, err := s.orderUseCase.Reject(ctx, req.GetOrderId(), req.GetReason())
+	if err != nil {
+		return nil, fmt.Errorf("reject order: %w", err)
+	}
+
+	return &user.RejectOrderResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toOrder(order),
+	}, nil
+}
+
+func toOrder(order userentity.Order) *user.Order {
+	return &user.Order{
+		Id:             order.ID,
+		Code:           order.Code,
+		Side:           string(order.Side),
+		TimeInForce:    string(order.TimeInForce),
+		Price:          order.Price,
+		Quantity:       order.Quantity,
+		FilledQuantity: order.FilledQuantity,
+		AveragePrice:   order.AveragePrice(),
+		Status:         string(order.Status),
+		Reason:         order.Reason,
+		PriorityAt:     order.PriorityAt.Unix(),
+		CreatedAt:      order.CreatedAt.Unix(),
+		UpdatedAt:      order.UpdatedAt.Unix(),
+		Fees:           order.Fees,
+		Taxes:          order.Taxes,
+	}
+}
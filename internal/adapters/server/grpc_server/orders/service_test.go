@@ -0,0 +1,60 @@
+package orders
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderService_MarketReports(t *testing.T) {
+	ctx := context.Background()
+	repo := database.NewInMemoryUserRepository()
+	repo.AddStock(userentity.Stock{Code: "VNM", Name: "VNM", CompanyName: "VNM JSC"})
+	owner, err := repo.CreateUserWithVerification(ctx, ports.CreateUserWithVerificationParams{
+		User:  userentity.User{Username: "trader01", Name: "Trader", Email: "trader01@example.com"},
+		Login: userentity.LoginMethodPassword{UserName: "trader01", Password: "hashed"},
+		Token: userentity.VerificationToken{Token: "token-trader01", Purpose: userentity.VerificationPurposeRegister, ExpiresAt: time.Now().Add(time.Hour)},
+	})
+	require.NoError(t, err)
+	place := func() userentity.Order {
+		t.Helper()
+		order, err := repo.CreateOrder(ctx, userentity.Order{
+			UserID: owner.Id, Code: "VNM", Side: userentity.OrderSideBuy, TimeInForce: userentity.TimeInForceGTC,
+			Price: 60000, Quantity: 200,
+		}, nil)
+		require.NoError(t, err)
+		return order
+	}
+	service := NewOrderService(userusecase.NewUserOrderUseCase(repo, repo, userusecase.OrderConfig{}))
+
+	filled := place()
+	executedAt := time.Date(2026, time.October, 19, 3, 0, 0, 0, time.UTC)
+	fill := &user.RecordOrderFillRequest{OrderId: filled.ID, ExecutionId: "x-1", Price: 59500, Quantity: 150, ExecutedAt: executedAt.Unix()}
+	resp, err := service.RecordOrderFill(ctx, fill)
+	require.NoError(t, err)
+	require.Equal(t, string(userentity.OrderStatusPartiallyFilled), resp.GetData().GetStatus())
+	require.Equal(t, int64(150), resp.GetData().GetFilledQuantity())
+	require.Equal(t, int64(59500), resp.GetData().GetAveragePrice())
+	require.Equal(t, executedAt.Unix(), resp.GetData().GetUpdatedAt())
+	// The market may report an execution more than once.
+	resp, err = service.RecordOrderFill(ctx, fill)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), resp.GetData().GetFilledQuantity())
+	_, err = service.RecordOrderFill(ctx, &user.RecordOrderFillRequest{OrderId: filled.ID + 100, ExecutionId: "x-2", Price: 59500, Quantity: 1})
+	require.ErrorIs(t, err, userusecase.ErrOrderNotFound)
+
+	rejected := place()
+	rejectResp, err := service.RejectOrder(ctx, &user.RejectOrderRequest{OrderId: rejected.ID, Reason: "price outside the daily band"})
+	require.NoError(t, err)
+	require.Equal(t, string(userentity.OrderStatusRejected), rejectResp.GetData().GetStatus())
+	require.Equal(t, "price outside the daily band", rejectResp.GetData().GetReason())
+	_, err = service.RejectOrder(ctx, &user.RejectOrderRequest{OrderId: rejected.ID, Reason: "again"})
+	require.Error(t, err)
+}
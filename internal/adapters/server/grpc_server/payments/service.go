@@ -0,0 +1,175 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// callbackStatusConfirmed is the callback status of payments the gateway
+// completed.
+const callbackStatusConfirmed = "confirmed"
+
+// PaymentService implements the PaymentService gRPC API. Access to the admin
+// methods, and the open access to the callback, is enforced by the
+// grpc_server authorizer; errors are mapped to statuses by the grpc_server
+// error interceptors.
+type PaymentService struct {
+	user.UnimplementedPaymentServiceServer
+	paymentUseCase userusecase.UserPaymentUseCase
+}
+
+func NewPaymentService(paymentUseCase userusecase.UserPaymentUseCase) *PaymentService {
+	return &PaymentService{paymentUseCase: paymentUseCase}
+}
+
+func (s *PaymentService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterPaymentServiceServer(server, s)
+}
+
+func (s *PaymentService) RequestDeposit(ctx context.Context, req *user.RequestDepositRequest) (*user.RequestDepositResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	payment, err := s.paymentUseCase.Deposit(ctx, uid, req.GetUsername(), userusecase.PaymentRequestInput{
+		Amount:         req.GetAmount(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("request deposit: %w", err)
+	}
+
+	return &user.RequestDepositResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toPayment(payment),
+	}, nil
+}
+
+func (s *PaymentService) RequestWithdrawal(ctx context.Context, req *user.RequestWithdrawalRequest) (*user.RequestWithdrawalResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	payment, err := s.paymentUseCase.Withdraw(ctx, uid, req.GetUsername(), userusecase.PaymentRequestInput{
+		Amount:         req.GetAmount(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("request withdrawal: %w", err)
+	}
+
+	return &user.RequestWithdrawalResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toPayment(payment),
+	}, nil
+}
+
+func (s *PaymentService) ListPayments(ctx context.Context, req *user.ListPaymentsRequest) (*user.ListPaymentsResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	result, err := s.paymentUseCase.List(ctx, uid, req.GetUsername(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("list payments: %w", err)
+	}
+
+	return &user.ListPaymentsResponse{
+		Code:          uint32(codes.OK),
+		Message:       codes.OK.String(),
+		Data:          toPayments(result.Payments),
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *PaymentService) ListPendingWithdrawals(ctx context.Context, req *user.ListPendingWithdrawalsRequest) (*user.ListPendingWithdrawalsResponse, error) {
+	result, err := s.paymentUseCase.ListAwaitingApproval(ctx, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("list pending withdrawals: %w", err)
+	}
+
+	return &user.ListPendingWithdrawalsResponse{
+		Code:          uint32(codes.OK),
+		Message:       codes.OK.String(),
+		Data:          toPayments(result.Payments),
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *PaymentService) ReviewWithdrawal(ctx context.Context, req *user.ReviewWithdrawalRequest) (*user.ReviewWithdrawalResponse, error) {
+	reviewerID, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	payment, err := s.paymentUseCase.ReviewWithdrawal(ctx, reviewerID, req.GetPaymentId(), req.GetDecision(), req.GetReason())
+	if err != nil {
+		return nil, fmt.Errorf("review withdrawal: %w", err)
+	}
+
+	return &user.ReviewWithdrawalResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toPayment(payment),
+	}, nil
+}
+
+func (s *PaymentService) HandlePaymentCallback(ctx context.Context, req *user.HandlePaymentCallbackRequest) (*user.HandlePaymentCallbackResponse, error) {
+	if _, err := s.paymentUseCase.HandleCallback(ctx, ports.PaymentCallback{
+		Reference:        req.GetReference(),
+		GatewayReference: req.GetGatewayReference(),
+		Succeeded:        req.GetStatus() == callbackStatusConfirmed,
+		Reason:           req.GetReason(),
+		Signature:        req.GetSignature(),
+	}); err != nil {
+		return nil, fmt.Errorf("handle payment callback: %w", err)
+	}
+
+	return &user.HandlePaymentCallbackResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+	}, nil
+}
+
+func toPayments(payments []userentity.Payment) []*user.Payment {
+	data := make([]*user.Payment, 0, len(payments))
+	for _, payment := range payments {
+		data = append(data, toPayment(payment))
+	}
+	return data
+}
+
+func toPayment(payment userentity.Payment) *user.Payment {
+	return &user.Payment{
+		Id:               payment.ID,
+		UserId:           payment.UserID,
+		Kind:             string(payment.Kind),
+		Amount:           payment.Amount,
+		Status:           string(payment.Status),
+		IdempotencyKey:   payment.IdempotencyKey,
+		GatewayReference: payment.GatewayReference,
+		PaymentUrl:       payment.PaymentURL,
+		Reason:           payment.Reason,
+		CreatedAt:        payment.CreatedAt.Unix(),
+		UpdatedAt:        payment.UpdatedAt.Unix(),
+		CompletedAt:      toUnix(payment.CompletedAt),
+	}
+}
+
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,88 @@
+package portfolios
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PortfolioService implements the PortfolioService gRPC API. Errors are
+// mapped to statuses by the grpc_server error interceptors.
+type PortfolioService struct {
+	user.UnimplementedPortfolioServiceServer
+	portfolioUseCase userusecase.UserPortfolioUseCase
+}
+
+func NewPortfolioService(portfolioUseCase userusecase.UserPortfolioUseCase) *PortfolioService {
+	return &PortfolioService{portfolioUseCase: portfolioUseCase}
+}
+
+func (s *PortfolioService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterPortfolioServiceServer(server, s)
+}
+
+func (s *PortfolioService) GetPortfolioPerformance(ctx context.Context, req *user.GetPortfolioPerformanceRequest) (*user.GetPortfolioPerformanceResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	perf, err := s.portfolioUseCase.Performance(ctx, uid, req.GetUsername(), userentity.CostMethod(req.GetCostMethod()), userusecase.PerformanceRange(req.GetRange()))
+	if err != nil {
+		return nil, fmt.Errorf("get portfolio performance: %w", err)
+	}
+
+	data := &user.PortfolioPerformance{
+		CostMethod:    string(perf.CostMethod),
+		Range:         string(perf.Range),
+		AsOf:          perf.AsOf.Unix(),
+		Cash:          perf.Cash,
+		MarketValue:   perf.MarketValue,
+		TotalValue:    perf.Value(),
+		CashWeightBps: perf.CashWeightBps,
+		RealizedPnl:   perf.RealizedPnL,
+		UnrealizedPnl: perf.UnrealizedPnL,
+		Dividends:     perf.Dividends,
+		Fees:          perf.Fees,
+		Taxes:         perf.Taxes,
+	}
+	for _, position := range perf.Positions {
+		data.Positions = append(data.Positions, &user.PortfolioPosition{
+			Code:          position.Code,
+			Sector:        position.Sector,
+			Quantity:      position.Quantity,
+			Cost:          position.Cost,
+			Price:         position.Price,
+			MarketValue:   position.MarketValue,
+			UnrealizedPnl: position.UnrealizedPnL,
+			RealizedPnl:   position.RealizedPnL,
+			WeightBps:     position.WeightBps,
+		})
+	}
+	for _, sector := range perf.Sectors {
+		data.Sectors = append(data.Sectors, &user.SectorAllocation{Sector: sector.Sector, MarketValue: sector.MarketValue, WeightBps: sector.WeightBps})
+	}
+	for _, ret := range perf.Returns {
+		data.Returns = append(data.Returns, &user.PerformanceReturn{Range: string(ret.Range), ReturnBps: ret.ReturnBps})
+	}
+	for _, point := range perf.History {
+		data.History = append(data.History, &user.PerformancePoint{
+			Date:      point.Date.Format(time.DateOnly),
+			Value:     point.Value,
+			NetFlow:   point.NetFlow,
+			ReturnBps: point.ReturnBps,
+		})
+	}
+	return &user.GetPortfolioPerformanceResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    data,
+	}, nil
+}
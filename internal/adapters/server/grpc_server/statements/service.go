@@ -0,0 +1,66 @@
+package statements
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatementService implements the StatementService gRPC API. Errors are
+// mapped to statuses by the grpc_server error interceptors.
+type StatementService struct {
+	user.UnimplementedStatementServiceServer
+	statementUseCase userusecase.UserStatementUseCase
+}
+
+func NewStatementService(statementUseCase userusecase.UserStatementUseCase) *StatementService {
+	return &StatementService{statementUseCase: statementUseCase}
+}
+
+func (s *StatementService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterStatementServiceServer(server, s)
+}
+
+func (s *StatementService) GetStatement(ctx context.Context, req *user.GetStatementRequest) (*user.GetStatementResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	result, err := s.statementUseCase.Get(ctx, uid, req.GetUsername(), req.GetPeriod())
+	if err != nil {
+		return nil, fmt.Errorf("get statement: %w", err)
+	}
+
+	statement := result.Statement
+	return &user.GetStatementResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data: &user.Statement{
+			Period:       statement.Period,
+			From:         toUnix(statement.From),
+			To:           toUnix(statement.To),
+			OpeningCash:  statement.OpeningCash,
+			ClosingCash:  statement.ClosingCash,
+			Fees:         statement.Fees,
+			CsvUrl:       result.CSVURL,
+			PdfUrl:       result.PDFURL,
+			UrlsExpireAt: toUnix(result.ExpiresAt),
+			GeneratedAt:  toUnix(statement.CreatedAt),
+			Taxes:        statement.Taxes,
+		},
+	}, nil
+}
+
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
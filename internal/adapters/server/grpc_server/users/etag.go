@@ -0,0 +1,63 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// etagHeader is the response metadata key the HTTP gateway turns into ETag.
+const etagHeader = "etag"
+
+// ifMatchHeader is the request metadata key forwarded from the If-Match header.
+const ifMatchHeader = "if-match"
+
+var errInvalidETag = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ETAG", "invalid etag")
+
+// formatETag renders a user version as a strong HTTP entity tag.
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseETag returns the version encoded in tag. Empty and "*" tags mean "any
+// version" and yield 0.
+func parseETag(tag string) (int64, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || tag == "*" {
+		return 0, nil
+	}
+	tag = strings.TrimPrefix(tag, "W/")
+	if unquoted, err := strconv.Unquote(tag); err == nil {
+		tag = unquoted
+	}
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("%w: %q", errInvalidETag, tag)
+	}
+	return version, nil
+}
+
+// expectedVersion reads the precondition from the request body or, when
+// empty, from the If-Match header.
+func expectedVersion(ctx context.Context, bodyETag string) (int64, error) {
+	if bodyETag != "" {
+		return parseETag(bodyETag)
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(ifMatchHeader); len(vals) > 0 {
+			return parseETag(vals[0])
+		}
+	}
+	return 0, nil
+}
+
+// setETag reports the current profile version in the response headers.
+func setETag(ctx context.Context, version int64) {
+	// Outside a gRPC call (e.g. in unit tests) there is no stream to set on.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(etagHeader, formatETag(version)))
+}
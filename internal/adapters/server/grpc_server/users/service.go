@@ -2,17 +2,21 @@ package users
 
 import (
 	"context"
-	"errors"
-	"strings"
+	"fmt"
 
 	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
 	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// UserService implements the UserService gRPC API. Handlers return use-case
+// errors wrapped with context; grpc_server.ErrorMappingUnaryServerInterceptor
+// translates them into gRPC statuses.
 type UserService struct {
 	user.UnimplementedUserServiceServer
 	registerUseCase           userusecase.UserRegisterUseCase
@@ -26,6 +30,12 @@ type UserService struct {
 	listUseCase               userusecase.UserListUseCase
 	updateUseCase             userusecase.UserUpdateUseCase
 	changePasswordUseCase     userusecase.UserChangePasswordUseCase
+	emailChangeUseCase        userusecase.UserEmailChangeUseCase
+	accountStatusUseCase      userusecase.UserAccountStatusUseCase
+	dataExportUseCase         userusecase.UserDataExportUseCase
+	auditUseCase              userusecase.UserAuditUseCase
+	loginHistoryUseCase       userusecase.UserLoginHistoryUseCase
+	avatarUseCase             userusecase.UserAvatarUseCase
 }
 
 func NewUserService(
@@ -40,6 +50,12 @@ func NewUserService(
 	listUseCase userusecase.UserListUseCase,
 	updateUseCase userusecase.UserUpdateUseCase,
 	changePasswordUseCase userusecase.UserChangePasswordUseCase,
+	emailChangeUseCase userusecase.UserEmailChangeUseCase,
+	accountStatusUseCase userusecase.UserAccountStatusUseCase,
+	dataExportUseCase userusecase.UserDataExportUseCase,
+	auditUseCase userusecase.UserAuditUseCase,
+	loginHistoryUseCase userusecase.UserLoginHistoryUseCase,
+	avatarUseCase userusecase.UserAvatarUseCase,
 ) *UserService {
 	return &UserService{
 		registerUseCase:           registerUseCase,
@@ -53,6 +69,12 @@ func NewUserService(
 		listUseCase:               listUseCase,
 		updateUseCase:             updateUseCase,
 		changePasswordUseCase:     changePasswordUseCase,
+		emailChangeUseCase:        emailChangeUseCase,
+		accountStatusUseCase:      accountStatusUseCase,
+		dataExportUseCase:         dataExportUseCase,
+		auditUseCase:              auditUseCase,
+		loginHistoryUseCase:       loginHistoryUseCase,
+		avatarUseCase:             avatarUseCase,
 	}
 }
 
@@ -72,7 +94,7 @@ func (s *UserService) Register(ctx context.Context, req *user.RegisterRequest) (
 		PermanentAddress: req.GetPermanentAddress(),
 		PhoneNumber:      req.GetPhoneNumber(),
 	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to register account: %v", err)
+		return nil, fmt.Errorf("failed to register account: %w", err)
 	}
 
 	return &user.RegisterResponse{
@@ -83,14 +105,7 @@ func (s *UserService) Register(ctx context.Context, req *user.RegisterRequest) (
 
 func (s *UserService) ResendVerification(ctx context.Context, req *user.ResendVerificationRequest) (*user.ResendVerificationResponse, error) {
 	if err := s.resendVerificationUseCase.Resend(ctx, userusecase.RequestResendVerification{Email: req.GetEmail()}); err != nil {
-		switch {
-		case errors.Is(err, userusecase.ErrResendEmptyEmail):
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case errors.Is(err, userusecase.ErrResendAlreadyVerified):
-			return nil, status.Error(codes.FailedPrecondition, err.Error())
-		default:
-			return nil, status.Errorf(codes.Internal, "resend verification: %v", err)
-		}
+		return nil, fmt.Errorf("resend verification: %w", err)
 	}
 
 	return &user.ResendVerificationResponse{
@@ -102,30 +117,22 @@ func (s *UserService) ResendVerification(ctx context.Context, req *user.ResendVe
 func (s *UserService) VerifyUser(ctx context.Context, req *user.VerifyUserRequest) (*user.VerifyUserResponse, error) {
 	entity, err := s.verifyUseCase.Verify(ctx, req.GetToken())
 	if err != nil {
-		switch {
-		case errors.Is(err, userusecase.ErrVerifyEmptyToken):
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case errors.Is(err, userusecase.ErrVerifyTokenExpired), errors.Is(err, userusecase.ErrVerifyTokenUsed):
-			return nil, status.Error(codes.FailedPrecondition, err.Error())
-		default:
-			return nil, status.Errorf(codes.Internal, "verify user: %v", err)
-		}
+		return nil, fmt.Errorf("verify user: %w", err)
 	}
 
 	return &user.VerifyUserResponse{
 		Code:    uint32(codes.OK),
 		Message: codes.OK.String(),
-		Data:    toUserProfile(entity),
+		Data:    s.profile(entity),
 	}, nil
 }
 
 func (s *UserService) Login(ctx context.Context, req *user.LoginRequest) (*user.LoginResponse, error) {
-	token, tokenExpire, refresh, refreshExpire, _, err := s.loginUseCase.Login(ctx, req.GetUsername(), req.GetPassword())
+	client := grpc_server.ClientInfoFromContext(ctx)
+	client.DeviceID = req.GetDeviceId()
+	token, tokenExpire, refresh, refreshExpire, _, err := s.loginUseCase.LoginFromClient(ctx, req.GetUsername(), req.GetPassword(), client)
 	if err != nil {
-		if strings.Contains(strings.ToLower(err.Error()), "invalid credentials") {
-			return nil, status.Errorf(codes.PermissionDenied, "login: %v", err)
-		}
-		return nil, status.Errorf(codes.Internal, "login: %v", err)
+		return nil, fmt.Errorf("login: %w", err)
 	}
 
 	return &user.LoginResponse{
@@ -143,11 +150,7 @@ func (s *UserService) Login(ctx context.Context, req *user.LoginRequest) (*user.
 func (s *UserService) RefreshToken(ctx context.Context, req *user.RefreshTokenRequest) (*user.RefreshTokenResponse, error) {
 	result, err := s.refreshUseCase.Refresh(ctx, req.GetRefreshToken())
 	if err != nil {
-		errLower := strings.ToLower(err.Error())
-		if strings.Contains(errLower, "validate refresh token") || strings.Contains(errLower, "revoke refresh token") {
-			return nil, status.Errorf(codes.PermissionDenied, "refresh token: %v", err)
-		}
-		return nil, status.Errorf(codes.Internal, "refresh token: %v", err)
+		return nil, fmt.Errorf("refresh token: %w", err)
 	}
 
 	return &user.RefreshTokenResponse{
@@ -163,8 +166,12 @@ func (s *UserService) RefreshToken(ctx context.Context, req *user.RefreshTokenRe
 }
 
 func (s *UserService) Logout(ctx context.Context, req *user.LogoutRequest) (*user.LogoutResponse, error) {
-	if err := s.logoutUseCase.Logout(ctx, req.GetRefreshToken()); err != nil {
-		return nil, status.Errorf(codes.Internal, "logout: %v", err)
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	if err := s.logoutUseCase.LogoutFromClient(ctx, uid, req.GetRefreshToken(), grpc_server.ClientInfoFromContext(ctx)); err != nil {
+		return nil, fmt.Errorf("logout: %w", err)
 	}
 
 	return &user.LogoutResponse{
@@ -174,66 +181,93 @@ func (s *UserService) Logout(ctx context.Context, req *user.LogoutRequest) (*use
 }
 
 func (s *UserService) Delete(ctx context.Context, req *user.DeleteRequest) (*user.DeleteResponse, error) {
-	if err := s.deleteUseCase.DeleteAccount(ctx, req.GetUsername()); err != nil {
-		switch {
-		case errors.Is(err, userusecase.ErrEmptyUsername):
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case errors.Is(err, userusecase.ErrPermissionDenied):
-			return nil, status.Error(codes.PermissionDenied, err.Error())
-		default:
-			if strings.Contains(strings.ToLower(err.Error()), "not found") {
-				return nil, status.Errorf(codes.NotFound, "delete user: %v", err)
-			}
-			return nil, status.Errorf(codes.Internal, "delete user: %v", err)
-		}
+	entity, err := s.deleteUseCase.DeleteAccount(ctx, req.GetUsername())
+	if err != nil {
+		return nil, fmt.Errorf("delete user: %w", err)
 	}
 
 	return &user.DeleteResponse{
-		Code:    uint32(codes.OK),
-		Message: codes.OK.String(),
+		Code:                uint32(codes.OK),
+		Message:             codes.OK.String(),
+		DeletionScheduledAt: entity.DeletionScheduledAt.Unix(),
 	}, nil
 }
 
 func (s *UserService) Get(ctx context.Context, req *user.GetUserRequest) (*user.GetUserResponse, error) {
 	entity, err := s.getUseCase.Get(ctx, req.GetUsername())
 	if err != nil {
-		code := codes.Internal
-		if strings.Contains(strings.ToLower(err.Error()), "not found") {
-			code = codes.NotFound
-		}
-		return nil, status.Errorf(code, "get user: %v", err)
+		return nil, fmt.Errorf("get user: %w", err)
 	}
+	setETag(ctx, entity.Version)
 
 	return &user.GetUserResponse{
 		Code:    uint32(codes.OK),
 		Message: codes.OK.String(),
-		Data:    toUserProfile(entity),
+		Data:    s.profile(entity),
 	}, nil
 }
 
 func (s *UserService) List(ctx context.Context, req *user.ListUsersRequest) (*user.ListUsersResponse, error) {
-	result, err := s.listUseCase.List(ctx, req.GetPage(), req.GetPageSize())
+	result, err := s.listUseCase.List(ctx, toListUsersQuery(req))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "list users: %v", err)
+		return nil, fmt.Errorf("list users: %w", err)
 	}
 
 	profiles := make([]*user.UserProfile, 0, len(result.Users))
 	for _, entity := range result.Users {
-		profiles = append(profiles, toUserProfile(entity))
+		profiles = append(profiles, s.profile(entity))
 	}
 
 	return &user.ListUsersResponse{
-		Code:     uint32(codes.OK),
-		Message:  codes.OK.String(),
-		Data:     profiles,
-		Total:    uint64(result.Total),
-		Page:     result.Page,
-		PageSize: result.PageSize,
+		Code:          uint32(codes.OK),
+		Message:       codes.OK.String(),
+		Data:          profiles,
+		Total:         uint64(result.Total),
+		Page:          result.Page,
+		PageSize:      result.PageSize,
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
+func toListUsersQuery(req *user.ListUsersRequest) userusecase.ListUsersQuery {
+	statuses := make([]userentity.AccountStatus, 0, len(req.GetStatuses()))
+	for _, st := range req.GetStatuses() {
+		statuses = append(statuses, userentity.AccountStatus(st))
+	}
+	filter := ports.ListUsersFilter{
+		Statuses:       statuses,
+		EmailPrefix:    req.GetEmailPrefix(),
+		UsernamePrefix: req.GetUsernamePrefix(),
+		NamePrefix:     req.GetNamePrefix(),
+		PhoneNumber:    req.GetPhoneNumber(),
+		DocumentID:     req.GetCmnd(),
+		Query:          req.GetQuery(),
+	}
+	if req.Verified != nil {
+		verified := req.GetVerified().GetValue()
+		filter.Verified = &verified
+	}
+	if req.CreatedAfter != nil {
+		filter.CreatedAfter = req.GetCreatedAfter().AsTime()
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = req.GetCreatedBefore().AsTime()
+	}
+	return userusecase.ListUsersQuery{
+		Page:      req.GetPage(),
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+		OrderBy:   req.GetOrderBy(),
+		Filter:    filter,
+	}
+}
+
 func (s *UserService) Update(ctx context.Context, req *user.UpdateUserRequest) (*user.UpdateUserResponse, error) {
-	err := s.updateUseCase.UpdateProfile(ctx, req.GetUsername(), userusecase.RequestUpdate{
+	version, err := expectedVersion(ctx, req.GetEtag())
+	if err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+	updated, err := s.updateUseCase.UpdateProfile(ctx, req.GetUsername(), userusecase.RequestUpdate{
 		Email:            req.GetEmail(),
 		Name:             req.GetName(),
 		Cmnd:             req.GetCmnd(),
@@ -241,37 +275,25 @@ func (s *UserService) Update(ctx context.Context, req *user.UpdateUserRequest) (
 		Gender:           req.GetGender(),
 		PermanentAddress: req.GetPermanentAddress(),
 		PhoneNumber:      req.GetPhoneNumber(),
+		Fields:           req.GetUpdateMask().GetPaths(),
+		ExpectedVersion:  version,
 	})
 	if err != nil {
-		switch {
-		case errors.Is(err, userusecase.ErrUpdateEmptyUsername), errors.Is(err, userusecase.ErrUpdateEmptyEmail), errors.Is(err, userusecase.ErrUpdateEmptyName):
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case strings.Contains(strings.ToLower(err.Error()), "not found"):
-			return nil, status.Errorf(codes.NotFound, "update user: %v", err)
-		default:
-			return nil, status.Errorf(codes.Internal, "update user: %v", err)
-		}
+		return nil, fmt.Errorf("update user: %w", err)
 	}
+	setETag(ctx, updated.Version)
 
 	return &user.UpdateUserResponse{
 		Code:    uint32(codes.OK),
 		Message: codes.OK.String(),
+		Data:    s.profile(updated),
 	}, nil
 }
 
 func (s *UserService) ChangePassword(ctx context.Context, req *user.ChangePasswordRequest) (*user.ChangePasswordResponse, error) {
 	err := s.changePasswordUseCase.ChangePassword(ctx, req.GetUsername(), req.GetOldPassword(), req.GetNewPassword())
 	if err != nil {
-		switch {
-		case errors.Is(err, userusecase.ErrChangePasswordEmptyUsername), errors.Is(err, userusecase.ErrChangePasswordEmptyNew):
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case errors.Is(err, userusecase.ErrChangePasswordInvalidCurrent):
-			return nil, status.Error(codes.PermissionDenied, err.Error())
-		case strings.Contains(strings.ToLower(err.Error()), "not found"):
-			return nil, status.Errorf(codes.NotFound, "change password: %v", err)
-		default:
-			return nil, status.Errorf(codes.Internal, "change password: %v", err)
-		}
+		return nil, fmt.Errorf("change password: %w", err)
 	}
 
 	return &user.ChangePasswordResponse{
@@ -280,12 +302,264 @@ func (s *UserService) ChangePassword(ctx context.Context, req *user.ChangePasswo
 	}, nil
 }
 
+func (s *UserService) RequestEmailChange(ctx context.Context, req *user.RequestEmailChangeRequest) (*user.RequestEmailChangeResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	if err := s.emailChangeUseCase.RequestChange(ctx, uid, req.GetUsername(), req.GetNewEmail()); err != nil {
+		return nil, fmt.Errorf("request email change: %w", err)
+	}
+
+	return &user.RequestEmailChangeResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+	}, nil
+}
+
+func (s *UserService) DeactivateAccount(ctx context.Context, req *user.DeactivateAccountRequest) (*user.DeactivateAccountResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	entity, err := s.accountStatusUseCase.Deactivate(ctx, uid, req.GetUsername())
+	if err != nil {
+		return nil, fmt.Errorf("deactivate account: %w", err)
+	}
+
+	return &user.DeactivateAccountResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    s.profile(entity),
+	}, nil
+}
+
+func (s *UserService) ReactivateAccount(ctx context.Context, req *user.ReactivateAccountRequest) (*user.ReactivateAccountResponse, error) {
+	entity, err := s.accountStatusUseCase.Reactivate(ctx, req.GetUsername(), req.GetPassword())
+	if err != nil {
+		return nil, fmt.Errorf("reactivate account: %w", err)
+	}
+	setETag(ctx, entity.Version)
+
+	return &user.ReactivateAccountResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    s.profile(entity),
+	}, nil
+}
+
+func (s *UserService) ExportMyData(ctx context.Context, req *user.ExportMyDataRequest) (*user.ExportMyDataResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	export, err := s.dataExportUseCase.Request(ctx, uid, req.GetUsername(), req.GetFormat())
+	if err != nil {
+		return nil, fmt.Errorf("export my data: %w", err)
+	}
+
+	return &user.ExportMyDataResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toDataExport(export),
+	}, nil
+}
+
+func (s *UserService) GetDataExport(ctx context.Context, req *user.GetDataExportRequest) (*user.GetDataExportResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	export, err := s.dataExportUseCase.Get(ctx, uid, req.GetUsername(), req.GetExportId())
+	if err != nil {
+		return nil, fmt.Errorf("get data export: %w", err)
+	}
+
+	return &user.GetDataExportResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toDataExport(export),
+	}, nil
+}
+
+func (s *UserService) EraseMyData(ctx context.Context, req *user.EraseMyDataRequest) (*user.EraseMyDataResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	entity, err := s.deleteUseCase.EraseAccountOwned(ctx, uid, req.GetUsername(), req.GetPassword())
+	if err != nil {
+		return nil, fmt.Errorf("erase my data: %w", err)
+	}
+
+	return &user.EraseMyDataResponse{
+		Code:               uint32(codes.OK),
+		Message:            codes.OK.String(),
+		ErasureScheduledAt: entity.DeletionScheduledAt.Unix(),
+	}, nil
+}
+
+func (s *UserService) ConfirmEmailChange(ctx context.Context, req *user.ConfirmEmailChangeRequest) (*user.ConfirmEmailChangeResponse, error) {
+	entity, err := s.emailChangeUseCase.Confirm(ctx, req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("confirm email change: %w", err)
+	}
+	setETag(ctx, entity.Version)
+
+	return &user.ConfirmEmailChangeResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    s.profile(entity),
+	}, nil
+}
+
+func (s *UserService) GetLoginHistory(ctx context.Context, req *user.GetLoginHistoryRequest) (*user.GetLoginHistoryResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	result, err := s.loginHistoryUseCase.List(ctx, uid, req.GetUsername(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, fmt.Errorf("get login history: %w", err)
+	}
+
+	records := make([]*user.LoginRecord, 0, len(result.Records))
+	for _, record := range result.Records {
+		records = append(records, &user.LoginRecord{
+			Id:        record.ID,
+			Type:      string(record.Type),
+			Ip:        record.IP,
+			UserAgent: record.UserAgent,
+			NewDevice: record.NewDevice,
+			CreatedAt: record.CreatedAt.Unix(),
+		})
+	}
+
+	return &user.GetLoginHistoryResponse{
+		Code:          uint32(codes.OK),
+		Message:       codes.OK.String(),
+		Data:          records,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *UserService) CreateAvatarUploadUrl(ctx context.Context, req *user.CreateAvatarUploadUrlRequest) (*user.CreateAvatarUploadUrlResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	upload, err := s.avatarUseCase.CreateUploadURL(ctx, uid, req.GetUsername())
+	if err != nil {
+		return nil, fmt.Errorf("create avatar upload url: %w", err)
+	}
+
+	return &user.CreateAvatarUploadUrlResponse{
+		Code:      uint32(codes.OK),
+		Message:   codes.OK.String(),
+		UploadUrl: upload.URL,
+		UploadKey: upload.Key,
+		ExpiresAt: upload.ExpiresAt.Unix(),
+		MaxBytes:  upload.MaxBytes,
+	}, nil
+}
+
+func (s *UserService) UploadAvatar(ctx context.Context, req *user.UploadAvatarRequest) (*user.UploadAvatarResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	updated, err := s.avatarUseCase.UploadAvatar(ctx, uid, req.GetUsername(), req.GetUploadKey())
+	if err != nil {
+		return nil, fmt.Errorf("upload avatar: %w", err)
+	}
+	setETag(ctx, updated.Version)
+
+	return &user.UploadAvatarResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    s.profile(updated),
+	}, nil
+}
+
+func (s *UserService) ListAuditEvents(ctx context.Context, req *user.ListAuditEventsRequest) (*user.ListAuditEventsResponse, error) {
+	result, err := s.auditUseCase.List(ctx, toListAuditEventsQuery(req))
+	if err != nil {
+		return nil, fmt.Errorf("list audit events: %w", err)
+	}
+
+	events := make([]*user.AuditEvent, 0, len(result.Events))
+	for _, event := range result.Events {
+		events = append(events, toAuditEvent(event))
+	}
+
+	return &user.ListAuditEventsResponse{
+		Code:          uint32(codes.OK),
+		Message:       codes.OK.String(),
+		Data:          events,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func toListAuditEventsQuery(req *user.ListAuditEventsRequest) userusecase.ListAuditEventsQuery {
+	actions := make([]userentity.AuditAction, 0, len(req.GetActions()))
+	for _, action := range req.GetActions() {
+		actions = append(actions, userentity.AuditAction(action))
+	}
+	filter := ports.AuditEventFilter{
+		UserID:    req.GetUserId(),
+		ActorID:   req.GetActorId(),
+		Actions:   actions,
+		RequestID: req.GetRequestId(),
+	}
+	if req.CreatedAfter != nil {
+		filter.CreatedAfter = req.GetCreatedAfter().AsTime()
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = req.GetCreatedBefore().AsTime()
+	}
+	return userusecase.ListAuditEventsQuery{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+		Filter:    filter,
+	}
+}
+
+func toAuditEvent(event userentity.AuditEvent) *user.AuditEvent {
+	var changes map[string]*user.AuditChange
+	if len(event.Changes) > 0 {
+		changes = make(map[string]*user.AuditChange, len(event.Changes))
+		for field, change := range event.Changes {
+			changes[field] = &user.AuditChange{From: change.From, To: change.To, Redacted: change.Redacted}
+		}
+	}
+	return &user.AuditEvent{
+		Id:        event.ID,
+		UserId:    event.UserID,
+		ActorId:   event.ActorID,
+		Action:    string(event.Action),
+		Method:    event.Method,
+		Ip:        event.IP,
+		UserAgent: event.UserAgent,
+		RequestId: event.RequestID,
+		Changes:   changes,
+		CreatedAt: event.CreatedAt.Unix(),
+	}
+}
+
+// profile renders entity with a freshly signed avatar URL.
+func (s *UserService) profile(entity userentity.User) *user.UserProfile {
+	out := toUserProfile(entity)
+	out.AvatarUrl = s.avatarUseCase.AvatarURL(entity)
+	return out
+}
+
 func toUserProfile(entity userentity.User) *user.UserProfile {
 	var (
-		birthday   int64
-		created    int64
-		updated    int64
-		verifiedAt int64
+		birthday            int64
+		created             int64
+		updated             int64
+		verifiedAt          int64
+		deletionScheduledAt int64
 	)
 	if !entity.Birthday.IsZero() {
 		birthday = entity.Birthday.Unix()
@@ -299,20 +573,48 @@ func toUserProfile(entity userentity.User) *user.UserProfile {
 	if !entity.VerifiedAt.IsZero() {
 		verifiedAt = entity.VerifiedAt.Unix()
 	}
+	if !entity.DeletionScheduledAt.IsZero() {
+		deletionScheduledAt = entity.DeletionScheduledAt.Unix()
+	}
 
 	return &user.UserProfile{
-		Id:               entity.Id,
-		Username:         entity.Username,
-		Name:             entity.Name,
-		Email:            entity.Email,
-		Cmnd:             entity.DocumentID,
-		Birthday:         birthday,
-		Gender:           entity.Gender,
-		PermanentAddress: entity.PermanentAddress,
-		PhoneNumber:      entity.PhoneNumber,
-		CreatedAt:        created,
-		UpdatedAt:        updated,
-		Verified:         entity.Verified,
-		VerifiedAt:       verifiedAt,
+		Id:                  entity.Id,
+		Username:            entity.Username,
+		Name:                entity.Name,
+		Email:               entity.Email,
+		Cmnd:                entity.DocumentID,
+		Birthday:            birthday,
+		Gender:              entity.Gender,
+		PermanentAddress:    entity.PermanentAddress,
+		PhoneNumber:         entity.PhoneNumber,
+		CreatedAt:           created,
+		UpdatedAt:           updated,
+		Verified:            entity.Verified,
+		VerifiedAt:          verifiedAt,
+		Etag:                formatETag(entity.Version),
+		Status:              string(entity.Status),
+		DeletionScheduledAt: deletionScheduledAt,
+	}
+}
+
+func toDataExport(export userentity.DataExport) *user.DataExport {
+	out := &user.DataExport{
+		Id:          export.ID,
+		Format:      string(export.Format),
+		Status:      string(export.Status),
+		CreatedAt:   export.CreatedAt.Unix(),
+		Error:       export.Error,
+		FileName:    userusecase.DataExportFileName(export),
+		ContentType: userusecase.DataExportContentType(export.Format),
+	}
+	if !export.CompletedAt.IsZero() {
+		out.CompletedAt = export.CompletedAt.Unix()
+	}
+	if !export.ExpiresAt.IsZero() {
+		out.ExpiresAt = export.ExpiresAt.Unix()
+	}
+	if export.Status == userentity.DataExportStatusReady {
+		out.Content = export.Content
 	}
+	return out
 }
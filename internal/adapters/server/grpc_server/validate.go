@@ -53,8 +53,17 @@ func parserError(err error) error {
 	return status.Error(codes.InvalidArgument, err.Error())
 }
 
+// invalidRequestReason is reported for requests rejected by proto validation.
+// The user-facing text comes from the i18n catalog via LocalizedMessage.
+const invalidRequestReason = "INVALID_REQUEST"
+
+func invalidRequestStatus(br *errdetails.BadRequest) error {
+	sts := status.New(codes.InvalidArgument, "invalid request")
+	sts, _ = sts.WithDetails(&errdetails.ErrorInfo{Reason: invalidRequestReason, Domain: ErrorDomain}, br)
+	return sts.Err()
+}
+
 func parserErrorList(errs errorList) error {
-	sts := status.New(codes.InvalidArgument, "yêu cầu không hợp lệ.")
 	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0, len(errs.AllErrors()))
 	for _, e := range errs.AllErrors() {
 		if v, ok := e.(errorValidation); ok {
@@ -67,12 +76,10 @@ func parserErrorList(errs errorList) error {
 	br := &errdetails.BadRequest{
 		FieldViolations: fieldViolations,
 	}
-	sts, _ = sts.WithDetails(br)
-	return sts.Err()
+	return invalidRequestStatus(br)
 }
 
 func parserErrorValidation(err errorValidation) error {
-	sts := status.New(codes.InvalidArgument, "yêu cầu không hợp lệ.")
 	br := &errdetails.BadRequest{
 		FieldViolations: []*errdetails.BadRequest_FieldViolation{
 			{
@@ -81,8 +88,7 @@ func parserErrorValidation(err errorValidation) error {
 			},
 		},
 	}
-	sts, _ = sts.WithDetails(br)
-	return sts.Err()
+	return invalidRequestStatus(br)
 }
 
 // RequestValidationUnaryServerInterceptor returns a new unary server interceptor that validates incoming messages.
@@ -96,3 +102,22 @@ func RequestValidationUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// RequestValidationStreamServerInterceptor validates every message a stream
+// receives, like RequestValidationUnaryServerInterceptor.
+func RequestValidationStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(m)
+}
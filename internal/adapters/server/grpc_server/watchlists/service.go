@@ -0,0 +1,183 @@
+package watchlists
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchlistService implements the WatchlistService gRPC API. Errors are
+// mapped to statuses by the grpc_server error interceptors.
+type WatchlistService struct {
+	user.UnimplementedWatchlistServiceServer
+	watchlistUseCase userusecase.UserWatchlistUseCase
+}
+
+func NewWatchlistService(watchlistUseCase userusecase.UserWatchlistUseCase) *WatchlistService {
+	return &WatchlistService{watchlistUseCase: watchlistUseCase}
+}
+
+func (s *WatchlistService) RegisterService(server grpc.ServiceRegistrar) {
+	user.RegisterWatchlistServiceServer(server, s)
+}
+
+func (s *WatchlistService) ListWatchlists(ctx context.Context, req *user.ListWatchlistsRequest) (*user.ListWatchlistsResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	watchlists, err := s.watchlistUseCase.List(ctx, uid, req.GetUsername())
+	if err != nil {
+		return nil, fmt.Errorf("list watchlists: %w", err)
+	}
+
+	data := make([]*user.Watchlist, 0, len(watchlists))
+	for _, watchlist := range watchlists {
+		data = append(data, toWatchlist(watchlist))
+	}
+	return &user.ListWatchlistsResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    data,
+	}, nil
+}
+
+func (s *WatchlistService) CreateWatchlist(ctx context.Context, req *user.CreateWatchlistRequest) (*user.CreateWatchlistResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	watchlist, err := s.watchlistUseCase.Create(ctx, uid, req.GetUsername(), req.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("create watchlist: %w", err)
+	}
+
+	return &user.CreateWatchlistResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toWatchlist(watchlist),
+	}, nil
+}
+
+func (s *WatchlistService) RenameWatchlist(ctx context.Context, req *user.RenameWatchlistRequest) (*user.RenameWatchlistResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	watchlist, err := s.watchlistUseCase.Rename(ctx, uid, req.GetUsername(), req.GetWatchlistId(), req.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("rename watchlist: %w", err)
+	}
+
+	return &user.RenameWatchlistResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toWatchlist(watchlist),
+	}, nil
+}
+
+func (s *WatchlistService) DeleteWatchlist(ctx context.Context, req *user.DeleteWatchlistRequest) (*user.DeleteWatchlistResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	if err := s.watchlistUseCase.Delete(ctx, uid, req.GetUsername(), req.GetWatchlistId()); err != nil {
+		return nil, fmt.Errorf("delete watchlist: %w", err)
+	}
+
+	return &user.DeleteWatchlistResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+	}, nil
+}
+
+func (s *WatchlistService) AddWatchlistStock(ctx context.Context, req *user.AddWatchlistStockRequest) (*user.AddWatchlistStockResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	watchlist, err := s.watchlistUseCase.AddStock(ctx, uid, req.GetUsername(), req.GetWatchlistId(), req.GetCode())
+	if err != nil {
+		return nil, fmt.Errorf("add watchlist stock: %w", err)
+	}
+
+	return &user.AddWatchlistStockResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toWatchlist(watchlist),
+	}, nil
+}
+
+func (s *WatchlistService) RemoveWatchlistStock(ctx context.Context, req *user.RemoveWatchlistStockRequest) (*user.RemoveWatchlistStockResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	watchlist, err := s.watchlistUseCase.RemoveStock(ctx, uid, req.GetUsername(), req.GetWatchlistId(), req.GetCode())
+	if err != nil {
+		return nil, fmt.Errorf("remove watchlist stock: %w", err)
+	}
+
+	return &user.RemoveWatchlistStockResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toWatchlist(watchlist),
+	}, nil
+}
+
+func (s *WatchlistService) ReorderWatchlist(ctx context.Context, req *user.ReorderWatchlistRequest) (*user.ReorderWatchlistResponse, error) {
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	watchlist, err := s.watchlistUseCase.Reorder(ctx, uid, req.GetUsername(), req.GetWatchlistId(), req.GetCodes())
+	if err != nil {
+		return nil, fmt.Errorf("reorder watchlist: %w", err)
+	}
+
+	return &user.ReorderWatchlistResponse{
+		Code:    uint32(codes.OK),
+		Message: codes.OK.String(),
+		Data:    toWatchlist(watchlist),
+	}, nil
+}
+
+func (s *WatchlistService) WatchWatchlistQuotes(req *user.WatchWatchlistQuotesRequest, stream grpc.ServerStreamingServer[user.WatchWatchlistQuotesResponse]) error {
+	ctx := stream.Context()
+	uid, ok := grpc_server.UserIDFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing user identity")
+	}
+	err := s.watchlistUseCase.WatchQuotes(ctx, uid, req.GetUsername(), req.GetWatchlistId(), func(quotes []userentity.StockQuote) error {
+		resp := &user.WatchWatchlistQuotesResponse{Quotes: make([]*user.StockQuote, 0, len(quotes))}
+		for _, quote := range quotes {
+			resp.Quotes = append(resp.Quotes, &user.StockQuote{
+				Code:  quote.Code,
+				Price: quote.Price,
+				At:    quote.At.Unix(),
+			})
+		}
+		return stream.Send(resp)
+	})
+	if err != nil {
+		return fmt.Errorf("watch watchlist quotes: %w", err)
+	}
+	return nil
+}
+
+func toWatchlist(watchlist userentity.Watchlist) *user.Watchlist {
+	return &user.Watchlist{
+		Id:        watchlist.ID,
+		Name:      watchlist.Name,
+		Codes:     watchlist.Codes,
+		CreatedAt: watchlist.CreatedAt.Unix(),
+		UpdatedAt: watchlist.UpdatedAt.Unix(),
+	}
+}
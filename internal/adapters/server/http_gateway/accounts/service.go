@@ -0,0 +1,27 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type AccountService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewAccountGatewayService(conn *grpc.ClientConn) *AccountService {
+	return &AccountService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *AccountService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterAccountServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for account service: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type PriceAlertService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewPriceAlertGatewayService(conn *grpc.ClientConn) *PriceAlertService {
+	return &PriceAlertService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *PriceAlertService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterPriceAlertServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for price alert service: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package blobs
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/blobstore"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/grpc_server"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blobPath serves the URLs signed by blobstore.URLSigner; keys contain
+// slashes.
+const blobPath = "/api/v1/blobs/{key=**}"
+
+// sniffLen is the number of leading bytes http.DetectContentType reads.
+const sniffLen = 512
+
+var (
+	ErrBlobTooLarge    = apperrors.New(apperrors.ErrInvalidArgument, "BLOB_TOO_LARGE", "file is larger than this upload allows")
+	ErrBlobContentType = apperrors.New(apperrors.ErrInvalidArgument, "BLOB_CONTENT_TYPE", "file type is not accepted for this upload")
+)
+
+// BlobService transfers blobs for holders of signed URLs. It talks to the
+// blob store directly instead of through gRPC, so uploads and downloads are
+// streamed rather than wrapped in messages; the URL signature stands in for
+// the caller's credentials.
+type BlobService struct {
+	store  ports.BlobStore
+	signer *blobstore.URLSigner
+}
+
+func NewBlobGatewayService(store ports.BlobStore, signer *blobstore.URLSigner) *BlobService {
+	return &BlobService{
+		store:  store,
+		signer: signer,
+	}
+}
+
+func (s *BlobService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := mux.HandlePath(http.MethodGet, blobPath, s.downloadHandler(mux)); err != nil {
+		return fmt.Errorf("failed to register blob download: %w", err)
+	}
+	if err := mux.HandlePath(http.MethodPut, blobPath, s.uploadHandler(mux)); err != nil {
+		return fmt.Errorf("failed to register blob upload: %w", err)
+	}
+	return nil
+}
+
+// downloadHandler streams the blob with the content type sniffed from its
+// first bytes; browsers are told not to sniff again.
+func (s *BlobService) downloadHandler(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		key := pathParams["key"]
+		if _, err := s.signer.Verify(http.MethodGet, key, r.URL.Query(), time.Now()); err != nil {
+			writeError(mux, w, r, err, 0)
+			return
+		}
+		rc, err := s.store.Get(r.Context(), key)
+		if err != nil {
+			writeError(mux, w, r, err, 0)
+			return
+		}
+		defer rc.Close()
+
+		content := bufio.NewReaderSize(rc, sniffLen)
+		head, err := content.Peek(sniffLen)
+		if err != nil && !errors.Is(err, io.EOF) {
+			writeError(mux, w, r, fmt.Errorf("read blob: %w", err), 0)
+			return
+		}
+		w.Header().Set("Content-Type", http.DetectContentType(head))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", "private")
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.Copy(w, content)
+	}
+}
+
+// uploadHandler stores the request body once it fits the size limit and
+// content types signed into the URL.
+func (s *BlobService) uploadHandler(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		key := pathParams["key"]
+		policy, err := s.signer.Verify(http.MethodPut, key, r.URL.Query(), time.Now())
+		if err != nil {
+			writeError(mux, w, r, err, 0)
+			return
+		}
+
+		content, err := io.ReadAll(http.MaxBytesReader(w, r.Body, policy.MaxBytes))
+		if err != nil {
+			var maxBytes *http.MaxBytesError
+			if errors.As(err, &maxBytes) {
+				writeError(mux, w, r, ErrBlobTooLarge, http.StatusRequestEntityTooLarge)
+				return
+			}
+			writeError(mux, w, r, status.Error(codes.InvalidArgument, "could not read the uploaded file"), 0)
+			return
+		}
+		contentType := http.DetectContentType(content)
+		if len(policy.ContentTypes) > 0 && !accepts(policy.ContentTypes, contentType) {
+			writeError(mux, w, r, ErrBlobContentType, http.StatusUnsupportedMediaType)
+			return
+		}
+		if err := s.store.Put(r.Context(), key, bytes.NewReader(content), contentType); err != nil {
+			writeError(mux, w, r, err, 0)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// writeError answers with the gateway's error envelope, using httpStatus
+// instead of the one derived from the error when it is set.
+func writeError(mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request, err error, httpStatus int) {
+	err = grpc_server.StatusError(r.Context(), blobPath, err)
+	if httpStatus != 0 {
+		err = &runtime.HTTPStatusError{HTTPStatus: httpStatus, Err: err}
+	}
+	_, outbound := runtime.MarshalerForRequest(mux, r)
+	runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+}
+
+func accepts(contentTypes []string, contentType string) bool {
+	for _, accepted := range contentTypes {
+		if accepted == contentType {
+			return true
+		}
+	}
+	return false
+}
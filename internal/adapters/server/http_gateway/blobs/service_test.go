@@ -0,0 +1,106 @@
+package blobs
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/blobstore"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/server/http_gateway"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var testPNG = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 64)...)
+
+// newTestGateway serves the blob routes. Its signer issues URLs relative to
+// the returned server URL.
+func newTestGateway(t *testing.T) (string, *blobstore.URLSigner) {
+	t.Helper()
+	store, err := blobstore.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	signer, err := blobstore.NewURLSigner("gateway-test-secret-0123456789", "/api/v1/blobs")
+	require.NoError(t, err)
+
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(http_gateway.ErrorHandler))
+	require.NoError(t, NewBlobGatewayService(store, signer).HTTPGatewayRegister(mux))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server.URL, signer
+}
+
+func put(t *testing.T, rawURL string, body []byte) (*http.Response, http_gateway.ErrorResponse) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPut, rawURL, bytes.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var errResp http_gateway.ErrorResponse
+	if resp.StatusCode >= 400 {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&errResp))
+	}
+	return resp, errResp
+}
+
+func TestBlobGatewayUploadAndDownload(t *testing.T) {
+	base, signer := newTestGateway(t)
+	policy := ports.BlobUploadPolicy{MaxBytes: 128, ContentTypes: []string{"image/png"}}
+	uploadURL := base + signer.UploadURL("avatars/7/abc", time.Now().Add(time.Minute), policy)
+
+	resp, _ := put(t, uploadURL, testPNG)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err := http.Get(base + signer.DownloadURL("avatars/7/abc", time.Now().Add(time.Minute)))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+	require.Equal(t, "nosniff", resp.Header.Get("X-Content-Type-Options"))
+	require.Equal(t, testPNG, content)
+
+	// The upload URL does not allow downloads, nor other keys.
+	resp, err = http.Get(uploadURL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	signed, err := url.Parse(uploadURL)
+	require.NoError(t, err)
+	signed.Path = "/api/v1/blobs/avatars/8/abc"
+	resp, errResp := put(t, signed.String(), testPNG)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	require.Equal(t, "INVALID_BLOB_SIGNATURE", errResp.Reason)
+
+	resp, err = http.Get(base + signer.DownloadURL("avatars/7/missing", time.Now().Add(time.Minute)))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestBlobGatewayUploadLimits(t *testing.T) {
+	base, signer := newTestGateway(t)
+	policy := ports.BlobUploadPolicy{MaxBytes: 128, ContentTypes: []string{"image/png"}}
+	uploadURL := base + signer.UploadURL("avatars/7/abc", time.Now().Add(time.Minute), policy)
+
+	resp, errResp := put(t, uploadURL, append(append([]byte{}, testPNG...), make([]byte, 128)...))
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	require.Equal(t, "BLOB_TOO_LARGE", errResp.Reason)
+
+	resp, errResp = put(t, uploadURL, []byte("<html><script>alert(1)</script></html>"))
+	require.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	require.Equal(t, "BLOB_CONTENT_TYPE", errResp.Reason)
+
+	expired := base + signer.UploadURL("avatars/7/abc", time.Now().Add(-time.Second), policy)
+	resp, errResp = put(t, expired, testPNG)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	require.Equal(t, "BLOB_URL_EXPIRED", errResp.Reason)
+}
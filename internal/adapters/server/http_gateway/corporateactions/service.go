@@ -0,0 +1,27 @@
+package corporateactions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type CorporateActionService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewCorporateActionGatewayService(conn *grpc.ClientConn) *CorporateActionService {
+	return &CorporateActionService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *CorporateActionService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterCorporateActionServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for corporate action service: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package http_gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader carries the request correlation ID. It is echoed on every
+// response and reported in the error envelope.
+const RequestIDHeader = "X-Request-Id"
+
+// ErrorResponse is the JSON envelope returned for every failed gateway request.
+type ErrorResponse struct {
+	// Code is the canonical gRPC code name, e.g. "NOT_FOUND".
+	Code string `json:"code"`
+	// Reason is the stable machine-readable reason from google.rpc.ErrorInfo.
+	Reason string `json:"reason"`
+	// Metadata details the reason, from google.rpc.ErrorInfo, such as the
+	// limit a rejected order exceeded.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Message is localized from the request Accept-Language header.
+	Message         string           `json:"message"`
+	FieldViolations []FieldViolation `json:"field_violations,omitempty"`
+	RequestID       string           `json:"request_id"`
+}
+
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// ErrorHandler replaces runtime.DefaultHTTPErrorHandler and writes an
+// ErrorResponse instead of the raw google.rpc.Status.
+func ErrorHandler(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	httpStatus := 0
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		httpStatus = customStatus.HTTPStatus
+		err = customStatus.Err
+	}
+	st := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = runtime.HTTPStatusFromCode(st.Code())
+		// A failed If-Match precondition is a 412 rather than a plain conflict.
+		if st.Code() == codes.Aborted && r.Header.Get("If-Match") != "" {
+			httpStatus = http.StatusPreconditionFailed
+		}
+	}
+
+	resp := newErrorResponse(st, i18n.ParseAcceptLanguage(r.Header.Get(i18n.AcceptLanguageHeader)))
+	resp.RequestID = r.Header.Get(RequestIDHeader)
+
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", "application/json")
+	if resp.RequestID != "" {
+		w.Header().Set(RequestIDHeader, resp.RequestID)
+	}
+	w.WriteHeader(httpStatus)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		slog.ErrorContext(ctx, "HTTP GATEWAY WRITE ERROR RESPONSE FAILED", "error", err)
+	}
+}
+
+func newErrorResponse(st *status.Status, locale string) ErrorResponse {
+	resp := ErrorResponse{
+		Code:    i18n.CodeReason(st.Code()),
+		Message: st.Message(),
+	}
+	var localized *errdetails.LocalizedMessage
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			resp.Reason = d.GetReason()
+			resp.Metadata = d.GetMetadata()
+		case *errdetails.LocalizedMessage:
+			localized = d
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				resp.FieldViolations = append(resp.FieldViolations, FieldViolation{
+					Field:       v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		}
+	}
+	if resp.Reason == "" {
+		resp.Reason = resp.Code
+	}
+
+	switch {
+	case localized != nil && localized.GetLocale() == locale:
+		resp.Message = localized.GetMessage()
+	default:
+		if msg, ok := i18n.Message(locale, resp.Reason); ok {
+			resp.Message = msg
+		} else if msg, ok := i18n.Message(locale, resp.Code); ok {
+			resp.Message = msg
+		}
+	}
+	return resp
+}
+
+// withRequestID makes sure every request carries a request ID (generating one
+// when the client did not send it) so it can be forwarded to gRPC and echoed.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+			r.Header.Set(RequestIDHeader, id)
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,115 @@
+package http_gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func serveError(t *testing.T, err error, headers map[string]string) (*httptest.ResponseRecorder, ErrorResponse) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/user/alice", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	withRequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ErrorHandler(context.Background(), nil, nil, w, r, err)
+	})).ServeHTTP(rec, req)
+
+	var body ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return rec, body
+}
+
+func TestErrorHandlerEnvelope(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid request").WithDetails(
+		&errdetails.ErrorInfo{Reason: "INVALID_REQUEST", Domain: "stock-trading-be"},
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "email", Description: "value must be a valid email address"},
+		}},
+		&errdetails.LocalizedMessage{Locale: "en", Message: "The request is invalid."},
+	)
+	require.NoError(t, err)
+
+	rec, body := serveError(t, st.Err(), map[string]string{
+		"Accept-Language": "en-US,en;q=0.9",
+		RequestIDHeader:   "req-123",
+	})
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.Equal(t, "req-123", rec.Header().Get(RequestIDHeader))
+	require.Equal(t, ErrorResponse{
+		Code:            "INVALID_ARGUMENT",
+		Reason:          "INVALID_REQUEST",
+		Message:         "The request is invalid.",
+		FieldViolations: []FieldViolation{{Field: "email", Description: "value must be a valid email address"}},
+		RequestID:       "req-123",
+	}, body)
+}
+
+func TestErrorHandlerMetadata(t *testing.T) {
+	st, err := status.New(codes.FailedPrecondition, "insufficient buying power").WithDetails(
+		&errdetails.ErrorInfo{Reason: "INSUFFICIENT_BUYING_POWER", Metadata: map[string]string{"required": "2800000", "available": "1000000"}},
+	)
+	require.NoError(t, err)
+
+	rec, body := serveError(t, st.Err(), nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, "INSUFFICIENT_BUYING_POWER", body.Reason)
+	require.Equal(t, map[string]string{"required": "2800000", "available": "1000000"}, body.Metadata)
+}
+
+func TestErrorHandlerLocalizesWithoutDetails(t *testing.T) {
+	// Errors raised by the gateway itself (routing, body decoding) carry no
+	// details; the catalog is consulted by code.
+	rec, body := serveError(t, status.Error(codes.NotFound, "Not Found"), nil)
+
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, "NOT_FOUND", body.Code)
+	require.Equal(t, "NOT_FOUND", body.Reason)
+	require.Equal(t, "Không tìm thấy dữ liệu.", body.Message)
+	require.NotEmpty(t, body.RequestID, "a request ID is generated when missing")
+	require.Equal(t, body.RequestID, rec.Header().Get(RequestIDHeader))
+}
+
+func TestErrorHandlerRelocalizesMismatchedLocale(t *testing.T) {
+	st, err := status.New(codes.NotFound, "user not found").WithDetails(
+		&errdetails.ErrorInfo{Reason: "USER_NOT_FOUND"},
+		&errdetails.LocalizedMessage{Locale: "vi", Message: "Không tìm thấy người dùng."},
+	)
+	require.NoError(t, err)
+
+	_, body := serveError(t, st.Err(), map[string]string{"Accept-Language": "en"})
+	require.Equal(t, "User not found.", body.Message)
+}
+
+func TestErrorHandlerHTTPStatusError(t *testing.T) {
+	rec, body := serveError(t, &runtime.HTTPStatusError{
+		HTTPStatus: http.StatusMethodNotAllowed,
+		Err:        status.Error(codes.Unimplemented, "Method Not Allowed"),
+	}, map[string]string{"Accept-Language": "en"})
+
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	require.Equal(t, "UNIMPLEMENTED", body.Code)
+	require.Equal(t, "This feature is not supported.", body.Message)
+}
+
+func TestErrorHandlerIfMatchPreconditionFailed(t *testing.T) {
+	conflict := status.Error(codes.Aborted, "user was modified concurrently")
+
+	rec, _ := serveError(t, conflict, map[string]string{"If-Match": `"3"`})
+	require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	rec, _ = serveError(t, conflict, nil)
+	require.Equal(t, http.StatusConflict, rec.Code, "without If-Match an aborted update is a plain conflict")
+}
@@ -0,0 +1,27 @@
+package fees
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type FeeService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewFeeGatewayService(conn *grpc.ClientConn) *FeeService {
+	return &FeeService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *FeeService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterFeeServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for fee service: %w", err)
+	}
+	return nil
+}
@@ -31,7 +31,8 @@ func StartServer(cfg Config, grpcGwServices []GrpcGatewayServices, httpServices
 	chanErr := make(chan error, 1)
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(HeaderMatcher),
-		runtime.WithErrorHandler(runtime.DefaultHTTPErrorHandler),
+		runtime.WithOutgoingHeaderMatcher(OutgoingHeaderMatcher),
+		runtime.WithErrorHandler(ErrorHandler),
 		runtime.WithMetadata(ExtractInfoAnnotator),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
@@ -61,7 +62,7 @@ func StartServer(cfg Config, grpcGwServices []GrpcGatewayServices, httpServices
 
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
-		Handler: httpMux,
+		Handler: withRequestID(httpMux),
 	}
 
     graceShutdown = func() {
@@ -91,6 +92,12 @@ func StartServer(cfg Config, grpcGwServices []GrpcGatewayServices, httpServices
 var _Headers = map[string]struct{}{
     // Forward Authorization header to gRPC metadata for auth checks
     "authorization": {},
+    // Language preference for google.rpc.LocalizedMessage error details
+    "accept-language": {},
+    // Request correlation ID set by withRequestID
+    "x-request-id": {},
+    // Optimistic concurrency precondition for profile updates
+    "if-match": {},
 }
 
 func HeaderMatcher(key string) (string, bool) {
@@ -98,9 +105,23 @@ func HeaderMatcher(key string) (string, bool) {
 	if _, ok := _Headers[lowKey]; ok {
 		return lowKey, true
 	}
+	// gRPC reserves user-agent for the gateway's own client, so the browser's
+	// value travels under the gateway's default prefix for the audit log.
+	if lowKey == "user-agent" {
+		return runtime.MetadataPrefix + lowKey, true
+	}
 	return "", false
 }
 
+// OutgoingHeaderMatcher exposes the ETag response metadata as the standard
+// header and keeps the default Grpc-Metadata- prefix for everything else.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if strings.ToLower(key) == "etag" {
+		return "ETag", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 func ExtractInfoAnnotator(ctx context.Context, r *http.Request) metadata.MD {
     md := make(map[string]string)
     if method, ok := runtime.RPCMethod(ctx); ok {
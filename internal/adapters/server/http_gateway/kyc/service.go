@@ -0,0 +1,106 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	userusecase "github.com/sinhnguyen1411/stock-trading-be/internal/usecases/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadDocumentPath accepts document images as multipart/form-data, which
+// the generated gateway cannot map onto UploadKycDocument's bytes field.
+const uploadDocumentPath = "/api/v1/user/{username}/kyc/documents"
+
+// maxUploadFormSize bounds the whole form: the image plus room for the kind
+// field and the multipart headers.
+const maxUploadFormSize = userusecase.MaxKycDocumentSize + 64<<10
+
+type KycService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewKycGatewayService(conn *grpc.ClientConn) *KycService {
+	return &KycService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *KycService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterKycServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for kyc service: %w", err)
+	}
+	client := user.NewKycServiceClient(s.grpcServerConn)
+	if err := mux.HandlePath(http.MethodPost, uploadDocumentPath, uploadDocumentHandler(mux, client)); err != nil {
+		return fmt.Errorf("failed to register kyc document upload: %w", err)
+	}
+	return nil
+}
+
+// uploadDocumentHandler reads the kind and file form fields and forwards them
+// to UploadKycDocument. Headers are forwarded to gRPC metadata exactly like
+// for the generated handlers, so authentication and auditing are unchanged.
+func uploadDocumentHandler(mux *runtime.ServeMux, client user.KycServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, user.KycService_UploadKycDocument_FullMethodName, runtime.WithHTTPPathPattern(uploadDocumentPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		req, err := readUploadForm(w, r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		req.Username = pathParams["username"]
+
+		var md runtime.ServerMetadata
+		resp, err := client.UploadKycDocument(ctx, req, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, resp)
+	}
+}
+
+func readUploadForm(w http.ResponseWriter, r *http.Request) (*user.UploadKycDocumentRequest, error) {
+	tooLarge := &runtime.HTTPStatusError{
+		HTTPStatus: http.StatusRequestEntityTooLarge,
+		Err:        status.Error(codes.InvalidArgument, userusecase.ErrKycDocumentTooLarge.Error()),
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadFormSize)
+	if err := r.ParseMultipartForm(maxUploadFormSize); err != nil {
+		var maxBytes *http.MaxBytesError
+		if errors.As(err, &maxBytes) {
+			return nil, tooLarge
+		}
+		return nil, status.Error(codes.InvalidArgument, "expected a multipart/form-data body")
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "the file field is required")
+	}
+	defer file.Close()
+	content, err := io.ReadAll(io.LimitReader(file, userusecase.MaxKycDocumentSize+1))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not read the uploaded file")
+	}
+	if len(content) > userusecase.MaxKycDocumentSize {
+		return nil, tooLarge
+	}
+	return &user.UploadKycDocumentRequest{
+		Kind:    r.FormValue("kind"),
+		Content: content,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package market
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type MarketService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewMarketGatewayService(conn *grpc.ClientConn) *MarketService {
+	return &MarketService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *MarketService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterMarketServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for market service: %w", err)
+	}
+	return nil
+}
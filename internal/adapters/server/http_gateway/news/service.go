@@ -0,0 +1,27 @@
+package news
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type NewsService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewNewsGatewayService(conn *grpc.ClientConn) *NewsService {
+	return &NewsService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *NewsService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterNewsServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for news service: %w", err)
+	}
+	return nil
+}
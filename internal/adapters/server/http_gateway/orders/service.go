@@ -0,0 +1,27 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type OrderService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewOrderGatewayService(conn *grpc.ClientConn) *OrderService {
+	return &OrderService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *OrderService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterOrderServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for order service: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type PaymentService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewPaymentGatewayService(conn *grpc.ClientConn) *PaymentService {
+	return &PaymentService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *PaymentService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterPaymentServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for payment service: %w", err)
+	}
+	return nil
+}
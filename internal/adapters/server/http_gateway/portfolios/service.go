@@ -0,0 +1,27 @@
+package portfolios
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type PortfolioService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewPortfolioGatewayService(conn *grpc.ClientConn) *PortfolioService {
+	return &PortfolioService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *PortfolioService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterPortfolioServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for portfolio service: %w", err)
+	}
+	return nil
+}
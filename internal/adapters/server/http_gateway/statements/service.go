@@ -0,0 +1,27 @@
+package statements
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type StatementService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewStatementGatewayService(conn *grpc.ClientConn) *StatementService {
+	return &StatementService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *StatementService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterStatementServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for statement service: %w", err)
+	}
+	return nil
+}
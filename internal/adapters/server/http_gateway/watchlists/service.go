@@ -0,0 +1,27 @@
+package watchlists
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sinhnguyen1411/stock-trading-be/api/grpc/user"
+	"google.golang.org/grpc"
+)
+
+type WatchlistService struct {
+	grpcServerConn *grpc.ClientConn
+}
+
+func NewWatchlistGatewayService(conn *grpc.ClientConn) *WatchlistService {
+	return &WatchlistService{
+		grpcServerConn: conn,
+	}
+}
+
+func (s *WatchlistService) HTTPGatewayRegister(mux *runtime.ServeMux) error {
+	if err := user.RegisterWatchlistServiceHandler(context.Background(), mux, s.grpcServerConn); err != nil {
+		return fmt.Errorf("failed to register http gateway for watchlist service: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Package apperrors defines transport-agnostic error kinds shared by the
+// repositories, use cases and servers.
+//
+// Lower layers return (or wrap) one of the kind sentinels below, usually via an
+// *Error that also carries a stable machine-readable reason. Transport adapters
+// classify errors with errors.Is against the kinds instead of matching strings.
+package apperrors
+
+import "errors"
+
+// Error kinds. Every domain error should wrap exactly one of them.
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("already exists")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrResourceExhausted  = errors.New("resource exhausted")
+	ErrAborted            = errors.New("aborted")
+)
+
+// Error is a domain error with a stable reason (UPPER_SNAKE_CASE) and a kind.
+// Its message is returned verbatim by Error so existing texts stay unchanged.
+type Error struct {
+	kind    error
+	reason  string
+	message string
+	// metadata details the reason, such as the limit a request exceeded. It
+	// is set on copies made by WithMetadata; parent is the original.
+	metadata map[string]string
+	parent   *Error
+}
+
+// New returns an *Error of the given kind.
+func New(kind error, reason, message string) *Error {
+	return &Error{kind: kind, reason: reason, message: message}
+}
+
+func (e *Error) Error() string { return e.message }
+
+// Unwrap exposes the kind so errors.Is(err, ErrNotFound) works. A copy made
+// by WithMetadata unwraps to its original, so errors.Is matches the original
+// too.
+func (e *Error) Unwrap() error {
+	if e.parent != nil {
+		return e.parent
+	}
+	return e.kind
+}
+
+// WithMetadata returns a copy of e carrying metadata, which transports report
+// next to the reason. Keys are lower_snake_case.
+func (e *Error) WithMetadata(metadata map[string]string) *Error {
+	return &Error{kind: e.kind, reason: e.reason, message: e.message, metadata: metadata, parent: e}
+}
+
+// Metadata returns the metadata set by WithMetadata, or nil.
+func (e *Error) Metadata() map[string]string { return e.metadata }
+
+// Reason returns the stable machine-readable reason.
+func (e *Error) Reason() string { return e.reason }
+
+// Kind returns the kind sentinel the error belongs to.
+func (e *Error) Kind() error { return e.kind }
+
+// ReasonOf returns the reason of the outermost *Error in the chain, or an empty
+// string when the chain does not contain one.
+func ReasonOf(err error) string {
+	var de *Error
+	if errors.As(err, &de) {
+		return de.reason
+	}
+	return ""
+}
+
+// MetadataOf returns the metadata of the outermost *Error in the chain, or nil.
+func MetadataOf(err error) map[string]string {
+	var de *Error
+	if errors.As(err, &de) {
+		return de.metadata
+	}
+	return nil
+}
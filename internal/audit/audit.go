@@ -0,0 +1,50 @@
+// Package audit carries the audit events of a request from the use cases that
+// perform an action to the transport layer, which adds the request metadata
+// and stores them.
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+type recorderKey struct{}
+
+// Recorder collects the audit events recorded while serving one request.
+type Recorder struct {
+	mu     sync.Mutex
+	events []userentity.AuditEvent
+}
+
+// NewContext returns a context whose Record calls are collected by the
+// returned Recorder.
+func NewContext(ctx context.Context) (context.Context, *Recorder) {
+	rec := &Recorder{}
+	return context.WithValue(ctx, recorderKey{}, rec), rec
+}
+
+// Record adds event to the request's Recorder. A zero UserID stands for the
+// authenticated caller. Without a Recorder, for example in background jobs,
+// the event is dropped.
+func Record(ctx context.Context, event userentity.AuditEvent) {
+	rec, ok := ctx.Value(recorderKey{}).(*Recorder)
+	if !ok {
+		return
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now().UTC()
+	}
+	rec.mu.Lock()
+	rec.events = append(rec.events, event)
+	rec.mu.Unlock()
+}
+
+// Events returns the events recorded so far.
+func (r *Recorder) Events() []userentity.AuditEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]userentity.AuditEvent(nil), r.events...)
+}
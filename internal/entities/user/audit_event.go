@@ -0,0 +1,57 @@
+package user
+
+import "time"
+
+// AuditAction names a security-sensitive or administrative action recorded in
+// the audit log.
+type AuditAction string
+
+const (
+	AuditActionRegister         AuditAction = "register"
+	AuditActionVerify           AuditAction = "verify"
+	AuditActionLogin            AuditAction = "login"
+	AuditActionLoginFailed      AuditAction = "login_failed"
+	AuditActionLogout           AuditAction = "logout"
+	AuditActionPasswordChange   AuditAction = "password_change"
+	AuditActionProfileUpdate    AuditAction = "profile_update"
+	AuditActionEmailChange      AuditAction = "email_change"
+	AuditActionDelete           AuditAction = "delete"
+	AuditActionErase            AuditAction = "erase"
+	AuditActionAnonymize        AuditAction = "anonymize"
+	AuditActionDeactivate       AuditAction = "deactivate"
+	AuditActionReactivate       AuditAction = "reactivate"
+	AuditActionKycSubmit        AuditAction = "kyc_submit"
+	AuditActionKycReview        AuditAction = "kyc_review"
+	AuditActionTradingTier      AuditAction = "trading_tier"
+	AuditActionLedgerAdjustment AuditAction = "ledger_adjustment"
+	AuditActionWithdrawalReview AuditAction = "withdrawal_review"
+	AuditActionFeeSchedule      AuditAction = "fee_schedule"
+	AuditActionCorporateAction  AuditAction = "corporate_action"
+)
+
+// AuditChange is the before/after value of one field. Sensitive fields only
+// record that they changed.
+type AuditChange struct {
+	From     string `json:"from,omitempty"`
+	To       string `json:"to,omitempty"`
+	Redacted bool   `json:"redacted,omitempty"`
+}
+
+// AuditEvent is an append-only record of an action on a user account.
+// ActorID is the authenticated caller, zero for anonymous requests such as
+// registration. IP, UserAgent, RequestID and Method describe the request the
+// action was part of.
+// Changes, IP and UserAgent are personal data: anonymizing the user erases
+// them, while the rest of the event stays as recorded.
+type AuditEvent struct {
+	ID        int64
+	UserID    int64
+	ActorID   int64
+	Action    AuditAction
+	Changes   map[string]AuditChange
+	Method    string
+	IP        string
+	UserAgent string
+	RequestID string
+	CreatedAt time.Time
+}
@@ -0,0 +1,104 @@
+package user
+
+import "time"
+
+// CorporateActionKind says what a corporate action gives shareholders.
+type CorporateActionKind string
+
+const (
+	// CorporateActionCashDividend pays CashPerShare for every share held.
+	CorporateActionCashDividend CorporateActionKind = "cash_dividend"
+	// CorporateActionStockDividend and CorporateActionSplit turn every
+	// OldShares shares held into NewShares shares.
+	CorporateActionStockDividend CorporateActionKind = "stock_dividend"
+	CorporateActionSplit         CorporateActionKind = "split"
+)
+
+// Valid reports whether k is a known kind.
+func (k CorporateActionKind) Valid() bool {
+	switch k {
+	case CorporateActionCashDividend, CorporateActionStockDividend, CorporateActionSplit:
+		return true
+	}
+	return false
+}
+
+// CorporateActionStatus is the state of a CorporateAction.
+type CorporateActionStatus string
+
+const (
+	CorporateActionStatusScheduled CorporateActionStatus = "scheduled"
+	CorporateActionStatusApplied   CorporateActionStatus = "applied"
+)
+
+// CorporateAction is a cash dividend, stock dividend or split of a stock.
+// Shares held at the start of ExDate, settled or not, are entitled; under
+// T+N settlement their holders are the holders of record on RecordDate.
+// The action is applied on ExDate: entitlements are posted to the ledger
+// and the prices recorded before ExDate are back-adjusted by Adjustment.
+// Dates are at midnight UTC, as settlement dates are given, and amounts are
+// in VND.
+type CorporateAction struct {
+	ID           int64
+	StockID      int64
+	Code         string
+	Kind         CorporateActionKind
+	CashPerShare int64
+	OldShares    int64
+	NewShares    int64
+	ExDate       time.Time
+	RecordDate   time.Time
+	Status       CorporateActionStatus
+	// Adjustment is set when the action is applied.
+	Adjustment PriceAdjustment
+	CreatedBy  int64
+	CreatedAt  time.Time
+	AppliedAt  time.Time
+}
+
+// Entitlement is what holding the given shares earns: cash for a cash
+// dividend, new shares for a stock dividend or split. Fractions of a share
+// are dropped.
+func (a CorporateAction) Entitlement(shares int64) (cash, newShares int64) {
+	if a.Kind == CorporateActionCashDividend {
+		return shares * a.CashPerShare, 0
+	}
+	if a.OldShares <= 0 {
+		return 0, 0
+	}
+	return 0, shares*a.NewShares/a.OldShares - shares
+}
+
+// PriceAdjustment is how the prices recorded before the ex-date are
+// adjusted, given lastClose, the last price recorded before it. A cash
+// dividend takes its share of lastClose off the prices; stock dividends and
+// splits divide them as the shares are multiplied. Without a usable close a
+// cash dividend leaves prices as they are.
+func (a CorporateAction) PriceAdjustment(lastClose int64) PriceAdjustment {
+	if a.Kind == CorporateActionCashDividend {
+		if lastClose <= a.CashPerShare {
+			return PriceAdjustment{Numerator: 1, Denominator: 1}
+		}
+		return PriceAdjustment{Numerator: lastClose - a.CashPerShare, Denominator: lastClose}
+	}
+	return PriceAdjustment{Numerator: a.OldShares, Denominator: a.NewShares}
+}
+
+// PriceAdjustment scales a price by Numerator/Denominator.
+type PriceAdjustment struct {
+	Numerator   int64
+	Denominator int64
+}
+
+// Identity reports whether a leaves prices unchanged.
+func (a PriceAdjustment) Identity() bool {
+	return a.Numerator == a.Denominator
+}
+
+// Apply returns price adjusted by a, rounded half up.
+func (a PriceAdjustment) Apply(price int64) int64 {
+	if a.Denominator == 0 {
+		return price
+	}
+	return (price*a.Numerator + a.Denominator/2) / a.Denominator
+}
@@ -0,0 +1,38 @@
+package user
+
+import "time"
+
+// DataExportFormat is the archive format of a personal data export.
+type DataExportFormat string
+
+const (
+	DataExportFormatJSON DataExportFormat = "json"
+	DataExportFormatZIP  DataExportFormat = "zip"
+)
+
+// DataExportStatus tracks an export through the background worker.
+type DataExportStatus string
+
+const (
+	DataExportStatusPending    DataExportStatus = "pending"
+	DataExportStatusProcessing DataExportStatus = "processing"
+	DataExportStatusReady      DataExportStatus = "ready"
+	DataExportStatusFailed     DataExportStatus = "failed"
+)
+
+// DataExport is a user's request for a copy of their personal data. Content
+// holds the finished archive once Status is ready.
+type DataExport struct {
+	ID      int64
+	UserID  int64
+	Format  DataExportFormat
+	Status  DataExportStatus
+	Content []byte
+	// Error describes why a failed export could not be produced.
+	Error       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt time.Time
+	// ExpiresAt is when a finished export is removed.
+	ExpiresAt time.Time
+}
@@ -0,0 +1,96 @@
+package user
+
+import "time"
+
+// FeeSchedule prices the fills of the users of a trading tier from
+// EffectiveFrom until the next schedule of the tier takes effect. Rates are
+// in basis points of the value of a fill. The commission is at least MinFee
+// and, unless MaxFee is zero, at most MaxFee; sales also pay SellTaxBps of
+// their value in tax. Amounts are in VND.
+type FeeSchedule struct {
+	ID          int64
+	Tier        string
+	BuyRateBps  int64
+	SellRateBps int64
+	MinFee      int64
+	MaxFee      int64
+	SellTaxBps  int64
+	// VolumeTiers replace the rates once the user's fills of the calendar
+	// month reach a value, lowest MinMonthlyValue first.
+	VolumeTiers   []FeeVolumeTier
+	EffectiveFrom time.Time
+	CreatedBy     int64
+	CreatedAt     time.Time
+}
+
+// FeeVolumeTier sets the rates of the fills of users whose fills of the
+// calendar month, before the one priced, add up to MinMonthlyValue or more.
+type FeeVolumeTier struct {
+	MinMonthlyValue int64
+	BuyRateBps      int64
+	SellRateBps     int64
+}
+
+// FeeCharges are what a fill is charged: the commission and, on sales, the
+// tax.
+type FeeCharges struct {
+	Fee int64
+	Tax int64
+}
+
+// RateBps is the commission rate of a fill on side for a user whose fills of
+// the month are worth monthlyValue so far.
+func (s FeeSchedule) RateBps(side OrderSide, monthlyValue int64) int64 {
+	buy, sell := s.BuyRateBps, s.SellRateBps
+	for _, tier := range s.VolumeTiers {
+		if monthlyValue < tier.MinMonthlyValue {
+			break
+		}
+		buy, sell = tier.BuyRateBps, tier.SellRateBps
+	}
+	if side == OrderSideSell {
+		return sell
+	}
+	return buy
+}
+
+// Charges prices a fill on side worth value for a user whose fills of the
+// month are worth monthlyValue so far. Amounts are rounded half up to the
+// dong.
+func (s FeeSchedule) Charges(side OrderSide, value, monthlyValue int64) FeeCharges {
+	charges := FeeCharges{Fee: s.bounded(basisPoints(value, s.RateBps(side, monthlyValue)))}
+	if side == OrderSideSell {
+		charges.Tax = basisPoints(value, s.SellTaxBps)
+	}
+	return charges
+}
+
+// MaxBuyFee is the largest commission a buy worth value can be charged,
+// whatever the monthly volume of the user: value at the highest buy rate of
+// the schedule and its volume tiers, at least MinFee and, unless MaxFee is
+// zero, at most MaxFee.
+func (s FeeSchedule) MaxBuyFee(value int64) int64 {
+	rate := s.BuyRateBps
+	for _, tier := range s.VolumeTiers {
+		if tier.BuyRateBps > rate {
+			rate = tier.BuyRateBps
+		}
+	}
+	return s.bounded(basisPoints(value, rate))
+}
+
+// bounded keeps a commission between MinFee and, unless it is zero, MaxFee.
+func (s FeeSchedule) bounded(fee int64) int64 {
+	if fee < s.MinFee {
+		fee = s.MinFee
+	}
+	if s.MaxFee > 0 && fee > s.MaxFee {
+		fee = s.MaxFee
+	}
+	return fee
+}
+
+// basisPoints is bps basis points of value, rounded half up.
+func basisPoints(value, bps int64) int64 {
+	return (value*bps + 5000) / 10000
+}
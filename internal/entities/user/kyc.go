@@ -0,0 +1,64 @@
+package user
+
+import "time"
+
+// KycStatus is the identity verification state of a user. Submissions use the
+// same values except none.
+type KycStatus string
+
+const (
+	KycStatusNone     KycStatus = "none"
+	KycStatusPending  KycStatus = "pending"
+	KycStatusApproved KycStatus = "approved"
+	KycStatusRejected KycStatus = "rejected"
+)
+
+// KycDocumentType is the kind of identity document a submission is for.
+type KycDocumentType string
+
+const (
+	KycDocumentTypeIDCard   KycDocumentType = "id_card"
+	KycDocumentTypePassport KycDocumentType = "passport"
+)
+
+// KycDocumentKind says which image of the document an upload holds.
+type KycDocumentKind string
+
+const (
+	KycDocumentKindFront  KycDocumentKind = "front"
+	KycDocumentKindBack   KycDocumentKind = "back"
+	KycDocumentKindSelfie KycDocumentKind = "selfie"
+)
+
+// KycDocument is an uploaded document image. The content lives in the blob
+// store under BlobKey; SubmissionID is zero until the image is submitted.
+type KycDocument struct {
+	ID           int64
+	UserID       int64
+	SubmissionID int64
+	Kind         KycDocumentKind
+	BlobKey      string
+	ContentType  string
+	SizeBytes    int64
+	CreatedAt    time.Time
+}
+
+// KycSubmission is the identity document metadata a user submitted for
+// review, with the images attached to it. ReviewerID and ReviewedAt are set
+// once an administrator approved or rejected it.
+type KycSubmission struct {
+	ID              int64
+	UserID          int64
+	DocumentType    KycDocumentType
+	DocumentNumber  string
+	FullName        string
+	IssuedOn        time.Time
+	ExpiresOn       time.Time
+	Status          KycStatus
+	RejectionReason string
+	ReviewerID      int64
+	ReviewedAt      time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Documents       []KycDocument
+}
@@ -0,0 +1,34 @@
+package user
+
+import "time"
+
+// LoginRecordType distinguishes sign-ins from sign-outs in the login history.
+type LoginRecordType string
+
+const (
+	LoginRecordTypeLogin  LoginRecordType = "login"
+	LoginRecordTypeLogout LoginRecordType = "logout"
+)
+
+// ClientInfo describes the client a request came from. DeviceID is an
+// optional identifier the client keeps across sessions.
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+	DeviceID  string
+}
+
+// LoginRecord is one entry of a user's login history. DeviceFingerprint
+// identifies the device without storing the raw device identifier. NewDevice
+// reports that the user had logged in before, but never from this device or
+// IP.
+type LoginRecord struct {
+	ID                int64
+	UserID            int64
+	Type              LoginRecordType
+	IP                string
+	UserAgent         string
+	DeviceFingerprint string
+	NewDevice         bool
+	CreatedAt         time.Time
+}
@@ -0,0 +1,51 @@
+package user
+
+import "time"
+
+// NewsCategory is the section a news item is filed under.
+type NewsCategory string
+
+const (
+	NewsCategoryMarket       NewsCategory = "market"
+	NewsCategoryCompany      NewsCategory = "company"
+	NewsCategoryEconomy      NewsCategory = "economy"
+	NewsCategoryAnalysis     NewsCategory = "analysis"
+	NewsCategoryAnnouncement NewsCategory = "announcement"
+)
+
+// Valid reports whether c is one of the known categories.
+func (c NewsCategory) Valid() bool {
+	switch c {
+	case NewsCategoryMarket, NewsCategoryCompany, NewsCategoryEconomy, NewsCategoryAnalysis, NewsCategoryAnnouncement:
+		return true
+	}
+	return false
+}
+
+// NewsStatus tells whether a news item is visible to users.
+type NewsStatus string
+
+const (
+	NewsStatusPublished   NewsStatus = "published"
+	NewsStatusUnpublished NewsStatus = "unpublished"
+)
+
+// News is an article of the news feed. Codes are the related stock codes,
+// sorted. ExternalID identifies items imported from a feed so a repeated
+// import updates them instead of adding duplicates; it is empty for items
+// written by administrators.
+type News struct {
+	ID          int64
+	ExternalID  string
+	Category    NewsCategory
+	Title       string
+	Summary     string
+	Content     string
+	Source      string
+	URL         string
+	Codes       []string
+	Status      NewsStatus
+	PublishedAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
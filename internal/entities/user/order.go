@@ -0,0 +1,189 @@
+package user
+
+import "time"
+
+// OrderSide says whether an order buys or sells.
+type OrderSide string
+
+const (
+	OrderSideBuy  OrderSide = "buy"
+	OrderSideSell OrderSide = "sell"
+)
+
+// Valid reports whether s is a known side.
+func (s OrderSide) Valid() bool {
+	return s == OrderSideBuy || s == OrderSideSell
+}
+
+// TimeInForce says how long an order stays in the market.
+type TimeInForce string
+
+const (
+	// TimeInForceGTC orders stay until they are filled or cancelled.
+	TimeInForceGTC TimeInForce = "GTC"
+	// TimeInForceDAY orders expire at the end of the trading session they
+	// were placed in.
+	TimeInForceDAY TimeInForce = "DAY"
+	// TimeInForceIOC orders fill what they can when placed; the rest expires.
+	TimeInForceIOC TimeInForce = "IOC"
+	// TimeInForceFOK orders fill completely when placed or expire unfilled.
+	TimeInForceFOK TimeInForce = "FOK"
+)
+
+// Valid reports whether t is a known time in force.
+func (t TimeInForce) Valid() bool {
+	switch t {
+	case TimeInForceGTC, TimeInForceDAY, TimeInForceIOC, TimeInForceFOK:
+		return true
+	}
+	return false
+}
+
+// Immediate reports whether orders with t never rest in the market.
+func (t TimeInForce) Immediate() bool {
+	return t == TimeInForceIOC || t == TimeInForceFOK
+}
+
+// OrderStatus is the state of an order. New and partially filled orders are
+// open; the other states are final.
+type OrderStatus string
+
+const (
+	OrderStatusNew             OrderStatus = "new"
+	OrderStatusPartiallyFilled OrderStatus = "partially_filled"
+	OrderStatusFilled          OrderStatus = "filled"
+	OrderStatusCancelled       OrderStatus = "cancelled"
+	OrderStatusRejected        OrderStatus = "rejected"
+	OrderStatusExpired         OrderStatus = "expired"
+)
+
+// Valid reports whether s is a known status.
+func (s OrderStatus) Valid() bool {
+	switch s {
+	case OrderStatusNew, OrderStatusPartiallyFilled, OrderStatusFilled,
+		OrderStatusCancelled, OrderStatusRejected, OrderStatusExpired:
+		return true
+	}
+	return false
+}
+
+// Open reports whether an order in status s can still be filled, amended or
+// cancelled.
+func (s OrderStatus) Open() bool {
+	return s == OrderStatusNew || s == OrderStatusPartiallyFilled
+}
+
+// Order is a limit order of a user on a listed stock. Prices are in VND per
+// share. FilledValue is the sum of quantity times price over the fills, and
+// Fees and Taxes the sums of what the fills were charged, in VND.
+// PriorityAt is when the order took its place in the queue: it is the
+// placement time until an amendment loses the order its priority.
+type Order struct {
+	ID             int64
+	UserID         int64
+	StockID        int64
+	Code           string
+	Side           OrderSide
+	TimeInForce    TimeInForce
+	Price          int64
+	Quantity       int64
+	FilledQuantity int64
+	FilledValue    int64
+	Fees           int64
+	Taxes          int64
+	Status         OrderStatus
+	Reason         string
+	PriorityAt     time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Remaining is the quantity still to be filled.
+func (o Order) Remaining() int64 {
+	return o.Quantity - o.FilledQuantity
+}
+
+// AveragePrice is the average fill price, rounded down, or zero before the
+// first fill.
+func (o Order) AveragePrice() int64 {
+	if o.FilledQuantity == 0 {
+		return 0
+	}
+	return o.FilledValue / o.FilledQuantity
+}
+
+// Amended returns the order with a new price and total quantity. Changing
+// the price or raising the quantity sends the order to the back of the
+// queue, so PriorityAt becomes at; lowering the quantity keeps its place.
+// The caller checks that the order is open and quantity exceeds the filled
+// quantity.
+func (o Order) Amended(quantity, price int64, at time.Time) Order {
+	if price != o.Price || quantity > o.Quantity {
+		o.PriorityAt = at
+	}
+	o.Price = price
+	o.Quantity = quantity
+	o.UpdatedAt = at
+	return o
+}
+
+// Filled returns the order after a fill of quantity shares at price that
+// was charged charges. The caller checks that the order is open and
+// quantity does not exceed the remaining quantity.
+func (o Order) Filled(quantity, price int64, charges FeeCharges, at time.Time) Order {
+	o.FilledQuantity += quantity
+	o.FilledValue += quantity * price
+	o.Fees += charges.Fee
+	o.Taxes += charges.Tax
+	if o.FilledQuantity >= o.Quantity {
+		o.Status = OrderStatusFilled
+	} else {
+		o.Status = OrderStatusPartiallyFilled
+	}
+	o.UpdatedAt = at
+	return o
+}
+
+// OrderEventType names a change in the history of an order.
+type OrderEventType string
+
+const (
+	OrderEventPlaced    OrderEventType = "placed"
+	OrderEventAmended   OrderEventType = "amended"
+	OrderEventFilled    OrderEventType = "filled"
+	OrderEventCancelled OrderEventType = "cancelled"
+	OrderEventRejected  OrderEventType = "rejected"
+	OrderEventExpired   OrderEventType = "expired"
+)
+
+// OrderEvent records one change of an order. Status is the status after the
+// change. Placed and amended events carry the order's price and quantity
+// after the change, filled events the price, quantity, fee and tax of the
+// fill.
+type OrderEvent struct {
+	ID        int64
+	OrderID   int64
+	Type      OrderEventType
+	Status    OrderStatus
+	Price     int64
+	Quantity  int64
+	Fee       int64
+	Tax       int64
+	Reason    string
+	CreatedAt time.Time
+}
+
+// OrderFill is an execution of part of an order reported by the market.
+// ExecutionID identifies it at the market, so a fill reported twice is
+// recorded once. Fee is the commission the fill was charged and Tax the tax
+// withheld from a sale, in VND.
+type OrderFill struct {
+	ID          int64
+	OrderID     int64
+	ExecutionID string
+	Price       int64
+	Quantity    int64
+	Fee         int64
+	Tax         int64
+	CreatedAt   time.Time
+}
@@ -0,0 +1,113 @@
+package user
+
+import (
+	"fmt"
+	"time"
+)
+
+// PaymentKind says which way a payment moves cash.
+type PaymentKind string
+
+const (
+	// PaymentKindDeposit payments move cash from the user's bank into the
+	// trading account.
+	PaymentKindDeposit PaymentKind = "deposit"
+	// PaymentKindWithdrawal payments move cash out to the user's bank.
+	PaymentKindWithdrawal PaymentKind = "withdrawal"
+)
+
+// Valid reports whether k is a known kind.
+func (k PaymentKind) Valid() bool {
+	return k == PaymentKindDeposit || k == PaymentKindWithdrawal
+}
+
+// PaymentStatus is the state of a payment. Pending, awaiting approval and
+// processing payments are open; the other states are final.
+type PaymentStatus string
+
+const (
+	// PaymentStatusPending payments are accepted but not yet submitted to
+	// the payment gateway.
+	PaymentStatusPending PaymentStatus = "pending"
+	// PaymentStatusAwaitingApproval withdrawals are above the approval
+	// threshold and wait for an administrator.
+	PaymentStatusAwaitingApproval PaymentStatus = "awaiting_approval"
+	// PaymentStatusProcessing payments were submitted to the gateway, which
+	// has not confirmed them yet.
+	PaymentStatusProcessing PaymentStatus = "processing"
+	PaymentStatusCompleted  PaymentStatus = "completed"
+	PaymentStatusFailed     PaymentStatus = "failed"
+	PaymentStatusRejected   PaymentStatus = "rejected"
+)
+
+// Valid reports whether s is a known status.
+func (s PaymentStatus) Valid() bool {
+	switch s {
+	case PaymentStatusPending, PaymentStatusAwaitingApproval, PaymentStatusProcessing,
+		PaymentStatusCompleted, PaymentStatusFailed, PaymentStatusRejected:
+		return true
+	}
+	return false
+}
+
+// Open reports whether a payment in status s can still complete.
+func (s PaymentStatus) Open() bool {
+	return s == PaymentStatusPending || s == PaymentStatusAwaitingApproval || s == PaymentStatusProcessing
+}
+
+// CanMoveTo reports whether a payment in status s may move to next. Approved
+// withdrawals go back to pending to be submitted; the gateway may confirm a
+// payment before its submission is recorded.
+func (s PaymentStatus) CanMoveTo(next PaymentStatus) bool {
+	switch s {
+	case PaymentStatusAwaitingApproval:
+		return next == PaymentStatusPending || next == PaymentStatusRejected
+	case PaymentStatusPending:
+		return next == PaymentStatusProcessing || next == PaymentStatusCompleted || next == PaymentStatusFailed
+	case PaymentStatusProcessing:
+		return next == PaymentStatusCompleted || next == PaymentStatusFailed
+	}
+	return false
+}
+
+// Payment is a deposit or a withdrawal of cash through the payment gateway.
+// Amount is in VND and positive for both kinds. The ledger entry of a
+// payment is posted when it completes; until then an open withdrawal holds
+// its amount back from the buying power.
+type Payment struct {
+	ID     int64
+	UserID int64
+	Kind   PaymentKind
+	Amount int64
+	Status PaymentStatus
+	// IdempotencyKey identifies the request of the user that created the
+	// payment, so a retried request creates it once.
+	IdempotencyKey string
+	// GatewayReference is the gateway's identifier, set on submission.
+	GatewayReference string
+	// PaymentURL is where the user completes a deposit with the gateway,
+	// when it needs one.
+	PaymentURL string
+	// Reason explains failed and rejected payments.
+	Reason string
+	// ReviewerID is the administrator who approved or rejected a withdrawal.
+	ReviewerID  int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt time.Time
+}
+
+// Reference identifies the payment to the gateway and on the ledger.
+func (p Payment) Reference() string {
+	return fmt.Sprintf("payment:%d", p.ID)
+}
+
+// LedgerEntry is the entry a completed payment posts: the amount in for a
+// deposit and out for a withdrawal.
+func (p Payment) LedgerEntry(at time.Time) LedgerEntry {
+	entry := LedgerEntry{UserID: p.UserID, Amount: p.Amount, Type: LedgerEntryDeposit, Reference: p.Reference(), CreatedAt: at}
+	if p.Kind == PaymentKindWithdrawal {
+		entry.Amount, entry.Type = -p.Amount, LedgerEntryWithdrawal
+	}
+	return entry
+}
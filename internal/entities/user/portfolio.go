@@ -0,0 +1,38 @@
+package user
+
+import "time"
+
+// CostMethod is how the cost of the shares a sale gives up is measured.
+type CostMethod string
+
+const (
+	// CostMethodFIFO sells the earliest bought shares first.
+	CostMethodFIFO CostMethod = "fifo"
+	// CostMethodAverage sells shares at the average cost of the position.
+	CostMethodAverage CostMethod = "average"
+)
+
+// Valid reports whether m is a known method.
+func (m CostMethod) Valid() bool {
+	return m == CostMethodFIFO || m == CostMethodAverage
+}
+
+// PortfolioSnapshot is the value of a user's account at the end of a
+// trading day, with shares valued at the last price recorded that day.
+// Balances are on trade date: what pending trades will deliver is counted.
+// NetFlow is the cash and shares, at the day's prices, deposited less
+// withdrawn since the previous snapshot; time-weighted returns leave it
+// out. Date is at midnight UTC and amounts are in VND.
+type PortfolioSnapshot struct {
+	UserID      int64
+	Date        time.Time
+	Cash        int64
+	MarketValue int64
+	NetFlow     int64
+	CreatedAt   time.Time
+}
+
+// Value is the cash and the market value of the shares.
+func (s PortfolioSnapshot) Value() int64 {
+	return s.Cash + s.MarketValue
+}
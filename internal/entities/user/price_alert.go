@@ -0,0 +1,63 @@
+package user
+
+import "time"
+
+// PriceAlertCondition says when a price alert fires.
+type PriceAlertCondition string
+
+const (
+	// PriceAlertAbove fires once the price is at or above TargetPrice.
+	PriceAlertAbove PriceAlertCondition = "above"
+	// PriceAlertBelow fires once the price is at or below TargetPrice.
+	PriceAlertBelow PriceAlertCondition = "below"
+	// PriceAlertMove fires once the price moved ChangeBps basis points away
+	// from BasePrice, up or down.
+	PriceAlertMove PriceAlertCondition = "move"
+)
+
+// PriceAlertStatus is the state of a price alert. Alerts fire only once.
+type PriceAlertStatus string
+
+const (
+	PriceAlertStatusActive    PriceAlertStatus = "active"
+	PriceAlertStatusTriggered PriceAlertStatus = "triggered"
+)
+
+// PriceAlert asks for a notification when the price of a stock meets its
+// condition. Prices are in VND. BasePrice is the price of the stock when a
+// move alert was created. TriggeredPrice and TriggeredAt are set once the
+// alert fired.
+type PriceAlert struct {
+	ID             int64
+	UserID         int64
+	StockID        int64
+	Code           string
+	Condition      PriceAlertCondition
+	TargetPrice    int64
+	ChangeBps      int64
+	BasePrice      int64
+	Status         PriceAlertStatus
+	TriggeredPrice int64
+	TriggeredAt    time.Time
+	CreatedAt      time.Time
+}
+
+// Matches reports whether price meets the alert condition.
+func (a PriceAlert) Matches(price int64) bool {
+	switch a.Condition {
+	case PriceAlertAbove:
+		return price >= a.TargetPrice
+	case PriceAlertBelow:
+		return price <= a.TargetPrice
+	case PriceAlertMove:
+		if a.BasePrice <= 0 || a.ChangeBps <= 0 {
+			return false
+		}
+		diff := price - a.BasePrice
+		if diff < 0 {
+			diff = -diff
+		}
+		return diff*10000 >= a.ChangeBps*a.BasePrice
+	}
+	return false
+}
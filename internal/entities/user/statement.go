@@ -0,0 +1,27 @@
+package user
+
+import "time"
+
+// StatementPeriodLayout is the time layout of Statement.Period.
+const StatementPeriodLayout = "2006-01"
+
+// Statement is the account statement of a user for a calendar month: the
+// positions, cash movements, fills, fees and taxes of the month, rendered
+// into a CSV and a PDF file kept in the blob store. Amounts are in VND.
+type Statement struct {
+	ID     int64
+	UserID int64
+	// Period is the month covered, such as "2026-09".
+	Period string
+	// From and To bound the month in the market's time zone; To is the
+	// start of the next month.
+	From        time.Time
+	To          time.Time
+	OpeningCash int64
+	ClosingCash int64
+	Fees        int64
+	Taxes       int64
+	CSVKey      string
+	PDFKey      string
+	CreatedAt   time.Time
+}
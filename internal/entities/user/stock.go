@@ -0,0 +1,24 @@
+package user
+
+import "time"
+
+// Stock is a listed symbol of the stocks catalog. Code is the ticker users
+// trade and watch, for example "VNM". Sector is empty for unclassified
+// stocks.
+type Stock struct {
+	ID          int64
+	Code        string
+	Name        string
+	CompanyName string
+	Sector      string
+}
+
+// StockQuote is a price recorded for a stock, in VND. ID identifies the
+// price record; records of all stocks share one increasing sequence.
+type StockQuote struct {
+	ID      int64
+	StockID int64
+	Code    string
+	Price   int64
+	At      time.Time
+}
@@ -0,0 +1,133 @@
+package user
+
+import "time"
+
+// DefaultTradingTier is the tier of users no tier was set for.
+const DefaultTradingTier = "standard"
+
+// LedgerEntryType says why a ledger entry was posted.
+type LedgerEntryType string
+
+const (
+	LedgerEntryDeposit    LedgerEntryType = "deposit"
+	LedgerEntryWithdrawal LedgerEntryType = "withdrawal"
+	// LedgerEntryTrade entries move the cash and shares of an order fill.
+	LedgerEntryTrade LedgerEntryType = "trade"
+	// LedgerEntryAdjustment entries are posted by administrators, such as
+	// opening balances.
+	LedgerEntryAdjustment LedgerEntryType = "adjustment"
+	// LedgerEntrySettlement entries bring what a trade delivers into the
+	// account when the trade settles.
+	LedgerEntrySettlement LedgerEntryType = "settlement"
+	// LedgerEntryFee and LedgerEntryTax entries charge the commission and
+	// the tax of an order fill.
+	LedgerEntryFee LedgerEntryType = "fee"
+	LedgerEntryTax LedgerEntryType = "tax"
+	// LedgerEntryCorporateAction entries pay the dividends and deliver the
+	// shares of corporate actions.
+	LedgerEntryCorporateAction LedgerEntryType = "corporate_action"
+)
+
+// Valid reports whether t is a known entry type.
+func (t LedgerEntryType) Valid() bool {
+	switch t {
+	case LedgerEntryDeposit, LedgerEntryWithdrawal, LedgerEntryTrade, LedgerEntryAdjustment, LedgerEntrySettlement,
+		LedgerEntryFee, LedgerEntryTax, LedgerEntryCorporateAction:
+		return true
+	}
+	return false
+}
+
+// LedgerEntry changes the cash or the shares of one stock a user holds.
+// Cash entries have no Code and are in VND; stock entries are in shares.
+// Amount is negative for what leaves the account. The entries posted
+// together share their Reference, such as the fill they settle.
+type LedgerEntry struct {
+	ID        int64
+	UserID    int64
+	StockID   int64
+	Code      string
+	Amount    int64
+	Type      LedgerEntryType
+	Reference string
+	Note      string
+	CreatedAt time.Time
+}
+
+// Cash reports whether e moves cash rather than shares.
+func (e LedgerEntry) Cash() bool {
+	return e.Code == ""
+}
+
+// SettlementStatus is the state of a Settlement.
+type SettlementStatus string
+
+const (
+	SettlementStatusPending SettlementStatus = "pending"
+	SettlementStatusSettled SettlementStatus = "settled"
+)
+
+// Settlement is what a trade delivers to an account, the proceeds of a sale
+// or the shares of a purchase, from the fill until the trade settles. What a
+// trade takes out of an account is posted to the ledger at the fill; what it
+// delivers is posted as a settlement entry on SettlesOn.
+type Settlement struct {
+	ID      int64
+	UserID  int64
+	StockID int64
+	Code    string
+	Amount  int64
+	// Reference is the reference of the trade, such as its fill.
+	Reference string
+	// SettlesOn is the settlement date, at midnight UTC.
+	SettlesOn time.Time
+	Status    SettlementStatus
+	SettledAt time.Time
+	CreatedAt time.Time
+}
+
+// Cash reports whether s delivers cash rather than shares.
+func (s Settlement) Cash() bool {
+	return s.Code == ""
+}
+
+// Position is how many shares of a stock a user holds. Pending shares were
+// bought but have not settled; they are not part of Quantity.
+type Position struct {
+	StockID  int64
+	Code     string
+	Quantity int64
+	Pending  int64
+}
+
+// TradingAccount is the cash and the positions of a user, summed over the
+// ledger, and the tier that selects the user's risk limits. Cash and
+// quantities are settled; what pending settlements will deliver is counted
+// apart. Withheld is the cash open withdrawals hold back; it is part of Cash
+// until the withdrawals complete.
+type TradingAccount struct {
+	UserID      int64
+	Tier        string
+	Cash        int64
+	PendingCash int64
+	Withheld    int64
+	// Positions are the stocks with a non-zero quantity or pending shares,
+	// by code.
+	Positions []Position
+}
+
+// Empty reports whether the account holds no cash or shares, settled or
+// pending, and has no open withdrawals.
+func (a TradingAccount) Empty() bool {
+	return a.Cash == 0 && a.PendingCash == 0 && a.Withheld == 0 && len(a.Positions) == 0
+}
+
+// Shares returns the quantity of the stock with code the account holds.
+func (a TradingAccount) Shares(code string) int64 {
+	for _, position := range a.Positions {
+		if position.Code == code {
+			return position.Quantity
+		}
+	}
+	return 0
+}
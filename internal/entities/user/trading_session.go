@@ -0,0 +1,39 @@
+package user
+
+import "time"
+
+// TradingSession is a phase of the trading day.
+type TradingSession string
+
+const (
+	// TradingSessionATO collects orders for the opening call auction.
+	TradingSessionATO TradingSession = "ato"
+	// TradingSessionContinuous matches orders as they arrive.
+	TradingSessionContinuous TradingSession = "continuous"
+	// TradingSessionATC collects orders for the closing call auction.
+	TradingSessionATC TradingSession = "atc"
+	// TradingSessionClosed covers nights, breaks, weekends and holidays.
+	TradingSessionClosed TradingSession = "closed"
+)
+
+// Valid reports whether s is a known session.
+func (s TradingSession) Valid() bool {
+	switch s {
+	case TradingSessionATO, TradingSessionContinuous, TradingSessionATC, TradingSessionClosed:
+		return true
+	}
+	return false
+}
+
+// CallAuction reports whether orders of s are matched at its end, at a
+// single price.
+func (s TradingSession) CallAuction() bool {
+	return s == TradingSessionATO || s == TradingSessionATC
+}
+
+// TradingSessionTransition is the end of session From and the start of To.
+type TradingSessionTransition struct {
+	At   time.Time
+	From TradingSession
+	To   TradingSession
+}
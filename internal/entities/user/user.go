@@ -16,8 +16,32 @@ type User struct {
 	VerifiedAt       time.Time
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+	// Version increments on every profile change and backs the profile ETag.
+	Version int64
+	Status  AccountStatus
+	// StatusChangedAt is zero until the account leaves the active state once.
+	StatusChangedAt time.Time
+	// DeletionScheduledAt is when a pending_deletion account gets anonymized.
+	DeletionScheduledAt time.Time
+	// KycStatus is the identity verification state; only approved users may
+	// place orders.
+	KycStatus KycStatus
+	// AvatarKey is the blob store key of the profile picture; empty when none
+	// was uploaded.
+	AvatarKey string
 }
 
+// AccountStatus is the lifecycle state of a user account. Only active accounts
+// can log in or be looked up; deleted accounts have been anonymized.
+type AccountStatus string
+
+const (
+	AccountStatusActive          AccountStatus = "active"
+	AccountStatusDeactivated     AccountStatus = "deactivated"
+	AccountStatusPendingDeletion AccountStatus = "pending_deletion"
+	AccountStatusDeleted         AccountStatus = "deleted"
+)
+
 type LoginMethodPassword struct {
 	UserName string
 	Password string
@@ -28,13 +52,17 @@ type VerificationPurpose string
 const (
 	VerificationPurposeRegister VerificationPurpose = "register"
 	VerificationPurposeResend   VerificationPurpose = "resend"
+	// VerificationPurposeEmailChange tokens confirm a pending email address.
+	VerificationPurposeEmailChange VerificationPurpose = "email_change"
 )
 
 type VerificationToken struct {
-	ID         int64
-	UserID     int64
-	Token      string
-	Purpose    VerificationPurpose
+	ID      int64
+	UserID  int64
+	Token   string
+	Purpose VerificationPurpose
+	// NewEmail is the pending address for VerificationPurposeEmailChange tokens.
+	NewEmail   string
 	ExpiresAt  time.Time
 	ConsumedAt *time.Time
 	CreatedAt  time.Time
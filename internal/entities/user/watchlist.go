@@ -0,0 +1,14 @@
+package user
+
+import "time"
+
+// Watchlist is a named, ordered list of stock codes a user tracks. Codes are
+// kept in the order the user arranged them.
+type Watchlist struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	Codes     []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,350 @@
+package i18n
+
+// catalog maps locale -> error reason -> message. Every reason must exist in
+// every locale, and every reason declared with apperrors.New must have a
+// message; TestCatalogComplete and TestCatalogCoversDeclaredReasons enforce
+// it.
+var catalog = map[string]map[string]string{
+	LocaleVietnamese: {
+		// Generic reasons derived from gRPC codes.
+		"INVALID_ARGUMENT":    "Yêu cầu không hợp lệ.",
+		"INVALID_REQUEST":     "Yêu cầu không hợp lệ.",
+		"NOT_FOUND":           "Không tìm thấy dữ liệu.",
+		"ALREADY_EXISTS":      "Dữ liệu đã tồn tại.",
+		"UNAUTHENTICATED":     "Bạn cần đăng nhập để tiếp tục.",
+		"PERMISSION_DENIED":   "Bạn không có quyền thực hiện thao tác này.",
+		"FAILED_PRECONDITION": "Không thể thực hiện thao tác ở trạng thái hiện tại.",
+		"RESOURCE_EXHAUSTED":  "Bạn đã gửi quá nhiều yêu cầu, vui lòng thử lại sau.",
+		"ABORTED":             "Dữ liệu đã bị thay đổi, vui lòng tải lại và thử lại.",
+		"CANCELED":            "Yêu cầu đã bị hủy.",
+		"DEADLINE_EXCEEDED":   "Yêu cầu đã hết thời gian xử lý.",
+		"UNIMPLEMENTED":       "Chức năng chưa được hỗ trợ.",
+		"UNAVAILABLE":         "Dịch vụ tạm thời không khả dụng, vui lòng thử lại sau.",
+		"INTERNAL":            "Đã có lỗi xảy ra, vui lòng thử lại sau.",
+		"UNKNOWN":             "Đã có lỗi xảy ra, vui lòng thử lại sau.",
+
+		// Domain reasons.
+		"USER_NOT_FOUND":                     "Không tìm thấy người dùng.",
+		"USER_ALREADY_EXISTS":                "Tên đăng nhập hoặc email đã được sử dụng.",
+		"USER_NOT_VERIFIED":                  "Tài khoản chưa được xác thực email.",
+		"USER_ALREADY_VERIFIED":              "Tài khoản đã được xác thực.",
+		"INVALID_CREDENTIALS":                "Tên đăng nhập hoặc mật khẩu không đúng.",
+		"INVALID_REFRESH_TOKEN":              "Phiên đăng nhập không hợp lệ hoặc đã hết hạn.",
+		"INVALID_CURRENT_PASSWORD":           "Mật khẩu hiện tại không đúng.",
+		"USERNAME_REQUIRED":                  "Vui lòng nhập tên đăng nhập.",
+		"PASSWORD_REQUIRED":                  "Vui lòng nhập mật khẩu.",
+		"NEW_PASSWORD_REQUIRED":              "Vui lòng nhập mật khẩu mới.",
+		"EMAIL_REQUIRED":                     "Vui lòng nhập email.",
+		"NAME_REQUIRED":                      "Vui lòng nhập họ tên.",
+		"TOKEN_REQUIRED":                     "Thiếu mã xác thực.",
+		"VERIFICATION_TOKEN_NOT_FOUND":       "Mã xác thực không tồn tại.",
+		"VERIFICATION_TOKEN_EXPIRED":         "Mã xác thực đã hết hạn.",
+		"VERIFICATION_TOKEN_USED":            "Mã xác thực đã được sử dụng.",
+		"RESEND_TOO_FREQUENT":                "Bạn yêu cầu gửi lại quá nhanh, vui lòng thử lại sau.",
+		"OUTBOX_EVENT_NOT_FOUND":             "Không tìm thấy sự kiện.",
+		"INVALID_OUTBOX_STATUS":              "Trạng thái sự kiện không hợp lệ.",
+		"USER_VERSION_CONFLICT":              "Hồ sơ đã được cập nhật ở nơi khác, vui lòng tải lại và thử lại.",
+		"INVALID_UPDATE_MASK":                "Danh sách trường cần cập nhật không hợp lệ.",
+		"UPDATE_MASK_EMPTY":                  "Không có thông tin nào để cập nhật.",
+		"INVALID_ETAG":                       "Giá trị ETag không hợp lệ.",
+		"EMAIL_UNCHANGED":                    "Email mới trùng với email hiện tại.",
+		"EMAIL_CHANGE_REQUIRES_CONFIRMATION": "Vui lòng đổi email qua chức năng đổi email để xác nhận địa chỉ mới.",
+		"INVALID_ORDER_BY":                   "Tiêu chí sắp xếp không hợp lệ.",
+		"INVALID_FILTER":                     "Điều kiện lọc không hợp lệ.",
+		"INVALID_PAGE_TOKEN":                 "Mã trang không hợp lệ hoặc không khớp với yêu cầu.",
+		"ACCOUNT_INACTIVE":                   "Tài khoản đã bị vô hiệu hóa hoặc đang chờ xóa.",
+		"ACCOUNT_DELETION_GRACE_EXPIRED":     "Đã hết thời hạn khôi phục tài khoản.",
+		"ACCOUNT_STATUS_CONFLICT":            "Trạng thái tài khoản hiện tại không cho phép thao tác này.",
+		"ACCOUNT_NOT_EMPTY":                  "Tài khoản vẫn còn tiền, cổ phiếu hoặc giao dịch, lệnh, yêu cầu rút tiền đang xử lý.",
+		"INVALID_ACCOUNT_STATUS":             "Trạng thái tài khoản không hợp lệ.",
+		"INVALID_EXPORT_FORMAT":              "Định dạng xuất dữ liệu phải là json hoặc zip.",
+		"INVALID_DATA_EXPORT":                "Yêu cầu xuất dữ liệu không hợp lệ.",
+		"DATA_EXPORT_NOT_FOUND":              "Không tìm thấy bản xuất dữ liệu.",
+		"DATA_EXPORT_EXPIRED":                "Bản xuất dữ liệu đã hết hạn.",
+		"DATA_EXPORT_IN_PROGRESS":            "Đang có một yêu cầu xuất dữ liệu được xử lý.",
+		"DATA_EXPORT_NOT_PROCESSING":         "Bản xuất dữ liệu không ở trạng thái đang xử lý.",
+		"INVALID_AUDIT_EVENT":                "Sự kiện nhật ký kiểm toán không hợp lệ.",
+		"INVALID_LOGIN_RECORD":               "Bản ghi đăng nhập không hợp lệ.",
+		"BLOB_NOT_FOUND":                     "Không tìm thấy tệp.",
+		"INVALID_BLOB_KEY":                   "Khóa tệp không hợp lệ.",
+		"INVALID_KYC_DOCUMENT":               "Ảnh giấy tờ không hợp lệ.",
+		"INVALID_KYC_SUBMISSION":             "Hồ sơ xác minh danh tính không hợp lệ.",
+		"KYC_DOCUMENT_NOT_FOUND":             "Không tìm thấy ảnh giấy tờ hoặc ảnh đã được gửi.",
+		"KYC_SUBMISSION_NOT_FOUND":           "Không tìm thấy hồ sơ xác minh danh tính.",
+		"KYC_STATUS_CONFLICT":                "Trạng thái xác minh danh tính hiện tại không cho phép thao tác này.",
+		"KYC_DOCUMENT_TOO_LARGE":             "Ảnh giấy tờ không được vượt quá 10 MiB.",
+		"KYC_DOCUMENT_CONTENT_TYPE":          "Ảnh giấy tờ phải có định dạng JPEG hoặc PNG.",
+		"INVALID_KYC_DOCUMENT_KIND":          "Loại ảnh phải là mặt trước, mặt sau hoặc ảnh chân dung.",
+		"KYC_DOCUMENTS_INCOMPLETE":           "CCCD cần ảnh mặt trước và mặt sau, hộ chiếu cần ảnh trang thông tin.",
+		"KYC_DOCUMENT_NUMBER_REQUIRED":       "Vui lòng nhập số giấy tờ.",
+		"INVALID_KYC_DOCUMENT_TYPE":          "Loại giấy tờ phải là CCCD hoặc hộ chiếu.",
+		"INVALID_KYC_DECISION":               "Kết quả duyệt phải là chấp thuận hoặc từ chối.",
+		"KYC_REJECTION_REASON_REQUIRED":      "Vui lòng nhập lý do từ chối.",
+		"KYC_NOT_APPROVED":                   "Tài khoản chưa được xác minh danh tính.",
+		"INVALID_BLOB_SIGNATURE":             "Liên kết tệp không hợp lệ.",
+		"BLOB_URL_EXPIRED":                   "Liên kết tệp đã hết hạn.",
+		"BLOB_TOO_LARGE":                     "Tệp vượt quá dung lượng cho phép.",
+		"BLOB_CONTENT_TYPE":                  "Loại tệp không được chấp nhận.",
+		"AVATAR_UPLOAD_NOT_FOUND":            "Không tìm thấy ảnh đại diện đã tải lên; hãy yêu cầu liên kết tải lên mới.",
+		"AVATAR_TOO_LARGE":                   "Ảnh đại diện tối đa 2 MiB.",
+		"AVATAR_CONTENT_TYPE":                "Ảnh đại diện phải là JPEG, PNG hoặc WebP.",
+		"STOCK_NOT_FOUND":                    "Mã chứng khoán không được niêm yết.",
+		"INVALID_STOCK_CODE":                 "Mã chứng khoán gồm 1 đến 10 chữ cái hoặc chữ số.",
+		"INVALID_WATCHLIST":                  "Danh mục theo dõi cần có người dùng và tên.",
+		"INVALID_WATCHLIST_NAME":             "Tên danh mục theo dõi dài từ 1 đến 64 ký tự.",
+		"WATCHLIST_NOT_FOUND":                "Không tìm thấy danh mục theo dõi.",
+		"WATCHLIST_NAME_TAKEN":               "Đã có danh mục theo dõi cùng tên.",
+		"WATCHLIST_LIMIT":                    "Bạn chỉ có thể tạo tối đa 20 danh mục theo dõi.",
+		"WATCHLIST_FULL":                     "Mỗi danh mục theo dõi chứa tối đa 50 mã.",
+		"WATCHLIST_STOCK_EXISTS":             "Mã chứng khoán đã có trong danh mục theo dõi.",
+		"WATCHLIST_STOCK_NOT_FOUND":          "Mã chứng khoán không có trong danh mục theo dõi.",
+		"WATCHLIST_ORDER_MISMATCH":           "Thứ tự mới phải liệt kê đúng các mã trong danh mục theo dõi.",
+		"INVALID_PRICE_ALERT":                "Cảnh báo giá cần người dùng, mã chứng khoán và điều kiện hợp lệ.",
+		"PRICE_ALERT_NOT_FOUND":              "Không tìm thấy cảnh báo giá.",
+		"INVALID_ALERT_CONDITION":            "Điều kiện cảnh báo phải là above, below hoặc move.",
+		"INVALID_ALERT_TARGET":               "Giá mục tiêu phải lớn hơn 0.",
+		"INVALID_ALERT_CHANGE":               "Mức biến động phải từ 1 đến 10000 điểm cơ bản.",
+		"STOCK_PRICE_UNAVAILABLE":            "Chưa có giá cho mã chứng khoán này.",
+		"PRICE_ALERT_LIMIT":                  "Bạn chỉ có thể có tối đa 50 cảnh báo giá đang hoạt động.",
+		"INVALID_NEWS":                       "Tin tức cần chuyên mục, tiêu đề và thời gian đăng.",
+		"NEWS_NOT_FOUND":                     "Không tìm thấy tin tức.",
+		"NEWS_EXTERNAL_ID_TAKEN":             "Đã có tin tức với mã nguồn này.",
+		"INVALID_NEWS_CATEGORY":              "Chuyên mục phải là market, company, economy, analysis hoặc announcement.",
+		"INVALID_NEWS_TITLE":                 "Tiêu đề phải có từ 1 đến 255 ký tự.",
+		"INVALID_NEWS_TEXT":                  "Tóm tắt tối đa 2000 ký tự và nội dung tối đa 100000 ký tự.",
+		"INVALID_NEWS_SOURCE":                "Nguồn tối đa 255 ký tự và liên kết phải là URL http hoặc https đầy đủ.",
+		"INVALID_NEWS_EXTERNAL_ID":           "Mã nguồn của tin tức tối đa 255 ký tự.",
+		"NEWS_CODE_LIMIT":                    "Một tin tức liên quan tối đa 20 mã chứng khoán.",
+		"INVALID_NEWS_QUERY":                 "Nội dung tìm kiếm tối đa 200 ký tự.",
+		"INVALID_ORDER":                      "Lệnh cần người dùng, mã chứng khoán, chiều mua bán, hiệu lực, giá và khối lượng.",
+		"ORDER_NOT_FOUND":                    "Không tìm thấy lệnh.",
+		"ORDER_NOT_OPEN":                     "Lệnh đã khớp hết, đã hủy, bị từ chối hoặc đã hết hiệu lực.",
+		"ORDER_QUANTITY_BELOW_FILLED":        "Khối lượng lệnh phải lớn hơn khối lượng đã khớp.",
+		"ORDER_OVERFILL":                     "Khối lượng khớp vượt quá khối lượng còn lại của lệnh.",
+		"INVALID_ORDER_SIDE":                 "Chiều lệnh phải là mua (buy) hoặc bán (sell).",
+		"INVALID_TIME_IN_FORCE":              "Hiệu lực lệnh phải là GTC, DAY, IOC hoặc FOK.",
+		"INVALID_ORDER_PRICE":                "Giá đặt phải lớn hơn 0.",
+		"INVALID_ORDER_QUANTITY":             "Khối lượng đặt phải lớn hơn 0.",
+		"INVALID_ORDER_AMENDMENT":            "Sửa lệnh phải thay đổi giá hoặc khối lượng và giữ khối lượng lớn hơn khối lượng đã khớp.",
+		"INVALID_ORDER_FILL":                 "Kết quả khớp cần mã khớp tối đa 64 ký tự, giá và khối lượng lớn hơn 0.",
+		"INVALID_ORDER_REJECTION":            "Từ chối lệnh cần lý do tối đa 255 ký tự.",
+		"INVALID_ORDER_MATCH":                "Khớp lệnh cần một lệnh mua và một lệnh bán của cùng mã chứng khoán.",
+		"MARKET_CLOSED":                      "Thị trường đang đóng cửa.",
+		"TIME_IN_FORCE_NOT_SUPPORTED":        "Không hỗ trợ lệnh IOC và FOK vì lệnh không được khớp ngay khi đặt.",
+		"ORDER_IN_CALL_AUCTION":              "Không thể sửa hoặc hủy lệnh trong phiên khớp lệnh định kỳ.",
+		"INVALID_LOT_SIZE":                   "Khối lượng phải là bội số của lô giao dịch.",
+		"INVALID_TICK_SIZE":                  "Giá phải là bội số của bước giá.",
+		"PRICE_OUTSIDE_BAND":                 "Giá nằm ngoài biên độ trần và sàn trong ngày.",
+		"ORDER_VALUE_LIMIT_EXCEEDED":         "Giá trị lệnh vượt quá hạn mức cho mỗi lệnh.",
+		"DAILY_ORDER_LIMIT_EXCEEDED":         "Số lệnh trong ngày đã đạt hạn mức.",
+		"DAILY_VALUE_LIMIT_EXCEEDED":         "Giá trị lệnh vượt quá hạn mức còn lại trong ngày.",
+		"INSUFFICIENT_BUYING_POWER":          "Sức mua không đủ để đặt lệnh.",
+		"INSUFFICIENT_HOLDINGS":              "Số cổ phiếu khả dụng không đủ để đặt lệnh bán.",
+		"UNKNOWN_TRADING_TIER":               "Hạng tài khoản giao dịch chưa được cấu hình.",
+		"INVALID_TRADING_TIER":               "Hạng tài khoản giao dịch gồm 1 đến 32 ký tự.",
+		"INVALID_LEDGER_ENTRY":               "Bút toán cần mã tham chiếu tối đa 64 ký tự, người dùng, loại và số tiền khác 0.",
+		"INVALID_LEDGER_ADJUSTMENT":          "Điều chỉnh cần số lượng khác 0, mã tham chiếu từ 1 đến 50 ký tự và ghi chú tối đa 255 ký tự.",
+		"INVALID_PAYMENT":                    "Giao dịch nạp/rút cần người dùng, loại, số tiền dương và khóa idempotency tối đa 64 ký tự.",
+		"PAYMENT_NOT_FOUND":                  "Không tìm thấy giao dịch nạp/rút.",
+		"PAYMENT_STATUS_CONFLICT":            "Trạng thái giao dịch nạp/rút không cho phép thay đổi này.",
+		"INVALID_PAYMENT_REQUEST":            "Yêu cầu nạp/rút cần số tiền dương và khóa idempotency từ 1 đến 64 ký tự.",
+		"IDEMPOTENCY_KEY_REUSED":             "Khóa idempotency đã được dùng cho một giao dịch khác.",
+		"INSUFFICIENT_FUNDS":                 "Số tiền rút vượt quá số dư khả dụng.",
+		"PAYMENTS_UNAVAILABLE":               "Chức năng nạp/rút tiền hiện không khả dụng.",
+		"PAYMENT_NOT_AWAITING_APPROVAL":      "Giao dịch không phải lệnh rút tiền đang chờ duyệt.",
+		"INVALID_PAYMENT_CALLBACK":           "Thông báo từ cổng thanh toán không hợp lệ.",
+		"INVALID_WITHDRAWAL_DECISION":        "Quyết định phải là approved hoặc rejected.",
+		"WITHDRAWAL_REASON_REQUIRED":         "Từ chối lệnh rút tiền cần lý do tối đa 255 ký tự.",
+		"WITHDRAWAL_SELF_REVIEW":             "Quản trị viên không thể duyệt lệnh rút tiền của chính mình.",
+		"ORDER_FILL_NOT_FOUND":               "Không tìm thấy lần khớp lệnh.",
+		"INVALID_STATEMENT":                  "Sao kê cần người dùng, kỳ, thời gian bắt đầu và kết thúc cùng khóa của các tệp.",
+		"STATEMENT_NOT_FOUND":                "Không tìm thấy sao kê.",
+		"INVALID_STATEMENT_PERIOD":           "Kỳ sao kê phải là một tháng, ví dụ 2026-09.",
+		"STATEMENT_PERIOD_OPEN":              "Sao kê chỉ có sau khi tháng kết thúc.",
+		"INVALID_FEE_SCHEDULE":               "Biểu phí có tỷ lệ từ 0 đến 10000 điểm cơ bản, phí không âm, phí tối đa bằng 0 hoặc không nhỏ hơn phí tối thiểu và các bậc khối lượng tăng dần.",
+		"FEE_SCHEDULE_IN_PAST":               "Biểu phí phải có hiệu lực trong tương lai.",
+		"FEE_SCHEDULE_EXISTS":                "Hạng này đã có biểu phí có hiệu lực vào thời điểm này.",
+		"FEE_SCHEDULE_NOT_FOUND":             "Không tìm thấy biểu phí.",
+		"FEE_SCHEDULE_IN_EFFECT":             "Không thể xóa biểu phí đã có hiệu lực.",
+		"INVALID_CORPORATE_ACTION":           "Cổ tức tiền mặt cần số tiền dương trên mỗi cổ phiếu; cổ tức bằng cổ phiếu và chia tách cần số cổ phiếu mới lớn hơn số cổ phiếu cũ.",
+		"INVALID_CORPORATE_ACTION_DATE":      "Ngày phải có dạng YYYY-MM-DD và ngày đăng ký cuối cùng không được trước ngày giao dịch không hưởng quyền.",
+		"CORPORATE_ACTION_EX_DATE":           "Ngày giao dịch không hưởng quyền phải là một ngày giao dịch sau hôm nay.",
+		"CORPORATE_ACTION_EXISTS":            "Cổ phiếu đã có sự kiện quyền cùng loại vào ngày giao dịch không hưởng quyền này.",
+		"CORPORATE_ACTION_NOT_FOUND":         "Không tìm thấy sự kiện quyền.",
+		"CORPORATE_ACTION_APPLIED":           "Không thể xóa sự kiện quyền đã được thực hiện.",
+		"INVALID_COST_METHOD":                "Phương pháp tính giá vốn phải là fifo hoặc average.",
+		"INVALID_PERFORMANCE_RANGE":          "Khoảng thời gian phải là 1w, 1m, 3m, 6m, ytd, 1y hoặc all.",
+		"INVALID_PORTFOLIO_SNAPSHOT":         "Ảnh chụp danh mục cần có người dùng và ngày.",
+		"PORTFOLIO_SNAPSHOT_NOT_FOUND":       "Không tìm thấy ảnh chụp danh mục.",
+	},
+	LocaleEnglish: {
+		"INVALID_ARGUMENT":    "The request is invalid.",
+		"INVALID_REQUEST":     "The request is invalid.",
+		"NOT_FOUND":           "The requested resource was not found.",
+		"ALREADY_EXISTS":      "The resource already exists.",
+		"UNAUTHENTICATED":     "Please sign in to continue.",
+		"PERMISSION_DENIED":   "You are not allowed to perform this action.",
+		"FAILED_PRECONDITION": "The action cannot be performed in the current state.",
+		"RESOURCE_EXHAUSTED":  "Too many requests, please try again later.",
+		"ABORTED":             "The data was changed by someone else, please reload and try again.",
+		"CANCELED":            "The request was canceled.",
+		"DEADLINE_EXCEEDED":   "The request timed out.",
+		"UNIMPLEMENTED":       "This feature is not supported.",
+		"UNAVAILABLE":         "The service is temporarily unavailable, please try again later.",
+		"INTERNAL":            "Something went wrong, please try again later.",
+		"UNKNOWN":             "Something went wrong, please try again later.",
+
+		"USER_NOT_FOUND":                     "User not found.",
+		"USER_ALREADY_EXISTS":                "The username or email is already in use.",
+		"USER_NOT_VERIFIED":                  "Your email address has not been verified yet.",
+		"USER_ALREADY_VERIFIED":              "Your account is already verified.",
+		"INVALID_CREDENTIALS":                "Incorrect username or password.",
+		"INVALID_REFRESH_TOKEN":              "Your session is invalid or has expired.",
+		"INVALID_CURRENT_PASSWORD":           "The current password is incorrect.",
+		"USERNAME_REQUIRED":                  "Please enter a username.",
+		"PASSWORD_REQUIRED":                  "Please enter a password.",
+		"NEW_PASSWORD_REQUIRED":              "Please enter a new password.",
+		"EMAIL_REQUIRED":                     "Please enter an email address.",
+		"NAME_REQUIRED":                      "Please enter your name.",
+		"TOKEN_REQUIRED":                     "The verification token is missing.",
+		"VERIFICATION_TOKEN_NOT_FOUND":       "The verification token does not exist.",
+		"VERIFICATION_TOKEN_EXPIRED":         "The verification token has expired.",
+		"VERIFICATION_TOKEN_USED":            "The verification token has already been used.",
+		"RESEND_TOO_FREQUENT":                "You are requesting too often, please try again later.",
+		"OUTBOX_EVENT_NOT_FOUND":             "Event not found.",
+		"INVALID_OUTBOX_STATUS":              "Invalid event status.",
+		"USER_VERSION_CONFLICT":              "The profile was updated elsewhere, please reload and try again.",
+		"INVALID_UPDATE_MASK":                "The update mask contains an unknown field.",
+		"UPDATE_MASK_EMPTY":                  "There is nothing to update.",
+		"INVALID_ETAG":                       "The ETag value is invalid.",
+		"EMAIL_UNCHANGED":                    "The new email is the same as the current one.",
+		"EMAIL_CHANGE_REQUIRES_CONFIRMATION": "Email addresses can only be changed through the email change flow.",
+		"INVALID_ORDER_BY":                   "The sort order is invalid.",
+		"INVALID_FILTER":                     "The filter is invalid.",
+		"INVALID_PAGE_TOKEN":                 "The page token is invalid or does not match the request.",
+		"ACCOUNT_INACTIVE":                   "The account is deactivated or scheduled for deletion.",
+		"ACCOUNT_DELETION_GRACE_EXPIRED":     "The account can no longer be restored.",
+		"ACCOUNT_STATUS_CONFLICT":            "The current account status does not allow this action.",
+		"ACCOUNT_NOT_EMPTY":                  "The account still holds cash or shares, or has trades, orders or withdrawals in progress.",
+		"INVALID_ACCOUNT_STATUS":             "The account status is invalid.",
+		"INVALID_EXPORT_FORMAT":              "The export format must be json or zip.",
+		"INVALID_DATA_EXPORT":                "The data export request is invalid.",
+		"DATA_EXPORT_NOT_FOUND":              "The data export was not found.",
+		"DATA_EXPORT_EXPIRED":                "The data export has expired.",
+		"DATA_EXPORT_IN_PROGRESS":            "A data export is already in progress.",
+		"DATA_EXPORT_NOT_PROCESSING":         "The data export is not being processed.",
+		"INVALID_AUDIT_EVENT":                "The audit event is invalid.",
+		"INVALID_LOGIN_RECORD":               "The login record is invalid.",
+		"BLOB_NOT_FOUND":                     "The file was not found.",
+		"INVALID_BLOB_KEY":                   "The file key is invalid.",
+		"INVALID_KYC_DOCUMENT":               "The document image is invalid.",
+		"INVALID_KYC_SUBMISSION":             "The identity verification submission is invalid.",
+		"KYC_DOCUMENT_NOT_FOUND":             "The document image was not found or was already submitted.",
+		"KYC_SUBMISSION_NOT_FOUND":           "The identity verification submission was not found.",
+		"KYC_STATUS_CONFLICT":                "The identity verification status does not allow this action.",
+		"KYC_DOCUMENT_TOO_LARGE":             "The document image must be at most 10 MiB.",
+		"KYC_DOCUMENT_CONTENT_TYPE":          "The document image must be a JPEG or PNG.",
+		"INVALID_KYC_DOCUMENT_KIND":          "The image kind must be front, back or selfie.",
+		"KYC_DOCUMENTS_INCOMPLETE":           "ID cards need a front and a back image, passports a front image.",
+		"KYC_DOCUMENT_NUMBER_REQUIRED":       "The document number is required.",
+		"INVALID_KYC_DOCUMENT_TYPE":          "The document type must be id_card or passport.",
+		"INVALID_KYC_DECISION":               "The decision must be approved or rejected.",
+		"KYC_REJECTION_REASON_REQUIRED":      "A rejection needs a reason.",
+		"KYC_NOT_APPROVED":                   "Identity verification has not been approved.",
+		"INVALID_BLOB_SIGNATURE":             "The file link is invalid.",
+		"BLOB_URL_EXPIRED":                   "The file link has expired.",
+		"BLOB_TOO_LARGE":                     "The file is larger than allowed.",
+		"BLOB_CONTENT_TYPE":                  "This type of file is not accepted.",
+		"AVATAR_UPLOAD_NOT_FOUND":            "The uploaded avatar was not found; request a new upload link.",
+		"AVATAR_TOO_LARGE":                   "The avatar image must be at most 2 MiB.",
+		"AVATAR_CONTENT_TYPE":                "The avatar image must be a JPEG, PNG or WebP.",
+		"STOCK_NOT_FOUND":                    "The stock code is not listed.",
+		"INVALID_STOCK_CODE":                 "A stock code has 1 to 10 letters or digits.",
+		"INVALID_WATCHLIST":                  "A watchlist needs a user and a name.",
+		"INVALID_WATCHLIST_NAME":             "Watchlist names are 1 to 64 characters long.",
+		"WATCHLIST_NOT_FOUND":                "The watchlist was not found.",
+		"WATCHLIST_NAME_TAKEN":               "You already have a watchlist with this name.",
+		"WATCHLIST_LIMIT":                    "You can keep at most 20 watchlists.",
+		"WATCHLIST_FULL":                     "A watchlist holds at most 50 stocks.",
+		"WATCHLIST_STOCK_EXISTS":             "The stock is already on the watchlist.",
+		"WATCHLIST_STOCK_NOT_FOUND":          "The stock is not on the watchlist.",
+		"WATCHLIST_ORDER_MISMATCH":           "The new order must list exactly the stocks on the watchlist.",
+		"INVALID_PRICE_ALERT":                "A price alert needs a user, a stock and a valid condition.",
+		"PRICE_ALERT_NOT_FOUND":              "Price alert not found.",
+		"INVALID_ALERT_CONDITION":            "The alert condition must be above, below or move.",
+		"INVALID_ALERT_TARGET":               "The target price must be positive.",
+		"INVALID_ALERT_CHANGE":               "The change must be between 1 and 10000 basis points.",
+		"STOCK_PRICE_UNAVAILABLE":            "No price is known for this stock yet.",
+		"PRICE_ALERT_LIMIT":                  "You can keep at most 50 active price alerts.",
+		"INVALID_NEWS":                       "A news item needs a category, a title and a publication time.",
+		"NEWS_NOT_FOUND":                     "The news item was not found.",
+		"NEWS_EXTERNAL_ID_TAKEN":             "A news item with this external id already exists.",
+		"INVALID_NEWS_CATEGORY":              "The category must be market, company, economy, analysis or announcement.",
+		"INVALID_NEWS_TITLE":                 "The title must be 1 to 255 characters.",
+		"INVALID_NEWS_TEXT":                  "The summary must be at most 2000 and the content at most 100000 characters.",
+		"INVALID_NEWS_SOURCE":                "The source must be at most 255 characters and the link an absolute http or https URL.",
+		"INVALID_NEWS_EXTERNAL_ID":           "The external id must be at most 255 characters.",
+		"NEWS_CODE_LIMIT":                    "A news item relates to at most 20 stocks.",
+		"INVALID_NEWS_QUERY":                 "The search text must be at most 200 characters.",
+		"INVALID_ORDER":                      "An order needs a user, a stock, a side, a time in force, a price and a quantity.",
+		"ORDER_NOT_FOUND":                    "Order not found.",
+		"ORDER_NOT_OPEN":                     "The order is already filled, cancelled, rejected or expired.",
+		"ORDER_QUANTITY_BELOW_FILLED":        "The order quantity must exceed the filled quantity.",
+		"ORDER_OVERFILL":                     "The fill exceeds the remaining quantity of the order.",
+		"INVALID_ORDER_SIDE":                 "The side must be buy or sell.",
+		"INVALID_TIME_IN_FORCE":              "The time in force must be GTC, DAY, IOC or FOK.",
+		"INVALID_ORDER_PRICE":                "The price must be positive.",
+		"INVALID_ORDER_QUANTITY":             "The quantity must be positive.",
+		"INVALID_ORDER_AMENDMENT":            "An amendment must change the price or quantity and keep the quantity above the filled quantity.",
+		"INVALID_ORDER_FILL":                 "A fill needs an execution id of at most 64 characters, a positive price and a positive quantity.",
+		"INVALID_ORDER_REJECTION":            "A rejection needs a reason of at most 255 characters.",
+		"INVALID_ORDER_MATCH":                "A match needs a buy and a sell order of the same stock.",
+		"MARKET_CLOSED":                      "The market is closed.",
+		"TIME_IN_FORCE_NOT_SUPPORTED":        "IOC and FOK orders are not supported because orders are not matched as they are placed.",
+		"ORDER_IN_CALL_AUCTION":              "Orders cannot be amended or cancelled during a call auction session.",
+		"INVALID_LOT_SIZE":                   "The quantity must be a multiple of the lot size.",
+		"INVALID_TICK_SIZE":                  "The price must be a multiple of the tick size.",
+		"PRICE_OUTSIDE_BAND":                 "The price is outside the floor and ceiling of the day.",
+		"ORDER_VALUE_LIMIT_EXCEEDED":         "The order value exceeds the limit per order.",
+		"DAILY_ORDER_LIMIT_EXCEEDED":         "The number of orders of the day reached the limit.",
+		"DAILY_VALUE_LIMIT_EXCEEDED":         "The order value exceeds what is left of the daily limit.",
+		"INSUFFICIENT_BUYING_POWER":          "There is not enough buying power for the order.",
+		"INSUFFICIENT_HOLDINGS":              "There are not enough available shares for the sell order.",
+		"UNKNOWN_TRADING_TIER":               "The trading tier is not configured.",
+		"INVALID_TRADING_TIER":               "A trading tier has 1 to 32 characters.",
+		"INVALID_LEDGER_ENTRY":               "Ledger entries need a reference of at most 64 characters, a user, a type and a non-zero amount.",
+		"INVALID_LEDGER_ADJUSTMENT":          "An adjustment needs a non-zero amount, a reference of 1 to 50 characters and a note of at most 255 characters.",
+		"INVALID_PAYMENT":                    "A payment needs a user, a kind, a positive amount and an idempotency key of at most 64 characters.",
+		"PAYMENT_NOT_FOUND":                  "Payment not found.",
+		"PAYMENT_STATUS_CONFLICT":            "The payment's status does not allow this change.",
+		"INVALID_PAYMENT_REQUEST":            "A payment needs a positive amount and an idempotency key of 1 to 64 characters.",
+		"IDEMPOTENCY_KEY_REUSED":             "The idempotency key was used for another payment.",
+		"INSUFFICIENT_FUNDS":                 "The withdrawal is above the available cash.",
+		"PAYMENTS_UNAVAILABLE":               "Deposits and withdrawals are not available.",
+		"PAYMENT_NOT_AWAITING_APPROVAL":      "The payment is not a withdrawal awaiting approval.",
+		"INVALID_PAYMENT_CALLBACK":           "The payment gateway callback is invalid.",
+		"INVALID_WITHDRAWAL_DECISION":        "The decision must be approved or rejected.",
+		"WITHDRAWAL_REASON_REQUIRED":         "Rejecting a withdrawal needs a reason of at most 255 characters.",
+		"WITHDRAWAL_SELF_REVIEW":             "Administrators cannot review their own withdrawals.",
+		"ORDER_FILL_NOT_FOUND":               "Order fill not found.",
+		"INVALID_STATEMENT":                  "A statement needs a user, a period, its bounds and the keys of its files.",
+		"STATEMENT_NOT_FOUND":                "Statement not found.",
+		"INVALID_STATEMENT_PERIOD":           "The period must be a month such as 2026-09.",
+		"STATEMENT_PERIOD_OPEN":              "Statements are available once the month has ended.",
+		"INVALID_FEE_SCHEDULE":               "Rates must be 0 to 10000 basis points, fees may not be negative, the maximum fee must be zero or at least the minimum and volume tiers must ascend.",
+		"FEE_SCHEDULE_IN_PAST":               "A fee schedule must take effect in the future.",
+		"FEE_SCHEDULE_EXISTS":                "The tier already has a fee schedule taking effect at this time.",
+		"FEE_SCHEDULE_NOT_FOUND":             "Fee schedule not found.",
+		"FEE_SCHEDULE_IN_EFFECT":             "A fee schedule cannot be deleted once it has taken effect.",
+		"INVALID_CORPORATE_ACTION":           "Cash dividends need a positive amount per share; stock dividends and splits need more new shares than old shares.",
+		"INVALID_CORPORATE_ACTION_DATE":      "Dates must be given as YYYY-MM-DD and the record date may not precede the ex-date.",
+		"CORPORATE_ACTION_EX_DATE":           "The ex-date must be a trading day after today.",
+		"CORPORATE_ACTION_EXISTS":            "The stock already has a corporate action of this kind on the ex-date.",
+		"CORPORATE_ACTION_NOT_FOUND":         "Corporate action not found.",
+		"CORPORATE_ACTION_APPLIED":           "A corporate action cannot be deleted once it has been applied.",
+		"INVALID_COST_METHOD":                "The cost method must be fifo or average.",
+		"INVALID_PERFORMANCE_RANGE":          "The range must be 1w, 1m, 3m, 6m, ytd, 1y or all.",
+		"INVALID_PORTFOLIO_SNAPSHOT":         "A portfolio snapshot needs a user and a date.",
+		"PORTFOLIO_SNAPSHOT_NOT_FOUND":       "Portfolio snapshot not found.",
+	},
+}
@@ -0,0 +1,87 @@
+// Package i18n holds the user-facing message catalog shared by the gRPC server
+// (google.rpc.LocalizedMessage details) and the HTTP gateway error envelope.
+//
+// Messages are keyed by the stable error reasons reported in
+// google.rpc.ErrorInfo, so every transport localizes the same error the same way.
+package i18n
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// Supported locales. DefaultLocale is used when the client does not ask for a
+// supported language.
+const (
+	LocaleVietnamese = "vi"
+	LocaleEnglish    = "en"
+	DefaultLocale    = LocaleVietnamese
+)
+
+// AcceptLanguageHeader is the HTTP header (and lower-cased gRPC metadata key)
+// carrying the client language preference.
+const AcceptLanguageHeader = "Accept-Language"
+
+var matcher = language.NewMatcher([]language.Tag{
+	language.Vietnamese, // first tag is the fallback
+	language.English,
+})
+
+// ParseAcceptLanguage picks the best supported locale for an Accept-Language
+// value such as "en-US,en;q=0.9,vi;q=0.8".
+func ParseAcceptLanguage(header string) string {
+	if strings.TrimSpace(header) == "" {
+		return DefaultLocale
+	}
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil || len(tags) == 0 {
+		return DefaultLocale
+	}
+	_, idx, conf := matcher.Match(tags...)
+	if conf == language.No {
+		return DefaultLocale
+	}
+	if idx == 1 {
+		return LocaleEnglish
+	}
+	return LocaleVietnamese
+}
+
+// LocaleFromIncomingContext reads the accept-language metadata forwarded by the
+// gateway (or sent by gRPC clients directly).
+func LocaleFromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return DefaultLocale
+	}
+	return ParseAcceptLanguage(strings.Join(md.Get(strings.ToLower(AcceptLanguageHeader)), ","))
+}
+
+// Message returns the catalog text for reason in locale. ok is false when the
+// reason is unknown; callers should then fall back to their own message.
+func Message(locale, reason string) (msg string, ok bool) {
+	messages, found := catalog[locale]
+	if !found {
+		messages = catalog[DefaultLocale]
+	}
+	msg, ok = messages[reason]
+	return msg, ok
+}
+
+// CodeReason derives the generic UPPER_SNAKE_CASE reason for a gRPC code, e.g.
+// codes.NotFound -> "NOT_FOUND". It is used when an error carries no domain
+// reason of its own.
+func CodeReason(code codes.Code) string {
+	var b strings.Builder
+	for i, r := range code.String() {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
@@ -0,0 +1,130 @@
+package i18n
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	cases := map[string]string{
+		"":                          DefaultLocale,
+		"en":                        LocaleEnglish,
+		"en-US,en;q=0.9":            LocaleEnglish,
+		"vi-VN":                     LocaleVietnamese,
+		"fr-FR,en;q=0.5":            LocaleEnglish,
+		"en;q=0.3,vi;q=0.8":         LocaleVietnamese,
+		"de":                        DefaultLocale,
+		"not a language tag;q=oops": DefaultLocale,
+	}
+	for header, want := range cases {
+		require.Equal(t, want, ParseAcceptLanguage(header), header)
+	}
+}
+
+func TestLocaleFromIncomingContext(t *testing.T) {
+	require.Equal(t, DefaultLocale, LocaleFromIncomingContext(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("accept-language", "en-GB"))
+	require.Equal(t, LocaleEnglish, LocaleFromIncomingContext(ctx))
+}
+
+func TestMessage(t *testing.T) {
+	msg, ok := Message(LocaleEnglish, "USER_NOT_FOUND")
+	require.True(t, ok)
+	require.Equal(t, "User not found.", msg)
+
+	msg, ok = Message("fr", "USER_NOT_FOUND")
+	require.True(t, ok, "unknown locales fall back to the default")
+	require.Equal(t, catalog[DefaultLocale]["USER_NOT_FOUND"], msg)
+
+	_, ok = Message(LocaleEnglish, "NO_SUCH_REASON")
+	require.False(t, ok)
+}
+
+func TestCatalogComplete(t *testing.T) {
+	for locale, messages := range catalog {
+		for other, otherMessages := range catalog {
+			for reason := range otherMessages {
+				_, ok := messages[reason]
+				require.True(t, ok, "reason %s exists in %s but not in %s", reason, other, locale)
+			}
+		}
+	}
+}
+
+// TestCatalogCoversDeclaredReasons checks that every reason passed to
+// apperrors.New in the module's sources has a message.
+func TestCatalogCoversDeclaredReasons(t *testing.T) {
+	reasons := make(map[string]string)
+	err := filepath.WalkDir("../..", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			// repotest declares errors of its fake callbacks, which never
+			// reach clients.
+			if name := d.Name(); name == "vendor" || name == "repotest" || (name != "." && name != ".." && strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "New" {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "apperrors" {
+				return true
+			}
+			lit, ok := call.Args[1].(*ast.BasicLit)
+			require.True(t, ok && lit.Kind == token.STRING, "%s: reasons are string literals", path)
+			reason, err := strconv.Unquote(lit.Value)
+			require.NoError(t, err)
+			reasons[reason] = path
+			return true
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, reasons)
+
+	for reason, path := range reasons {
+		for locale, messages := range catalog {
+			_, ok := messages[reason]
+			assert.True(t, ok, "reason %s declared in %s has no %s message", reason, path, locale)
+		}
+	}
+}
+
+func TestCodeReason(t *testing.T) {
+	require.Equal(t, "NOT_FOUND", CodeReason(codes.NotFound))
+	require.Equal(t, "FAILED_PRECONDITION", CodeReason(codes.FailedPrecondition))
+	require.Equal(t, "INTERNAL", CodeReason(codes.Internal))
+	for reason := range map[string]struct{}{CodeReason(codes.Canceled): {}, CodeReason(codes.Unavailable): {}} {
+		_, ok := Message(LocaleEnglish, reason)
+		require.True(t, ok, reason)
+	}
+}
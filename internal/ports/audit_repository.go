@@ -0,0 +1,38 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// AuditEventFilter narrows ListAuditEvents results. Zero values disable a
+// condition.
+type AuditEventFilter struct {
+	UserID        int64
+	ActorID       int64
+	Actions       []user.AuditAction
+	CreatedAfter  time.Time // inclusive
+	CreatedBefore time.Time // exclusive
+	RequestID     string
+}
+
+// ListAuditEventsParams selects a page of audit events, newest first. When
+// BeforeID is set only events with a smaller id are returned.
+type ListAuditEventsParams struct {
+	Filter   AuditEventFilter
+	BeforeID int64
+	Limit    int
+}
+
+// AuditRepository stores the append-only audit log. Events are never updated
+// or deleted, except that anonymizing an account clears the personal data in
+// its events.
+type AuditRepository interface {
+	// AppendAuditEvents stores the events of one request in a single write.
+	AppendAuditEvents(ctx context.Context, events []user.AuditEvent) error
+
+	// ListAuditEvents returns events matching params.Filter, newest first.
+	ListAuditEvents(ctx context.Context, params ListAuditEventsParams) ([]user.AuditEvent, error)
+}
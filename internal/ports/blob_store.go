@@ -0,0 +1,40 @@
+package ports
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// BlobStore keeps uploaded files by key. Keys are slash separated relative
+// paths such as "kyc/42/<random>".
+type BlobStore interface {
+	// Put stores content under key, replacing any previous content.
+	Put(ctx context.Context, key string, content io.Reader, contentType string) error
+
+	// Get opens the content stored under key. It fails with an
+	// apperrors.ErrNotFound error when there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the content under key. Deleting a missing key succeeds.
+	Delete(ctx context.Context, key string) error
+}
+
+// BlobUploadPolicy restricts what may be uploaded through a signed URL.
+type BlobUploadPolicy struct {
+	// MaxBytes is the largest accepted body.
+	MaxBytes int64
+	// ContentTypes lists the accepted sniffed content types.
+	ContentTypes []string
+}
+
+// BlobURLSigner issues URLs that let clients transfer a single blob over HTTP
+// without other credentials until the URLs expire.
+type BlobURLSigner interface {
+	// DownloadURL returns a URL for GET requests on key.
+	DownloadURL(key string, expires time.Time) string
+
+	// UploadURL returns a URL for PUT requests on key whose body must satisfy
+	// policy.
+	UploadURL(key string, expires time.Time, policy BlobUploadPolicy) string
+}
@@ -0,0 +1,64 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// StockHolder is a user holding shares of a stock, with the user so a
+// notice can be addressed.
+type StockHolder struct {
+	Owner  user.User
+	Shares int64
+}
+
+// ApplyCorporateActionParams applies a scheduled action. Entries are posted
+// to the ledger under the reference of the action; entries naming a stock
+// are in the action's stock. The prices of the stock recorded before
+// PricesBefore are back-adjusted by Adjustment. Events, addressed by their
+// AggregateID, are written to the outbox. All in one transaction.
+type ApplyCorporateActionParams struct {
+	ActionID     int64
+	Entries      []user.LedgerEntry
+	Adjustment   user.PriceAdjustment
+	PricesBefore time.Time
+	Events       []user.OutboxEvent
+	At           time.Time
+}
+
+// CorporateActionRepository keeps the corporate actions of listed stocks. A
+// stock has at most one action of a kind per ex-date.
+type CorporateActionRepository interface {
+	// CreateCorporateAction stores a scheduled action on the stock with the
+	// action's code. It fails with a not found error when the code is not
+	// listed and with a conflict error when the stock has an action of the
+	// kind on the ex-date.
+	CreateCorporateAction(ctx context.Context, action user.CorporateAction) (user.CorporateAction, error)
+
+	GetCorporateAction(ctx context.Context, actionID int64) (user.CorporateAction, error)
+
+	// ListCorporateActions returns the actions on the stock with code, or on
+	// every stock when code is empty, latest ex-date first.
+	ListCorporateActions(ctx context.Context, code string) ([]user.CorporateAction, error)
+
+	// ListDueCorporateActions returns the scheduled actions with an ex-date
+	// on or before through, earliest ex-date first, then by id.
+	ListDueCorporateActions(ctx context.Context, through time.Time) ([]user.CorporateAction, error)
+
+	// DeleteCorporateAction removes a scheduled action. It fails with a not
+	// found error when there is no such action and with an
+	// apperrors.ErrFailedPrecondition error when it was applied.
+	DeleteCorporateAction(ctx context.Context, actionID int64) error
+
+	// ListStockHolders returns the users holding shares of the stock before
+	// at, by user id. Shares traded before at count whether they settled or
+	// not.
+	ListStockHolders(ctx context.Context, stockID int64, at time.Time) ([]StockHolder, error)
+
+	// ApplyCorporateAction applies a scheduled action and marks it applied
+	// at params.At. It returns false, changing nothing, when the action was
+	// applied already or deleted, so concurrent runs apply it once.
+	ApplyCorporateAction(ctx context.Context, params ApplyCorporateActionParams) (bool, error)
+}
@@ -0,0 +1,59 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// PersonalData is everything stored about a user that a data export contains.
+type PersonalData struct {
+	User               user.User
+	VerificationTokens []user.VerificationToken
+	OutboxEvents       []user.OutboxEvent
+	LoginHistory       []user.LoginRecord
+	// KycSubmissions hold the metadata of the user's document images, not the
+	// images themselves.
+	KycSubmissions []user.KycSubmission
+}
+
+// FinishDataExportParams records the outcome of a processing export. Content
+// is stored when Status is ready and Error when it is failed.
+type FinishDataExportParams struct {
+	ExportID  int64
+	Status    user.DataExportStatus
+	Content   []byte
+	Error     string
+	At        time.Time
+	ExpiresAt time.Time
+}
+
+// DataExportRepository queues personal data exports for the background worker
+// and reads the data they are built from.
+type DataExportRepository interface {
+	// CreateDataExport queues a pending export for the user. It fails with an
+	// apperrors.ErrConflict error while another export of the user is pending
+	// or processing.
+	CreateDataExport(ctx context.Context, userID int64, format user.DataExportFormat, at time.Time) (user.DataExport, error)
+
+	// GetDataExport returns an export of the user, including its content.
+	GetDataExport(ctx context.Context, userID, exportID int64) (user.DataExport, error)
+
+	// ClaimDataExport moves the oldest pending export, or a processing export
+	// last updated before staleBefore, to processing and returns it. It fails
+	// with an apperrors.ErrNotFound error when there is nothing to process.
+	ClaimDataExport(ctx context.Context, at, staleBefore time.Time) (user.DataExport, error)
+
+	// FinishDataExport marks a processing export ready or failed. It fails with
+	// an apperrors.ErrFailedPrecondition error when the export is not processing.
+	FinishDataExport(ctx context.Context, params FinishDataExportParams) error
+
+	// DeleteExpiredDataExports removes finished exports that expired at or
+	// before the given time and returns how many were removed.
+	DeleteExpiredDataExports(ctx context.Context, before time.Time) (int64, error)
+
+	// GetPersonalData collects the stored personal data of a user, whatever
+	// the account status.
+	GetPersonalData(ctx context.Context, userID int64) (PersonalData, error)
+}
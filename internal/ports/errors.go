@@ -0,0 +1,8 @@
+package ports
+
+import "github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+
+// ErrStockNotFound is returned by repositories when a stock code is not
+// listed. It is shared so use cases can tell it from the other not found
+// errors of a call, such as a missing user.
+var ErrStockNotFound = apperrors.New(apperrors.ErrNotFound, "STOCK_NOT_FOUND", "stock code is not listed")
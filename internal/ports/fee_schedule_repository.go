@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// FeeScheduleRepository keeps the fee schedules of the trading tiers. A tier
+// has at most one schedule taking effect at a given time.
+type FeeScheduleRepository interface {
+	// CreateFeeSchedule stores a new schedule. It fails with a conflict
+	// error when the tier has a schedule taking effect at the same time.
+	CreateFeeSchedule(ctx context.Context, schedule user.FeeSchedule) (user.FeeSchedule, error)
+
+	// ListFeeSchedules returns the schedules of tier, or of every tier when
+	// tier is empty, by tier and then earliest EffectiveFrom first.
+	ListFeeSchedules(ctx context.Context, tier string) ([]user.FeeSchedule, error)
+
+	// FeeScheduleAt returns the schedule of tier in force at at: the one
+	// that took effect last at or before at. It fails with a not found error
+	// when there is none.
+	FeeScheduleAt(ctx context.Context, tier string, at time.Time) (user.FeeSchedule, error)
+
+	// DeleteFeeSchedule removes a schedule that takes effect after at. It
+	// fails with a not found error when there is no such schedule and with
+	// an apperrors.ErrFailedPrecondition error when it took effect already.
+	DeleteFeeSchedule(ctx context.Context, scheduleID int64, at time.Time) error
+}
@@ -0,0 +1,74 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// SubmitKycParams stores a submission with the uploaded documents it attaches
+// and the outbox event announcing the pending review.
+type SubmitKycParams struct {
+	Submission  user.KycSubmission
+	DocumentIDs []int64
+	OutboxEvent user.OutboxEvent
+}
+
+// ReviewKycParams approves or rejects a pending submission. Reason is kept
+// for rejections only.
+type ReviewKycParams struct {
+	SubmissionID int64
+	ReviewerID   int64
+	Status       user.KycStatus
+	Reason       string
+	At           time.Time
+	OutboxEvent  user.OutboxEvent
+}
+
+// ListKycSubmissionsParams selects a page of submissions, oldest first. An
+// empty Status lists every submission; when AfterID is set only submissions
+// with a larger id are returned.
+type ListKycSubmissionsParams struct {
+	Status  user.KycStatus
+	AfterID int64
+	Limit   int
+}
+
+// KycRepository stores identity verification submissions and the metadata of
+// their document images. The images themselves live in a BlobStore.
+type KycRepository interface {
+	// CreateKycDocument stores an uploaded, not yet submitted document image.
+	CreateKycDocument(ctx context.Context, document user.KycDocument) (user.KycDocument, error)
+
+	// GetKycDocument returns a document image by id.
+	GetKycDocument(ctx context.Context, documentID int64) (user.KycDocument, error)
+
+	// ListKycDocuments returns every document image of the user, submitted or
+	// not, oldest first.
+	ListKycDocuments(ctx context.Context, userID int64) ([]user.KycDocument, error)
+
+	// SubmitKyc stores a pending submission, attaches the documents and moves
+	// the user to the pending KYC status, writing params.OutboxEvent in the
+	// same transaction. It fails with an apperrors.ErrFailedPrecondition error
+	// while the user is pending or approved, and with an apperrors.ErrNotFound
+	// error when a document does not belong to the user or was submitted
+	// before.
+	SubmitKyc(ctx context.Context, params SubmitKycParams) (user.KycSubmission, error)
+
+	// GetKycSubmission returns a submission with its documents and the user it
+	// belongs to.
+	GetKycSubmission(ctx context.Context, submissionID int64) (user.KycSubmission, user.User, error)
+
+	// GetLatestKycSubmission returns the newest submission of the user.
+	GetLatestKycSubmission(ctx context.Context, userID int64) (user.KycSubmission, error)
+
+	// ListKycSubmissions returns submissions without their documents.
+	ListKycSubmissions(ctx context.Context, params ListKycSubmissionsParams) ([]user.KycSubmission, error)
+
+	// ReviewKycSubmission records the decision on a pending submission and
+	// gives the user the same KYC status, writing params.OutboxEvent in the
+	// same transaction. It fails with an apperrors.ErrFailedPrecondition error
+	// when the submission is not pending.
+	ReviewKycSubmission(ctx context.Context, params ReviewKycParams) (user.KycSubmission, error)
+}
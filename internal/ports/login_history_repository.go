@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// RecordLoginParams stores a login. NewLoginEvent is written to the outbox in
+// the same transaction when the login is from a new device.
+type RecordLoginParams struct {
+	Record        user.LoginRecord
+	NewLoginEvent user.OutboxEvent
+}
+
+// ListLoginHistoryParams selects a page of a user's login history, newest
+// first. When BeforeID is set only records with a smaller id are returned.
+type ListLoginHistoryParams struct {
+	UserID   int64
+	BeforeID int64
+	Limit    int
+}
+
+// LoginHistoryRepository stores the logins and logouts of users.
+type LoginHistoryRepository interface {
+	// RecordLogin stores a login record and returns it. The record is marked
+	// NewDevice when the user has logged in before, but never with the same
+	// device fingerprint or never from the same IP; only then is
+	// params.NewLoginEvent written.
+	RecordLogin(ctx context.Context, params RecordLoginParams) (user.LoginRecord, error)
+
+	// RecordLogout stores a logout record.
+	RecordLogout(ctx context.Context, record user.LoginRecord) error
+
+	// ListLoginHistory returns records of the user, newest first.
+	ListLoginHistory(ctx context.Context, params ListLoginHistoryParams) ([]user.LoginRecord, error)
+}
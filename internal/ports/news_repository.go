@@ -0,0 +1,49 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// ListNewsParams selects a page of news, newest published first. Empty
+// fields do not filter. Query is free text; an item matches when every word
+// of it starts a word of the item's title or summary, ignoring case and
+// Vietnamese diacritics. When BeforeID is set only items published before
+// BeforePublishedAt, or at that time with a smaller id, are returned.
+type ListNewsParams struct {
+	Status            user.NewsStatus
+	Category          user.NewsCategory
+	Code              string
+	Query             string
+	BeforePublishedAt time.Time
+	BeforeID          int64
+	Limit             int
+}
+
+// NewsRepository stores the news feed. Related stock codes are resolved
+// against the stocks catalog; writing an item with a code that is not listed
+// fails with an apperrors.ErrNotFound error.
+type NewsRepository interface {
+	// CreateNews stores an item. It fails with an apperrors.ErrConflict error
+	// when another item has the same ExternalID.
+	CreateNews(ctx context.Context, news user.News) (user.News, error)
+
+	// GetNews returns an item by id.
+	GetNews(ctx context.Context, newsID int64) (user.News, error)
+
+	// GetNewsByExternalID returns the item imported under externalID.
+	GetNewsByExternalID(ctx context.Context, externalID string) (user.News, error)
+
+	// UpdateNews replaces the category, texts, source, related codes and
+	// publication time of the item with id news.ID. Its status and
+	// ExternalID are kept.
+	UpdateNews(ctx context.Context, news user.News) (user.News, error)
+
+	// SetNewsStatus publishes or unpublishes an item.
+	SetNewsStatus(ctx context.Context, newsID int64, status user.NewsStatus, at time.Time) (user.News, error)
+
+	// ListNews returns a page of items.
+	ListNews(ctx context.Context, params ListNewsParams) ([]user.News, error)
+}
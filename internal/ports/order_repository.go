@@ -0,0 +1,201 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// OrderCheck vets an order before CreateOrder or AmendOrder stores it. It
+// gets the order as it would be stored, and the trading account and open
+// orders of its owner read under a lock on the owner that withdrawals take
+// too, so checks on what is free of other reservations cannot be raced. An
+// error is returned as it is and nothing is stored.
+type OrderCheck func(ctx context.Context, order user.Order, account user.TradingAccount, open []user.Order) error
+
+// AmendOrderParams changes the price and total quantity of an open order.
+// A nil Check stores the amendment unchecked.
+type AmendOrderParams struct {
+	OrderID  int64
+	Price    int64
+	Quantity int64
+	At       time.Time
+	Check    OrderCheck
+}
+
+// FillPricer prices a fill of quantity shares of order at price, at at. The
+// order repository calls it with the order read under its row lock and
+// under the row lock of its owner, which every priced fill of the owner
+// takes, so the fills of a user are priced one at a time and see the value
+// filled before them. An error is returned as it is and nothing is
+// recorded.
+type FillPricer func(ctx context.Context, order user.Order, price, quantity int64, at time.Time) (user.FeeCharges, error)
+
+// RecordOrderFillParams records an execution of an open order. SettlesOn is
+// the settlement date of the trade, at midnight UTC; the zero time settles
+// it at once. Charges are kept on the fill and taken from the cash of the
+// account at once, whatever the settlement date. A non-nil Pricer prices
+// the fill in place of Charges.
+type RecordOrderFillParams struct {
+	OrderID     int64
+	ExecutionID string
+	Price       int64
+	Quantity    int64
+	Charges     user.FeeCharges
+	Pricer      FillPricer
+	At          time.Time
+	SettlesOn   time.Time
+}
+
+// RecordOrderMatchParams records an execution between a buy and a sell
+// order, such as a match of a call auction. ExecutionID and SettlesOn are
+// kept on the fills of both orders; BuyCharges and SellCharges are what each
+// side is charged. A non-nil Pricer prices both fills in place of them.
+type RecordOrderMatchParams struct {
+	BuyOrderID  int64
+	SellOrderID int64
+	ExecutionID string
+	Price       int64
+	Quantity    int64
+	BuyCharges  user.FeeCharges
+	SellCharges user.FeeCharges
+	Pricer      FillPricer
+	At          time.Time
+	SettlesOn   time.Time
+}
+
+// CloseOrderParams moves an open order to a final status: cancelled,
+// rejected or expired. Reason is kept on the order and its event.
+type CloseOrderParams struct {
+	OrderID int64
+	Status  user.OrderStatus
+	Reason  string
+	At      time.Time
+}
+
+// ListOrdersParams selects a page of the orders of a user, newest first. An
+// empty Statuses lists every status; when BeforeID is set only orders with a
+// smaller id are returned.
+type ListOrdersParams struct {
+	UserID   int64
+	Statuses []user.OrderStatus
+	BeforeID int64
+	Limit    int
+}
+
+// OrderTotals sums the orders a user placed over a period. Value is the sum
+// of price times quantity.
+type OrderTotals struct {
+	Count int
+	Value int64
+}
+
+// ListTradesParams selects the fills of the orders of a user recorded at or
+// after From and before To.
+type ListTradesParams struct {
+	UserID int64
+	From   time.Time
+	To     time.Time
+}
+
+// Trade is a fill with the order it executed.
+type Trade struct {
+	Order user.Order
+	Fill  user.OrderFill
+}
+
+// UnconfirmedTrade is a fill whose confirmation was not sent yet, with the
+// owner of the order so the confirmation can be addressed.
+type UnconfirmedTrade struct {
+	Order user.Order
+	Fill  user.OrderFill
+	Owner user.User
+}
+
+// ConfirmTradeParams marks a fill confirmed. Event, when its EventType is
+// set, is written to the outbox in the same transaction.
+type ConfirmTradeParams struct {
+	FillID int64
+	At     time.Time
+	Event  user.OutboxEvent
+}
+
+// OrderRepository stores orders with the history of their changes. Every
+// write appends an OrderEvent in the same transaction, and changes of open
+// orders are made under a row lock, so the state machine holds with several
+// replicas.
+type OrderRepository interface {
+	// CreateOrder stores a new order on the stock with the order's code and
+	// its placed event once check, if not nil, lets it through. It fails
+	// with a not found error when the code is not listed.
+	CreateOrder(ctx context.Context, order user.Order, check OrderCheck) (user.Order, error)
+
+	GetOrder(ctx context.Context, orderID int64) (user.Order, error)
+
+	// ListOrders returns the orders of a user, newest first.
+	ListOrders(ctx context.Context, params ListOrdersParams) ([]user.Order, error)
+
+	// ListOrderEvents returns the history of an order, oldest first.
+	ListOrderEvents(ctx context.Context, orderID int64) ([]user.OrderEvent, error)
+
+	// AmendOrder applies user.Order.Amended to an open order once
+	// params.Check, if set, lets the amended order through. It fails with
+	// an apperrors.ErrFailedPrecondition error when the order is no longer
+	// open or the new quantity does not exceed the filled quantity.
+	AmendOrder(ctx context.Context, params AmendOrderParams) (user.Order, error)
+
+	// RecordOrderFill stores a fill and applies user.Order.Filled, and posts
+	// the trade to the ledger of the order's owner: a buy pays price times
+	// quantity and receives the shares, a sell the other way round. What the
+	// trade delivers, the shares of a buy or the proceeds of a sale, is
+	// scheduled as a user.Settlement when params.SettlesOn is set. The fee
+	// and tax of the fill are posted as entries of their own. A fill
+	// whose execution id was recorded before for the order changes nothing
+	// and returns the order as it is. It fails with an
+	// apperrors.ErrFailedPrecondition error when the order is not open or
+	// the fill exceeds the remaining quantity.
+	RecordOrderFill(ctx context.Context, params RecordOrderFillParams) (user.Order, error)
+
+	// RecordOrderMatch records the fills of both orders of a match in one
+	// transaction, like RecordOrderFill does for one order. A match whose
+	// execution id was recorded before changes nothing. It fails with an
+	// invalid argument error when the orders are not a buy and a sell order
+	// of the same stock.
+	RecordOrderMatch(ctx context.Context, params RecordOrderMatchParams) (buy user.Order, sell user.Order, err error)
+
+	// ListOpenOrders returns the open orders of the user, or of every user
+	// when userID is zero, by priority: earliest PriorityAt first, then
+	// lowest id.
+	ListOpenOrders(ctx context.Context, userID int64) ([]user.Order, error)
+
+	// OrderTotalsSince sums the orders the user placed at or after since,
+	// whatever their status.
+	OrderTotalsSince(ctx context.Context, userID int64, since time.Time) (OrderTotals, error)
+
+	// FilledValueSince sums price times quantity over the fills of the
+	// user's orders recorded at or after since.
+	FilledValueSince(ctx context.Context, userID int64, since time.Time) (int64, error)
+
+	// CloseOrder moves an open order to params.Status. It fails with an
+	// apperrors.ErrFailedPrecondition error when the order is not open.
+	CloseOrder(ctx context.Context, params CloseOrderParams) (user.Order, error)
+
+	// ExpireOrders expires the open orders with the time in force that were
+	// placed before placedBefore, with reason, and returns how many expired.
+	ExpireOrders(ctx context.Context, tif user.TimeInForce, placedBefore time.Time, reason string, at time.Time) (int, error)
+
+	// ListTrades returns the fills of the user's orders in the period, oldest
+	// first.
+	ListTrades(ctx context.Context, params ListTradesParams) ([]Trade, error)
+
+	// ListUnconfirmedTrades returns up to limit fills that were not confirmed
+	// yet, oldest first.
+	ListUnconfirmedTrades(ctx context.Context, limit int) ([]UnconfirmedTrade, error)
+
+	// ConfirmTrade marks a fill confirmed and writes params.Event. It returns
+	// false, writing nothing, when the fill was confirmed before, so
+	// concurrent senders confirm a trade once. It fails with a not found
+	// error when there is no such fill.
+	ConfirmTrade(ctx context.Context, params ConfirmTradeParams) (bool, error)
+}
@@ -0,0 +1,48 @@
+package ports
+
+import (
+	"context"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// PaymentInstruction asks the gateway to move Amount VND in or out of the
+// bank account of a user. Reference identifies the payment.
+type PaymentInstruction struct {
+	Reference string
+	UserID    int64
+	Kind      user.PaymentKind
+	Amount    int64
+}
+
+// PaymentInitiation is the gateway's answer to an instruction.
+type PaymentInitiation struct {
+	GatewayReference string
+	// PaymentURL is where the user completes a deposit; empty when the
+	// gateway needs no action from the user.
+	PaymentURL string
+}
+
+// PaymentCallback is the outcome of a payment the gateway reports to the
+// webhook.
+type PaymentCallback struct {
+	Reference        string
+	GatewayReference string
+	// Succeeded is true when the money moved.
+	Succeeded bool
+	// Reason explains a failure.
+	Reason    string
+	Signature string
+}
+
+// PaymentGateway moves cash between the banks of users and the broker.
+// Payments complete asynchronously: Initiate submits one and the gateway
+// calls the webhook back with the outcome later.
+type PaymentGateway interface {
+	// Initiate submits a payment. Submitting a reference again returns the
+	// first initiation, so submissions can be retried.
+	Initiate(ctx context.Context, instruction PaymentInstruction) (PaymentInitiation, error)
+
+	// VerifyCallback checks that callback was signed by the gateway.
+	VerifyCallback(callback PaymentCallback) error
+}
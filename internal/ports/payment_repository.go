@@ -0,0 +1,61 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// ListPaymentsParams selects a page of payments, newest first. A zero UserID
+// lists the payments of every user and an empty Status every status; when
+// BeforeID is set only payments with a smaller id are returned.
+type ListPaymentsParams struct {
+	UserID   int64
+	Status   user.PaymentStatus
+	BeforeID int64
+	Limit    int
+}
+
+// UpdatePaymentParams moves a payment to Status, which its current status
+// must allow (see user.PaymentStatus.CanMoveTo). Non-empty GatewayReference,
+// PaymentURL and Reason and a non-zero ReviewerID are stored with it.
+type UpdatePaymentParams struct {
+	PaymentID        int64
+	Status           user.PaymentStatus
+	GatewayReference string
+	PaymentURL       string
+	Reason           string
+	ReviewerID       int64
+	At               time.Time
+}
+
+// PaymentCheck vets a payment before CreatePayment stores it. It gets the
+// trading account and open orders of the owner read under the same lock as
+// an OrderCheck, so a withdrawal and an order cannot both spend the same
+// cash. An error is returned as it is and nothing is stored.
+type PaymentCheck func(ctx context.Context, payment user.Payment, account user.TradingAccount, open []user.Order) error
+
+// PaymentRepository stores the deposits and withdrawals of users. Changes
+// are made under a row lock, so the state machine holds with several
+// replicas.
+type PaymentRepository interface {
+	// CreatePayment stores a new payment. When the user created a payment
+	// with the same idempotency key before, that payment is returned instead
+	// and created is false, without running check.
+	CreatePayment(ctx context.Context, payment user.Payment, check PaymentCheck) (stored user.Payment, created bool, err error)
+
+	GetPayment(ctx context.Context, paymentID int64) (user.Payment, error)
+
+	// FindPayment returns the payment the user created with the idempotency
+	// key. It fails with a not found error when there is none.
+	FindPayment(ctx context.Context, userID int64, idempotencyKey string) (user.Payment, error)
+
+	ListPayments(ctx context.Context, params ListPaymentsParams) ([]user.Payment, error)
+
+	// UpdatePayment applies params to a payment. Moving it to completed
+	// posts user.Payment.LedgerEntry in the same transaction. It fails with
+	// an apperrors.ErrFailedPrecondition error when the payment's status
+	// does not allow the move.
+	UpdatePayment(ctx context.Context, params UpdatePaymentParams) (user.Payment, error)
+}
@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// PortfolioRepository reads the ledger on trade date and keeps the daily
+// snapshots portfolio performance is charted from.
+type PortfolioRepository interface {
+	// ListTradeDateEntries returns the ledger of the user before before on
+	// trade date, oldest first: the ledger entries other than settlement
+	// entries, and the settlements of trades as settlement entries posted
+	// when they were scheduled. Balances summed over them count what trades
+	// deliver from the trade on, whether it settled or not.
+	ListTradeDateEntries(ctx context.Context, userID int64, before time.Time) ([]user.LedgerEntry, error)
+
+	// ListPortfolioOwners returns the ids of the users with ledger entries
+	// before before, ascending.
+	ListPortfolioOwners(ctx context.Context, before time.Time) ([]int64, error)
+
+	// SavePortfolioSnapshots stores snapshots in one transaction, replacing
+	// those of the same user and date.
+	SavePortfolioSnapshots(ctx context.Context, snapshots []user.PortfolioSnapshot) error
+
+	// ListPortfolioSnapshots returns the snapshots of the user dated on or
+	// after from, earliest first.
+	ListPortfolioSnapshots(ctx context.Context, userID int64, from time.Time) ([]user.PortfolioSnapshot, error)
+
+	// LastPortfolioSnapshot returns the latest snapshot of the user. It
+	// fails with a not found error when there is none.
+	LastPortfolioSnapshot(ctx context.Context, userID int64) (user.PortfolioSnapshot, error)
+}
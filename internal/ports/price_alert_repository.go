@@ -0,0 +1,47 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// ActivePriceAlert is an alert waiting to fire, with its owner so the
+// notification can be addressed.
+type ActivePriceAlert struct {
+	Alert user.PriceAlert
+	Owner user.User
+}
+
+// TriggerPriceAlertParams fires an alert at a price. Event is written to the
+// outbox in the same transaction.
+type TriggerPriceAlertParams struct {
+	AlertID int64
+	Price   int64
+	At      time.Time
+	Event   user.OutboxEvent
+}
+
+// PriceAlertRepository stores the price alerts of users.
+type PriceAlertRepository interface {
+	// CreatePriceAlert stores an active alert on the stock with the alert's
+	// code. It fails with a not found error when the code is not listed.
+	CreatePriceAlert(ctx context.Context, alert user.PriceAlert) (user.PriceAlert, error)
+
+	GetPriceAlert(ctx context.Context, alertID int64) (user.PriceAlert, error)
+
+	// ListPriceAlerts returns the alerts of the user, newest first.
+	ListPriceAlerts(ctx context.Context, userID int64) ([]user.PriceAlert, error)
+
+	DeletePriceAlert(ctx context.Context, alertID int64) error
+
+	// ListActivePriceAlerts returns the alerts on the stock that have not
+	// fired yet, oldest first.
+	ListActivePriceAlerts(ctx context.Context, stockID int64) ([]ActivePriceAlert, error)
+
+	// TriggerPriceAlert marks an active alert triggered and writes
+	// params.Event. It returns false, writing nothing, when the alert already
+	// fired or was deleted, so concurrent evaluators notify only once.
+	TriggerPriceAlert(ctx context.Context, params TriggerPriceAlertParams) (bool, error)
+}
@@ -0,0 +1,210 @@
+package repotest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunAuditRepositoryTests exercises every ports.AuditRepository method.
+func RunAuditRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"AppendAuditEvents", testAppendAuditEvents},
+		{"AppendAuditEventsInvalid", testAppendAuditEventsInvalid},
+		{"ListAuditEventsFilters", testListAuditEventsFilters},
+		{"ListAuditEventsPaging", testListAuditEventsPaging},
+		{"AnonymizeUserErasesAuditDetails", testAnonymizeUserErasesAuditDetails},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func testAppendAuditEvents(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	created := mustCreate(t, repos.Users, newSeed("audit0001"))
+
+	require.NoError(t, repos.Audit.AppendAuditEvents(ctx, nil))
+	require.NoError(t, repos.Audit.AppendAuditEvents(ctx, []userentity.AuditEvent{
+		{UserID: created.Id, Action: userentity.AuditActionRegister, Method: "/user.UserService/Register",
+			IP: "203.0.113.7", UserAgent: "curl/8.0", RequestID: "req-1", CreatedAt: now},
+		{UserID: created.Id, ActorID: created.Id, Action: userentity.AuditActionProfileUpdate,
+			Changes: map[string]userentity.AuditChange{
+				"name":  {From: "Old", To: "New"},
+				"cmnd":  {Redacted: true},
+				"email": {To: "new@example.com"},
+			},
+			UserAgent: strings.Repeat("ü", 200), RequestID: "req-1", CreatedAt: now},
+	}))
+
+	events, err := repos.Audit.ListAuditEvents(ctx, ports.ListAuditEventsParams{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	// Newest first.
+	update, register := events[0], events[1]
+	require.Greater(t, update.ID, register.ID)
+
+	require.Equal(t, created.Id, register.UserID)
+	require.Zero(t, register.ActorID)
+	require.Equal(t, userentity.AuditActionRegister, register.Action)
+	require.Equal(t, "/user.UserService/Register", register.Method)
+	require.Equal(t, "203.0.113.7", register.IP)
+	require.Equal(t, "curl/8.0", register.UserAgent)
+	require.Equal(t, "req-1", register.RequestID)
+	require.Empty(t, register.Changes)
+	require.WithinDuration(t, now, register.CreatedAt, time.Second)
+
+	require.Equal(t, created.Id, update.ActorID)
+	require.Equal(t, map[string]userentity.AuditChange{
+		"name":  {From: "Old", To: "New"},
+		"cmnd":  {Redacted: true},
+		"email": {To: "new@example.com"},
+	}, update.Changes)
+	// Over-long metadata is cut on a character boundary.
+	require.LessOrEqual(t, len(update.UserAgent), 255)
+	require.Equal(t, strings.Repeat("ü", len(update.UserAgent)/2), update.UserAgent)
+}
+
+func testAppendAuditEventsInvalid(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("audit0002"))
+
+	err := repos.Audit.AppendAuditEvents(ctx, []userentity.AuditEvent{{Action: userentity.AuditActionLogin}})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	err = repos.Audit.AppendAuditEvents(ctx, []userentity.AuditEvent{{UserID: created.Id}})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+
+	// A batch is stored entirely or not at all.
+	err = repos.Audit.AppendAuditEvents(ctx, []userentity.AuditEvent{
+		{UserID: created.Id, Action: userentity.AuditActionLogin},
+		{UserID: created.Id + 1000, Action: userentity.AuditActionLogin},
+	})
+	requireNotFound(t, err)
+
+	events, err := repos.Audit.ListAuditEvents(ctx, ports.ListAuditEventsParams{})
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func testListAuditEventsFilters(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	first := mustCreate(t, repos.Users, newSeed("audit0003"))
+	second := mustCreate(t, repos.Users, newSeed("audit0004"))
+
+	require.NoError(t, repos.Audit.AppendAuditEvents(ctx, []userentity.AuditEvent{
+		{UserID: first.Id, Action: userentity.AuditActionRegister, RequestID: "req-a", CreatedAt: now.Add(-2 * time.Hour)},
+		{UserID: first.Id, ActorID: first.Id, Action: userentity.AuditActionLogin, RequestID: "req-b", CreatedAt: now.Add(-time.Hour)},
+		{UserID: second.Id, ActorID: first.Id, Action: userentity.AuditActionDeactivate, RequestID: "req-c", CreatedAt: now},
+		{UserID: second.Id, Action: userentity.AuditActionLoginFailed, RequestID: "req-d", CreatedAt: now},
+	}))
+
+	cases := []struct {
+		name   string
+		filter ports.AuditEventFilter
+		want   []string
+	}{
+		{"none", ports.AuditEventFilter{}, []string{"req-d", "req-c", "req-b", "req-a"}},
+		{"user", ports.AuditEventFilter{UserID: second.Id}, []string{"req-d", "req-c"}},
+		{"actor", ports.AuditEventFilter{ActorID: first.Id}, []string{"req-c", "req-b"}},
+		{"actions", ports.AuditEventFilter{Actions: []userentity.AuditAction{
+			userentity.AuditActionLogin, userentity.AuditActionLoginFailed,
+		}}, []string{"req-d", "req-b"}},
+		{"created range", ports.AuditEventFilter{CreatedAfter: now.Add(-time.Hour), CreatedBefore: now}, []string{"req-b"}},
+		{"request id", ports.AuditEventFilter{RequestID: "req-c"}, []string{"req-c"}},
+		{"combined", ports.AuditEventFilter{UserID: first.Id, ActorID: first.Id}, []string{"req-b"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			events, err := repos.Audit.ListAuditEvents(ctx, ports.ListAuditEventsParams{Filter: tc.filter})
+			require.NoError(t, err)
+			got := make([]string, 0, len(events))
+			for _, event := range events {
+				got = append(got, event.RequestID)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func testListAuditEventsPaging(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("audit0005"))
+
+	batch := make([]userentity.AuditEvent, 0, 5)
+	for i := 0; i < 5; i++ {
+		batch = append(batch, userentity.AuditEvent{UserID: created.Id, Action: userentity.AuditActionLogin})
+	}
+	require.NoError(t, repos.Audit.AppendAuditEvents(ctx, batch))
+
+	page, err := repos.Audit.ListAuditEvents(ctx, ports.ListAuditEventsParams{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	next, err := repos.Audit.ListAuditEvents(ctx, ports.ListAuditEventsParams{Limit: 2, BeforeID: page[1].ID})
+	require.NoError(t, err)
+	require.Len(t, next, 2)
+	require.Less(t, next[0].ID, page[1].ID)
+	last, err := repos.Audit.ListAuditEvents(ctx, ports.ListAuditEventsParams{Limit: 2, BeforeID: next[1].ID})
+	require.NoError(t, err)
+	require.Len(t, last, 1)
+}
+
+func testAnonymizeUserErasesAuditDetails(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	created := mustCreate(t, repos.Users, newSeed("audit0006"))
+	kept := mustCreate(t, repos.Users, newSeed("audit0007"))
+
+	event := userentity.AuditEvent{
+		Action:    userentity.AuditActionProfileUpdate,
+		Changes:   map[string]userentity.AuditChange{"name": {From: "Nguyen Van A", To: "Nguyen Van B"}},
+		Method:    "/user.UserService/Update",
+		IP:        "203.0.113.7",
+		UserAgent: "curl/8.0",
+		RequestID: "req-1",
+	}
+	scrubbed, untouched := event, event
+	scrubbed.UserID, untouched.UserID = created.Id, kept.Id
+	require.NoError(t, repos.Audit.AppendAuditEvents(ctx, []userentity.AuditEvent{scrubbed, untouched}))
+
+	scheduleDeletion(t, repos.Users, created.Id, now)
+	_, err := repos.Users.AnonymizeUser(ctx, created.Id, now)
+	require.NoError(t, err)
+
+	events, err := repos.Audit.ListAuditEvents(ctx, ports.ListAuditEventsParams{Filter: ports.AuditEventFilter{UserID: created.Id}})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	// The anonymization itself is appended to the trail.
+	require.Equal(t, userentity.AuditActionAnonymize, events[0].Action)
+	require.Equal(t, int64(0), events[0].ActorID)
+	require.WithinDuration(t, now, events[0].CreatedAt, time.Second)
+	require.Empty(t, events[1].Changes)
+	require.Empty(t, events[1].IP)
+	require.Empty(t, events[1].UserAgent)
+	// What happened and when stays on record.
+	require.Equal(t, userentity.AuditActionProfileUpdate, events[1].Action)
+	require.Equal(t, "/user.UserService/Update", events[1].Method)
+	require.Equal(t, "req-1", events[1].RequestID)
+
+	events, err = repos.Audit.ListAuditEvents(ctx, ports.ListAuditEventsParams{Filter: ports.AuditEventFilter{UserID: kept.Id}})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, event.Changes, events[0].Changes)
+	require.Equal(t, "203.0.113.7", events[0].IP)
+}
@@ -0,0 +1,289 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunCorporateActionRepositoryTests exercises every
+// ports.CorporateActionRepository method.
+func RunCorporateActionRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateAndListCorporateActions", testCreateAndListCorporateActions},
+		{"DeleteCorporateAction", testDeleteCorporateAction},
+		{"ListStockHolders", testListStockHolders},
+		{"ApplyCorporateAction", testApplyCorporateAction},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func newCashDividend(code string, exDate time.Time, perShare int64) userentity.CorporateAction {
+	return userentity.CorporateAction{
+		Code:         code,
+		Kind:         userentity.CorporateActionCashDividend,
+		CashPerShare: perShare,
+		ExDate:       exDate,
+		RecordDate:   exDate.AddDate(0, 0, 1),
+		CreatedBy:    1,
+		CreatedAt:    time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func newStockSplit(code string, exDate time.Time, oldShares, newShares int64) userentity.CorporateAction {
+	return userentity.CorporateAction{
+		Code:       code,
+		Kind:       userentity.CorporateActionSplit,
+		OldShares:  oldShares,
+		NewShares:  newShares,
+		ExDate:     exDate,
+		RecordDate: exDate.AddDate(0, 0, 1),
+		CreatedBy:  1,
+		CreatedAt:  time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func testCreateAndListCorporateActions(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	addStocks(t, repos, "VNM", "FPT")
+	day := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+
+	dividend, err := repos.Actions.CreateCorporateAction(ctx, newCashDividend("VNM", day, 1_500))
+	require.NoError(t, err)
+	require.NotZero(t, dividend.ID)
+	require.NotZero(t, dividend.StockID)
+	require.Equal(t, "VNM", dividend.Code)
+	require.Equal(t, userentity.CorporateActionCashDividend, dividend.Kind)
+	require.Equal(t, int64(1_500), dividend.CashPerShare)
+	require.True(t, day.Equal(dividend.ExDate))
+	require.True(t, day.AddDate(0, 0, 1).Equal(dividend.RecordDate))
+	require.Equal(t, userentity.CorporateActionStatusScheduled, dividend.Status)
+	require.Equal(t, int64(1), dividend.CreatedBy)
+	require.True(t, dividend.AppliedAt.IsZero())
+
+	split, err := repos.Actions.CreateCorporateAction(ctx, newStockSplit("FPT", day.AddDate(0, 0, 7), 1, 2))
+	require.NoError(t, err)
+	later, err := repos.Actions.CreateCorporateAction(ctx, newStockSplit("VNM", day, 100, 120))
+	require.NoError(t, err, "a stock may have actions of different kinds on the same ex-date")
+
+	_, err = repos.Actions.CreateCorporateAction(ctx, newCashDividend("VNM", day, 2_000))
+	requireConflict(t, err)
+	_, err = repos.Actions.CreateCorporateAction(ctx, newCashDividend("XXX", day, 2_000))
+	requireNotFound(t, err)
+	for _, invalid := range []userentity.CorporateAction{
+		newCashDividend("VNM", day.AddDate(0, 0, 1), 0),
+		newStockSplit("VNM", day.AddDate(0, 0, 1), 2, 1),
+		func() userentity.CorporateAction {
+			action := newCashDividend("VNM", day.AddDate(0, 0, 1), 1_000)
+			action.RecordDate = day
+			return action
+		}(),
+	} {
+		_, err = repos.Actions.CreateCorporateAction(ctx, invalid)
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	}
+
+	all, err := repos.Actions.ListCorporateActions(ctx, "")
+	require.NoError(t, err)
+	ids := make([]int64, 0, len(all))
+	for _, action := range all {
+		ids = append(ids, action.ID)
+	}
+	require.Equal(t, []int64{split.ID, later.ID, dividend.ID}, ids, "latest ex-date first")
+	vnm, err := repos.Actions.ListCorporateActions(ctx, "VNM")
+	require.NoError(t, err)
+	require.Len(t, vnm, 2)
+
+	got, err := repos.Actions.GetCorporateAction(ctx, split.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), got.OldShares)
+	require.Equal(t, int64(2), got.NewShares)
+	_, err = repos.Actions.GetCorporateAction(ctx, split.ID+100)
+	requireNotFound(t, err)
+
+	due, err := repos.Actions.ListDueCorporateActions(ctx, day.Add(10*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.Equal(t, dividend.ID, due[0].ID)
+	require.Equal(t, later.ID, due[1].ID)
+	due, err = repos.Actions.ListDueCorporateActions(ctx, day.AddDate(0, 0, -1))
+	require.NoError(t, err)
+	require.Empty(t, due)
+}
+
+func testDeleteCorporateAction(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	addStocks(t, repos, "HPG")
+	day := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+
+	scheduled, err := repos.Actions.CreateCorporateAction(ctx, newCashDividend("HPG", day, 500))
+	require.NoError(t, err)
+	applied, err := repos.Actions.CreateCorporateAction(ctx, newStockSplit("HPG", day, 1, 2))
+	require.NoError(t, err)
+	ok, err := repos.Actions.ApplyCorporateAction(ctx, ports.ApplyCorporateActionParams{
+		ActionID:     applied.ID,
+		Adjustment:   userentity.PriceAdjustment{Numerator: 1, Denominator: 2},
+		PricesBefore: day,
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, repos.Actions.DeleteCorporateAction(ctx, scheduled.ID))
+	requireNotFound(t, repos.Actions.DeleteCorporateAction(ctx, scheduled.ID))
+	err = repos.Actions.DeleteCorporateAction(ctx, applied.ID)
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	all, err := repos.Actions.ListCorporateActions(ctx, "HPG")
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, userentity.CorporateActionStatusApplied, all[0].Status)
+}
+
+func testListStockHolders(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	holder := mustCreate(t, repos.Users, newSeed("action001"))
+	buyer := mustCreate(t, repos.Users, newSeed("action002"))
+	seller := mustCreate(t, repos.Users, newSeed("action003"))
+	late := mustCreate(t, repos.Users, newSeed("action004"))
+	addStocks(t, repos, "MWG", "VIC")
+	at := time.Now().UTC().Truncate(time.Second)
+	settles := time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:action", []userentity.LedgerEntry{
+		{UserID: holder.Id, Code: "MWG", Amount: 300, Type: userentity.LedgerEntryAdjustment, CreatedAt: at.Add(-time.Hour)},
+		{UserID: holder.Id, Code: "VIC", Amount: 50, Type: userentity.LedgerEntryAdjustment, CreatedAt: at.Add(-time.Hour)},
+		{UserID: seller.Id, Code: "MWG", Amount: 200, Type: userentity.LedgerEntryAdjustment, CreatedAt: at.Add(-time.Hour)},
+		{UserID: late.Id, Code: "MWG", Amount: 100, Type: userentity.LedgerEntryAdjustment, CreatedAt: at.Add(time.Hour)},
+	})
+	require.NoError(t, err)
+	buy, err := repos.Orders.CreateOrder(ctx, newOrder(buyer.Id, "MWG", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 200, at), nil)
+	require.NoError(t, err)
+	sell, err := repos.Orders.CreateOrder(ctx, newOrder(seller.Id, "MWG", userentity.OrderSideSell, userentity.TimeInForceGTC, 60000, 200, at), nil)
+	require.NoError(t, err)
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{BuyOrderID: buy.ID, SellOrderID: sell.ID, ExecutionID: "a1", Price: 60000, Quantity: 200, At: at, SettlesOn: settles})
+	require.NoError(t, err)
+
+	stocks, err := repos.Stocks.GetStocksByCodes(ctx, []string{"MWG"})
+	require.NoError(t, err)
+	holders, err := repos.Actions.ListStockHolders(ctx, stocks[0].ID, at.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, holders, 2, "the seller sold out and the late holder came after")
+	require.Equal(t, holder.Id, holders[0].Owner.Id)
+	require.Equal(t, "action001", holders[0].Owner.Username)
+	require.Equal(t, int64(300), holders[0].Shares)
+	require.Equal(t, buyer.Id, holders[1].Owner.Id)
+	require.Equal(t, int64(200), holders[1].Shares, "pending shares are held")
+
+	_, err = repos.Accounts.SettleDue(ctx, settles, at.Add(30*time.Second))
+	require.NoError(t, err)
+	holders, err = repos.Actions.ListStockHolders(ctx, stocks[0].ID, at.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, holders, 2)
+	require.Equal(t, int64(200), holders[1].Shares, "settled shares count once")
+
+	holders, err = repos.Actions.ListStockHolders(ctx, stocks[0].ID, at.Add(-2*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, holders)
+}
+
+func testApplyCorporateAction(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("action005"))
+	addStocks(t, repos, "VNM", "FPT")
+	at := time.Now().UTC().Truncate(time.Second)
+	exDate := at.Add(-time.Minute)
+	repos.RecordStockPrice(t, "VNM", 70001, at.Add(-2*time.Hour))
+	repos.RecordStockPrice(t, "VNM", 65000, at.Add(-time.Hour))
+	repos.RecordStockPrice(t, "VNM", 32600, at)
+	repos.RecordStockPrice(t, "FPT", 120000, at.Add(-time.Hour))
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:action005", []userentity.LedgerEntry{
+		{UserID: owner.Id, Code: "VNM", Amount: 301, Type: userentity.LedgerEntryAdjustment, CreatedAt: at.Add(-time.Hour)},
+	})
+	require.NoError(t, err)
+	action, err := repos.Actions.CreateCorporateAction(ctx, newStockSplit("VNM", exDate, 1, 2))
+	require.NoError(t, err)
+
+	params := ports.ApplyCorporateActionParams{
+		ActionID: action.ID,
+		Entries: []userentity.LedgerEntry{
+			{UserID: owner.Id, Code: "VNM", Amount: 301, Type: userentity.LedgerEntryCorporateAction, Note: "VNM split 1:2"},
+		},
+		Adjustment:   userentity.PriceAdjustment{Numerator: 1, Denominator: 2},
+		PricesBefore: exDate,
+		Events: []userentity.OutboxEvent{{
+			AggregateID:   owner.Id,
+			AggregateType: "user",
+			EventType:     "user.corporate_action.applied",
+			Payload:       []byte(`{"purpose":"corporate_action"}`),
+			Status:        userentity.OutboxEventStatusPending,
+		}},
+		At: at,
+	}
+	foreign := params
+	foreign.Entries = []userentity.LedgerEntry{{UserID: owner.Id, Code: "FPT", Amount: 1, Type: userentity.LedgerEntryCorporateAction}}
+	_, err = repos.Actions.ApplyCorporateAction(ctx, foreign)
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "entries must be in the action's stock")
+
+	ok, err := repos.Actions.ApplyCorporateAction(ctx, params)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = repos.Actions.ApplyCorporateAction(ctx, params)
+	require.NoError(t, err)
+	require.False(t, ok, "an action is applied once")
+	ok, err = repos.Actions.ApplyCorporateAction(ctx, ports.ApplyCorporateActionParams{ActionID: action.ID + 100, Adjustment: params.Adjustment})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	applied, err := repos.Actions.GetCorporateAction(ctx, action.ID)
+	require.NoError(t, err)
+	require.Equal(t, userentity.CorporateActionStatusApplied, applied.Status)
+	require.Equal(t, params.Adjustment, applied.Adjustment)
+	require.True(t, at.Equal(applied.AppliedAt))
+
+	account, err := repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(602), account.Shares("VNM"))
+	entries, err := repos.Accounts.ListLedgerEntries(ctx, ports.ListLedgerEntriesParams{UserID: owner.Id, From: at, To: at.Add(time.Second)})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, userentity.LedgerEntryCorporateAction, entries[0].Type)
+	require.Equal(t, "VNM", entries[0].Code)
+	require.NotEmpty(t, entries[0].Reference)
+
+	quotes, err := repos.Stocks.ListStockQuotesAfter(ctx, 0, 10)
+	require.NoError(t, err)
+	prices := make(map[string][]int64)
+	for _, quote := range quotes {
+		prices[quote.Code] = append(prices[quote.Code], quote.Price)
+	}
+	require.Equal(t, []int64{35001, 32500, 32600}, prices["VNM"], "prices before the ex-date are halved, rounding half up")
+	require.Equal(t, []int64{120000}, prices["FPT"], "other stocks keep their prices")
+	latest, err := repos.Stocks.LatestStockQuotesBefore(ctx, []string{"VNM"}, exDate)
+	require.NoError(t, err)
+	require.Equal(t, int64(32500), latest[0].Price)
+
+	data, err := repos.DataExports.GetPersonalData(ctx, owner.Id)
+	require.NoError(t, err)
+	notices := 0
+	for _, event := range data.OutboxEvents {
+		if event.EventType == "user.corporate_action.applied" {
+			notices++
+		}
+	}
+	require.Equal(t, 1, notices)
+}
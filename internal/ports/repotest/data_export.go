@@ -0,0 +1,230 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunDataExportRepositoryTests exercises every ports.DataExportRepository
+// method.
+func RunDataExportRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateDataExport", testCreateDataExport},
+		{"ClaimAndFinishDataExport", testClaimAndFinishDataExport},
+		{"ClaimStaleDataExport", testClaimStaleDataExport},
+		{"DeleteExpiredDataExports", testDeleteExpiredDataExports},
+		{"GetPersonalData", testGetPersonalData},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func testCreateDataExport(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	created := mustCreate(t, repos.Users, newSeed("export001"))
+
+	export, err := repos.DataExports.CreateDataExport(ctx, created.Id, userentity.DataExportFormatZIP, now)
+	require.NoError(t, err)
+	require.NotZero(t, export.ID)
+	require.Equal(t, created.Id, export.UserID)
+	require.Equal(t, userentity.DataExportFormatZIP, export.Format)
+	require.Equal(t, userentity.DataExportStatusPending, export.Status)
+	require.WithinDuration(t, now, export.CreatedAt, time.Second)
+
+	got, err := repos.DataExports.GetDataExport(ctx, created.Id, export.ID)
+	require.NoError(t, err)
+	require.Equal(t, export.ID, got.ID)
+	require.Empty(t, got.Content)
+
+	// Only one export per user may be in progress.
+	_, err = repos.DataExports.CreateDataExport(ctx, created.Id, userentity.DataExportFormatJSON, now)
+	requireConflict(t, err)
+
+	// Exports are private to their user.
+	other := mustCreate(t, repos.Users, newSeed("export002"))
+	_, err = repos.DataExports.GetDataExport(ctx, other.Id, export.ID)
+	requireNotFound(t, err)
+	_, err = repos.DataExports.GetDataExport(ctx, created.Id, export.ID+1000)
+	requireNotFound(t, err)
+
+	_, err = repos.DataExports.CreateDataExport(ctx, other.Id, userentity.DataExportFormat("pdf"), now)
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	_, err = repos.DataExports.CreateDataExport(ctx, other.Id+1000, userentity.DataExportFormatJSON, now)
+	requireNotFound(t, err)
+}
+
+func testClaimAndFinishDataExport(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	first := mustCreate(t, repos.Users, newSeed("claimer01"))
+	second := mustCreate(t, repos.Users, newSeed("claimer02"))
+
+	_, err := repos.DataExports.ClaimDataExport(ctx, now, now.Add(-time.Hour))
+	requireNotFound(t, err)
+
+	older, err := repos.DataExports.CreateDataExport(ctx, first.Id, userentity.DataExportFormatJSON, now)
+	require.NoError(t, err)
+	newer, err := repos.DataExports.CreateDataExport(ctx, second.Id, userentity.DataExportFormatZIP, now)
+	require.NoError(t, err)
+
+	claimed, err := repos.DataExports.ClaimDataExport(ctx, now, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, older.ID, claimed.ID)
+	require.Equal(t, userentity.DataExportStatusProcessing, claimed.Status)
+	claimed2, err := repos.DataExports.ClaimDataExport(ctx, now, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, newer.ID, claimed2.ID)
+	_, err = repos.DataExports.ClaimDataExport(ctx, now, now.Add(-time.Hour))
+	requireNotFound(t, err)
+
+	content := []byte(`{"profile":{}}`)
+	require.NoError(t, repos.DataExports.FinishDataExport(ctx, ports.FinishDataExportParams{
+		ExportID:  older.ID,
+		Status:    userentity.DataExportStatusReady,
+		Content:   content,
+		At:        now,
+		ExpiresAt: now.Add(time.Hour),
+	}))
+	ready, err := repos.DataExports.GetDataExport(ctx, first.Id, older.ID)
+	require.NoError(t, err)
+	require.Equal(t, userentity.DataExportStatusReady, ready.Status)
+	require.Equal(t, content, ready.Content)
+	require.WithinDuration(t, now, ready.CompletedAt, time.Second)
+	require.WithinDuration(t, now.Add(time.Hour), ready.ExpiresAt, time.Second)
+
+	require.NoError(t, repos.DataExports.FinishDataExport(ctx, ports.FinishDataExportParams{
+		ExportID: newer.ID,
+		Status:   userentity.DataExportStatusFailed,
+		Error:    "boom",
+		At:       now,
+	}))
+	failed, err := repos.DataExports.GetDataExport(ctx, second.Id, newer.ID)
+	require.NoError(t, err)
+	require.Equal(t, userentity.DataExportStatusFailed, failed.Status)
+	require.Equal(t, "boom", failed.Error)
+	require.Empty(t, failed.Content)
+
+	// Finished exports cannot be finished again, and a new one may be queued.
+	err = repos.DataExports.FinishDataExport(ctx, ports.FinishDataExportParams{
+		ExportID: older.ID,
+		Status:   userentity.DataExportStatusFailed,
+		At:       now,
+	})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	err = repos.DataExports.FinishDataExport(ctx, ports.FinishDataExportParams{
+		ExportID: older.ID + 1000,
+		Status:   userentity.DataExportStatusReady,
+		At:       now,
+	})
+	requireNotFound(t, err)
+	err = repos.DataExports.FinishDataExport(ctx, ports.FinishDataExportParams{
+		ExportID: older.ID,
+		Status:   userentity.DataExportStatusPending,
+	})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	_, err = repos.DataExports.CreateDataExport(ctx, first.Id, userentity.DataExportFormatJSON, now)
+	require.NoError(t, err)
+}
+
+func testClaimStaleDataExport(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	created := mustCreate(t, repos.Users, newSeed("stalexp01"))
+
+	export, err := repos.DataExports.CreateDataExport(ctx, created.Id, userentity.DataExportFormatJSON, now)
+	require.NoError(t, err)
+	_, err = repos.DataExports.ClaimDataExport(ctx, now, now.Add(-time.Hour))
+	require.NoError(t, err)
+
+	// A processing export is only taken over once it went stale.
+	_, err = repos.DataExports.ClaimDataExport(ctx, now.Add(time.Minute), now.Add(-time.Minute))
+	requireNotFound(t, err)
+	reclaimed, err := repos.DataExports.ClaimDataExport(ctx, now.Add(2*time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, export.ID, reclaimed.ID)
+	require.Equal(t, userentity.DataExportStatusProcessing, reclaimed.Status)
+}
+
+func testDeleteExpiredDataExports(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := mustCreate(t, repos.Users, newSeed("expired01"))
+	fresh := mustCreate(t, repos.Users, newSeed("freshexp1"))
+	queued := mustCreate(t, repos.Users, newSeed("queuedex1"))
+
+	finish := func(userID int64, expiresAt time.Time) int64 {
+		t.Helper()
+		export, err := repos.DataExports.CreateDataExport(ctx, userID, userentity.DataExportFormatJSON, now)
+		require.NoError(t, err)
+		claimed, err := repos.DataExports.ClaimDataExport(ctx, now, now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, export.ID, claimed.ID)
+		require.NoError(t, repos.DataExports.FinishDataExport(ctx, ports.FinishDataExportParams{
+			ExportID:  export.ID,
+			Status:    userentity.DataExportStatusReady,
+			Content:   []byte("{}"),
+			At:        now,
+			ExpiresAt: expiresAt,
+		}))
+		return export.ID
+	}
+	expiredID := finish(expired.Id, now.Add(-time.Minute))
+	freshID := finish(fresh.Id, now.Add(time.Hour))
+	queuedExport, err := repos.DataExports.CreateDataExport(ctx, queued.Id, userentity.DataExportFormatJSON, now)
+	require.NoError(t, err)
+
+	removed, err := repos.DataExports.DeleteExpiredDataExports(ctx, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, removed)
+
+	_, err = repos.DataExports.GetDataExport(ctx, expired.Id, expiredID)
+	requireNotFound(t, err)
+	_, err = repos.DataExports.GetDataExport(ctx, fresh.Id, freshID)
+	require.NoError(t, err)
+	_, err = repos.DataExports.GetDataExport(ctx, queued.Id, queuedExport.ID)
+	require.NoError(t, err)
+}
+
+func testGetPersonalData(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("personal1")
+	created := mustCreate(t, repos.Users, s)
+	mustCreate(t, repos.Users, newSeed("personal2"))
+
+	data, err := repos.DataExports.GetPersonalData(ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, created.Id, data.User.Id)
+	require.Equal(t, s.Email, data.User.Email)
+	require.Equal(t, "DOC-"+s.Username, data.User.DocumentID)
+	require.Len(t, data.VerificationTokens, 1)
+	require.Equal(t, s.Token, data.VerificationTokens[0].Token)
+	require.Len(t, data.OutboxEvents, 1)
+	require.Equal(t, created.Id, data.OutboxEvents[0].AggregateID)
+	require.Equal(t, "user.verification.register", data.OutboxEvents[0].EventType)
+	require.Contains(t, string(data.OutboxEvents[0].Payload), s.Email)
+
+	// Inactive accounts are still exported.
+	scheduleDeletion(t, repos.Users, created.Id, time.Now().UTC().Add(time.Hour))
+	data, err = repos.DataExports.GetPersonalData(ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, userentity.AccountStatusPendingDeletion, data.User.Status)
+
+	_, err = repos.DataExports.GetPersonalData(ctx, created.Id+1000)
+	requireNotFound(t, err)
+}
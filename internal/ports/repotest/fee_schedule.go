@@ -0,0 +1,177 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// RunFeeScheduleRepositoryTests exercises every ports.FeeScheduleRepository
+// method.
+func RunFeeScheduleRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateAndListFeeSchedules", testCreateAndListFeeSchedules},
+		{"CreateFeeScheduleValidation", testCreateFeeScheduleValidation},
+		{"FeeScheduleAt", testFeeScheduleAt},
+		{"DeleteFeeSchedule", testDeleteFeeSchedule},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func newFeeSchedule(tier string, effectiveFrom time.Time) userentity.FeeSchedule {
+	return userentity.FeeSchedule{
+		Tier:          tier,
+		BuyRateBps:    15,
+		SellRateBps:   15,
+		MinFee:        10_000,
+		MaxFee:        5_000_000,
+		SellTaxBps:    10,
+		EffectiveFrom: effectiveFrom,
+		CreatedBy:     1,
+		CreatedAt:     time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func testCreateAndListFeeSchedules(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	schedule := newFeeSchedule("standard", now.Add(24*time.Hour))
+	schedule.VolumeTiers = []userentity.FeeVolumeTier{
+		{MinMonthlyValue: 1_000_000_000, BuyRateBps: 12, SellRateBps: 12},
+		{MinMonthlyValue: 10_000_000_000, BuyRateBps: 10, SellRateBps: 8},
+	}
+	created, err := repos.Fees.CreateFeeSchedule(ctx, schedule)
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+	earlier, err := repos.Fees.CreateFeeSchedule(ctx, newFeeSchedule("standard", now))
+	require.NoError(t, err)
+	vip, err := repos.Fees.CreateFeeSchedule(ctx, newFeeSchedule("vip", now))
+	require.NoError(t, err)
+
+	_, err = repos.Fees.CreateFeeSchedule(ctx, newFeeSchedule("standard", now))
+	requireConflict(t, err)
+
+	all, err := repos.Fees.ListFeeSchedules(ctx, "")
+	require.NoError(t, err)
+	ids := make([]int64, 0, len(all))
+	for _, schedule := range all {
+		ids = append(ids, schedule.ID)
+	}
+	require.Equal(t, []int64{earlier.ID, created.ID, vip.ID}, ids, "by tier, then effective date")
+
+	standard, err := repos.Fees.ListFeeSchedules(ctx, "standard")
+	require.NoError(t, err)
+	require.Len(t, standard, 2)
+	got := standard[1]
+	require.Equal(t, "standard", got.Tier)
+	require.Equal(t, int64(15), got.BuyRateBps)
+	require.Equal(t, int64(15), got.SellRateBps)
+	require.Equal(t, int64(10_000), got.MinFee)
+	require.Equal(t, int64(5_000_000), got.MaxFee)
+	require.Equal(t, int64(10), got.SellTaxBps)
+	require.Equal(t, schedule.VolumeTiers, got.VolumeTiers)
+	require.True(t, schedule.EffectiveFrom.Equal(got.EffectiveFrom))
+	require.Equal(t, int64(1), got.CreatedBy)
+	require.True(t, schedule.CreatedAt.Equal(got.CreatedAt))
+	require.Empty(t, standard[0].VolumeTiers)
+
+	none, err := repos.Fees.ListFeeSchedules(ctx, "pro")
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func testCreateFeeScheduleValidation(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	invalid := []func(s *userentity.FeeSchedule){
+		func(s *userentity.FeeSchedule) { s.Tier = "" },
+		func(s *userentity.FeeSchedule) { s.EffectiveFrom = time.Time{} },
+		func(s *userentity.FeeSchedule) { s.BuyRateBps = -1 },
+		func(s *userentity.FeeSchedule) { s.SellTaxBps = 10001 },
+		func(s *userentity.FeeSchedule) { s.MaxFee = s.MinFee - 1 },
+		func(s *userentity.FeeSchedule) {
+			s.VolumeTiers = []userentity.FeeVolumeTier{{MinMonthlyValue: 2, BuyRateBps: 1}, {MinMonthlyValue: 1, BuyRateBps: 1}}
+		},
+	}
+	for _, change := range invalid {
+		schedule := newFeeSchedule("standard", now)
+		change(&schedule)
+		_, err := repos.Fees.CreateFeeSchedule(ctx, schedule)
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	}
+}
+
+func testFeeScheduleAt(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	_, err := repos.Fees.FeeScheduleAt(ctx, "standard", now)
+	requireNotFound(t, err)
+
+	first, err := repos.Fees.CreateFeeSchedule(ctx, newFeeSchedule("standard", now.Add(-48*time.Hour)))
+	require.NoError(t, err)
+	second := newFeeSchedule("standard", now.Add(-time.Hour))
+	second.BuyRateBps = 20
+	second, err = repos.Fees.CreateFeeSchedule(ctx, second)
+	require.NoError(t, err)
+	_, err = repos.Fees.CreateFeeSchedule(ctx, newFeeSchedule("standard", now.Add(time.Hour)))
+	require.NoError(t, err)
+
+	got, err := repos.Fees.FeeScheduleAt(ctx, "standard", now)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, got.ID)
+	require.Equal(t, int64(20), got.BuyRateBps)
+
+	got, err = repos.Fees.FeeScheduleAt(ctx, "standard", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, second.ID, got.ID, "in force from its effective time")
+
+	got, err = repos.Fees.FeeScheduleAt(ctx, "standard", now.Add(-2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, first.ID, got.ID)
+
+	_, err = repos.Fees.FeeScheduleAt(ctx, "standard", now.Add(-72*time.Hour))
+	requireNotFound(t, err)
+	_, err = repos.Fees.FeeScheduleAt(ctx, "vip", now)
+	requireNotFound(t, err)
+}
+
+func testDeleteFeeSchedule(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	current, err := repos.Fees.CreateFeeSchedule(ctx, newFeeSchedule("standard", now.Add(-time.Hour)))
+	require.NoError(t, err)
+	planned, err := repos.Fees.CreateFeeSchedule(ctx, newFeeSchedule("standard", now.Add(time.Hour)))
+	require.NoError(t, err)
+
+	err = repos.Fees.DeleteFeeSchedule(ctx, current.ID, now)
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	err = repos.Fees.DeleteFeeSchedule(ctx, planned.ID+1000, now)
+	requireNotFound(t, err)
+
+	require.NoError(t, repos.Fees.DeleteFeeSchedule(ctx, planned.ID, now))
+	err = repos.Fees.DeleteFeeSchedule(ctx, planned.ID, now)
+	requireNotFound(t, err)
+
+	schedules, err := repos.Fees.ListFeeSchedules(ctx, "standard")
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.Equal(t, current.ID, schedules[0].ID)
+}
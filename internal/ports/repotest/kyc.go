@@ -0,0 +1,319 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunKycRepositoryTests exercises every ports.KycRepository method.
+func RunKycRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateKycDocument", testCreateKycDocument},
+		{"SubmitKyc", testSubmitKyc},
+		{"SubmitKycInvalid", testSubmitKycInvalid},
+		{"ReviewKycSubmission", testReviewKycSubmission},
+		{"ListKycSubmissionsPaging", testListKycSubmissionsPaging},
+		{"KycPersonalDataAndAnonymize", testKycPersonalDataAndAnonymize},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func mustCreateKycDocument(t *testing.T, repos Repositories, userID int64, kind userentity.KycDocumentKind) userentity.KycDocument {
+	t.Helper()
+	document, err := repos.Kyc.CreateKycDocument(context.Background(), userentity.KycDocument{
+		UserID:      userID,
+		Kind:        kind,
+		BlobKey:     "kyc/conformance/" + string(kind),
+		ContentType: "image/jpeg",
+		SizeBytes:   1024,
+	})
+	require.NoError(t, err)
+	return document
+}
+
+func kycSubmitParams(userID int64, documentIDs ...int64) ports.SubmitKycParams {
+	return ports.SubmitKycParams{
+		Submission: userentity.KycSubmission{
+			UserID:         userID,
+			DocumentType:   userentity.KycDocumentTypeIDCard,
+			DocumentNumber: "079090001234",
+			FullName:       "Nguyen Van A",
+			IssuedOn:       time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC),
+			ExpiresOn:      time.Date(2036, time.March, 4, 0, 0, 0, 0, time.UTC),
+		},
+		DocumentIDs: documentIDs,
+		OutboxEvent: userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     "user.kyc.status_changed",
+			Payload:       []byte(`{"status":"pending"}`),
+			Status:        userentity.OutboxEventStatusPending,
+		},
+	}
+}
+
+func kycReviewParams(submissionID int64, status userentity.KycStatus, reason string) ports.ReviewKycParams {
+	return ports.ReviewKycParams{
+		SubmissionID: submissionID,
+		ReviewerID:   1,
+		Status:       status,
+		Reason:       reason,
+		At:           time.Now().UTC().Truncate(time.Second),
+		OutboxEvent: userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     "user.kyc.status_changed",
+			Payload:       []byte(`{"status":"` + string(status) + `"}`),
+			Status:        userentity.OutboxEventStatusPending,
+		},
+	}
+}
+
+func kycEvents(t *testing.T, repos Repositories, userID int64) int {
+	t.Helper()
+	data, err := repos.DataExports.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	count := 0
+	for _, event := range data.OutboxEvents {
+		if event.EventType == "user.kyc.status_changed" {
+			count++
+		}
+	}
+	return count
+}
+
+func testCreateKycDocument(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("kycdoc001"))
+
+	front := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindFront)
+	require.NotZero(t, front.ID)
+	require.Zero(t, front.SubmissionID)
+	require.False(t, front.CreatedAt.IsZero())
+	back := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindBack)
+
+	got, err := repos.Kyc.GetKycDocument(ctx, front.ID)
+	require.NoError(t, err)
+	require.Equal(t, created.Id, got.UserID)
+	require.Equal(t, userentity.KycDocumentKindFront, got.Kind)
+	require.Equal(t, "kyc/conformance/front", got.BlobKey)
+	require.Equal(t, "image/jpeg", got.ContentType)
+	require.Equal(t, int64(1024), got.SizeBytes)
+
+	documents, err := repos.Kyc.ListKycDocuments(ctx, created.Id)
+	require.NoError(t, err)
+	require.Len(t, documents, 2)
+	require.Equal(t, front.ID, documents[0].ID)
+	require.Equal(t, back.ID, documents[1].ID)
+
+	_, err = repos.Kyc.GetKycDocument(ctx, back.ID+1000)
+	requireNotFound(t, err)
+	_, err = repos.Kyc.CreateKycDocument(ctx, userentity.KycDocument{UserID: created.Id + 1000, Kind: userentity.KycDocumentKindFront, BlobKey: "k"})
+	requireNotFound(t, err)
+	_, err = repos.Kyc.CreateKycDocument(ctx, userentity.KycDocument{UserID: created.Id, Kind: "palm", BlobKey: "k"})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+}
+
+func testSubmitKyc(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("kycsub001"))
+	user, err := repos.Users.GetUser(ctx, "kycsub001")
+	require.NoError(t, err)
+	require.Equal(t, userentity.KycStatusNone, user.KycStatus)
+
+	front := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindFront)
+	back := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindBack)
+	unsubmitted := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindSelfie)
+
+	submission, err := repos.Kyc.SubmitKyc(ctx, kycSubmitParams(created.Id, front.ID, back.ID))
+	require.NoError(t, err)
+	require.NotZero(t, submission.ID)
+	require.Equal(t, userentity.KycStatusPending, submission.Status)
+	require.Equal(t, "079090001234", submission.DocumentNumber)
+	require.Equal(t, "Nguyen Van A", submission.FullName)
+	sameDay(t, time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC), submission.IssuedOn)
+	require.Len(t, submission.Documents, 2)
+	require.Equal(t, submission.ID, submission.Documents[0].SubmissionID)
+
+	user, err = repos.Users.GetUser(ctx, "kycsub001")
+	require.NoError(t, err)
+	require.Equal(t, userentity.KycStatusPending, user.KycStatus)
+	require.Equal(t, 1, kycEvents(t, repos, created.Id))
+
+	got, owner, err := repos.Kyc.GetKycSubmission(ctx, submission.ID)
+	require.NoError(t, err)
+	require.Equal(t, created.Id, owner.Id)
+	require.Equal(t, "079090001234", got.DocumentNumber)
+	require.Len(t, got.Documents, 2)
+
+	latest, err := repos.Kyc.GetLatestKycSubmission(ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, submission.ID, latest.ID)
+
+	// A pending user cannot submit again, even with fresh documents.
+	_, err = repos.Kyc.SubmitKyc(ctx, kycSubmitParams(created.Id, unsubmitted.ID))
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	require.Equal(t, 1, kycEvents(t, repos, created.Id))
+
+	documents, err := repos.Kyc.ListKycDocuments(ctx, created.Id)
+	require.NoError(t, err)
+	require.Len(t, documents, 3)
+	require.Zero(t, documents[2].SubmissionID)
+}
+
+func testSubmitKycInvalid(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	alice := mustCreate(t, repos.Users, newSeed("kycinv001"))
+	bob := mustCreate(t, repos.Users, newSeed("kycinv002"))
+	bobs := mustCreateKycDocument(t, repos, bob.Id, userentity.KycDocumentKindFront)
+
+	_, err := repos.Kyc.SubmitKyc(ctx, kycSubmitParams(alice.Id))
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+
+	params := kycSubmitParams(alice.Id, bobs.ID)
+	params.Submission.DocumentType = "driving_license"
+	_, err = repos.Kyc.SubmitKyc(ctx, params)
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+
+	_, err = repos.Kyc.SubmitKyc(ctx, kycSubmitParams(alice.Id, bobs.ID))
+	requireNotFound(t, err)
+	_, err = repos.Kyc.SubmitKyc(ctx, kycSubmitParams(alice.Id+1000, bobs.ID))
+	requireNotFound(t, err)
+
+	// Nothing was written by the failed attempts.
+	user, err := repos.Users.GetUser(ctx, "kycinv001")
+	require.NoError(t, err)
+	require.Equal(t, userentity.KycStatusNone, user.KycStatus)
+	_, err = repos.Kyc.GetLatestKycSubmission(ctx, alice.Id)
+	requireNotFound(t, err)
+	got, err := repos.Kyc.GetKycDocument(ctx, bobs.ID)
+	require.NoError(t, err)
+	require.Zero(t, got.SubmissionID)
+	require.Zero(t, kycEvents(t, repos, alice.Id))
+}
+
+func testReviewKycSubmission(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("kycrev001"))
+	front := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindFront)
+	first, err := repos.Kyc.SubmitKyc(ctx, kycSubmitParams(created.Id, front.ID))
+	require.NoError(t, err)
+
+	rejected, err := repos.Kyc.ReviewKycSubmission(ctx, kycReviewParams(first.ID, userentity.KycStatusRejected, "photo is blurred"))
+	require.NoError(t, err)
+	require.Equal(t, userentity.KycStatusRejected, rejected.Status)
+	require.Equal(t, "photo is blurred", rejected.RejectionReason)
+	require.Equal(t, int64(1), rejected.ReviewerID)
+	require.False(t, rejected.ReviewedAt.IsZero())
+	user, err := repos.Users.GetUser(ctx, "kycrev001")
+	require.NoError(t, err)
+	require.Equal(t, userentity.KycStatusRejected, user.KycStatus)
+
+	_, err = repos.Kyc.ReviewKycSubmission(ctx, kycReviewParams(first.ID, userentity.KycStatusApproved, ""))
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	_, err = repos.Kyc.ReviewKycSubmission(ctx, kycReviewParams(first.ID, userentity.KycStatusPending, ""))
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	_, err = repos.Kyc.ReviewKycSubmission(ctx, kycReviewParams(first.ID+1000, userentity.KycStatusApproved, ""))
+	requireNotFound(t, err)
+
+	// Submitted documents cannot be reused; a rejected user submits new ones.
+	_, err = repos.Kyc.SubmitKyc(ctx, kycSubmitParams(created.Id, front.ID))
+	requireNotFound(t, err)
+	retake := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindFront)
+	second, err := repos.Kyc.SubmitKyc(ctx, kycSubmitParams(created.Id, retake.ID))
+	require.NoError(t, err)
+
+	approved, err := repos.Kyc.ReviewKycSubmission(ctx, kycReviewParams(second.ID, userentity.KycStatusApproved, "ignored"))
+	require.NoError(t, err)
+	require.Equal(t, userentity.KycStatusApproved, approved.Status)
+	require.Empty(t, approved.RejectionReason)
+	user, err = repos.Users.GetUser(ctx, "kycrev001")
+	require.NoError(t, err)
+	require.Equal(t, userentity.KycStatusApproved, user.KycStatus)
+	require.Equal(t, 4, kycEvents(t, repos, created.Id))
+
+	latest, err := repos.Kyc.GetLatestKycSubmission(ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, second.ID, latest.ID)
+
+	extra := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindSelfie)
+	_, err = repos.Kyc.SubmitKyc(ctx, kycSubmitParams(created.Id, extra.ID))
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+}
+
+func testListKycSubmissionsPaging(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	var ids []int64
+	for _, username := range []string{"kyclst001", "kyclst002", "kyclst003"} {
+		created := mustCreate(t, repos.Users, newSeed(username))
+		front := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindFront)
+		submission, err := repos.Kyc.SubmitKyc(ctx, kycSubmitParams(created.Id, front.ID))
+		require.NoError(t, err)
+		ids = append(ids, submission.ID)
+	}
+	_, err := repos.Kyc.ReviewKycSubmission(ctx, kycReviewParams(ids[1], userentity.KycStatusApproved, ""))
+	require.NoError(t, err)
+
+	page, err := repos.Kyc.ListKycSubmissions(ctx, ports.ListKycSubmissionsParams{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, ids[0], page[0].ID)
+	require.Equal(t, ids[1], page[1].ID)
+	require.Equal(t, "079090001234", page[0].DocumentNumber)
+
+	page, err = repos.Kyc.ListKycSubmissions(ctx, ports.ListKycSubmissionsParams{AfterID: ids[1], Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, ids[2], page[0].ID)
+
+	pending, err := repos.Kyc.ListKycSubmissions(ctx, ports.ListKycSubmissionsParams{Status: userentity.KycStatusPending})
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, ids[0], pending[0].ID)
+	require.Equal(t, ids[2], pending[1].ID)
+}
+
+func testKycPersonalDataAndAnonymize(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("kycanon01"))
+	front := mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindFront)
+	mustCreateKycDocument(t, repos, created.Id, userentity.KycDocumentKindSelfie)
+	submission, err := repos.Kyc.SubmitKyc(ctx, kycSubmitParams(created.Id, front.ID))
+	require.NoError(t, err)
+
+	data, err := repos.DataExports.GetPersonalData(ctx, created.Id)
+	require.NoError(t, err)
+	require.Len(t, data.KycSubmissions, 1)
+	require.Equal(t, submission.ID, data.KycSubmissions[0].ID)
+	require.Equal(t, "079090001234", data.KycSubmissions[0].DocumentNumber)
+	require.Len(t, data.KycSubmissions[0].Documents, 1)
+	require.Equal(t, front.ID, data.KycSubmissions[0].Documents[0].ID)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	scheduleDeletion(t, repos.Users, created.Id, now.Add(-time.Minute))
+	_, err = repos.Users.AnonymizeUser(ctx, created.Id, now)
+	require.NoError(t, err)
+
+	documents, err := repos.Kyc.ListKycDocuments(ctx, created.Id)
+	require.NoError(t, err)
+	require.Empty(t, documents)
+	got, _, err := repos.Kyc.GetKycSubmission(ctx, submission.ID)
+	require.NoError(t, err)
+	require.Empty(t, got.DocumentNumber)
+	require.Empty(t, got.FullName)
+	require.Empty(t, got.Documents)
+}
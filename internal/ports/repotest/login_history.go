@@ -0,0 +1,195 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunLoginHistoryRepositoryTests exercises every ports.LoginHistoryRepository
+// method.
+func RunLoginHistoryRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"RecordLoginNewDevice", testRecordLoginNewDevice},
+		{"RecordLoginInvalid", testRecordLoginInvalid},
+		{"RecordLogout", testRecordLogout},
+		{"ListLoginHistoryPaging", testListLoginHistoryPaging},
+		{"LoginHistoryPersonalData", testLoginHistoryPersonalData},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func loginParams(userID int64, ip, fingerprint string) ports.RecordLoginParams {
+	return ports.RecordLoginParams{
+		Record: userentity.LoginRecord{
+			UserID:            userID,
+			IP:                ip,
+			UserAgent:         "Mozilla/5.0",
+			DeviceFingerprint: fingerprint,
+		},
+		NewLoginEvent: userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     "user.security.new_login",
+			Payload:       []byte(`{"ip":"` + ip + `"}`),
+			Status:        userentity.OutboxEventStatusPending,
+		},
+	}
+}
+
+func newLoginEvents(t *testing.T, repos Repositories, userID int64) []userentity.OutboxEvent {
+	t.Helper()
+	data, err := repos.DataExports.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	var events []userentity.OutboxEvent
+	for _, event := range data.OutboxEvents {
+		if event.EventType == "user.security.new_login" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func testRecordLoginNewDevice(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("logins001"))
+
+	steps := []struct {
+		name        string
+		ip, device  string
+		wantNew     bool
+		wantAlerted int
+	}{
+		{"first login is not alerted", "203.0.113.1", "device-a", false, 0},
+		{"known device and ip", "203.0.113.1", "device-a", false, 0},
+		{"new ip", "203.0.113.2", "device-a", true, 1},
+		{"new device", "203.0.113.1", "device-b", true, 2},
+		{"both seen before, separately", "203.0.113.2", "device-b", false, 2},
+	}
+	for _, step := range steps {
+		record, err := repos.Logins.RecordLogin(ctx, loginParams(created.Id, step.ip, step.device))
+		require.NoError(t, err, step.name)
+		require.NotZero(t, record.ID, step.name)
+		require.Equal(t, userentity.LoginRecordTypeLogin, record.Type, step.name)
+		require.Equal(t, step.wantNew, record.NewDevice, step.name)
+		require.Len(t, newLoginEvents(t, repos, created.Id), step.wantAlerted, step.name)
+	}
+	require.Contains(t, string(newLoginEvents(t, repos, created.Id)[0].Payload), "203.0.113.2")
+
+	// Devices are tracked per user.
+	other := mustCreate(t, repos.Users, newSeed("logins002"))
+	_, err := repos.Logins.RecordLogin(ctx, loginParams(other.Id, "198.51.100.1", "device-c"))
+	require.NoError(t, err)
+	record, err := repos.Logins.RecordLogin(ctx, loginParams(other.Id, "203.0.113.1", "device-a"))
+	require.NoError(t, err)
+	require.True(t, record.NewDevice)
+}
+
+func testRecordLoginInvalid(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("logins003"))
+
+	_, err := repos.Logins.RecordLogin(ctx, loginParams(0, "203.0.113.1", "device-a"))
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	_, err = repos.Logins.RecordLogin(ctx, loginParams(created.Id+1000, "203.0.113.1", "device-a"))
+	requireNotFound(t, err)
+	err = repos.Logins.RecordLogout(ctx, userentity.LoginRecord{UserID: created.Id + 1000})
+	requireNotFound(t, err)
+}
+
+func testRecordLogout(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("logins004"))
+
+	_, err := repos.Logins.RecordLogin(ctx, loginParams(created.Id, "203.0.113.1", "device-a"))
+	require.NoError(t, err)
+	require.NoError(t, repos.Logins.RecordLogout(ctx, userentity.LoginRecord{
+		UserID: created.Id, IP: "203.0.113.9", UserAgent: "curl/8.0", DeviceFingerprint: "device-z",
+	}))
+
+	// Logouts are not logins: the device stays unknown.
+	record, err := repos.Logins.RecordLogin(ctx, loginParams(created.Id, "203.0.113.9", "device-z"))
+	require.NoError(t, err)
+	require.True(t, record.NewDevice)
+
+	history, err := repos.Logins.ListLoginHistory(ctx, ports.ListLoginHistoryParams{UserID: created.Id})
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	logout := history[1]
+	require.Equal(t, userentity.LoginRecordTypeLogout, logout.Type)
+	require.Equal(t, "203.0.113.9", logout.IP)
+	require.Equal(t, "curl/8.0", logout.UserAgent)
+	require.Equal(t, "device-z", logout.DeviceFingerprint)
+	require.False(t, logout.NewDevice)
+	require.False(t, logout.CreatedAt.IsZero())
+}
+
+func testListLoginHistoryPaging(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("logins005"))
+	other := mustCreate(t, repos.Users, newSeed("logins006"))
+
+	for i := 0; i < 5; i++ {
+		_, err := repos.Logins.RecordLogin(ctx, loginParams(created.Id, "203.0.113.1", "device-a"))
+		require.NoError(t, err)
+	}
+	_, err := repos.Logins.RecordLogin(ctx, loginParams(other.Id, "203.0.113.1", "device-a"))
+	require.NoError(t, err)
+
+	page, err := repos.Logins.ListLoginHistory(ctx, ports.ListLoginHistoryParams{UserID: created.Id, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Greater(t, page[0].ID, page[1].ID)
+	next, err := repos.Logins.ListLoginHistory(ctx, ports.ListLoginHistoryParams{UserID: created.Id, Limit: 2, BeforeID: page[1].ID})
+	require.NoError(t, err)
+	require.Len(t, next, 2)
+	last, err := repos.Logins.ListLoginHistory(ctx, ports.ListLoginHistoryParams{UserID: created.Id, Limit: 2, BeforeID: next[1].ID})
+	require.NoError(t, err)
+	require.Len(t, last, 1)
+	for _, record := range append(append(page, next...), last...) {
+		require.Equal(t, created.Id, record.UserID)
+	}
+}
+
+func testLoginHistoryPersonalData(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	created := mustCreate(t, repos.Users, newSeed("logins007"))
+
+	_, err := repos.Logins.RecordLogin(ctx, loginParams(created.Id, "203.0.113.1", "device-a"))
+	require.NoError(t, err)
+	require.NoError(t, repos.Logins.RecordLogout(ctx, userentity.LoginRecord{UserID: created.Id, IP: "203.0.113.1"}))
+
+	data, err := repos.DataExports.GetPersonalData(ctx, created.Id)
+	require.NoError(t, err)
+	require.Len(t, data.LoginHistory, 2)
+	require.Equal(t, userentity.LoginRecordTypeLogin, data.LoginHistory[0].Type)
+	require.Equal(t, userentity.LoginRecordTypeLogout, data.LoginHistory[1].Type)
+
+	// Anonymization keeps when the account was used but not from where.
+	scheduleDeletion(t, repos.Users, created.Id, now)
+	_, err = repos.Users.AnonymizeUser(ctx, created.Id, now)
+	require.NoError(t, err)
+	history, err := repos.Logins.ListLoginHistory(ctx, ports.ListLoginHistoryParams{UserID: created.Id})
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	for _, record := range history {
+		require.Empty(t, record.IP)
+		require.Empty(t, record.UserAgent)
+		require.Empty(t, record.DeviceFingerprint)
+	}
+}
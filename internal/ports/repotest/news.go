@@ -0,0 +1,259 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunNewsRepositoryTests exercises every ports.NewsRepository method.
+func RunNewsRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateAndGetNews", testCreateAndGetNews},
+		{"UpdateNews", testUpdateNews},
+		{"SetNewsStatus", testSetNewsStatus},
+		{"ListNewsFilters", testListNewsFilters},
+		{"ListNewsPages", testListNewsPages},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func seedNewsStocks(t *testing.T, repos Repositories, codes ...string) {
+	t.Helper()
+	for _, code := range codes {
+		repos.AddStock(t, userentity.Stock{Code: code, Name: code, CompanyName: code + " JSC"})
+	}
+}
+
+func newsItem(title string, publishedAt time.Time) userentity.News {
+	return userentity.News{
+		Category:    userentity.NewsCategoryMarket,
+		Title:       title,
+		PublishedAt: publishedAt,
+	}
+}
+
+func testCreateAndGetNews(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	seedNewsStocks(t, repos, "VCB", "ACB")
+	publishedAt := time.Now().UTC().Truncate(time.Second)
+
+	item := newsItem("Banks lead the rally", publishedAt)
+	item.ExternalID = "feed:1"
+	item.Summary = "Lenders gained the most."
+	item.Content = "Full story."
+	item.Source = "Feed"
+	item.URL = "https://example.com/1"
+	item.Codes = []string{"VCB", "ACB", "VCB"}
+	created, err := repos.News.CreateNews(ctx, item)
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+	require.Equal(t, []string{"ACB", "VCB"}, created.Codes)
+	require.Equal(t, userentity.NewsStatusPublished, created.Status)
+	require.True(t, publishedAt.Equal(created.PublishedAt))
+	require.False(t, created.CreatedAt.IsZero())
+
+	got, err := repos.News.GetNews(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, "feed:1", got.ExternalID)
+	require.Equal(t, "Lenders gained the most.", got.Summary)
+	require.Equal(t, "Full story.", got.Content)
+	require.Equal(t, "Feed", got.Source)
+	require.Equal(t, "https://example.com/1", got.URL)
+	require.Equal(t, []string{"ACB", "VCB"}, got.Codes)
+
+	byExternal, err := repos.News.GetNewsByExternalID(ctx, "feed:1")
+	require.NoError(t, err)
+	require.Equal(t, created.ID, byExternal.ID)
+
+	_, err = repos.News.CreateNews(ctx, item)
+	requireConflict(t, err)
+
+	unknown := newsItem("Unknown stock", publishedAt)
+	unknown.Codes = []string{"ZZZ"}
+	_, err = repos.News.CreateNews(ctx, unknown)
+	requireNotFound(t, err)
+
+	// Items without an external id never collide.
+	_, err = repos.News.CreateNews(ctx, newsItem("Written by an editor", publishedAt))
+	require.NoError(t, err)
+	_, err = repos.News.CreateNews(ctx, newsItem("Written by an editor", publishedAt))
+	require.NoError(t, err)
+
+	_, err = repos.News.GetNews(ctx, created.ID+1000)
+	requireNotFound(t, err)
+	_, err = repos.News.GetNewsByExternalID(ctx, "feed:missing")
+	requireNotFound(t, err)
+}
+
+func testUpdateNews(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	seedNewsStocks(t, repos, "VCB", "HPG")
+	publishedAt := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+
+	item := newsItem("Steel output falls", publishedAt)
+	item.ExternalID = "feed:steel"
+	item.Codes = []string{"VCB"}
+	created, err := repos.News.CreateNews(ctx, item)
+	require.NoError(t, err)
+	_, err = repos.News.SetNewsStatus(ctx, created.ID, userentity.NewsStatusUnpublished, time.Now().UTC())
+	require.NoError(t, err)
+
+	change := created
+	change.Category = userentity.NewsCategoryCompany
+	change.Title = "Steel output recovers"
+	change.Summary = "Mills restart."
+	change.Codes = []string{"HPG"}
+	change.PublishedAt = publishedAt.Add(time.Minute)
+	change.Status = userentity.NewsStatusPublished
+	change.ExternalID = "feed:other"
+	updated, err := repos.News.UpdateNews(ctx, change)
+	require.NoError(t, err)
+	require.Equal(t, userentity.NewsCategoryCompany, updated.Category)
+	require.Equal(t, "Steel output recovers", updated.Title)
+	require.Equal(t, "Mills restart.", updated.Summary)
+	require.Equal(t, []string{"HPG"}, updated.Codes)
+	require.True(t, change.PublishedAt.Equal(updated.PublishedAt))
+	require.Equal(t, userentity.NewsStatusUnpublished, updated.Status, "status is kept")
+	require.Equal(t, "feed:steel", updated.ExternalID, "external id is kept")
+
+	// The search words follow the new texts.
+	found, err := repos.News.ListNews(ctx, ports.ListNewsParams{Query: "recovers"})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	found, err = repos.News.ListNews(ctx, ports.ListNewsParams{Query: "falls"})
+	require.NoError(t, err)
+	require.Empty(t, found)
+
+	change.Codes = []string{"ZZZ"}
+	_, err = repos.News.UpdateNews(ctx, change)
+	requireNotFound(t, err)
+	change.ID = created.ID + 1000
+	change.Codes = nil
+	_, err = repos.News.UpdateNews(ctx, change)
+	requireNotFound(t, err)
+}
+
+func testSetNewsStatus(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created, err := repos.News.CreateNews(ctx, newsItem("Market closes higher", time.Now().UTC().Truncate(time.Second)))
+	require.NoError(t, err)
+
+	unpublished, err := repos.News.SetNewsStatus(ctx, created.ID, userentity.NewsStatusUnpublished, time.Now().UTC())
+	require.NoError(t, err)
+	require.Equal(t, userentity.NewsStatusUnpublished, unpublished.Status)
+
+	again, err := repos.News.SetNewsStatus(ctx, created.ID, userentity.NewsStatusUnpublished, time.Now().UTC())
+	require.NoError(t, err)
+	require.Equal(t, userentity.NewsStatusUnpublished, again.Status)
+
+	published, err := repos.News.SetNewsStatus(ctx, created.ID, userentity.NewsStatusPublished, time.Now().UTC())
+	require.NoError(t, err)
+	require.Equal(t, userentity.NewsStatusPublished, published.Status)
+
+	_, err = repos.News.SetNewsStatus(ctx, created.ID+1000, userentity.NewsStatusPublished, time.Now().UTC())
+	requireNotFound(t, err)
+}
+
+func testListNewsFilters(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	seedNewsStocks(t, repos, "VCB", "HPG")
+	base := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+
+	create := func(item userentity.News) userentity.News {
+		t.Helper()
+		created, err := repos.News.CreateNews(ctx, item)
+		require.NoError(t, err)
+		return created
+	}
+	banks := newsItem("Ngân hàng tăng trưởng tín dụng", base)
+	banks.Summary = "Vietcombank dẫn đầu"
+	banks.Codes = []string{"VCB"}
+	banks = create(banks)
+
+	steel := newsItem("Steel output falls", base.Add(time.Minute))
+	steel.Category = userentity.NewsCategoryCompany
+	steel.Codes = []string{"HPG"}
+	steel = create(steel)
+
+	hidden := newsItem("Ngân hàng hidden draft", base.Add(2*time.Minute))
+	hidden.Codes = []string{"VCB"}
+	hidden = create(hidden)
+	_, err := repos.News.SetNewsStatus(ctx, hidden.ID, userentity.NewsStatusUnpublished, time.Now().UTC())
+	require.NoError(t, err)
+
+	ids := func(params ports.ListNewsParams) []int64 {
+		t.Helper()
+		items, err := repos.News.ListNews(ctx, params)
+		require.NoError(t, err)
+		out := make([]int64, 0, len(items))
+		for _, item := range items {
+			out = append(out, item.ID)
+		}
+		return out
+	}
+	published := userentity.NewsStatusPublished
+
+	require.Equal(t, []int64{hidden.ID, steel.ID, banks.ID}, ids(ports.ListNewsParams{}))
+	require.Equal(t, []int64{steel.ID, banks.ID}, ids(ports.ListNewsParams{Status: published}))
+	require.Equal(t, []int64{steel.ID}, ids(ports.ListNewsParams{Status: published, Category: userentity.NewsCategoryCompany}))
+	require.Equal(t, []int64{banks.ID}, ids(ports.ListNewsParams{Status: published, Code: "VCB"}))
+	require.Empty(t, ids(ports.ListNewsParams{Status: published, Code: "ZZZ"}))
+
+	// Search ignores case and diacritics and matches word prefixes; every
+	// word must match.
+	require.Equal(t, []int64{banks.ID}, ids(ports.ListNewsParams{Status: published, Query: "ngan hang"}))
+	require.Equal(t, []int64{banks.ID}, ids(ports.ListNewsParams{Status: published, Query: "TĂNG tin"}))
+	require.Equal(t, []int64{banks.ID}, ids(ports.ListNewsParams{Status: published, Query: "vietcom"}))
+	require.Equal(t, []int64{hidden.ID, banks.ID}, ids(ports.ListNewsParams{Query: "ngân"}))
+	require.Empty(t, ids(ports.ListNewsParams{Status: published, Query: "ngan steel"}))
+
+	items, err := repos.News.ListNews(ctx, ports.ListNewsParams{Code: "HPG"})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, []string{"HPG"}, items[0].Codes)
+}
+
+func testListNewsPages(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	at := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+
+	// Three items share a publication time; ties are ordered by id.
+	var created []userentity.News
+	for i, offset := range []time.Duration{0, time.Minute, time.Minute, time.Minute, 2 * time.Minute} {
+		item, err := repos.News.CreateNews(ctx, newsItem("Item "+string(rune('A'+i)), at.Add(offset)))
+		require.NoError(t, err)
+		created = append(created, item)
+	}
+	want := []int64{created[4].ID, created[3].ID, created[2].ID, created[1].ID, created[0].ID}
+
+	var got []int64
+	params := ports.ListNewsParams{Limit: 2}
+	for {
+		page, err := repos.News.ListNews(ctx, params)
+		require.NoError(t, err)
+		for _, item := range page {
+			got = append(got, item.ID)
+		}
+		if len(page) < params.Limit {
+			break
+		}
+		last := page[len(page)-1]
+		params.BeforePublishedAt, params.BeforeID = last.PublishedAt, last.ID
+	}
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,951 @@
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunOrderRepositoryTests exercises every ports.OrderRepository method.
+func RunOrderRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateAndGetOrder", testCreateAndGetOrder},
+		{"AmendOrder", testAmendOrder},
+		{"RecordOrderFill", testRecordOrderFill},
+		{"CloseOrder", testCloseOrder},
+		{"ListOrders", testListOrders},
+		{"ExpireOrders", testExpireOrders},
+		{"RecordOrderMatch", testRecordOrderMatch},
+		{"ListOpenOrders", testListOpenOrders},
+		{"OrderTotalsSince", testOrderTotalsSince},
+		{"RecordOrderFillCharges", testRecordOrderFillCharges},
+		{"FilledValueSince", testFilledValueSince},
+		{"ListTrades", testListTrades},
+		{"ConfirmTrade", testConfirmTrade},
+		{"RecordOrderFillConcurrent", testRecordOrderFillConcurrent},
+		{"OrderCheck", testOrderCheck},
+		{"CreateOrderCheckConcurrent", testCreateOrderCheckConcurrent},
+		{"FillPricer", testFillPricer},
+		{"FillPricerConcurrent", testFillPricerConcurrent},
+		{"ChangeAccountStatusCancelsOrders", testChangeAccountStatusCancelsOrders},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func newOrder(userID int64, code string, side userentity.OrderSide, tif userentity.TimeInForce, price, quantity int64, at time.Time) userentity.Order {
+	return userentity.Order{
+		UserID:      userID,
+		Code:        code,
+		Side:        side,
+		TimeInForce: tif,
+		Price:       price,
+		Quantity:    quantity,
+		CreatedAt:   at,
+	}
+}
+
+func orderEventTypes(t *testing.T, repos Repositories, orderID int64) []userentity.OrderEventType {
+	t.Helper()
+	events, err := repos.Orders.ListOrderEvents(context.Background(), orderID)
+	require.NoError(t, err)
+	types := make([]userentity.OrderEventType, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func testCreateAndGetOrder(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders001"))
+	vnm := repos.AddStock(t, userentity.Stock{Code: "VNM", Name: "Vinamilk", CompanyName: "Vietnam Dairy Products JSC"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	created, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 75000, 300, at), nil)
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+	require.Equal(t, vnm.ID, created.StockID)
+	require.Equal(t, "VNM", created.Code)
+	require.Equal(t, userentity.OrderStatusNew, created.Status)
+	require.Zero(t, created.FilledQuantity)
+	require.True(t, created.PriorityAt.Equal(at))
+	require.True(t, created.CreatedAt.Equal(at))
+
+	got, err := repos.Orders.GetOrder(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, got.ID)
+	require.Equal(t, userentity.OrderSideBuy, got.Side)
+	require.Equal(t, userentity.TimeInForceGTC, got.TimeInForce)
+	require.Equal(t, int64(75000), got.Price)
+	require.Equal(t, int64(300), got.Quantity)
+
+	events, err := repos.Orders.ListOrderEvents(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, userentity.OrderEventPlaced, events[0].Type)
+	require.Equal(t, userentity.OrderStatusNew, events[0].Status)
+	require.Equal(t, int64(75000), events[0].Price)
+	require.Equal(t, int64(300), events[0].Quantity)
+
+	_, err = repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "XXX", userentity.OrderSideBuy, userentity.TimeInForceGTC, 1000, 100, at), nil)
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+	_, err = repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 0, 100, at), nil)
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	_, err = repos.Orders.GetOrder(ctx, created.ID+1000)
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+	_, err = repos.Orders.ListOrderEvents(ctx, created.ID+1000)
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+}
+
+func testAmendOrder(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders002"))
+	repos.AddStock(t, userentity.Stock{Code: "FPT", Name: "FPT", CompanyName: "FPT Corporation"})
+	placed := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "FPT", userentity.OrderSideSell, userentity.TimeInForceGTC, 120000, 500, placed), nil)
+	require.NoError(t, err)
+
+	// Lowering the quantity keeps the order's place in the queue.
+	first := placed.Add(time.Minute)
+	amended, err := repos.Orders.AmendOrder(ctx, ports.AmendOrderParams{OrderID: order.ID, Price: 120000, Quantity: 400, At: first})
+	require.NoError(t, err)
+	require.Equal(t, int64(400), amended.Quantity)
+	require.True(t, amended.PriorityAt.Equal(placed))
+
+	// Raising it or changing the price sends it to the back.
+	second := placed.Add(2 * time.Minute)
+	amended, err = repos.Orders.AmendOrder(ctx, ports.AmendOrderParams{OrderID: order.ID, Price: 119000, Quantity: 400, At: second})
+	require.NoError(t, err)
+	require.Equal(t, int64(119000), amended.Price)
+	require.True(t, amended.PriorityAt.Equal(second))
+
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "x1", Price: 119000, Quantity: 100, At: second})
+	require.NoError(t, err)
+	_, err = repos.Orders.AmendOrder(ctx, ports.AmendOrderParams{OrderID: order.ID, Price: 119000, Quantity: 100, At: second})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	events, err := repos.Orders.ListOrderEvents(ctx, order.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, userentity.OrderEventAmended, events[2].Type)
+	require.Equal(t, int64(119000), events[2].Price)
+	require.Equal(t, int64(400), events[2].Quantity)
+
+	_, err = repos.Orders.CloseOrder(ctx, ports.CloseOrderParams{OrderID: order.ID, Status: userentity.OrderStatusCancelled, At: second})
+	require.NoError(t, err)
+	_, err = repos.Orders.AmendOrder(ctx, ports.AmendOrderParams{OrderID: order.ID, Price: 119000, Quantity: 300, At: second})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	_, err = repos.Orders.AmendOrder(ctx, ports.AmendOrderParams{OrderID: order.ID + 1000, Price: 1000, Quantity: 100})
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+}
+
+func testRecordOrderFill(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders003"))
+	repos.AddStock(t, userentity.Stock{Code: "HPG", Name: "Hoa Phat", CompanyName: "Hoa Phat Group"})
+	at := time.Now().UTC().Truncate(time.Second)
+	order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "HPG", userentity.OrderSideBuy, userentity.TimeInForceGTC, 28000, 300, at), nil)
+	require.NoError(t, err)
+
+	filled, err := repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "e1", Price: 27900, Quantity: 100, At: at})
+	require.NoError(t, err)
+	require.Equal(t, userentity.OrderStatusPartiallyFilled, filled.Status)
+	require.Equal(t, int64(100), filled.FilledQuantity)
+	require.Equal(t, int64(2790000), filled.FilledValue)
+
+	// The same execution reported again changes nothing.
+	again, err := repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "e1", Price: 27900, Quantity: 100, At: at})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), again.FilledQuantity)
+
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "e2", Price: 28000, Quantity: 300, At: at})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "fill exceeds the remaining quantity")
+
+	filled, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "e2", Price: 28000, Quantity: 200, At: at})
+	require.NoError(t, err)
+	require.Equal(t, userentity.OrderStatusFilled, filled.Status)
+	require.Equal(t, int64(300), filled.FilledQuantity)
+	require.Equal(t, int64(27966), filled.AveragePrice())
+
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "e3", Price: 28000, Quantity: 1, At: at})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "filled orders are closed")
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "", Price: 28000, Quantity: 1, At: at})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+
+	require.Equal(t,
+		[]userentity.OrderEventType{userentity.OrderEventPlaced, userentity.OrderEventFilled, userentity.OrderEventFilled},
+		orderEventTypes(t, repos, order.ID))
+	events, err := repos.Orders.ListOrderEvents(ctx, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, userentity.OrderStatusFilled, events[2].Status)
+	require.Equal(t, int64(28000), events[2].Price)
+	require.Equal(t, int64(200), events[2].Quantity)
+}
+
+func testCloseOrder(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders004"))
+	repos.AddStock(t, userentity.Stock{Code: "VCB", Name: "Vietcombank", CompanyName: "Joint Stock Commercial Bank for Foreign Trade of Vietnam"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	for _, status := range []userentity.OrderStatus{userentity.OrderStatusCancelled, userentity.OrderStatusRejected, userentity.OrderStatusExpired} {
+		order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "VCB", userentity.OrderSideBuy, userentity.TimeInForceGTC, 90000, 100, at), nil)
+		require.NoError(t, err)
+		closed, err := repos.Orders.CloseOrder(ctx, ports.CloseOrderParams{OrderID: order.ID, Status: status, Reason: "because", At: at})
+		require.NoError(t, err)
+		require.Equal(t, status, closed.Status)
+		require.Equal(t, "because", closed.Reason)
+
+		events, err := repos.Orders.ListOrderEvents(ctx, order.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, status, events[1].Status)
+		require.Equal(t, "because", events[1].Reason)
+
+		_, err = repos.Orders.CloseOrder(ctx, ports.CloseOrderParams{OrderID: order.ID, Status: userentity.OrderStatusCancelled, At: at})
+		require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	}
+
+	order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "VCB", userentity.OrderSideBuy, userentity.TimeInForceGTC, 90000, 100, at), nil)
+	require.NoError(t, err)
+	_, err = repos.Orders.CloseOrder(ctx, ports.CloseOrderParams{OrderID: order.ID, Status: userentity.OrderStatusFilled, At: at})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "orders are only filled by fills")
+	_, err = repos.Orders.CloseOrder(ctx, ports.CloseOrderParams{OrderID: order.ID + 1000, Status: userentity.OrderStatusCancelled, At: at})
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+}
+
+func testListOrders(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders005"))
+	other := mustCreate(t, repos.Users, newSeed("orders006"))
+	repos.AddStock(t, userentity.Stock{Code: "VNM", Name: "Vinamilk", CompanyName: "Vietnam Dairy Products JSC"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	ids := make([]int64, 0, 5)
+	for i := 0; i < 5; i++ {
+		order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 70000+int64(i), 100, at), nil)
+		require.NoError(t, err)
+		ids = append(ids, order.ID)
+	}
+	_, err := repos.Orders.CreateOrder(ctx, newOrder(other.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 70000, 100, at), nil)
+	require.NoError(t, err)
+	_, err = repos.Orders.CloseOrder(ctx, ports.CloseOrderParams{OrderID: ids[1], Status: userentity.OrderStatusCancelled, At: at})
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: ids[3], ExecutionID: "p1", Price: 70003, Quantity: 50, At: at})
+	require.NoError(t, err)
+
+	idsOf := func(orders []userentity.Order) []int64 {
+		out := make([]int64, 0, len(orders))
+		for _, order := range orders {
+			out = append(out, order.ID)
+		}
+		return out
+	}
+
+	all, err := repos.Orders.ListOrders(ctx, ports.ListOrdersParams{UserID: owner.Id})
+	require.NoError(t, err)
+	require.Equal(t, []int64{ids[4], ids[3], ids[2], ids[1], ids[0]}, idsOf(all))
+
+	open, err := repos.Orders.ListOrders(ctx, ports.ListOrdersParams{
+		UserID:   owner.Id,
+		Statuses: []userentity.OrderStatus{userentity.OrderStatusNew, userentity.OrderStatusPartiallyFilled},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{ids[4], ids[3], ids[2], ids[0]}, idsOf(open))
+
+	cancelled, err := repos.Orders.ListOrders(ctx, ports.ListOrdersParams{UserID: owner.Id, Statuses: []userentity.OrderStatus{userentity.OrderStatusCancelled}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{ids[1]}, idsOf(cancelled))
+
+	page, err := repos.Orders.ListOrders(ctx, ports.ListOrdersParams{UserID: owner.Id, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{ids[4], ids[3]}, idsOf(page))
+	page, err = repos.Orders.ListOrders(ctx, ports.ListOrdersParams{UserID: owner.Id, BeforeID: ids[3], Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int64{ids[2], ids[1]}, idsOf(page))
+}
+
+func testExpireOrders(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders007"))
+	repos.AddStock(t, userentity.Stock{Code: "MWG", Name: "Mobile World", CompanyName: "Mobile World Investment Corporation"})
+	sessionEnd := time.Now().UTC().Truncate(time.Second)
+	before := sessionEnd.Add(-time.Hour)
+
+	create := func(tif userentity.TimeInForce, at time.Time) userentity.Order {
+		order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "MWG", userentity.OrderSideSell, tif, 45000, 200, at), nil)
+		require.NoError(t, err)
+		return order
+	}
+	dayOpen := create(userentity.TimeInForceDAY, before)
+	dayPartial := create(userentity.TimeInForceDAY, before)
+	_, err := repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: dayPartial.ID, ExecutionID: "d1", Price: 45000, Quantity: 100, At: before})
+	require.NoError(t, err)
+	dayCancelled := create(userentity.TimeInForceDAY, before)
+	_, err = repos.Orders.CloseOrder(ctx, ports.CloseOrderParams{OrderID: dayCancelled.ID, Status: userentity.OrderStatusCancelled, At: before})
+	require.NoError(t, err)
+	dayLater := create(userentity.TimeInForceDAY, sessionEnd.Add(time.Minute))
+	gtc := create(userentity.TimeInForceGTC, before)
+
+	count, err := repos.Orders.ExpireOrders(ctx, userentity.TimeInForceDAY, sessionEnd, "session ended", sessionEnd)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	for id, want := range map[int64]userentity.OrderStatus{
+		dayOpen.ID:      userentity.OrderStatusExpired,
+		dayPartial.ID:   userentity.OrderStatusExpired,
+		dayCancelled.ID: userentity.OrderStatusCancelled,
+		dayLater.ID:     userentity.OrderStatusNew,
+		gtc.ID:          userentity.OrderStatusNew,
+	} {
+		got, err := repos.Orders.GetOrder(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, want, got.Status, fmt.Sprintf("order %d", id))
+	}
+	expired, err := repos.Orders.GetOrder(ctx, dayPartial.ID)
+	require.NoError(t, err)
+	require.Equal(t, "session ended", expired.Reason)
+	require.Equal(t, int64(100), expired.FilledQuantity)
+	require.Equal(t,
+		[]userentity.OrderEventType{userentity.OrderEventPlaced, userentity.OrderEventFilled, userentity.OrderEventExpired},
+		orderEventTypes(t, repos, dayPartial.ID))
+
+	count, err = repos.Orders.ExpireOrders(ctx, userentity.TimeInForceDAY, sessionEnd, "session ended", sessionEnd)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func testRecordOrderMatch(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	buyer := mustCreate(t, repos.Users, newSeed("orders009"))
+	seller := mustCreate(t, repos.Users, newSeed("orders010"))
+	repos.AddStock(t, userentity.Stock{Code: "VCB", Name: "Vietcombank", CompanyName: "Joint Stock Commercial Bank for Foreign Trade of Vietnam"})
+	repos.AddStock(t, userentity.Stock{Code: "TCB", Name: "Techcombank", CompanyName: "Vietnam Technological and Commercial Joint Stock Bank"})
+	at := time.Now().UTC().Truncate(time.Second)
+	buy, err := repos.Orders.CreateOrder(ctx, newOrder(buyer.Id, "VCB", userentity.OrderSideBuy, userentity.TimeInForceDAY, 92000, 300, at), nil)
+	require.NoError(t, err)
+	sell, err := repos.Orders.CreateOrder(ctx, newOrder(seller.Id, "VCB", userentity.OrderSideSell, userentity.TimeInForceDAY, 91000, 100, at), nil)
+	require.NoError(t, err)
+	other, err := repos.Orders.CreateOrder(ctx, newOrder(seller.Id, "TCB", userentity.OrderSideSell, userentity.TimeInForceDAY, 25000, 100, at), nil)
+	require.NoError(t, err)
+
+	match := ports.RecordOrderMatchParams{
+		BuyOrderID: buy.ID, SellOrderID: sell.ID, ExecutionID: "ato-1", Price: 91500, Quantity: 100, At: at,
+		BuyCharges:  userentity.FeeCharges{Fee: 13725},
+		SellCharges: userentity.FeeCharges{Fee: 13725, Tax: 9150},
+	}
+	gotBuy, gotSell, err := repos.Orders.RecordOrderMatch(ctx, match)
+	require.NoError(t, err)
+	require.Equal(t, userentity.OrderStatusPartiallyFilled, gotBuy.Status)
+	require.Equal(t, int64(100), gotBuy.FilledQuantity)
+	require.Equal(t, int64(13725), gotBuy.Fees)
+	require.Zero(t, gotBuy.Taxes)
+	require.Equal(t, userentity.OrderStatusFilled, gotSell.Status)
+	require.Equal(t, int64(9150000), gotSell.FilledValue)
+	require.Equal(t, int64(13725), gotSell.Fees)
+	require.Equal(t, int64(9150), gotSell.Taxes)
+
+	// The same match recorded again changes nothing.
+	gotBuy, gotSell, err = repos.Orders.RecordOrderMatch(ctx, match)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), gotBuy.FilledQuantity)
+	require.Equal(t, int64(100), gotSell.FilledQuantity)
+
+	match.ExecutionID = "ato-2"
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, match)
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "the sell order is filled")
+	got, err := repos.Orders.GetOrder(ctx, buy.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), got.FilledQuantity, "a failed match fills neither order")
+
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{BuyOrderID: buy.ID, SellOrderID: other.ID, ExecutionID: "x", Price: 25000, Quantity: 100, At: at})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "orders of different stocks")
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{BuyOrderID: sell.ID, SellOrderID: buy.ID, ExecutionID: "x", Price: 91500, Quantity: 1, At: at})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "sides swapped")
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{BuyOrderID: buy.ID, SellOrderID: sell.ID + 1000, ExecutionID: "x", Price: 91500, Quantity: 1, At: at})
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+
+	require.Equal(t,
+		[]userentity.OrderEventType{userentity.OrderEventPlaced, userentity.OrderEventFilled},
+		orderEventTypes(t, repos, sell.ID))
+}
+
+func testListOpenOrders(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	alice := mustCreate(t, repos.Users, newSeed("orders011"))
+	bob := mustCreate(t, repos.Users, newSeed("orders012"))
+	repos.AddStock(t, userentity.Stock{Code: "MSN", Name: "Masan", CompanyName: "Masan Group Corporation"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	first, err := repos.Orders.CreateOrder(ctx, newOrder(alice.Id, "MSN", userentity.OrderSideBuy, userentity.TimeInForceGTC, 70000, 100, at), nil)
+	require.NoError(t, err)
+	second, err := repos.Orders.CreateOrder(ctx, newOrder(bob.Id, "MSN", userentity.OrderSideSell, userentity.TimeInForceDAY, 71000, 100, at.Add(time.Second)), nil)
+	require.NoError(t, err)
+	filled, err := repos.Orders.CreateOrder(ctx, newOrder(bob.Id, "MSN", userentity.OrderSideSell, userentity.TimeInForceDAY, 71000, 100, at), nil)
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: filled.ID, ExecutionID: "f1", Price: 71000, Quantity: 100, At: at})
+	require.NoError(t, err)
+	third, err := repos.Orders.CreateOrder(ctx, newOrder(bob.Id, "MSN", userentity.OrderSideBuy, userentity.TimeInForceDAY, 69000, 100, at), nil)
+	require.NoError(t, err)
+	// A new price sends the first order to the back of the queue.
+	_, err = repos.Orders.AmendOrder(ctx, ports.AmendOrderParams{OrderID: first.ID, Price: 70500, Quantity: 100, At: at.Add(2 * time.Second)})
+	require.NoError(t, err)
+
+	open, err := repos.Orders.ListOpenOrders(ctx, 0)
+	require.NoError(t, err)
+	ids := make([]int64, 0, len(open))
+	for _, order := range open {
+		ids = append(ids, order.ID)
+	}
+	require.Equal(t, []int64{third.ID, second.ID, first.ID}, ids)
+
+	open, err = repos.Orders.ListOpenOrders(ctx, alice.Id)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	require.Equal(t, first.ID, open[0].ID)
+}
+
+func testOrderTotalsSince(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders013"))
+	repos.AddStock(t, userentity.Stock{Code: "GAS", Name: "PV Gas", CompanyName: "PetroVietnam Gas JSC"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	_, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "GAS", userentity.OrderSideBuy, userentity.TimeInForceDAY, 70000, 100, at.Add(-time.Hour)), nil)
+	require.NoError(t, err)
+	cancelled, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "GAS", userentity.OrderSideBuy, userentity.TimeInForceDAY, 71000, 200, at), nil)
+	require.NoError(t, err)
+	_, err = repos.Orders.CloseOrder(ctx, ports.CloseOrderParams{OrderID: cancelled.ID, Status: userentity.OrderStatusCancelled, Reason: "test", At: at})
+	require.NoError(t, err)
+	_, err = repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "GAS", userentity.OrderSideSell, userentity.TimeInForceDAY, 72000, 100, at.Add(time.Minute)), nil)
+	require.NoError(t, err)
+
+	totals, err := repos.Orders.OrderTotalsSince(ctx, owner.Id, at)
+	require.NoError(t, err)
+	require.Equal(t, ports.OrderTotals{Count: 2, Value: 71000*200 + 72000*100}, totals, "cancelled orders count")
+
+	totals, err = repos.Orders.OrderTotalsSince(ctx, owner.Id+1000, at)
+	require.NoError(t, err)
+	require.Zero(t, totals)
+}
+
+func testRecordOrderFillCharges(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders017"))
+	repos.AddStock(t, userentity.Stock{Code: "VIC", Name: "Vingroup", CompanyName: "Vingroup Joint Stock Company"})
+	at := time.Now().UTC().Truncate(time.Second)
+	order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "VIC", userentity.OrderSideSell, userentity.TimeInForceGTC, 42000, 200, at), nil)
+	require.NoError(t, err)
+
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "c0", Price: 42000, Quantity: 100, Charges: userentity.FeeCharges{Fee: -1}, At: at})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "negative fee")
+
+	filled, err := repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{
+		OrderID: order.ID, ExecutionID: "c1", Price: 42000, Quantity: 100, Charges: userentity.FeeCharges{Fee: 6300, Tax: 4200}, At: at,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(6300), filled.Fees)
+	require.Equal(t, int64(4200), filled.Taxes)
+	filled, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{
+		OrderID: order.ID, ExecutionID: "c2", Price: 42000, Quantity: 100, Charges: userentity.FeeCharges{Fee: 6300, Tax: 4200}, At: at,
+	})
+	require.NoError(t, err)
+	got, err := repos.Orders.GetOrder(ctx, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, filled.Fees, got.Fees)
+	require.Equal(t, int64(8400), got.Taxes)
+
+	events, err := repos.Orders.ListOrderEvents(ctx, order.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, int64(6300), events[1].Fee)
+	require.Equal(t, int64(4200), events[1].Tax)
+	require.Zero(t, events[0].Fee, "placements are not charged")
+
+	trades, err := repos.Orders.ListTrades(ctx, ports.ListTradesParams{UserID: owner.Id, From: at, To: at.Add(time.Second)})
+	require.NoError(t, err)
+	require.Len(t, trades, 2)
+	require.Equal(t, int64(6300), trades[0].Fill.Fee)
+	require.Equal(t, int64(4200), trades[0].Fill.Tax)
+
+	// Charges are taken from cash as entries of their own.
+	entries, err := repos.Accounts.ListLedgerEntries(ctx, ports.ListLedgerEntriesParams{UserID: owner.Id, From: at, To: at.Add(time.Second)})
+	require.NoError(t, err)
+	charged := map[userentity.LedgerEntryType]int64{}
+	for _, entry := range entries {
+		if entry.Cash() {
+			charged[entry.Type] += entry.Amount
+		}
+	}
+	require.Equal(t, int64(2*42000*100), charged[userentity.LedgerEntryTrade])
+	require.Equal(t, int64(-12600), charged[userentity.LedgerEntryFee])
+	require.Equal(t, int64(-8400), charged[userentity.LedgerEntryTax])
+	account, err := repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(2*42000*100-12600-8400), account.Cash)
+}
+
+func testFilledValueSince(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders018"))
+	other := mustCreate(t, repos.Users, newSeed("orders019"))
+	repos.AddStock(t, userentity.Stock{Code: "VHM", Name: "Vinhomes", CompanyName: "Vinhomes Joint Stock Company"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	fill := func(userID int64, execution string, price, quantity int64, at time.Time) {
+		t.Helper()
+		order, err := repos.Orders.CreateOrder(ctx, newOrder(userID, "VHM", userentity.OrderSideBuy, userentity.TimeInForceGTC, price, quantity, at), nil)
+		require.NoError(t, err)
+		_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: execution, Price: price, Quantity: quantity, At: at})
+		require.NoError(t, err)
+	}
+	fill(owner.Id, "v1", 40000, 100, at.Add(-time.Hour))
+	fill(owner.Id, "v2", 41000, 200, at)
+	fill(owner.Id, "v3", 42000, 100, at.Add(time.Minute))
+	fill(other.Id, "v4", 41000, 500, at)
+
+	value, err := repos.Orders.FilledValueSince(ctx, owner.Id, at)
+	require.NoError(t, err)
+	require.Equal(t, int64(41000*200+42000*100), value)
+
+	value, err = repos.Orders.FilledValueSince(ctx, owner.Id+1000, at)
+	require.NoError(t, err)
+	require.Zero(t, value)
+}
+
+func testListTrades(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders014"))
+	other := mustCreate(t, repos.Users, newSeed("orders015"))
+	repos.AddStock(t, userentity.Stock{Code: "MBB", Name: "MB Bank", CompanyName: "Military Commercial Joint Stock Bank"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	buy, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "MBB", userentity.OrderSideBuy, userentity.TimeInForceGTC, 25000, 300, at.Add(-2*time.Hour)), nil)
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "t1", Price: 24900, Quantity: 100, At: at.Add(-2 * time.Hour)})
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "t2", Price: 25000, Quantity: 100, At: at.Add(-time.Hour)})
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "t3", Price: 25000, Quantity: 100, At: at})
+	require.NoError(t, err)
+	theirs, err := repos.Orders.CreateOrder(ctx, newOrder(other.Id, "MBB", userentity.OrderSideBuy, userentity.TimeInForceGTC, 25000, 100, at.Add(-time.Hour)), nil)
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: theirs.ID, ExecutionID: "t4", Price: 25000, Quantity: 100, At: at.Add(-time.Hour)})
+	require.NoError(t, err)
+
+	trades, err := repos.Orders.ListTrades(ctx, ports.ListTradesParams{UserID: owner.Id, From: at.Add(-2 * time.Hour), To: at})
+	require.NoError(t, err)
+	require.Len(t, trades, 2, "the period includes From and excludes To")
+	require.Equal(t, "t1", trades[0].Fill.ExecutionID, "oldest first")
+	require.Equal(t, "t2", trades[1].Fill.ExecutionID)
+	require.Equal(t, buy.ID, trades[0].Order.ID)
+	require.Equal(t, "MBB", trades[0].Order.Code)
+	require.Equal(t, userentity.OrderSideBuy, trades[0].Order.Side)
+	require.NotZero(t, trades[0].Fill.ID)
+	require.Equal(t, buy.ID, trades[0].Fill.OrderID)
+	require.Equal(t, int64(24900), trades[0].Fill.Price)
+	require.Equal(t, int64(100), trades[0].Fill.Quantity)
+	require.Zero(t, trades[0].Fill.Fee)
+	require.True(t, at.Add(-2*time.Hour).Equal(trades[0].Fill.CreatedAt))
+
+	trades, err = repos.Orders.ListTrades(ctx, ports.ListTradesParams{UserID: owner.Id, From: at.Add(time.Second), To: at.Add(time.Hour)})
+	require.NoError(t, err)
+	require.Empty(t, trades)
+}
+
+func testConfirmTrade(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders016"))
+	repos.AddStock(t, userentity.Stock{Code: "TCB", Name: "Techcombank", CompanyName: "Vietnam Technological and Commercial Joint Stock Bank"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "TCB", userentity.OrderSideSell, userentity.TimeInForceGTC, 35000, 200, at), nil)
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "c1", Price: 35000, Quantity: 100, At: at})
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "c2", Price: 35100, Quantity: 100, At: at})
+	require.NoError(t, err)
+
+	pending, err := repos.Orders.ListUnconfirmedTrades(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, "c1", pending[0].Fill.ExecutionID, "oldest first")
+	require.Equal(t, order.ID, pending[0].Order.ID)
+	require.Equal(t, owner.Id, pending[0].Owner.Id)
+	require.Equal(t, owner.Email, pending[0].Owner.Email)
+
+	limited, err := repos.Orders.ListUnconfirmedTrades(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+
+	event := userentity.OutboxEvent{
+		AggregateType: "user",
+		EventType:     "user.trade.confirmed",
+		Payload:       []byte(`{"purpose":"trade_confirmation"}`),
+		Status:        userentity.OutboxEventStatusPending,
+	}
+	confirmed, err := repos.Orders.ConfirmTrade(ctx, ports.ConfirmTradeParams{FillID: pending[0].Fill.ID, At: at, Event: event})
+	require.NoError(t, err)
+	require.True(t, confirmed)
+	confirmed, err = repos.Orders.ConfirmTrade(ctx, ports.ConfirmTradeParams{FillID: pending[0].Fill.ID, At: at, Event: event})
+	require.NoError(t, err)
+	require.False(t, confirmed, "a trade is confirmed once")
+	confirmed, err = repos.Orders.ConfirmTrade(ctx, ports.ConfirmTradeParams{FillID: pending[1].Fill.ID, At: at})
+	require.NoError(t, err)
+	require.True(t, confirmed, "confirming without an event")
+
+	_, err = repos.Orders.ConfirmTrade(ctx, ports.ConfirmTradeParams{FillID: pending[1].Fill.ID + 1000, At: at, Event: event})
+	requireNotFound(t, err)
+
+	pending, err = repos.Orders.ListUnconfirmedTrades(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	data, err := repos.DataExports.GetPersonalData(ctx, owner.Id)
+	require.NoError(t, err)
+	var events []userentity.OutboxEvent
+	for _, event := range data.OutboxEvents {
+		if event.EventType == "user.trade.confirmed" {
+			events = append(events, event)
+		}
+	}
+	require.Len(t, events, 1)
+	require.Equal(t, owner.Id, events[0].AggregateID)
+}
+
+// testRecordOrderFillConcurrent races fills for the whole remaining quantity:
+// exactly one may be recorded.
+func testRecordOrderFillConcurrent(t *testing.T, repos Repositories) {
+	if repos.SkipConcurrency {
+		t.Skip("stand-in database does not isolate transactions")
+	}
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders008"))
+	repos.AddStock(t, userentity.Stock{Code: "SSI", Name: "SSI", CompanyName: "SSI Securities Corporation"})
+	at := time.Now().UTC().Truncate(time.Second)
+	order, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "SSI", userentity.OrderSideBuy, userentity.TimeInForceGTC, 30000, 100, at), nil)
+	require.NoError(t, err)
+
+	const writers = 5
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{
+				OrderID:     order.ID,
+				ExecutionID: fmt.Sprintf("race-%d", i),
+				Price:       30000,
+				Quantity:    100,
+				At:          at,
+			})
+			if err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+				return
+			}
+			require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, 1, succeeded)
+
+	got, err := repos.Orders.GetOrder(ctx, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), got.FilledQuantity)
+	require.Equal(t, userentity.OrderStatusFilled, got.Status)
+}
+
+// errNoBuyingPower is the rejection of buyingPowerCheck.
+var errNoBuyingPower = apperrors.New(apperrors.ErrFailedPrecondition, "NO_BUYING_POWER", "the order is above the free cash")
+
+// buyingPowerCheck lets buy orders through while the cash not reserved by
+// the other open buy orders pays for them.
+func buyingPowerCheck(_ context.Context, order userentity.Order, account userentity.TradingAccount, open []userentity.Order) error {
+	free := account.Cash - account.Withheld
+	for _, other := range open {
+		if other.ID != order.ID && other.Side == userentity.OrderSideBuy {
+			free -= other.Remaining() * other.Price
+		}
+	}
+	if order.Remaining()*order.Price > free {
+		return errNoBuyingPower
+	}
+	return nil
+}
+
+func testOrderCheck(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders009"))
+	repos.AddStock(t, userentity.Stock{Code: "PNJ", Name: "PNJ", CompanyName: "Phu Nhuan Jewelry JSC"})
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:orders009", []userentity.LedgerEntry{{UserID: owner.Id, Amount: 6_000_000, Type: userentity.LedgerEntryAdjustment}})
+	require.NoError(t, err)
+	at := time.Now().UTC().Truncate(time.Second)
+
+	var (
+		checked userentity.Order
+		account userentity.TradingAccount
+		open    []userentity.Order
+	)
+	recording := func(ctx context.Context, o userentity.Order, a userentity.TradingAccount, oo []userentity.Order) error {
+		checked, account, open = o, a, oo
+		return buyingPowerCheck(ctx, o, a, oo)
+	}
+	first, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "PNJ", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 100, at), recording)
+	require.NoError(t, err)
+	require.Zero(t, checked.ID)
+	require.Equal(t, int64(100), checked.Quantity)
+	require.Equal(t, int64(6_000_000), account.Cash)
+	require.Empty(t, open)
+
+	_, err = repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "PNJ", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 100, at), recording)
+	require.ErrorIs(t, err, errNoBuyingPower)
+	require.Len(t, open, 1)
+	stored, err := repos.Orders.ListOpenOrders(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Len(t, stored, 1, "a rejected order is not stored")
+
+	_, err = repos.Orders.AmendOrder(ctx, ports.AmendOrderParams{OrderID: first.ID, Price: 60000, Quantity: 200, At: at, Check: recording})
+	require.ErrorIs(t, err, errNoBuyingPower)
+	require.Equal(t, first.ID, checked.ID)
+	require.Equal(t, int64(200), checked.Quantity)
+	got, err := repos.Orders.GetOrder(ctx, first.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), got.Quantity, "a rejected amendment is not applied")
+	require.Equal(t, []userentity.OrderEventType{userentity.OrderEventPlaced}, orderEventTypes(t, repos, first.ID))
+
+	amended, err := repos.Orders.AmendOrder(ctx, ports.AmendOrderParams{OrderID: first.ID, Price: 50000, Quantity: 100, At: at, Check: recording})
+	require.NoError(t, err)
+	require.Equal(t, int64(50000), amended.Price)
+	require.Equal(t, int64(50000), checked.Price)
+}
+
+func testCreateOrderCheckConcurrent(t *testing.T, repos Repositories) {
+	skipIfSerial(t, repos)
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders010"))
+	repos.AddStock(t, userentity.Stock{Code: "DGC", Name: "DGC", CompanyName: "Duc Giang Chemicals JSC"})
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:orders010", []userentity.LedgerEntry{{UserID: owner.Id, Amount: 10_000_000, Type: userentity.LedgerEntryAdjustment}})
+	require.NoError(t, err)
+	at := time.Now().UTC().Truncate(time.Second)
+
+	// The cash pays for one of the orders only. The check is slow so that
+	// checks not run one at a time would overlap.
+	slowCheck := func(ctx context.Context, order userentity.Order, account userentity.TradingAccount, open []userentity.Order) error {
+		time.Sleep(20 * time.Millisecond)
+		return buyingPowerCheck(ctx, order, account, open)
+	}
+	const writers = 5
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "DGC", userentity.OrderSideBuy, userentity.TimeInForceGTC, 90000, 100, at), slowCheck)
+			if err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+				return
+			}
+			require.ErrorIs(t, err, errNoBuyingPower)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, succeeded)
+
+	open, err := repos.Orders.ListOpenOrders(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+}
+
+// errPricing is the rejection of failingPricer.
+var errPricing = apperrors.New(apperrors.ErrAborted, "PRICING_FAILED", "the fill could not be priced")
+
+func failingPricer(context.Context, userentity.Order, int64, int64, time.Time) (userentity.FeeCharges, error) {
+	return userentity.FeeCharges{}, errPricing
+}
+
+func testFillPricer(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	buyer := mustCreate(t, repos.Users, newSeed("orders011"))
+	seller := mustCreate(t, repos.Users, newSeed("orders012"))
+	repos.AddStock(t, userentity.Stock{Code: "MWG", Name: "MWG", CompanyName: "Mobile World Investment Corp"})
+	at := time.Now().UTC().Truncate(time.Second)
+	buy, err := repos.Orders.CreateOrder(ctx, newOrder(buyer.Id, "MWG", userentity.OrderSideBuy, userentity.TimeInForceGTC, 50000, 300, at), nil)
+	require.NoError(t, err)
+	sell, err := repos.Orders.CreateOrder(ctx, newOrder(seller.Id, "MWG", userentity.OrderSideSell, userentity.TimeInForceGTC, 50000, 300, at), nil)
+	require.NoError(t, err)
+
+	// The pricer gets the order as it is stored and prices what it is told.
+	var priced []userentity.Order
+	pricer := func(_ context.Context, order userentity.Order, price, quantity int64, _ time.Time) (userentity.FeeCharges, error) {
+		priced = append(priced, order)
+		charges := userentity.FeeCharges{Fee: price * quantity / 1000}
+		if order.Side == userentity.OrderSideSell {
+			charges.Tax = price * quantity / 2000
+		}
+		return charges, nil
+	}
+	filled, err := repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{
+		OrderID: buy.ID, ExecutionID: "p1", Price: 50000, Quantity: 100, Charges: userentity.FeeCharges{Fee: 1}, Pricer: pricer, At: at,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5000), filled.Fees, "the pricer replaces the charges")
+	require.Len(t, priced, 1)
+	require.Equal(t, buy.ID, priced[0].ID)
+	require.Equal(t, buyer.Id, priced[0].UserID)
+
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "p2", Price: 50000, Quantity: 100, Pricer: failingPricer, At: at})
+	require.ErrorIs(t, err, errPricing)
+	negative := func(context.Context, userentity.Order, int64, int64, time.Time) (userentity.FeeCharges, error) {
+		return userentity.FeeCharges{Fee: -1}, nil
+	}
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "p2", Price: 50000, Quantity: 100, Pricer: negative, At: at})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "negative fee")
+	got, err := repos.Orders.GetOrder(ctx, buy.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), got.FilledQuantity, "failed pricings record nothing")
+
+	buy, sell, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{
+		BuyOrderID: buy.ID, SellOrderID: sell.ID, ExecutionID: "p3", Price: 50000, Quantity: 100, Pricer: pricer, At: at,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(10000), buy.Fees)
+	require.Equal(t, int64(5000), sell.Fees)
+	require.Equal(t, int64(2500), sell.Taxes)
+
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{
+		BuyOrderID: buy.ID, SellOrderID: sell.ID, ExecutionID: "p4", Price: 50000, Quantity: 100, Pricer: failingPricer, At: at,
+	})
+	require.ErrorIs(t, err, errPricing)
+	got, err = repos.Orders.GetOrder(ctx, sell.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), got.FilledQuantity, "failed pricings record nothing")
+}
+
+func testFillPricerConcurrent(t *testing.T, repos Repositories) {
+	skipIfSerial(t, repos)
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders013"))
+	repos.AddStock(t, userentity.Stock{Code: "MSN", Name: "MSN", CompanyName: "Masan Group Corp"})
+	at := time.Now().UTC().Truncate(time.Second)
+	const fills = 4
+	orders := make([]userentity.Order, fills)
+	for i := range orders {
+		var err error
+		orders[i], err = repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "MSN", userentity.OrderSideBuy, userentity.TimeInForceGTC, 10000, 100, at), nil)
+		require.NoError(t, err)
+	}
+
+	// Each fill is charged the number of fills of the owner priced before
+	// it, read like a volume tier. The pricer is slow so that pricings not
+	// run one at a time would see the same volume.
+	pricer := func(ctx context.Context, order userentity.Order, _, _ int64, _ time.Time) (userentity.FeeCharges, error) {
+		value, err := repos.Orders.FilledValueSince(ctx, order.UserID, at.Add(-time.Hour))
+		if err != nil {
+			return userentity.FeeCharges{}, err
+		}
+		time.Sleep(20 * time.Millisecond)
+		return userentity.FeeCharges{Fee: value / 1_000_000}, nil
+	}
+	var wg sync.WaitGroup
+	for i := range orders {
+		wg.Add(1)
+		go func(order userentity.Order) {
+			defer wg.Done()
+			_, err := repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{
+				OrderID: order.ID, ExecutionID: "v", Price: 10000, Quantity: 100, Pricer: pricer, At: at,
+			})
+			require.NoError(t, err)
+		}(orders[i])
+	}
+	wg.Wait()
+
+	fees := make([]int64, 0, fills)
+	for _, order := range orders {
+		got, err := repos.Orders.GetOrder(ctx, order.ID)
+		require.NoError(t, err)
+		fees = append(fees, got.Fees)
+	}
+	sort.Slice(fees, func(i, j int) bool { return fees[i] < fees[j] })
+	require.Equal(t, []int64{0, 1, 2, 3}, fees)
+}
+
+func testChangeAccountStatusCancelsOrders(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("orders014"))
+	other := mustCreate(t, repos.Users, newSeed("orders015"))
+	repos.AddStock(t, userentity.Stock{Code: "VJC", Name: "Vietjet", CompanyName: "Vietjet Aviation JSC"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	open, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "VJC", userentity.OrderSideBuy, userentity.TimeInForceGTC, 100000, 100, at), nil)
+	require.NoError(t, err)
+	filled, err := repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "VJC", userentity.OrderSideBuy, userentity.TimeInForceGTC, 100000, 100, at), nil)
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: filled.ID, ExecutionID: "f1", Price: 100000, Quantity: 100, At: at})
+	require.NoError(t, err)
+	others, err := repos.Orders.CreateOrder(ctx, newOrder(other.Id, "VJC", userentity.OrderSideSell, userentity.TimeInForceGTC, 100000, 100, at), nil)
+	require.NoError(t, err)
+
+	_, err = repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: owner.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusActive},
+		To:     userentity.AccountStatusDeactivated,
+		At:     at.Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	cancelled, err := repos.Orders.GetOrder(ctx, open.ID)
+	require.NoError(t, err)
+	require.Equal(t, userentity.OrderStatusCancelled, cancelled.Status)
+	require.NotEmpty(t, cancelled.Reason)
+	require.Equal(t,
+		[]userentity.OrderEventType{userentity.OrderEventPlaced, userentity.OrderEventCancelled},
+		orderEventTypes(t, repos, open.ID))
+	stored, err := repos.Orders.GetOrder(ctx, filled.ID)
+	require.NoError(t, err)
+	require.Equal(t, userentity.OrderStatusFilled, stored.Status, "closed orders are left alone")
+	stored, err = repos.Orders.GetOrder(ctx, others.ID)
+	require.NoError(t, err)
+	require.True(t, stored.Status.Open(), "other users keep their orders")
+
+	// Reactivating does not restore the cancelled orders.
+	_, err = repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: owner.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusDeactivated},
+		To:     userentity.AccountStatusActive,
+		At:     at.Add(2 * time.Minute),
+	})
+	require.NoError(t, err)
+	remaining, err := repos.Orders.ListOpenOrders(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+}
@@ -0,0 +1,290 @@
+package repotest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunPaymentRepositoryTests exercises every ports.PaymentRepository method
+// and the ledger entries and withheld cash of payments.
+func RunPaymentRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateAndList", testCreateAndListPayments},
+		{"UpdatePayment", testUpdatePayment},
+		{"PaymentCheck", testPaymentCheck},
+		{"CreatePaymentCheckConcurrent", testCreatePaymentCheckConcurrent},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func newPayment(userID int64, kind userentity.PaymentKind, status userentity.PaymentStatus, amount int64, key string, at time.Time) userentity.Payment {
+	return userentity.Payment{UserID: userID, Kind: kind, Amount: amount, Status: status, IdempotencyKey: key, CreatedAt: at}
+}
+
+func testCreateAndListPayments(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("payment001"))
+	other := mustCreate(t, repos.Users, newSeed("payment002"))
+	at := time.Now().UTC().Truncate(time.Second)
+
+	deposit, created, err := repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindDeposit, userentity.PaymentStatusPending, 5_000_000, "key-1", at), nil)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.NotZero(t, deposit.ID)
+	require.Equal(t, userentity.PaymentStatusPending, deposit.Status)
+	require.True(t, at.Equal(deposit.CreatedAt))
+	require.True(t, deposit.CompletedAt.IsZero())
+
+	again, created, err := repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindDeposit, userentity.PaymentStatusPending, 9_000_000, "key-1", at), nil)
+	require.NoError(t, err)
+	require.False(t, created, "an idempotency key creates one payment")
+	require.Equal(t, deposit.ID, again.ID)
+	require.Equal(t, int64(5_000_000), again.Amount)
+
+	_, created, err = repos.Payments.CreatePayment(ctx, newPayment(other.Id, userentity.PaymentKindDeposit, userentity.PaymentStatusPending, 1_000_000, "key-1", at), nil)
+	require.NoError(t, err)
+	require.True(t, created, "keys are per user")
+	withdrawal, _, err := repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindWithdrawal, userentity.PaymentStatusAwaitingApproval, 2_000_000, "key-2", at), nil)
+	require.NoError(t, err)
+
+	_, _, err = repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindDeposit, userentity.PaymentStatusPending, 0, "key-3", at), nil)
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	_, _, err = repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindDeposit, userentity.PaymentStatusCompleted, 1, "key-3", at), nil)
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "payments start open")
+	_, _, err = repos.Payments.CreatePayment(ctx, newPayment(999999, userentity.PaymentKindDeposit, userentity.PaymentStatusPending, 1, "key-3", at), nil)
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+
+	got, err := repos.Payments.GetPayment(ctx, withdrawal.ID)
+	require.NoError(t, err)
+	require.Equal(t, userentity.PaymentKindWithdrawal, got.Kind)
+	require.Equal(t, "key-2", got.IdempotencyKey)
+	_, err = repos.Payments.GetPayment(ctx, 999999)
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+	found, err := repos.Payments.FindPayment(ctx, owner.Id, "key-2")
+	require.NoError(t, err)
+	require.Equal(t, withdrawal.ID, found.ID)
+	_, err = repos.Payments.FindPayment(ctx, owner.Id, "key-3")
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+
+	listed, err := repos.Payments.ListPayments(ctx, ports.ListPaymentsParams{UserID: owner.Id})
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	require.Equal(t, withdrawal.ID, listed[0].ID, "newest first")
+	listed, err = repos.Payments.ListPayments(ctx, ports.ListPaymentsParams{UserID: owner.Id, BeforeID: withdrawal.ID})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, deposit.ID, listed[0].ID)
+	listed, err = repos.Payments.ListPayments(ctx, ports.ListPaymentsParams{Status: userentity.PaymentStatusPending})
+	require.NoError(t, err)
+	require.Len(t, listed, 2, "every user")
+
+	account, err := repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(2_000_000), account.Withheld, "open withdrawals hold their amount")
+	require.Zero(t, account.Cash)
+}
+
+func testUpdatePayment(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("payment003"))
+	reviewer := mustCreate(t, repos.Users, newSeed("payment004"))
+	at := time.Now().UTC().Truncate(time.Second)
+
+	deposit, _, err := repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindDeposit, userentity.PaymentStatusPending, 5_000_000, "dep", at), nil)
+	require.NoError(t, err)
+	processing, err := repos.Payments.UpdatePayment(ctx, ports.UpdatePaymentParams{
+		PaymentID:        deposit.ID,
+		Status:           userentity.PaymentStatusProcessing,
+		GatewayReference: "gw-1",
+		PaymentURL:       "https://pay.example.com/gw-1",
+		At:               at.Add(time.Second),
+	})
+	require.NoError(t, err)
+	require.Equal(t, userentity.PaymentStatusProcessing, processing.Status)
+	require.Equal(t, "gw-1", processing.GatewayReference)
+	require.Equal(t, "https://pay.example.com/gw-1", processing.PaymentURL)
+	require.True(t, processing.CompletedAt.IsZero())
+
+	completed, err := repos.Payments.UpdatePayment(ctx, ports.UpdatePaymentParams{PaymentID: deposit.ID, Status: userentity.PaymentStatusCompleted, At: at.Add(2 * time.Second)})
+	require.NoError(t, err)
+	require.Equal(t, "gw-1", completed.GatewayReference, "kept")
+	require.True(t, at.Add(2*time.Second).Equal(completed.CompletedAt))
+	_, err = repos.Payments.UpdatePayment(ctx, ports.UpdatePaymentParams{PaymentID: deposit.ID, Status: userentity.PaymentStatusCompleted, At: at})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "completed payments are final")
+
+	withdrawal, _, err := repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindWithdrawal, userentity.PaymentStatusAwaitingApproval, 1_500_000, "wd", at), nil)
+	require.NoError(t, err)
+	_, err = repos.Payments.UpdatePayment(ctx, ports.UpdatePaymentParams{PaymentID: withdrawal.ID, Status: userentity.PaymentStatusCompleted, At: at})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "withdrawals awaiting approval do not complete")
+	approved, err := repos.Payments.UpdatePayment(ctx, ports.UpdatePaymentParams{PaymentID: withdrawal.ID, Status: userentity.PaymentStatusPending, ReviewerID: reviewer.Id, At: at})
+	require.NoError(t, err)
+	require.Equal(t, reviewer.Id, approved.ReviewerID)
+
+	account, err := repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(5_000_000), account.Cash, "the completed deposit is posted")
+	require.Equal(t, int64(1_500_000), account.Withheld)
+
+	_, err = repos.Payments.UpdatePayment(ctx, ports.UpdatePaymentParams{PaymentID: withdrawal.ID, Status: userentity.PaymentStatusCompleted, At: at})
+	require.NoError(t, err)
+	entries, err := repos.Accounts.PostLedgerEntries(ctx, withdrawal.Reference(), []userentity.LedgerEntry{{UserID: owner.Id, Amount: 1, Type: userentity.LedgerEntryAdjustment}})
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "the withdrawal was posted under its reference")
+	require.Equal(t, userentity.LedgerEntryWithdrawal, entries[0].Type)
+	require.Equal(t, int64(-1_500_000), entries[0].Amount)
+
+	account, err = repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(3_500_000), account.Cash)
+	require.Zero(t, account.Withheld)
+
+	failed, _, err := repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindWithdrawal, userentity.PaymentStatusPending, 500_000, "wd-2", at), nil)
+	require.NoError(t, err)
+	failed, err = repos.Payments.UpdatePayment(ctx, ports.UpdatePaymentParams{PaymentID: failed.ID, Status: userentity.PaymentStatusFailed, Reason: "bank account closed", At: at})
+	require.NoError(t, err)
+	require.Equal(t, "bank account closed", failed.Reason)
+	require.False(t, failed.CompletedAt.IsZero())
+
+	_, err = repos.Payments.UpdatePayment(ctx, ports.UpdatePaymentParams{PaymentID: 999999, Status: userentity.PaymentStatusFailed, At: at})
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+}
+
+// errNoFunds is the rejection of fundsCheck.
+var errNoFunds = apperrors.New(apperrors.ErrFailedPrecondition, "NO_FUNDS", "the withdrawal is above the free cash")
+
+// fundsCheck lets withdrawals through while the cash not withheld by other
+// withdrawals or reserved by open buy orders pays for them.
+func fundsCheck(_ context.Context, payment userentity.Payment, account userentity.TradingAccount, open []userentity.Order) error {
+	free := account.Cash - account.Withheld
+	for _, order := range open {
+		if order.Side == userentity.OrderSideBuy {
+			free -= order.Remaining() * order.Price
+		}
+	}
+	if payment.Amount > free {
+		return errNoFunds
+	}
+	return nil
+}
+
+func testPaymentCheck(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("payment003"))
+	repos.AddStock(t, userentity.Stock{Code: "REE", Name: "REE", CompanyName: "Refrigeration Electrical Engineering Corp"})
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:payment003", []userentity.LedgerEntry{{UserID: owner.Id, Amount: 10_000_000, Type: userentity.LedgerEntryAdjustment}})
+	require.NoError(t, err)
+	at := time.Now().UTC().Truncate(time.Second)
+	_, err = repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "REE", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 100, at), nil)
+	require.NoError(t, err)
+
+	// The check sees the cash, the withheld withdrawals and the open orders.
+	var (
+		checks int
+		seen   userentity.TradingAccount
+		seenN  int
+	)
+	recording := func(ctx context.Context, p userentity.Payment, a userentity.TradingAccount, oo []userentity.Order) error {
+		checks++
+		seen, seenN = a, len(oo)
+		return fundsCheck(ctx, p, a, oo)
+	}
+	first, created, err := repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindWithdrawal, userentity.PaymentStatusPending, 3_000_000, "key-1", at), recording)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Equal(t, int64(10_000_000), seen.Cash)
+	require.Zero(t, seen.Withheld)
+	require.Equal(t, 1, seenN)
+
+	// 10M less the 6M order and the 3M withdrawal leaves 1M.
+	_, _, err = repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindWithdrawal, userentity.PaymentStatusPending, 2_000_000, "key-2", at), recording)
+	require.ErrorIs(t, err, errNoFunds)
+	require.Equal(t, int64(3_000_000), seen.Withheld)
+	_, err = repos.Payments.FindPayment(ctx, owner.Id, "key-2")
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+
+	// A repeated key is answered with its payment without a check.
+	checks = 0
+	again, created, err := repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindWithdrawal, userentity.PaymentStatusPending, 3_000_000, "key-1", at), recording)
+	require.NoError(t, err)
+	require.False(t, created)
+	require.Equal(t, first.ID, again.ID)
+	require.Zero(t, checks)
+
+	_, _, err = repos.Payments.CreatePayment(ctx, newPayment(999999, userentity.PaymentKindWithdrawal, userentity.PaymentStatusPending, 1, "key-3", at), fundsCheck)
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+}
+
+func testCreatePaymentCheckConcurrent(t *testing.T, repos Repositories) {
+	skipIfSerial(t, repos)
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("payment004"))
+	repos.AddStock(t, userentity.Stock{Code: "GAS", Name: "GAS", CompanyName: "PetroVietnam Gas JSC"})
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:payment004", []userentity.LedgerEntry{{UserID: owner.Id, Amount: 10_000_000, Type: userentity.LedgerEntryAdjustment}})
+	require.NoError(t, err)
+	at := time.Now().UTC().Truncate(time.Second)
+
+	// The cash pays for one of the withdrawals and buy orders only. The
+	// checks are slow so that checks not run one at a time would overlap.
+	slowFunds := func(ctx context.Context, p userentity.Payment, a userentity.TradingAccount, oo []userentity.Order) error {
+		time.Sleep(20 * time.Millisecond)
+		return fundsCheck(ctx, p, a, oo)
+	}
+	slowBuyingPower := func(ctx context.Context, o userentity.Order, a userentity.TradingAccount, oo []userentity.Order) error {
+		time.Sleep(20 * time.Millisecond)
+		return buyingPowerCheck(ctx, o, a, oo)
+	}
+	const writers = 6
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			if i%2 == 0 {
+				key := "key-" + string(rune('a'+i))
+				_, _, err = repos.Payments.CreatePayment(ctx, newPayment(owner.Id, userentity.PaymentKindWithdrawal, userentity.PaymentStatusPending, 6_000_000, key, at), slowFunds)
+			} else {
+				_, err = repos.Orders.CreateOrder(ctx, newOrder(owner.Id, "GAS", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 100, at), slowBuyingPower)
+			}
+			if err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+				return
+			}
+			require.Condition(t, func() bool {
+				return errors.Is(err, errNoFunds) || errors.Is(err, errNoBuyingPower)
+			}, "unexpected error: %v", err)
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, 1, succeeded)
+
+	account, err := repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	open, err := repos.Orders.ListOpenOrders(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(6_000_000), account.Withheld+int64(len(open))*6_000_000)
+}
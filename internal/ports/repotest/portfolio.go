@@ -0,0 +1,137 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunPortfolioRepositoryTests exercises every ports.PortfolioRepository
+// method.
+func RunPortfolioRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"ListTradeDateEntries", testListTradeDateEntries},
+		{"PortfolioSnapshots", testPortfolioSnapshots},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func testListTradeDateEntries(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	buyer := mustCreate(t, repos.Users, newSeed("portfolio001"))
+	seller := mustCreate(t, repos.Users, newSeed("portfolio002"))
+	idle := mustCreate(t, repos.Users, newSeed("portfolio003"))
+	addStocks(t, repos, "MWG")
+	at := time.Now().UTC().Truncate(time.Second)
+	settles := time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC)
+
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:portfolio", []userentity.LedgerEntry{
+		{UserID: buyer.Id, Amount: 50_000_000, Type: userentity.LedgerEntryDeposit, CreatedAt: at.Add(-time.Hour)},
+		{UserID: seller.Id, Code: "MWG", Amount: 300, Type: userentity.LedgerEntryAdjustment, CreatedAt: at.Add(-time.Hour)},
+	})
+	require.NoError(t, err)
+	buy, err := repos.Orders.CreateOrder(ctx, newOrder(buyer.Id, "MWG", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 200, at), nil)
+	require.NoError(t, err)
+	sell, err := repos.Orders.CreateOrder(ctx, newOrder(seller.Id, "MWG", userentity.OrderSideSell, userentity.TimeInForceGTC, 60000, 200, at), nil)
+	require.NoError(t, err)
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{BuyOrderID: buy.ID, SellOrderID: sell.ID, ExecutionID: "p1", Price: 60000, Quantity: 200, At: at, SettlesOn: settles})
+	require.NoError(t, err)
+
+	sums := func(entries []userentity.LedgerEntry) (cash, shares int64) {
+		for _, entry := range entries {
+			if entry.Cash() {
+				cash += entry.Amount
+			} else {
+				shares += entry.Amount
+			}
+		}
+		return cash, shares
+	}
+	entries, err := repos.Portfolios.ListTradeDateEntries(ctx, buyer.Id, at.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, userentity.LedgerEntryDeposit, entries[0].Type)
+	require.Equal(t, userentity.LedgerEntrySettlement, entries[2].Type)
+	require.Equal(t, "MWG", entries[2].Code)
+	cash, shares := sums(entries)
+	require.Equal(t, int64(38_000_000), cash)
+	require.Equal(t, int64(200), shares, "pending shares count from the trade on")
+
+	_, err = repos.Accounts.SettleDue(ctx, settles, at.Add(30*time.Second))
+	require.NoError(t, err)
+	entries, err = repos.Portfolios.ListTradeDateEntries(ctx, seller.Id, at.Add(time.Minute))
+	require.NoError(t, err)
+	cash, shares = sums(entries)
+	require.Equal(t, int64(12_000_000), cash, "settled proceeds count once")
+	require.Equal(t, int64(100), shares)
+
+	entries, err = repos.Portfolios.ListTradeDateEntries(ctx, buyer.Id, at)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	owners, err := repos.Portfolios.ListPortfolioOwners(ctx, at.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, []int64{buyer.Id, seller.Id}, owners)
+	require.NotContains(t, owners, idle.Id)
+	owners, err = repos.Portfolios.ListPortfolioOwners(ctx, at.Add(-2*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, owners)
+}
+
+func testPortfolioSnapshots(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("portfolio004"))
+	other := mustCreate(t, repos.Users, newSeed("portfolio005"))
+	day := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	at := time.Now().UTC().Truncate(time.Second)
+
+	_, err := repos.Portfolios.LastPortfolioSnapshot(ctx, owner.Id)
+	requireNotFound(t, err)
+
+	require.NoError(t, repos.Portfolios.SavePortfolioSnapshots(ctx, []userentity.PortfolioSnapshot{
+		{UserID: owner.Id, Date: day, Cash: 10_000_000, MarketValue: 0, NetFlow: 10_000_000, CreatedAt: at},
+		{UserID: owner.Id, Date: day.AddDate(0, 0, 3), Cash: 1_000_000, MarketValue: 9_500_000, CreatedAt: at},
+		{UserID: other.Id, Date: day.AddDate(0, 0, 3), Cash: 5, CreatedAt: at},
+	}))
+	require.NoError(t, repos.Portfolios.SavePortfolioSnapshots(ctx, []userentity.PortfolioSnapshot{
+		{UserID: owner.Id, Date: day.AddDate(0, 0, 3), Cash: 1_000_000, MarketValue: 9_800_000, CreatedAt: at},
+	}), "a snapshot of the same day is replaced")
+
+	err = repos.Portfolios.SavePortfolioSnapshots(ctx, []userentity.PortfolioSnapshot{{UserID: owner.Id}})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	err = repos.Portfolios.SavePortfolioSnapshots(ctx, []userentity.PortfolioSnapshot{{UserID: other.Id + 100, Date: day}})
+	requireNotFound(t, err)
+
+	snapshots, err := repos.Portfolios.ListPortfolioSnapshots(ctx, owner.Id, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.True(t, day.Equal(snapshots[0].Date))
+	require.Equal(t, int64(10_000_000), snapshots[0].NetFlow)
+	require.Equal(t, int64(10_800_000), snapshots[1].Value())
+	require.True(t, at.Equal(snapshots[1].CreatedAt))
+
+	snapshots, err = repos.Portfolios.ListPortfolioSnapshots(ctx, owner.Id, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+
+	last, err := repos.Portfolios.LastPortfolioSnapshot(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, owner.Id, last.UserID)
+	require.True(t, day.AddDate(0, 0, 3).Equal(last.Date))
+	require.Equal(t, int64(9_800_000), last.MarketValue)
+}
@@ -0,0 +1,233 @@
+package repotest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunPriceAlertRepositoryTests exercises every ports.PriceAlertRepository
+// method.
+func RunPriceAlertRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreatePriceAlert", testCreatePriceAlert},
+		{"ListAndDeletePriceAlerts", testListAndDeletePriceAlerts},
+		{"TriggerPriceAlert", testTriggerPriceAlert},
+		{"TriggerPriceAlertConcurrent", testTriggerPriceAlertConcurrent},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func alertTriggeredEvents(t *testing.T, repos Repositories, userID int64) []userentity.OutboxEvent {
+	t.Helper()
+	data, err := repos.DataExports.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	var events []userentity.OutboxEvent
+	for _, event := range data.OutboxEvents {
+		if event.EventType == "user.alert.triggered" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func triggerParams(alertID, price int64) ports.TriggerPriceAlertParams {
+	return ports.TriggerPriceAlertParams{
+		AlertID: alertID,
+		Price:   price,
+		At:      time.Now().UTC().Truncate(time.Second),
+		Event: userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     "user.alert.triggered",
+			Payload:       []byte(`{"purpose":"price_alert"}`),
+			Status:        userentity.OutboxEventStatusPending,
+		},
+	}
+}
+
+func testCreatePriceAlert(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("alerts001"))
+	vnm := repos.AddStock(t, userentity.Stock{Code: "VNM", Name: "Vinamilk", CompanyName: "Vietnam Dairy Products JSC"})
+
+	created, err := repos.PriceAlerts.CreatePriceAlert(ctx, userentity.PriceAlert{
+		UserID:      owner.Id,
+		Code:        "VNM",
+		Condition:   userentity.PriceAlertAbove,
+		TargetPrice: 75000,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+	require.Equal(t, vnm.ID, created.StockID)
+	require.Equal(t, userentity.PriceAlertStatusActive, created.Status)
+	require.False(t, created.CreatedAt.IsZero())
+	require.True(t, created.TriggeredAt.IsZero())
+
+	got, err := repos.PriceAlerts.GetPriceAlert(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, got.ID)
+	require.Equal(t, "VNM", got.Code)
+	require.Equal(t, userentity.PriceAlertAbove, got.Condition)
+	require.Equal(t, int64(75000), got.TargetPrice)
+
+	move, err := repos.PriceAlerts.CreatePriceAlert(ctx, userentity.PriceAlert{
+		UserID:    owner.Id,
+		Code:      "VNM",
+		Condition: userentity.PriceAlertMove,
+		ChangeBps: 500,
+		BasePrice: 70000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(500), move.ChangeBps)
+	require.Equal(t, int64(70000), move.BasePrice)
+
+	invalid := []userentity.PriceAlert{
+		{UserID: owner.Id, Code: "VNM", Condition: userentity.PriceAlertBelow},
+		{UserID: owner.Id, Code: "VNM", Condition: userentity.PriceAlertMove, ChangeBps: 500},
+		{UserID: owner.Id, Code: "VNM", Condition: "sideways", TargetPrice: 1},
+		{Code: "VNM", Condition: userentity.PriceAlertAbove, TargetPrice: 1},
+	}
+	for _, alert := range invalid {
+		_, err = repos.PriceAlerts.CreatePriceAlert(ctx, alert)
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	}
+	_, err = repos.PriceAlerts.CreatePriceAlert(ctx, userentity.PriceAlert{UserID: owner.Id, Code: "XXX", Condition: userentity.PriceAlertAbove, TargetPrice: 1})
+	requireNotFound(t, err)
+	_, err = repos.PriceAlerts.CreatePriceAlert(ctx, userentity.PriceAlert{UserID: owner.Id + 1000, Code: "VNM", Condition: userentity.PriceAlertAbove, TargetPrice: 1})
+	requireNotFound(t, err)
+	_, err = repos.PriceAlerts.GetPriceAlert(ctx, created.ID+1000)
+	requireNotFound(t, err)
+}
+
+func testListAndDeletePriceAlerts(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	alice := mustCreate(t, repos.Users, newSeed("alerts002"))
+	bob := mustCreate(t, repos.Users, newSeed("alerts003"))
+	fpt := repos.AddStock(t, userentity.Stock{Code: "FPT", Name: "FPT", CompanyName: "FPT Corporation"})
+	addStocks(t, repos, "VNM")
+
+	create := func(userID int64, code string, target int64) userentity.PriceAlert {
+		t.Helper()
+		alert, err := repos.PriceAlerts.CreatePriceAlert(ctx, userentity.PriceAlert{
+			UserID: userID, Code: code, Condition: userentity.PriceAlertBelow, TargetPrice: target,
+		})
+		require.NoError(t, err)
+		return alert
+	}
+	first := create(alice.Id, "FPT", 100000)
+	second := create(alice.Id, "VNM", 60000)
+	third := create(bob.Id, "FPT", 110000)
+
+	alerts, err := repos.PriceAlerts.ListPriceAlerts(ctx, alice.Id)
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	require.Equal(t, second.ID, alerts[0].ID, "newest first")
+	require.Equal(t, first.ID, alerts[1].ID)
+
+	active, err := repos.PriceAlerts.ListActivePriceAlerts(ctx, fpt.ID)
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	require.Equal(t, first.ID, active[0].Alert.ID, "oldest first")
+	require.Equal(t, alice.Email, active[0].Owner.Email)
+	require.Equal(t, third.ID, active[1].Alert.ID)
+	require.Equal(t, bob.Id, active[1].Owner.Id)
+
+	require.NoError(t, repos.PriceAlerts.DeletePriceAlert(ctx, first.ID))
+	requireNotFound(t, repos.PriceAlerts.DeletePriceAlert(ctx, first.ID))
+	_, err = repos.PriceAlerts.GetPriceAlert(ctx, first.ID)
+	requireNotFound(t, err)
+	alerts, err = repos.PriceAlerts.ListPriceAlerts(ctx, alice.Id)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	active, err = repos.PriceAlerts.ListActivePriceAlerts(ctx, fpt.ID)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+}
+
+func testTriggerPriceAlert(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("alerts004"))
+	vnm := repos.AddStock(t, userentity.Stock{Code: "VNM", Name: "Vinamilk", CompanyName: "Vietnam Dairy Products JSC"})
+	alert, err := repos.PriceAlerts.CreatePriceAlert(ctx, userentity.PriceAlert{
+		UserID: owner.Id, Code: "VNM", Condition: userentity.PriceAlertAbove, TargetPrice: 75000,
+	})
+	require.NoError(t, err)
+
+	params := triggerParams(alert.ID, 75500)
+	triggered, err := repos.PriceAlerts.TriggerPriceAlert(ctx, params)
+	require.NoError(t, err)
+	require.True(t, triggered)
+
+	got, err := repos.PriceAlerts.GetPriceAlert(ctx, alert.ID)
+	require.NoError(t, err)
+	require.Equal(t, userentity.PriceAlertStatusTriggered, got.Status)
+	require.Equal(t, int64(75500), got.TriggeredPrice)
+	require.True(t, params.At.Equal(got.TriggeredAt))
+	active, err := repos.PriceAlerts.ListActivePriceAlerts(ctx, vnm.ID)
+	require.NoError(t, err)
+	require.Empty(t, active, "triggered alerts are not active")
+
+	triggered, err = repos.PriceAlerts.TriggerPriceAlert(ctx, triggerParams(alert.ID, 76000))
+	require.NoError(t, err)
+	require.False(t, triggered, "alerts fire once")
+	triggered, err = repos.PriceAlerts.TriggerPriceAlert(ctx, triggerParams(alert.ID+1000, 76000))
+	require.NoError(t, err)
+	require.False(t, triggered, "unknown alerts do not fire")
+
+	events := alertTriggeredEvents(t, repos, owner.Id)
+	require.Len(t, events, 1)
+	require.Equal(t, owner.Id, events[0].AggregateID)
+	require.Equal(t, userentity.OutboxEventStatusPending, events[0].Status)
+}
+
+func testTriggerPriceAlertConcurrent(t *testing.T, repos Repositories) {
+	if repos.SkipConcurrency {
+		t.Skip("repository does not provide transaction isolation")
+	}
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("alerts005"))
+	addStocks(t, repos, "VNM")
+	alert, err := repos.PriceAlerts.CreatePriceAlert(ctx, userentity.PriceAlert{
+		UserID: owner.Id, Code: "VNM", Condition: userentity.PriceAlertBelow, TargetPrice: 65000,
+	})
+	require.NoError(t, err)
+
+	const workers = 8
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		fired int
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			triggered, err := repos.PriceAlerts.TriggerPriceAlert(ctx, triggerParams(alert.ID, 64000))
+			require.NoError(t, err)
+			if triggered {
+				mu.Lock()
+				fired++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, fired)
+	require.Len(t, alertTriggeredEvents(t, repos, owner.Id), 1)
+}
@@ -0,0 +1,1243 @@
+// Package repotest provides a conformance test suite that every implementation
+// of ports.UserRepository, ports.OutboxRepository, ports.DataExportRepository,
+// ports.AuditRepository, ports.LoginHistoryRepository, ports.KycRepository,
+// ports.StockRepository, ports.WatchlistRepository,
+// ports.PriceAlertRepository, ports.NewsRepository, ports.OrderRepository,
+// ports.TradingAccountRepository, ports.PaymentRepository,
+// ports.StatementRepository, ports.FeeScheduleRepository,
+// ports.CorporateActionRepository and ports.PortfolioRepository is expected
+// to pass.
+//
+// Adapters call Run from their own _test.go files with a Factory that returns a
+// fresh, empty repository for every sub-test. The suite only relies on the
+// behaviour documented by the ports so that in-memory and database backed
+// implementations stay interchangeable.
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// Repositories bundles the implementations under test.
+type Repositories struct {
+	Users       ports.UserRepository
+	Outbox      ports.OutboxRepository
+	DataExports ports.DataExportRepository
+	Audit       ports.AuditRepository
+	Logins      ports.LoginHistoryRepository
+	Kyc         ports.KycRepository
+	Stocks      ports.StockRepository
+	Watchlists  ports.WatchlistRepository
+	PriceAlerts ports.PriceAlertRepository
+	News        ports.NewsRepository
+	Orders      ports.OrderRepository
+	Accounts    ports.TradingAccountRepository
+	Payments    ports.PaymentRepository
+	Statements  ports.StatementRepository
+	Fees        ports.FeeScheduleRepository
+	Actions     ports.CorporateActionRepository
+	Portfolios  ports.PortfolioRepository
+
+	// LatestOutboxEventID returns the identifier of the newest outbox event
+	// written for the given aggregate. The ports intentionally do not expose a
+	// read API for outbox events, so adapters provide this hook from their tests.
+	LatestOutboxEventID func(ctx context.Context, aggregateID int64) (int64, error)
+
+	// AddStock lists a stock in the catalog and RecordStockPrice records its
+	// newest price. The stocks catalog is maintained outside the ports, so
+	// adapters provide these hooks from their tests.
+	AddStock         func(t *testing.T, stock userentity.Stock) userentity.Stock
+	RecordStockPrice func(t *testing.T, code string, price int64, at time.Time)
+
+	// SkipConcurrency disables the racing-writer tests. Only set it for
+	// stand-in databases that do not provide real transaction isolation.
+	SkipConcurrency bool
+}
+
+// Factory returns an empty set of repositories. It is invoked once per sub-test.
+type Factory func(t *testing.T) Repositories
+
+// Run executes the full conformance suite.
+func Run(t *testing.T, newRepos Factory) {
+	t.Helper()
+	t.Run("UserRepository", func(t *testing.T) { RunUserRepositoryTests(t, newRepos) })
+	t.Run("OutboxRepository", func(t *testing.T) { RunOutboxRepositoryTests(t, newRepos) })
+	t.Run("DataExportRepository", func(t *testing.T) { RunDataExportRepositoryTests(t, newRepos) })
+	t.Run("AuditRepository", func(t *testing.T) { RunAuditRepositoryTests(t, newRepos) })
+	t.Run("LoginHistoryRepository", func(t *testing.T) { RunLoginHistoryRepositoryTests(t, newRepos) })
+	t.Run("KycRepository", func(t *testing.T) { RunKycRepositoryTests(t, newRepos) })
+	t.Run("StockRepository", func(t *testing.T) { RunStockRepositoryTests(t, newRepos) })
+	t.Run("WatchlistRepository", func(t *testing.T) { RunWatchlistRepositoryTests(t, newRepos) })
+	t.Run("PriceAlertRepository", func(t *testing.T) { RunPriceAlertRepositoryTests(t, newRepos) })
+	t.Run("NewsRepository", func(t *testing.T) { RunNewsRepositoryTests(t, newRepos) })
+	t.Run("OrderRepository", func(t *testing.T) { RunOrderRepositoryTests(t, newRepos) })
+	t.Run("TradingAccountRepository", func(t *testing.T) { RunTradingAccountRepositoryTests(t, newRepos) })
+	t.Run("PaymentRepository", func(t *testing.T) { RunPaymentRepositoryTests(t, newRepos) })
+	t.Run("StatementRepository", func(t *testing.T) { RunStatementRepositoryTests(t, newRepos) })
+	t.Run("FeeScheduleRepository", func(t *testing.T) { RunFeeScheduleRepositoryTests(t, newRepos) })
+	t.Run("CorporateActionRepository", func(t *testing.T) { RunCorporateActionRepositoryTests(t, newRepos) })
+	t.Run("PortfolioRepository", func(t *testing.T) { RunPortfolioRepositoryTests(t, newRepos) })
+}
+
+// RunUserRepositoryTests exercises every ports.UserRepository method.
+func RunUserRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CheckUserNameAndEmailIsExist", testCheckUserNameAndEmailIsExist},
+		{"CreateUserWithVerification", testCreateUserWithVerification},
+		{"CreateUserWithVerificationDuplicate", testCreateUserWithVerificationDuplicate},
+		{"CreateUserWithVerificationConcurrent", testCreateUserWithVerificationConcurrent},
+		{"RotateVerificationToken", testRotateVerificationToken},
+		{"RotateVerificationTokenUnknownUser", testRotateVerificationTokenUnknownUser},
+		{"FindVerificationToken", testFindVerificationToken},
+		{"GetLatestVerificationToken", testGetLatestVerificationToken},
+		{"VerifyUserWithToken", testVerifyUserWithToken},
+		{"VerifyUserWithTokenConcurrent", testVerifyUserWithTokenConcurrent},
+		{"RequestEmailChange", testRequestEmailChange},
+		{"ConfirmEmailChange", testConfirmEmailChange},
+		{"GetLoginInfo", testGetLoginInfo},
+		{"ChangeAccountStatus", testChangeAccountStatus},
+		{"ChangeAccountStatusInvalid", testChangeAccountStatusInvalid},
+		{"ListUsersDueForAnonymization", testListUsersDueForAnonymization},
+		{"AnonymizeUser", testAnonymizeUser},
+		{"GetUser", testGetUser},
+		{"GetUserByEmail", testGetUserByEmail},
+		{"ListUsers", testListUsers},
+		{"ListUsersBounds", testListUsersBounds},
+		{"ListUsersFilters", testListUsersFilters},
+		{"ListUsersKeyset", testListUsersKeyset},
+		{"UpdateUser", testUpdateUser},
+		{"UpdateUserEmailConflict", testUpdateUserEmailConflict},
+		{"UpdateUserVersion", testUpdateUserVersion},
+		{"UpdateAvatar", testUpdateAvatar},
+		{"UpdatePassword", testUpdatePassword},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+// RunOutboxRepositoryTests exercises every ports.OutboxRepository method.
+func RunOutboxRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"UpdateOutboxStatus", testUpdateOutboxStatus},
+		{"UpdateOutboxStatusInvalid", testUpdateOutboxStatusInvalid},
+		{"UpdateOutboxStatusUnknownEvent", testUpdateOutboxStatusUnknownEvent},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+// requireNotFound asserts the error is classified as apperrors.ErrNotFound,
+// which the gRPC layer maps to codes.NotFound.
+func requireNotFound(t *testing.T, err error) {
+	t.Helper()
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+}
+
+// requireConflict asserts the error is classified as apperrors.ErrConflict.
+func requireConflict(t *testing.T, err error) {
+	t.Helper()
+	require.ErrorIs(t, err, apperrors.ErrConflict)
+}
+
+func skipIfSerial(t *testing.T, repos Repositories) {
+	t.Helper()
+	if repos.SkipConcurrency {
+		t.Skip("concurrency tests disabled for this backend")
+	}
+}
+
+type seed struct {
+	Username string
+	Email    string
+	Password string
+	Token    string
+}
+
+func newSeed(username string) seed {
+	return seed{
+		Username: username,
+		Email:    username + "@example.com",
+		Password: "hashed-" + username,
+		Token:    uuid.NewString(),
+	}
+}
+
+func createParams(s seed) ports.CreateUserWithVerificationParams {
+	now := time.Now().UTC().Truncate(time.Second)
+	return ports.CreateUserWithVerificationParams{
+		User: userentity.User{
+			Username:         s.Username,
+			Name:             "Conformance " + s.Username,
+			Email:            s.Email,
+			DocumentID:       "DOC-" + s.Username,
+			Birthday:         time.Date(1990, time.July, 1, 0, 0, 0, 0, time.UTC),
+			Gender:           true,
+			PermanentAddress: "1 Conformance Street",
+			PhoneNumber:      "0123456789",
+		},
+		Login: userentity.LoginMethodPassword{
+			UserName: s.Username,
+			Password: s.Password,
+		},
+		Token: userentity.VerificationToken{
+			Token:     s.Token,
+			Purpose:   userentity.VerificationPurposeRegister,
+			ExpiresAt: now.Add(24 * time.Hour),
+			CreatedAt: now,
+		},
+		OutboxEvent: userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     "user.verification.register",
+			Payload:       []byte(fmt.Sprintf(`{"email":%q,"token":%q,"purpose":"register"}`, s.Email, s.Token)),
+			Status:        userentity.OutboxEventStatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		},
+	}
+}
+
+func mustCreate(t *testing.T, repo ports.UserRepository, s seed) userentity.User {
+	t.Helper()
+	created, err := repo.CreateUserWithVerification(context.Background(), createParams(s))
+	require.NoError(t, err)
+	return created
+}
+
+func mustVerify(t *testing.T, repo ports.UserRepository, s seed) userentity.User {
+	t.Helper()
+	ctx := context.Background()
+	vt, owner, err := repo.FindVerificationToken(ctx, s.Token)
+	require.NoError(t, err)
+	verified, err := repo.VerifyUserWithToken(ctx, vt.ID, owner.Id, time.Now().UTC().Truncate(time.Second))
+	require.NoError(t, err)
+	return verified
+}
+
+func sameDay(t *testing.T, expected, actual time.Time) {
+	t.Helper()
+	ey, em, ed := expected.UTC().Date()
+	ay, am, ad := actual.UTC().Date()
+	require.Equal(t, fmt.Sprintf("%04d-%02d-%02d", ey, em, ed), fmt.Sprintf("%04d-%02d-%02d", ay, am, ad))
+}
+
+func testCheckUserNameAndEmailIsExist(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("checker01")
+
+	require.NoError(t, repos.Users.CheckUserNameAndEmailIsExist(ctx, s.Username, s.Email))
+	mustCreate(t, repos.Users, s)
+
+	requireConflict(t, repos.Users.CheckUserNameAndEmailIsExist(ctx, s.Username, "other@example.com"))
+	requireConflict(t, repos.Users.CheckUserNameAndEmailIsExist(ctx, "another01", s.Email))
+	require.NoError(t, repos.Users.CheckUserNameAndEmailIsExist(ctx, "another01", "other@example.com"))
+}
+
+func testCreateUserWithVerification(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("creator01")
+	params := createParams(s)
+
+	created, err := repos.Users.CreateUserWithVerification(ctx, params)
+	require.NoError(t, err)
+	require.NotZero(t, created.Id)
+	require.Equal(t, s.Username, created.Username)
+	require.Equal(t, s.Email, created.Email)
+	require.False(t, created.Verified)
+	require.True(t, created.VerifiedAt.IsZero())
+
+	stored, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, created.Id, stored.Id)
+	require.Equal(t, params.User.Name, stored.Name)
+	require.Equal(t, params.User.DocumentID, stored.DocumentID)
+	require.Equal(t, params.User.Gender, stored.Gender)
+	require.Equal(t, params.User.PermanentAddress, stored.PermanentAddress)
+	require.Equal(t, params.User.PhoneNumber, stored.PhoneNumber)
+	require.False(t, stored.Verified)
+	sameDay(t, params.User.Birthday, stored.Birthday)
+
+	vt, err := repos.Users.GetLatestVerificationToken(ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, s.Token, vt.Token)
+	require.Equal(t, created.Id, vt.UserID)
+	require.Equal(t, userentity.VerificationPurposeRegister, vt.Purpose)
+	require.Nil(t, vt.ConsumedAt)
+	require.WithinDuration(t, params.Token.ExpiresAt, vt.ExpiresAt, time.Second)
+}
+
+func testCreateUserWithVerificationDuplicate(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	original := newSeed("original01")
+	mustCreate(t, repos.Users, original)
+
+	sameName := newSeed("original01")
+	sameName.Email = "different@example.com"
+	_, err := repos.Users.CreateUserWithVerification(ctx, createParams(sameName))
+	requireConflict(t, err)
+
+	sameEmail := newSeed("different01")
+	sameEmail.Email = original.Email
+	_, err = repos.Users.CreateUserWithVerification(ctx, createParams(sameEmail))
+	requireConflict(t, err)
+
+	_, total, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, total)
+}
+
+func testCreateUserWithVerificationConcurrent(t *testing.T, repos Repositories) {
+	skipIfSerial(t, repos)
+	ctx := context.Background()
+	const workers = 8
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		ids       = make(map[int64]struct{})
+	)
+	// Distinct users must all be created with unique identifiers.
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			created, err := repos.Users.CreateUserWithVerification(ctx, createParams(newSeed(fmt.Sprintf("parallel%02d", i))))
+			if err != nil {
+				t.Errorf("create parallel%02d: %v", i, err)
+				return
+			}
+			mu.Lock()
+			ids[created.Id] = struct{}{}
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	require.Len(t, ids, workers)
+
+	// Racing registrations for the same username must produce exactly one winner.
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s := newSeed("contested01")
+			s.Email = fmt.Sprintf("contested%02d@example.com", i)
+			_, err := repos.Users.CreateUserWithVerification(ctx, createParams(s))
+			if err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	require.Equal(t, 1, succeeded)
+
+	_, total, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 100})
+	require.NoError(t, err)
+	require.EqualValues(t, workers+1, total)
+}
+
+func testRotateVerificationToken(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("rotator01")
+	created := mustCreate(t, repos.Users, s)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	next := uuid.NewString()
+	err := repos.Users.RotateVerificationToken(ctx, ports.RotateVerificationTokenParams{
+		UserID: created.Id,
+		Token: userentity.VerificationToken{
+			Token:     next,
+			Purpose:   userentity.VerificationPurposeResend,
+			ExpiresAt: now.Add(time.Hour),
+			CreatedAt: now,
+		},
+		OutboxEvent: userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     "user.verification.resend",
+			Payload:       []byte(`{}`),
+			Status:        userentity.OutboxEventStatusPending,
+		},
+	})
+	require.NoError(t, err)
+
+	latest, err := repos.Users.GetLatestVerificationToken(ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, next, latest.Token)
+	require.Equal(t, userentity.VerificationPurposeResend, latest.Purpose)
+	require.Nil(t, latest.ConsumedAt)
+
+	previous, _, err := repos.Users.FindVerificationToken(ctx, s.Token)
+	require.NoError(t, err)
+	require.NotNil(t, previous.ConsumedAt, "rotated token must be consumed")
+}
+
+func testRotateVerificationTokenUnknownUser(t *testing.T, repos Repositories) {
+	now := time.Now().UTC()
+	err := repos.Users.RotateVerificationToken(context.Background(), ports.RotateVerificationTokenParams{
+		UserID: 987654,
+		Token: userentity.VerificationToken{
+			Token:     uuid.NewString(),
+			Purpose:   userentity.VerificationPurposeResend,
+			ExpiresAt: now.Add(time.Hour),
+			CreatedAt: now,
+		},
+		OutboxEvent: userentity.OutboxEvent{EventType: "user.verification.resend", Payload: []byte(`{}`)},
+	})
+	require.Error(t, err)
+}
+
+func testFindVerificationToken(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("finder01")
+	created := mustCreate(t, repos.Users, s)
+
+	vt, owner, err := repos.Users.FindVerificationToken(ctx, s.Token)
+	require.NoError(t, err)
+	require.Equal(t, s.Token, vt.Token)
+	require.Equal(t, created.Id, vt.UserID)
+	require.Equal(t, created.Id, owner.Id)
+	require.Equal(t, s.Username, owner.Username)
+	require.Equal(t, s.Email, owner.Email)
+
+	_, _, err = repos.Users.FindVerificationToken(ctx, uuid.NewString())
+	requireNotFound(t, err)
+}
+
+func testGetLatestVerificationToken(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("latest01"))
+
+	_, err := repos.Users.GetLatestVerificationToken(ctx, created.Id+1000)
+	requireNotFound(t, err)
+}
+
+func testVerifyUserWithToken(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("verifier01")
+	mustCreate(t, repos.Users, s)
+
+	verified := mustVerify(t, repos.Users, s)
+	require.True(t, verified.Verified)
+	require.False(t, verified.VerifiedAt.IsZero())
+	require.Equal(t, s.Username, verified.Username)
+
+	stored, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.True(t, stored.Verified)
+
+	vt, owner, err := repos.Users.FindVerificationToken(ctx, s.Token)
+	require.NoError(t, err)
+	require.NotNil(t, vt.ConsumedAt)
+
+	_, err = repos.Users.VerifyUserWithToken(ctx, vt.ID, owner.Id, time.Now().UTC())
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "a consumed token cannot be used twice")
+}
+
+func testVerifyUserWithTokenConcurrent(t *testing.T, repos Repositories) {
+	skipIfSerial(t, repos)
+	ctx := context.Background()
+	s := newSeed("racer01")
+	mustCreate(t, repos.Users, s)
+	vt, owner, err := repos.Users.FindVerificationToken(ctx, s.Token)
+	require.NoError(t, err)
+
+	const workers = 8
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := repos.Users.VerifyUserWithToken(ctx, vt.ID, owner.Id, time.Now().UTC()); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, succeeded)
+}
+
+func testGetLoginInfo(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("logger01")
+	created := mustCreate(t, repos.Users, s)
+
+	login, info, err := repos.Users.GetLoginInfo(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, s.Username, login.UserName)
+	require.Equal(t, s.Password, login.Password)
+	require.Equal(t, created.Id, info.Id)
+	require.Equal(t, s.Email, info.Email)
+	require.False(t, info.Verified)
+	sameDay(t, createParams(s).User.Birthday, info.Birthday)
+
+	mustVerify(t, repos.Users, s)
+	_, info, err = repos.Users.GetLoginInfo(ctx, s.Username)
+	require.NoError(t, err)
+	require.True(t, info.Verified)
+
+	_, _, err = repos.Users.GetLoginInfo(ctx, "missing01")
+	requireNotFound(t, err)
+}
+
+func testChangeAccountStatus(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("status001")
+	created := mustCreate(t, repos.Users, s)
+	require.Equal(t, userentity.AccountStatusActive, created.Status)
+	mustVerify(t, repos.Users, s)
+
+	at := time.Now().UTC().Truncate(time.Second)
+	deactivated, err := repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: created.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusActive},
+		To:     userentity.AccountStatusDeactivated,
+		At:     at,
+	})
+	require.NoError(t, err)
+	require.Equal(t, userentity.AccountStatusDeactivated, deactivated.Status)
+	require.WithinDuration(t, at, deactivated.StatusChangedAt, time.Second)
+	require.True(t, deactivated.DeletionScheduledAt.IsZero())
+	require.Equal(t, int64(3), deactivated.Version, "created, verified, deactivated")
+
+	// Lookups only see active accounts; login info still resolves.
+	_, err = repos.Users.GetUser(ctx, s.Username)
+	requireNotFound(t, err)
+	_, err = repos.Users.GetUserByEmail(ctx, s.Email)
+	requireNotFound(t, err)
+	_, info, err := repos.Users.GetLoginInfo(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, userentity.AccountStatusDeactivated, info.Status)
+	requireNotFound(t, repos.Users.UpdatePassword(ctx, s.Username, "hashed-new"))
+	requireNotFound(t, repos.Users.UpdateUser(ctx, s.Username, userentity.User{Name: "Nope"}))
+	users, total, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, users)
+	require.Zero(t, total)
+	users, _, err = repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10, Filter: ports.ListUsersFilter{
+		Statuses: []userentity.AccountStatus{userentity.AccountStatusDeactivated},
+	}})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+
+	// The username and email stay reserved.
+	requireConflict(t, repos.Users.CheckUserNameAndEmailIsExist(ctx, s.Username, "other@example.com"))
+
+	// Transitions from a state outside From are rejected.
+	_, err = repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: created.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusActive},
+		To:     userentity.AccountStatusDeactivated,
+	})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	scheduled := at.Add(48 * time.Hour)
+	pending, err := repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID:              created.Id,
+		From:                []userentity.AccountStatus{userentity.AccountStatusActive, userentity.AccountStatusDeactivated},
+		To:                  userentity.AccountStatusPendingDeletion,
+		At:                  at,
+		DeletionScheduledAt: scheduled,
+	})
+	require.NoError(t, err)
+	require.Equal(t, userentity.AccountStatusPendingDeletion, pending.Status)
+	require.WithinDuration(t, scheduled, pending.DeletionScheduledAt, time.Second)
+
+	restored, err := repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: created.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusDeactivated, userentity.AccountStatusPendingDeletion},
+		To:     userentity.AccountStatusActive,
+		At:     at,
+	})
+	require.NoError(t, err)
+	require.Equal(t, userentity.AccountStatusActive, restored.Status)
+	require.True(t, restored.DeletionScheduledAt.IsZero(), "reactivation clears the deletion schedule")
+
+	got, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, restored.Version, got.Version)
+	require.True(t, got.Verified, "status changes keep the verification state")
+
+	_, err = repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: 999999,
+		From:   []userentity.AccountStatus{userentity.AccountStatusActive},
+		To:     userentity.AccountStatusDeactivated,
+	})
+	requireNotFound(t, err)
+}
+
+func testChangeAccountStatusInvalid(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("status002"))
+
+	for _, params := range []ports.ChangeAccountStatusParams{
+		{UserID: created.Id, From: []userentity.AccountStatus{userentity.AccountStatusActive}, To: userentity.AccountStatusDeleted},
+		{UserID: created.Id, From: []userentity.AccountStatus{userentity.AccountStatusActive}, To: "frozen"},
+		{UserID: created.Id, To: userentity.AccountStatusDeactivated},
+		{UserID: created.Id, From: []userentity.AccountStatus{userentity.AccountStatusActive}, To: userentity.AccountStatusPendingDeletion},
+	} {
+		_, err := repos.Users.ChangeAccountStatus(ctx, params)
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "%+v", params)
+	}
+
+	got, err := repos.Users.GetUser(ctx, created.Username)
+	require.NoError(t, err)
+	require.Equal(t, userentity.AccountStatusActive, got.Status)
+}
+
+// scheduleDeletion moves the seeded user to pending_deletion due at scheduled.
+func scheduleDeletion(t *testing.T, repo ports.UserRepository, userID int64, scheduled time.Time) {
+	t.Helper()
+	_, err := repo.ChangeAccountStatus(context.Background(), ports.ChangeAccountStatusParams{
+		UserID:              userID,
+		From:                []userentity.AccountStatus{userentity.AccountStatusActive},
+		To:                  userentity.AccountStatusPendingDeletion,
+		At:                  time.Now().UTC().Truncate(time.Second),
+		DeletionScheduledAt: scheduled,
+	})
+	require.NoError(t, err)
+}
+
+func testListUsersDueForAnonymization(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	later := mustCreate(t, repos.Users, newSeed("duelater1"))
+	first := mustCreate(t, repos.Users, newSeed("duefirst1"))
+	second := mustCreate(t, repos.Users, newSeed("duesecnd1"))
+	mustCreate(t, repos.Users, newSeed("dueactiv1"))
+	scheduleDeletion(t, repos.Users, later.Id, now.Add(time.Hour))
+	scheduleDeletion(t, repos.Users, first.Id, now.Add(-2*time.Hour))
+	scheduleDeletion(t, repos.Users, second.Id, now.Add(-time.Hour))
+
+	due, err := repos.Users.ListUsersDueForAnonymization(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.Equal(t, first.Id, due[0].Id, "oldest schedule first")
+	require.Equal(t, second.Id, due[1].Id)
+
+	due, err = repos.Users.ListUsersDueForAnonymization(ctx, now, 1)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+
+	due, err = repos.Users.ListUsersDueForAnonymization(ctx, now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 3)
+}
+
+func testAnonymizeUser(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	s := newSeed("anonym001")
+	created := mustCreate(t, repos.Users, s)
+	keep := newSeed("keeper001")
+	kept := mustCreate(t, repos.Users, keep)
+	export, err := repos.DataExports.CreateDataExport(ctx, created.Id, userentity.DataExportFormatJSON, now)
+	require.NoError(t, err)
+	_, err = repos.Users.UpdateAvatar(ctx, created.Id, "avatars/anonym001", now)
+	require.NoError(t, err)
+
+	// Only due pending_deletion accounts can be anonymized.
+	_, err = repos.Users.AnonymizeUser(ctx, created.Id, now)
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	scheduleDeletion(t, repos.Users, created.Id, now.Add(time.Hour))
+	_, err = repos.Users.AnonymizeUser(ctx, created.Id, now)
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	anonymized, err := repos.Users.AnonymizeUser(ctx, created.Id, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, created.Id, anonymized.Id)
+	require.Equal(t, userentity.AccountStatusDeleted, anonymized.Status)
+	require.NotEqual(t, s.Username, anonymized.Username)
+	require.NotEqual(t, s.Email, anonymized.Email)
+	require.Empty(t, anonymized.Name)
+	require.Empty(t, anonymized.DocumentID)
+	require.Empty(t, anonymized.PhoneNumber)
+	require.Empty(t, anonymized.PermanentAddress)
+	require.Empty(t, anonymized.AvatarKey)
+	require.True(t, anonymized.DeletionScheduledAt.IsZero())
+
+	_, err = repos.Users.GetUser(ctx, s.Username)
+	requireNotFound(t, err)
+	_, err = repos.Users.GetUserByEmail(ctx, s.Email)
+	requireNotFound(t, err)
+	_, _, err = repos.Users.GetLoginInfo(ctx, s.Username)
+	requireNotFound(t, err)
+	_, _, err = repos.Users.GetLoginInfo(ctx, anonymized.Username)
+	requireNotFound(t, err)
+	_, _, err = repos.Users.FindVerificationToken(ctx, s.Token)
+	requireNotFound(t, err)
+	_, err = repos.Users.GetUser(ctx, keep.Username)
+	require.NoError(t, err)
+
+	// Exports are removed and outbox payloads no longer carry the address.
+	_, err = repos.DataExports.GetDataExport(ctx, created.Id, export.ID)
+	requireNotFound(t, err)
+	data, err := repos.DataExports.GetPersonalData(ctx, created.Id)
+	require.NoError(t, err)
+	require.Empty(t, data.VerificationTokens)
+	require.NotEmpty(t, data.OutboxEvents)
+	for _, event := range data.OutboxEvents {
+		require.NotContains(t, string(event.Payload), s.Email)
+	}
+	keptData, err := repos.DataExports.GetPersonalData(ctx, kept.Id)
+	require.NoError(t, err)
+	require.Contains(t, string(keptData.OutboxEvents[0].Payload), keep.Email)
+
+	users, _, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10, Filter: ports.ListUsersFilter{
+		Statuses: []userentity.AccountStatus{userentity.AccountStatusDeleted},
+	}})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, created.Id, users[0].Id)
+
+	// Deleted accounts cannot be restored or anonymized twice.
+	_, err = repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: created.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusDeactivated, userentity.AccountStatusPendingDeletion},
+		To:     userentity.AccountStatusActive,
+	})
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+	_, err = repos.Users.AnonymizeUser(ctx, created.Id, now.Add(time.Hour))
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	// The username and email become available again.
+	require.NoError(t, repos.Users.CheckUserNameAndEmailIsExist(ctx, s.Username, s.Email))
+	mustCreate(t, repos.Users, s)
+}
+
+func testGetUser(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("getter01")
+	created := mustCreate(t, repos.Users, s)
+
+	got, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, created.Id, got.Id)
+	require.Equal(t, s.Email, got.Email)
+
+	_, err = repos.Users.GetUser(ctx, "missing01")
+	requireNotFound(t, err)
+}
+
+func testGetUserByEmail(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("emailer01")
+	created := mustCreate(t, repos.Users, s)
+
+	got, err := repos.Users.GetUserByEmail(ctx, s.Email)
+	require.NoError(t, err)
+	require.Equal(t, created.Id, got.Id)
+	require.Equal(t, s.Username, got.Username)
+
+	_, err = repos.Users.GetUserByEmail(ctx, "missing@example.com")
+	requireNotFound(t, err)
+}
+
+func testListUsers(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+
+	users, total, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, users)
+	require.Zero(t, total)
+
+	// Insert out of alphabetical order: results must follow creation (id) order.
+	names := []string{"zulu0001", "alpha001", "mike0001", "bravo001", "echo0001"}
+	for _, name := range names {
+		mustCreate(t, repos.Users, newSeed(name))
+	}
+
+	users, total, err = repos.Users.ListUsers(ctx, ports.ListUsersParams{Offset: 0, Limit: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, len(names), total)
+	require.Len(t, users, 2)
+	require.Equal(t, names[0], users[0].Username)
+	require.Equal(t, names[1], users[1].Username)
+
+	users, _, err = repos.Users.ListUsers(ctx, ports.ListUsersParams{Offset: 4, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, names[4], users[0].Username)
+
+	users, total, err = repos.Users.ListUsers(ctx, ports.ListUsersParams{Offset: 50, Limit: 2})
+	require.NoError(t, err)
+	require.Empty(t, users)
+	require.EqualValues(t, len(names), total)
+
+	users, _, err = repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, users, len(names))
+	for i := 1; i < len(users); i++ {
+		require.Less(t, users[i-1].Id, users[i].Id)
+	}
+}
+
+func testListUsersBounds(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	const seeded = 105
+	for i := 0; i < seeded; i++ {
+		mustCreate(t, repos.Users, newSeed(fmt.Sprintf("bounds%03d", i)))
+	}
+
+	users, total, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{})
+	require.NoError(t, err)
+	require.EqualValues(t, seeded, total)
+	require.Len(t, users, 20, "non-positive limit defaults to 20")
+
+	users, _, err = repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 500})
+	require.NoError(t, err)
+	require.Len(t, users, 100, "limit is capped at 100")
+
+	users, _, err = repos.Users.ListUsers(ctx, ports.ListUsersParams{Offset: -5, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, "bounds000", users[0].Username, "negative offset starts from the beginning")
+}
+
+func testListUsersFilters(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+
+	for i, name := range []string{"alpha001", "alpine01", "bravo001", "charlie1", "al_pha01"} {
+		s := newSeed(name)
+		params := createParams(s)
+		params.User.PhoneNumber = fmt.Sprintf("090000000%d", i)
+		_, err := repos.Users.CreateUserWithVerification(ctx, params)
+		require.NoError(t, err)
+		if i%2 == 0 {
+			mustVerify(t, repos.Users, s)
+		}
+	}
+
+	usernames := func(users []userentity.User) []string {
+		names := make([]string, 0, len(users))
+		for _, u := range users {
+			names = append(names, u.Username)
+		}
+		return names
+	}
+	verified, unverified := true, false
+
+	tests := []struct {
+		name   string
+		filter ports.ListUsersFilter
+		want   []string
+	}{
+		{"verified", ports.ListUsersFilter{Verified: &verified}, []string{"alpha001", "bravo001", "al_pha01"}},
+		{"unverified", ports.ListUsersFilter{Verified: &unverified}, []string{"alpine01", "charlie1"}},
+		{"username prefix", ports.ListUsersFilter{UsernamePrefix: "alp"}, []string{"alpha001", "alpine01"}},
+		{"prefix wildcards are literal", ports.ListUsersFilter{UsernamePrefix: "al_"}, []string{"al_pha01"}},
+		{"email prefix", ports.ListUsersFilter{EmailPrefix: "bravo001@"}, []string{"bravo001"}},
+		{"name prefix", ports.ListUsersFilter{NamePrefix: "Conformance c"}, []string{"charlie1"}},
+		{"phone number", ports.ListUsersFilter{PhoneNumber: "0900000003"}, []string{"charlie1"}},
+		{"phone number is not a prefix", ports.ListUsersFilter{PhoneNumber: "090000000"}, []string{}},
+		{"document id", ports.ListUsersFilter{DocumentID: "DOC-bravo001"}, []string{"bravo001"}},
+		{"query skips encrypted columns", ports.ListUsersFilter{Query: "0900"}, []string{}},
+		{"query", ports.ListUsersFilter{Query: "pha"}, []string{"alpha001", "al_pha01"}},
+		{"combined", ports.ListUsersFilter{Verified: &verified, Query: "al"}, []string{"alpha001", "al_pha01"}},
+		{"created range", ports.ListUsersFilter{
+			CreatedAfter:  time.Now().Add(-time.Hour),
+			CreatedBefore: time.Now().Add(time.Hour),
+		}, []string{"alpha001", "alpine01", "bravo001", "charlie1", "al_pha01"}},
+		{"created before", ports.ListUsersFilter{CreatedBefore: time.Now().Add(-time.Hour)}, []string{}},
+	}
+	for _, tt := range tests {
+		users, total, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10, Filter: tt.filter})
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.want, usernames(users), tt.name)
+		require.EqualValues(t, len(tt.want), total, tt.name)
+	}
+}
+
+func testListUsersKeyset(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+
+	names := []string{"kilo0001", "delta001", "xray0001", "golf0001", "papa0001"}
+	for _, name := range names {
+		mustCreate(t, repos.Users, newSeed(name))
+	}
+
+	for _, sort := range []ports.ListUsersSort{
+		{Field: ports.ListUsersSortUsername},
+		{Field: ports.ListUsersSortEmail, Desc: true},
+		{Field: ports.ListUsersSortCreatedAt, Desc: true},
+		{Field: ports.ListUsersSortID},
+	} {
+		all, _, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10, Sort: sort})
+		require.NoError(t, err)
+		require.Len(t, all, len(names))
+
+		var paged []userentity.User
+		params := ports.ListUsersParams{Limit: 2, Sort: sort}
+		for {
+			page, total, err := repos.Users.ListUsers(ctx, params)
+			require.NoError(t, err)
+			require.EqualValues(t, len(names), total)
+			if len(page) == 0 {
+				break
+			}
+			paged = append(paged, page...)
+			last := page[len(page)-1]
+			params.After = &ports.ListUsersCursor{
+				ID:        last.Id,
+				Username:  last.Username,
+				Email:     last.Email,
+				Name:      last.Name,
+				CreatedAt: last.CreatedAt,
+			}
+			params.Offset = 50 // ignored once After is set
+		}
+		require.Equal(t, all, paged, "sort %+v", sort)
+	}
+
+	users, _, err := repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10, Sort: ports.ListUsersSort{Field: ports.ListUsersSortUsername}})
+	require.NoError(t, err)
+	require.Equal(t, "delta001", users[0].Username)
+	require.Equal(t, "xray0001", users[len(users)-1].Username)
+
+	users, _, err = repos.Users.ListUsers(ctx, ports.ListUsersParams{Limit: 10, Sort: ports.ListUsersSort{Field: ports.ListUsersSortCreatedAt, Desc: true}})
+	require.NoError(t, err)
+	for i := 1; i < len(users); i++ {
+		require.False(t, users[i].CreatedAt.After(users[i-1].CreatedAt))
+		if users[i].CreatedAt.Equal(users[i-1].CreatedAt) {
+			require.Less(t, users[i].Id, users[i-1].Id, "ties are broken by id in the sort direction")
+		}
+	}
+}
+
+func testUpdateUser(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("updater01")
+	mustCreate(t, repos.Users, s)
+	mustVerify(t, repos.Users, s)
+
+	current, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+
+	changed := current
+	changed.Name = "Updated Name"
+	changed.Email = "updated01@example.com"
+	changed.DocumentID = "DOC-UPDATED"
+	changed.Birthday = time.Date(1985, time.March, 15, 0, 0, 0, 0, time.UTC)
+	changed.Gender = false
+	changed.PermanentAddress = "2 Updated Avenue"
+	changed.PhoneNumber = "0987654321"
+	changed.UpdatedAt = time.Time{}
+	require.NoError(t, repos.Users.UpdateUser(ctx, s.Username, changed))
+
+	got, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, current.Id, got.Id)
+	require.Equal(t, s.Username, got.Username)
+	require.Equal(t, changed.Name, got.Name)
+	require.Equal(t, changed.Email, got.Email)
+	require.Equal(t, changed.DocumentID, got.DocumentID)
+	require.Equal(t, changed.Gender, got.Gender)
+	require.Equal(t, changed.PermanentAddress, got.PermanentAddress)
+	require.Equal(t, changed.PhoneNumber, got.PhoneNumber)
+	require.True(t, got.Verified, "profile updates must not reset verification")
+	sameDay(t, changed.Birthday, got.Birthday)
+
+	byEmail, err := repos.Users.GetUserByEmail(ctx, changed.Email)
+	require.NoError(t, err)
+	require.Equal(t, current.Id, byEmail.Id)
+	_, err = repos.Users.GetUserByEmail(ctx, s.Email)
+	requireNotFound(t, err)
+
+	requireNotFound(t, repos.Users.UpdateUser(ctx, "missing01", changed))
+}
+
+func testUpdateUserEmailConflict(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	first := newSeed("first001")
+	second := newSeed("second01")
+	mustCreate(t, repos.Users, first)
+	mustCreate(t, repos.Users, second)
+
+	current, err := repos.Users.GetUser(ctx, second.Username)
+	require.NoError(t, err)
+
+	// Keeping the same email is not a conflict.
+	require.NoError(t, repos.Users.UpdateUser(ctx, second.Username, current))
+
+	current, err = repos.Users.GetUser(ctx, second.Username)
+	require.NoError(t, err)
+	current.Email = first.Email
+	requireConflict(t, repos.Users.UpdateUser(ctx, second.Username, current))
+
+	got, err := repos.Users.GetUser(ctx, second.Username)
+	require.NoError(t, err)
+	require.Equal(t, second.Email, got.Email)
+}
+
+func testUpdateUserVersion(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("version01")
+	created := mustCreate(t, repos.Users, s)
+	require.EqualValues(t, 1, created.Version, "new users start at version 1")
+
+	current, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, current.Version)
+
+	stale := current
+	current.Name = "First Writer"
+	require.NoError(t, repos.Users.UpdateUser(ctx, s.Username, current))
+
+	got, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, got.Version)
+
+	stale.Name = "Second Writer"
+	err = repos.Users.UpdateUser(ctx, s.Username, stale)
+	require.ErrorIs(t, err, apperrors.ErrAborted, "a stale version must not overwrite newer data")
+
+	got, err = repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, "First Writer", got.Name)
+
+	// Version 0 skips the check but still bumps the version.
+	got.Name = "Unconditional"
+	got.Version = 0
+	require.NoError(t, repos.Users.UpdateUser(ctx, s.Username, got))
+	got, err = repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, got.Version)
+
+	verified := mustVerify(t, repos.Users, s)
+	require.EqualValues(t, 4, verified.Version, "verification changes the profile and bumps the version")
+}
+
+func testUpdateAvatar(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	s := newSeed("avatar001")
+	created := mustCreate(t, repos.Users, s)
+
+	updated, err := repos.Users.UpdateAvatar(ctx, created.Id, "avatars/1/first", now)
+	require.NoError(t, err)
+	require.Equal(t, "avatars/1/first", updated.AvatarKey)
+	require.Equal(t, created.Version+1, updated.Version, "a new avatar changes the profile")
+
+	got, err := repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, "avatars/1/first", got.AvatarKey)
+
+	// Profile updates keep the avatar.
+	got.Name = "Renamed"
+	require.NoError(t, repos.Users.UpdateUser(ctx, s.Username, got))
+	got, err = repos.Users.GetUser(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, "avatars/1/first", got.AvatarKey)
+
+	removed, err := repos.Users.UpdateAvatar(ctx, created.Id, "", now)
+	require.NoError(t, err)
+	require.Empty(t, removed.AvatarKey)
+
+	_, err = repos.Users.UpdateAvatar(ctx, created.Id+1000, "avatars/x", now)
+	requireNotFound(t, err)
+	_, err = repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: created.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusActive},
+		To:     userentity.AccountStatusDeactivated,
+		At:     now,
+	})
+	require.NoError(t, err)
+	_, err = repos.Users.UpdateAvatar(ctx, created.Id, "avatars/1/second", now)
+	requireNotFound(t, err)
+}
+
+func emailChangeParams(userID int64, newEmail string) ports.RequestEmailChangeParams {
+	now := time.Now().UTC().Truncate(time.Second)
+	event := func(eventType string) userentity.OutboxEvent {
+		return userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     eventType,
+			Payload:       []byte(fmt.Sprintf(`{"email":%q,"purpose":"email_change"}`, newEmail)),
+			Status:        userentity.OutboxEventStatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+	}
+	return ports.RequestEmailChangeParams{
+		UserID: userID,
+		Token: userentity.VerificationToken{
+			Token:     uuid.NewString(),
+			Purpose:   userentity.VerificationPurposeEmailChange,
+			NewEmail:  newEmail,
+			ExpiresAt: now.Add(time.Hour),
+			CreatedAt: now,
+		},
+		OutboxEvents: []userentity.OutboxEvent{
+			event("user.email_change.confirm"),
+			event("user.email_change.notice"),
+		},
+	}
+}
+
+func testRequestEmailChange(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := newSeed("emailchg01")
+	other := newSeed("emailchg02")
+	created := mustCreate(t, repos.Users, owner)
+	mustCreate(t, repos.Users, other)
+	mustVerify(t, repos.Users, owner)
+	latestRegister, err := repos.Users.GetLatestVerificationToken(ctx, created.Id)
+	require.NoError(t, err)
+
+	requireConflict(t, repos.Users.RequestEmailChange(ctx, emailChangeParams(created.Id, other.Email)))
+	requireNotFound(t, repos.Users.RequestEmailChange(ctx, emailChangeParams(created.Id+1000, "nobody@example.com")))
+
+	first := emailChangeParams(created.Id, "changed01@example.com")
+	require.NoError(t, repos.Users.RequestEmailChange(ctx, first))
+	if repos.LatestOutboxEventID != nil {
+		latestOutboxEventID(t, repos, created.Id)
+	}
+
+	vt, u, err := repos.Users.FindVerificationToken(ctx, first.Token.Token)
+	require.NoError(t, err)
+	require.Equal(t, userentity.VerificationPurposeEmailChange, vt.Purpose)
+	require.Equal(t, "changed01@example.com", vt.NewEmail)
+	require.Nil(t, vt.ConsumedAt)
+	require.Equal(t, owner.Email, u.Email, "requesting a change must not touch the stored email")
+
+	second := emailChangeParams(created.Id, "changed02@example.com")
+	require.NoError(t, repos.Users.RequestEmailChange(ctx, second))
+	vt, _, err = repos.Users.FindVerificationToken(ctx, first.Token.Token)
+	require.NoError(t, err)
+	require.NotNil(t, vt.ConsumedAt, "a new request supersedes the pending one")
+
+	latest, err := repos.Users.GetLatestVerificationToken(ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, latestRegister.ID, latest.ID, "email change tokens are not verification tokens")
+}
+
+func testConfirmEmailChange(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := newSeed("emailcfm01")
+	other := newSeed("emailcfm02")
+	created := mustCreate(t, repos.Users, owner)
+	mustCreate(t, repos.Users, other)
+	verified := mustVerify(t, repos.Users, owner)
+
+	params := emailChangeParams(created.Id, "confirmed01@example.com")
+	require.NoError(t, repos.Users.RequestEmailChange(ctx, params))
+	vt, _, err := repos.Users.FindVerificationToken(ctx, params.Token.Token)
+	require.NoError(t, err)
+
+	registerToken, _, err := repos.Users.FindVerificationToken(ctx, owner.Token)
+	require.NoError(t, err)
+	_, err = repos.Users.ConfirmEmailChange(ctx, registerToken.ID, created.Id, time.Now().UTC())
+	requireNotFound(t, err)
+
+	updated, err := repos.Users.ConfirmEmailChange(ctx, vt.ID, created.Id, time.Now().UTC().Truncate(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, "confirmed01@example.com", updated.Email)
+	require.True(t, updated.Verified)
+	require.Equal(t, verified.Version+1, updated.Version)
+
+	byEmail, err := repos.Users.GetUserByEmail(ctx, "confirmed01@example.com")
+	require.NoError(t, err)
+	require.Equal(t, created.Id, byEmail.Id)
+	_, err = repos.Users.GetUserByEmail(ctx, owner.Email)
+	requireNotFound(t, err)
+
+	_, err = repos.Users.ConfirmEmailChange(ctx, vt.ID, created.Id, time.Now().UTC())
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "a confirmed token cannot be reused")
+
+	// The address may be taken between request and confirmation.
+	race := emailChangeParams(created.Id, "raced01@example.com")
+	require.NoError(t, repos.Users.RequestEmailChange(ctx, race))
+	raceToken, _, err := repos.Users.FindVerificationToken(ctx, race.Token.Token)
+	require.NoError(t, err)
+	otherUser, err := repos.Users.GetUser(ctx, other.Username)
+	require.NoError(t, err)
+	otherUser.Email = "raced01@example.com"
+	require.NoError(t, repos.Users.UpdateUser(ctx, other.Username, otherUser))
+	_, err = repos.Users.ConfirmEmailChange(ctx, raceToken.ID, created.Id, time.Now().UTC())
+	requireConflict(t, err)
+
+	got, err := repos.Users.GetUser(ctx, owner.Username)
+	require.NoError(t, err)
+	require.Equal(t, "confirmed01@example.com", got.Email)
+}
+
+func testUpdatePassword(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	s := newSeed("password01")
+	mustCreate(t, repos.Users, s)
+
+	require.NoError(t, repos.Users.UpdatePassword(ctx, s.Username, "new-hash"))
+	login, _, err := repos.Users.GetLoginInfo(ctx, s.Username)
+	require.NoError(t, err)
+	require.Equal(t, "new-hash", login.Password)
+
+	requireNotFound(t, repos.Users.UpdatePassword(ctx, "missing01", "new-hash"))
+}
+
+func latestOutboxEventID(t *testing.T, repos Repositories, aggregateID int64) int64 {
+	t.Helper()
+	require.NotNil(t, repos.LatestOutboxEventID, "Repositories.LatestOutboxEventID is required for outbox tests")
+	id, err := repos.LatestOutboxEventID(context.Background(), aggregateID)
+	require.NoError(t, err)
+	require.NotZero(t, id)
+	return id
+}
+
+func testUpdateOutboxStatus(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("outbox01"))
+	eventID := latestOutboxEventID(t, repos, created.Id)
+
+	for _, status := range []string{"processed", "failed", "pending", " PROCESSED "} {
+		require.NoError(t, repos.Outbox.UpdateOutboxStatus(ctx, eventID, status), status)
+	}
+}
+
+func testUpdateOutboxStatusInvalid(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	created := mustCreate(t, repos.Users, newSeed("outbox02"))
+	eventID := latestOutboxEventID(t, repos, created.Id)
+
+	for _, status := range []string{"", "done", "processing"} {
+		require.ErrorIs(t, repos.Outbox.UpdateOutboxStatus(ctx, eventID, status), apperrors.ErrInvalidArgument, status)
+	}
+}
+
+func testUpdateOutboxStatusUnknownEvent(t *testing.T, repos Repositories) {
+	requireNotFound(t, repos.Outbox.UpdateOutboxStatus(context.Background(), 987654, "processed"))
+}
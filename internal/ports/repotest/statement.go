@@ -0,0 +1,115 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// RunStatementRepositoryTests exercises every ports.StatementRepository
+// method.
+func RunStatementRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateAndGetStatement", testCreateAndGetStatement},
+		{"CreateStatementValidation", testCreateStatementValidation},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func newStatement(userID int64, from time.Time) userentity.Statement {
+	period := from.Format(userentity.StatementPeriodLayout)
+	return userentity.Statement{
+		UserID:      userID,
+		Period:      period,
+		From:        from,
+		To:          from.AddDate(0, 1, 0),
+		OpeningCash: 10_000_000,
+		ClosingCash: 8_500_000,
+		Fees:        15_000,
+		Taxes:       8_000,
+		CSVKey:      "statements/" + period + ".csv",
+		PDFKey:      "statements/" + period + ".pdf",
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func testCreateAndGetStatement(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("stmt001"))
+	september := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := repos.Statements.GetStatement(ctx, owner.Id, "2026-09")
+	requireNotFound(t, err)
+
+	created, err := repos.Statements.CreateStatement(ctx, newStatement(owner.Id, september))
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+
+	got, err := repos.Statements.GetStatement(ctx, owner.Id, "2026-09")
+	require.NoError(t, err)
+	require.Equal(t, created.ID, got.ID)
+	require.Equal(t, owner.Id, got.UserID)
+	require.Equal(t, "2026-09", got.Period)
+	require.True(t, created.From.Equal(got.From))
+	require.True(t, created.To.Equal(got.To))
+	require.Equal(t, int64(10_000_000), got.OpeningCash)
+	require.Equal(t, int64(8_500_000), got.ClosingCash)
+	require.Equal(t, int64(15_000), got.Fees)
+	require.Equal(t, int64(8_000), got.Taxes)
+	require.Equal(t, "statements/2026-09.csv", got.CSVKey)
+	require.Equal(t, "statements/2026-09.pdf", got.PDFKey)
+	require.True(t, created.CreatedAt.Equal(got.CreatedAt))
+
+	again := newStatement(owner.Id, september)
+	again.ClosingCash = 1
+	again.CSVKey = "statements/other.csv"
+	existing, err := repos.Statements.CreateStatement(ctx, again)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, existing.ID, "a period has one statement")
+	require.Equal(t, int64(8_500_000), existing.ClosingCash)
+	require.Equal(t, "statements/2026-09.csv", existing.CSVKey)
+
+	next, err := repos.Statements.CreateStatement(ctx, newStatement(owner.Id, september.AddDate(0, 1, 0)))
+	require.NoError(t, err)
+	require.NotEqual(t, created.ID, next.ID)
+
+	_, err = repos.Statements.GetStatement(ctx, owner.Id+1000, "2026-09")
+	requireNotFound(t, err)
+}
+
+func testCreateStatementValidation(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("stmt002"))
+	september := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	invalid := []func(s *userentity.Statement){
+		func(s *userentity.Statement) { s.Period = "2026-9" },
+		func(s *userentity.Statement) { s.Period = "September" },
+		func(s *userentity.Statement) { s.To = s.From },
+		func(s *userentity.Statement) { s.CSVKey = "" },
+		func(s *userentity.Statement) { s.PDFKey = "" },
+	}
+	for _, mutate := range invalid {
+		statement := newStatement(owner.Id, september)
+		mutate(&statement)
+		_, err := repos.Statements.CreateStatement(ctx, statement)
+		require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	}
+
+	_, err := repos.Statements.CreateStatement(ctx, newStatement(owner.Id+1000, september))
+	requireNotFound(t, err)
+}
@@ -0,0 +1,311 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// RunTradingAccountRepositoryTests exercises every
+// ports.TradingAccountRepository method and the ledger entries and
+// settlements of fills.
+func RunTradingAccountRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"PostLedgerEntries", testPostLedgerEntries},
+		{"SetTradingTier", testSetTradingTier},
+		{"FillsPostTrades", testFillsPostTrades},
+		{"SettleDue", testSettleDue},
+		{"ListLedgerEntries", testListLedgerEntries},
+		{"DeletionRequiresEmptyAccount", testDeletionRequiresEmptyAccount},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func testPostLedgerEntries(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("ledger001"))
+	repos.AddStock(t, userentity.Stock{Code: "VNM", Name: "Vinamilk", CompanyName: "Vietnam Dairy Products JSC"})
+	repos.AddStock(t, userentity.Stock{Code: "FPT", Name: "FPT", CompanyName: "FPT Corporation"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	account, err := repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, userentity.TradingAccount{UserID: owner.Id, Tier: userentity.DefaultTradingTier, Positions: []userentity.Position{}}, account)
+
+	opening := []userentity.LedgerEntry{
+		{UserID: owner.Id, Amount: 50_000_000, Type: userentity.LedgerEntryAdjustment, Note: "opening balance", CreatedAt: at},
+		{UserID: owner.Id, Code: "VNM", Amount: 300, Type: userentity.LedgerEntryAdjustment, CreatedAt: at},
+		{UserID: owner.Id, Code: "FPT", Amount: 100, Type: userentity.LedgerEntryAdjustment, CreatedAt: at},
+	}
+	posted, err := repos.Accounts.PostLedgerEntries(ctx, "opening:ledger001", opening)
+	require.NoError(t, err)
+	require.Len(t, posted, 3)
+	require.NotZero(t, posted[0].ID)
+	require.Equal(t, "opening:ledger001", posted[0].Reference)
+	require.Equal(t, "opening balance", posted[0].Note)
+	require.Zero(t, posted[0].StockID)
+	require.NotZero(t, posted[1].StockID)
+	require.Equal(t, "VNM", posted[1].Code)
+	require.True(t, at.Equal(posted[1].CreatedAt))
+
+	again, err := repos.Accounts.PostLedgerEntries(ctx, "opening:ledger001", opening[:1])
+	require.NoError(t, err)
+	require.Len(t, again, 3, "a reference is posted once")
+	require.Equal(t, posted[2].ID, again[2].ID)
+
+	_, err = repos.Accounts.PostLedgerEntries(ctx, "sale:ledger001", []userentity.LedgerEntry{
+		{UserID: owner.Id, Code: "FPT", Amount: -100, Type: userentity.LedgerEntryAdjustment, CreatedAt: at},
+		{UserID: owner.Id, Amount: -1_000_000, Type: userentity.LedgerEntryWithdrawal, CreatedAt: at},
+	})
+	require.NoError(t, err)
+
+	account, err = repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(49_000_000), account.Cash)
+	require.Len(t, account.Positions, 1, "positions of zero are left out")
+	require.Equal(t, "VNM", account.Positions[0].Code)
+	require.Equal(t, int64(300), account.Positions[0].Quantity)
+	require.Equal(t, int64(300), account.Shares("VNM"))
+
+	_, err = repos.Accounts.PostLedgerEntries(ctx, "bad:ledger001", []userentity.LedgerEntry{
+		{UserID: owner.Id, Amount: 1, Type: userentity.LedgerEntryAdjustment},
+		{UserID: owner.Id, Code: "XXX", Amount: 1, Type: userentity.LedgerEntryAdjustment},
+	})
+	require.ErrorIs(t, err, apperrors.ErrNotFound, "unknown stock")
+	account, err = repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(49_000_000), account.Cash, "a failed posting stores nothing")
+
+	_, err = repos.Accounts.PostLedgerEntries(ctx, "bad:ledger001", []userentity.LedgerEntry{{UserID: owner.Id + 1000, Amount: 1, Type: userentity.LedgerEntryAdjustment}})
+	require.ErrorIs(t, err, apperrors.ErrNotFound, "unknown user")
+	_, err = repos.Accounts.PostLedgerEntries(ctx, "", opening)
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	_, err = repos.Accounts.PostLedgerEntries(ctx, "bad:ledger001", []userentity.LedgerEntry{{UserID: owner.Id, Type: userentity.LedgerEntryAdjustment}})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument, "zero amount")
+	_, err = repos.Accounts.GetTradingAccount(ctx, owner.Id+1000)
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+}
+
+func testSetTradingTier(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("ledger002"))
+	at := time.Now().UTC().Truncate(time.Second)
+
+	account, err := repos.Accounts.SetTradingTier(ctx, owner.Id, "vip", at)
+	require.NoError(t, err)
+	require.Equal(t, "vip", account.Tier)
+	account, err = repos.Accounts.SetTradingTier(ctx, owner.Id, "professional", at)
+	require.NoError(t, err)
+	require.Equal(t, "professional", account.Tier)
+	account, err = repos.Accounts.GetTradingAccount(ctx, owner.Id)
+	require.NoError(t, err)
+	require.Equal(t, "professional", account.Tier)
+
+	_, err = repos.Accounts.SetTradingTier(ctx, owner.Id+1000, "vip", at)
+	require.ErrorIs(t, err, apperrors.ErrNotFound)
+	_, err = repos.Accounts.SetTradingTier(ctx, owner.Id, "", at)
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+}
+
+func testFillsPostTrades(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	buyer := mustCreate(t, repos.Users, newSeed("ledger003"))
+	seller := mustCreate(t, repos.Users, newSeed("ledger004"))
+	repos.AddStock(t, userentity.Stock{Code: "HPG", Name: "Hoa Phat", CompanyName: "Hoa Phat Group"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	buy, err := repos.Orders.CreateOrder(ctx, newOrder(buyer.Id, "HPG", userentity.OrderSideBuy, userentity.TimeInForceGTC, 28000, 300, at), nil)
+	require.NoError(t, err)
+	sell, err := repos.Orders.CreateOrder(ctx, newOrder(seller.Id, "HPG", userentity.OrderSideSell, userentity.TimeInForceGTC, 27500, 200, at), nil)
+	require.NoError(t, err)
+
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "e1", Price: 27900, Quantity: 100, At: at})
+	require.NoError(t, err)
+	_, err = repos.Orders.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "e1", Price: 27900, Quantity: 100, At: at})
+	require.NoError(t, err, "a repeated fill posts nothing")
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{BuyOrderID: buy.ID, SellOrderID: sell.ID, ExecutionID: "m1", Price: 27800, Quantity: 200, At: at})
+	require.NoError(t, err)
+
+	account, err := repos.Accounts.GetTradingAccount(ctx, buyer.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(-(27900*100 + 27800*200)), account.Cash)
+	require.Equal(t, int64(300), account.Shares("HPG"))
+
+	account, err = repos.Accounts.GetTradingAccount(ctx, seller.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(27800*200), account.Cash)
+	require.Equal(t, int64(-200), account.Shares("HPG"))
+}
+
+func testSettleDue(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	buyer := mustCreate(t, repos.Users, newSeed("ledger005"))
+	seller := mustCreate(t, repos.Users, newSeed("ledger006"))
+	repos.AddStock(t, userentity.Stock{Code: "MWG", Name: "Mobile World", CompanyName: "Mobile World Investment Corporation"})
+	at := time.Now().UTC().Truncate(time.Second)
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:ledger006", []userentity.LedgerEntry{
+		{UserID: seller.Id, Code: "MWG", Amount: 500, Type: userentity.LedgerEntryAdjustment, CreatedAt: at},
+	})
+	require.NoError(t, err)
+
+	buy, err := repos.Orders.CreateOrder(ctx, newOrder(buyer.Id, "MWG", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 500, at), nil)
+	require.NoError(t, err)
+	sell, err := repos.Orders.CreateOrder(ctx, newOrder(seller.Id, "MWG", userentity.OrderSideSell, userentity.TimeInForceGTC, 60000, 500, at), nil)
+	require.NoError(t, err)
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{BuyOrderID: buy.ID, SellOrderID: sell.ID, ExecutionID: "s1", Price: 60000, Quantity: 200, At: at, SettlesOn: day})
+	require.NoError(t, err)
+	_, _, err = repos.Orders.RecordOrderMatch(ctx, ports.RecordOrderMatchParams{BuyOrderID: buy.ID, SellOrderID: sell.ID, ExecutionID: "s2", Price: 60000, Quantity: 100, At: at, SettlesOn: day.AddDate(0, 0, 1)})
+	require.NoError(t, err)
+
+	account, err := repos.Accounts.GetTradingAccount(ctx, buyer.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(-60000*300), account.Cash, "cash leaves at the fill")
+	require.Zero(t, account.PendingCash)
+	require.Equal(t, []userentity.Position{{StockID: account.Positions[0].StockID, Code: "MWG", Pending: 300}}, account.Positions, "shares arrive when they settle")
+
+	account, err = repos.Accounts.GetTradingAccount(ctx, seller.Id)
+	require.NoError(t, err)
+	require.Zero(t, account.Cash)
+	require.Equal(t, int64(60000*300), account.PendingCash)
+	require.Equal(t, int64(200), account.Shares("MWG"), "shares leave at the fill")
+
+	pending, err := repos.Accounts.ListPendingSettlements(ctx, seller.Id)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, int64(60000*200), pending[0].Amount)
+	require.True(t, pending[0].Cash())
+	require.True(t, day.Equal(pending[0].SettlesOn))
+	require.Equal(t, userentity.SettlementStatusPending, pending[0].Status)
+	require.NotEmpty(t, pending[0].Reference)
+	require.True(t, day.AddDate(0, 0, 1).Equal(pending[1].SettlesOn))
+
+	settled, err := repos.Accounts.SettleDue(ctx, day.AddDate(0, 0, -1), at)
+	require.NoError(t, err)
+	require.Zero(t, settled, "nothing is due before the settlement date")
+	settled, err = repos.Accounts.SettleDue(ctx, day.Add(15*time.Hour), at)
+	require.NoError(t, err)
+	require.Equal(t, 2, settled, "the cash of the seller and the shares of the buyer")
+	settled, err = repos.Accounts.SettleDue(ctx, day, at)
+	require.NoError(t, err)
+	require.Zero(t, settled, "settlements settle once")
+
+	account, err = repos.Accounts.GetTradingAccount(ctx, seller.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(60000*200), account.Cash)
+	require.Equal(t, int64(60000*100), account.PendingCash)
+	account, err = repos.Accounts.GetTradingAccount(ctx, buyer.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), account.Positions[0].Quantity)
+	require.Equal(t, int64(100), account.Positions[0].Pending)
+
+	settled, err = repos.Accounts.SettleDue(ctx, day.AddDate(0, 0, 5), at)
+	require.NoError(t, err)
+	require.Equal(t, 2, settled)
+	pending, err = repos.Accounts.ListPendingSettlements(ctx, buyer.Id)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+	account, err = repos.Accounts.GetTradingAccount(ctx, buyer.Id)
+	require.NoError(t, err)
+	require.Equal(t, []userentity.Position{{StockID: account.Positions[0].StockID, Code: "MWG", Quantity: 300}}, account.Positions)
+}
+
+func testListLedgerEntries(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("ledger007"))
+	other := mustCreate(t, repos.Users, newSeed("ledger008"))
+	repos.AddStock(t, userentity.Stock{Code: "VCB", Name: "Vietcombank", CompanyName: "Joint Stock Commercial Bank for Foreign Trade of Vietnam"})
+	at := time.Now().UTC().Truncate(time.Second)
+
+	post := func(reference string, entries ...userentity.LedgerEntry) {
+		t.Helper()
+		_, err := repos.Accounts.PostLedgerEntries(ctx, reference, entries)
+		require.NoError(t, err)
+	}
+	post("list:1", userentity.LedgerEntry{UserID: owner.Id, Amount: 10_000_000, Type: userentity.LedgerEntryDeposit, CreatedAt: at.Add(-2 * time.Hour)})
+	post("list:2",
+		userentity.LedgerEntry{UserID: owner.Id, Code: "VCB", Amount: 100, Type: userentity.LedgerEntryAdjustment, CreatedAt: at.Add(-time.Hour)},
+		userentity.LedgerEntry{UserID: owner.Id, Amount: -9_000_000, Type: userentity.LedgerEntryAdjustment, Note: "transfer", CreatedAt: at.Add(-time.Hour)},
+	)
+	post("list:3", userentity.LedgerEntry{UserID: owner.Id, Amount: -1_000_000, Type: userentity.LedgerEntryWithdrawal, CreatedAt: at})
+	post("list:4", userentity.LedgerEntry{UserID: other.Id, Amount: 5_000_000, Type: userentity.LedgerEntryDeposit, CreatedAt: at.Add(-time.Hour)})
+
+	entries, err := repos.Accounts.ListLedgerEntries(ctx, ports.ListLedgerEntriesParams{UserID: owner.Id, To: at})
+	require.NoError(t, err)
+	require.Len(t, entries, 3, "a zero From lists from the first entry and To is excluded")
+	require.Equal(t, "list:1", entries[0].Reference, "oldest first")
+	require.Equal(t, int64(10_000_000), entries[0].Amount)
+	require.True(t, at.Add(-2*time.Hour).Equal(entries[0].CreatedAt))
+	require.Equal(t, "VCB", entries[1].Code)
+	require.NotZero(t, entries[1].StockID)
+	require.Equal(t, "transfer", entries[2].Note)
+
+	entries, err = repos.Accounts.ListLedgerEntries(ctx, ports.ListLedgerEntriesParams{UserID: owner.Id, From: at.Add(-time.Hour), To: at.Add(time.Second)})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, "list:3", entries[2].Reference)
+
+	entries, err = repos.Accounts.ListLedgerEntries(ctx, ports.ListLedgerEntriesParams{UserID: owner.Id + 1000, To: at.Add(time.Second)})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func testDeletionRequiresEmptyAccount(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	owner := mustCreate(t, repos.Users, newSeed("ledger009"))
+	repos.AddStock(t, userentity.Stock{Code: "VNM", Name: "Vinamilk", CompanyName: "Vietnam Dairy Products JSC"})
+	at := time.Now().UTC().Truncate(time.Second)
+	schedule := func() error {
+		_, err := repos.Users.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+			UserID:              owner.Id,
+			From:                []userentity.AccountStatus{userentity.AccountStatusActive},
+			To:                  userentity.AccountStatusPendingDeletion,
+			At:                  at,
+			DeletionScheduledAt: at,
+		})
+		return err
+	}
+
+	_, err := repos.Accounts.PostLedgerEntries(ctx, "opening:ledger009", []userentity.LedgerEntry{
+		{UserID: owner.Id, Code: "VNM", Amount: 100, Type: userentity.LedgerEntryAdjustment, CreatedAt: at},
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, schedule(), apperrors.ErrFailedPrecondition, "holds shares")
+
+	_, err = repos.Accounts.PostLedgerEntries(ctx, "closing:ledger009", []userentity.LedgerEntry{
+		{UserID: owner.Id, Code: "VNM", Amount: -100, Type: userentity.LedgerEntryAdjustment, CreatedAt: at},
+	})
+	require.NoError(t, err)
+	require.NoError(t, schedule())
+
+	// Cash received while the deletion is pending holds the erasure back.
+	_, err = repos.Accounts.PostLedgerEntries(ctx, "late:ledger009", []userentity.LedgerEntry{
+		{UserID: owner.Id, Amount: 10_000, Type: userentity.LedgerEntryAdjustment, CreatedAt: at},
+	})
+	require.NoError(t, err)
+	_, err = repos.Users.AnonymizeUser(ctx, owner.Id, at)
+	require.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	_, err = repos.Accounts.PostLedgerEntries(ctx, "payout:ledger009", []userentity.LedgerEntry{
+		{UserID: owner.Id, Amount: -10_000, Type: userentity.LedgerEntryAdjustment, CreatedAt: at},
+	})
+	require.NoError(t, err)
+	anonymized, err := repos.Users.AnonymizeUser(ctx, owner.Id, at)
+	require.NoError(t, err)
+	require.Equal(t, userentity.AccountStatusDeleted, anonymized.Status)
+}
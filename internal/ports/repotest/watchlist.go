@@ -0,0 +1,287 @@
+package repotest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// RunStockRepositoryTests exercises every ports.StockRepository method.
+func RunStockRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"GetStocksByCodes", testGetStocksByCodes},
+		{"LatestStockQuotes", testLatestStockQuotes},
+		{"ListStockQuotesAfter", testListStockQuotesAfter},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+// RunWatchlistRepositoryTests exercises every ports.WatchlistRepository
+// method.
+func RunWatchlistRepositoryTests(t *testing.T, newRepos Factory) {
+	t.Helper()
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repos Repositories)
+	}{
+		{"CreateWatchlist", testCreateWatchlist},
+		{"RenameAndDeleteWatchlist", testRenameAndDeleteWatchlist},
+		{"WatchlistStocks", testWatchlistStocks},
+		{"ReorderWatchlist", testReorderWatchlist},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newRepos(t))
+		})
+	}
+}
+
+func addStocks(t *testing.T, repos Repositories, codes ...string) {
+	t.Helper()
+	for _, code := range codes {
+		repos.AddStock(t, userentity.Stock{Code: code, Name: code, CompanyName: code + " Joint Stock Company"})
+	}
+}
+
+func testGetStocksByCodes(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	vnm := repos.AddStock(t, userentity.Stock{Code: "VNM", Name: "Vinamilk", CompanyName: "Vietnam Dairy Products JSC", Sector: "Consumer Staples"})
+	addStocks(t, repos, "FPT")
+
+	stocks, err := repos.Stocks.GetStocksByCodes(ctx, []string{"FPT", "XXX", "VNM"})
+	require.NoError(t, err)
+	require.Len(t, stocks, 2)
+	require.Equal(t, "FPT", stocks[0].Code)
+	require.Equal(t, vnm, stocks[1])
+
+	stocks, err = repos.Stocks.GetStocksByCodes(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, stocks)
+}
+
+func testLatestStockQuotes(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	addStocks(t, repos, "VNM", "FPT", "HPG")
+	at := time.Now().UTC().Truncate(time.Second)
+	repos.RecordStockPrice(t, "VNM", 70000, at.Add(-time.Minute))
+	repos.RecordStockPrice(t, "VNM", 71500, at)
+	repos.RecordStockPrice(t, "FPT", 120000, at)
+
+	quotes, err := repos.Stocks.LatestStockQuotes(ctx, []string{"HPG", "VNM", "FPT", "XXX"})
+	require.NoError(t, err)
+	require.Len(t, quotes, 2, "stocks without a price and unknown codes are skipped")
+	require.Equal(t, "VNM", quotes[0].Code)
+	require.Equal(t, int64(71500), quotes[0].Price)
+	require.True(t, at.Equal(quotes[0].At))
+	require.NotZero(t, quotes[0].StockID)
+	require.Equal(t, "FPT", quotes[1].Code)
+	require.Equal(t, int64(120000), quotes[1].Price)
+
+	quotes, err = repos.Stocks.LatestStockQuotesBefore(ctx, []string{"FPT", "VNM"}, at)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1, "FPT has no price before at")
+	require.Equal(t, "VNM", quotes[0].Code)
+	require.Equal(t, int64(70000), quotes[0].Price)
+}
+
+func testListStockQuotesAfter(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	addStocks(t, repos, "VNM", "FPT")
+	last, err := repos.Stocks.LastStockQuoteID(ctx)
+	require.NoError(t, err)
+	require.Zero(t, last, "no price recorded yet")
+
+	at := time.Now().UTC().Truncate(time.Second)
+	repos.RecordStockPrice(t, "VNM", 70000, at)
+	repos.RecordStockPrice(t, "FPT", 120000, at)
+	repos.RecordStockPrice(t, "VNM", 70500, at.Add(time.Second))
+
+	quotes, err := repos.Stocks.ListStockQuotesAfter(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+	require.Equal(t, "VNM", quotes[0].Code)
+	require.Equal(t, int64(70000), quotes[0].Price)
+	require.NotZero(t, quotes[0].StockID)
+	require.Equal(t, "FPT", quotes[1].Code)
+	require.Greater(t, quotes[1].ID, quotes[0].ID)
+
+	rest, err := repos.Stocks.ListStockQuotesAfter(ctx, quotes[1].ID, 10)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	require.Equal(t, int64(70500), rest[0].Price)
+	require.True(t, at.Add(time.Second).Equal(rest[0].At))
+
+	last, err = repos.Stocks.LastStockQuoteID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, rest[0].ID, last)
+	none, err := repos.Stocks.ListStockQuotesAfter(ctx, last, 10)
+	require.NoError(t, err)
+	require.Empty(t, none)
+
+	latest, err := repos.Stocks.LatestStockQuotes(ctx, []string{"VNM"})
+	require.NoError(t, err)
+	require.Len(t, latest, 1)
+	require.Equal(t, last, latest[0].ID)
+}
+
+func testCreateWatchlist(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	alice := mustCreate(t, repos.Users, newSeed("watch001"))
+	bob := mustCreate(t, repos.Users, newSeed("watch002"))
+
+	created, err := repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id, Name: "banks"})
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+	require.Equal(t, alice.Id, created.UserID)
+	require.Equal(t, "banks", created.Name)
+	require.Empty(t, created.Codes)
+	require.False(t, created.CreatedAt.IsZero())
+
+	_, err = repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id, Name: "banks"})
+	requireConflict(t, err)
+	_, err = repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: bob.Id, Name: "banks"})
+	require.NoError(t, err, "names are unique per user")
+	second, err := repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id, Name: "tech"})
+	require.NoError(t, err)
+
+	_, err = repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id})
+	require.ErrorIs(t, err, apperrors.ErrInvalidArgument)
+	_, err = repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: bob.Id + 1000, Name: "banks"})
+	requireNotFound(t, err)
+
+	got, err := repos.Watchlists.GetWatchlist(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, got.ID)
+	require.Equal(t, "banks", got.Name)
+	_, err = repos.Watchlists.GetWatchlist(ctx, second.ID+1000)
+	requireNotFound(t, err)
+
+	watchlists, err := repos.Watchlists.ListWatchlists(ctx, alice.Id)
+	require.NoError(t, err)
+	require.Len(t, watchlists, 2)
+	require.Equal(t, created.ID, watchlists[0].ID)
+	require.Equal(t, second.ID, watchlists[1].ID)
+}
+
+func testRenameAndDeleteWatchlist(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	alice := mustCreate(t, repos.Users, newSeed("watch003"))
+	addStocks(t, repos, "VNM")
+	banks, err := repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id, Name: "banks"})
+	require.NoError(t, err)
+	tech, err := repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id, Name: "tech"})
+	require.NoError(t, err)
+
+	at := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	renamed, err := repos.Watchlists.RenameWatchlist(ctx, banks.ID, "lenders", at)
+	require.NoError(t, err)
+	require.Equal(t, "lenders", renamed.Name)
+	require.True(t, at.Equal(renamed.UpdatedAt))
+	_, err = repos.Watchlists.RenameWatchlist(ctx, banks.ID, "lenders", at)
+	require.NoError(t, err, "renaming to the current name succeeds")
+	_, err = repos.Watchlists.RenameWatchlist(ctx, banks.ID, "tech", at)
+	requireConflict(t, err)
+	_, err = repos.Watchlists.RenameWatchlist(ctx, tech.ID+1000, "other", at)
+	requireNotFound(t, err)
+
+	_, err = repos.Watchlists.AddWatchlistStock(ctx, tech.ID, "VNM", at)
+	require.NoError(t, err)
+	require.NoError(t, repos.Watchlists.DeleteWatchlist(ctx, tech.ID))
+	_, err = repos.Watchlists.GetWatchlist(ctx, tech.ID)
+	requireNotFound(t, err)
+	requireNotFound(t, repos.Watchlists.DeleteWatchlist(ctx, tech.ID))
+
+	watchlists, err := repos.Watchlists.ListWatchlists(ctx, alice.Id)
+	require.NoError(t, err)
+	require.Len(t, watchlists, 1)
+	require.Equal(t, banks.ID, watchlists[0].ID)
+}
+
+func testWatchlistStocks(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	alice := mustCreate(t, repos.Users, newSeed("watch004"))
+	addStocks(t, repos, "VNM", "FPT", "HPG")
+	watchlist, err := repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id, Name: "core"})
+	require.NoError(t, err)
+	other, err := repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id, Name: "other"})
+	require.NoError(t, err)
+
+	at := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	for _, code := range []string{"VNM", "FPT", "HPG"} {
+		watchlist, err = repos.Watchlists.AddWatchlistStock(ctx, watchlist.ID, code, at)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"VNM", "FPT", "HPG"}, watchlist.Codes)
+	require.True(t, at.Equal(watchlist.UpdatedAt))
+	_, err = repos.Watchlists.AddWatchlistStock(ctx, other.ID, "FPT", at)
+	require.NoError(t, err)
+
+	_, err = repos.Watchlists.AddWatchlistStock(ctx, watchlist.ID, "FPT", at)
+	requireConflict(t, err)
+	_, err = repos.Watchlists.AddWatchlistStock(ctx, watchlist.ID, "XXX", at)
+	requireNotFound(t, err)
+	_, err = repos.Watchlists.AddWatchlistStock(ctx, other.ID+1000, "FPT", at)
+	requireNotFound(t, err)
+
+	watchlist, err = repos.Watchlists.RemoveWatchlistStock(ctx, watchlist.ID, "FPT", at)
+	require.NoError(t, err)
+	require.Equal(t, []string{"VNM", "HPG"}, watchlist.Codes)
+	_, err = repos.Watchlists.RemoveWatchlistStock(ctx, watchlist.ID, "FPT", at)
+	requireNotFound(t, err)
+	watchlist, err = repos.Watchlists.AddWatchlistStock(ctx, watchlist.ID, "FPT", at)
+	require.NoError(t, err)
+	require.Equal(t, []string{"VNM", "HPG", "FPT"}, watchlist.Codes, "codes added again go to the end")
+
+	watchlists, err := repos.Watchlists.ListWatchlists(ctx, alice.Id)
+	require.NoError(t, err)
+	require.Len(t, watchlists, 2)
+	require.Equal(t, []string{"VNM", "HPG", "FPT"}, watchlists[0].Codes)
+	require.Equal(t, []string{"FPT"}, watchlists[1].Codes)
+}
+
+func testReorderWatchlist(t *testing.T, repos Repositories) {
+	ctx := context.Background()
+	alice := mustCreate(t, repos.Users, newSeed("watch005"))
+	addStocks(t, repos, "VNM", "FPT", "HPG")
+	watchlist, err := repos.Watchlists.CreateWatchlist(ctx, userentity.Watchlist{UserID: alice.Id, Name: "core"})
+	require.NoError(t, err)
+	at := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	for _, code := range []string{"VNM", "FPT", "HPG"} {
+		_, err = repos.Watchlists.AddWatchlistStock(ctx, watchlist.ID, code, at)
+		require.NoError(t, err)
+	}
+
+	reordered, err := repos.Watchlists.ReorderWatchlist(ctx, watchlist.ID, []string{"HPG", "VNM", "FPT"}, at)
+	require.NoError(t, err)
+	require.Equal(t, []string{"HPG", "VNM", "FPT"}, reordered.Codes)
+	got, err := repos.Watchlists.GetWatchlist(ctx, watchlist.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"HPG", "VNM", "FPT"}, got.Codes)
+
+	for _, codes := range [][]string{
+		{"HPG", "VNM"},
+		{"HPG", "VNM", "FPT", "SSI"},
+		{"HPG", "VNM", "VNM"},
+		{"HPG", "VNM", "SSI"},
+	} {
+		_, err = repos.Watchlists.ReorderWatchlist(ctx, watchlist.ID, codes, at)
+		require.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "%v", codes)
+	}
+	_, err = repos.Watchlists.ReorderWatchlist(ctx, watchlist.ID+1000, nil, at)
+	requireNotFound(t, err)
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// StatementRepository keeps the generated account statements of users. The
+// files themselves are kept in the blob store.
+type StatementRepository interface {
+	// CreateStatement stores a generated statement. When the user has a
+	// statement for the period already it stores nothing and returns that
+	// one, so concurrent generations agree on a single statement.
+	CreateStatement(ctx context.Context, statement user.Statement) (user.Statement, error)
+
+	// GetStatement returns the statement of the user for period. It fails
+	// with a not found error when none was generated.
+	GetStatement(ctx context.Context, userID int64, period string) (user.Statement, error)
+}
@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// StockRepository reads the stocks catalog and the latest prices recorded
+// for it. Codes are matched exactly; callers upper-case them first.
+type StockRepository interface {
+	// GetStocksByCodes returns the catalog entries of the given codes in the
+	// order of codes. Unknown codes are skipped.
+	GetStocksByCodes(ctx context.Context, codes []string) ([]user.Stock, error)
+
+	// LatestStockQuotes returns the newest price of every given stock in the
+	// order of codes. Stocks without any recorded price are skipped.
+	LatestStockQuotes(ctx context.Context, codes []string) ([]user.StockQuote, error)
+
+	// LatestStockQuotesBefore is LatestStockQuotes over the prices recorded
+	// before the given time, such as the closing prices of the last trading
+	// day.
+	LatestStockQuotesBefore(ctx context.Context, codes []string, before time.Time) ([]user.StockQuote, error)
+
+	// ListStockQuotesAfter returns up to limit price records of any stock with
+	// an ID greater than afterID, oldest first. Consumers of price updates
+	// keep the ID of the last record they handled.
+	ListStockQuotesAfter(ctx context.Context, afterID int64, limit int) ([]user.StockQuote, error)
+
+	// LastStockQuoteID returns the ID of the newest price record, or zero when
+	// no price was recorded.
+	LastStockQuoteID(ctx context.Context) (int64, error)
+}
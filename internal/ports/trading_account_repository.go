@@ -0,0 +1,50 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// ListLedgerEntriesParams selects the ledger entries of a user posted at or
+// after From and before To. A zero From selects from the first entry.
+type ListLedgerEntriesParams struct {
+	UserID int64
+	From   time.Time
+	To     time.Time
+}
+
+// TradingAccountRepository keeps the ledger of cash and shares of users and
+// their trading tiers. Balances are the sums of the ledger; entries are never
+// changed or removed. The order repository posts the entries of fills, and
+// schedules their settlements, in the transaction that records them.
+type TradingAccountRepository interface {
+	// GetTradingAccount returns the tier and balances of a user. It fails
+	// with a not found error when the user does not exist.
+	GetTradingAccount(ctx context.Context, userID int64) (user.TradingAccount, error)
+
+	// SetTradingTier moves a user to tier.
+	SetTradingTier(ctx context.Context, userID int64, tier string, at time.Time) (user.TradingAccount, error)
+
+	// PostLedgerEntries stores entries under reference in one transaction and
+	// returns them with their ids. Entries naming a stock are looked up by
+	// code; a code that is not listed fails with a not found error. A
+	// reference posted before posts nothing and returns the entries stored
+	// then, so postings can be retried.
+	PostLedgerEntries(ctx context.Context, reference string, entries []user.LedgerEntry) ([]user.LedgerEntry, error)
+
+	// ListPendingSettlements returns the pending settlements of a user,
+	// earliest settlement date first, then by id.
+	ListPendingSettlements(ctx context.Context, userID int64) ([]user.Settlement, error)
+
+	// SettleDue settles the pending settlements due on or before the date
+	// through: each one posts a settlement entry to the ledger and becomes
+	// settled at at, in one transaction. It returns how many settled; a
+	// settlement is settled once however often SettleDue runs.
+	SettleDue(ctx context.Context, through time.Time, at time.Time) (int, error)
+
+	// ListLedgerEntries returns the entries of the user in the period, oldest
+	// first.
+	ListLedgerEntries(ctx context.Context, params ListLedgerEntriesParams) ([]user.LedgerEntry, error)
+}
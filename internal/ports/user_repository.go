@@ -7,10 +7,62 @@ import (
 	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 )
 
-// ListUsersParams describes pagination inputs for listing users.
+// ListUsersParams describes filter, sort and pagination inputs for listing
+// users. When After is set the page starts after that keyset position and
+// Offset is ignored.
 type ListUsersParams struct {
 	Offset int
 	Limit  int
+	Filter ListUsersFilter
+	Sort   ListUsersSort
+	After  *ListUsersCursor
+}
+
+// ListUsersFilter narrows ListUsers results. Zero values disable a condition,
+// except Statuses which defaults to active accounts only. Prefix and Query
+// matches are case-insensitive.
+type ListUsersFilter struct {
+	Statuses       []user.AccountStatus
+	Verified       *bool
+	CreatedAfter   time.Time // inclusive
+	CreatedBefore  time.Time // exclusive
+	EmailPrefix    string
+	UsernamePrefix string
+	NamePrefix     string
+	// PhoneNumber and DocumentID match exactly; they are encrypted at rest
+	// and looked up through blind indexes.
+	PhoneNumber string
+	DocumentID  string
+	// Query matches a substring of username, email or name.
+	Query string
+}
+
+// ListUsersSortField names a column users can be ordered by.
+type ListUsersSortField string
+
+const (
+	ListUsersSortID        ListUsersSortField = "id"
+	ListUsersSortUsername  ListUsersSortField = "username"
+	ListUsersSortEmail     ListUsersSortField = "email"
+	ListUsersSortName      ListUsersSortField = "name"
+	ListUsersSortCreatedAt ListUsersSortField = "created_at"
+)
+
+// ListUsersSort orders ListUsers results. Ties are broken by id in the same
+// direction; an empty Field sorts by id.
+type ListUsersSort struct {
+	Field ListUsersSortField
+	Desc  bool
+}
+
+// ListUsersCursor is the keyset position of the last row of a previous page:
+// its id plus the value of the sort field (only that field is read).
+type ListUsersCursor struct {
+	ID        int64
+	Username  string
+	Email     string
+	Name      string
+	CreatedAt time.Time
 }
 
 type CreateUserWithVerificationParams struct {
@@ -26,6 +78,27 @@ type RotateVerificationTokenParams struct {
 	OutboxEvent user.OutboxEvent
 }
 
+// RequestEmailChangeParams describes a pending email change: the confirmation
+// token (carrying the new address) and the outbox events notifying both the
+// new and the old address.
+type RequestEmailChangeParams struct {
+	UserID       int64
+	Token        user.VerificationToken
+	OutboxEvents []user.OutboxEvent
+}
+
+// ChangeAccountStatusParams describes an account state transition. The
+// transition only applies while the account is in one of the From states.
+type ChangeAccountStatusParams struct {
+	UserID int64
+	From   []user.AccountStatus
+	To     user.AccountStatus
+	At     time.Time
+	// DeletionScheduledAt is required when To is pending_deletion and cleared
+	// otherwise.
+	DeletionScheduledAt time.Time
+}
+
 // UserRepository defines the interface for user persistence operations.
 type UserRepository interface {
 	// CheckUserNameAndEmailIsExist ensures username and email are unique before creation.
@@ -46,24 +119,65 @@ type UserRepository interface {
 	// VerifyUserWithToken marks the token as consumed and the user as verified.
 	VerifyUserWithToken(ctx context.Context, tokenID int64, userID int64, verifiedAt time.Time) (user.User, error)
 
-	// GetLoginInfo retrieves login and user information for a username.
-	GetLoginInfo(ctx context.Context, userName string) (user.LoginMethodPassword, user.User, error)
+	// RequestEmailChange stores an email change token, consumes earlier pending
+	// email change tokens of the user and writes the outbox events atomically.
+	// It fails with a conflict when the new email already belongs to a user.
+	RequestEmailChange(ctx context.Context, params RequestEmailChangeParams) error
+
+	// ConfirmEmailChange consumes the email change token and moves the user to
+	// the token's new email, bumping the user version.
+	ConfirmEmailChange(ctx context.Context, tokenID int64, userID int64, confirmedAt time.Time) (user.User, error)
 
-	// DeleteUser removes a user from repository by username.
-	DeleteUser(ctx context.Context, userName string) error
+	// GetLoginInfo retrieves login and user information for a username. Unlike
+	// the other lookups it returns deactivated and pending_deletion accounts so
+	// callers can tell them apart; deleted accounts are not found.
+	GetLoginInfo(ctx context.Context, userName string) (user.LoginMethodPassword, user.User, error)
 
-	// GetUser retrieves a user by username.
+	// ChangeAccountStatus moves the account to params.To. It fails with an
+	// apperrors.ErrFailedPrecondition error when the current status is not in
+	// params.From. Accounts cannot be moved to deleted this way. An account
+	// leaving active has its open orders cancelled in the same transaction,
+	// so they are not filled while their owner cannot cancel them. Moving to
+	// pending_deletion fails with an apperrors.ErrFailedPrecondition error
+	// while the account holds cash or shares, settled or pending, or has open
+	// withdrawals.
+	ChangeAccountStatus(ctx context.Context, params ChangeAccountStatusParams) (user.User, error)
+
+	// ListUsersDueForAnonymization returns up to limit pending_deletion accounts
+	// whose deletion was scheduled at or before the given time.
+	ListUsersDueForAnonymization(ctx context.Context, before time.Time, limit int) ([]user.User, error)
+
+	// AnonymizeUser scrubs the personal data of a pending_deletion account that
+	// is due, removes its credentials, verification tokens and data exports,
+	// redacts the payloads of its outbox events, erases the changes, IP and
+	// user agent of its audit events and appends an anonymize event, deletes
+	// its KYC document records, blanks the document number and name of its KYC
+	// submissions, clears its avatar and marks it deleted. The row is kept so financial records and history
+	// referencing the id stay intact. The document and avatar images are left
+	// to the caller. Like the move to pending_deletion, it fails with an
+	// apperrors.ErrFailedPrecondition error while the account is not empty or
+	// has open orders.
+	AnonymizeUser(ctx context.Context, userID int64, at time.Time) (user.User, error)
+
+	// GetUser retrieves an active user by username.
 	GetUser(ctx context.Context, userName string) (user.User, error)
 
-	// GetUserByEmail retrieves a user by email.
+	// GetUserByEmail retrieves an active user by email.
 	GetUserByEmail(ctx context.Context, email string) (user.User, error)
 
-	// ListUsers returns users using provided pagination parameters and the total count.
+	// ListUsers returns a page of users matching params and the total number of
+	// users matching params.Filter.
 	ListUsers(ctx context.Context, params ListUsersParams) ([]user.User, int64, error)
 
-	// UpdateUser updates user profile details for the given username.
+	// UpdateUser updates user profile details for the given username and bumps
+	// the user version. When updated.Version is non-zero it must equal the stored
+	// version, otherwise an apperrors.ErrAborted error is returned.
 	UpdateUser(ctx context.Context, userName string, updated user.User) error
 
+	// UpdateAvatar sets the avatar blob key of an active user and bumps the
+	// user version. An empty key removes the avatar.
+	UpdateAvatar(ctx context.Context, userID int64, avatarKey string, at time.Time) (user.User, error)
+
 	// UpdatePassword replaces the hashed password for the given username.
 	UpdatePassword(ctx context.Context, userName, hashedPassword string) error
 }
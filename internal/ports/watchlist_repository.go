@@ -0,0 +1,46 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	user "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// WatchlistRepository stores the watchlists of users. Stock codes are
+// resolved against the stocks catalog; every method that takes a code fails
+// with an apperrors.ErrNotFound error when the code is not listed. Returned
+// watchlists carry their codes in the user's order.
+type WatchlistRepository interface {
+	// CreateWatchlist stores an empty watchlist. It fails with an
+	// apperrors.ErrConflict error when the user already has a watchlist of
+	// that name.
+	CreateWatchlist(ctx context.Context, watchlist user.Watchlist) (user.Watchlist, error)
+
+	// GetWatchlist returns a watchlist by id.
+	GetWatchlist(ctx context.Context, watchlistID int64) (user.Watchlist, error)
+
+	// ListWatchlists returns every watchlist of the user, oldest first.
+	ListWatchlists(ctx context.Context, userID int64) ([]user.Watchlist, error)
+
+	// RenameWatchlist changes the name of a watchlist, failing like
+	// CreateWatchlist when the name is taken.
+	RenameWatchlist(ctx context.Context, watchlistID int64, name string, at time.Time) (user.Watchlist, error)
+
+	// DeleteWatchlist removes a watchlist and its codes.
+	DeleteWatchlist(ctx context.Context, watchlistID int64) error
+
+	// AddWatchlistStock appends a code to the end of the watchlist. It fails
+	// with an apperrors.ErrConflict error when the code is already on it.
+	AddWatchlistStock(ctx context.Context, watchlistID int64, code string, at time.Time) (user.Watchlist, error)
+
+	// RemoveWatchlistStock removes a code from the watchlist, failing with an
+	// apperrors.ErrNotFound error when it is not on it.
+	RemoveWatchlistStock(ctx context.Context, watchlistID int64, code string, at time.Time) (user.Watchlist, error)
+
+	// ReorderWatchlist puts the codes of the watchlist into the given order.
+	// codes must hold exactly the codes on the watchlist; otherwise it fails
+	// with an apperrors.ErrFailedPrecondition error, for example when the
+	// watchlist changed since the caller read it.
+	ReorderWatchlist(ctx context.Context, watchlistID int64, codes []string, at time.Time) (user.Watchlist, error)
+}
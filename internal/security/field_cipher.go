@@ -0,0 +1,162 @@
+package security
+
+import (
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// encryptedPrefix marks values produced by FieldCipher. Values without it are
+// legacy plaintext and are returned unchanged by Decrypt.
+const encryptedPrefix = "enc:v1:"
+
+// blindIndexLength is the number of HMAC bytes kept in a blind index.
+const blindIndexLength = 16
+
+var errMalformedCiphertext = errors.New("malformed encrypted value")
+
+// FieldCipher encrypts individual column values with AES-256-GCM (envelope
+// encryption). A random data key is generated per process and wrapped by the
+// MasterKeyProvider; every value carries its wrapped data key:
+//
+//	enc:v1:<master key id>:<wrapped data key>:<nonce|ciphertext>
+//
+// Only the field name is bound as additional data: a value copied into
+// another column fails to decrypt, but one copied into the same column of
+// another row decrypts. Values are not tied to the row they were written
+// for, so row-level integrity is left to the database's access controls.
+type FieldCipher struct {
+	provider MasterKeyProvider
+	keyID    string
+	wrapped  string
+	aead     cipher.AEAD
+
+	mu       sync.RWMutex
+	dataKeys map[string]cipher.AEAD // "<key id>:<wrapped>" -> unwrapped data key
+}
+
+func NewFieldCipher(provider MasterKeyProvider) (*FieldCipher, error) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+	wrappedKey, err := provider.WrapKey(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("wrap data key: %w", err)
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("init data key: %w", err)
+	}
+
+	c := &FieldCipher{
+		provider: provider,
+		keyID:    provider.KeyID(),
+		wrapped:  base64.RawURLEncoding.EncodeToString(wrappedKey),
+		aead:     aead,
+		dataKeys: map[string]cipher.AEAD{},
+	}
+	c.dataKeys[c.keyID+":"+c.wrapped] = aead
+	return c, nil
+}
+
+// Encrypt seals plaintext for field, whichever row it is stored in. Empty
+// values stay empty.
+func (c *FieldCipher) Encrypt(field, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), []byte(field))
+	return encryptedPrefix + c.keyID + ":" + c.wrapped + ":" + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value produced by Encrypt for the same field. Plaintext
+// values written before encryption was enabled are returned as is.
+func (c *FieldCipher) Decrypt(field, value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return value, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(value, encryptedPrefix), ":")
+	if len(parts) != 3 {
+		return "", errMalformedCiphertext
+	}
+	aead, err := c.dataKey(parts[0], parts[1])
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errMalformedCiphertext
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(field))
+	if err != nil {
+		return "", fmt.Errorf("decrypt %s: %w", field, err)
+	}
+	return string(plaintext), nil
+}
+
+func (c *FieldCipher) dataKey(keyID, wrapped string) (cipher.AEAD, error) {
+	cacheKey := keyID + ":" + wrapped
+	c.mu.RLock()
+	aead, ok := c.dataKeys[cacheKey]
+	c.mu.RUnlock()
+	if ok {
+		return aead, nil
+	}
+
+	wrappedKey, err := base64.RawURLEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, errMalformedCiphertext
+	}
+	dataKey, err := c.provider.UnwrapKey(keyID, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err = newGCM(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("init data key: %w", err)
+	}
+	c.mu.Lock()
+	c.dataKeys[cacheKey] = aead
+	c.mu.Unlock()
+	return aead, nil
+}
+
+// BlindIndex returns a keyed hash of value that supports equality lookups on
+// an encrypted field without revealing the value. Empty values have no index.
+func (c *FieldCipher) BlindIndex(field, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, c.provider.BlindIndexKey())
+	mac.Write([]byte(field))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)[:blindIndexLength])
+}
+
+// NeedsReencrypt reports whether value is plaintext or sealed under a master
+// key other than the current one.
+func (c *FieldCipher) NeedsReencrypt(value string) bool {
+	if value == "" {
+		return false
+	}
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return true
+	}
+	keyID, _, _ := strings.Cut(strings.TrimPrefix(value, encryptedPrefix), ":")
+	return keyID != c.keyID
+}
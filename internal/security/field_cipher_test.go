@@ -0,0 +1,124 @@
+package security
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testKeyOne   = []byte("field-cipher-master-key-one-32by")
+	testKeyTwo   = []byte("field-cipher-master-key-two-32by")
+	testBlindKey = []byte("field-cipher-blind-index-key-32b")
+)
+
+func newTestCipher(t *testing.T, currentID string) *FieldCipher {
+	t.Helper()
+	keyring, err := NewKeyring(currentID, map[string][]byte{"k1": testKeyOne, "k2": testKeyTwo}, testBlindKey)
+	require.NoError(t, err)
+	c, err := NewFieldCipher(keyring)
+	require.NoError(t, err)
+	return c
+}
+
+func TestFieldCipherRoundTrip(t *testing.T) {
+	c := newTestCipher(t, "k1")
+
+	sealed, err := c.Encrypt("cmnd", "079090000001")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, "enc:v1:k1:"))
+	assert.NotContains(t, sealed, "079090000001")
+
+	again, err := c.Encrypt("cmnd", "079090000001")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "every value gets a fresh nonce")
+
+	plain, err := c.Decrypt("cmnd", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "079090000001", plain)
+
+	_, err = c.Decrypt("phone_number", sealed)
+	assert.Error(t, err, "a value is bound to its field")
+
+	empty, err := c.Encrypt("cmnd", "")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	legacy, err := c.Decrypt("cmnd", "079090000001")
+	require.NoError(t, err)
+	assert.Equal(t, "079090000001", legacy, "plaintext passes through")
+
+	_, err = c.Decrypt("cmnd", "enc:v1:k1:broken")
+	assert.Error(t, err)
+}
+
+func TestFieldCipherRotation(t *testing.T) {
+	old := newTestCipher(t, "k1")
+	current := newTestCipher(t, "k2")
+
+	sealed, err := old.Encrypt("phone_number", "0900000001")
+	require.NoError(t, err)
+	assert.False(t, old.NeedsReencrypt(sealed))
+	assert.True(t, current.NeedsReencrypt(sealed))
+	assert.True(t, current.NeedsReencrypt("0900000001"))
+	assert.False(t, current.NeedsReencrypt(""))
+
+	plain, err := current.Decrypt("phone_number", sealed)
+	require.NoError(t, err, "retired keys stay readable")
+	assert.Equal(t, "0900000001", plain)
+
+	onlyNew, err := NewKeyring("k2", map[string][]byte{"k2": testKeyTwo}, testBlindKey)
+	require.NoError(t, err)
+	withoutOld, err := NewFieldCipher(onlyNew)
+	require.NoError(t, err)
+	_, err = withoutOld.Decrypt("phone_number", sealed)
+	assert.Error(t, err)
+}
+
+func TestFieldCipherBlindIndex(t *testing.T) {
+	a := newTestCipher(t, "k1")
+	b := newTestCipher(t, "k2")
+
+	index := a.BlindIndex("cmnd", "079090000001")
+	assert.Len(t, index, 32)
+	assert.Equal(t, index, b.BlindIndex("cmnd", " 079090000001 "), "stable across master keys and whitespace")
+	assert.NotEqual(t, index, a.BlindIndex("phone_number", "079090000001"))
+	assert.Empty(t, a.BlindIndex("cmnd", "  "))
+}
+
+func TestLoadKeyring(t *testing.T) {
+	b64 := base64.StdEncoding.EncodeToString
+
+	path := filepath.Join(t.TempDir(), "keys.json")
+	content := `{"current_key_id":"k2","master_keys":{"k1":"` + b64(testKeyOne) + `","k2":"` + b64(testKeyTwo) + `"},"blind_index_key":"` + b64(testBlindKey) + `"}`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	fromFile, err := LoadKeyringFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "k2", fromFile.KeyID())
+
+	t.Setenv("TEST_PII_CURRENT_KEY_ID", "k1")
+	t.Setenv("TEST_PII_MASTER_KEYS", "k1:"+b64(testKeyOne)+", k2:"+b64(testKeyTwo))
+	t.Setenv("TEST_PII_BLIND_INDEX_KEY", b64(testBlindKey))
+	fromEnv, err := LoadKeyringEnv("TEST_PII_")
+	require.NoError(t, err)
+	assert.Equal(t, "k1", fromEnv.KeyID())
+
+	wrapped, err := fromEnv.WrapKey(testKeyOne)
+	require.NoError(t, err)
+	unwrapped, err := fromFile.UnwrapKey("k1", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, testKeyOne, unwrapped)
+	_, err = fromFile.UnwrapKey("k2", wrapped)
+	assert.Error(t, err, "the key id is bound to the wrapped key")
+
+	t.Setenv("TEST_PII_CURRENT_KEY_ID", "k3")
+	_, err = LoadKeyringEnv("TEST_PII_")
+	assert.Error(t, err)
+	_, err = NewKeyring("k1", map[string][]byte{"k1": []byte("short")}, testBlindKey)
+	assert.Error(t, err)
+}
@@ -0,0 +1,165 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// MasterKeyProvider wraps and unwraps the data keys used by FieldCipher. New
+// data keys are wrapped under KeyID; older key ids must stay unwrappable until
+// every value has been re-encrypted.
+type MasterKeyProvider interface {
+	KeyID() string
+	WrapKey(dataKey []byte) ([]byte, error)
+	UnwrapKey(keyID string, wrapped []byte) ([]byte, error)
+	// BlindIndexKey keys the HMAC of blind indexes. It is independent of the
+	// master keys so rotating them does not change any index.
+	BlindIndexKey() []byte
+}
+
+// Keyring is a MasterKeyProvider holding AES-256 master keys in memory. It is
+// loaded from a local key file or from environment variables.
+type Keyring struct {
+	currentID     string
+	keys          map[string]cipher.AEAD
+	blindIndexKey []byte
+}
+
+var _ MasterKeyProvider = (*Keyring)(nil)
+
+// keyringFile is the JSON layout of a key file. Keys are base64 encoded
+// 32-byte values.
+type keyringFile struct {
+	CurrentKeyID  string            `json:"current_key_id"`
+	MasterKeys    map[string]string `json:"master_keys"`
+	BlindIndexKey string            `json:"blind_index_key"`
+}
+
+// NewKeyring builds a keyring from raw keys. Every key, including the blind
+// index key, must be 32 bytes long; key ids must not contain ':'.
+func NewKeyring(currentID string, masterKeys map[string][]byte, blindIndexKey []byte) (*Keyring, error) {
+	if _, ok := masterKeys[currentID]; !ok {
+		return nil, fmt.Errorf("current master key %q not found", currentID)
+	}
+	if len(blindIndexKey) != 32 {
+		return nil, errors.New("blind index key must be 32 bytes")
+	}
+	k := &Keyring{currentID: currentID, keys: make(map[string]cipher.AEAD, len(masterKeys)), blindIndexKey: blindIndexKey}
+	for id, key := range masterKeys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid master key id %q", id)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("master key %q must be 32 bytes", id)
+		}
+		aead, err := newGCM(key)
+		if err != nil {
+			return nil, fmt.Errorf("master key %q: %w", id, err)
+		}
+		k.keys[id] = aead
+	}
+	return k, nil
+}
+
+// LoadKeyringFile reads a JSON key file:
+//
+//	{"current_key_id": "2026-10", "master_keys": {"2026-10": "<base64>"}, "blind_index_key": "<base64>"}
+func LoadKeyringFile(path string) (*Keyring, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read key file: %w", err)
+	}
+	var file keyringFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("decode key file: %w", err)
+	}
+	return decodeKeyring(file)
+}
+
+// LoadKeyringEnv reads the keyring from <prefix>CURRENT_KEY_ID,
+// <prefix>MASTER_KEYS ("id:<base64>,id:<base64>") and <prefix>BLIND_INDEX_KEY.
+func LoadKeyringEnv(prefix string) (*Keyring, error) {
+	file := keyringFile{
+		CurrentKeyID:  strings.TrimSpace(os.Getenv(prefix + "CURRENT_KEY_ID")),
+		MasterKeys:    map[string]string{},
+		BlindIndexKey: strings.TrimSpace(os.Getenv(prefix + "BLIND_INDEX_KEY")),
+	}
+	for _, entry := range strings.Split(os.Getenv(prefix+"MASTER_KEYS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, key, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("%sMASTER_KEYS entries must be id:key", prefix)
+		}
+		file.MasterKeys[strings.TrimSpace(id)] = strings.TrimSpace(key)
+	}
+	return decodeKeyring(file)
+}
+
+func decodeKeyring(file keyringFile) (*Keyring, error) {
+	keys := make(map[string][]byte, len(file.MasterKeys))
+	for id, encoded := range file.MasterKeys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decode master key %q: %w", id, err)
+		}
+		keys[id] = key
+	}
+	blindIndexKey, err := base64.StdEncoding.DecodeString(file.BlindIndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode blind index key: %w", err)
+	}
+	return NewKeyring(file.CurrentKeyID, keys, blindIndexKey)
+}
+
+func (k *Keyring) KeyID() string {
+	return k.currentID
+}
+
+// WrapKey seals dataKey under the current master key; the key id is bound as
+// additional data so a wrapped key cannot be presented under another id.
+func (k *Keyring) WrapKey(dataKey []byte) ([]byte, error) {
+	aead := k.keys[k.currentID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(k.currentID)), nil
+}
+
+func (k *Keyring) UnwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown master key %q", keyID)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("wrapped key too short")
+	}
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+func (k *Keyring) BlindIndexKey() []byte {
+	return k.blindIndexKey
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
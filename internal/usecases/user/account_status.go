@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrAccountInactive      = apperrors.New(apperrors.ErrFailedPrecondition, "ACCOUNT_INACTIVE", "account is deactivated or scheduled for deletion")
+	ErrDeletionGraceExpired = apperrors.New(apperrors.ErrFailedPrecondition, "ACCOUNT_DELETION_GRACE_EXPIRED", "account deletion can no longer be cancelled")
+)
+
+// requireActive rejects accounts that are not in the active state.
+func requireActive(u userentity.User) error {
+	if u.Status != userentity.AccountStatusActive {
+		return ErrAccountInactive
+	}
+	return nil
+}
+
+// UserAccountStatusUseCase lets users deactivate their account and restore a
+// deactivated or pending_deletion account.
+type UserAccountStatusUseCase struct {
+	repository ports.UserRepository
+}
+
+func NewUserAccountStatusUseCase(repo ports.UserRepository) UserAccountStatusUseCase {
+	return UserAccountStatusUseCase{repository: repo}
+}
+
+// Deactivate suspends the account identified by username on behalf of the
+// authenticated user uid. Login and lookups ignore it until Reactivate, and
+// its open orders are cancelled.
+func (u UserAccountStatusUseCase) Deactivate(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	_, info, err := u.repository.GetLoginInfo(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get login info: %w", err)
+	}
+	if info.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+
+	deactivated, err := u.repository.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: info.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusActive},
+		To:     userentity.AccountStatusDeactivated,
+		At:     time.Now().UTC(),
+	})
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("deactivate account: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{UserID: deactivated.Id, Action: userentity.AuditActionDeactivate})
+	return deactivated, nil
+}
+
+// Reactivate restores a deactivated account, or cancels a pending deletion
+// whose grace period has not ended. Inactive users cannot hold a session, so
+// the password is checked instead of an access token.
+func (u UserAccountStatusUseCase) Reactivate(ctx context.Context, username, password string) (userentity.User, error) {
+	login, info, err := u.repository.GetLoginInfo(ctx, username)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return userentity.User{}, ErrInvalidCredentials
+		}
+		return userentity.User{}, fmt.Errorf("get login info: %w", err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(login.Password), []byte(password)); err != nil {
+		return userentity.User{}, ErrInvalidCredentials
+	}
+
+	now := time.Now().UTC()
+	if info.Status == userentity.AccountStatusPendingDeletion && !info.DeletionScheduledAt.After(now) {
+		return userentity.User{}, ErrDeletionGraceExpired
+	}
+
+	reactivated, err := u.repository.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+		UserID: info.Id,
+		From:   []userentity.AccountStatus{userentity.AccountStatusDeactivated, userentity.AccountStatusPendingDeletion},
+		To:     userentity.AccountStatusActive,
+		At:     now,
+	})
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("reactivate account: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{UserID: reactivated.Id, ActorID: reactivated.Id, Action: userentity.AuditActionReactivate})
+	return reactivated, nil
+}
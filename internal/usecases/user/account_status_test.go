@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
+)
+
+func newTestLoginUseCase(t *testing.T, repo ports.UserRepository) UserLoginUseCase {
+	t.Helper()
+	accessManager, err := security.NewJWTManager("unit-test-secret-must-be-long-123456", "test-issuer", "test-aud", 15*time.Minute)
+	require.NoError(t, err)
+	refreshManager, err := security.NewJWTRefreshManager("unit-test-refresh-secret-change-me-1234567890", "test-issuer", "test-aud", time.Hour)
+	require.NoError(t, err)
+	return NewUserLoginUseCase(repo, accessManager, refreshManager)
+}
+
+func TestAccountStatus_DeactivateAndReactivate(t *testing.T) {
+	repo := newTestRepo()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc := NewUserAccountStatusUseCase(repo)
+	login := newTestLoginUseCase(t, repo)
+	ctx := context.Background()
+
+	_, err := uc.Deactivate(ctx, alice.Id+1, "alice")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	deactivated, err := uc.Deactivate(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.AccountStatusDeactivated, deactivated.Status)
+
+	_, _, _, _, _, err = login.Login(ctx, "alice", "secret")
+	assert.ErrorIs(t, err, ErrAccountInactive)
+	_, _, _, _, _, err = login.Login(ctx, "alice", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials, "the state is only revealed with valid credentials")
+	_, err = NewUserGetUseCase(repo).Get(ctx, "alice")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = uc.Deactivate(ctx, alice.Id, "alice")
+	assert.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	_, err = uc.Reactivate(ctx, "alice", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = uc.Reactivate(ctx, "nobody", "secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	reactivated, err := uc.Reactivate(ctx, "alice", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.AccountStatusActive, reactivated.Status)
+	_, _, _, _, _, err = login.Login(ctx, "alice", "secret")
+	assert.NoError(t, err)
+
+	_, err = uc.Reactivate(ctx, "alice", "secret")
+	assert.ErrorIs(t, err, apperrors.ErrFailedPrecondition, "active accounts cannot be reactivated")
+}
+
+func TestAccountStatus_ReactivateCancelsPendingDeletion(t *testing.T) {
+	repo := newTestRepo()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc := NewUserAccountStatusUseCase(repo)
+	ctx := context.Background()
+
+	deleted, err := NewUserDeleteUseCase(repo).DeleteAccountOwned(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.AccountStatusPendingDeletion, deleted.Status)
+
+	reactivated, err := uc.Reactivate(ctx, "alice", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.AccountStatusActive, reactivated.Status)
+	assert.True(t, reactivated.DeletionScheduledAt.IsZero())
+}
+
+func TestAccountStatus_ReactivateAfterGracePeriod(t *testing.T) {
+	repo := newTestRepo()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	ctx := context.Background()
+
+	_, err := NewUserDeleteUseCaseWithGracePeriod(repo, time.Nanosecond).DeleteAccountOwned(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+
+	_, err = NewUserAccountStatusUseCase(repo).Reactivate(ctx, "alice", "secret")
+	assert.ErrorIs(t, err, ErrDeletionGraceExpired)
+}
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const defaultAnonymizeBatchSize = 100
+
+// UserAnonymizeUseCase anonymizes accounts whose deletion grace period has
+// ended. It is meant to run periodically.
+type UserAnonymizeUseCase struct {
+	repository ports.UserRepository
+	kyc        ports.KycRepository
+	blobs      ports.BlobStore
+	batchSize  int
+}
+
+func NewUserAnonymizeUseCase(repo ports.UserRepository) UserAnonymizeUseCase {
+	return UserAnonymizeUseCase{repository: repo, batchSize: defaultAnonymizeBatchSize}
+}
+
+// NewUserAnonymizeUseCaseWithDocuments also removes the KYC document images and
+// avatars of anonymized accounts from blobs. The repository only drops their
+// references.
+func NewUserAnonymizeUseCaseWithDocuments(repo ports.UserRepository, kyc ports.KycRepository, blobs ports.BlobStore) UserAnonymizeUseCase {
+	uc := NewUserAnonymizeUseCase(repo)
+	uc.kyc = kyc
+	uc.blobs = blobs
+	return uc
+}
+
+// AnonymizeDue anonymizes every account due at now and returns how many were
+// anonymized. Accounts reactivated concurrently, and accounts that received
+// cash or shares during their grace period, are skipped; the latter are
+// retried on every run until they are emptied.
+func (u UserAnonymizeUseCase) AnonymizeDue(ctx context.Context, now time.Time) (int, error) {
+	anonymized := 0
+	for {
+		due, err := u.repository.ListUsersDueForAnonymization(ctx, now, u.batchSize)
+		if err != nil {
+			return anonymized, fmt.Errorf("list users due for anonymization: %w", err)
+		}
+		processed := 0
+		for _, user := range due {
+			documents, err := u.documents(ctx, user.Id)
+			if err != nil {
+				return anonymized, err
+			}
+			if _, err := u.repository.AnonymizeUser(ctx, user.Id, now); err != nil {
+				if errors.Is(err, apperrors.ErrFailedPrecondition) || errors.Is(err, ErrNotFound) {
+					continue
+				}
+				return anonymized, fmt.Errorf("anonymize user %d: %w", user.Id, err)
+			}
+			// The records are gone, so a failed delete cannot be retried from
+			// here; the images are left for the blob store's own cleanup.
+			for _, document := range documents {
+				_ = u.blobs.Delete(ctx, document.BlobKey)
+			}
+			if u.blobs != nil && user.AvatarKey != "" {
+				_ = u.blobs.Delete(ctx, user.AvatarKey)
+			}
+			processed++
+		}
+		anonymized += processed
+		if len(due) < u.batchSize || processed == 0 {
+			return anonymized, nil
+		}
+	}
+}
+
+// documents lists the KYC document images to remove with the account.
+func (u UserAnonymizeUseCase) documents(ctx context.Context, userID int64) ([]userentity.KycDocument, error) {
+	if u.kyc == nil || u.blobs == nil {
+		return nil, nil
+	}
+	documents, err := u.kyc.ListKycDocuments(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list kyc documents of user %d: %w", userID, err)
+	}
+	return documents, nil
+}
@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func TestAnonymizeDue(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	bruce, err := seedUserWithToken(t, repo, "bruce", "bruce@example.com", "secret", "token-bruce", true)
+	require.NoError(t, err)
+	carol, err := seedUserWithToken(t, repo, "carol", "carol@example.com", "secret", "token-carol", true)
+	require.NoError(t, err)
+
+	grace := NewUserDeleteUseCaseWithGracePeriod(repo, time.Hour)
+	_, err = grace.DeleteAccount(ctx, "alice")
+	require.NoError(t, err)
+	_, err = grace.DeleteAccount(ctx, "bruce")
+	require.NoError(t, err)
+
+	uc := NewUserAnonymizeUseCase(repo)
+	uc.batchSize = 1
+
+	count, err := uc.AnonymizeDue(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Zero(t, count, "grace period has not ended")
+
+	count, err = uc.AnonymizeDue(ctx, time.Now().UTC().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	deleted, _, err := repo.ListUsers(ctx, ports.ListUsersParams{Filter: ports.ListUsersFilter{
+		Statuses: []userentity.AccountStatus{userentity.AccountStatusDeleted},
+	}})
+	require.NoError(t, err)
+	ids := []int64{}
+	for _, u := range deleted {
+		ids = append(ids, u.Id)
+		assert.Empty(t, u.Name)
+	}
+	assert.ElementsMatch(t, []int64{alice.Id, bruce.Id}, ids)
+
+	_, _, _, _, _, err = newTestLoginUseCase(t, repo).Login(ctx, "alice", "secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = NewUserGetUseCase(repo).Get(ctx, carol.Username)
+	assert.NoError(t, err)
+
+	count, err = uc.AnonymizeDue(ctx, time.Now().UTC().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestEraseAccountOwned(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	exports := NewUserDataExportUseCase(repo, repo)
+	export, err := exports.Request(ctx, alice.Id, "alice", "json")
+	require.NoError(t, err)
+
+	uc := NewUserDeleteUseCase(repo)
+	_, err = uc.EraseAccountOwned(ctx, alice.Id+1, "alice", "secret")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	_, err = uc.EraseAccountOwned(ctx, alice.Id, "alice", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	erased, err := uc.EraseAccountOwned(ctx, alice.Id, "alice", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.AccountStatusPendingDeletion, erased.Status)
+	assert.False(t, erased.DeletionScheduledAt.After(time.Now().UTC()), "erasure has no grace period")
+
+	count, err := NewUserAnonymizeUseCase(repo).AnonymizeDue(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	data, err := repo.GetPersonalData(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.AccountStatusDeleted, data.User.Status)
+	assert.Empty(t, data.User.Name)
+	_, err = repo.GetDataExport(ctx, alice.Id, export.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEraseAccountOwned_RequiresEmptyAccount(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	uc := NewUserDeleteUseCase(repo)
+
+	_, err := repo.PostLedgerEntries(ctx, "deposit-1", []userentity.LedgerEntry{
+		{UserID: alice.Id, Amount: 1_000_000, Type: userentity.LedgerEntryDeposit},
+	})
+	require.NoError(t, err)
+	_, err = uc.EraseAccountOwned(ctx, alice.Id, "alice", "secret")
+	assert.ErrorIs(t, err, database.ErrAccountNotEmpty)
+	_, err = uc.DeleteAccountOwned(ctx, alice.Id, "alice")
+	assert.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	// Open orders do not hold the deletion back: they are cancelled.
+	closeOutAccount(t, repo, alice.Id)
+	order := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 75000, 100, time.Now().UTC())
+	_, err = uc.EraseAccountOwned(ctx, alice.Id, "alice", "secret")
+	require.NoError(t, err)
+	cancelled, err := repo.GetOrder(ctx, order.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusCancelled, cancelled.Status)
+
+	// Cash arriving before the anonymizer runs keeps the account until it is
+	// paid out.
+	_, err = repo.PostLedgerEntries(ctx, "deposit-2", []userentity.LedgerEntry{
+		{UserID: alice.Id, Amount: 500_000, Type: userentity.LedgerEntryDeposit},
+	})
+	require.NoError(t, err)
+	count, err := NewUserAnonymizeUseCase(repo).AnonymizeDue(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	data, err := repo.GetPersonalData(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.AccountStatusPendingDeletion, data.User.Status)
+}
@@ -0,0 +1,94 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 100
+)
+
+// UserAuditUseCase reads the audit log for administrators. Events are written
+// by the use cases through audit.Record.
+type UserAuditUseCase struct {
+	repository ports.AuditRepository
+}
+
+func NewUserAuditUseCase(repo ports.AuditRepository) UserAuditUseCase {
+	return UserAuditUseCase{repository: repo}
+}
+
+// ListAuditEventsQuery selects a page of audit events, newest first. The
+// Filter must not change between pages.
+type ListAuditEventsQuery struct {
+	PageSize  uint32
+	PageToken string
+	Filter    ports.AuditEventFilter
+}
+
+type ListAuditEventsResult struct {
+	Events []userentity.AuditEvent
+	// NextPageToken continues the listing after this page. It is empty when the
+	// page is not full; a full last page may be followed by an empty one.
+	NextPageToken string
+}
+
+// auditPageToken is the decoded form of an audit log page token.
+type auditPageToken struct {
+	Filter   string `json:"f"`
+	BeforeID int64  `json:"b"`
+}
+
+func (u UserAuditUseCase) List(ctx context.Context, query ListAuditEventsQuery) (ListAuditEventsResult, error) {
+	pageSize := query.PageSize
+	if pageSize == 0 {
+		pageSize = defaultAuditPageSize
+	}
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+	filter := query.Filter
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		return ListAuditEventsResult{}, ErrListInvalidFilter
+	}
+	fingerprint := listFilterFingerprint(filter)
+
+	params := ports.ListAuditEventsParams{Filter: filter, Limit: int(pageSize)}
+	if query.PageToken != "" {
+		beforeID, err := decodeAuditPageToken(query.PageToken, fingerprint)
+		if err != nil {
+			return ListAuditEventsResult{}, err
+		}
+		params.BeforeID = beforeID
+	}
+
+	events, err := u.repository.ListAuditEvents(ctx, params)
+	if err != nil {
+		return ListAuditEventsResult{}, fmt.Errorf("list audit events: %w", err)
+	}
+	result := ListAuditEventsResult{Events: events}
+	if len(events) == int(pageSize) {
+		raw, _ := json.Marshal(auditPageToken{Filter: fingerprint, BeforeID: events[len(events)-1].ID})
+		result.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	return result, nil
+}
+
+func decodeAuditPageToken(value, fingerprint string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return 0, ErrListInvalidPageToken
+	}
+	var token auditPageToken
+	if err := json.Unmarshal(raw, &token); err != nil || token.Filter != fingerprint || token.BeforeID <= 0 {
+		return 0, ErrListInvalidPageToken
+	}
+	return token.BeforeID, nil
+}
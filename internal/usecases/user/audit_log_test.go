@@ -0,0 +1,115 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
+)
+
+func TestLoginRecordsAuditEvents(t *testing.T) {
+	repo := newTestRepo()
+	created := seedVerifiedUser(t, repo, "alice", "secret")
+
+	accessManager, err := security.NewJWTManager("unit-test-secret-must-be-long-123456", "test-issuer", "test-aud", 15*time.Minute)
+	require.NoError(t, err)
+	refreshManager, err := security.NewJWTRefreshManager("unit-test-refresh-secret-change-me-1234567890", "test-issuer", "test-aud", 7*24*time.Hour)
+	require.NoError(t, err)
+	uc := NewUserLoginUseCase(repo, accessManager, refreshManager)
+
+	ctx, rec := audit.NewContext(context.Background())
+	_, _, _, _, _, err = uc.Login(ctx, "alice", "wrong")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, _, _, _, _, err = uc.Login(ctx, "nobody", "secret")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, _, _, _, _, err = uc.Login(ctx, "alice", "secret")
+	require.NoError(t, err)
+
+	events := rec.Events()
+	require.Len(t, events, 2, "unknown usernames are not audited")
+	require.Equal(t, userentity.AuditActionLoginFailed, events[0].Action)
+	require.Equal(t, created.Id, events[0].UserID)
+	require.Zero(t, events[0].ActorID)
+	require.Equal(t, userentity.AuditActionLogin, events[1].Action)
+	require.Equal(t, created.Id, events[1].ActorID)
+	require.False(t, events[1].CreatedAt.IsZero())
+}
+
+func TestUpdateProfileRecordsRedactedDiff(t *testing.T) {
+	repo := database.NewInMemoryUserRepository()
+	seedUpdateUser(t, repo)
+	uc := NewUserUpdateUseCase(repo)
+
+	ctx, rec := audit.NewContext(context.Background())
+	_, err := uc.UpdateProfile(ctx, "alice123", RequestUpdate{
+		Name:        "Alice Nguyen",
+		Cmnd:        "CMND999",
+		PhoneNumber: "0987654321",
+	})
+	require.NoError(t, err)
+
+	events := rec.Events()
+	require.Len(t, events, 1)
+	require.Equal(t, userentity.AuditActionProfileUpdate, events[0].Action)
+	require.Equal(t, map[string]userentity.AuditChange{
+		UpdateFieldName:        {From: "Alice", To: "Alice Nguyen"},
+		UpdateFieldCmnd:        {Redacted: true},
+		UpdateFieldPhoneNumber: {Redacted: true},
+	}, events[0].Changes)
+}
+
+func TestAuditRecordWithoutRecorder(t *testing.T) {
+	repo := newTestRepo()
+	seedVerifiedUser(t, repo, "alice", "secret")
+	uc := NewUserChangePasswordUseCase(repo)
+
+	// Background jobs run without a recorder; recording must be a no-op.
+	require.NoError(t, uc.ChangePassword(context.Background(), "alice", "secret", "secret2"))
+}
+
+func TestUserAuditUseCase_List(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	created := seedVerifiedUser(t, repo, "alice", "secret")
+
+	events := make([]userentity.AuditEvent, 0, 5)
+	for i := 0; i < 5; i++ {
+		events = append(events, userentity.AuditEvent{UserID: created.Id, Action: userentity.AuditActionLogin})
+	}
+	require.NoError(t, repo.AppendAuditEvents(ctx, events))
+
+	uc := NewUserAuditUseCase(repo)
+	filter := ports.AuditEventFilter{UserID: created.Id}
+
+	first, err := uc.List(ctx, ListAuditEventsQuery{PageSize: 2, Filter: filter})
+	require.NoError(t, err)
+	require.Len(t, first.Events, 2)
+	require.NotEmpty(t, first.NextPageToken)
+
+	second, err := uc.List(ctx, ListAuditEventsQuery{PageSize: 2, PageToken: first.NextPageToken, Filter: filter})
+	require.NoError(t, err)
+	require.Len(t, second.Events, 2)
+	require.Less(t, second.Events[0].ID, first.Events[1].ID)
+
+	third, err := uc.List(ctx, ListAuditEventsQuery{PageSize: 2, PageToken: second.NextPageToken, Filter: filter})
+	require.NoError(t, err)
+	require.Len(t, third.Events, 1)
+	require.Empty(t, third.NextPageToken)
+
+	// Tokens are bound to the filter they were issued for.
+	_, err = uc.List(ctx, ListAuditEventsQuery{PageSize: 2, PageToken: first.NextPageToken})
+	require.ErrorIs(t, err, ErrListInvalidPageToken)
+	_, err = uc.List(ctx, ListAuditEventsQuery{PageToken: "not-a-token"})
+	require.ErrorIs(t, err, ErrListInvalidPageToken)
+
+	now := time.Now()
+	_, err = uc.List(ctx, ListAuditEventsQuery{Filter: ports.AuditEventFilter{CreatedAfter: now, CreatedBefore: now}})
+	require.ErrorIs(t, err, ErrListInvalidFilter)
+}
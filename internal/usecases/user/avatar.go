@@ -0,0 +1,192 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// MaxAvatarSize is the largest avatar image accepted for upload.
+const MaxAvatarSize = 2 << 20
+
+const (
+	// avatarUploadURLTTL is how long an avatar upload URL can be used.
+	avatarUploadURLTTL = 15 * time.Minute
+	// avatarURLTTL is the minimum lifetime of the avatar URLs in profiles.
+	avatarURLTTL = 24 * time.Hour
+)
+
+// avatarAuditField names avatar changes in profile_update audit events.
+const avatarAuditField = "avatar"
+
+var (
+	ErrAvatarUploadNotFound = apperrors.New(apperrors.ErrNotFound, "AVATAR_UPLOAD_NOT_FOUND", "avatar upload not found; request a new upload url")
+	ErrAvatarTooLarge       = apperrors.New(apperrors.ErrInvalidArgument, "AVATAR_TOO_LARGE", "avatar image must be at most 2 MiB")
+	ErrAvatarContentType    = apperrors.New(apperrors.ErrInvalidArgument, "AVATAR_CONTENT_TYPE", "avatar image must be a JPEG, PNG or WebP")
+)
+
+// avatarContentTypes are the sniffed media types accepted for avatars.
+var avatarContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
+// AvatarUpload is a signed URL the client PUTs the avatar image to before
+// calling UploadAvatar with Key.
+type AvatarUpload struct {
+	Key       string
+	URL       string
+	ExpiresAt time.Time
+	MaxBytes  int64
+}
+
+// UserAvatarUseCase manages profile pictures. Clients upload the image to a
+// signed URL served by the HTTP gateway, which enforces the size limit and
+// the content type, and then make it their avatar.
+type UserAvatarUseCase struct {
+	users ports.UserRepository
+	blobs ports.BlobStore
+	urls  ports.BlobURLSigner
+}
+
+func NewUserAvatarUseCase(users ports.UserRepository, blobs ports.BlobStore, urls ports.BlobURLSigner) UserAvatarUseCase {
+	return UserAvatarUseCase{users: users, blobs: blobs, urls: urls}
+}
+
+// CreateUploadURL returns a URL for uploading a new avatar of the caller's
+// account.
+func (u UserAvatarUseCase) CreateUploadURL(ctx context.Context, uid int64, username string) (AvatarUpload, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return AvatarUpload{}, err
+	}
+	var random [16]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return AvatarUpload{}, fmt.Errorf("generate blob key: %w", err)
+	}
+	key := avatarKeyPrefix(owner.Id) + hex.EncodeToString(random[:])
+	expires := time.Now().UTC().Add(avatarUploadURLTTL).Truncate(time.Second)
+	return AvatarUpload{
+		Key: key,
+		URL: u.urls.UploadURL(key, expires, ports.BlobUploadPolicy{
+			MaxBytes:     MaxAvatarSize,
+			ContentTypes: avatarContentTypes,
+		}),
+		ExpiresAt: expires,
+		MaxBytes:  MaxAvatarSize,
+	}, nil
+}
+
+// UploadAvatar makes the image uploaded under key the caller's avatar and
+// removes the previous one. The image is checked again because the blob store
+// may have been written by other means than the gateway.
+func (u UserAvatarUseCase) UploadAvatar(ctx context.Context, uid int64, username, key string) (userentity.User, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.User{}, err
+	}
+	key = strings.TrimSpace(key)
+	random, ok := strings.CutPrefix(key, avatarKeyPrefix(owner.Id))
+	if !ok || random == "" || strings.Contains(random, "/") {
+		return userentity.User{}, ErrAvatarUploadNotFound
+	}
+	if key == owner.AvatarKey {
+		return owner, nil
+	}
+	if err := u.checkImage(ctx, key); err != nil {
+		return userentity.User{}, err
+	}
+
+	updated, err := u.users.UpdateAvatar(ctx, owner.Id, key, time.Now().UTC())
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("update avatar: %w", err)
+	}
+	if owner.AvatarKey != "" {
+		// Nothing references the old image any more; removing it is best
+		// effort.
+		_ = u.blobs.Delete(ctx, owner.AvatarKey)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		UserID:  owner.Id,
+		Action:  userentity.AuditActionProfileUpdate,
+		Changes: map[string]userentity.AuditChange{avatarAuditField: {Redacted: true}},
+	})
+	return updated, nil
+}
+
+// AvatarURL returns a signed download URL of the user's avatar, or "" when
+// there is none. Expiry is rounded up to whole periods so the URL stays the
+// same, and cacheable by clients, for at least avatarURLTTL.
+func (u UserAvatarUseCase) AvatarURL(user userentity.User) string {
+	if user.AvatarKey == "" || u.urls == nil {
+		return ""
+	}
+	expires := time.Now().UTC().Truncate(avatarURLTTL).Add(2 * avatarURLTTL)
+	return u.urls.DownloadURL(user.AvatarKey, expires)
+}
+
+// checkImage verifies the uploaded image's size and sniffed content type. A
+// rejected image is deleted.
+func (u UserAvatarUseCase) checkImage(ctx context.Context, key string) error {
+	rc, err := u.blobs.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return ErrAvatarUploadNotFound
+		}
+		return fmt.Errorf("open avatar upload: %w", err)
+	}
+	content, err := io.ReadAll(io.LimitReader(rc, MaxAvatarSize+1))
+	_ = rc.Close()
+	if err != nil {
+		return fmt.Errorf("read avatar upload: %w", err)
+	}
+
+	var rejected error
+	switch {
+	case len(content) > MaxAvatarSize:
+		rejected = ErrAvatarTooLarge
+	case !containsString(avatarContentTypes, http.DetectContentType(content)):
+		rejected = ErrAvatarContentType
+	}
+	if rejected != nil {
+		_ = u.blobs.Delete(ctx, key)
+	}
+	return rejected
+}
+
+func (u UserAvatarUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
+
+// avatarKeyPrefix is the blob key prefix of a user's avatar images.
+func avatarKeyPrefix(userID int64) string {
+	return "avatars/" + strconv.FormatInt(userID, 10) + "/"
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
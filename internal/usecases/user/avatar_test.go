@@ -0,0 +1,127 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/blobstore"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+)
+
+func newTestAvatarUseCase(t *testing.T, repo *database.InMemoryUserRepository) (UserAvatarUseCase, *blobstore.LocalBlobStore, *blobstore.URLSigner) {
+	t.Helper()
+	blobs, err := blobstore.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	signer, err := blobstore.NewURLSigner("avatar-test-secret-0123456789", "/api/v1/blobs")
+	require.NoError(t, err)
+	return NewUserAvatarUseCase(repo, blobs, signer), blobs, signer
+}
+
+// uploadAvatar stands in for the client's PUT to the signed upload URL.
+func uploadAvatar(t *testing.T, uc UserAvatarUseCase, blobs *blobstore.LocalBlobStore, uid int64, username string, content []byte) AvatarUpload {
+	t.Helper()
+	upload, err := uc.CreateUploadURL(context.Background(), uid, username)
+	require.NoError(t, err)
+	require.NoError(t, blobs.Put(context.Background(), upload.Key, bytes.NewReader(content), ""))
+	return upload
+}
+
+func TestUserAvatarUseCase_UploadAvatar(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc, blobs, signer := newTestAvatarUseCase(t, repo)
+
+	upload, err := uc.CreateUploadURL(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Regexp(t, `^avatars/\d+/[0-9a-f]{32}$`, upload.Key)
+	signed, err := url.Parse(upload.URL)
+	require.NoError(t, err)
+	policy, err := signer.Verify(http.MethodPut, upload.Key, signed.Query(), time.Now())
+	require.NoError(t, err)
+	assert.EqualValues(t, MaxAvatarSize, policy.MaxBytes)
+	assert.Equal(t, []string{"image/jpeg", "image/png", "image/webp"}, policy.ContentTypes)
+
+	_, err = uc.UploadAvatar(ctx, alice.Id, "alice", upload.Key)
+	assert.ErrorIs(t, err, ErrAvatarUploadNotFound, "nothing was uploaded yet")
+
+	current, err := repo.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	first := uploadAvatar(t, uc, blobs, alice.Id, "alice", testPNG)
+	updated, err := uc.UploadAvatar(ctx, alice.Id, "alice", first.Key)
+	require.NoError(t, err)
+	assert.Equal(t, first.Key, updated.AvatarKey)
+	assert.Equal(t, current.Version+1, updated.Version)
+
+	avatarURL, err := url.Parse(uc.AvatarURL(updated))
+	require.NoError(t, err)
+	_, err = signer.Verify(http.MethodGet, first.Key, avatarURL.Query(), time.Now().Add(avatarURLTTL))
+	assert.NoError(t, err, "avatar urls last at least avatarURLTTL")
+	assert.Empty(t, uc.AvatarURL(alice))
+
+	second := uploadAvatar(t, uc, blobs, alice.Id, "alice", testJPEG)
+	updated, err = uc.UploadAvatar(ctx, alice.Id, "alice", second.Key)
+	require.NoError(t, err)
+	assert.Equal(t, second.Key, updated.AvatarKey)
+	_, err = blobs.Get(ctx, first.Key)
+	assert.ErrorIs(t, err, ErrNotFound, "the replaced avatar is deleted")
+}
+
+func TestUserAvatarUseCase_UploadAvatarRejects(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	bob, err := seedUserWithToken(t, repo, "bob", "bob@example.com", "secret", "token-bob", true)
+	require.NoError(t, err)
+	uc, blobs, _ := newTestAvatarUseCase(t, repo)
+
+	document := uploadAvatar(t, uc, blobs, alice.Id, "alice", []byte("%PDF-1.7 not an image"))
+	_, err = uc.UploadAvatar(ctx, alice.Id, "alice", document.Key)
+	assert.ErrorIs(t, err, ErrAvatarContentType)
+	_, err = blobs.Get(ctx, document.Key)
+	assert.ErrorIs(t, err, ErrNotFound, "rejected uploads are deleted")
+
+	huge := uploadAvatar(t, uc, blobs, alice.Id, "alice", append(append([]byte{}, testPNG...), make([]byte, MaxAvatarSize)...))
+	_, err = uc.UploadAvatar(ctx, alice.Id, "alice", huge.Key)
+	assert.ErrorIs(t, err, ErrAvatarTooLarge)
+
+	// Uploads of other users and other blobs cannot be claimed.
+	bobs := uploadAvatar(t, uc, blobs, bob.Id, "bob", testPNG)
+	_, err = uc.UploadAvatar(ctx, alice.Id, "alice", bobs.Key)
+	assert.ErrorIs(t, err, ErrAvatarUploadNotFound)
+	_, err = uc.UploadAvatar(ctx, alice.Id, "alice", "kyc/1/front")
+	assert.ErrorIs(t, err, ErrAvatarUploadNotFound)
+	_, err = uc.UploadAvatar(ctx, bob.Id, "alice", bobs.Key)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	_, err = uc.CreateUploadURL(ctx, bob.Id, "alice")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	user, err := repo.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.Empty(t, user.AvatarKey)
+}
+
+func TestAnonymizeDueDeletesAvatar(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc, blobs, _ := newTestAvatarUseCase(t, repo)
+	upload := uploadAvatar(t, uc, blobs, alice.Id, "alice", testPNG)
+	_, err := uc.UploadAvatar(ctx, alice.Id, "alice", upload.Key)
+	require.NoError(t, err)
+
+	_, err = NewUserDeleteUseCaseWithGracePeriod(repo, time.Hour).DeleteAccount(ctx, "alice")
+	require.NoError(t, err)
+	_, err = NewUserAnonymizeUseCaseWithDocuments(repo, repo, blobs).AnonymizeDue(ctx, time.Now().UTC().Add(2*time.Hour))
+	require.NoError(t, err)
+
+	_, err = blobs.Get(ctx, upload.Key)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
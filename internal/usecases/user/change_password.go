@@ -2,9 +2,11 @@ package user
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -18,9 +20,9 @@ func NewUserChangePasswordUseCase(repo ports.UserRepository) UserChangePasswordU
 }
 
 var (
-	ErrChangePasswordEmptyUsername  = errors.New("username is empty")
-	ErrChangePasswordEmptyNew       = errors.New("new password is empty")
-	ErrChangePasswordInvalidCurrent = errors.New("invalid current password")
+	ErrChangePasswordEmptyUsername  = apperrors.New(apperrors.ErrInvalidArgument, "USERNAME_REQUIRED", "username is empty")
+	ErrChangePasswordEmptyNew       = apperrors.New(apperrors.ErrInvalidArgument, "NEW_PASSWORD_REQUIRED", "new password is empty")
+	ErrChangePasswordInvalidCurrent = apperrors.New(apperrors.ErrPermissionDenied, "INVALID_CURRENT_PASSWORD", "invalid current password")
 )
 
 func (u UserChangePasswordUseCase) ChangePassword(ctx context.Context, username, oldPassword, newPassword string) error {
@@ -31,10 +33,13 @@ func (u UserChangePasswordUseCase) ChangePassword(ctx context.Context, username,
 		return ErrChangePasswordEmptyNew
 	}
 
-	login, _, err := u.repository.GetLoginInfo(ctx, username)
+	login, current, err := u.repository.GetLoginInfo(ctx, username)
 	if err != nil {
 		return fmt.Errorf("get login info: %w", err)
 	}
+	if err := requireActive(current); err != nil {
+		return err
+	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(login.Password), []byte(oldPassword)); err != nil {
 		return ErrChangePasswordInvalidCurrent
@@ -48,5 +53,6 @@ func (u UserChangePasswordUseCase) ChangePassword(ctx context.Context, username,
 	if err := u.repository.UpdatePassword(ctx, username, string(hashed)); err != nil {
 		return fmt.Errorf("update password: %w", err)
 	}
+	audit.Record(ctx, userentity.AuditEvent{UserID: current.Id, Action: userentity.AuditActionPasswordChange})
 	return nil
 }
@@ -0,0 +1,284 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var (
+	ErrInvalidCorporateAction     = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_CORPORATE_ACTION", "cash dividends need a positive amount per share; stock dividends and splits need more new shares than old shares")
+	ErrInvalidCorporateActionDate = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_CORPORATE_ACTION_DATE", "dates must be given as YYYY-MM-DD and the record date may not precede the ex-date")
+	ErrCorporateActionExDate      = apperrors.New(apperrors.ErrInvalidArgument, "CORPORATE_ACTION_EX_DATE", "the ex-date must be a trading day after today")
+	ErrCorporateActionExists      = apperrors.New(apperrors.ErrConflict, "CORPORATE_ACTION_EXISTS", "the stock already has a corporate action of this kind on the ex-date")
+	ErrCorporateActionNotFound    = apperrors.New(apperrors.ErrNotFound, "CORPORATE_ACTION_NOT_FOUND", "corporate action not found")
+	ErrCorporateActionApplied     = apperrors.New(apperrors.ErrFailedPrecondition, "CORPORATE_ACTION_APPLIED", "a corporate action cannot be deleted once it has been applied")
+)
+
+// corporateActionPayload is the outbox payload of the notice a holder gets
+// when a corporate action is applied. Amounts are in VND and dates are
+// YYYY-MM-DD.
+type corporateActionPayload struct {
+	Email          string `json:"email"`
+	Purpose        string `json:"purpose"`
+	Code           string `json:"code"`
+	ActionKind     string `json:"action_kind"`
+	CashPerShare   int64  `json:"cash_per_share"`
+	OldShares      int64  `json:"old_shares"`
+	NewShares      int64  `json:"new_shares"`
+	ExDate         string `json:"ex_date"`
+	RecordDate     string `json:"record_date"`
+	HeldShares     int64  `json:"held_shares"`
+	Cash           int64  `json:"cash"`
+	ReceivedShares int64  `json:"received_shares"`
+}
+
+// UserCorporateActionUseCase lets administrators schedule the dividends and
+// splits of listed stocks and applies them on their ex-dates: holders are
+// paid or receive their new shares, the prices recorded before the ex-date
+// are back-adjusted so charts and returns stay comparable, and every holder
+// is notified.
+type UserCorporateActionUseCase struct {
+	actions  ports.CorporateActionRepository
+	stocks   ports.StockRepository
+	calendar TradingCalendar
+}
+
+// NewUserCorporateActionUseCase takes ex-dates as trading days of calendar.
+func NewUserCorporateActionUseCase(actions ports.CorporateActionRepository, stocks ports.StockRepository, calendar TradingCalendar) UserCorporateActionUseCase {
+	return UserCorporateActionUseCase{actions: actions, stocks: stocks, calendar: calendar}
+}
+
+// CorporateActionInput describes a corporate action. CashPerShare is in
+// VND, for cash dividends; OldShares and NewShares are for stock dividends
+// and splits. Dates are YYYY-MM-DD.
+type CorporateActionInput struct {
+	Code         string
+	Kind         userentity.CorporateActionKind
+	CashPerShare int64
+	OldShares    int64
+	NewShares    int64
+	ExDate       string
+	RecordDate   string
+}
+
+// CorporateActionRunResult tells what a run of ApplyDue did.
+type CorporateActionRunResult struct {
+	// Date is the day the run applied actions for, at midnight UTC.
+	Date    time.Time
+	Applied int
+	// Holders is how many holdings the applied actions adjusted.
+	Holders int
+}
+
+// Create schedules a corporate action on a future trading day.
+func (u UserCorporateActionUseCase) Create(ctx context.Context, creatorID int64, input CorporateActionInput) (userentity.CorporateAction, error) {
+	action := userentity.CorporateAction{
+		Code:         strings.ToUpper(strings.TrimSpace(input.Code)),
+		Kind:         userentity.CorporateActionKind(strings.ToLower(strings.TrimSpace(string(input.Kind)))),
+		CashPerShare: input.CashPerShare,
+		OldShares:    input.OldShares,
+		NewShares:    input.NewShares,
+		CreatedBy:    creatorID,
+		CreatedAt:    time.Now().UTC(),
+	}
+	if !validCorporateActionTerms(action) {
+		return userentity.CorporateAction{}, ErrInvalidCorporateAction
+	}
+	exDate, err := time.Parse(time.DateOnly, strings.TrimSpace(input.ExDate))
+	if err != nil {
+		return userentity.CorporateAction{}, ErrInvalidCorporateActionDate
+	}
+	recordDate, err := time.Parse(time.DateOnly, strings.TrimSpace(input.RecordDate))
+	if err != nil || recordDate.Before(exDate) {
+		return userentity.CorporateAction{}, ErrInvalidCorporateActionDate
+	}
+	if !exDate.After(u.calendar.Date(action.CreatedAt)) || !u.calendar.TradingDay(u.exDateStart(exDate)) {
+		return userentity.CorporateAction{}, ErrCorporateActionExDate
+	}
+	action.ExDate, action.RecordDate = exDate, recordDate
+
+	created, err := u.actions.CreateCorporateAction(ctx, action)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperrors.ErrNotFound):
+			return userentity.CorporateAction{}, ErrStockNotFound
+		case errors.Is(err, apperrors.ErrConflict):
+			return userentity.CorporateAction{}, ErrCorporateActionExists
+		}
+		return userentity.CorporateAction{}, fmt.Errorf("create corporate action: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		Action: userentity.AuditActionCorporateAction,
+		Changes: map[string]userentity.AuditChange{
+			"action_id": {To: strconv.FormatInt(created.ID, 10)},
+			"code":      {To: created.Code},
+			"kind":      {To: string(created.Kind)},
+			"ex_date":   {To: created.ExDate.Format(time.DateOnly)},
+		},
+	})
+	return created, nil
+}
+
+// List returns the actions on the stock with code, or on every stock when
+// code is empty, latest ex-date first.
+func (u UserCorporateActionUseCase) List(ctx context.Context, code string) ([]userentity.CorporateAction, error) {
+	actions, err := u.actions.ListCorporateActions(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, fmt.Errorf("list corporate actions: %w", err)
+	}
+	return actions, nil
+}
+
+// Delete drops an action that has not been applied yet.
+func (u UserCorporateActionUseCase) Delete(ctx context.Context, actionID int64) error {
+	err := u.actions.DeleteCorporateAction(ctx, actionID)
+	switch {
+	case errors.Is(err, apperrors.ErrNotFound):
+		return ErrCorporateActionNotFound
+	case errors.Is(err, apperrors.ErrFailedPrecondition):
+		return ErrCorporateActionApplied
+	case err != nil:
+		return fmt.Errorf("delete corporate action: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		Action:  userentity.AuditActionCorporateAction,
+		Changes: map[string]userentity.AuditChange{"action_id": {From: strconv.FormatInt(actionID, 10)}},
+	})
+	return nil
+}
+
+// ApplyDue applies the scheduled actions whose ex-date is on or before the
+// day of t in the calendar's zone, so a run missed on an earlier day is
+// caught up. It is meant to run before the market opens; running it again
+// applies nothing more.
+func (u UserCorporateActionUseCase) ApplyDue(ctx context.Context, t time.Time) (CorporateActionRunResult, error) {
+	result := CorporateActionRunResult{Date: u.calendar.Date(t)}
+	due, err := u.actions.ListDueCorporateActions(ctx, result.Date)
+	if err != nil {
+		return result, fmt.Errorf("list due corporate actions: %w", err)
+	}
+	for _, action := range due {
+		applied, holders, err := u.apply(ctx, action)
+		if err != nil {
+			return result, fmt.Errorf("apply corporate action %d: %w", action.ID, err)
+		}
+		if applied {
+			result.Applied++
+			result.Holders += holders
+		}
+	}
+	return result, nil
+}
+
+// apply pays the holders at the start of the ex-date what action entitles
+// them to and back-adjusts the prices recorded before it.
+func (u UserCorporateActionUseCase) apply(ctx context.Context, action userentity.CorporateAction) (bool, int, error) {
+	start := u.exDateStart(action.ExDate)
+	holders, err := u.actions.ListStockHolders(ctx, action.StockID, start)
+	if err != nil {
+		return false, 0, fmt.Errorf("list stock holders: %w", err)
+	}
+	var lastClose int64
+	if action.Kind == userentity.CorporateActionCashDividend {
+		quotes, err := u.stocks.LatestStockQuotesBefore(ctx, []string{action.Code}, start)
+		if err != nil {
+			return false, 0, fmt.Errorf("last close: %w", err)
+		}
+		if len(quotes) > 0 {
+			lastClose = quotes[0].Price
+		}
+	}
+
+	params := ports.ApplyCorporateActionParams{
+		ActionID:     action.ID,
+		Entries:      make([]userentity.LedgerEntry, 0, len(holders)),
+		Adjustment:   action.PriceAdjustment(lastClose),
+		PricesBefore: start,
+		Events:       make([]userentity.OutboxEvent, 0, len(holders)),
+		At:           time.Now().UTC(),
+	}
+	note := fmt.Sprintf("%s %s %s", action.Code, strings.ReplaceAll(string(action.Kind), "_", " "), action.ExDate.Format(time.DateOnly))
+	adjusted := 0
+	for _, holder := range holders {
+		cash, shares := action.Entitlement(holder.Shares)
+		if cash == 0 && shares == 0 {
+			continue
+		}
+		adjusted++
+		if cash != 0 {
+			params.Entries = append(params.Entries, userentity.LedgerEntry{UserID: holder.Owner.Id, Amount: cash, Type: userentity.LedgerEntryCorporateAction, Note: note})
+		}
+		if shares != 0 {
+			params.Entries = append(params.Entries, userentity.LedgerEntry{UserID: holder.Owner.Id, Code: action.Code, Amount: shares, Type: userentity.LedgerEntryCorporateAction, Note: note})
+		}
+		if holder.Owner.Status == userentity.AccountStatusDeleted {
+			continue
+		}
+		event, err := corporateActionEvent(holder, action, cash, shares)
+		if err != nil {
+			return false, 0, err
+		}
+		params.Events = append(params.Events, event)
+	}
+	applied, err := u.actions.ApplyCorporateAction(ctx, params)
+	if err != nil {
+		return false, 0, err
+	}
+	return applied, adjusted, nil
+}
+
+// exDateStart is the start of the ex-date, in the calendar's zone, of an
+// action whose ExDate is given at midnight UTC.
+func (u UserCorporateActionUseCase) exDateStart(exDate time.Time) time.Time {
+	return time.Date(exDate.Year(), exDate.Month(), exDate.Day(), 0, 0, 0, 0, u.calendar.Location())
+}
+
+func validCorporateActionTerms(action userentity.CorporateAction) bool {
+	switch action.Kind {
+	case userentity.CorporateActionCashDividend:
+		return action.CashPerShare > 0 && action.OldShares == 0 && action.NewShares == 0
+	case userentity.CorporateActionStockDividend, userentity.CorporateActionSplit:
+		return action.CashPerShare == 0 && action.OldShares > 0 && action.NewShares > action.OldShares
+	}
+	return false
+}
+
+func corporateActionEvent(holder ports.StockHolder, action userentity.CorporateAction, cash, shares int64) (userentity.OutboxEvent, error) {
+	payload, err := json.Marshal(corporateActionPayload{
+		Email:          holder.Owner.Email,
+		Purpose:        corporateActionPurpose,
+		Code:           action.Code,
+		ActionKind:     string(action.Kind),
+		CashPerShare:   action.CashPerShare,
+		OldShares:      action.OldShares,
+		NewShares:      action.NewShares,
+		ExDate:         action.ExDate.Format(time.DateOnly),
+		RecordDate:     action.RecordDate.Format(time.DateOnly),
+		HeldShares:     holder.Shares,
+		Cash:           cash,
+		ReceivedShares: shares,
+	})
+	if err != nil {
+		return userentity.OutboxEvent{}, fmt.Errorf("marshal corporate action payload: %w", err)
+	}
+	now := time.Now().UTC()
+	return userentity.OutboxEvent{
+		AggregateID:   holder.Owner.Id,
+		AggregateType: "user",
+		EventType:     "user.corporate_action.applied",
+		Payload:       payload,
+		Status:        userentity.OutboxEventStatusPending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
@@ -0,0 +1,192 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func corporateActionNotices(t *testing.T, repo *database.InMemoryUserRepository, userID int64) []corporateActionPayload {
+	t.Helper()
+	data, err := repo.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	var notices []corporateActionPayload
+	for _, event := range data.OutboxEvents {
+		if event.EventType != "user.corporate_action.applied" {
+			continue
+		}
+		var payload corporateActionPayload
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		notices = append(notices, payload)
+	}
+	return notices
+}
+
+func TestUserCorporateActionUseCase_Manage(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	seedStocks(repo, "VNM")
+	uc := NewUserCorporateActionUseCase(repo, repo, hoseCalendar(t))
+	dividend := CorporateActionInput{Code: " vnm ", Kind: userentity.CorporateActionCashDividend, CashPerShare: 2000, ExDate: "2099-01-05", RecordDate: "2099-01-06"}
+
+	invalid := map[string]func(CorporateActionInput) CorporateActionInput{
+		"unknown kind":          func(in CorporateActionInput) CorporateActionInput { in.Kind = "rights"; return in },
+		"no dividend":           func(in CorporateActionInput) CorporateActionInput { in.CashPerShare = 0; return in },
+		"dividend with a ratio": func(in CorporateActionInput) CorporateActionInput { in.OldShares, in.NewShares = 1, 2; return in },
+		"reverse split": func(in CorporateActionInput) CorporateActionInput {
+			in.Kind, in.CashPerShare, in.OldShares, in.NewShares = userentity.CorporateActionSplit, 0, 2, 1
+			return in
+		},
+		"split with a dividend": func(in CorporateActionInput) CorporateActionInput {
+			in.Kind, in.OldShares, in.NewShares = userentity.CorporateActionSplit, 1, 2
+			return in
+		},
+		"malformed ex-date":     func(in CorporateActionInput) CorporateActionInput { in.ExDate = "05/01/2099"; return in },
+		"record before ex-date": func(in CorporateActionInput) CorporateActionInput { in.RecordDate = "2099-01-02"; return in },
+		"ex-date in the past": func(in CorporateActionInput) CorporateActionInput {
+			in.ExDate, in.RecordDate = "2026-01-05", "2026-01-06"
+			return in
+		},
+		"ex-date on a weekend":   func(in CorporateActionInput) CorporateActionInput { in.ExDate = "2099-01-04"; return in },
+		"stock that is unlisted": func(in CorporateActionInput) CorporateActionInput { in.Code = "XYZ"; return in },
+	}
+	wants := map[string]error{
+		"malformed ex-date":      ErrInvalidCorporateActionDate,
+		"record before ex-date":  ErrInvalidCorporateActionDate,
+		"ex-date in the past":    ErrCorporateActionExDate,
+		"ex-date on a weekend":   ErrCorporateActionExDate,
+		"stock that is unlisted": ErrStockNotFound,
+	}
+	for name, change := range invalid {
+		t.Run(name, func(t *testing.T) {
+			want, ok := wants[name]
+			if !ok {
+				want = ErrInvalidCorporateAction
+			}
+			_, err := uc.Create(ctx, 1, change(dividend))
+			assert.ErrorIs(t, err, want)
+		})
+	}
+
+	created, err := uc.Create(ctx, 1, dividend)
+	require.NoError(t, err)
+	assert.Equal(t, "VNM", created.Code)
+	assert.Equal(t, userentity.CorporateActionStatusScheduled, created.Status)
+	assert.Equal(t, time.Date(2099, 1, 5, 0, 0, 0, 0, time.UTC), created.ExDate)
+	_, err = uc.Create(ctx, 1, dividend)
+	assert.ErrorIs(t, err, ErrCorporateActionExists)
+
+	split, err := uc.Create(ctx, 1, CorporateActionInput{Code: "VNM", Kind: userentity.CorporateActionSplit, OldShares: 1, NewShares: 2, ExDate: "2099-01-05", RecordDate: "2099-01-06"})
+	require.NoError(t, err, "a split may share the ex-date of a dividend")
+
+	actions, err := uc.List(ctx, "vnm")
+	require.NoError(t, err)
+	assert.Len(t, actions, 2)
+	actions, err = uc.List(ctx, "FPT")
+	require.NoError(t, err)
+	assert.Empty(t, actions)
+
+	require.NoError(t, uc.Delete(ctx, split.ID))
+	assert.ErrorIs(t, uc.Delete(ctx, split.ID), ErrCorporateActionNotFound)
+	actions, err = uc.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, created.ID, actions[0].ID)
+}
+
+func TestUserCorporateActionUseCase_ApplyDue(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob := seedTradingUser(t, repo, "bob")
+	carol := seedTradingUser(t, repo, "carol")
+	seedStocks(repo, "VNM")
+	fundAccount(t, repo, alice.Id, 1_000_000, 1000)
+	fundAccount(t, repo, bob.Id, 1_000_000, 333)
+	fundAccount(t, repo, carol.Id, 1_000_000, 0)
+	require.NoError(t, repo.RecordStockPrice("VNM", 50000, vnTime("2099-01-02", "14:45")))
+	require.NoError(t, repo.RecordStockPrice("VNM", 48500, vnTime("2099-01-05", "10:00")))
+	uc := NewUserCorporateActionUseCase(repo, repo, hoseCalendar(t))
+
+	dividend, err := uc.Create(ctx, 1, CorporateActionInput{Code: "VNM", Kind: userentity.CorporateActionCashDividend, CashPerShare: 2000, ExDate: "2099-01-05", RecordDate: "2099-01-06"})
+	require.NoError(t, err)
+	bonus, err := uc.Create(ctx, 1, CorporateActionInput{Code: "VNM", Kind: userentity.CorporateActionStockDividend, OldShares: 100, NewShares: 115, ExDate: "2099-01-06", RecordDate: "2099-01-07"})
+	require.NoError(t, err)
+
+	result, err := uc.ApplyDue(ctx, vnTime("2099-01-02", "08:00"))
+	require.NoError(t, err)
+	assert.Zero(t, result.Applied, "nothing is due before the ex-date")
+
+	result, err = uc.ApplyDue(ctx, vnTime("2099-01-05", "08:00"))
+	require.NoError(t, err)
+	assert.Equal(t, CorporateActionRunResult{Date: time.Date(2099, 1, 5, 0, 0, 0, 0, time.UTC), Applied: 1, Holders: 2}, result)
+	result, err = uc.ApplyDue(ctx, vnTime("2099-01-05", "09:00"))
+	require.NoError(t, err)
+	assert.Zero(t, result.Applied, "an action is applied once")
+
+	account, err := repo.GetTradingAccount(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3_000_000), account.Cash)
+	account, err = repo.GetTradingAccount(ctx, bob.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1_666_000), account.Cash)
+	account, err = repo.GetTradingAccount(ctx, carol.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1_000_000), account.Cash, "carol holds no VNM")
+
+	quotes, err := repo.LatestStockQuotesBefore(ctx, []string{"VNM"}, vnTime("2099-01-05", "00:00"))
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	assert.Equal(t, int64(48000), quotes[0].Price, "the close before the ex-date is back-adjusted by the dividend")
+
+	applied, err := uc.List(ctx, "VNM")
+	require.NoError(t, err)
+	require.Len(t, applied, 2)
+	assert.Equal(t, bonus.ID, applied[0].ID)
+	assert.Equal(t, userentity.CorporateActionStatusScheduled, applied[0].Status)
+	assert.Equal(t, userentity.CorporateActionStatusApplied, applied[1].Status)
+	assert.Equal(t, userentity.PriceAdjustment{Numerator: 48000, Denominator: 50000}, applied[1].Adjustment)
+	assert.ErrorIs(t, uc.Delete(ctx, dividend.ID), ErrCorporateActionApplied)
+
+	// A missed day is caught up by the next run.
+	result, err = uc.ApplyDue(ctx, vnTime("2099-01-07", "08:00"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Applied)
+
+	account, err = repo.GetTradingAccount(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1150), account.Shares("VNM"))
+	account, err = repo.GetTradingAccount(ctx, bob.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(382), account.Shares("VNM"), "fractions of a share are dropped")
+
+	quotes, err = repo.LatestStockQuotesBefore(ctx, []string{"VNM"}, vnTime("2099-01-05", "00:00"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(41739), quotes[0].Price)
+	quotes, err = repo.LatestStockQuotesBefore(ctx, []string{"VNM"}, vnTime("2099-01-06", "00:00"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42174), quotes[0].Price, "prices on the dividend's ex-date are adjusted by later actions")
+
+	notices := corporateActionNotices(t, repo, alice.Id)
+	require.Len(t, notices, 2)
+	assert.Equal(t, corporateActionPayload{
+		Email:        alice.Email,
+		Purpose:      corporateActionPurpose,
+		Code:         "VNM",
+		ActionKind:   "cash_dividend",
+		CashPerShare: 2000,
+		ExDate:       "2099-01-05",
+		RecordDate:   "2099-01-06",
+		HeldShares:   1000,
+		Cash:         2_000_000,
+	}, notices[0])
+	assert.Equal(t, int64(150), notices[1].ReceivedShares)
+	assert.Empty(t, corporateActionNotices(t, repo, carol.Id))
+}
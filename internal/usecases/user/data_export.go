@@ -0,0 +1,387 @@
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// DefaultDataExportTTL is how long a finished export can be downloaded.
+const DefaultDataExportTTL = 7 * 24 * time.Hour
+
+// dataExportStaleAfter is how long an export may stay processing before
+// another worker takes it over, e.g. after a crash.
+const dataExportStaleAfter = 15 * time.Minute
+
+var (
+	ErrInvalidExportFormat = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_EXPORT_FORMAT", "format must be json or zip")
+	ErrDataExportExpired   = apperrors.New(apperrors.ErrNotFound, "DATA_EXPORT_EXPIRED", "data export has expired")
+)
+
+// UserDataExportUseCase lets users request a copy of their personal data. The
+// archive is built asynchronously by ProcessNext and downloaded through Get.
+type UserDataExportUseCase struct {
+	users   ports.UserRepository
+	exports ports.DataExportRepository
+	ttl     time.Duration
+}
+
+func NewUserDataExportUseCase(users ports.UserRepository, exports ports.DataExportRepository) UserDataExportUseCase {
+	return NewUserDataExportUseCaseWithTTL(users, exports, DefaultDataExportTTL)
+}
+
+// NewUserDataExportUseCaseWithTTL allows configuring how long exports are kept.
+func NewUserDataExportUseCaseWithTTL(users ports.UserRepository, exports ports.DataExportRepository, ttl time.Duration) UserDataExportUseCase {
+	if ttl <= 0 {
+		ttl = DefaultDataExportTTL
+	}
+	return UserDataExportUseCase{users: users, exports: exports, ttl: ttl}
+}
+
+// Request queues an export of the account identified by username on behalf of
+// the authenticated user uid. An empty format means json.
+func (u UserDataExportUseCase) Request(ctx context.Context, uid int64, username, format string) (userentity.DataExport, error) {
+	exportFormat, err := parseDataExportFormat(format)
+	if err != nil {
+		return userentity.DataExport{}, err
+	}
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.DataExport{}, err
+	}
+
+	export, err := u.exports.CreateDataExport(ctx, owner.Id, exportFormat, time.Now().UTC())
+	if err != nil {
+		return userentity.DataExport{}, fmt.Errorf("create data export: %w", err)
+	}
+	return export, nil
+}
+
+// Get returns an export of the caller's account. Content is only set once the
+// export is ready; expired exports are reported as ErrDataExportExpired.
+func (u UserDataExportUseCase) Get(ctx context.Context, uid int64, username string, exportID int64) (userentity.DataExport, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.DataExport{}, err
+	}
+
+	export, err := u.exports.GetDataExport(ctx, owner.Id, exportID)
+	if err != nil {
+		return userentity.DataExport{}, fmt.Errorf("get data export: %w", err)
+	}
+	if !export.ExpiresAt.IsZero() && !export.ExpiresAt.After(time.Now().UTC()) {
+		return userentity.DataExport{}, ErrDataExportExpired
+	}
+	return export, nil
+}
+
+func (u UserDataExportUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
+
+// ProcessNext builds the oldest queued export and reports whether there was
+// one. When the personal data cannot be read the export stays processing and
+// is retried once it goes stale.
+func (u UserDataExportUseCase) ProcessNext(ctx context.Context, now time.Time) (bool, error) {
+	export, err := u.exports.ClaimDataExport(ctx, now, now.Add(-dataExportStaleAfter))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("claim data export: %w", err)
+	}
+
+	data, err := u.exports.GetPersonalData(ctx, export.UserID)
+	if err != nil {
+		return true, fmt.Errorf("get personal data of user %d: %w", export.UserID, err)
+	}
+
+	finish := ports.FinishDataExportParams{
+		ExportID:  export.ID,
+		Status:    userentity.DataExportStatusReady,
+		At:        now,
+		ExpiresAt: now.Add(u.ttl),
+	}
+	finish.Content, err = buildDataExport(data, export.Format, now)
+	if err != nil {
+		finish.Status = userentity.DataExportStatusFailed
+		finish.Error = err.Error()
+	}
+	if err := u.exports.FinishDataExport(ctx, finish); err != nil {
+		return true, fmt.Errorf("finish data export %d: %w", export.ID, err)
+	}
+	return true, nil
+}
+
+// DeleteExpired removes exports whose download window ended at now.
+func (u UserDataExportUseCase) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	removed, err := u.exports.DeleteExpiredDataExports(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired data exports: %w", err)
+	}
+	return removed, nil
+}
+
+func parseDataExportFormat(format string) (userentity.DataExportFormat, error) {
+	switch userentity.DataExportFormat(strings.ToLower(strings.TrimSpace(format))) {
+	case "", userentity.DataExportFormatJSON:
+		return userentity.DataExportFormatJSON, nil
+	case userentity.DataExportFormatZIP:
+		return userentity.DataExportFormatZIP, nil
+	}
+	return "", ErrInvalidExportFormat
+}
+
+// DataExportFileName returns the download name of an export.
+func DataExportFileName(export userentity.DataExport) string {
+	return fmt.Sprintf("personal-data-%d.%s", export.ID, export.Format)
+}
+
+// DataExportContentType returns the media type of an export's content.
+func DataExportContentType(format userentity.DataExportFormat) string {
+	if format == userentity.DataExportFormatZIP {
+		return "application/zip"
+	}
+	return "application/json"
+}
+
+// personalDataDocument is the exported representation of ports.PersonalData.
+// Secrets such as password hashes and token values are never included.
+type personalDataDocument struct {
+	GeneratedAt        time.Time                   `json:"generated_at"`
+	Profile            exportedProfile             `json:"profile"`
+	VerificationTokens []exportedVerificationToken `json:"verification_tokens"`
+	Notifications      []exportedNotification      `json:"notifications"`
+	LoginHistory       []exportedLogin             `json:"login_history"`
+	Kyc                []exportedKycSubmission     `json:"kyc"`
+}
+
+type exportedProfile struct {
+	ID               int64      `json:"id"`
+	Username         string     `json:"username"`
+	Name             string     `json:"name"`
+	Email            string     `json:"email"`
+	Cmnd             string     `json:"cmnd"`
+	Birthday         string     `json:"birthday"`
+	Gender           string     `json:"gender"`
+	PermanentAddress string     `json:"permanent_address"`
+	PhoneNumber      string     `json:"phone_number"`
+	Verified         bool       `json:"verified"`
+	VerifiedAt       *time.Time `json:"verified_at,omitempty"`
+	Status           string     `json:"status"`
+	KycStatus        string     `json:"kyc_status"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+type exportedVerificationToken struct {
+	Purpose    string     `json:"purpose"`
+	NewEmail   string     `json:"new_email,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	ConsumedAt *time.Time `json:"consumed_at,omitempty"`
+}
+
+type exportedNotification struct {
+	EventType   string          `json:"event_type"`
+	Status      string          `json:"status"`
+	Payload     json.RawMessage `json:"payload"`
+	CreatedAt   time.Time       `json:"created_at"`
+	ProcessedAt *time.Time      `json:"processed_at,omitempty"`
+}
+
+type exportedLogin struct {
+	Type      string    `json:"type"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	NewDevice bool      `json:"new_device"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// exportedKycSubmission describes a KYC submission and its images. The images
+// themselves are not part of the export.
+type exportedKycSubmission struct {
+	DocumentType    string                `json:"document_type"`
+	DocumentNumber  string                `json:"document_number"`
+	FullName        string                `json:"full_name"`
+	IssuedOn        string                `json:"issued_on,omitempty"`
+	ExpiresOn       string                `json:"expires_on,omitempty"`
+	Status          string                `json:"status"`
+	RejectionReason string                `json:"rejection_reason,omitempty"`
+	ReviewedAt      *time.Time            `json:"reviewed_at,omitempty"`
+	CreatedAt       time.Time             `json:"created_at"`
+	Documents       []exportedKycDocument `json:"documents"`
+}
+
+type exportedKycDocument struct {
+	Kind        string    `json:"kind"`
+	ContentType string    `json:"content_type"`
+	SizeBytes   int64     `json:"size_bytes"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func newPersonalDataDocument(data ports.PersonalData, now time.Time) personalDataDocument {
+	u := data.User
+	doc := personalDataDocument{
+		GeneratedAt: now,
+		Profile: exportedProfile{
+			ID:               u.Id,
+			Username:         u.Username,
+			Name:             u.Name,
+			Email:            u.Email,
+			Cmnd:             u.DocumentID,
+			Birthday:         u.Birthday.Format(time.DateOnly),
+			Gender:           genderLabel(u.Gender),
+			PermanentAddress: u.PermanentAddress,
+			PhoneNumber:      u.PhoneNumber,
+			Verified:         u.Verified,
+			Status:           string(u.Status),
+			KycStatus:        string(u.KycStatus),
+			CreatedAt:        u.CreatedAt,
+			UpdatedAt:        u.UpdatedAt,
+		},
+		VerificationTokens: make([]exportedVerificationToken, 0, len(data.VerificationTokens)),
+		Notifications:      make([]exportedNotification, 0, len(data.OutboxEvents)),
+		LoginHistory:       make([]exportedLogin, 0, len(data.LoginHistory)),
+		Kyc:                make([]exportedKycSubmission, 0, len(data.KycSubmissions)),
+	}
+	if !u.VerifiedAt.IsZero() {
+		verifiedAt := u.VerifiedAt
+		doc.Profile.VerifiedAt = &verifiedAt
+	}
+	for _, token := range data.VerificationTokens {
+		doc.VerificationTokens = append(doc.VerificationTokens, exportedVerificationToken{
+			Purpose:    string(token.Purpose),
+			NewEmail:   token.NewEmail,
+			CreatedAt:  token.CreatedAt,
+			ExpiresAt:  token.ExpiresAt,
+			ConsumedAt: token.ConsumedAt,
+		})
+	}
+	for _, event := range data.OutboxEvents {
+		doc.Notifications = append(doc.Notifications, exportedNotification{
+			EventType:   event.EventType,
+			Status:      string(event.Status),
+			Payload:     redactOutboxPayload(event.Payload),
+			CreatedAt:   event.CreatedAt,
+			ProcessedAt: event.ProcessedAt,
+		})
+	}
+	for _, record := range data.LoginHistory {
+		doc.LoginHistory = append(doc.LoginHistory, exportedLogin{
+			Type:      string(record.Type),
+			IP:        record.IP,
+			UserAgent: record.UserAgent,
+			NewDevice: record.NewDevice,
+			CreatedAt: record.CreatedAt,
+		})
+	}
+	for _, submission := range data.KycSubmissions {
+		doc.Kyc = append(doc.Kyc, newExportedKycSubmission(submission))
+	}
+	return doc
+}
+
+func newExportedKycSubmission(submission userentity.KycSubmission) exportedKycSubmission {
+	exported := exportedKycSubmission{
+		DocumentType:    string(submission.DocumentType),
+		DocumentNumber:  submission.DocumentNumber,
+		FullName:        submission.FullName,
+		Status:          string(submission.Status),
+		RejectionReason: submission.RejectionReason,
+		CreatedAt:       submission.CreatedAt,
+		Documents:       make([]exportedKycDocument, 0, len(submission.Documents)),
+	}
+	if !submission.IssuedOn.IsZero() {
+		exported.IssuedOn = submission.IssuedOn.Format(time.DateOnly)
+	}
+	if !submission.ExpiresOn.IsZero() {
+		exported.ExpiresOn = submission.ExpiresOn.Format(time.DateOnly)
+	}
+	if !submission.ReviewedAt.IsZero() {
+		reviewedAt := submission.ReviewedAt
+		exported.ReviewedAt = &reviewedAt
+	}
+	for _, document := range submission.Documents {
+		exported.Documents = append(exported.Documents, exportedKycDocument{
+			Kind:        string(document.Kind),
+			ContentType: document.ContentType,
+			SizeBytes:   document.SizeBytes,
+			CreatedAt:   document.CreatedAt,
+		})
+	}
+	return exported
+}
+
+// redactOutboxPayload drops the token from a notification payload; it is a
+// live credential rather than data about the user.
+func redactOutboxPayload(payload []byte) json.RawMessage {
+	var fields map[string]any
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return json.RawMessage(`{}`)
+	}
+	delete(fields, "token")
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return json.RawMessage(`{}`)
+	}
+	return raw
+}
+
+// buildDataExport renders the export archive: a single JSON document, or a
+// ZIP with one JSON file per section.
+func buildDataExport(data ports.PersonalData, format userentity.DataExportFormat, now time.Time) ([]byte, error) {
+	doc := newPersonalDataDocument(data, now)
+	if format != userentity.DataExportFormatZIP {
+		return json.MarshalIndent(doc, "", "  ")
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	sections := []struct {
+		name  string
+		value any
+	}{
+		{"profile.json", doc.Profile},
+		{"verification_tokens.json", doc.VerificationTokens},
+		{"notifications.json", doc.Notifications},
+		{"login_history.json", doc.LoginHistory},
+		{"kyc.json", doc.Kyc},
+	}
+	for _, section := range sections {
+		raw, err := json.MarshalIndent(section.value, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encode %s: %w", section.name, err)
+		}
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: section.name, Method: zip.Deflate, Modified: now})
+		if err != nil {
+			return nil, fmt.Errorf("add %s: %w", section.name, err)
+		}
+		if _, err := w.Write(raw); err != nil {
+			return nil, fmt.Errorf("write %s: %w", section.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("close zip: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,148 @@
+package user
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func TestDataExportJSON(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	_, err := repo.RecordLogin(ctx, ports.RecordLoginParams{Record: userentity.LoginRecord{
+		UserID: alice.Id, IP: "203.0.113.7", UserAgent: "Mozilla/5.0", DeviceFingerprint: "device-a",
+	}})
+	require.NoError(t, err)
+	uc := NewUserDataExportUseCase(repo, repo)
+
+	export, err := uc.Request(ctx, alice.Id, "alice", "")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.DataExportFormatJSON, export.Format)
+	assert.Equal(t, userentity.DataExportStatusPending, export.Status)
+
+	_, err = uc.Request(ctx, alice.Id, "alice", "json")
+	assert.ErrorIs(t, err, ErrConflict, "only one export may be in progress")
+
+	processed, err := uc.ProcessNext(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.True(t, processed)
+	processed, err = uc.ProcessNext(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.False(t, processed)
+
+	ready, err := uc.Get(ctx, alice.Id, "alice", export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.DataExportStatusReady, ready.Status)
+	assert.NotContains(t, string(ready.Content), "token-verified", "token values are not exported")
+
+	var doc personalDataDocument
+	require.NoError(t, json.Unmarshal(ready.Content, &doc))
+	assert.Equal(t, "alice", doc.Profile.Username)
+	assert.Equal(t, "alice@example.com", doc.Profile.Email)
+	assert.True(t, doc.Profile.Verified)
+	require.Len(t, doc.VerificationTokens, 1)
+	assert.NotNil(t, doc.VerificationTokens[0].ConsumedAt)
+	require.Len(t, doc.Notifications, 1)
+	assert.Equal(t, "user.verification.register", doc.Notifications[0].EventType)
+	require.Len(t, doc.LoginHistory, 1)
+	assert.Equal(t, "login", doc.LoginHistory[0].Type)
+	assert.Equal(t, "203.0.113.7", doc.LoginHistory[0].IP)
+	assert.NotContains(t, string(ready.Content), "device-a", "fingerprints are not exported")
+}
+
+func TestDataExportZIP(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc := NewUserDataExportUseCase(repo, repo)
+
+	export, err := uc.Request(ctx, alice.Id, "alice", "ZIP")
+	require.NoError(t, err)
+	_, err = uc.ProcessNext(ctx, time.Now().UTC())
+	require.NoError(t, err)
+
+	ready, err := uc.Get(ctx, alice.Id, "alice", export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "application/zip", DataExportContentType(ready.Format))
+	assert.Equal(t, "personal-data-1.zip", DataExportFileName(ready))
+
+	zr, err := zip.NewReader(bytes.NewReader(ready.Content), int64(len(ready.Content)))
+	require.NoError(t, err)
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[f.Name] = content
+	}
+	assert.Len(t, files, 5)
+	var profile exportedProfile
+	require.NoError(t, json.Unmarshal(files["profile.json"], &profile))
+	assert.Equal(t, alice.Id, profile.ID)
+	assert.Contains(t, files, "notifications.json")
+	assert.Contains(t, files, "verification_tokens.json")
+	assert.Contains(t, files, "login_history.json")
+	assert.Contains(t, files, "kyc.json")
+}
+
+func TestDataExportRejects(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc := NewUserDataExportUseCase(repo, repo)
+
+	_, err := uc.Request(ctx, alice.Id, "alice", "pdf")
+	assert.ErrorIs(t, err, ErrInvalidExportFormat)
+	_, err = uc.Request(ctx, alice.Id+1, "alice", "json")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	_, err = uc.Request(ctx, alice.Id, "", "json")
+	assert.ErrorIs(t, err, ErrEmptyUsername)
+
+	export, err := uc.Request(ctx, alice.Id, "alice", "json")
+	require.NoError(t, err)
+	_, err = uc.Get(ctx, alice.Id+1, "alice", export.ID)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	_, err = uc.Get(ctx, alice.Id, "alice", export.ID+1)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDataExportExpires(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc := NewUserDataExportUseCaseWithTTL(repo, repo, time.Hour)
+
+	export, err := uc.Request(ctx, alice.Id, "alice", "json")
+	require.NoError(t, err)
+	past := time.Now().UTC().Add(-2 * time.Hour)
+	_, err = uc.ProcessNext(ctx, past)
+	require.NoError(t, err)
+
+	_, err = uc.Get(ctx, alice.Id, "alice", export.ID)
+	assert.ErrorIs(t, err, ErrDataExportExpired)
+
+	removed, err := uc.DeleteExpired(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, removed)
+	_, err = uc.Get(ctx, alice.Id, "alice", export.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRedactOutboxPayload(t *testing.T) {
+	redacted := redactOutboxPayload([]byte(`{"email":"a@example.com","token":"secret","purpose":"register"}`))
+	assert.JSONEq(t, `{"email":"a@example.com","purpose":"register"}`, string(redacted))
+	assert.JSONEq(t, `{}`, string(redactOutboxPayload([]byte("not json"))))
+}
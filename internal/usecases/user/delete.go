@@ -3,50 +3,113 @@ package user
 import (
     "context"
     "fmt"
+    "time"
 
+    "github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+    "github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+    userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
     "github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+    "golang.org/x/crypto/bcrypt"
 )
 
+// DefaultDeletionGracePeriod is how long a deleted account can still be
+// reactivated before its personal data is anonymized.
+const DefaultDeletionGracePeriod = 30 * 24 * time.Hour
+
+// UserDeleteUseCase soft deletes accounts: they move to pending_deletion and
+// UserAnonymizeUseCase scrubs them once the grace period has passed.
 type UserDeleteUseCase struct {
-	repository ports.UserRepository
+	repository  ports.UserRepository
+	gracePeriod time.Duration
 }
 
 func NewUserDeleteUseCase(repo ports.UserRepository) UserDeleteUseCase {
-	return UserDeleteUseCase{repository: repo}
+	return NewUserDeleteUseCaseWithGracePeriod(repo, DefaultDeletionGracePeriod)
+}
+
+// NewUserDeleteUseCaseWithGracePeriod allows configuring the grace period.
+func NewUserDeleteUseCaseWithGracePeriod(repo ports.UserRepository, gracePeriod time.Duration) UserDeleteUseCase {
+	if gracePeriod <= 0 {
+		gracePeriod = DefaultDeletionGracePeriod
+	}
+	return UserDeleteUseCase{repository: repo, gracePeriod: gracePeriod}
 }
 
-func (u UserDeleteUseCase) DeleteAccount(ctx context.Context, username string) error {
+// DeleteAccount schedules the account for deletion and returns it with the
+// scheduled anonymization time. Its open orders are cancelled; an account
+// still holding cash or shares, or with a withdrawal in progress, is refused
+// with an ACCOUNT_NOT_EMPTY error.
+func (u UserDeleteUseCase) DeleteAccount(ctx context.Context, username string) (userentity.User, error) {
     if username == "" {
-        return ErrEmptyUsername
+        return userentity.User{}, ErrEmptyUsername
     }
-    if err := u.repository.DeleteUser(ctx, username); err != nil {
-        return fmt.Errorf("delete user got error: %w", err)
+    _, info, err := u.repository.GetLoginInfo(ctx, username)
+    if err != nil {
+        return userentity.User{}, fmt.Errorf("get login info: %w", err)
     }
-    return nil
+    return u.scheduleDeletion(ctx, info.Id)
 }
 
 // ErrEmptyUsername indicates the caller didn't provide a username to delete.
-var ErrEmptyUsername = fmt.Errorf("username is empty")
+var ErrEmptyUsername = apperrors.New(apperrors.ErrInvalidArgument, "USERNAME_REQUIRED", "username is empty")
 
 // ErrPermissionDenied indicates the authenticated user is not allowed
 // to operate on the target username.
-var ErrPermissionDenied = fmt.Errorf("permission denied")
+var ErrPermissionDenied = apperrors.New(apperrors.ErrPermissionDenied, "PERMISSION_DENIED", "permission denied")
 
-// DeleteAccountOwned deletes the account identified by username only if the
-// provided uid matches the account's user ID.
-func (u UserDeleteUseCase) DeleteAccountOwned(ctx context.Context, uid int64, username string) error {
+// DeleteAccountOwned schedules the account identified by username for deletion
+// only if the provided uid matches the account's user ID.
+func (u UserDeleteUseCase) DeleteAccountOwned(ctx context.Context, uid int64, username string) (userentity.User, error) {
     if username == "" {
-        return ErrEmptyUsername
+        return userentity.User{}, ErrEmptyUsername
     }
     _, info, err := u.repository.GetLoginInfo(ctx, username)
     if err != nil {
-        return fmt.Errorf("get login info: %w", err)
+        return userentity.User{}, fmt.Errorf("get login info: %w", err)
     }
     if info.Id != uid {
-        return ErrPermissionDenied
+        return userentity.User{}, ErrPermissionDenied
     }
-    if err := u.repository.DeleteUser(ctx, username); err != nil {
-        return fmt.Errorf("delete user got error: %w", err)
+    return u.scheduleDeletion(ctx, info.Id)
+}
+
+// EraseAccountOwned erases the caller's personal data without a grace period:
+// the account is due for anonymization immediately and the anonymizer job
+// scrubs it on its next run. The password is required because the erasure
+// cannot be undone.
+func (u UserDeleteUseCase) EraseAccountOwned(ctx context.Context, uid int64, username, password string) (userentity.User, error) {
+    if username == "" {
+        return userentity.User{}, ErrEmptyUsername
+    }
+    login, info, err := u.repository.GetLoginInfo(ctx, username)
+    if err != nil {
+        return userentity.User{}, fmt.Errorf("get login info: %w", err)
+    }
+    if info.Id != uid {
+        return userentity.User{}, ErrPermissionDenied
+    }
+    if err := bcrypt.CompareHashAndPassword([]byte(login.Password), []byte(password)); err != nil {
+        return userentity.User{}, ErrInvalidCredentials
+    }
+    return u.scheduleDeletionAt(ctx, info.Id, 0, userentity.AuditActionErase)
+}
+
+func (u UserDeleteUseCase) scheduleDeletion(ctx context.Context, userID int64) (userentity.User, error) {
+    return u.scheduleDeletionAt(ctx, userID, u.gracePeriod, userentity.AuditActionDelete)
+}
+
+func (u UserDeleteUseCase) scheduleDeletionAt(ctx context.Context, userID int64, gracePeriod time.Duration, action userentity.AuditAction) (userentity.User, error) {
+    now := time.Now().UTC()
+    deleted, err := u.repository.ChangeAccountStatus(ctx, ports.ChangeAccountStatusParams{
+        UserID:              userID,
+        From:                []userentity.AccountStatus{userentity.AccountStatusActive, userentity.AccountStatusDeactivated},
+        To:                  userentity.AccountStatusPendingDeletion,
+        At:                  now,
+        DeletionScheduledAt: now.Add(gracePeriod),
+    })
+    if err != nil {
+        return userentity.User{}, fmt.Errorf("delete user got error: %w", err)
     }
-    return nil
+    audit.Record(ctx, userentity.AuditEvent{UserID: deleted.Id, Action: action})
+    return deleted, nil
 }
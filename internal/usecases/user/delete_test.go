@@ -12,8 +12,8 @@ import (
 )
 
 type deleteRepo struct {
-	deleted string
-	err     error
+	status ports.ChangeAccountStatusParams
+	err    error
 }
 
 var _ ports.UserRepository = &deleteRepo{}
@@ -38,17 +38,36 @@ func (r *deleteRepo) VerifyUserWithToken(ctx context.Context, tokenID int64, use
 	return userentity.User{}, fmt.Errorf("not implemented")
 }
 
+func (r *deleteRepo) RequestEmailChange(ctx context.Context, params ports.RequestEmailChangeParams) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (r *deleteRepo) ConfirmEmailChange(ctx context.Context, tokenID int64, userID int64, confirmedAt time.Time) (userentity.User, error) {
+	return userentity.User{}, fmt.Errorf("not implemented")
+}
+
 func (r *deleteRepo) GetLoginInfo(ctx context.Context, userName string) (userentity.LoginMethodPassword, userentity.User, error) {
-	return userentity.LoginMethodPassword{}, userentity.User{}, fmt.Errorf("not implemented")
+	return userentity.LoginMethodPassword{UserName: userName}, userentity.User{Id: 7, Username: userName, Status: userentity.AccountStatusActive}, nil
 }
 
 func (r *deleteRepo) GetLatestVerificationToken(ctx context.Context, userID int64) (userentity.VerificationToken, error) {
 	return userentity.VerificationToken{}, fmt.Errorf("not implemented")
 }
 
-func (r *deleteRepo) DeleteUser(ctx context.Context, userName string) error {
-	r.deleted = userName
-	return r.err
+func (r *deleteRepo) ChangeAccountStatus(ctx context.Context, params ports.ChangeAccountStatusParams) (userentity.User, error) {
+	r.status = params
+	if r.err != nil {
+		return userentity.User{}, r.err
+	}
+	return userentity.User{Id: params.UserID, Status: params.To, DeletionScheduledAt: params.DeletionScheduledAt}, nil
+}
+
+func (r *deleteRepo) ListUsersDueForAnonymization(ctx context.Context, before time.Time, limit int) ([]userentity.User, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (r *deleteRepo) AnonymizeUser(ctx context.Context, userID int64, at time.Time) (userentity.User, error) {
+	return userentity.User{}, fmt.Errorf("not implemented")
 }
 
 func (r *deleteRepo) GetUser(ctx context.Context, userName string) (userentity.User, error) {
@@ -67,18 +86,39 @@ func (r *deleteRepo) UpdateUser(ctx context.Context, userName string, updated us
 	return nil
 }
 
+func (r *deleteRepo) UpdateAvatar(ctx context.Context, userID int64, avatarKey string, at time.Time) (userentity.User, error) {
+	return userentity.User{}, fmt.Errorf("not implemented")
+}
+
 func (r *deleteRepo) UpdatePassword(ctx context.Context, userName, hashedPassword string) error {
 	return nil
 }
 
 func TestDeleteAccount(t *testing.T) {
 	repo := &deleteRepo{}
-	uc := NewUserDeleteUseCase(repo)
+	uc := NewUserDeleteUseCaseWithGracePeriod(repo, 48*time.Hour)
 
-	err := uc.DeleteAccount(context.Background(), "alice123")
+	deleted, err := uc.DeleteAccount(context.Background(), "alice123")
 	assert.NoError(t, err)
-	assert.Equal(t, "alice123", repo.deleted)
+	assert.Equal(t, int64(7), repo.status.UserID)
+	assert.Equal(t, userentity.AccountStatusPendingDeletion, repo.status.To)
+	assert.ElementsMatch(t, []userentity.AccountStatus{userentity.AccountStatusActive, userentity.AccountStatusDeactivated}, repo.status.From)
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), repo.status.DeletionScheduledAt, time.Minute)
+	assert.Equal(t, repo.status.DeletionScheduledAt, deleted.DeletionScheduledAt)
 
-	err = uc.DeleteAccount(context.Background(), "")
+	_, err = uc.DeleteAccount(context.Background(), "")
 	assert.Error(t, err)
 }
+
+func TestDeleteAccountOwned(t *testing.T) {
+	repo := &deleteRepo{}
+	uc := NewUserDeleteUseCase(repo)
+
+	_, err := uc.DeleteAccountOwned(context.Background(), 8, "alice123")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	assert.Zero(t, repo.status.UserID)
+
+	deleted, err := uc.DeleteAccountOwned(context.Background(), 7, "alice123")
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(DefaultDeletionGracePeriod), deleted.DeletionScheduledAt, time.Minute)
+}
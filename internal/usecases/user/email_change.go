@@ -0,0 +1,181 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var (
+	ErrEmailChangeEmptyEmail = apperrors.New(apperrors.ErrInvalidArgument, "EMAIL_REQUIRED", "email is empty")
+	ErrEmailChangeUnchanged  = apperrors.New(apperrors.ErrInvalidArgument, "EMAIL_UNCHANGED", "new email equals the current email")
+	ErrEmailChangeEmptyToken = apperrors.New(apperrors.ErrInvalidArgument, "TOKEN_REQUIRED", "token is empty")
+)
+
+// UserEmailChangeUseCase changes a user's email in two steps: the request
+// stores the new address on a confirmation token, and the change is applied
+// only once that token (sent to the new address) is confirmed.
+type UserEmailChangeUseCase struct {
+	repository     ports.UserRepository
+	tokenTTL       time.Duration
+	tokenGenerator func() string
+}
+
+func NewUserEmailChangeUseCase(repo ports.UserRepository) UserEmailChangeUseCase {
+	return NewUserEmailChangeUseCaseWithTTL(repo, 24*time.Hour)
+}
+
+// NewUserEmailChangeUseCaseWithTTL allows configuring the confirmation token TTL.
+func NewUserEmailChangeUseCaseWithTTL(repo ports.UserRepository, tokenTTL time.Duration) UserEmailChangeUseCase {
+	if tokenTTL <= 0 {
+		tokenTTL = 24 * time.Hour
+	}
+	return UserEmailChangeUseCase{
+		repository:     repo,
+		tokenTTL:       tokenTTL,
+		tokenGenerator: func() string { return uuid.NewString() },
+	}
+}
+
+// RequestChange starts an email change for username on behalf of the
+// authenticated user uid. A confirmation token goes to newEmail and a notice to
+// the current address, both through the outbox.
+func (u UserEmailChangeUseCase) RequestChange(ctx context.Context, uid int64, username, newEmail string) error {
+	newEmail = strings.TrimSpace(newEmail)
+	if username == "" {
+		return ErrEmptyUsername
+	}
+	if newEmail == "" {
+		return ErrEmailChangeEmptyEmail
+	}
+
+	_, current, err := u.repository.GetLoginInfo(ctx, username)
+	if err != nil {
+		return fmt.Errorf("get login info: %w", err)
+	}
+	if current.Id != uid {
+		return ErrPermissionDenied
+	}
+	if err := requireActive(current); err != nil {
+		return err
+	}
+	if !current.Verified {
+		return ErrUnverified
+	}
+	if strings.EqualFold(current.Email, newEmail) {
+		return ErrEmailChangeUnchanged
+	}
+	// Same uniqueness rule as registration; the repository re-checks atomically.
+	if err := u.repository.CheckUserNameAndEmailIsExist(ctx, "", newEmail); err != nil {
+		return fmt.Errorf("check email: %w", err)
+	}
+
+	now := time.Now().UTC()
+	tokenValue := u.tokenGenerator()
+
+	confirmPayload, err := json.Marshal(verificationEmailPayload{
+		Email:   newEmail,
+		Token:   tokenValue,
+		Purpose: emailChangePurpose,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal email change payload: %w", err)
+	}
+	noticePayload, err := json.Marshal(emailChangeNoticePayload{
+		Email:    current.Email,
+		NewEmail: maskEmail(newEmail),
+		Purpose:  emailChangeNoticePurpose,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal email change notice payload: %w", err)
+	}
+
+	err = u.repository.RequestEmailChange(ctx, ports.RequestEmailChangeParams{
+		UserID: current.Id,
+		Token: userentity.VerificationToken{
+			Token:     tokenValue,
+			Purpose:   userentity.VerificationPurposeEmailChange,
+			NewEmail:  newEmail,
+			ExpiresAt: now.Add(u.tokenTTL),
+			CreatedAt: now,
+		},
+		OutboxEvents: []userentity.OutboxEvent{
+			{
+				AggregateType: "user",
+				EventType:     "user.email_change.confirm",
+				Payload:       confirmPayload,
+				Status:        userentity.OutboxEventStatusPending,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			},
+			{
+				AggregateType: "user",
+				EventType:     "user.email_change.notice",
+				Payload:       noticePayload,
+				Status:        userentity.OutboxEventStatusPending,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("request email change: %w", err)
+	}
+	return nil
+}
+
+// Confirm applies the pending email stored on token and returns the user.
+func (u UserEmailChangeUseCase) Confirm(ctx context.Context, token string) (userentity.User, error) {
+	if strings.TrimSpace(token) == "" {
+		return userentity.User{}, ErrEmailChangeEmptyToken
+	}
+
+	vt, owner, err := u.repository.FindVerificationToken(ctx, token)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("find email change token: %w", err)
+	}
+	if vt.Purpose != userentity.VerificationPurposeEmailChange {
+		return userentity.User{}, ErrVerifyTokenNotFound
+	}
+	if err := requireActive(owner); err != nil {
+		return userentity.User{}, err
+	}
+	if vt.ConsumedAt != nil {
+		return userentity.User{}, ErrVerifyTokenUsed
+	}
+	now := time.Now().UTC()
+	if vt.ExpiresAt.Before(now) {
+		return userentity.User{}, ErrVerifyTokenExpired
+	}
+
+	updated, err := u.repository.ConfirmEmailChange(ctx, vt.ID, owner.Id, now)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("confirm email change: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		UserID:  updated.Id,
+		ActorID: updated.Id,
+		Action:  userentity.AuditActionEmailChange,
+		Changes: map[string]userentity.AuditChange{"email": {From: owner.Email, To: updated.Email}},
+	})
+	return updated, nil
+}
+
+// maskEmail keeps the first character of the local part and the domain, e.g.
+// "alice@example.com" -> "a****@example.com".
+func maskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return "***"
+	}
+	local := []rune(email[:at])
+	return string(local[0]) + strings.Repeat("*", len(local)-1) + email[at:]
+}
@@ -0,0 +1,143 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+	"github.com/stretchr/testify/require"
+)
+
+// emailChangeRepo records the outbox events written by RequestEmailChange.
+type emailChangeRepo struct {
+	*database.InMemoryUserRepository
+	requests []ports.RequestEmailChangeParams
+}
+
+func (r *emailChangeRepo) RequestEmailChange(ctx context.Context, params ports.RequestEmailChangeParams) error {
+	r.requests = append(r.requests, params)
+	return r.InMemoryUserRepository.RequestEmailChange(ctx, params)
+}
+
+func newEmailChangeFixture(t *testing.T, verified bool) (*emailChangeRepo, UserEmailChangeUseCase, userentity.User) {
+	t.Helper()
+	repo := &emailChangeRepo{InMemoryUserRepository: database.NewInMemoryUserRepository()}
+	created := seedUpdateUser(t, repo.InMemoryUserRepository)
+	if verified {
+		vt, _, err := repo.FindVerificationToken(context.Background(), "token-update")
+		require.NoError(t, err)
+		created, err = repo.VerifyUserWithToken(context.Background(), vt.ID, created.Id, time.Now().UTC())
+		require.NoError(t, err)
+	}
+	uc := NewUserEmailChangeUseCase(repo)
+	uc.tokenGenerator = func() string { return "email-change-token" }
+	return repo, uc, created
+}
+
+func TestUserEmailChangeUseCase_RequestAndConfirm(t *testing.T) {
+	repo, uc, created := newEmailChangeFixture(t, true)
+	ctx := context.Background()
+
+	require.NoError(t, uc.RequestChange(ctx, created.Id, "alice123", "alice.new@example.com"))
+
+	current, err := repo.GetUser(ctx, "alice123")
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", current.Email, "email only changes after confirmation")
+
+	require.Len(t, repo.requests, 1)
+	req := repo.requests[0]
+	require.Equal(t, "alice.new@example.com", req.Token.NewEmail)
+	require.Len(t, req.OutboxEvents, 2)
+
+	var confirm verificationEmailPayload
+	require.NoError(t, json.Unmarshal(req.OutboxEvents[0].Payload, &confirm))
+	require.Equal(t, "user.email_change.confirm", req.OutboxEvents[0].EventType)
+	require.Equal(t, verificationEmailPayload{Email: "alice.new@example.com", Token: "email-change-token", Purpose: emailChangePurpose}, confirm)
+
+	var notice emailChangeNoticePayload
+	require.NoError(t, json.Unmarshal(req.OutboxEvents[1].Payload, &notice))
+	require.Equal(t, "user.email_change.notice", req.OutboxEvents[1].EventType)
+	require.Equal(t, emailChangeNoticePayload{Email: "alice@example.com", NewEmail: "a********@example.com", Purpose: emailChangeNoticePurpose}, notice)
+
+	updated, err := uc.Confirm(ctx, "email-change-token")
+	require.NoError(t, err)
+	require.Equal(t, "alice.new@example.com", updated.Email)
+	require.Greater(t, updated.Version, current.Version)
+
+	byEmail, err := repo.GetUserByEmail(ctx, "alice.new@example.com")
+	require.NoError(t, err)
+	require.Equal(t, created.Id, byEmail.Id)
+
+	_, err = uc.Confirm(ctx, "email-change-token")
+	require.ErrorIs(t, err, ErrVerifyTokenUsed)
+}
+
+func TestUserEmailChangeUseCase_RequestValidation(t *testing.T) {
+	ctx := context.Background()
+
+	_, uc, created := newEmailChangeFixture(t, false)
+	require.ErrorIs(t, uc.RequestChange(ctx, created.Id, "alice123", "alice.new@example.com"), ErrUnverified)
+
+	repo, uc, created := newEmailChangeFixture(t, true)
+	require.ErrorIs(t, uc.RequestChange(ctx, created.Id+1, "alice123", "alice.new@example.com"), ErrPermissionDenied)
+	require.ErrorIs(t, uc.RequestChange(ctx, created.Id, "alice123", " "), ErrEmailChangeEmptyEmail)
+	require.ErrorIs(t, uc.RequestChange(ctx, created.Id, "alice123", "ALICE@example.com"), ErrEmailChangeUnchanged)
+	require.Empty(t, repo.requests)
+}
+
+func TestUserEmailChangeUseCase_EmailTaken(t *testing.T) {
+	repo, uc, created := newEmailChangeFixture(t, true)
+	ctx := context.Background()
+
+	_, err := repo.CreateUserWithVerification(ctx, ports.CreateUserWithVerificationParams{
+		User:  userentity.User{Email: "bob@example.com", Name: "Bob"},
+		Login: userentity.LoginMethodPassword{UserName: "bob12345", Password: "hashed"},
+		Token: userentity.VerificationToken{Token: "token-bob", ExpiresAt: time.Now().Add(time.Hour)},
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, uc.RequestChange(ctx, created.Id, "alice123", "bob@example.com"), ErrConflict)
+	require.Empty(t, repo.requests)
+}
+
+func TestUserEmailChangeUseCase_ConfirmRejectsOtherTokens(t *testing.T) {
+	repo, uc, _ := newEmailChangeFixture(t, false)
+	ctx := context.Background()
+
+	_, err := uc.Confirm(ctx, "token-update")
+	require.ErrorIs(t, err, ErrVerifyTokenNotFound, "registration tokens cannot confirm an email change")
+
+	_, err = uc.Confirm(ctx, "")
+	require.ErrorIs(t, err, ErrEmailChangeEmptyToken)
+
+	current, err := repo.GetUser(ctx, "alice123")
+	require.NoError(t, err)
+	require.False(t, current.Verified)
+}
+
+func TestUserVerifyUseCase_RejectsEmailChangeToken(t *testing.T) {
+	repo, uc, created := newEmailChangeFixture(t, true)
+	ctx := context.Background()
+	require.NoError(t, uc.RequestChange(ctx, created.Id, "alice123", "alice.new@example.com"))
+
+	_, err := NewUserVerifyUseCase(repo).Verify(ctx, "email-change-token")
+	require.ErrorIs(t, err, ErrVerifyTokenNotFound)
+}
+
+func TestUserEmailChangeUseCase_ConfirmExpired(t *testing.T) {
+	repo, uc, created := newEmailChangeFixture(t, true)
+	ctx := context.Background()
+	uc.tokenTTL = -time.Minute
+	require.NoError(t, uc.RequestChange(ctx, created.Id, "alice123", "alice.new@example.com"))
+
+	_, err := uc.Confirm(ctx, "email-change-token")
+	require.ErrorIs(t, err, ErrVerifyTokenExpired)
+
+	current, err := repo.GetUser(ctx, "alice123")
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", current.Email)
+}
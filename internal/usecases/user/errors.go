@@ -0,0 +1,13 @@
+package user
+
+import "github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+
+// Shared user-domain errors. The use-case specific errors declared next to each
+// use case wrap one of the apperrors kinds as well, so transports can classify
+// any of them with errors.Is.
+var (
+	ErrNotFound           = apperrors.ErrNotFound
+	ErrConflict           = apperrors.ErrConflict
+	ErrUnverified         = apperrors.New(apperrors.ErrFailedPrecondition, "USER_NOT_VERIFIED", "user is not verified")
+	ErrInvalidCredentials = apperrors.New(apperrors.ErrUnauthenticated, "INVALID_CREDENTIALS", "invalid credentials")
+)
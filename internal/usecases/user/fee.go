@@ -0,0 +1,205 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// maxFeeRateBps caps every rate of a fee schedule at the whole value of a
+// fill.
+const maxFeeRateBps = 10000
+
+var (
+	ErrInvalidFeeSchedule  = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_FEE_SCHEDULE", "rates must be 0 to 10000 basis points, fees may not be negative, the maximum fee must be zero or at least the minimum and volume tiers must have ascending positive thresholds")
+	ErrFeeScheduleInPast   = apperrors.New(apperrors.ErrInvalidArgument, "FEE_SCHEDULE_IN_PAST", "a fee schedule must take effect in the future")
+	ErrFeeScheduleExists   = apperrors.New(apperrors.ErrConflict, "FEE_SCHEDULE_EXISTS", "the tier already has a fee schedule taking effect at this time")
+	ErrFeeScheduleNotFound = apperrors.New(apperrors.ErrNotFound, "FEE_SCHEDULE_NOT_FOUND", "fee schedule not found")
+	ErrFeeScheduleInEffect = apperrors.New(apperrors.ErrFailedPrecondition, "FEE_SCHEDULE_IN_EFFECT", "a fee schedule cannot be deleted once it has taken effect")
+)
+
+// FeeEngine prices fills with the fee schedule of the owner's trading tier
+// in force at the time of the fill. Users whose tier has no schedule pay
+// the schedule of userentity.DefaultTradingTier, and fills are free when
+// that has none either. Volume tiers look at the value the owner filled
+// since the start of the calendar month in the market's time zone.
+type FeeEngine struct {
+	fees     ports.FeeScheduleRepository
+	accounts ports.TradingAccountRepository
+	orders   ports.OrderRepository
+	location *time.Location
+}
+
+// NewFeeEngine counts monthly volumes in the time zone of calendar.
+func NewFeeEngine(fees ports.FeeScheduleRepository, accounts ports.TradingAccountRepository, orders ports.OrderRepository, calendar TradingCalendar) FeeEngine {
+	return FeeEngine{fees: fees, accounts: accounts, orders: orders, location: calendar.Location()}
+}
+
+// Charges prices a fill of quantity shares of order at price, at at. It is
+// the ports.FillPricer of the fills the engine charges, so the repository
+// prices the fills of a user one at a time under their lock and each sees
+// the monthly volume of the fills before it.
+func (e FeeEngine) Charges(ctx context.Context, order userentity.Order, price, quantity int64, at time.Time) (userentity.FeeCharges, error) {
+	account, err := e.accounts.GetTradingAccount(ctx, order.UserID)
+	if err != nil {
+		return userentity.FeeCharges{}, fmt.Errorf("get trading account: %w", err)
+	}
+	schedule, err := feeScheduleAt(ctx, e.fees, account.Tier, at)
+	if err != nil {
+		return userentity.FeeCharges{}, err
+	}
+
+	monthlyValue := int64(0)
+	if len(schedule.VolumeTiers) > 0 {
+		local := at.In(e.location)
+		month := time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, e.location)
+		if monthlyValue, err = e.orders.FilledValueSince(ctx, order.UserID, month); err != nil {
+			return userentity.FeeCharges{}, fmt.Errorf("filled value: %w", err)
+		}
+	}
+	return schedule.Charges(order.Side, price*quantity, monthlyValue), nil
+}
+
+// feeScheduleAt returns the schedule users of tier pay at at: the one of
+// their tier or else of userentity.DefaultTradingTier. It is the zero
+// schedule, which charges nothing, when neither has one or fees is nil.
+func feeScheduleAt(ctx context.Context, fees ports.FeeScheduleRepository, tier string, at time.Time) (userentity.FeeSchedule, error) {
+	if fees == nil {
+		return userentity.FeeSchedule{}, nil
+	}
+	schedule, err := fees.FeeScheduleAt(ctx, tier, at)
+	if errors.Is(err, apperrors.ErrNotFound) && tier != userentity.DefaultTradingTier {
+		schedule, err = fees.FeeScheduleAt(ctx, userentity.DefaultTradingTier, at)
+	}
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return userentity.FeeSchedule{}, nil
+	}
+	if err != nil {
+		return userentity.FeeSchedule{}, fmt.Errorf("fee schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// UserFeeScheduleUseCase lets administrators manage the fee schedules of the
+// trading tiers. Schedules are planned ahead: they take effect in the
+// future, and only those not in effect yet can be deleted. A schedule in
+// force stays until the next one of its tier takes effect.
+type UserFeeScheduleUseCase struct {
+	fees   ports.FeeScheduleRepository
+	config RiskConfig
+}
+
+// NewUserFeeScheduleUseCase accepts schedules for the tiers of config.
+func NewUserFeeScheduleUseCase(fees ports.FeeScheduleRepository, config RiskConfig) UserFeeScheduleUseCase {
+	return UserFeeScheduleUseCase{fees: fees, config: config}
+}
+
+// FeeScheduleInput describes a fee schedule. Rates are in basis points and
+// fees in VND; a zero MaxFee means no maximum.
+type FeeScheduleInput struct {
+	Tier          string
+	BuyRateBps    int64
+	SellRateBps   int64
+	MinFee        int64
+	MaxFee        int64
+	SellTaxBps    int64
+	VolumeTiers   []userentity.FeeVolumeTier
+	EffectiveFrom time.Time
+}
+
+// Create schedules the fees of a tier from input.EffectiveFrom on.
+func (u UserFeeScheduleUseCase) Create(ctx context.Context, creatorID int64, input FeeScheduleInput) (userentity.FeeSchedule, error) {
+	tier := strings.ToLower(strings.TrimSpace(input.Tier))
+	if !u.config.HasTier(tier) {
+		return userentity.FeeSchedule{}, ErrUnknownTradingTier
+	}
+	schedule := userentity.FeeSchedule{
+		Tier:          tier,
+		BuyRateBps:    input.BuyRateBps,
+		SellRateBps:   input.SellRateBps,
+		MinFee:        input.MinFee,
+		MaxFee:        input.MaxFee,
+		SellTaxBps:    input.SellTaxBps,
+		VolumeTiers:   input.VolumeTiers,
+		EffectiveFrom: input.EffectiveFrom.UTC().Truncate(time.Second),
+		CreatedBy:     creatorID,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if !validFeeSchedule(schedule) {
+		return userentity.FeeSchedule{}, ErrInvalidFeeSchedule
+	}
+	if !schedule.EffectiveFrom.After(schedule.CreatedAt) {
+		return userentity.FeeSchedule{}, ErrFeeScheduleInPast
+	}
+	created, err := u.fees.CreateFeeSchedule(ctx, schedule)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrConflict) {
+			return userentity.FeeSchedule{}, ErrFeeScheduleExists
+		}
+		return userentity.FeeSchedule{}, fmt.Errorf("create fee schedule: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		Action: userentity.AuditActionFeeSchedule,
+		Changes: map[string]userentity.AuditChange{
+			"schedule_id":    {To: strconv.FormatInt(created.ID, 10)},
+			"tier":           {To: created.Tier},
+			"effective_from": {To: created.EffectiveFrom.Format(time.RFC3339)},
+		},
+	})
+	return created, nil
+}
+
+// List returns the schedules of tier, or of every tier when tier is empty,
+// by tier and then date of effect.
+func (u UserFeeScheduleUseCase) List(ctx context.Context, tier string) ([]userentity.FeeSchedule, error) {
+	schedules, err := u.fees.ListFeeSchedules(ctx, strings.ToLower(strings.TrimSpace(tier)))
+	if err != nil {
+		return nil, fmt.Errorf("list fee schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// Delete drops a schedule that has not taken effect yet.
+func (u UserFeeScheduleUseCase) Delete(ctx context.Context, scheduleID int64) error {
+	err := u.fees.DeleteFeeSchedule(ctx, scheduleID, time.Now().UTC())
+	switch {
+	case errors.Is(err, apperrors.ErrNotFound):
+		return ErrFeeScheduleNotFound
+	case errors.Is(err, apperrors.ErrFailedPrecondition):
+		return ErrFeeScheduleInEffect
+	case err != nil:
+		return fmt.Errorf("delete fee schedule: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		Action:  userentity.AuditActionFeeSchedule,
+		Changes: map[string]userentity.AuditChange{"schedule_id": {From: strconv.FormatInt(scheduleID, 10)}},
+	})
+	return nil
+}
+
+func validFeeSchedule(schedule userentity.FeeSchedule) bool {
+	if !validFeeRate(schedule.BuyRateBps) || !validFeeRate(schedule.SellRateBps) || !validFeeRate(schedule.SellTaxBps) ||
+		schedule.MinFee < 0 || schedule.MaxFee < 0 || (schedule.MaxFee > 0 && schedule.MaxFee < schedule.MinFee) {
+		return false
+	}
+	floor := int64(0)
+	for _, tier := range schedule.VolumeTiers {
+		if tier.MinMonthlyValue <= floor || !validFeeRate(tier.BuyRateBps) || !validFeeRate(tier.SellRateBps) {
+			return false
+		}
+		floor = tier.MinMonthlyValue
+	}
+	return true
+}
+
+func validFeeRate(bps int64) bool {
+	return bps >= 0 && bps <= maxFeeRateBps
+}
@@ -0,0 +1,252 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// standardFees charges 0.15% with a minimum of 10,000 and a maximum of
+// 1,000,000 VND, 0.10% from 1 billion VND a month, and 0.1% tax on sales.
+var standardFees = userentity.FeeSchedule{
+	Tier:        userentity.DefaultTradingTier,
+	BuyRateBps:  15,
+	SellRateBps: 15,
+	MinFee:      10_000,
+	MaxFee:      1_000_000,
+	SellTaxBps:  10,
+	VolumeTiers: []userentity.FeeVolumeTier{{MinMonthlyValue: 1_000_000_000, BuyRateBps: 10, SellRateBps: 10}},
+	CreatedBy:   1,
+}
+
+// seedFeeSchedule stores schedule for tier from effectiveFrom, past dates
+// included.
+func seedFeeSchedule(t *testing.T, repo *database.InMemoryUserRepository, schedule userentity.FeeSchedule, tier string, effectiveFrom time.Time) userentity.FeeSchedule {
+	t.Helper()
+	schedule.Tier, schedule.EffectiveFrom = tier, effectiveFrom
+	created, err := repo.CreateFeeSchedule(context.Background(), schedule)
+	require.NoError(t, err)
+	return created
+}
+
+func TestFeeSchedule_Charges(t *testing.T) {
+	tests := []struct {
+		name    string
+		side    userentity.OrderSide
+		value   int64
+		monthly int64
+		want    userentity.FeeCharges
+	}{
+		{"rate", userentity.OrderSideBuy, 100_000_000, 0, userentity.FeeCharges{Fee: 150_000}},
+		{"minimum", userentity.OrderSideBuy, 1_000_000, 0, userentity.FeeCharges{Fee: 10_000}},
+		{"maximum", userentity.OrderSideBuy, 1_000_000_000, 0, userentity.FeeCharges{Fee: 1_000_000}},
+		{"sale tax", userentity.OrderSideSell, 100_000_000, 0, userentity.FeeCharges{Fee: 150_000, Tax: 100_000}},
+		{"tax below the minimum fee", userentity.OrderSideSell, 1_000_000, 0, userentity.FeeCharges{Fee: 10_000, Tax: 1_000}},
+		{"volume tier", userentity.OrderSideBuy, 100_000_000, 1_000_000_000, userentity.FeeCharges{Fee: 100_000}},
+		{"rounded half up", userentity.OrderSideSell, 23_333_333, 0, userentity.FeeCharges{Fee: 35_000, Tax: 23_333}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, standardFees.Charges(tc.side, tc.value, tc.monthly))
+		})
+	}
+}
+
+func TestFeeSchedule_MaxBuyFee(t *testing.T) {
+	steep := standardFees
+	steep.VolumeTiers = []userentity.FeeVolumeTier{{MinMonthlyValue: 1_000_000_000, BuyRateBps: 20, SellRateBps: 20}}
+	tests := []struct {
+		name     string
+		schedule userentity.FeeSchedule
+		value    int64
+		want     int64
+	}{
+		{"rate", standardFees, 100_000_000, 150_000},
+		{"minimum", standardFees, 1_000_000, 10_000},
+		{"maximum", standardFees, 1_000_000_000, 1_000_000},
+		{"highest volume tier rate", steep, 100_000_000, 200_000},
+		{"no schedule", userentity.FeeSchedule{}, 100_000_000, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.schedule.MaxBuyFee(tc.value))
+		})
+	}
+}
+
+func TestFeeEngine_Charges(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob := seedTradingUser(t, repo, "bob")
+	seedStocks(repo, "VNM")
+	engine := NewFeeEngine(repo, repo, repo, hoseCalendar(t))
+	buy := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 70000, 100_000, vnTime("2026-09-30", "10:00"))
+
+	charges, err := engine.Charges(ctx, buy, 70000, 1000, vnTime("2026-09-30", "10:01"))
+	require.NoError(t, err)
+	assert.Zero(t, charges, "fills are free without a schedule")
+
+	seedFeeSchedule(t, repo, standardFees, userentity.DefaultTradingTier, vnTime("2026-09-01", "00:00"))
+	vip := standardFees
+	vip.BuyRateBps, vip.SellRateBps, vip.MinFee, vip.VolumeTiers = 5, 5, 0, nil
+	seedFeeSchedule(t, repo, vip, "vip", vnTime("2026-10-01", "00:00"))
+
+	charges, err = engine.Charges(ctx, buy, 70000, 1000, vnTime("2026-09-30", "10:01"))
+	require.NoError(t, err)
+	assert.Equal(t, userentity.FeeCharges{Fee: 105_000}, charges)
+
+	// September's fills do not count towards October's volume; the month
+	// starts at midnight in the market's time zone.
+	_, err = repo.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "sep", Price: 70000, Quantity: 20_000, At: vnTime("2026-09-30", "10:01")})
+	require.NoError(t, err)
+	charges, err = engine.Charges(ctx, buy, 70000, 1000, vnTime("2026-10-01", "09:30"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(105_000), charges.Fee)
+	_, err = repo.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: buy.ID, ExecutionID: "oct", Price: 70000, Quantity: 15_000, At: vnTime("2026-10-01", "06:00")})
+	require.NoError(t, err)
+	charges, err = engine.Charges(ctx, buy, 70000, 1000, vnTime("2026-10-01", "09:30"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(70_000), charges.Fee, "1.05 billion VND filled this month")
+
+	// Tiers without a schedule in force pay the standard one.
+	_, err = repo.SetTradingTier(ctx, bob.Id, "vip", time.Now().UTC())
+	require.NoError(t, err)
+	sell := createOrder(t, repo, bob.Id, "VNM", userentity.OrderSideSell, userentity.TimeInForceGTC, 70000, 1000, vnTime("2026-09-30", "10:00"))
+	charges, err = engine.Charges(ctx, sell, 70000, 1000, vnTime("2026-09-30", "10:01"))
+	require.NoError(t, err)
+	assert.Equal(t, userentity.FeeCharges{Fee: 105_000, Tax: 70_000}, charges)
+	charges, err = engine.Charges(ctx, sell, 70000, 1000, vnTime("2026-10-01", "09:30"))
+	require.NoError(t, err)
+	assert.Equal(t, userentity.FeeCharges{Fee: 35_000, Tax: 70_000}, charges)
+}
+
+func TestUserOrderUseCase_RecordFillCharges(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	fundAccount(t, repo, alice.Id, 100_000_000, 0)
+	seedFeeSchedule(t, repo, standardFees, userentity.DefaultTradingTier, vnTime("2026-09-01", "00:00"))
+	calendar := hoseCalendar(t)
+	risk, fees := newTestRiskChain(t, repo), NewFeeEngine(repo, repo, repo, calendar)
+	uc := NewUserOrderUseCase(repo, repo, OrderConfig{Calendar: &calendar, Risk: &risk, Fees: &fees})
+	order := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 70000, 200, vnTime("2026-10-21", "10:00"))
+
+	filled, err := uc.RecordFill(ctx, RecordFillInput{OrderID: order.ID, ExecutionID: "x1", Price: 70000, Quantity: 200, At: vnTime("2026-10-21", "10:01")})
+	require.NoError(t, err)
+	assert.Equal(t, int64(21_000), filled.Fees)
+	assert.Zero(t, filled.Taxes)
+
+	_, events, err := uc.Get(ctx, alice.Id, "alice", order.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, int64(21_000), events[1].Fee)
+
+	account, err := repo.GetTradingAccount(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100_000_000-14_000_000-21_000), account.Cash)
+
+	_, err = uc.RecordFill(ctx, RecordFillInput{OrderID: order.ID + 100, ExecutionID: "x2", Price: 70000, Quantity: 100})
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+}
+
+func TestUserTradingSessionUseCase_AuctionCharges(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob := seedTradingUser(t, repo, "bob")
+	seedStocks(repo, "VNM")
+	seedFeeSchedule(t, repo, standardFees, userentity.DefaultTradingTier, vnTime("2026-09-01", "00:00"))
+	calendar := hoseCalendar(t)
+	fees := NewFeeEngine(repo, repo, repo, calendar)
+	uc := NewUserTradingSessionUseCase(repo, repo, repo, calendar, TradingSessionConfig{Fees: &fees})
+
+	preOpen := vnTime("2026-10-19", "09:05")
+	buy := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceDAY, 75000, 200, preOpen)
+	sell := createOrder(t, repo, bob.Id, "VNM", userentity.OrderSideSell, userentity.TimeInForceDAY, 75000, 200, preOpen)
+
+	report, err := uc.Advance(ctx, vnTime("2026-10-19", "09:14"), vnTime("2026-10-19", "09:16"))
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Matches)
+
+	bought, err := repo.GetOrder(ctx, buy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(22_500), bought.Fees)
+	assert.Zero(t, bought.Taxes)
+	sold, err := repo.GetOrder(ctx, sell.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(22_500), sold.Fees)
+	assert.Equal(t, int64(15_000), sold.Taxes)
+}
+
+func TestUserFeeScheduleUseCase(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	uc := NewUserFeeScheduleUseCase(repo, hoseRiskConfig)
+	tomorrow := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+	input := FeeScheduleInput{
+		Tier:          " VIP ",
+		BuyRateBps:    10,
+		SellRateBps:   12,
+		MinFee:        5_000,
+		SellTaxBps:    10,
+		VolumeTiers:   []userentity.FeeVolumeTier{{MinMonthlyValue: 500_000_000, BuyRateBps: 8, SellRateBps: 8}},
+		EffectiveFrom: tomorrow,
+	}
+
+	created, err := uc.Create(ctx, 1, input)
+	require.NoError(t, err)
+	assert.Equal(t, "vip", created.Tier)
+	assert.Equal(t, int64(1), created.CreatedBy)
+	assert.True(t, tomorrow.Equal(created.EffectiveFrom))
+
+	_, err = uc.Create(ctx, 1, input)
+	assert.ErrorIs(t, err, ErrFeeScheduleExists)
+
+	invalid := []struct {
+		name   string
+		change func(in *FeeScheduleInput)
+		want   error
+	}{
+		{"unknown tier", func(in *FeeScheduleInput) { in.Tier = "gold" }, ErrUnknownTradingTier},
+		{"rate above 100%", func(in *FeeScheduleInput) { in.SellRateBps = 10_001 }, ErrInvalidFeeSchedule},
+		{"negative minimum", func(in *FeeScheduleInput) { in.MinFee = -1 }, ErrInvalidFeeSchedule},
+		{"maximum below minimum", func(in *FeeScheduleInput) { in.MaxFee = 1_000 }, ErrInvalidFeeSchedule},
+		{"tier at zero", func(in *FeeScheduleInput) { in.VolumeTiers[0].MinMonthlyValue = 0 }, ErrInvalidFeeSchedule},
+		{"in the past", func(in *FeeScheduleInput) { in.EffectiveFrom = time.Now().Add(-time.Minute) }, ErrFeeScheduleInPast},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			in := input
+			in.VolumeTiers = append([]userentity.FeeVolumeTier(nil), input.VolumeTiers...)
+			in.EffectiveFrom = input.EffectiveFrom.Add(time.Hour)
+			tc.change(&in)
+			_, err := uc.Create(ctx, 1, in)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+
+	current := seedFeeSchedule(t, repo, standardFees, userentity.DefaultTradingTier, vnTime("2026-09-01", "00:00"))
+	schedules, err := uc.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	assert.Equal(t, current.ID, schedules[0].ID)
+	schedules, err = uc.List(ctx, "VIP")
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+
+	assert.ErrorIs(t, uc.Delete(ctx, current.ID), ErrFeeScheduleInEffect)
+	assert.ErrorIs(t, uc.Delete(ctx, created.ID+100), ErrFeeScheduleNotFound)
+	require.NoError(t, uc.Delete(ctx, created.ID))
+	schedules, err = uc.List(ctx, "vip")
+	require.NoError(t, err)
+	assert.Empty(t, schedules)
+}
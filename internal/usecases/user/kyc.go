@@ -0,0 +1,388 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// MaxKycDocumentSize is the largest document image accepted for upload.
+const MaxKycDocumentSize = 10 << 20
+
+const (
+	defaultKycSubmissionsPageSize = 50
+	maxKycSubmissionsPageSize     = 100
+)
+
+var (
+	ErrKycDocumentTooLarge       = apperrors.New(apperrors.ErrInvalidArgument, "KYC_DOCUMENT_TOO_LARGE", "document image must be at most 10 MiB")
+	ErrKycDocumentContentType    = apperrors.New(apperrors.ErrInvalidArgument, "KYC_DOCUMENT_CONTENT_TYPE", "document image must be a JPEG or PNG")
+	ErrKycDocumentKind           = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_KYC_DOCUMENT_KIND", "document kind must be front, back or selfie")
+	ErrKycDocumentNotFound       = apperrors.New(apperrors.ErrNotFound, "KYC_DOCUMENT_NOT_FOUND", "document not found or already submitted")
+	ErrKycDocumentsIncomplete    = apperrors.New(apperrors.ErrInvalidArgument, "KYC_DOCUMENTS_INCOMPLETE", "id cards need a front and a back image, passports a front image")
+	ErrKycDocumentNumberRequired = apperrors.New(apperrors.ErrInvalidArgument, "KYC_DOCUMENT_NUMBER_REQUIRED", "document number is empty")
+	ErrKycInvalidDocumentType    = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_KYC_DOCUMENT_TYPE", "document type must be id_card or passport")
+	ErrKycInvalidDecision        = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_KYC_DECISION", "decision must be approved or rejected")
+	ErrKycReasonRequired         = apperrors.New(apperrors.ErrInvalidArgument, "KYC_REJECTION_REASON_REQUIRED", "a rejection needs a reason")
+	ErrKycNotApproved            = apperrors.New(apperrors.ErrFailedPrecondition, "KYC_NOT_APPROVED", "identity verification is not approved")
+)
+
+// allowedKycContentTypes are the sniffed media types accepted for images.
+var allowedKycContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+// RequireKycApproved fails with ErrKycNotApproved unless the user passed
+// identity verification. Trading use cases call it before accepting orders.
+func RequireKycApproved(u userentity.User) error {
+	if u.KycStatus != userentity.KycStatusApproved {
+		return ErrKycNotApproved
+	}
+	return nil
+}
+
+// UserKycUseCase lets users verify their identity with document images that
+// administrators approve or reject. Images are kept in the blob store; the
+// repository only holds their metadata.
+type UserKycUseCase struct {
+	users ports.UserRepository
+	kyc   ports.KycRepository
+	blobs ports.BlobStore
+}
+
+func NewUserKycUseCase(users ports.UserRepository, kyc ports.KycRepository, blobs ports.BlobStore) UserKycUseCase {
+	return UserKycUseCase{users: users, kyc: kyc, blobs: blobs}
+}
+
+// SubmitKycInput is the document metadata a user submits for review.
+type SubmitKycInput struct {
+	DocumentType   string
+	DocumentNumber string
+	FullName       string
+	IssuedOn       time.Time
+	ExpiresOn      time.Time
+	DocumentIDs    []int64
+}
+
+// KycStatusResult is the verification state of a user with the newest
+// submission, which is nil before the first one.
+type KycStatusResult struct {
+	Status     userentity.KycStatus
+	Submission *userentity.KycSubmission
+}
+
+type KycSubmissionsResult struct {
+	Submissions []userentity.KycSubmission
+	// NextPageToken continues the listing after this page. It is empty when the
+	// page is not full.
+	NextPageToken string
+}
+
+// kycSubmissionsPageToken is the decoded form of a submissions page token.
+type kycSubmissionsPageToken struct {
+	Status  string `json:"s"`
+	AfterID int64  `json:"a"`
+}
+
+// kycStatusPayload tells the user about the progress of their verification.
+type kycStatusPayload struct {
+	Email        string `json:"email"`
+	Purpose      string `json:"purpose"`
+	Status       string `json:"status"`
+	Reason       string `json:"reason,omitempty"`
+	SubmissionID int64  `json:"submission_id,omitempty"`
+}
+
+// UploadDocument stores a document image of the caller's account. The content
+// type is sniffed from the content; only JPEG and PNG are accepted.
+func (u UserKycUseCase) UploadDocument(ctx context.Context, uid int64, username, kind string, content []byte) (userentity.KycDocument, error) {
+	documentKind := userentity.KycDocumentKind(strings.ToLower(strings.TrimSpace(kind)))
+	switch documentKind {
+	case userentity.KycDocumentKindFront, userentity.KycDocumentKindBack, userentity.KycDocumentKindSelfie:
+	default:
+		return userentity.KycDocument{}, ErrKycDocumentKind
+	}
+	if len(content) > MaxKycDocumentSize {
+		return userentity.KycDocument{}, ErrKycDocumentTooLarge
+	}
+	contentType := http.DetectContentType(content)
+	if !allowedKycContentTypes[contentType] {
+		return userentity.KycDocument{}, ErrKycDocumentContentType
+	}
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.KycDocument{}, err
+	}
+
+	key, err := newKycBlobKey(owner.Id)
+	if err != nil {
+		return userentity.KycDocument{}, err
+	}
+	if err := u.blobs.Put(ctx, key, bytes.NewReader(content), contentType); err != nil {
+		return userentity.KycDocument{}, fmt.Errorf("store document image: %w", err)
+	}
+	document, err := u.kyc.CreateKycDocument(ctx, userentity.KycDocument{
+		UserID:      owner.Id,
+		Kind:        documentKind,
+		BlobKey:     key,
+		ContentType: contentType,
+		SizeBytes:   int64(len(content)),
+		CreatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		// The image is unreachable without its record; removing it is best
+		// effort.
+		_ = u.blobs.Delete(ctx, key)
+		return userentity.KycDocument{}, fmt.Errorf("create kyc document: %w", err)
+	}
+	return document, nil
+}
+
+// Submit sends uploaded documents of the caller's account for review. An id
+// card needs its front and back, a passport its front; a selfie is optional.
+func (u UserKycUseCase) Submit(ctx context.Context, uid int64, username string, input SubmitKycInput) (userentity.KycSubmission, error) {
+	documentType := userentity.KycDocumentType(strings.ToLower(strings.TrimSpace(input.DocumentType)))
+	if documentType != userentity.KycDocumentTypeIDCard && documentType != userentity.KycDocumentTypePassport {
+		return userentity.KycSubmission{}, ErrKycInvalidDocumentType
+	}
+	number := strings.TrimSpace(input.DocumentNumber)
+	if number == "" {
+		return userentity.KycSubmission{}, ErrKycDocumentNumberRequired
+	}
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+
+	kinds := make(map[userentity.KycDocumentKind]bool)
+	for _, id := range input.DocumentIDs {
+		document, err := u.kyc.GetKycDocument(ctx, id)
+		if err != nil {
+			return userentity.KycSubmission{}, fmt.Errorf("get kyc document: %w", err)
+		}
+		if document.UserID != owner.Id {
+			return userentity.KycSubmission{}, ErrKycDocumentNotFound
+		}
+		kinds[document.Kind] = true
+	}
+	if !kinds[userentity.KycDocumentKindFront] || (documentType == userentity.KycDocumentTypeIDCard && !kinds[userentity.KycDocumentKindBack]) {
+		return userentity.KycSubmission{}, ErrKycDocumentsIncomplete
+	}
+
+	now := time.Now().UTC()
+	event, err := kycStatusEvent(owner.Email, userentity.KycStatusPending, "", 0, now)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+	submission, err := u.kyc.SubmitKyc(ctx, ports.SubmitKycParams{
+		Submission: userentity.KycSubmission{
+			UserID:         owner.Id,
+			DocumentType:   documentType,
+			DocumentNumber: number,
+			FullName:       strings.TrimSpace(input.FullName),
+			IssuedOn:       input.IssuedOn,
+			ExpiresOn:      input.ExpiresOn,
+			CreatedAt:      now,
+		},
+		DocumentIDs: input.DocumentIDs,
+		OutboxEvent: event,
+	})
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("submit kyc: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{UserID: owner.Id, Action: userentity.AuditActionKycSubmit})
+	return submission, nil
+}
+
+// Status returns the verification state of the caller's account.
+func (u UserKycUseCase) Status(ctx context.Context, uid int64, username string) (KycStatusResult, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return KycStatusResult{}, err
+	}
+	result := KycStatusResult{Status: owner.KycStatus}
+	if result.Status == "" {
+		result.Status = userentity.KycStatusNone
+	}
+	latest, err := u.kyc.GetLatestKycSubmission(ctx, owner.Id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return result, nil
+		}
+		return KycStatusResult{}, fmt.Errorf("get latest kyc submission: %w", err)
+	}
+	result.Submission = &latest
+	return result, nil
+}
+
+// List returns a page of submissions for administrators, oldest first so the
+// review queue is worked in order. An empty status lists every submission.
+func (u UserKycUseCase) List(ctx context.Context, status string, pageSize uint32, pageToken string) (KycSubmissionsResult, error) {
+	status = strings.ToLower(strings.TrimSpace(status))
+	switch userentity.KycStatus(status) {
+	case "", userentity.KycStatusPending, userentity.KycStatusApproved, userentity.KycStatusRejected:
+	default:
+		return KycSubmissionsResult{}, ErrListInvalidFilter
+	}
+	if pageSize == 0 {
+		pageSize = defaultKycSubmissionsPageSize
+	}
+	if pageSize > maxKycSubmissionsPageSize {
+		pageSize = maxKycSubmissionsPageSize
+	}
+	params := ports.ListKycSubmissionsParams{Status: userentity.KycStatus(status), Limit: int(pageSize)}
+	if pageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return KycSubmissionsResult{}, ErrListInvalidPageToken
+		}
+		var token kycSubmissionsPageToken
+		if err := json.Unmarshal(raw, &token); err != nil || token.Status != status || token.AfterID <= 0 {
+			return KycSubmissionsResult{}, ErrListInvalidPageToken
+		}
+		params.AfterID = token.AfterID
+	}
+
+	submissions, err := u.kyc.ListKycSubmissions(ctx, params)
+	if err != nil {
+		return KycSubmissionsResult{}, fmt.Errorf("list kyc submissions: %w", err)
+	}
+	result := KycSubmissionsResult{Submissions: submissions}
+	if len(submissions) == int(pageSize) {
+		raw, _ := json.Marshal(kycSubmissionsPageToken{Status: status, AfterID: submissions[len(submissions)-1].ID})
+		result.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	return result, nil
+}
+
+// Get returns a submission with the account it belongs to for administrators.
+func (u UserKycUseCase) Get(ctx context.Context, submissionID int64) (userentity.KycSubmission, userentity.User, error) {
+	submission, owner, err := u.kyc.GetKycSubmission(ctx, submissionID)
+	if err != nil {
+		return userentity.KycSubmission{}, userentity.User{}, fmt.Errorf("get kyc submission: %w", err)
+	}
+	return submission, owner, nil
+}
+
+// Document returns a document image with its content for administrators.
+func (u UserKycUseCase) Document(ctx context.Context, documentID int64) (userentity.KycDocument, []byte, error) {
+	document, err := u.kyc.GetKycDocument(ctx, documentID)
+	if err != nil {
+		return userentity.KycDocument{}, nil, fmt.Errorf("get kyc document: %w", err)
+	}
+	content, err := u.blobs.Get(ctx, document.BlobKey)
+	if err != nil {
+		return userentity.KycDocument{}, nil, fmt.Errorf("open document image: %w", err)
+	}
+	defer content.Close()
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(content); err != nil {
+		return userentity.KycDocument{}, nil, fmt.Errorf("read document image: %w", err)
+	}
+	return document, buf.Bytes(), nil
+}
+
+// Review approves or rejects a pending submission on behalf of the
+// administrator reviewerID and notifies the user. Rejections need a reason,
+// which is shown to the user.
+func (u UserKycUseCase) Review(ctx context.Context, reviewerID, submissionID int64, decision, reason string) (userentity.KycSubmission, error) {
+	status := userentity.KycStatus(strings.ToLower(strings.TrimSpace(decision)))
+	reason = strings.TrimSpace(reason)
+	switch status {
+	case userentity.KycStatusApproved:
+		reason = ""
+	case userentity.KycStatusRejected:
+		if reason == "" {
+			return userentity.KycSubmission{}, ErrKycReasonRequired
+		}
+	default:
+		return userentity.KycSubmission{}, ErrKycInvalidDecision
+	}
+
+	_, owner, err := u.kyc.GetKycSubmission(ctx, submissionID)
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("get kyc submission: %w", err)
+	}
+	now := time.Now().UTC()
+	event, err := kycStatusEvent(owner.Email, status, reason, submissionID, now)
+	if err != nil {
+		return userentity.KycSubmission{}, err
+	}
+	reviewed, err := u.kyc.ReviewKycSubmission(ctx, ports.ReviewKycParams{
+		SubmissionID: submissionID,
+		ReviewerID:   reviewerID,
+		Status:       status,
+		Reason:       reason,
+		At:           now,
+		OutboxEvent:  event,
+	})
+	if err != nil {
+		return userentity.KycSubmission{}, fmt.Errorf("review kyc submission: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		UserID: owner.Id,
+		Action: userentity.AuditActionKycReview,
+		Changes: map[string]userentity.AuditChange{
+			"kyc_status": {From: string(userentity.KycStatusPending), To: string(status)},
+		},
+	})
+	return reviewed, nil
+}
+
+func (u UserKycUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
+
+func kycStatusEvent(email string, status userentity.KycStatus, reason string, submissionID int64, now time.Time) (userentity.OutboxEvent, error) {
+	payload, err := json.Marshal(kycStatusPayload{
+		Email:        email,
+		Purpose:      kycStatusPurpose,
+		Status:       string(status),
+		Reason:       reason,
+		SubmissionID: submissionID,
+	})
+	if err != nil {
+		return userentity.OutboxEvent{}, fmt.Errorf("marshal kyc status payload: %w", err)
+	}
+	return userentity.OutboxEvent{
+		AggregateType: "user",
+		EventType:     "user.kyc.status_changed",
+		Payload:       payload,
+		Status:        userentity.OutboxEventStatusPending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// newKycBlobKey returns an unguessable blob key under the user's prefix.
+func newKycBlobKey(userID int64) (string, error) {
+	var random [16]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return "", fmt.Errorf("generate blob key: %w", err)
+	}
+	return fmt.Sprintf("kyc/%d/%s", userID, hex.EncodeToString(random[:])), nil
+}
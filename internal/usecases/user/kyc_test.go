@@ -0,0 +1,241 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/blobstore"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+var (
+	testPNG  = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 64)...)
+	testJPEG = append([]byte("\xff\xd8\xff\xe0"), make([]byte, 64)...)
+)
+
+func newTestKycUseCase(t *testing.T, repo *database.InMemoryUserRepository) (UserKycUseCase, *blobstore.LocalBlobStore) {
+	t.Helper()
+	blobs, err := blobstore.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	return NewUserKycUseCase(repo, repo, blobs), blobs
+}
+
+func kycNotices(t *testing.T, repo *database.InMemoryUserRepository, userID int64) []kycStatusPayload {
+	t.Helper()
+	data, err := repo.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	var notices []kycStatusPayload
+	for _, event := range data.OutboxEvents {
+		if event.EventType != "user.kyc.status_changed" {
+			continue
+		}
+		var payload kycStatusPayload
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		notices = append(notices, payload)
+	}
+	return notices
+}
+
+// submitIDCard uploads both sides of an id card and submits them.
+func submitIDCard(t *testing.T, uc UserKycUseCase, user userentity.User) userentity.KycSubmission {
+	t.Helper()
+	ctx := context.Background()
+	front, err := uc.UploadDocument(ctx, user.Id, user.Username, "front", testJPEG)
+	require.NoError(t, err)
+	back, err := uc.UploadDocument(ctx, user.Id, user.Username, "back", testPNG)
+	require.NoError(t, err)
+	submission, err := uc.Submit(ctx, user.Id, user.Username, SubmitKycInput{
+		DocumentType:   "id_card",
+		DocumentNumber: " 079090001234 ",
+		FullName:       "Alice Nguyen",
+		IssuedOn:       time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC),
+		DocumentIDs:    []int64{front.ID, back.ID},
+	})
+	require.NoError(t, err)
+	return submission
+}
+
+func TestUserKycUseCase_UploadDocument(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc, _ := newTestKycUseCase(t, repo)
+
+	document, err := uc.UploadDocument(ctx, alice.Id, "alice", "Front", testPNG)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.KycDocumentKindFront, document.Kind)
+	assert.Equal(t, "image/png", document.ContentType)
+	assert.Equal(t, int64(len(testPNG)), document.SizeBytes)
+
+	_, content, err := uc.Document(ctx, document.ID)
+	require.NoError(t, err)
+	assert.Equal(t, testPNG, content)
+
+	_, err = uc.UploadDocument(ctx, alice.Id, "alice", "front", []byte("%PDF-1.7 not an image"))
+	assert.ErrorIs(t, err, ErrKycDocumentContentType)
+	_, err = uc.UploadDocument(ctx, alice.Id, "alice", "front", make([]byte, MaxKycDocumentSize+1))
+	assert.ErrorIs(t, err, ErrKycDocumentTooLarge)
+	_, err = uc.UploadDocument(ctx, alice.Id, "alice", "palm", testPNG)
+	assert.ErrorIs(t, err, ErrKycDocumentKind)
+	_, err = uc.UploadDocument(ctx, alice.Id+1, "alice", "front", testPNG)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+}
+
+func TestUserKycUseCase_Submit(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc, _ := newTestKycUseCase(t, repo)
+
+	status, err := uc.Status(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.KycStatusNone, status.Status)
+	assert.Nil(t, status.Submission)
+
+	front, err := uc.UploadDocument(ctx, alice.Id, "alice", "front", testJPEG)
+	require.NoError(t, err)
+	input := SubmitKycInput{DocumentType: "id_card", DocumentNumber: "079090001234", DocumentIDs: []int64{front.ID}}
+	_, err = uc.Submit(ctx, alice.Id, "alice", input)
+	assert.ErrorIs(t, err, ErrKycDocumentsIncomplete, "an id card needs its back")
+
+	input.DocumentType = "driving_license"
+	_, err = uc.Submit(ctx, alice.Id, "alice", input)
+	assert.ErrorIs(t, err, ErrKycInvalidDocumentType)
+	input.DocumentType = "passport"
+	input.DocumentNumber = " "
+	_, err = uc.Submit(ctx, alice.Id, "alice", input)
+	assert.ErrorIs(t, err, ErrKycDocumentNumberRequired)
+
+	input.DocumentNumber = "B1234567"
+	submission, err := uc.Submit(ctx, alice.Id, "alice", input)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.KycStatusPending, submission.Status)
+	assert.Equal(t, userentity.KycDocumentTypePassport, submission.DocumentType)
+	require.Len(t, submission.Documents, 1)
+
+	status, err = uc.Status(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.KycStatusPending, status.Status)
+	require.NotNil(t, status.Submission)
+	assert.Equal(t, submission.ID, status.Submission.ID)
+
+	notices := kycNotices(t, repo, alice.Id)
+	require.Len(t, notices, 1)
+	assert.Equal(t, "alice@example.com", notices[0].Email)
+	assert.Equal(t, kycStatusPurpose, notices[0].Purpose)
+	assert.Equal(t, "pending", notices[0].Status)
+
+	// Another user's documents cannot be submitted.
+	bob, err := seedUserWithToken(t, repo, "bob", "bob@example.com", "secret", "token-bob", true)
+	require.NoError(t, err)
+	_, err = uc.Submit(ctx, bob.Id, "bob", SubmitKycInput{DocumentType: "passport", DocumentNumber: "C7654321", DocumentIDs: []int64{front.ID}})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestUserKycUseCase_Review(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc, _ := newTestKycUseCase(t, repo)
+	first := submitIDCard(t, uc, alice)
+	assert.Equal(t, "079090001234", first.DocumentNumber)
+
+	_, err := uc.Review(ctx, 99, first.ID, "rejected", " ")
+	assert.ErrorIs(t, err, ErrKycReasonRequired)
+	_, err = uc.Review(ctx, 99, first.ID, "pending", "")
+	assert.ErrorIs(t, err, ErrKycInvalidDecision)
+
+	rejected, err := uc.Review(ctx, 99, first.ID, "rejected", "The photo is blurred")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.KycStatusRejected, rejected.Status)
+	assert.Equal(t, int64(99), rejected.ReviewerID)
+	_, err = uc.Review(ctx, 99, first.ID, "approved", "")
+	assert.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	user, err := repo.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.ErrorIs(t, RequireKycApproved(user), ErrKycNotApproved)
+
+	second := submitIDCard(t, uc, alice)
+	_, err = uc.Review(ctx, 99, second.ID, "approved", "ignored")
+	require.NoError(t, err)
+	user, err = repo.GetUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.NoError(t, RequireKycApproved(user))
+
+	notices := kycNotices(t, repo, alice.Id)
+	require.Len(t, notices, 4)
+	assert.Equal(t, "rejected", notices[1].Status)
+	assert.Equal(t, "The photo is blurred", notices[1].Reason)
+	assert.Equal(t, first.ID, notices[1].SubmissionID)
+	assert.Equal(t, "approved", notices[3].Status)
+	assert.Empty(t, notices[3].Reason)
+
+	got, owner, err := uc.Get(ctx, second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, alice.Id, owner.Id)
+	assert.Equal(t, userentity.KycStatusApproved, got.Status)
+}
+
+func TestUserKycUseCase_List(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	uc, _ := newTestKycUseCase(t, repo)
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	bob, err := seedUserWithToken(t, repo, "bob", "bob@example.com", "secret", "token-bob", true)
+	require.NoError(t, err)
+	carol, err := seedUserWithToken(t, repo, "carol", "carol@example.com", "secret", "token-carol", true)
+	require.NoError(t, err)
+	var ids []int64
+	for _, user := range []userentity.User{alice, bob, carol} {
+		ids = append(ids, submitIDCard(t, uc, user).ID)
+	}
+	_, err = uc.Review(ctx, 99, ids[0], "approved", "")
+	require.NoError(t, err)
+
+	first, err := uc.List(ctx, "pending", 1, "")
+	require.NoError(t, err)
+	require.Len(t, first.Submissions, 1)
+	assert.Equal(t, ids[1], first.Submissions[0].ID)
+	require.NotEmpty(t, first.NextPageToken)
+	second, err := uc.List(ctx, "pending", 1, first.NextPageToken)
+	require.NoError(t, err)
+	require.Len(t, second.Submissions, 1)
+	assert.Equal(t, ids[2], second.Submissions[0].ID)
+
+	all, err := uc.List(ctx, "", 0, "")
+	require.NoError(t, err)
+	assert.Len(t, all.Submissions, 3)
+	assert.Empty(t, all.NextPageToken)
+
+	_, err = uc.List(ctx, "approved", 1, first.NextPageToken)
+	assert.ErrorIs(t, err, ErrListInvalidPageToken, "tokens are bound to the status filter")
+	_, err = uc.List(ctx, "unknown", 0, "")
+	assert.ErrorIs(t, err, ErrListInvalidFilter)
+}
+
+func TestAnonymizeDueDeletesKycImages(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	kyc, blobs := newTestKycUseCase(t, repo)
+	submission := submitIDCard(t, kyc, alice)
+
+	_, err := NewUserDeleteUseCaseWithGracePeriod(repo, time.Hour).DeleteAccount(ctx, "alice")
+	require.NoError(t, err)
+	count, err := NewUserAnonymizeUseCaseWithDocuments(repo, repo, blobs).AnonymizeDue(ctx, time.Now().UTC().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	for _, document := range submission.Documents {
+		_, err := blobs.Get(ctx, document.BlobKey)
+		assert.ErrorIs(t, err, ErrNotFound)
+	}
+}
@@ -2,8 +2,15 @@ package user
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
 	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 )
@@ -13,6 +20,12 @@ const (
 	maxListPageSize     = 100
 )
 
+var (
+	ErrListInvalidOrderBy   = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER_BY", "order_by must be one of id, username, email, name, created_at optionally followed by asc or desc")
+	ErrListInvalidFilter    = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_FILTER", "created_after must be before created_before")
+	ErrListInvalidPageToken = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_PAGE_TOKEN", "page token is invalid or does not match the request")
+)
+
 type UserListUseCase struct {
 	repository ports.UserRepository
 }
@@ -21,14 +34,30 @@ func NewUserListUseCase(repo ports.UserRepository) UserListUseCase {
 	return UserListUseCase{repository: repo}
 }
 
+// ListUsersQuery selects a page of users. When PageToken is set it continues a
+// previous listing and Page is ignored; OrderBy and Filter must then match the
+// request that produced the token (PageSize may change).
+type ListUsersQuery struct {
+	Page      uint32
+	PageSize  uint32
+	PageToken string
+	// OrderBy is "<field> [asc|desc]", e.g. "created_at desc". Empty sorts by id.
+	OrderBy string
+	Filter  ports.ListUsersFilter
+}
+
 type ListUsersResult struct {
 	Users    []userentity.User
 	Total    int64
 	Page     uint32
 	PageSize uint32
+	// NextPageToken continues the listing after this page. It is empty when the
+	// page is not full; a full last page may be followed by an empty one.
+	NextPageToken string
 }
 
-func (u UserListUseCase) List(ctx context.Context, page, pageSize uint32) (ListUsersResult, error) {
+func (u UserListUseCase) List(ctx context.Context, query ListUsersQuery) (ListUsersResult, error) {
+	page, pageSize := query.Page, query.PageSize
 	if page == 0 {
 		page = 1
 	}
@@ -39,10 +68,29 @@ func (u UserListUseCase) List(ctx context.Context, page, pageSize uint32) (ListU
 		pageSize = maxListPageSize
 	}
 
-	offset := int((page - 1) * pageSize)
+	sortBy, orderBy, err := parseListOrderBy(query.OrderBy)
+	if err != nil {
+		return ListUsersResult{}, err
+	}
+	filter := query.Filter
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		return ListUsersResult{}, ErrListInvalidFilter
+	}
+	fingerprint := listFilterFingerprint(filter)
+
 	params := ports.ListUsersParams{
-		Offset: offset,
 		Limit:  int(pageSize),
+		Filter: filter,
+		Sort:   sortBy,
+	}
+	if query.PageToken != "" {
+		cursor, err := decodeListPageToken(query.PageToken, orderBy, fingerprint, sortBy.Field)
+		if err != nil {
+			return ListUsersResult{}, err
+		}
+		params.After = &cursor
+	} else {
+		params.Offset = int((page - 1) * pageSize)
 	}
 
 	users, total, err := u.repository.ListUsers(ctx, params)
@@ -50,10 +98,107 @@ func (u UserListUseCase) List(ctx context.Context, page, pageSize uint32) (ListU
 		return ListUsersResult{}, fmt.Errorf("list users: %w", err)
 	}
 
-	return ListUsersResult{
+	result := ListUsersResult{
 		Users:    users,
 		Total:    total,
 		Page:     page,
 		PageSize: pageSize,
-	}, nil
+	}
+	if len(users) == int(pageSize) {
+		result.NextPageToken = encodeListPageToken(users[len(users)-1], orderBy, fingerprint, sortBy.Field)
+	}
+	return result, nil
+}
+
+// parseListOrderBy parses an order_by expression and returns it normalised
+// as "<field> <asc|desc>" for page token matching.
+func parseListOrderBy(orderBy string) (ports.ListUsersSort, string, error) {
+	parts := strings.Fields(strings.ToLower(orderBy))
+	if len(parts) == 0 {
+		return ports.ListUsersSort{Field: ports.ListUsersSortID}, "id asc", nil
+	}
+	if len(parts) > 2 {
+		return ports.ListUsersSort{}, "", ErrListInvalidOrderBy
+	}
+
+	field := ports.ListUsersSortField(parts[0])
+	switch field {
+	case ports.ListUsersSortID, ports.ListUsersSortUsername, ports.ListUsersSortEmail,
+		ports.ListUsersSortName, ports.ListUsersSortCreatedAt:
+	default:
+		return ports.ListUsersSort{}, "", ErrListInvalidOrderBy
+	}
+
+	dir := "asc"
+	if len(parts) == 2 {
+		dir = parts[1]
+		if dir != "asc" && dir != "desc" {
+			return ports.ListUsersSort{}, "", ErrListInvalidOrderBy
+		}
+	}
+	return ports.ListUsersSort{Field: field, Desc: dir == "desc"}, string(field) + " " + dir, nil
+}
+
+// listPageToken is the decoded form of an opaque page token. Only the sort
+// field value of the last row is stored so tokens do not carry other profile
+// data.
+type listPageToken struct {
+	OrderBy string `json:"o"`
+	Filter  string `json:"f"`
+	ID      int64  `json:"i"`
+	Key     string `json:"k,omitempty"`
+}
+
+// listFilterFingerprint ties a page token to the filter it was issued for.
+func listFilterFingerprint(filter any) string {
+	raw, _ := json.Marshal(filter)
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:8])
+}
+
+func encodeListPageToken(last userentity.User, orderBy, fingerprint string, field ports.ListUsersSortField) string {
+	token := listPageToken{OrderBy: orderBy, Filter: fingerprint, ID: last.Id}
+	switch field {
+	case ports.ListUsersSortUsername:
+		token.Key = last.Username
+	case ports.ListUsersSortEmail:
+		token.Key = last.Email
+	case ports.ListUsersSortName:
+		token.Key = last.Name
+	case ports.ListUsersSortCreatedAt:
+		token.Key = last.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	raw, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeListPageToken(value, orderBy, fingerprint string, field ports.ListUsersSortField) (ports.ListUsersCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return ports.ListUsersCursor{}, ErrListInvalidPageToken
+	}
+	var token listPageToken
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return ports.ListUsersCursor{}, ErrListInvalidPageToken
+	}
+	if token.OrderBy != orderBy || token.Filter != fingerprint {
+		return ports.ListUsersCursor{}, ErrListInvalidPageToken
+	}
+
+	cursor := ports.ListUsersCursor{ID: token.ID}
+	switch field {
+	case ports.ListUsersSortUsername:
+		cursor.Username = token.Key
+	case ports.ListUsersSortEmail:
+		cursor.Email = token.Key
+	case ports.ListUsersSortName:
+		cursor.Name = token.Key
+	case ports.ListUsersSortCreatedAt:
+		createdAt, err := time.Parse(time.RFC3339Nano, token.Key)
+		if err != nil {
+			return ports.ListUsersCursor{}, ErrListInvalidPageToken
+		}
+		cursor.CreatedAt = createdAt
+	}
+	return cursor, nil
 }
@@ -2,15 +2,18 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 )
 
 func TestUserListUseCase_ListDefaults(t *testing.T) {
 	repo := newTestRepo()
 	uc := NewUserListUseCase(repo)
 
-	result, err := uc.List(context.Background(), 0, 0)
+	result, err := uc.List(context.Background(), ListUsersQuery{})
 	if err != nil {
 		t.Fatalf("List returned error: %v", err)
 	}
@@ -48,7 +51,7 @@ func TestUserListUseCase_ListPagination(t *testing.T) {
 
 	uc := NewUserListUseCase(repo)
 
-	result, err := uc.List(context.Background(), 2, 2)
+	result, err := uc.List(context.Background(), ListUsersQuery{Page: 2, PageSize: 2})
 	if err != nil {
 		t.Fatalf("List returned error: %v", err)
 	}
@@ -74,7 +77,7 @@ func TestUserListUseCase_ListCapsPageSize(t *testing.T) {
 	repo := newTestRepo()
 	uc := NewUserListUseCase(repo)
 
-	result, err := uc.List(context.Background(), 1, maxListPageSize+200)
+	result, err := uc.List(context.Background(), ListUsersQuery{Page: 1, PageSize: maxListPageSize + 200})
 	if err != nil {
 		t.Fatalf("List returned error: %v", err)
 	}
@@ -83,3 +86,110 @@ func TestUserListUseCase_ListCapsPageSize(t *testing.T) {
 		t.Fatalf("expected capped page size %d, got %d", maxListPageSize, result.PageSize)
 	}
 }
+
+func TestUserListUseCase_ListPageTokens(t *testing.T) {
+	repo := newTestRepo()
+
+	seeds := []struct {
+		Username string
+		Verified bool
+	}{
+		{"alice001", true},
+		{"bruce002", false},
+		{"carol003", true},
+		{"danny004", true},
+		{"erika005", true},
+	}
+	for i, seed := range seeds {
+		email := seed.Username + "@example.com"
+		if _, err := seedUserWithToken(t, repo, seed.Username, email, "password", fmt.Sprintf("token-%d", i), seed.Verified); err != nil {
+			t.Fatalf("seed %d failed: %v", i, err)
+		}
+	}
+
+	uc := NewUserListUseCase(repo)
+	verified := true
+	query := ListUsersQuery{
+		PageSize: 2,
+		OrderBy:  "username desc",
+		Filter:   ports.ListUsersFilter{Verified: &verified},
+	}
+
+	var got []string
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatalf("pagination did not terminate, got %v", got)
+		}
+		result, err := uc.List(context.Background(), query)
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		if result.Total != 4 {
+			t.Fatalf("expected filtered total 4, got %d", result.Total)
+		}
+		for _, u := range result.Users {
+			got = append(got, u.Username)
+		}
+		if result.NextPageToken == "" {
+			break
+		}
+		query.PageToken = result.NextPageToken
+	}
+
+	want := []string{"erika005", "danny004", "carol003", "alice001"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestUserListUseCase_ListRejectsInvalidInput(t *testing.T) {
+	repo := newTestRepo()
+	for i, name := range []string{"alice001", "bruce002"} {
+		if _, err := seedUserWithToken(t, repo, name, name+"@example.com", "password", fmt.Sprintf("token-%d", i), false); err != nil {
+			t.Fatalf("seed %d failed: %v", i, err)
+		}
+	}
+	uc := NewUserListUseCase(repo)
+
+	first, err := uc.List(context.Background(), ListUsersQuery{PageSize: 1, OrderBy: "email"})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if first.NextPageToken == "" {
+		t.Fatal("expected a next page token")
+	}
+
+	tests := []struct {
+		name  string
+		query ListUsersQuery
+		want  error
+	}{
+		{"unknown order field", ListUsersQuery{OrderBy: "password_hash"}, ErrListInvalidOrderBy},
+		{"bad direction", ListUsersQuery{OrderBy: "email sideways"}, ErrListInvalidOrderBy},
+		{"malformed token", ListUsersQuery{PageToken: "not-a-token"}, ErrListInvalidPageToken},
+		{"order changed", ListUsersQuery{PageToken: first.NextPageToken, OrderBy: "email desc"}, ErrListInvalidPageToken},
+		{"filter changed", ListUsersQuery{PageToken: first.NextPageToken, OrderBy: "email", Filter: ports.ListUsersFilter{Query: "bru"}}, ErrListInvalidPageToken},
+		{"inverted range", ListUsersQuery{Filter: ports.ListUsersFilter{
+			CreatedAfter:  first.Users[0].CreatedAt,
+			CreatedBefore: first.Users[0].CreatedAt,
+		}}, ErrListInvalidFilter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.List(context.Background(), tt.query); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	second, err := uc.List(context.Background(), ListUsersQuery{PageSize: 5, OrderBy: "EMAIL asc", PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatalf("List with token returned error: %v", err)
+	}
+	if len(second.Users) != 1 || second.Users[0].Username != "bruce002" {
+		t.Fatalf("unexpected second page: %+v", second.Users)
+	}
+	if second.NextPageToken != "" {
+		t.Fatalf("expected no further pages, got token %q", second.NextPageToken)
+	}
+}
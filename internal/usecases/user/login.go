@@ -2,9 +2,15 @@ package user
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
 	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
@@ -13,6 +19,7 @@ import (
 
 type UserLoginUseCase struct {
 	repository    ports.UserRepository
+	history       ports.LoginHistoryRepository
 	accessTokens  security.AccessTokenManager
 	refreshTokens security.RefreshTokenManager
 }
@@ -21,17 +28,40 @@ func NewUserLoginUseCase(repo ports.UserRepository, accessTokens security.Access
 	return UserLoginUseCase{repository: repo, accessTokens: accessTokens, refreshTokens: refreshTokens}
 }
 
+// NewUserLoginUseCaseWithHistory also records every login in the login
+// history and alerts users about logins from new devices.
+func NewUserLoginUseCaseWithHistory(repo ports.UserRepository, history ports.LoginHistoryRepository, accessTokens security.AccessTokenManager, refreshTokens security.RefreshTokenManager) UserLoginUseCase {
+	return UserLoginUseCase{repository: repo, history: history, accessTokens: accessTokens, refreshTokens: refreshTokens}
+}
+
 // Login validates user credentials and returns access & refresh tokens along with user info when successful.
 func (u UserLoginUseCase) Login(ctx context.Context, username, password string) (string, time.Time, string, time.Time, userentity.User, error) {
+	return u.LoginFromClient(ctx, username, password, userentity.ClientInfo{})
+}
+
+// LoginFromClient is Login for a request from client, which is recorded in
+// the login history.
+func (u UserLoginUseCase) LoginFromClient(ctx context.Context, username, password string, client userentity.ClientInfo) (string, time.Time, string, time.Time, userentity.User, error) {
 	info, userInfo, err := u.repository.GetLoginInfo(ctx, username)
 	if err != nil {
+		// Unknown usernames are reported like wrong passwords so callers cannot
+		// probe which accounts exist.
+		if errors.Is(err, ErrNotFound) {
+			return "", time.Time{}, "", time.Time{}, userentity.User{}, ErrInvalidCredentials
+		}
 		return "", time.Time{}, "", time.Time{}, userentity.User{}, fmt.Errorf("get login info: %w", err)
 	}
-	if !userInfo.Verified {
-		return "", time.Time{}, "", time.Time{}, userentity.User{}, fmt.Errorf("user is not verified")
-	}
 	if err := bcrypt.CompareHashAndPassword([]byte(info.Password), []byte(password)); err != nil {
-		return "", time.Time{}, "", time.Time{}, userentity.User{}, fmt.Errorf("invalid credentials")
+		audit.Record(ctx, userentity.AuditEvent{UserID: userInfo.Id, Action: userentity.AuditActionLoginFailed})
+		return "", time.Time{}, "", time.Time{}, userentity.User{}, ErrInvalidCredentials
+	}
+	// Checked after the password so the account state is not disclosed to
+	// callers without credentials.
+	if err := requireActive(userInfo); err != nil {
+		return "", time.Time{}, "", time.Time{}, userentity.User{}, err
+	}
+	if !userInfo.Verified {
+		return "", time.Time{}, "", time.Time{}, userentity.User{}, ErrUnverified
 	}
 	accessToken, accessExpires, err := u.accessTokens.GenerateAccessToken(userInfo.Id, username)
 	if err != nil {
@@ -41,5 +71,61 @@ func (u UserLoginUseCase) Login(ctx context.Context, username, password string)
 	if err != nil {
 		return "", time.Time{}, "", time.Time{}, userentity.User{}, fmt.Errorf("generate refresh token: %w", err)
 	}
+	if err := u.recordLogin(ctx, userInfo, client); err != nil {
+		return "", time.Time{}, "", time.Time{}, userentity.User{}, err
+	}
+	audit.Record(ctx, userentity.AuditEvent{UserID: userInfo.Id, ActorID: userInfo.Id, Action: userentity.AuditActionLogin})
 	return accessToken, accessExpires, refreshToken, refreshExpires, userInfo, nil
 }
+
+// recordLogin adds the login to the history. The repository decides whether
+// the device is new and only then writes the alert to the outbox.
+func (u UserLoginUseCase) recordLogin(ctx context.Context, account userentity.User, client userentity.ClientInfo) error {
+	if u.history == nil {
+		return nil
+	}
+	now := time.Now().UTC()
+	payload, err := json.Marshal(newLoginAlertPayload{
+		Email:      account.Email,
+		Purpose:    newLoginPurpose,
+		IP:         client.IP,
+		UserAgent:  client.UserAgent,
+		LoggedInAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal new login payload: %w", err)
+	}
+
+	_, err = u.history.RecordLogin(ctx, ports.RecordLoginParams{
+		Record: userentity.LoginRecord{
+			UserID:            account.Id,
+			IP:                client.IP,
+			UserAgent:         client.UserAgent,
+			DeviceFingerprint: deviceFingerprint(client),
+			CreatedAt:         now,
+		},
+		NewLoginEvent: userentity.OutboxEvent{
+			AggregateType: "user",
+			EventType:     "user.security.new_login",
+			Payload:       payload,
+			Status:        userentity.OutboxEventStatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("record login: %w", err)
+	}
+	return nil
+}
+
+// deviceFingerprint identifies the client device: by the device id the client
+// sent, otherwise by its user agent. Only the hash is stored.
+func deviceFingerprint(client userentity.ClientInfo) string {
+	source := "ua:" + strings.ToLower(strings.TrimSpace(client.UserAgent))
+	if id := strings.TrimSpace(client.DeviceID); id != "" {
+		source = "id:" + id
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	defaultLoginHistoryPageSize = 20
+	maxLoginHistoryPageSize     = 100
+)
+
+// UserLoginHistoryUseCase lets users review the logins and logouts of their
+// own account.
+type UserLoginHistoryUseCase struct {
+	users   ports.UserRepository
+	history ports.LoginHistoryRepository
+}
+
+func NewUserLoginHistoryUseCase(users ports.UserRepository, history ports.LoginHistoryRepository) UserLoginHistoryUseCase {
+	return UserLoginHistoryUseCase{users: users, history: history}
+}
+
+type LoginHistoryResult struct {
+	Records []userentity.LoginRecord
+	// NextPageToken continues the listing after this page. It is empty when the
+	// page is not full; a full last page may be followed by an empty one.
+	NextPageToken string
+}
+
+// loginHistoryPageToken is the decoded form of a login history page token.
+type loginHistoryPageToken struct {
+	UserID   int64 `json:"u"`
+	BeforeID int64 `json:"b"`
+}
+
+// List returns a page of the login history of username, newest first. uid is
+// the authenticated caller, who must own the account.
+func (u UserLoginHistoryUseCase) List(ctx context.Context, uid int64, username string, pageSize uint32, pageToken string) (LoginHistoryResult, error) {
+	if username == "" {
+		return LoginHistoryResult{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return LoginHistoryResult{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return LoginHistoryResult{}, ErrPermissionDenied
+	}
+
+	if pageSize == 0 {
+		pageSize = defaultLoginHistoryPageSize
+	}
+	if pageSize > maxLoginHistoryPageSize {
+		pageSize = maxLoginHistoryPageSize
+	}
+	params := ports.ListLoginHistoryParams{UserID: owner.Id, Limit: int(pageSize)}
+	if pageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return LoginHistoryResult{}, ErrListInvalidPageToken
+		}
+		var token loginHistoryPageToken
+		if err := json.Unmarshal(raw, &token); err != nil || token.UserID != owner.Id || token.BeforeID <= 0 {
+			return LoginHistoryResult{}, ErrListInvalidPageToken
+		}
+		params.BeforeID = token.BeforeID
+	}
+
+	records, err := u.history.ListLoginHistory(ctx, params)
+	if err != nil {
+		return LoginHistoryResult{}, fmt.Errorf("list login history: %w", err)
+	}
+	result := LoginHistoryResult{Records: records}
+	if len(records) == int(pageSize) {
+		raw, _ := json.Marshal(loginHistoryPageToken{UserID: owner.Id, BeforeID: records[len(records)-1].ID})
+		result.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	return result, nil
+}
@@ -0,0 +1,143 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
+)
+
+func newLoginHistoryUseCases(t *testing.T, repo *database.InMemoryUserRepository) (UserLoginUseCase, UserLogoutUseCase, security.RefreshTokenManager) {
+	t.Helper()
+	accessManager, err := security.NewJWTManager("unit-test-secret-must-be-long-123456", "test-issuer", "test-aud", 15*time.Minute)
+	require.NoError(t, err)
+	refreshManager, err := security.NewJWTRefreshManager("unit-test-refresh-secret-change-me-1234567890", "test-issuer", "test-aud", 7*24*time.Hour)
+	require.NoError(t, err)
+	return NewUserLoginUseCaseWithHistory(repo, repo, accessManager, refreshManager),
+		NewUserLogoutUseCaseWithHistory(refreshManager, repo),
+		refreshManager
+}
+
+func newLoginAlerts(t *testing.T, repo *database.InMemoryUserRepository, userID int64) []newLoginAlertPayload {
+	t.Helper()
+	data, err := repo.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	var alerts []newLoginAlertPayload
+	for _, event := range data.OutboxEvents {
+		if event.EventType != "user.security.new_login" {
+			continue
+		}
+		var payload newLoginAlertPayload
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		alerts = append(alerts, payload)
+	}
+	return alerts
+}
+
+func TestLoginFromClientRecordsHistory(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	login, _, _ := newLoginHistoryUseCases(t, repo)
+
+	laptop := userentity.ClientInfo{IP: "203.0.113.7", UserAgent: "Mozilla/5.0 (X11)"}
+	_, _, _, _, _, err := login.LoginFromClient(ctx, "alice", "secret", laptop)
+	require.NoError(t, err)
+	_, _, _, _, _, err = login.LoginFromClient(ctx, "alice", "secret", laptop)
+	require.NoError(t, err)
+	assert.Empty(t, newLoginAlerts(t, repo, alice.Id), "the first device is not alerted")
+
+	// Failed logins are not part of the history.
+	_, _, _, _, _, err = login.LoginFromClient(ctx, "alice", "wrong", userentity.ClientInfo{IP: "198.51.100.1"})
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	phone := userentity.ClientInfo{IP: "198.51.100.4", UserAgent: "StockApp/2.1 (iOS)", DeviceID: "a1b2c3"}
+	_, _, _, _, _, err = login.LoginFromClient(ctx, "alice", "secret", phone)
+	require.NoError(t, err)
+
+	alerts := newLoginAlerts(t, repo, alice.Id)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "alice@example.com", alerts[0].Email)
+	assert.Equal(t, newLoginPurpose, alerts[0].Purpose)
+	assert.Equal(t, "198.51.100.4", alerts[0].IP)
+	assert.Equal(t, "StockApp/2.1 (iOS)", alerts[0].UserAgent)
+	assert.False(t, alerts[0].LoggedInAt.IsZero())
+
+	history, err := NewUserLoginHistoryUseCase(repo, repo).List(ctx, alice.Id, "alice", 0, "")
+	require.NoError(t, err)
+	require.Len(t, history.Records, 3)
+	assert.True(t, history.Records[0].NewDevice)
+	assert.Equal(t, "198.51.100.4", history.Records[0].IP)
+	assert.False(t, history.Records[1].NewDevice)
+	assert.Empty(t, history.NextPageToken)
+}
+
+func TestDeviceFingerprint(t *testing.T) {
+	ua := userentity.ClientInfo{UserAgent: "Mozilla/5.0"}
+	assert.Len(t, deviceFingerprint(ua), 64)
+	assert.Equal(t, deviceFingerprint(ua), deviceFingerprint(userentity.ClientInfo{UserAgent: " mozilla/5.0 ", IP: "198.51.100.1"}))
+
+	// A device id identifies the device whatever its user agent.
+	withID := userentity.ClientInfo{UserAgent: "Mozilla/5.0", DeviceID: "a1b2c3"}
+	assert.NotEqual(t, deviceFingerprint(ua), deviceFingerprint(withID))
+	assert.Equal(t, deviceFingerprint(withID), deviceFingerprint(userentity.ClientInfo{UserAgent: "Other/1.0", DeviceID: "a1b2c3"}))
+}
+
+func TestLogoutFromClientRecordsHistory(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	_, logout, refreshManager := newLoginHistoryUseCases(t, repo)
+
+	token, _, err := refreshManager.GenerateRefreshToken(alice.Id, "alice")
+	require.NoError(t, err)
+	require.NoError(t, logout.LogoutFromClient(ctx, alice.Id, token, userentity.ClientInfo{IP: "203.0.113.7"}))
+	_, err = refreshManager.ValidateRefreshToken(token)
+	require.Error(t, err, "the token is revoked")
+
+	// A revoked token is rejected before anything is recorded.
+	err = logout.LogoutFromClient(ctx, alice.Id, token, userentity.ClientInfo{IP: "203.0.113.7"})
+	require.ErrorIs(t, err, ErrInvalidRefreshToken)
+
+	history, err := NewUserLoginHistoryUseCase(repo, repo).List(ctx, alice.Id, "alice", 0, "")
+	require.NoError(t, err)
+	require.Len(t, history.Records, 1)
+	assert.Equal(t, userentity.LoginRecordTypeLogout, history.Records[0].Type)
+	assert.Equal(t, "203.0.113.7", history.Records[0].IP)
+}
+
+func TestUserLoginHistoryUseCase_List(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	login, _, _ := newLoginHistoryUseCases(t, repo)
+	for i := 0; i < 3; i++ {
+		_, _, _, _, _, err := login.LoginFromClient(ctx, "alice", "secret", userentity.ClientInfo{IP: "203.0.113.7"})
+		require.NoError(t, err)
+	}
+	uc := NewUserLoginHistoryUseCase(repo, repo)
+
+	first, err := uc.List(ctx, alice.Id, "alice", 2, "")
+	require.NoError(t, err)
+	require.Len(t, first.Records, 2)
+	require.NotEmpty(t, first.NextPageToken)
+	second, err := uc.List(ctx, alice.Id, "alice", 2, first.NextPageToken)
+	require.NoError(t, err)
+	require.Len(t, second.Records, 1)
+	assert.Less(t, second.Records[0].ID, first.Records[1].ID)
+	assert.Empty(t, second.NextPageToken)
+
+	_, err = uc.List(ctx, alice.Id, "alice", 2, "not-a-token")
+	assert.ErrorIs(t, err, ErrListInvalidPageToken)
+	_, err = uc.List(ctx, alice.Id+1, "alice", 0, "")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	_, err = uc.List(ctx, alice.Id, "", 0, "")
+	assert.ErrorIs(t, err, ErrEmptyUsername)
+}
@@ -41,7 +41,10 @@ func TestLogin(t *testing.T) {
 	assert.Equal(t, int64(1), refreshClaims.UserID)
 
 	_, _, _, _, _, err = uc.Login(context.Background(), "alice", "wrong")
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, _, _, _, _, err = uc.Login(context.Background(), "nobody", "secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials, "unknown users must look like wrong passwords")
 }
 
 func TestLoginRequiresVerification(t *testing.T) {
@@ -57,5 +60,8 @@ func TestLoginRequiresVerification(t *testing.T) {
 	uc := NewUserLoginUseCase(repo, accessManager, refreshManager)
 
 	_, _, _, _, _, err = uc.Login(context.Background(), "bob", "secret")
-	require.Error(t, err)
+	require.ErrorIs(t, err, ErrUnverified)
+
+	_, _, _, _, _, err = uc.Login(context.Background(), "bob", "wrong")
+	require.ErrorIs(t, err, ErrInvalidCredentials, "verification state is only revealed after the password matches")
 }
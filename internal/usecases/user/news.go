@@ -0,0 +1,353 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	// MaxNewsCodes is how many related stock codes a news item may carry.
+	MaxNewsCodes = 20
+
+	defaultNewsPageSize  = 20
+	maxNewsPageSize      = 100
+	maxNewsTitleLen      = 255
+	maxNewsSummaryLen    = 2000
+	maxNewsContentLen    = 100000
+	maxNewsSourceLen     = 255
+	maxNewsURLLen        = 2048
+	maxNewsExternalIDLen = 255
+	maxNewsQueryLen      = 200
+)
+
+var (
+	ErrNewsNotFound          = apperrors.New(apperrors.ErrNotFound, "NEWS_NOT_FOUND", "news not found")
+	ErrInvalidNewsCategory   = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_NEWS_CATEGORY", "category must be market, company, economy, analysis or announcement")
+	ErrInvalidNewsTitle      = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_NEWS_TITLE", "title must be 1 to 255 characters")
+	ErrInvalidNewsText       = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_NEWS_TEXT", "summary must be at most 2000 and content at most 100000 characters")
+	ErrInvalidNewsSource     = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_NEWS_SOURCE", "source must be at most 255 characters and the link an absolute http or https URL")
+	ErrInvalidNewsExternalID = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_NEWS_EXTERNAL_ID", "external id must be at most 255 characters")
+	ErrNewsCodeLimit         = apperrors.New(apperrors.ErrInvalidArgument, "NEWS_CODE_LIMIT", "a news item relates to at most 20 stocks")
+	ErrInvalidNewsQuery      = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_NEWS_QUERY", "search text must be at most 200 characters")
+)
+
+// UserNewsUseCase publishes the news feed. Administrators write and withdraw
+// items; everyone reads the published ones.
+type UserNewsUseCase struct {
+	news ports.NewsRepository
+}
+
+func NewUserNewsUseCase(news ports.NewsRepository) UserNewsUseCase {
+	return UserNewsUseCase{news: news}
+}
+
+// NewsInput is the content of a news item. A zero PublishedAt means now when
+// publishing and keeps the current time when updating. ExternalID is only
+// used by imports.
+type NewsInput struct {
+	ExternalID  string
+	Category    string
+	Title       string
+	Summary     string
+	Content     string
+	Source      string
+	URL         string
+	Codes       []string
+	PublishedAt time.Time
+}
+
+// NewsFilter narrows a news listing. Empty fields do not filter.
+type NewsFilter struct {
+	Category string
+	Code     string
+	Query    string
+}
+
+type NewsListResult struct {
+	Items []userentity.News
+	// NextPageToken continues the listing after this page. It is empty when
+	// the page is not full.
+	NextPageToken string
+}
+
+// NewsImportResult counts the items of an import. Failed holds the items
+// that could not be stored, by their position in the input.
+type NewsImportResult struct {
+	Created int
+	Updated int
+	Failed  []NewsImportFailure
+}
+
+type NewsImportFailure struct {
+	Index      int
+	ExternalID string
+	Err        error
+}
+
+// newsPageToken is the decoded form of a news page token. It repeats the
+// filter so a token is not reused with another one.
+type newsPageToken struct {
+	Category    string `json:"c,omitempty"`
+	Code        string `json:"k,omitempty"`
+	Query       string `json:"q,omitempty"`
+	PublishedAt int64  `json:"p"`
+	BeforeID    int64  `json:"b"`
+}
+
+// Publish adds a published item.
+func (u UserNewsUseCase) Publish(ctx context.Context, input NewsInput) (userentity.News, error) {
+	news, err := newsFromInput(input)
+	if err != nil {
+		return userentity.News{}, err
+	}
+	now := time.Now().UTC()
+	if news.PublishedAt.IsZero() {
+		news.PublishedAt = now.Truncate(time.Second)
+	}
+	news.Status = userentity.NewsStatusPublished
+	news.CreatedAt = now
+	created, err := u.news.CreateNews(ctx, news)
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("create news: %w", err)
+	}
+	return created, nil
+}
+
+// Update replaces the content of an item, published or not, keeping its
+// status.
+func (u UserNewsUseCase) Update(ctx context.Context, newsID int64, input NewsInput) (userentity.News, error) {
+	news, err := newsFromInput(input)
+	if err != nil {
+		return userentity.News{}, err
+	}
+	current, err := u.get(ctx, newsID)
+	if err != nil {
+		return userentity.News{}, err
+	}
+	news.ID = current.ID
+	if news.PublishedAt.IsZero() {
+		news.PublishedAt = current.PublishedAt
+	}
+	news.UpdatedAt = time.Now().UTC()
+	updated, err := u.news.UpdateNews(ctx, news)
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("update news: %w", err)
+	}
+	return updated, nil
+}
+
+// Unpublish withdraws an item from the feed. Unpublishing an unpublished item
+// succeeds.
+func (u UserNewsUseCase) Unpublish(ctx context.Context, newsID int64) (userentity.News, error) {
+	if _, err := u.get(ctx, newsID); err != nil {
+		return userentity.News{}, err
+	}
+	news, err := u.news.SetNewsStatus(ctx, newsID, userentity.NewsStatusUnpublished, time.Now().UTC())
+	if err != nil {
+		return userentity.News{}, fmt.Errorf("unpublish news: %w", err)
+	}
+	return news, nil
+}
+
+// Get returns a published item. Unpublished items are reported as not found.
+func (u UserNewsUseCase) Get(ctx context.Context, newsID int64) (userentity.News, error) {
+	news, err := u.get(ctx, newsID)
+	if err != nil {
+		return userentity.News{}, err
+	}
+	if news.Status != userentity.NewsStatusPublished {
+		return userentity.News{}, ErrNewsNotFound
+	}
+	return news, nil
+}
+
+// get returns an item in any status.
+func (u UserNewsUseCase) get(ctx context.Context, newsID int64) (userentity.News, error) {
+	news, err := u.news.GetNews(ctx, newsID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return userentity.News{}, ErrNewsNotFound
+		}
+		return userentity.News{}, fmt.Errorf("get news: %w", err)
+	}
+	return news, nil
+}
+
+// List returns a page of published items, newest first.
+func (u UserNewsUseCase) List(ctx context.Context, filter NewsFilter, pageSize uint32, pageToken string) (NewsListResult, error) {
+	filter, err := normalizeNewsFilter(filter)
+	if err != nil {
+		return NewsListResult{}, err
+	}
+	if pageSize == 0 {
+		pageSize = defaultNewsPageSize
+	}
+	if pageSize > maxNewsPageSize {
+		pageSize = maxNewsPageSize
+	}
+	params := ports.ListNewsParams{
+		Status:   userentity.NewsStatusPublished,
+		Category: userentity.NewsCategory(filter.Category),
+		Code:     filter.Code,
+		Query:    filter.Query,
+		Limit:    int(pageSize),
+	}
+	if pageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return NewsListResult{}, ErrListInvalidPageToken
+		}
+		var token newsPageToken
+		if err := json.Unmarshal(raw, &token); err != nil || token.BeforeID <= 0 ||
+			token.Category != filter.Category || token.Code != filter.Code || token.Query != filter.Query {
+			return NewsListResult{}, ErrListInvalidPageToken
+		}
+		params.BeforePublishedAt = time.Unix(token.PublishedAt, 0).UTC()
+		params.BeforeID = token.BeforeID
+	}
+
+	items, err := u.news.ListNews(ctx, params)
+	if err != nil {
+		return NewsListResult{}, fmt.Errorf("list news: %w", err)
+	}
+	result := NewsListResult{Items: items}
+	if len(items) == int(pageSize) {
+		last := items[len(items)-1]
+		raw, _ := json.Marshal(newsPageToken{
+			Category:    filter.Category,
+			Code:        filter.Code,
+			Query:       filter.Query,
+			PublishedAt: last.PublishedAt.Unix(),
+			BeforeID:    last.ID,
+		})
+		result.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	return result, nil
+}
+
+// Import stores items read from a feed. Items with an ExternalID that was
+// imported before update the stored item, keeping its status; others are
+// published. An item that fails does not stop the import; the returned error
+// is only set when ctx ends.
+func (u UserNewsUseCase) Import(ctx context.Context, inputs []NewsInput) (NewsImportResult, error) {
+	var result NewsImportResult
+	for i, input := range inputs {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		created, err := u.importOne(ctx, input)
+		if err != nil {
+			result.Failed = append(result.Failed, NewsImportFailure{Index: i, ExternalID: input.ExternalID, Err: err})
+			continue
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+	return result, nil
+}
+
+func (u UserNewsUseCase) importOne(ctx context.Context, input NewsInput) (created bool, err error) {
+	externalID := strings.TrimSpace(input.ExternalID)
+	if externalID != "" {
+		current, err := u.news.GetNewsByExternalID(ctx, externalID)
+		switch {
+		case err == nil:
+			_, err = u.Update(ctx, current.ID, input)
+			return false, err
+		case !errors.Is(err, apperrors.ErrNotFound):
+			return false, fmt.Errorf("get news by external id: %w", err)
+		}
+	}
+	_, err = u.Publish(ctx, input)
+	return err == nil, err
+}
+
+// newsFromInput validates and normalizes the content of an item.
+func newsFromInput(input NewsInput) (userentity.News, error) {
+	news := userentity.News{
+		ExternalID: strings.TrimSpace(input.ExternalID),
+		Category:   userentity.NewsCategory(strings.ToLower(strings.TrimSpace(input.Category))),
+		Title:      strings.TrimSpace(input.Title),
+		Summary:    strings.TrimSpace(input.Summary),
+		Content:    strings.TrimSpace(input.Content),
+		Source:     strings.TrimSpace(input.Source),
+		URL:        strings.TrimSpace(input.URL),
+	}
+	if !news.Category.Valid() {
+		return userentity.News{}, ErrInvalidNewsCategory
+	}
+	if news.Title == "" || utf8.RuneCountInString(news.Title) > maxNewsTitleLen {
+		return userentity.News{}, ErrInvalidNewsTitle
+	}
+	if utf8.RuneCountInString(news.Summary) > maxNewsSummaryLen || utf8.RuneCountInString(news.Content) > maxNewsContentLen {
+		return userentity.News{}, ErrInvalidNewsText
+	}
+	if utf8.RuneCountInString(news.Source) > maxNewsSourceLen || !validNewsURL(news.URL) {
+		return userentity.News{}, ErrInvalidNewsSource
+	}
+	if utf8.RuneCountInString(news.ExternalID) > maxNewsExternalIDLen {
+		return userentity.News{}, ErrInvalidNewsExternalID
+	}
+	if len(input.Codes) > MaxNewsCodes {
+		return userentity.News{}, ErrNewsCodeLimit
+	}
+	news.Codes = make([]string, 0, len(input.Codes))
+	for _, code := range input.Codes {
+		code, err := normalizeStockCode(code)
+		if err != nil {
+			return userentity.News{}, err
+		}
+		news.Codes = append(news.Codes, code)
+	}
+	if !input.PublishedAt.IsZero() {
+		news.PublishedAt = input.PublishedAt.UTC().Truncate(time.Second)
+	}
+	return news, nil
+}
+
+// validNewsURL accepts an empty link or an absolute http(s) URL.
+func validNewsURL(raw string) bool {
+	if raw == "" {
+		return true
+	}
+	if len(raw) > maxNewsURLLen {
+		return false
+	}
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func normalizeNewsFilter(filter NewsFilter) (NewsFilter, error) {
+	filter.Category = strings.ToLower(strings.TrimSpace(filter.Category))
+	if filter.Category != "" && !userentity.NewsCategory(filter.Category).Valid() {
+		return NewsFilter{}, ErrInvalidNewsCategory
+	}
+	if code := strings.TrimSpace(filter.Code); code != "" {
+		normalized, err := normalizeStockCode(code)
+		if err != nil {
+			return NewsFilter{}, err
+		}
+		filter.Code = normalized
+	} else {
+		filter.Code = ""
+	}
+	filter.Query = strings.TrimSpace(filter.Query)
+	if utf8.RuneCountInString(filter.Query) > maxNewsQueryLen {
+		return NewsFilter{}, ErrInvalidNewsQuery
+	}
+	return filter, nil
+}
@@ -0,0 +1,152 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func TestUserNewsUseCase_PublishUpdateUnpublish(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	seedStocks(repo, "VCB", "HPG")
+	uc := NewUserNewsUseCase(repo)
+
+	news, err := uc.Publish(ctx, NewsInput{
+		Category: " Market ",
+		Title:    " Banks lead the rally ",
+		Summary:  "Lenders gained the most.",
+		URL:      "https://example.com/banks",
+		Codes:    []string{"vcb"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, userentity.NewsCategoryMarket, news.Category)
+	assert.Equal(t, "Banks lead the rally", news.Title)
+	assert.Equal(t, []string{"VCB"}, news.Codes)
+	assert.Equal(t, userentity.NewsStatusPublished, news.Status)
+	assert.False(t, news.PublishedAt.IsZero())
+
+	_, err = uc.Publish(ctx, NewsInput{Category: "gossip", Title: "x"})
+	assert.ErrorIs(t, err, ErrInvalidNewsCategory)
+	_, err = uc.Publish(ctx, NewsInput{Category: "market", Title: " "})
+	assert.ErrorIs(t, err, ErrInvalidNewsTitle)
+	_, err = uc.Publish(ctx, NewsInput{Category: "market", Title: "x", URL: "javascript:alert(1)"})
+	assert.ErrorIs(t, err, ErrInvalidNewsSource)
+	_, err = uc.Publish(ctx, NewsInput{Category: "market", Title: "x", Codes: []string{"SSI"}})
+	assert.ErrorIs(t, err, ErrNotFound, "codes must be listed")
+	tooMany := make([]string, MaxNewsCodes+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("S%02d", i)
+	}
+	_, err = uc.Publish(ctx, NewsInput{Category: "market", Title: "x", Codes: tooMany})
+	assert.ErrorIs(t, err, ErrNewsCodeLimit)
+
+	updated, err := uc.Update(ctx, news.ID, NewsInput{Category: "company", Title: "Steel leads the rally", Codes: []string{"HPG"}})
+	require.NoError(t, err)
+	assert.Equal(t, userentity.NewsCategoryCompany, updated.Category)
+	assert.Equal(t, []string{"HPG"}, updated.Codes)
+	assert.True(t, news.PublishedAt.Equal(updated.PublishedAt), "publication time is kept")
+	_, err = uc.Update(ctx, news.ID+100, NewsInput{Category: "company", Title: "x"})
+	assert.ErrorIs(t, err, ErrNewsNotFound)
+
+	got, err := uc.Get(ctx, news.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Steel leads the rally", got.Title)
+
+	_, err = uc.Unpublish(ctx, news.ID)
+	require.NoError(t, err)
+	_, err = uc.Get(ctx, news.ID)
+	assert.ErrorIs(t, err, ErrNewsNotFound, "unpublished items are hidden")
+	page, err := uc.List(ctx, NewsFilter{}, 0, "")
+	require.NoError(t, err)
+	assert.Empty(t, page.Items)
+}
+
+func TestUserNewsUseCase_ListPagesAndFilters(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	seedStocks(repo, "VCB")
+	uc := NewUserNewsUseCase(repo)
+
+	start := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	var ids []int64
+	for i := 0; i < 5; i++ {
+		input := NewsInput{Category: "market", Title: fmt.Sprintf("Thị trường phiên %d", i), PublishedAt: start.Add(time.Duration(i) * time.Minute)}
+		if i%2 == 0 {
+			input.Codes = []string{"VCB"}
+		}
+		news, err := uc.Publish(ctx, input)
+		require.NoError(t, err)
+		ids = append(ids, news.ID)
+	}
+
+	var got []int64
+	token := ""
+	for {
+		page, err := uc.List(ctx, NewsFilter{Query: "thi truong"}, 2, token)
+		require.NoError(t, err)
+		for _, item := range page.Items {
+			got = append(got, item.ID)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	assert.Equal(t, []int64{ids[4], ids[3], ids[2], ids[1], ids[0]}, got)
+
+	page, err := uc.List(ctx, NewsFilter{Code: "vcb"}, 2, "")
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	assert.Equal(t, ids[4], page.Items[0].ID)
+	_, err = uc.List(ctx, NewsFilter{Code: "HPG"}, 2, page.NextPageToken)
+	assert.ErrorIs(t, err, ErrListInvalidPageToken, "a token only continues its own filter")
+	_, err = uc.List(ctx, NewsFilter{}, 2, "not-a-token")
+	assert.ErrorIs(t, err, ErrListInvalidPageToken)
+	_, err = uc.List(ctx, NewsFilter{Category: "gossip"}, 2, "")
+	assert.ErrorIs(t, err, ErrInvalidNewsCategory)
+}
+
+func TestUserNewsUseCase_Import(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	seedStocks(repo, "VCB")
+	uc := NewUserNewsUseCase(repo)
+
+	items := []NewsInput{
+		{ExternalID: "feed:1", Category: "market", Title: "First"},
+		{ExternalID: "feed:2", Category: "market", Title: "Second", Codes: []string{"SSI"}},
+		{Category: "economy", Title: "No id"},
+	}
+	result, err := uc.Import(ctx, items)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Created)
+	assert.Zero(t, result.Updated)
+	require.Len(t, result.Failed, 1)
+	assert.Equal(t, 1, result.Failed[0].Index)
+	assert.ErrorIs(t, result.Failed[0].Err, ErrNotFound)
+
+	first, err := repo.GetNewsByExternalID(ctx, "feed:1")
+	require.NoError(t, err)
+	_, err = uc.Unpublish(ctx, first.ID)
+	require.NoError(t, err)
+
+	items[0].Title = "First, corrected"
+	items[1].Codes = []string{"VCB"}
+	result, err = uc.Import(ctx, items[:2])
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 1, result.Updated)
+	assert.Empty(t, result.Failed)
+
+	first, err = repo.GetNews(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "First, corrected", first.Title)
+	assert.Equal(t, userentity.NewsStatusUnpublished, first.Status, "re-imports keep the status")
+}
@@ -0,0 +1,468 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	defaultOrdersPageSize = 20
+	maxOrdersPageSize     = 100
+	maxOrderReasonLen     = 255
+	maxExecutionIDLen     = 64
+
+	// Reasons recorded on orders closed by this use case.
+	orderReasonCancelled   = "cancelled by the user"
+	orderReasonSessionDone = "trading session ended"
+)
+
+var (
+	ErrOrderNotFound           = apperrors.New(apperrors.ErrNotFound, "ORDER_NOT_FOUND", "order not found")
+	ErrOrderNotOpen            = apperrors.New(apperrors.ErrFailedPrecondition, "ORDER_NOT_OPEN", "order is filled, cancelled, rejected or expired")
+	ErrInvalidOrderSide        = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER_SIDE", "side must be buy or sell")
+	ErrInvalidTimeInForce      = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_TIME_IN_FORCE", "time in force must be GTC, DAY, IOC or FOK")
+	ErrInvalidOrderPrice       = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER_PRICE", "price must be positive")
+	ErrInvalidOrderQuantity    = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER_QUANTITY", "quantity must be positive")
+	ErrInvalidOrderAmendment   = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER_AMENDMENT", "an amendment must change the price or quantity and keep the quantity above the filled quantity")
+	ErrInvalidOrderFill        = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER_FILL", "a fill needs an execution id of at most 64 characters, a positive price and a positive quantity")
+	ErrInvalidOrderRejection   = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ORDER_REJECTION", "a rejection needs a reason of at most 255 characters")
+	ErrMarketClosed            = apperrors.New(apperrors.ErrFailedPrecondition, "MARKET_CLOSED", "the market is closed")
+	ErrTimeInForceNotSupported = apperrors.New(apperrors.ErrInvalidArgument, "TIME_IN_FORCE_NOT_SUPPORTED", "IOC and FOK orders are not supported because orders are not matched as they are placed")
+	ErrOrderInCallAuction      = apperrors.New(apperrors.ErrFailedPrecondition, "ORDER_IN_CALL_AUCTION", "orders cannot be amended or cancelled during a call auction session")
+)
+
+// UserOrderUseCase places and manages the limit orders of users and keeps
+// their state machine: new orders become partially filled and filled as the
+// market reports fills, and open orders end cancelled, rejected or expired.
+// Every change is recorded in the order's history.
+//
+// This service does not match orders. The market (an exchange gateway or a
+// matching engine) reports executions through RecordFill and refusals
+// through Reject, exposed as the administrative RecordOrderFill and
+// RejectOrder RPCs. Because nothing can fill an order while it is placed, IOC
+// and FOK orders are refused. Call auctions are run by
+// UserTradingSessionUseCase.
+//
+// With a trading calendar, orders are placed and amended only while the
+// market is open, and orders are not amended or cancelled during call auction sessions. Without
+// one the market is always in the continuous session.
+//
+// With a risk chain, orders are placed and amended only when every rule of
+// the chain lets them through; see RiskChain.
+//
+// With a fee engine, fills are charged the fees and taxes it prices;
+// without one they are free.
+type UserOrderUseCase struct {
+	users    ports.UserRepository
+	orders   ports.OrderRepository
+	calendar *TradingCalendar
+	risk     *RiskChain
+	fees     *FeeEngine
+}
+
+// OrderConfig holds the optional parts of UserOrderUseCase. A nil part is
+// left out.
+type OrderConfig struct {
+	// Calendar enforces its market hours.
+	Calendar *TradingCalendar
+	// Risk runs its pre-trade checks on the orders placed and amended.
+	Risk *RiskChain
+	// Fees charges fills the fees and taxes it prices.
+	Fees *FeeEngine
+}
+
+func NewUserOrderUseCase(users ports.UserRepository, orders ports.OrderRepository, config OrderConfig) UserOrderUseCase {
+	return UserOrderUseCase{users: users, orders: orders, calendar: config.Calendar, risk: config.Risk, fees: config.Fees}
+}
+
+// PlaceOrderInput describes a limit order. Price is in VND per share. An
+// empty TimeInForce means DAY.
+type PlaceOrderInput struct {
+	Code        string
+	Side        string
+	TimeInForce string
+	Price       int64
+	Quantity    int64
+}
+
+// AmendOrderInput is the new price and total quantity of an order; zero
+// keeps the current value.
+type AmendOrderInput struct {
+	Price    int64
+	Quantity int64
+}
+
+// RecordFillInput is an execution reported by the market. A zero At means
+// now.
+type RecordFillInput struct {
+	OrderID     int64
+	ExecutionID string
+	Price       int64
+	Quantity    int64
+	At          time.Time
+}
+
+// OrderListResult is a page of orders, newest first.
+type OrderListResult struct {
+	Orders []userentity.Order
+	// NextPageToken continues the listing after this page. It is empty when
+	// the page is not full.
+	NextPageToken string
+}
+
+// ordersPageToken is the decoded form of an orders page token. It repeats
+// the status filter so a token is not reused with another one.
+type ordersPageToken struct {
+	Statuses []string `json:"s,omitempty"`
+	BeforeID int64    `json:"b"`
+}
+
+// Place accepts an order of a user who passed identity verification while
+// the market is open and the risk checks pass. IOC and FOK orders are
+// refused with ErrTimeInForceNotSupported; see UserOrderUseCase.
+func (u UserOrderUseCase) Place(ctx context.Context, uid int64, username string, input PlaceOrderInput) (userentity.Order, error) {
+	code, err := normalizeStockCode(input.Code)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	order := userentity.Order{
+		Code:        code,
+		Side:        userentity.OrderSide(strings.ToLower(strings.TrimSpace(input.Side))),
+		TimeInForce: userentity.TimeInForce(strings.ToUpper(strings.TrimSpace(input.TimeInForce))),
+		Price:       input.Price,
+		Quantity:    input.Quantity,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if order.TimeInForce == "" {
+		order.TimeInForce = userentity.TimeInForceDAY
+	}
+	if !order.Side.Valid() {
+		return userentity.Order{}, ErrInvalidOrderSide
+	}
+	if !order.TimeInForce.Valid() {
+		return userentity.Order{}, ErrInvalidTimeInForce
+	}
+	if order.TimeInForce.Immediate() {
+		return userentity.Order{}, ErrTimeInForceNotSupported
+	}
+	if order.Price <= 0 {
+		return userentity.Order{}, ErrInvalidOrderPrice
+	}
+	if order.Quantity <= 0 {
+		return userentity.Order{}, ErrInvalidOrderQuantity
+	}
+	if u.session(order.CreatedAt) == userentity.TradingSessionClosed {
+		return userentity.Order{}, ErrMarketClosed
+	}
+
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if err := RequireKycApproved(owner); err != nil {
+		return userentity.Order{}, err
+	}
+	order.UserID = owner.Id
+
+	placed, err := u.orders.CreateOrder(ctx, order, u.riskCheck(order.CreatedAt))
+	if err != nil {
+		if errors.Is(err, ErrStockNotFound) {
+			return userentity.Order{}, ErrStockNotFound
+		}
+		return userentity.Order{}, fmt.Errorf("create order: %w", err)
+	}
+	return placed, nil
+}
+
+// Get returns one of the caller's orders with its history, oldest event
+// first.
+func (u UserOrderUseCase) Get(ctx context.Context, uid int64, username string, orderID int64) (userentity.Order, []userentity.OrderEvent, error) {
+	order, err := u.ownOrder(ctx, uid, username, orderID)
+	if err != nil {
+		return userentity.Order{}, nil, err
+	}
+	events, err := u.orders.ListOrderEvents(ctx, order.ID)
+	if err != nil {
+		return userentity.Order{}, nil, fmt.Errorf("list order events: %w", err)
+	}
+	return order, events, nil
+}
+
+// List returns a page of the caller's orders, newest first. Statuses
+// restricts the page to orders in one of them; empty lists every order.
+func (u UserOrderUseCase) List(ctx context.Context, uid int64, username string, statuses []string, pageSize uint32, pageToken string) (OrderListResult, error) {
+	normalized, err := normalizeOrderStatuses(statuses)
+	if err != nil {
+		return OrderListResult{}, err
+	}
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return OrderListResult{}, err
+	}
+	if pageSize == 0 {
+		pageSize = defaultOrdersPageSize
+	}
+	if pageSize > maxOrdersPageSize {
+		pageSize = maxOrdersPageSize
+	}
+	params := ports.ListOrdersParams{UserID: owner.Id, Limit: int(pageSize)}
+	for _, status := range normalized {
+		params.Statuses = append(params.Statuses, userentity.OrderStatus(status))
+	}
+	if pageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return OrderListResult{}, ErrListInvalidPageToken
+		}
+		var token ordersPageToken
+		if err := json.Unmarshal(raw, &token); err != nil || token.BeforeID <= 0 ||
+			strings.Join(token.Statuses, ",") != strings.Join(normalized, ",") {
+			return OrderListResult{}, ErrListInvalidPageToken
+		}
+		params.BeforeID = token.BeforeID
+	}
+
+	orders, err := u.orders.ListOrders(ctx, params)
+	if err != nil {
+		return OrderListResult{}, fmt.Errorf("list orders: %w", err)
+	}
+	result := OrderListResult{Orders: orders}
+	if len(orders) == int(pageSize) {
+		raw, _ := json.Marshal(ordersPageToken{Statuses: normalized, BeforeID: orders[len(orders)-1].ID})
+		result.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	return result, nil
+}
+
+// Cancel withdraws what is left of one of the caller's open orders. Fills
+// recorded before stay. Orders may be cancelled while the market is closed,
+// but not during a call auction session.
+func (u UserOrderUseCase) Cancel(ctx context.Context, uid int64, username string, orderID int64) (userentity.Order, error) {
+	order, err := u.ownOrder(ctx, uid, username, orderID)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if !order.Status.Open() {
+		return userentity.Order{}, ErrOrderNotOpen
+	}
+	now := time.Now().UTC()
+	if u.session(now).CallAuction() {
+		return userentity.Order{}, ErrOrderInCallAuction
+	}
+	cancelled, err := u.orders.CloseOrder(ctx, ports.CloseOrderParams{
+		OrderID: order.ID,
+		Status:  userentity.OrderStatusCancelled,
+		Reason:  orderReasonCancelled,
+		At:      now,
+	})
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("cancel order: %w", err)
+	}
+	return cancelled, nil
+}
+
+// Amend changes the price or total quantity of one of the caller's open
+// orders. Lowering the quantity keeps the order's priority; changing the
+// price or raising the quantity loses it, as if the order were placed again.
+// The quantity must stay above the filled quantity, the market must be in
+// the continuous session and the amended order must pass the risk checks.
+func (u UserOrderUseCase) Amend(ctx context.Context, uid int64, username string, orderID int64, input AmendOrderInput) (userentity.Order, error) {
+	if input.Price < 0 {
+		return userentity.Order{}, ErrInvalidOrderPrice
+	}
+	if input.Quantity < 0 {
+		return userentity.Order{}, ErrInvalidOrderQuantity
+	}
+	order, err := u.ownOrder(ctx, uid, username, orderID)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	if !order.Status.Open() {
+		return userentity.Order{}, ErrOrderNotOpen
+	}
+	price, quantity := order.Price, order.Quantity
+	if input.Price > 0 {
+		price = input.Price
+	}
+	if input.Quantity > 0 {
+		quantity = input.Quantity
+	}
+	if (price == order.Price && quantity == order.Quantity) || quantity <= order.FilledQuantity {
+		return userentity.Order{}, ErrInvalidOrderAmendment
+	}
+	now := time.Now().UTC()
+	switch session := u.session(now); {
+	case session == userentity.TradingSessionClosed:
+		return userentity.Order{}, ErrMarketClosed
+	case session.CallAuction():
+		return userentity.Order{}, ErrOrderInCallAuction
+	}
+
+	amended, err := u.orders.AmendOrder(ctx, ports.AmendOrderParams{
+		OrderID:  order.ID,
+		Price:    price,
+		Quantity: quantity,
+		At:       now,
+		Check:    u.riskCheck(now),
+	})
+	if err != nil {
+		return userentity.Order{}, fmt.Errorf("amend order: %w", err)
+	}
+	return amended, nil
+}
+
+// RecordFill applies an execution reported by the market. Reporting the
+// same execution again returns the order unchanged.
+func (u UserOrderUseCase) RecordFill(ctx context.Context, input RecordFillInput) (userentity.Order, error) {
+	executionID := strings.TrimSpace(input.ExecutionID)
+	if executionID == "" || len(executionID) > maxExecutionIDLen || input.Price <= 0 || input.Quantity <= 0 {
+		return userentity.Order{}, ErrInvalidOrderFill
+	}
+	at := input.At
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	params := ports.RecordOrderFillParams{
+		OrderID:     input.OrderID,
+		ExecutionID: executionID,
+		Price:       input.Price,
+		Quantity:    input.Quantity,
+		At:          at,
+		SettlesOn:   u.settlesOn(at),
+	}
+	if u.fees != nil {
+		params.Pricer = u.fees.Charges
+	}
+	order, err := u.orders.RecordOrderFill(ctx, params)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return userentity.Order{}, ErrOrderNotFound
+		}
+		return userentity.Order{}, fmt.Errorf("record order fill: %w", err)
+	}
+	return order, nil
+}
+
+// Reject closes an open order the market refused, keeping its reason.
+func (u UserOrderUseCase) Reject(ctx context.Context, orderID int64, reason string) (userentity.Order, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len(reason) > maxOrderReasonLen {
+		return userentity.Order{}, ErrInvalidOrderRejection
+	}
+	order, err := u.orders.CloseOrder(ctx, ports.CloseOrderParams{
+		OrderID: orderID,
+		Status:  userentity.OrderStatusRejected,
+		Reason:  reason,
+		At:      time.Now().UTC(),
+	})
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return userentity.Order{}, ErrOrderNotFound
+		}
+		return userentity.Order{}, fmt.Errorf("reject order: %w", err)
+	}
+	return order, nil
+}
+
+// ExpireDayOrders expires the open DAY orders placed before sessionEnd, the
+// end of the trading session they belong to, and returns how many expired.
+func (u UserOrderUseCase) ExpireDayOrders(ctx context.Context, sessionEnd time.Time) (int, error) {
+	count, err := u.orders.ExpireOrders(ctx, userentity.TimeInForceDAY, sessionEnd, orderReasonSessionDone, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("expire day orders: %w", err)
+	}
+	return count, nil
+}
+
+// session returns the trading session at t.
+func (u UserOrderUseCase) session(t time.Time) userentity.TradingSession {
+	if u.calendar == nil {
+		return userentity.TradingSessionContinuous
+	}
+	return u.calendar.SessionAt(t)
+}
+
+// settlesOn returns the date an execution at t settles on. Without a
+// calendar executions settle at once.
+func (u UserOrderUseCase) settlesOn(t time.Time) time.Time {
+	if u.calendar == nil {
+		return time.Time{}
+	}
+	return u.calendar.SettlementDate(t)
+}
+
+// riskCheck returns the check of the risk chain, if there is one, for
+// orders placed or amended at now.
+func (u UserOrderUseCase) riskCheck(now time.Time) ports.OrderCheck {
+	if u.risk == nil {
+		return nil
+	}
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if u.calendar != nil {
+		dayStart = u.calendar.midnight(now)
+	}
+	return u.risk.OrderCheck(now, dayStart)
+}
+
+// ownOrder returns an order of the caller. Orders of other users are
+// reported as not found so their ids reveal nothing.
+func (u UserOrderUseCase) ownOrder(ctx context.Context, uid int64, username string, orderID int64) (userentity.Order, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.Order{}, err
+	}
+	order, err := u.orders.GetOrder(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return userentity.Order{}, ErrOrderNotFound
+		}
+		return userentity.Order{}, fmt.Errorf("get order: %w", err)
+	}
+	if order.UserID != owner.Id {
+		return userentity.Order{}, ErrOrderNotFound
+	}
+	return order, nil
+}
+
+func (u UserOrderUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
+
+// normalizeOrderStatuses lower-cases, checks, dedupes and sorts a status
+// filter.
+func normalizeOrderStatuses(statuses []string) ([]string, error) {
+	seen := make(map[string]bool, len(statuses))
+	normalized := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		status = strings.ToLower(strings.TrimSpace(status))
+		if !userentity.OrderStatus(status).Valid() {
+			return nil, ErrListInvalidFilter
+		}
+		if !seen[status] {
+			seen[status] = true
+			normalized = append(normalized, status)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
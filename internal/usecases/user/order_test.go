@@ -0,0 +1,234 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// seedTradingUser creates a verified user whose identity verification was
+// approved.
+func seedTradingUser(t *testing.T, repo *database.InMemoryUserRepository, username string) userentity.User {
+	t.Helper()
+	user, err := seedUserWithToken(t, repo, username, username+"@example.com", "secret", "token-"+username, true)
+	require.NoError(t, err)
+	kyc, _ := newTestKycUseCase(t, repo)
+	submission := submitIDCard(t, kyc, user)
+	_, err = kyc.Review(context.Background(), 99, submission.ID, "approved", "")
+	require.NoError(t, err)
+	return user
+}
+
+// closeOutAccount settles the trades of a user and posts the adjustments
+// that empty the account, so it can be deleted.
+func closeOutAccount(t *testing.T, repo *database.InMemoryUserRepository, userID int64) {
+	t.Helper()
+	ctx := context.Background()
+	_, err := repo.SettleDue(ctx, time.Now().UTC().AddDate(1, 0, 0), time.Now().UTC())
+	require.NoError(t, err)
+	account, err := repo.GetTradingAccount(ctx, userID)
+	require.NoError(t, err)
+	var entries []userentity.LedgerEntry
+	if account.Cash != 0 {
+		entries = append(entries, userentity.LedgerEntry{UserID: userID, Amount: -account.Cash, Type: userentity.LedgerEntryAdjustment})
+	}
+	for _, position := range account.Positions {
+		entries = append(entries, userentity.LedgerEntry{UserID: userID, Code: position.Code, Amount: -position.Quantity, Type: userentity.LedgerEntryAdjustment})
+	}
+	if len(entries) > 0 {
+		_, err = repo.PostLedgerEntries(ctx, fmt.Sprintf("close-out-%d", userID), entries)
+		require.NoError(t, err)
+	}
+}
+
+func TestUserOrderUseCase_Place(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob, err := seedUserWithToken(t, repo, "bob", "bob@example.com", "secret", "token-bob", true)
+	require.NoError(t, err)
+	seedStocks(repo, "VNM")
+	uc := NewUserOrderUseCase(repo, repo, OrderConfig{})
+
+	order, err := uc.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "vnm", Side: "Buy", Price: 75000, Quantity: 100})
+	require.NoError(t, err)
+	assert.Equal(t, "VNM", order.Code)
+	assert.Equal(t, userentity.OrderSideBuy, order.Side)
+	assert.Equal(t, userentity.TimeInForceDAY, order.TimeInForce, "DAY is the default")
+	assert.Equal(t, userentity.OrderStatusNew, order.Status)
+
+	// Nothing fills an order while it is placed, so immediate orders are
+	// refused rather than stored.
+	for _, tif := range []string{"ioc", "FOK"} {
+		_, err := uc.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "VNM", Side: "sell", TimeInForce: tif, Price: 75000, Quantity: 100})
+		assert.ErrorIs(t, err, ErrTimeInForceNotSupported)
+	}
+	open, err := repo.ListOpenOrders(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Len(t, open, 1)
+
+	tests := []struct {
+		name  string
+		input PlaceOrderInput
+		want  error
+	}{
+		{"bad side", PlaceOrderInput{Code: "VNM", Side: "short", Price: 1, Quantity: 1}, ErrInvalidOrderSide},
+		{"bad time in force", PlaceOrderInput{Code: "VNM", Side: "buy", TimeInForce: "GTD", Price: 1, Quantity: 1}, ErrInvalidTimeInForce},
+		{"no price", PlaceOrderInput{Code: "VNM", Side: "buy", Quantity: 1}, ErrInvalidOrderPrice},
+		{"no quantity", PlaceOrderInput{Code: "VNM", Side: "buy", Price: 1}, ErrInvalidOrderQuantity},
+		{"bad code", PlaceOrderInput{Code: "VN-30", Side: "buy", Price: 1, Quantity: 1}, ErrInvalidStockCode},
+		{"unlisted code", PlaceOrderInput{Code: "SSI", Side: "buy", Price: 1, Quantity: 1}, ErrStockNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := uc.Place(ctx, alice.Id, "alice", tc.input)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+
+	_, err = uc.Place(ctx, bob.Id, "bob", PlaceOrderInput{Code: "VNM", Side: "buy", Price: 75000, Quantity: 100})
+	assert.ErrorIs(t, err, ErrKycNotApproved)
+	_, err = uc.Place(ctx, bob.Id, "alice", PlaceOrderInput{Code: "VNM", Side: "buy", Price: 75000, Quantity: 100})
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+}
+
+// ownerGoneOrders stores no orders because their owner was removed after
+// the use case looked them up.
+type ownerGoneOrders struct {
+	*database.InMemoryUserRepository
+}
+
+func (ownerGoneOrders) CreateOrder(context.Context, userentity.Order, ports.OrderCheck) (userentity.Order, error) {
+	return userentity.Order{}, database.ErrUserNotFound
+}
+
+func TestUserOrderUseCase_PlaceOwnerGone(t *testing.T) {
+	repo := newTestRepo()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	uc := NewUserOrderUseCase(repo, ownerGoneOrders{repo}, OrderConfig{})
+
+	_, err := uc.Place(context.Background(), alice.Id, "alice", PlaceOrderInput{Code: "VNM", Side: "buy", Price: 75000, Quantity: 100})
+	assert.ErrorIs(t, err, database.ErrUserNotFound)
+	assert.NotErrorIs(t, err, ErrStockNotFound, "a missing owner is not an unknown stock")
+}
+
+func TestUserOrderUseCase_Lifecycle(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob := seedTradingUser(t, repo, "bob")
+	seedStocks(repo, "FPT")
+	uc := NewUserOrderUseCase(repo, repo, OrderConfig{})
+
+	order, err := uc.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "FPT", Side: "sell", TimeInForce: "GTC", Price: 120000, Quantity: 500})
+	require.NoError(t, err)
+
+	// Lowering the quantity keeps the priority; a new price loses it.
+	amended, err := uc.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Quantity: 400})
+	require.NoError(t, err)
+	assert.Equal(t, int64(400), amended.Quantity)
+	assert.Equal(t, int64(120000), amended.Price)
+	assert.True(t, amended.PriorityAt.Equal(order.PriorityAt))
+	amended, err = uc.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Price: 119000})
+	require.NoError(t, err)
+	assert.True(t, amended.PriorityAt.After(order.PriorityAt))
+
+	_, err = uc.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Price: 119000, Quantity: 400})
+	assert.ErrorIs(t, err, ErrInvalidOrderAmendment, "nothing changes")
+	_, err = uc.Amend(ctx, bob.Id, "bob", order.ID, AmendOrderInput{Price: 1})
+	assert.ErrorIs(t, err, ErrOrderNotFound, "other users' orders are hidden")
+
+	filled, err := uc.RecordFill(ctx, RecordFillInput{OrderID: order.ID, ExecutionID: "x-1", Price: 119000, Quantity: 150})
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusPartiallyFilled, filled.Status)
+	again, err := uc.RecordFill(ctx, RecordFillInput{OrderID: order.ID, ExecutionID: "x-1", Price: 119000, Quantity: 150})
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), again.FilledQuantity, "executions are recorded once")
+	_, err = uc.RecordFill(ctx, RecordFillInput{OrderID: order.ID, ExecutionID: " ", Price: 119000, Quantity: 1})
+	assert.ErrorIs(t, err, ErrInvalidOrderFill)
+	_, err = uc.RecordFill(ctx, RecordFillInput{OrderID: order.ID + 100, ExecutionID: "x-2", Price: 119000, Quantity: 1})
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+
+	_, err = uc.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Quantity: 150})
+	assert.ErrorIs(t, err, ErrInvalidOrderAmendment, "quantity must exceed the filled quantity")
+
+	cancelled, err := uc.Cancel(ctx, alice.Id, "alice", order.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusCancelled, cancelled.Status)
+	assert.Equal(t, int64(150), cancelled.FilledQuantity, "fills stay")
+	_, err = uc.Cancel(ctx, alice.Id, "alice", order.ID)
+	assert.ErrorIs(t, err, ErrOrderNotOpen)
+	_, err = uc.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Price: 1})
+	assert.ErrorIs(t, err, ErrOrderNotOpen)
+
+	got, events, err := uc.Get(ctx, alice.Id, "alice", order.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusCancelled, got.Status)
+	types := make([]userentity.OrderEventType, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []userentity.OrderEventType{
+		userentity.OrderEventPlaced, userentity.OrderEventAmended, userentity.OrderEventAmended,
+		userentity.OrderEventFilled, userentity.OrderEventCancelled,
+	}, types)
+
+	rejected, err := uc.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "FPT", Side: "buy", Price: 100000, Quantity: 100})
+	require.NoError(t, err)
+	_, err = uc.Reject(ctx, rejected.ID, "")
+	assert.ErrorIs(t, err, ErrInvalidOrderRejection)
+	rejected, err = uc.Reject(ctx, rejected.ID, "price outside the daily band")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusRejected, rejected.Status)
+	assert.Equal(t, "price outside the daily band", rejected.Reason)
+}
+
+func TestUserOrderUseCase_ListAndExpire(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "HPG")
+	uc := NewUserOrderUseCase(repo, repo, OrderConfig{})
+
+	var ids []int64
+	for _, tif := range []string{"DAY", "GTC", "DAY"} {
+		order, err := uc.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "HPG", Side: "buy", TimeInForce: tif, Price: 28000, Quantity: 100})
+		require.NoError(t, err)
+		ids = append(ids, order.ID)
+	}
+	_, err := uc.RecordFill(ctx, RecordFillInput{OrderID: ids[2], ExecutionID: "h-1", Price: 28000, Quantity: 100})
+	require.NoError(t, err)
+
+	expired, err := uc.ExpireDayOrders(ctx, time.Now().UTC().Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired, "filled DAY orders do not expire")
+
+	open, err := uc.List(ctx, alice.Id, "alice", []string{"new", "partially_filled"}, 0, "")
+	require.NoError(t, err)
+	require.Len(t, open.Orders, 1)
+	assert.Equal(t, ids[1], open.Orders[0].ID)
+
+	page, err := uc.List(ctx, alice.Id, "alice", []string{"Expired", "filled"}, 1, "")
+	require.NoError(t, err)
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, ids[2], page.Orders[0].ID)
+	require.NotEmpty(t, page.NextPageToken)
+	page, err = uc.List(ctx, alice.Id, "alice", []string{"filled", "expired", "filled"}, 1, page.NextPageToken)
+	require.NoError(t, err)
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, ids[0], page.Orders[0].ID)
+
+	_, err = uc.List(ctx, alice.Id, "alice", []string{"new"}, 1, page.NextPageToken)
+	assert.ErrorIs(t, err, ErrListInvalidPageToken, "tokens are bound to their filter")
+	_, err = uc.List(ctx, alice.Id, "alice", []string{"open"}, 0, "")
+	assert.ErrorIs(t, err, ErrListInvalidFilter)
+}
@@ -0,0 +1,403 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	defaultPaymentsPageSize = 20
+	maxPaymentsPageSize     = 100
+	maxIdempotencyKeyLen    = 64
+	maxPaymentReasonLen     = 255
+
+	// paymentReferencePrefix starts the references of payments; see
+	// userentity.Payment.Reference.
+	paymentReferencePrefix = "payment:"
+)
+
+var (
+	ErrInvalidPaymentRequest      = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_PAYMENT_REQUEST", "a payment needs a positive amount and an idempotency key of 1 to 64 characters")
+	ErrIdempotencyKeyReused       = apperrors.New(apperrors.ErrConflict, "IDEMPOTENCY_KEY_REUSED", "the idempotency key was used for another payment")
+	ErrInsufficientFunds          = apperrors.New(apperrors.ErrFailedPrecondition, "INSUFFICIENT_FUNDS", "the withdrawal is above the cash open orders and withdrawals leave free")
+	ErrPaymentsUnavailable        = apperrors.New(apperrors.ErrFailedPrecondition, "PAYMENTS_UNAVAILABLE", "no payment gateway is configured")
+	ErrPaymentNotFound            = apperrors.New(apperrors.ErrNotFound, "PAYMENT_NOT_FOUND", "payment not found")
+	ErrPaymentNotAwaitingApproval = apperrors.New(apperrors.ErrFailedPrecondition, "PAYMENT_NOT_AWAITING_APPROVAL", "the payment is not a withdrawal awaiting approval")
+	ErrInvalidPaymentCallback     = apperrors.New(apperrors.ErrPermissionDenied, "INVALID_PAYMENT_CALLBACK", "the payment callback is not signed by the gateway or does not match the payment")
+	ErrWithdrawalInvalidDecision  = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_WITHDRAWAL_DECISION", "decision must be approved or rejected")
+	ErrWithdrawalReasonRequired   = apperrors.New(apperrors.ErrInvalidArgument, "WITHDRAWAL_REASON_REQUIRED", "a rejection needs a reason of at most 255 characters")
+	ErrWithdrawalSelfReview       = apperrors.New(apperrors.ErrPermissionDenied, "WITHDRAWAL_SELF_REVIEW", "administrators cannot review their own withdrawals")
+)
+
+// PaymentConfig holds the limits of payments.
+type PaymentConfig struct {
+	// WithdrawalApprovalThreshold is the largest withdrawal, in VND, that is
+	// submitted without the approval of an administrator. Zero submits every
+	// withdrawal.
+	WithdrawalApprovalThreshold int64
+}
+
+// UserPaymentUseCase moves cash in and out of trading accounts through the
+// payment gateway. Requests carry an idempotency key so a retried request
+// creates one payment. Payments are submitted to the gateway when they are
+// requested, or when an administrator approves a withdrawal above the
+// approval threshold, and complete when the gateway confirms them through
+// HandleCallback; only then is their ledger entry posted. Until a
+// withdrawal completes or fails its amount is held back from the buying
+// power.
+type UserPaymentUseCase struct {
+	users    ports.UserRepository
+	payments ports.PaymentRepository
+	fees     ports.FeeScheduleRepository
+	gateway  ports.PaymentGateway
+	config   PaymentConfig
+}
+
+// NewUserPaymentUseCase submits payments to gateway. With a nil gateway
+// requests, approvals and callbacks fail with ErrPaymentsUnavailable;
+// payments can still be listed.
+func NewUserPaymentUseCase(users ports.UserRepository, payments ports.PaymentRepository, fees ports.FeeScheduleRepository, gateway ports.PaymentGateway, config PaymentConfig) UserPaymentUseCase {
+	return UserPaymentUseCase{users: users, payments: payments, fees: fees, gateway: gateway, config: config}
+}
+
+// PaymentRequestInput is a deposit or withdrawal of Amount VND. Requests
+// with the same IdempotencyKey create one payment.
+type PaymentRequestInput struct {
+	Amount         int64
+	IdempotencyKey string
+}
+
+// PaymentListResult is a page of payments, newest first.
+type PaymentListResult struct {
+	Payments []userentity.Payment
+	// NextPageToken continues the listing after this page. It is empty when
+	// the page is not full.
+	NextPageToken string
+}
+
+// paymentsPageToken is the decoded form of a payments page token.
+type paymentsPageToken struct {
+	BeforeID int64 `json:"b"`
+}
+
+// Deposit asks the gateway to bring cash into the caller's account. The
+// returned payment carries the URL where the user pays, when the gateway
+// needs one.
+func (u UserPaymentUseCase) Deposit(ctx context.Context, uid int64, username string, input PaymentRequestInput) (userentity.Payment, error) {
+	return u.request(ctx, uid, username, userentity.PaymentKindDeposit, input)
+}
+
+// Withdraw asks the gateway to pay cash out of the caller's account. The
+// amount must be free of open buy orders and other withdrawals. Withdrawals
+// above the approval threshold wait for an administrator.
+func (u UserPaymentUseCase) Withdraw(ctx context.Context, uid int64, username string, input PaymentRequestInput) (userentity.Payment, error) {
+	return u.request(ctx, uid, username, userentity.PaymentKindWithdrawal, input)
+}
+
+// request creates and submits a payment. A request repeating the key of an
+// earlier one returns that payment, submitting it again if an earlier
+// submission failed.
+func (u UserPaymentUseCase) request(ctx context.Context, uid int64, username string, kind userentity.PaymentKind, input PaymentRequestInput) (userentity.Payment, error) {
+	key := strings.TrimSpace(input.IdempotencyKey)
+	if input.Amount <= 0 || key == "" || utf8.RuneCountInString(key) > maxIdempotencyKeyLen {
+		return userentity.Payment{}, ErrInvalidPaymentRequest
+	}
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.Payment{}, err
+	}
+	if err := RequireKycApproved(owner); err != nil {
+		return userentity.Payment{}, err
+	}
+	if u.gateway == nil {
+		return userentity.Payment{}, ErrPaymentsUnavailable
+	}
+
+	existing, err := u.payments.FindPayment(ctx, owner.Id, key)
+	switch {
+	case err == nil:
+		return u.repeated(ctx, existing, kind, input.Amount)
+	case !errors.Is(err, apperrors.ErrNotFound):
+		return userentity.Payment{}, fmt.Errorf("find payment: %w", err)
+	}
+
+	payment := userentity.Payment{
+		UserID:         owner.Id,
+		Kind:           kind,
+		Amount:         input.Amount,
+		Status:         userentity.PaymentStatusPending,
+		IdempotencyKey: key,
+		CreatedAt:      time.Now().UTC(),
+	}
+	var check ports.PaymentCheck
+	if kind == userentity.PaymentKindWithdrawal {
+		check = u.checkFunds
+		if threshold := u.config.WithdrawalApprovalThreshold; threshold > 0 && input.Amount > threshold {
+			payment.Status = userentity.PaymentStatusAwaitingApproval
+		}
+	}
+	stored, created, err := u.payments.CreatePayment(ctx, payment, check)
+	if err != nil {
+		return userentity.Payment{}, fmt.Errorf("create payment: %w", err)
+	}
+	if !created {
+		return u.repeated(ctx, stored, kind, input.Amount)
+	}
+	if stored.Status == userentity.PaymentStatusAwaitingApproval {
+		return stored, nil
+	}
+	return u.submit(ctx, stored)
+}
+
+// repeated answers a request whose key created existing.
+func (u UserPaymentUseCase) repeated(ctx context.Context, existing userentity.Payment, kind userentity.PaymentKind, amount int64) (userentity.Payment, error) {
+	if existing.Kind != kind || existing.Amount != amount {
+		return userentity.Payment{}, ErrIdempotencyKeyReused
+	}
+	if existing.Status == userentity.PaymentStatusPending {
+		return u.submit(ctx, existing)
+	}
+	return existing, nil
+}
+
+// checkFunds is the ports.PaymentCheck of withdrawals. It fails unless the
+// amount is free of open withdrawals and of open buy orders with the largest
+// commission they can be charged.
+func (u UserPaymentUseCase) checkFunds(ctx context.Context, payment userentity.Payment, account userentity.TradingAccount, open []userentity.Order) error {
+	fees, err := feeScheduleAt(ctx, u.fees, account.Tier, payment.CreatedAt)
+	if err != nil {
+		return err
+	}
+	reserved, _ := reservations(open, fees)
+	if available := account.Cash - account.Withheld - reserved; payment.Amount > available {
+		return ErrInsufficientFunds.WithMetadata(riskMetadata(map[string]int64{"required": payment.Amount, "available": available}))
+	}
+	return nil
+}
+
+// submit hands a pending payment to the gateway and records it as
+// processing. When the submission fails the payment stays pending, so
+// repeating the request submits it again. The gateway may confirm the
+// payment before the submission is recorded; the confirmed payment is then
+// returned.
+func (u UserPaymentUseCase) submit(ctx context.Context, payment userentity.Payment) (userentity.Payment, error) {
+	initiation, err := u.gateway.Initiate(ctx, ports.PaymentInstruction{
+		Reference: payment.Reference(),
+		UserID:    payment.UserID,
+		Kind:      payment.Kind,
+		Amount:    payment.Amount,
+	})
+	if err != nil {
+		return userentity.Payment{}, fmt.Errorf("initiate payment: %w", err)
+	}
+	processing, err := u.payments.UpdatePayment(ctx, ports.UpdatePaymentParams{
+		PaymentID:        payment.ID,
+		Status:           userentity.PaymentStatusProcessing,
+		GatewayReference: initiation.GatewayReference,
+		PaymentURL:       initiation.PaymentURL,
+		At:               time.Now().UTC(),
+	})
+	if errors.Is(err, apperrors.ErrFailedPrecondition) {
+		current, err := u.payments.GetPayment(ctx, payment.ID)
+		if err != nil {
+			return userentity.Payment{}, fmt.Errorf("get payment: %w", err)
+		}
+		return current, nil
+	}
+	if err != nil {
+		return userentity.Payment{}, fmt.Errorf("record payment submission: %w", err)
+	}
+	return processing, nil
+}
+
+// List returns a page of the caller's payments, newest first.
+func (u UserPaymentUseCase) List(ctx context.Context, uid int64, username string, pageSize uint32, pageToken string) (PaymentListResult, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return PaymentListResult{}, err
+	}
+	return u.list(ctx, ports.ListPaymentsParams{UserID: owner.Id}, pageSize, pageToken)
+}
+
+// ListAwaitingApproval returns a page of the withdrawals of every user that
+// wait for an administrator, newest first.
+func (u UserPaymentUseCase) ListAwaitingApproval(ctx context.Context, pageSize uint32, pageToken string) (PaymentListResult, error) {
+	return u.list(ctx, ports.ListPaymentsParams{Status: userentity.PaymentStatusAwaitingApproval}, pageSize, pageToken)
+}
+
+func (u UserPaymentUseCase) list(ctx context.Context, params ports.ListPaymentsParams, pageSize uint32, pageToken string) (PaymentListResult, error) {
+	if pageSize == 0 {
+		pageSize = defaultPaymentsPageSize
+	}
+	if pageSize > maxPaymentsPageSize {
+		pageSize = maxPaymentsPageSize
+	}
+	params.Limit = int(pageSize)
+	if pageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return PaymentListResult{}, ErrListInvalidPageToken
+		}
+		var token paymentsPageToken
+		if err := json.Unmarshal(raw, &token); err != nil || token.BeforeID <= 0 {
+			return PaymentListResult{}, ErrListInvalidPageToken
+		}
+		params.BeforeID = token.BeforeID
+	}
+
+	payments, err := u.payments.ListPayments(ctx, params)
+	if err != nil {
+		return PaymentListResult{}, fmt.Errorf("list payments: %w", err)
+	}
+	result := PaymentListResult{Payments: payments}
+	if len(payments) == int(pageSize) {
+		raw, _ := json.Marshal(paymentsPageToken{BeforeID: payments[len(payments)-1].ID})
+		result.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	return result, nil
+}
+
+// ReviewWithdrawal approves or rejects a withdrawal awaiting approval. An
+// approved withdrawal is submitted to the gateway at once; if that fails it
+// stays pending and the user's retried request submits it. A rejection
+// needs a reason and releases the held cash. Administrators cannot review
+// their own withdrawals.
+func (u UserPaymentUseCase) ReviewWithdrawal(ctx context.Context, reviewerID, paymentID int64, decision, reason string) (userentity.Payment, error) {
+	decision = strings.ToLower(strings.TrimSpace(decision))
+	reason = strings.TrimSpace(reason)
+	var status userentity.PaymentStatus
+	switch decision {
+	case "approved":
+		status, reason = userentity.PaymentStatusPending, ""
+	case "rejected":
+		if reason == "" || utf8.RuneCountInString(reason) > maxPaymentReasonLen {
+			return userentity.Payment{}, ErrWithdrawalReasonRequired
+		}
+		status = userentity.PaymentStatusRejected
+	default:
+		return userentity.Payment{}, ErrWithdrawalInvalidDecision
+	}
+
+	payment, err := u.payment(ctx, paymentID)
+	if err != nil {
+		return userentity.Payment{}, err
+	}
+	if payment.Kind != userentity.PaymentKindWithdrawal || payment.Status != userentity.PaymentStatusAwaitingApproval {
+		return userentity.Payment{}, ErrPaymentNotAwaitingApproval
+	}
+	if payment.UserID == reviewerID {
+		return userentity.Payment{}, ErrWithdrawalSelfReview
+	}
+	if status == userentity.PaymentStatusPending && u.gateway == nil {
+		return userentity.Payment{}, ErrPaymentsUnavailable
+	}
+	reviewed, err := u.payments.UpdatePayment(ctx, ports.UpdatePaymentParams{
+		PaymentID:  payment.ID,
+		Status:     status,
+		Reason:     reason,
+		ReviewerID: reviewerID,
+		At:         time.Now().UTC(),
+	})
+	if err != nil {
+		if errors.Is(err, apperrors.ErrFailedPrecondition) {
+			return userentity.Payment{}, ErrPaymentNotAwaitingApproval
+		}
+		return userentity.Payment{}, fmt.Errorf("review withdrawal: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		UserID: payment.UserID,
+		Action: userentity.AuditActionWithdrawalReview,
+		Changes: map[string]userentity.AuditChange{
+			"payment_status": {From: string(payment.Status), To: string(reviewed.Status)},
+		},
+	})
+	if reviewed.Status == userentity.PaymentStatusPending {
+		return u.submit(ctx, reviewed)
+	}
+	return reviewed, nil
+}
+
+// HandleCallback records the outcome the gateway reports for a payment:
+// a confirmed payment completes and posts its ledger entry, a failed one
+// releases what it held. Callbacks not signed by the gateway, or naming
+// another gateway reference than the one recorded, are refused. The gateway
+// may repeat a callback; a repeated outcome returns the payment unchanged.
+func (u UserPaymentUseCase) HandleCallback(ctx context.Context, callback ports.PaymentCallback) (userentity.Payment, error) {
+	if u.gateway == nil {
+		return userentity.Payment{}, ErrPaymentsUnavailable
+	}
+	if err := u.gateway.VerifyCallback(callback); err != nil {
+		return userentity.Payment{}, ErrInvalidPaymentCallback
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(callback.Reference, paymentReferencePrefix), 10, 64)
+	if err != nil || !strings.HasPrefix(callback.Reference, paymentReferencePrefix) {
+		return userentity.Payment{}, ErrInvalidPaymentCallback
+	}
+	payment, err := u.payment(ctx, id)
+	if err != nil {
+		return userentity.Payment{}, err
+	}
+	if payment.GatewayReference != "" && payment.GatewayReference != callback.GatewayReference {
+		return userentity.Payment{}, ErrInvalidPaymentCallback
+	}
+	status := userentity.PaymentStatusFailed
+	reason := strings.TrimSpace(callback.Reason)
+	if callback.Succeeded {
+		status, reason = userentity.PaymentStatusCompleted, ""
+	}
+	if payment.Status == status {
+		return payment, nil
+	}
+	if utf8.RuneCountInString(reason) > maxPaymentReasonLen {
+		reason = string([]rune(reason)[:maxPaymentReasonLen])
+	}
+	updated, err := u.payments.UpdatePayment(ctx, ports.UpdatePaymentParams{
+		PaymentID:        payment.ID,
+		Status:           status,
+		GatewayReference: callback.GatewayReference,
+		Reason:           reason,
+		At:               time.Now().UTC(),
+	})
+	if err != nil {
+		return userentity.Payment{}, fmt.Errorf("record payment outcome: %w", err)
+	}
+	return updated, nil
+}
+
+func (u UserPaymentUseCase) payment(ctx context.Context, paymentID int64) (userentity.Payment, error) {
+	payment, err := u.payments.GetPayment(ctx, paymentID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return userentity.Payment{}, ErrPaymentNotFound
+		}
+		return userentity.Payment{}, fmt.Errorf("get payment: %w", err)
+	}
+	return payment, nil
+}
+
+// owner returns the caller's user.
+func (u UserPaymentUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
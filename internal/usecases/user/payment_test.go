@@ -0,0 +1,230 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// fakeGateway records instructions and accepts callbacks signed "ok".
+type fakeGateway struct {
+	instructions []ports.PaymentInstruction
+	err          error
+}
+
+func (g *fakeGateway) Initiate(ctx context.Context, instruction ports.PaymentInstruction) (ports.PaymentInitiation, error) {
+	if g.err != nil {
+		return ports.PaymentInitiation{}, g.err
+	}
+	g.instructions = append(g.instructions, instruction)
+	return ports.PaymentInitiation{GatewayReference: "gw-" + instruction.Reference, PaymentURL: "https://pay.example.com/" + instruction.Reference}, nil
+}
+
+func (g *fakeGateway) VerifyCallback(callback ports.PaymentCallback) error {
+	if callback.Signature != "ok" {
+		return errors.New("bad signature")
+	}
+	return nil
+}
+
+func confirm(payment userentity.Payment, succeeded bool) ports.PaymentCallback {
+	return ports.PaymentCallback{Reference: payment.Reference(), GatewayReference: "gw-" + payment.Reference(), Succeeded: succeeded, Reason: "declined", Signature: "ok"}
+}
+
+func TestUserPaymentUseCase_Deposit(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob, err := seedUserWithToken(t, repo, "bob", "bob@example.com", "secret", "token-bob", true)
+	require.NoError(t, err)
+	gateway := &fakeGateway{}
+	uc := NewUserPaymentUseCase(repo, repo, repo, gateway, PaymentConfig{})
+
+	deposit, err := uc.Deposit(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 5_000_000, IdempotencyKey: " dep-1 "})
+	require.NoError(t, err)
+	assert.Equal(t, userentity.PaymentStatusProcessing, deposit.Status)
+	assert.Equal(t, "dep-1", deposit.IdempotencyKey)
+	assert.Equal(t, "https://pay.example.com/"+deposit.Reference(), deposit.PaymentURL)
+	require.Len(t, gateway.instructions, 1)
+	assert.Equal(t, ports.PaymentInstruction{Reference: deposit.Reference(), UserID: alice.Id, Kind: userentity.PaymentKindDeposit, Amount: 5_000_000}, gateway.instructions[0])
+
+	again, err := uc.Deposit(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 5_000_000, IdempotencyKey: "dep-1"})
+	require.NoError(t, err)
+	assert.Equal(t, deposit.ID, again.ID)
+	assert.Len(t, gateway.instructions, 1, "a processing payment is not submitted again")
+	_, err = uc.Deposit(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 6_000_000, IdempotencyKey: "dep-1"})
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	_, err = uc.Withdraw(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 5_000_000, IdempotencyKey: "dep-1"})
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+
+	account, err := repo.GetTradingAccount(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Zero(t, account.Cash, "nothing is posted before the gateway confirms")
+
+	forged := confirm(deposit, true)
+	forged.Signature = "forged"
+	_, err = uc.HandleCallback(ctx, forged)
+	assert.ErrorIs(t, err, ErrInvalidPaymentCallback)
+	mismatched := confirm(deposit, true)
+	mismatched.GatewayReference = "gw-other"
+	_, err = uc.HandleCallback(ctx, mismatched)
+	assert.ErrorIs(t, err, ErrInvalidPaymentCallback)
+	_, err = uc.HandleCallback(ctx, ports.PaymentCallback{Reference: "order:1", Signature: "ok"})
+	assert.ErrorIs(t, err, ErrInvalidPaymentCallback)
+	_, err = uc.HandleCallback(ctx, ports.PaymentCallback{Reference: "payment:999", Signature: "ok"})
+	assert.ErrorIs(t, err, ErrPaymentNotFound)
+
+	completed, err := uc.HandleCallback(ctx, confirm(deposit, true))
+	require.NoError(t, err)
+	assert.Equal(t, userentity.PaymentStatusCompleted, completed.Status)
+	repeated, err := uc.HandleCallback(ctx, confirm(deposit, true))
+	require.NoError(t, err)
+	assert.Equal(t, completed, repeated, "a repeated callback changes nothing")
+	_, err = uc.HandleCallback(ctx, confirm(deposit, false))
+	assert.ErrorIs(t, err, apperrors.ErrFailedPrecondition)
+
+	account, err = repo.GetTradingAccount(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5_000_000), account.Cash)
+
+	_, err = uc.Deposit(ctx, bob.Id, "bob", PaymentRequestInput{Amount: 1, IdempotencyKey: "k"})
+	assert.ErrorIs(t, err, ErrKycNotApproved)
+	_, err = uc.Deposit(ctx, bob.Id, "alice", PaymentRequestInput{Amount: 1, IdempotencyKey: "k"})
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	for _, input := range []PaymentRequestInput{{Amount: 0, IdempotencyKey: "k"}, {Amount: 1}, {Amount: 1, IdempotencyKey: fmt.Sprintf("%065d", 0)}} {
+		_, err = uc.Deposit(ctx, alice.Id, "alice", input)
+		assert.ErrorIs(t, err, ErrInvalidPaymentRequest)
+	}
+
+	listed, err := uc.List(ctx, alice.Id, "alice", 1, "")
+	require.NoError(t, err)
+	require.Len(t, listed.Payments, 1)
+	assert.NotEmpty(t, listed.NextPageToken)
+	listed, err = uc.List(ctx, alice.Id, "alice", 1, listed.NextPageToken)
+	require.NoError(t, err)
+	assert.Empty(t, listed.Payments)
+	_, err = uc.List(ctx, alice.Id, "alice", 1, "!")
+	assert.ErrorIs(t, err, ErrListInvalidPageToken)
+
+	_, err = NewUserPaymentUseCase(repo, repo, repo, nil, PaymentConfig{}).Deposit(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 1, IdempotencyKey: "k"})
+	assert.ErrorIs(t, err, ErrPaymentsUnavailable)
+}
+
+func TestUserPaymentUseCase_GatewayFailure(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	gateway := &fakeGateway{err: errors.New("gateway down")}
+	uc := NewUserPaymentUseCase(repo, repo, repo, gateway, PaymentConfig{})
+
+	_, err := uc.Deposit(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 1_000_000, IdempotencyKey: "dep"})
+	require.Error(t, err)
+	pending, err := repo.FindPayment(ctx, alice.Id, "dep")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.PaymentStatusPending, pending.Status)
+
+	gateway.err = nil
+	retried, err := uc.Deposit(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 1_000_000, IdempotencyKey: "dep"})
+	require.NoError(t, err)
+	assert.Equal(t, pending.ID, retried.ID)
+	assert.Equal(t, userentity.PaymentStatusProcessing, retried.Status, "a retry submits the pending payment")
+}
+
+func TestUserPaymentUseCase_WithdrawReservesFees(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	seedFeeSchedule(t, repo, standardFees, userentity.DefaultTradingTier, vnTime("2026-01-01", "00:00"))
+	fundAccount(t, repo, alice.Id, 10_000_000, 0)
+	createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 20000, 100, vnTime("2026-10-21", "10:00"))
+	uc := NewUserPaymentUseCase(repo, repo, repo, &fakeGateway{}, PaymentConfig{})
+
+	_, err := uc.Withdraw(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 8_000_000, IdempotencyKey: "wd-1"})
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+	assert.Equal(t, "7990000", apperrors.MetadataOf(err)["available"], "the open buy order holds its minimum fee too")
+	_, err = uc.Withdraw(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 7_990_000, IdempotencyKey: "wd-2"})
+	require.NoError(t, err)
+}
+
+func TestUserPaymentUseCase_Withdraw(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	admin := seedTradingUser(t, repo, "admin")
+	seedStocks(repo, "VNM")
+	fundAccount(t, repo, alice.Id, 10_000_000, 0)
+	createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 20000, 100, vnTime("2026-10-21", "10:00"))
+	gateway := &fakeGateway{}
+	uc := NewUserPaymentUseCase(repo, repo, repo, gateway, PaymentConfig{WithdrawalApprovalThreshold: 5_000_000})
+
+	_, err := uc.Withdraw(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 8_000_001, IdempotencyKey: "wd-0"})
+	var appErr *apperrors.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+	assert.Equal(t, "8000000", appErr.Metadata()["available"], "open buy orders hold their cash")
+
+	small, err := uc.Withdraw(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 2_000_000, IdempotencyKey: "wd-1"})
+	require.NoError(t, err)
+	assert.Equal(t, userentity.PaymentStatusProcessing, small.Status)
+	large, err := uc.Withdraw(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 6_000_000, IdempotencyKey: "wd-2"})
+	require.NoError(t, err)
+	assert.Equal(t, userentity.PaymentStatusAwaitingApproval, large.Status)
+	assert.Len(t, gateway.instructions, 1, "withdrawals above the threshold wait for approval")
+	_, err = uc.Withdraw(ctx, alice.Id, "alice", PaymentRequestInput{Amount: 1, IdempotencyKey: "wd-3"})
+	assert.ErrorIs(t, err, ErrInsufficientFunds, "open withdrawals hold their cash")
+
+	awaiting, err := uc.ListAwaitingApproval(ctx, 0, "")
+	require.NoError(t, err)
+	require.Len(t, awaiting.Payments, 1)
+	assert.Equal(t, large.ID, awaiting.Payments[0].ID)
+
+	_, err = uc.ReviewWithdrawal(ctx, admin.Id, large.ID, "maybe", "")
+	assert.ErrorIs(t, err, ErrWithdrawalInvalidDecision)
+	_, err = uc.ReviewWithdrawal(ctx, admin.Id, large.ID, "rejected", " ")
+	assert.ErrorIs(t, err, ErrWithdrawalReasonRequired)
+	_, err = uc.ReviewWithdrawal(ctx, alice.Id, large.ID, "approved", "")
+	assert.ErrorIs(t, err, ErrWithdrawalSelfReview)
+	_, err = uc.ReviewWithdrawal(ctx, admin.Id, small.ID, "approved", "")
+	assert.ErrorIs(t, err, ErrPaymentNotAwaitingApproval)
+	_, err = uc.ReviewWithdrawal(ctx, admin.Id, 999, "approved", "")
+	assert.ErrorIs(t, err, ErrPaymentNotFound)
+
+	approved, err := uc.ReviewWithdrawal(ctx, admin.Id, large.ID, " Approved ", "")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.PaymentStatusProcessing, approved.Status)
+	assert.Equal(t, admin.Id, approved.ReviewerID)
+	require.Len(t, gateway.instructions, 2)
+	assert.Equal(t, userentity.PaymentKindWithdrawal, gateway.instructions[1].Kind)
+
+	_, err = uc.HandleCallback(ctx, confirm(small, true))
+	require.NoError(t, err)
+	failed, err := uc.HandleCallback(ctx, confirm(approved, false))
+	require.NoError(t, err)
+	assert.Equal(t, userentity.PaymentStatusFailed, failed.Status)
+	assert.Equal(t, "declined", failed.Reason)
+
+	account, err := repo.GetTradingAccount(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(8_000_000), account.Cash, "only the confirmed withdrawal is posted")
+	assert.Zero(t, account.Withheld)
+
+	rejectedInput := PaymentRequestInput{Amount: 5_500_000, IdempotencyKey: "wd-4"}
+	pending, err := uc.Withdraw(ctx, alice.Id, "alice", rejectedInput)
+	require.NoError(t, err)
+	rejected, err := uc.ReviewWithdrawal(ctx, admin.Id, pending.ID, "rejected", "unusual activity")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.PaymentStatusRejected, rejected.Status)
+	assert.Equal(t, "unusual activity", rejected.Reason)
+	again, err := uc.Withdraw(ctx, alice.Id, "alice", rejectedInput)
+	require.NoError(t, err)
+	assert.Equal(t, rejected, again, "a repeated request returns the rejected payment")
+}
@@ -0,0 +1,712 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+var (
+	ErrInvalidCostMethod       = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_COST_METHOD", "cost method must be fifo or average")
+	ErrInvalidPerformanceRange = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_PERFORMANCE_RANGE", "range must be 1w, 1m, 3m, 6m, ytd, 1y or all")
+)
+
+// PerformanceRange is a period ending today that returns are measured over.
+type PerformanceRange string
+
+const (
+	PerformanceRangeWeek     PerformanceRange = "1w"
+	PerformanceRangeMonth    PerformanceRange = "1m"
+	PerformanceRangeQuarter  PerformanceRange = "3m"
+	PerformanceRangeHalfYear PerformanceRange = "6m"
+	PerformanceRangeYTD      PerformanceRange = "ytd"
+	PerformanceRangeYear     PerformanceRange = "1y"
+	PerformanceRangeAll      PerformanceRange = "all"
+)
+
+// performanceRanges are the ranges returns are reported for, shortest
+// first.
+var performanceRanges = []PerformanceRange{
+	PerformanceRangeWeek, PerformanceRangeMonth, PerformanceRangeQuarter, PerformanceRangeHalfYear,
+	PerformanceRangeYTD, PerformanceRangeYear, PerformanceRangeAll,
+}
+
+// Valid reports whether r is a known range.
+func (r PerformanceRange) Valid() bool {
+	for _, known := range performanceRanges {
+		if r == known {
+			return true
+		}
+	}
+	return false
+}
+
+// base returns the day whose closing value returns over r are measured
+// from, given today at midnight UTC: the day before the range starts. All
+// returns the zero time, before any snapshot.
+func (r PerformanceRange) base(today time.Time) time.Time {
+	switch r {
+	case PerformanceRangeWeek:
+		return today.AddDate(0, 0, -7)
+	case PerformanceRangeMonth:
+		return today.AddDate(0, -1, 0)
+	case PerformanceRangeQuarter:
+		return today.AddDate(0, -3, 0)
+	case PerformanceRangeHalfYear:
+		return today.AddDate(0, -6, 0)
+	case PerformanceRangeYTD:
+		return time.Date(today.Year()-1, time.December, 31, 0, 0, 0, 0, time.UTC)
+	case PerformanceRangeYear:
+		return today.AddDate(-1, 0, 0)
+	}
+	return time.Time{}
+}
+
+// PortfolioConfig holds the defaults of portfolio performance.
+type PortfolioConfig struct {
+	// CostMethod measures realized P&L when a request names none. Empty is
+	// FIFO.
+	CostMethod userentity.CostMethod
+}
+
+// PortfolioPosition is the holding of one stock. Cost is what the shares
+// held cost, fees included, by the cost method; shares received from stock
+// dividends and splits cost nothing. Price is the latest price recorded,
+// zero when none was. RealizedPnL is what sales of the stock made over the
+// cost of the shares they gave up, net of their fees and taxes.
+type PortfolioPosition struct {
+	Code          string
+	Sector        string
+	Quantity      int64
+	Cost          int64
+	Price         int64
+	MarketValue   int64
+	UnrealizedPnL int64
+	RealizedPnL   int64
+	// WeightBps is the share of the portfolio value the position makes up,
+	// in basis points.
+	WeightBps int64
+}
+
+// SectorAllocation is the market value of the positions in one sector.
+// Sector is empty for stocks that are not classified.
+type SectorAllocation struct {
+	Sector      string
+	MarketValue int64
+	WeightBps   int64
+}
+
+// PerformanceReturn is the time-weighted return over a range, in basis
+// points. Deposits and withdrawals do not count towards it.
+type PerformanceReturn struct {
+	Range     PerformanceRange
+	ReturnBps int64
+}
+
+// PerformancePoint is the value of the portfolio at the end of a trading
+// day, or now for the last point of a history. ReturnBps is the
+// time-weighted return from the start of the range to the point.
+type PerformancePoint struct {
+	Date      time.Time
+	Value     int64
+	NetFlow   int64
+	ReturnBps int64
+}
+
+// PortfolioPerformance is how a portfolio is doing now. Balances are on
+// trade date: what pending trades deliver is counted. Amounts are in VND.
+type PortfolioPerformance struct {
+	CostMethod    userentity.CostMethod
+	Range         PerformanceRange
+	AsOf          time.Time
+	Cash          int64
+	MarketValue   int64
+	CashWeightBps int64
+	RealizedPnL   int64
+	UnrealizedPnL int64
+	// Dividends are the cash dividends received.
+	Dividends int64
+	// Fees and Taxes are what every fill was charged.
+	Fees      int64
+	Taxes     int64
+	Positions []PortfolioPosition
+	Sectors   []SectorAllocation
+	// Returns holds the return over every range, shortest first.
+	Returns []PerformanceReturn
+	// History charts the value over Range, one point per trading day.
+	History []PerformancePoint
+}
+
+// Value is the cash and the market value of the positions.
+func (p PortfolioPerformance) Value() int64 {
+	return p.Cash + p.MarketValue
+}
+
+// PortfolioSnapshotRunResult tells what a run of Snapshot did.
+type PortfolioSnapshotRunResult struct {
+	// Date is the last day snapshots were taken for, at midnight UTC.
+	Date time.Time
+	// Users is how many accounts got snapshots.
+	Users     int
+	Snapshots int
+}
+
+// UserPortfolioUseCase reports the P&L, allocation and time-weighted
+// returns of portfolios. Returns are chained from daily snapshots of the
+// value of every account, taken once a day has ended by the
+// snapshot-portfolios command or, for the days it has not covered yet, the
+// first time performance is asked for; history is never recomputed.
+type UserPortfolioUseCase struct {
+	users      ports.UserRepository
+	portfolios ports.PortfolioRepository
+	orders     ports.OrderRepository
+	stocks     ports.StockRepository
+	actions    ports.CorporateActionRepository
+	calendar   TradingCalendar
+	config     PortfolioConfig
+}
+
+// NewUserPortfolioUseCase cuts days at midnight in the time zone of
+// calendar and snapshots its trading days only.
+func NewUserPortfolioUseCase(users ports.UserRepository, portfolios ports.PortfolioRepository, orders ports.OrderRepository, stocks ports.StockRepository, actions ports.CorporateActionRepository, calendar TradingCalendar, config PortfolioConfig) UserPortfolioUseCase {
+	if config.CostMethod == "" {
+		config.CostMethod = userentity.CostMethodFIFO
+	}
+	return UserPortfolioUseCase{
+		users:      users,
+		portfolios: portfolios,
+		orders:     orders,
+		stocks:     stocks,
+		actions:    actions,
+		calendar:   calendar,
+		config:     config,
+	}
+}
+
+// Performance returns the performance of the caller's portfolio with
+// realized P&L measured by method and history over rng. Empty arguments
+// take the configured cost method and the last month.
+func (u UserPortfolioUseCase) Performance(ctx context.Context, uid int64, username string, method userentity.CostMethod, rng PerformanceRange) (PortfolioPerformance, error) {
+	if method == "" {
+		method = u.config.CostMethod
+	}
+	if !method.Valid() {
+		return PortfolioPerformance{}, ErrInvalidCostMethod
+	}
+	if rng == "" {
+		rng = PerformanceRangeMonth
+	}
+	if !rng.Valid() {
+		return PortfolioPerformance{}, ErrInvalidPerformanceRange
+	}
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return PortfolioPerformance{}, err
+	}
+
+	now := time.Now()
+	today := u.calendar.Date(now)
+	if _, err := u.snapshot(ctx, owner.Id, today.AddDate(0, 0, -1)); err != nil {
+		return PortfolioPerformance{}, fmt.Errorf("snapshot portfolio: %w", err)
+	}
+	snapshots, err := u.portfolios.ListPortfolioSnapshots(ctx, owner.Id, time.Time{})
+	if err != nil {
+		return PortfolioPerformance{}, fmt.Errorf("list portfolio snapshots: %w", err)
+	}
+	entries, err := u.portfolios.ListTradeDateEntries(ctx, owner.Id, now)
+	if err != nil {
+		return PortfolioPerformance{}, fmt.Errorf("list trade date entries: %w", err)
+	}
+	trades, err := u.orders.ListTrades(ctx, ports.ListTradesParams{UserID: owner.Id, To: now})
+	if err != nil {
+		return PortfolioPerformance{}, fmt.Errorf("list trades: %w", err)
+	}
+
+	perf := PortfolioPerformance{CostMethod: method, Range: rng, AsOf: now.UTC()}
+	book, err := u.costBook(ctx, method, entries, trades)
+	if err != nil {
+		return PortfolioPerformance{}, err
+	}
+	for _, trade := range trades {
+		perf.Fees += trade.Fill.Fee
+		perf.Taxes += trade.Fill.Tax
+	}
+	for _, realized := range book.realized {
+		perf.RealizedPnL += realized
+	}
+
+	// The live point counts the flows since the end of the last snapshot.
+	var flowsFrom time.Time
+	if len(snapshots) > 0 {
+		flowsFrom = u.dayEnd(snapshots[len(snapshots)-1].Date)
+	}
+	var flow int64
+	shares := make(map[string]int64)
+	flowShares := make(map[string]int64)
+	for _, entry := range entries {
+		external := externalFlow(entry) && !entry.CreatedAt.Before(flowsFrom)
+		switch {
+		case entry.Cash():
+			perf.Cash += entry.Amount
+			if external {
+				flow += entry.Amount
+			}
+			if entry.Type == userentity.LedgerEntryCorporateAction {
+				perf.Dividends += entry.Amount
+			}
+		default:
+			shares[entry.Code] += entry.Amount
+			if external {
+				flowShares[entry.Code] += entry.Amount
+			}
+		}
+	}
+
+	codes := heldCodes(shares, flowShares)
+	prices := make(map[string]int64, len(codes))
+	quotes, err := u.stocks.LatestStockQuotes(ctx, codes)
+	if err != nil {
+		return PortfolioPerformance{}, fmt.Errorf("latest stock quotes: %w", err)
+	}
+	for _, quote := range quotes {
+		prices[quote.Code] = quote.Price
+	}
+	stocks, err := u.stocks.GetStocksByCodes(ctx, codes)
+	if err != nil {
+		return PortfolioPerformance{}, fmt.Errorf("get stocks: %w", err)
+	}
+	sectors := make(map[string]string, len(stocks))
+	for _, stock := range stocks {
+		sectors[stock.Code] = stock.Sector
+	}
+
+	for _, code := range codes {
+		flow += flowShares[code] * prices[code]
+		if shares[code] == 0 {
+			continue
+		}
+		_, cost := book.holding(code)
+		position := PortfolioPosition{
+			Code:        code,
+			Sector:      sectors[code],
+			Quantity:    shares[code],
+			Cost:        cost,
+			Price:       prices[code],
+			MarketValue: shares[code] * prices[code],
+			RealizedPnL: book.realized[code],
+		}
+		position.UnrealizedPnL = position.MarketValue - position.Cost
+		perf.MarketValue += position.MarketValue
+		perf.UnrealizedPnL += position.UnrealizedPnL
+		perf.Positions = append(perf.Positions, position)
+	}
+	perf.allocate()
+
+	points := make([]PerformancePoint, 0, len(snapshots)+1)
+	for _, snapshot := range snapshots {
+		points = append(points, PerformancePoint{Date: snapshot.Date, Value: snapshot.Value(), NetFlow: snapshot.NetFlow})
+	}
+	points = append(points, PerformancePoint{Date: today, Value: perf.Value(), NetFlow: flow})
+	for _, r := range performanceRanges {
+		history := timeWeighted(points, r.base(today))
+		var ret int64
+		if len(history) > 0 {
+			ret = history[len(history)-1].ReturnBps
+		}
+		perf.Returns = append(perf.Returns, PerformanceReturn{Range: r, ReturnBps: ret})
+		if r == rng {
+			perf.History = history
+		}
+	}
+	return perf, nil
+}
+
+// allocate weighs the positions and their sectors against the portfolio
+// value, sorting sectors by market value, largest first.
+func (p *PortfolioPerformance) allocate() {
+	total := p.Value()
+	weight := func(value int64) int64 {
+		if total <= 0 {
+			return 0
+		}
+		return int64(math.Round(float64(value) * 10000 / float64(total)))
+	}
+	p.CashWeightBps = weight(p.Cash)
+	bySector := make(map[string]int64)
+	for i := range p.Positions {
+		p.Positions[i].WeightBps = weight(p.Positions[i].MarketValue)
+		bySector[p.Positions[i].Sector] += p.Positions[i].MarketValue
+	}
+	for sector, value := range bySector {
+		p.Sectors = append(p.Sectors, SectorAllocation{Sector: sector, MarketValue: value, WeightBps: weight(value)})
+	}
+	sort.Slice(p.Sectors, func(i, j int) bool {
+		if p.Sectors[i].MarketValue != p.Sectors[j].MarketValue {
+			return p.Sectors[i].MarketValue > p.Sectors[j].MarketValue
+		}
+		return p.Sectors[i].Sector < p.Sectors[j].Sector
+	})
+}
+
+// timeWeighted returns the points dated after base with the return chained
+// from base to each. A day returns its value over the value of the day
+// before plus the day's net flow; a day that starts with nothing invested
+// returns nothing.
+func timeWeighted(points []PerformancePoint, base time.Time) []PerformancePoint {
+	var (
+		history  []PerformancePoint
+		previous int64
+		growth   = 1.0
+	)
+	for _, point := range points {
+		if point.Date.After(base) {
+			if invested := previous + point.NetFlow; invested > 0 {
+				growth *= float64(point.Value) / float64(invested)
+			}
+			point.ReturnBps = int64(math.Round((growth - 1) * 10000))
+			history = append(history, point)
+		}
+		previous = point.Value
+	}
+	return history
+}
+
+// Snapshot takes the snapshots of every account up to the day of t,
+// catching up on the days earlier runs missed. Days are snapshotted once
+// they have ended, so a run during the day stops at the day before.
+func (u UserPortfolioUseCase) Snapshot(ctx context.Context, t time.Time) (PortfolioSnapshotRunResult, error) {
+	result := PortfolioSnapshotRunResult{Date: u.lastEndedDay(u.calendar.Date(t))}
+	owners, err := u.portfolios.ListPortfolioOwners(ctx, u.dayEnd(result.Date))
+	if err != nil {
+		return result, fmt.Errorf("list portfolio owners: %w", err)
+	}
+	for _, userID := range owners {
+		taken, err := u.snapshot(ctx, userID, result.Date)
+		if err != nil {
+			return result, fmt.Errorf("snapshot portfolio of user %d: %w", userID, err)
+		}
+		if taken > 0 {
+			result.Users++
+			result.Snapshots += taken
+		}
+	}
+	return result, nil
+}
+
+// snapshot stores the snapshots of the user's trading days after the last
+// one stored, through the day through at most, and returns how many it
+// stored. Shares are valued at the last price recorded each day, taken
+// back to what was traded then for the corporate actions applied since.
+func (u UserPortfolioUseCase) snapshot(ctx context.Context, userID int64, through time.Time) (int, error) {
+	through = u.lastEndedDay(through)
+	var from, flowsFrom time.Time
+	last, err := u.portfolios.LastPortfolioSnapshot(ctx, userID)
+	switch {
+	case err == nil:
+		from, flowsFrom = last.Date.AddDate(0, 0, 1), u.dayEnd(last.Date)
+		if from.After(through) {
+			return 0, nil
+		}
+	case !errors.Is(err, apperrors.ErrNotFound):
+		return 0, fmt.Errorf("last portfolio snapshot: %w", err)
+	}
+	entries, err := u.portfolios.ListTradeDateEntries(ctx, userID, u.dayEnd(through))
+	if err != nil {
+		return 0, fmt.Errorf("list trade date entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return 0, nil
+	}
+	if from.IsZero() {
+		from = u.calendar.Date(entries[0].CreatedAt)
+	}
+
+	var (
+		snapshots []userentity.PortfolioSnapshot
+		cash      int64
+		shares    = make(map[string]int64)
+		actions   = make(map[string][]userentity.CorporateAction)
+		now       = time.Now().UTC()
+		next      int
+	)
+	for day := from; !day.After(through); day = day.AddDate(0, 0, 1) {
+		if !u.calendar.TradingDay(u.dayStart(day)) {
+			continue
+		}
+		end := u.dayEnd(day)
+		var flow int64
+		flowShares := make(map[string]int64)
+		for ; next < len(entries) && entries[next].CreatedAt.Before(end); next++ {
+			entry := entries[next]
+			external := externalFlow(entry) && !entry.CreatedAt.Before(flowsFrom)
+			if entry.Cash() {
+				cash += entry.Amount
+				if external {
+					flow += entry.Amount
+				}
+				continue
+			}
+			shares[entry.Code] += entry.Amount
+			if external {
+				flowShares[entry.Code] += entry.Amount
+			}
+		}
+		prices, err := u.pricesOn(ctx, heldCodes(shares, flowShares), day, actions)
+		if err != nil {
+			return 0, err
+		}
+		snapshot := userentity.PortfolioSnapshot{UserID: userID, Date: day, Cash: cash, CreatedAt: now}
+		for code, held := range shares {
+			snapshot.MarketValue += held * prices[code]
+		}
+		for code, moved := range flowShares {
+			flow += moved * prices[code]
+		}
+		snapshot.NetFlow = flow
+		snapshots = append(snapshots, snapshot)
+		flowsFrom = end
+	}
+	if len(snapshots) == 0 {
+		return 0, nil
+	}
+	if err := u.portfolios.SavePortfolioSnapshots(ctx, snapshots); err != nil {
+		return 0, fmt.Errorf("save portfolio snapshots: %w", err)
+	}
+	return len(snapshots), nil
+}
+
+// pricesOn returns the last prices of codes recorded by the end of day, as
+// they were traded then: the back-adjustments of the corporate actions with
+// a later ex-date are undone. actions caches the actions of each stock.
+func (u UserPortfolioUseCase) pricesOn(ctx context.Context, codes []string, day time.Time, actions map[string][]userentity.CorporateAction) (map[string]int64, error) {
+	prices := make(map[string]int64, len(codes))
+	if len(codes) == 0 {
+		return prices, nil
+	}
+	quotes, err := u.stocks.LatestStockQuotesBefore(ctx, codes, u.dayEnd(day))
+	if err != nil {
+		return nil, fmt.Errorf("latest stock quotes: %w", err)
+	}
+	for _, quote := range quotes {
+		applied, ok := actions[quote.Code]
+		if !ok {
+			if applied, err = u.actions.ListCorporateActions(ctx, quote.Code); err != nil {
+				return nil, fmt.Errorf("list corporate actions: %w", err)
+			}
+			actions[quote.Code] = applied
+		}
+		prices[quote.Code] = unadjustedPrice(quote.Price, applied, day)
+	}
+	return prices, nil
+}
+
+// unadjustedPrice undoes on price the adjustments of the applied actions
+// with an ex-date after day.
+func unadjustedPrice(price int64, actions []userentity.CorporateAction, day time.Time) int64 {
+	for _, action := range actions {
+		adjustment := action.Adjustment
+		if action.Status != userentity.CorporateActionStatusApplied || !action.ExDate.After(day) || adjustment.Identity() || adjustment.Numerator == 0 {
+			continue
+		}
+		price = userentity.PriceAdjustment{Numerator: adjustment.Denominator, Denominator: adjustment.Numerator}.Apply(price)
+	}
+	return price
+}
+
+// costBook replays the fills and the share entries other than trades in
+// time order. Shares posted by administrators cost what they were worth
+// then; shares withdrawn by them leave at cost without realizing anything.
+func (u UserPortfolioUseCase) costBook(ctx context.Context, method userentity.CostMethod, entries []userentity.LedgerEntry, trades []ports.Trade) (costBook, error) {
+	book := costBook{method: method, lots: make(map[string][]costLot), realized: make(map[string]int64)}
+	actions := make(map[string][]userentity.CorporateAction)
+	next := 0
+	for _, entry := range entries {
+		if entry.Cash() || entry.Type == userentity.LedgerEntryTrade || entry.Type == userentity.LedgerEntrySettlement {
+			continue
+		}
+		for ; next < len(trades) && trades[next].Fill.CreatedAt.Before(entry.CreatedAt); next++ {
+			book.fill(trades[next])
+		}
+		switch {
+		case entry.Amount < 0:
+			book.take(entry.Code, -entry.Amount)
+		case entry.Type == userentity.LedgerEntryCorporateAction:
+			book.split(entry.Code, entry.Amount)
+		default:
+			day := u.calendar.Date(entry.CreatedAt)
+			quotes, err := u.stocks.LatestStockQuotesBefore(ctx, []string{entry.Code}, entry.CreatedAt)
+			if err != nil {
+				return costBook{}, fmt.Errorf("latest stock quotes: %w", err)
+			}
+			var price int64
+			if len(quotes) > 0 {
+				applied, ok := actions[entry.Code]
+				if !ok {
+					if applied, err = u.actions.ListCorporateActions(ctx, entry.Code); err != nil {
+						return costBook{}, fmt.Errorf("list corporate actions: %w", err)
+					}
+					actions[entry.Code] = applied
+				}
+				price = unadjustedPrice(quotes[0].Price, applied, day)
+			}
+			book.add(entry.Code, entry.Amount, entry.Amount*price)
+		}
+	}
+	for ; next < len(trades); next++ {
+		book.fill(trades[next])
+	}
+	return book, nil
+}
+
+// costLot is shares bought together and what they cost.
+type costLot struct {
+	quantity int64
+	cost     int64
+}
+
+// costBook keeps the cost of the shares held, by stock, and the P&L sales
+// realized. FIFO keeps a lot per purchase and sells the earliest first;
+// average cost keeps one lot per stock.
+type costBook struct {
+	method   userentity.CostMethod
+	lots     map[string][]costLot
+	realized map[string]int64
+}
+
+// fill books a fill: a purchase costs its value and fee; a sale realizes
+// its value less its fee, tax and the cost of the shares it gave up.
+func (b *costBook) fill(trade ports.Trade) {
+	code, fill := trade.Order.Code, trade.Fill
+	value := fill.Price * fill.Quantity
+	if trade.Order.Side == userentity.OrderSideBuy {
+		b.add(code, fill.Quantity, value+fill.Fee)
+		return
+	}
+	b.realized[code] += value - fill.Fee - fill.Tax - b.take(code, fill.Quantity)
+}
+
+func (b *costBook) add(code string, quantity, cost int64) {
+	lots := b.lots[code]
+	if b.method == userentity.CostMethodAverage && len(lots) > 0 {
+		lots[0].quantity += quantity
+		lots[0].cost += cost
+		return
+	}
+	b.lots[code] = append(lots, costLot{quantity: quantity, cost: cost})
+}
+
+// take removes quantity shares, earliest lot first, and returns what they
+// cost. Shares beyond those on the book cost nothing.
+func (b *costBook) take(code string, quantity int64) int64 {
+	lots := b.lots[code]
+	var cost int64
+	for quantity > 0 && len(lots) > 0 {
+		if lots[0].quantity <= quantity {
+			cost += lots[0].cost
+			quantity -= lots[0].quantity
+			lots = lots[1:]
+			continue
+		}
+		part := lots[0].cost * quantity / lots[0].quantity
+		lots[0].cost -= part
+		lots[0].quantity -= quantity
+		cost += part
+		quantity = 0
+	}
+	b.lots[code] = lots
+	return cost
+}
+
+// split hands out quantity new shares over the lots in proportion to their
+// shares, the remainder to the latest lot, leaving their cost as it is.
+func (b *costBook) split(code string, quantity int64) {
+	held, _ := b.holding(code)
+	lots := b.lots[code]
+	if held <= 0 {
+		b.add(code, quantity, 0)
+		return
+	}
+	rest := quantity
+	for i := range lots {
+		share := quantity * lots[i].quantity / held
+		lots[i].quantity += share
+		rest -= share
+	}
+	lots[len(lots)-1].quantity += rest
+}
+
+// holding returns the shares of code on the book and what they cost.
+func (b *costBook) holding(code string) (quantity, cost int64) {
+	for _, lot := range b.lots[code] {
+		quantity += lot.quantity
+		cost += lot.cost
+	}
+	return quantity, cost
+}
+
+// externalFlow reports whether entry moves cash or shares in or out of the
+// account rather than being earned by it.
+func externalFlow(entry userentity.LedgerEntry) bool {
+	switch entry.Type {
+	case userentity.LedgerEntryDeposit, userentity.LedgerEntryWithdrawal, userentity.LedgerEntryAdjustment:
+		return true
+	}
+	return false
+}
+
+// heldCodes returns the codes with shares in any of holdings, sorted.
+func heldCodes(holdings ...map[string]int64) []string {
+	seen := make(map[string]bool)
+	codes := make([]string, 0)
+	for _, holding := range holdings {
+		for code, quantity := range holding {
+			if quantity != 0 && !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// lastEndedDay returns day, or yesterday when day has not ended yet.
+func (u UserPortfolioUseCase) lastEndedDay(day time.Time) time.Time {
+	if yesterday := u.calendar.Date(time.Now()).AddDate(0, 0, -1); day.After(yesterday) {
+		return yesterday
+	}
+	return day
+}
+
+// dayStart returns the start of day, a date at midnight UTC, in the
+// calendar's zone.
+func (u UserPortfolioUseCase) dayStart(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, u.calendar.Location())
+}
+
+// dayEnd returns the end of day, a date at midnight UTC, in the calendar's
+// zone.
+func (u UserPortfolioUseCase) dayEnd(day time.Time) time.Time {
+	return u.dayStart(day.AddDate(0, 0, 1))
+}
+
+func (u UserPortfolioUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
@@ -0,0 +1,196 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// seedPortfolio gives the user a September 2026 of trading: a deposit, two
+// purchases of VNM, a sale of most of them, a withdrawal and FPT shares
+// posted by an administrator.
+func seedPortfolio(t *testing.T, repo *database.InMemoryUserRepository, userID int64) {
+	t.Helper()
+	ctx := context.Background()
+	repo.AddStock(userentity.Stock{Code: "VNM", Name: "VNM", CompanyName: "VNM JSC", Sector: "Consumer Staples"})
+	repo.AddStock(userentity.Stock{Code: "FPT", Name: "FPT", CompanyName: "FPT JSC", Sector: "Technology"})
+	post := func(reference string, entry userentity.LedgerEntry) {
+		entry.UserID = userID
+		_, err := repo.PostLedgerEntries(ctx, reference, []userentity.LedgerEntry{entry})
+		require.NoError(t, err)
+	}
+	fill := func(side userentity.OrderSide, price, quantity, fee, tax int64, at time.Time, settlesOn string) {
+		require.NoError(t, repo.RecordStockPrice("VNM", price, at))
+		order := createOrder(t, repo, userID, "VNM", side, userentity.TimeInForceDAY, price, quantity, at)
+		settles, err := time.Parse(time.DateOnly, settlesOn)
+		require.NoError(t, err)
+		_, err = repo.RecordOrderFill(ctx, ports.RecordOrderFillParams{
+			OrderID:     order.ID,
+			ExecutionID: "exec-" + at.Format(time.RFC3339),
+			Price:       price,
+			Quantity:    quantity,
+			Charges:     userentity.FeeCharges{Fee: fee, Tax: tax},
+			At:          at,
+			SettlesOn:   settles,
+		})
+		require.NoError(t, err)
+	}
+
+	post("deposit:1", userentity.LedgerEntry{Amount: 200_000_000, Type: userentity.LedgerEntryDeposit, CreatedAt: vnTime("2026-09-07", "08:00")})
+	fill(userentity.OrderSideBuy, 50000, 1000, 75_000, 0, vnTime("2026-09-07", "10:00"), "2026-09-09")
+	fill(userentity.OrderSideBuy, 52000, 1000, 78_000, 0, vnTime("2026-09-08", "10:00"), "2026-09-10")
+	fill(userentity.OrderSideSell, 55000, 1500, 82_500, 82_500, vnTime("2026-09-09", "10:00"), "2026-09-11")
+	post("withdrawal:1", userentity.LedgerEntry{Amount: -50_000_000, Type: userentity.LedgerEntryWithdrawal, CreatedAt: vnTime("2026-09-10", "08:00")})
+	require.NoError(t, repo.RecordStockPrice("FPT", 100000, vnTime("2026-09-09", "14:45")))
+	post("transfer:1", userentity.LedgerEntry{Code: "FPT", Amount: 100, Type: userentity.LedgerEntryAdjustment, CreatedAt: vnTime("2026-09-10", "09:00")})
+	require.NoError(t, repo.RecordStockPrice("FPT", 110000, vnTime("2026-09-10", "11:00")))
+	require.NoError(t, repo.RecordStockPrice("VNM", 60000, vnTime("2026-09-11", "11:00")))
+}
+
+func TestUserPortfolioUseCase_Performance(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedPortfolio(t, repo, alice.Id)
+	uc := NewUserPortfolioUseCase(repo, repo, repo, repo, repo, hoseCalendar(t), PortfolioConfig{})
+
+	_, err := uc.Performance(ctx, alice.Id, "alice", "lifo", "")
+	assert.ErrorIs(t, err, ErrInvalidCostMethod)
+	_, err = uc.Performance(ctx, alice.Id, "alice", "", "2w")
+	assert.ErrorIs(t, err, ErrInvalidPerformanceRange)
+	_, err = uc.Performance(ctx, alice.Id+1, "alice", "", "")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	perf, err := uc.Performance(ctx, alice.Id, "alice", "", PerformanceRangeAll)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.CostMethodFIFO, perf.CostMethod, "FIFO is the default")
+	assert.Equal(t, int64(130_182_000), perf.Cash, "the proceeds of the unsettled sale are counted")
+	assert.Equal(t, int64(41_000_000), perf.MarketValue)
+	assert.Equal(t, int64(6_221_000), perf.RealizedPnL)
+	assert.Equal(t, int64(4_961_000), perf.UnrealizedPnL)
+	assert.Equal(t, int64(235_500), perf.Fees)
+	assert.Equal(t, int64(82_500), perf.Taxes)
+	assert.Equal(t, int64(7605), perf.CashWeightBps)
+	assert.Equal(t, []PortfolioPosition{
+		{Code: "FPT", Sector: "Technology", Quantity: 100, Cost: 10_000_000, Price: 110000, MarketValue: 11_000_000, UnrealizedPnL: 1_000_000, WeightBps: 643},
+		{Code: "VNM", Sector: "Consumer Staples", Quantity: 500, Cost: 26_039_000, Price: 60000, MarketValue: 30_000_000, UnrealizedPnL: 3_961_000, RealizedPnL: 6_221_000, WeightBps: 1753},
+	}, perf.Positions)
+	assert.Equal(t, []SectorAllocation{
+		{Sector: "Consumer Staples", MarketValue: 30_000_000, WeightBps: 1753},
+		{Sector: "Technology", MarketValue: 11_000_000, WeightBps: 643},
+	}, perf.Sectors)
+
+	require.Len(t, perf.Returns, len(performanceRanges))
+	assert.Equal(t, PerformanceReturn{Range: PerformanceRangeWeek}, perf.Returns[0], "nothing moved in the last week")
+	assert.Equal(t, PerformanceReturn{Range: PerformanceRangeAll, ReturnBps: 538}, perf.Returns[len(perf.Returns)-1])
+	require.Greater(t, len(perf.History), 5)
+	assert.Equal(t, []PerformancePoint{
+		{Date: time.Date(2026, 9, 7, 0, 0, 0, 0, time.UTC), Value: 199_925_000, NetFlow: 200_000_000, ReturnBps: -4},
+		{Date: time.Date(2026, 9, 8, 0, 0, 0, 0, time.UTC), Value: 201_847_000, ReturnBps: 92},
+		{Date: time.Date(2026, 9, 9, 0, 0, 0, 0, time.UTC), Value: 207_682_000, ReturnBps: 384},
+		{Date: time.Date(2026, 9, 10, 0, 0, 0, 0, time.UTC), Value: 168_682_000, NetFlow: -39_000_000, ReturnBps: 384},
+		{Date: time.Date(2026, 9, 11, 0, 0, 0, 0, time.UTC), Value: 171_182_000, ReturnBps: 538},
+	}, perf.History[:5], "the withdrawal and the FPT shares are flows, not returns")
+	live := perf.History[len(perf.History)-1]
+	assert.Equal(t, hoseCalendar(t).Date(time.Now()), live.Date)
+	assert.Equal(t, perf.Value(), live.Value)
+
+	average, err := uc.Performance(ctx, alice.Id, "alice", userentity.CostMethodAverage, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5_720_250), average.RealizedPnL)
+	assert.Equal(t, int64(25_538_250), average.Positions[1].Cost)
+	assert.Equal(t, PerformanceRangeMonth, average.Range)
+	assert.Equal(t, perf.Returns, average.Returns, "returns do not depend on the cost method")
+
+	// The snapshots of past days are stored once and read back afterwards.
+	snapshots, err := repo.ListPortfolioSnapshots(ctx, alice.Id, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, snapshots, len(perf.History)-1)
+	assert.Equal(t, userentity.PortfolioSnapshot{
+		UserID:      alice.Id,
+		Date:        time.Date(2026, 9, 10, 0, 0, 0, 0, time.UTC),
+		Cash:        130_182_000,
+		MarketValue: 38_500_000,
+		NetFlow:     -39_000_000,
+		CreatedAt:   snapshots[3].CreatedAt,
+	}, snapshots[3])
+	for _, snapshot := range snapshots {
+		assert.True(t, hoseCalendar(t).TradingDay(snapshot.Date.Add(12*time.Hour)), "%s is a trading day", snapshot.Date)
+	}
+	_, err = uc.Performance(ctx, alice.Id, "alice", "", "")
+	require.NoError(t, err)
+	again, err := repo.ListPortfolioSnapshots(ctx, alice.Id, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, snapshots, again)
+}
+
+func TestUserPortfolioUseCase_Snapshot(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedTradingUser(t, repo, "bob")
+	seedPortfolio(t, repo, alice.Id)
+	uc := NewUserPortfolioUseCase(repo, repo, repo, repo, repo, hoseCalendar(t), PortfolioConfig{CostMethod: userentity.CostMethodAverage})
+
+	result, err := uc.Snapshot(ctx, vnTime("2026-09-08", "20:00"))
+	require.NoError(t, err)
+	assert.Equal(t, PortfolioSnapshotRunResult{Date: time.Date(2026, 9, 8, 0, 0, 0, 0, time.UTC), Users: 1, Snapshots: 2}, result, "bob has no ledger")
+
+	// Days that have not ended are left to the next run, which catches up
+	// on the days since the last snapshot.
+	result, err = uc.Snapshot(ctx, time.Now().AddDate(0, 0, 3))
+	require.NoError(t, err)
+	assert.Equal(t, hoseCalendar(t).Date(time.Now()).AddDate(0, 0, -1), result.Date)
+	assert.Equal(t, 1, result.Users)
+	last, err := repo.LastPortfolioSnapshot(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Equal(t, int64(171_182_000), last.Value())
+	assert.Zero(t, last.NetFlow)
+
+	snapshots, err := repo.ListPortfolioSnapshots(ctx, alice.Id, time.Date(2026, 9, 9, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.NotEmpty(t, snapshots)
+	assert.Equal(t, int64(207_682_000), snapshots[0].Value())
+	assert.Zero(t, snapshots[0].NetFlow, "the deposit was counted by the snapshot of 7 September")
+
+	result, err = uc.Snapshot(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, result.Snapshots, "a day is snapshotted once")
+}
+
+func TestCostBook(t *testing.T) {
+	fifo := costBook{method: userentity.CostMethodFIFO, lots: make(map[string][]costLot), realized: make(map[string]int64)}
+	average := costBook{method: userentity.CostMethodAverage, lots: make(map[string][]costLot), realized: make(map[string]int64)}
+	for _, book := range []*costBook{&fifo, &average} {
+		book.add("VNM", 100, 1_000_000)
+		book.add("VNM", 300, 6_000_000)
+		book.split("VNM", 40)
+	}
+
+	quantity, cost := fifo.holding("VNM")
+	assert.Equal(t, int64(440), quantity)
+	assert.Equal(t, int64(7_000_000), cost, "new shares cost nothing")
+	assert.Equal(t, []costLot{{quantity: 110, cost: 1_000_000}, {quantity: 330, cost: 6_000_000}}, fifo.lots["VNM"])
+	assert.Equal(t, int64(1_000_000+6_000_000*20/330), fifo.take("VNM", 130), "the earliest lot goes first")
+	assert.Equal(t, int64(0), fifo.take("FPT", 10), "shares off the book cost nothing")
+
+	assert.Equal(t, []costLot{{quantity: 440, cost: 7_000_000}}, average.lots["VNM"])
+	assert.Equal(t, int64(7_000_000*130/440), average.take("VNM", 130))
+}
+
+func TestUnadjustedPrice(t *testing.T) {
+	exDate := time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC)
+	actions := []userentity.CorporateAction{
+		{Status: userentity.CorporateActionStatusApplied, ExDate: exDate, Adjustment: userentity.PriceAdjustment{Numerator: 1, Denominator: 2}},
+		{Status: userentity.CorporateActionStatusScheduled, ExDate: exDate.AddDate(0, 0, 7)},
+	}
+	assert.Equal(t, int64(60000), unadjustedPrice(30000, actions, exDate.AddDate(0, 0, -1)), "a split halved prices before its ex-date")
+	assert.Equal(t, int64(30000), unadjustedPrice(30000, actions, exDate), "prices from the ex-date on were not adjusted")
+}
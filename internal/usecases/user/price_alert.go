@@ -0,0 +1,290 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	// MaxActivePriceAlerts is how many alerts that have not fired a user may
+	// keep.
+	MaxActivePriceAlerts = 50
+
+	// maxAlertChangeBps bounds the move of a move alert: 100%.
+	maxAlertChangeBps = 10000
+	// priceAlertBatchSize is how many price records the evaluator reads at a
+	// time.
+	priceAlertBatchSize = 500
+)
+
+var (
+	ErrStockNotFound         = ports.ErrStockNotFound
+	ErrPriceAlertNotFound    = apperrors.New(apperrors.ErrNotFound, "PRICE_ALERT_NOT_FOUND", "price alert not found")
+	ErrInvalidAlertCondition = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ALERT_CONDITION", "alert condition must be above, below or move")
+	ErrInvalidAlertTarget    = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ALERT_TARGET", "target price must be positive")
+	ErrInvalidAlertChange    = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_ALERT_CHANGE", "change must be between 1 and 10000 basis points")
+	ErrStockPriceUnavailable = apperrors.New(apperrors.ErrFailedPrecondition, "STOCK_PRICE_UNAVAILABLE", "no price is known for this stock yet")
+	ErrPriceAlertLimit       = apperrors.New(apperrors.ErrResourceExhausted, "PRICE_ALERT_LIMIT", "at most 50 active price alerts are allowed")
+)
+
+// UserPriceAlertUseCase manages price alerts and fires them as prices are
+// recorded.
+type UserPriceAlertUseCase struct {
+	users  ports.UserRepository
+	alerts ports.PriceAlertRepository
+	stocks ports.StockRepository
+}
+
+func NewUserPriceAlertUseCase(users ports.UserRepository, alerts ports.PriceAlertRepository, stocks ports.StockRepository) UserPriceAlertUseCase {
+	return UserPriceAlertUseCase{users: users, alerts: alerts, stocks: stocks}
+}
+
+// CreatePriceAlertInput describes a new alert. Above and below alerts need
+// TargetPrice; move alerts need ChangeBps and fire once the price moved that
+// many basis points from the price at creation.
+type CreatePriceAlertInput struct {
+	Code        string
+	Condition   string
+	TargetPrice int64
+	ChangeBps   int64
+}
+
+// priceAlertPayload is the outbox payload of a fired alert.
+type priceAlertPayload struct {
+	Email       string    `json:"email"`
+	Purpose     string    `json:"purpose"`
+	AlertID     int64     `json:"alert_id"`
+	Code        string    `json:"code"`
+	Condition   string    `json:"condition"`
+	TargetPrice int64     `json:"target_price,omitempty"`
+	ChangeBps   int64     `json:"change_bps,omitempty"`
+	BasePrice   int64     `json:"base_price,omitempty"`
+	Price       int64     `json:"price"`
+	TriggeredAt time.Time `json:"triggered_at"`
+}
+
+// Create adds an active alert on a listed stock.
+func (u UserPriceAlertUseCase) Create(ctx context.Context, uid int64, username string, input CreatePriceAlertInput) (userentity.PriceAlert, error) {
+	code, err := normalizeStockCode(input.Code)
+	if err != nil {
+		return userentity.PriceAlert{}, err
+	}
+	alert := userentity.PriceAlert{
+		Code:      code,
+		Condition: userentity.PriceAlertCondition(strings.ToLower(strings.TrimSpace(input.Condition))),
+		CreatedAt: time.Now().UTC(),
+	}
+	switch alert.Condition {
+	case userentity.PriceAlertAbove, userentity.PriceAlertBelow:
+		if input.TargetPrice <= 0 {
+			return userentity.PriceAlert{}, ErrInvalidAlertTarget
+		}
+		alert.TargetPrice = input.TargetPrice
+	case userentity.PriceAlertMove:
+		if input.ChangeBps <= 0 || input.ChangeBps > maxAlertChangeBps {
+			return userentity.PriceAlert{}, ErrInvalidAlertChange
+		}
+		alert.ChangeBps = input.ChangeBps
+	default:
+		return userentity.PriceAlert{}, ErrInvalidAlertCondition
+	}
+
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.PriceAlert{}, err
+	}
+	alert.UserID = owner.Id
+	existing, err := u.alerts.ListPriceAlerts(ctx, owner.Id)
+	if err != nil {
+		return userentity.PriceAlert{}, fmt.Errorf("list price alerts: %w", err)
+	}
+	active := 0
+	for _, a := range existing {
+		if a.Status == userentity.PriceAlertStatusActive {
+			active++
+		}
+	}
+	if active >= MaxActivePriceAlerts {
+		return userentity.PriceAlert{}, ErrPriceAlertLimit
+	}
+
+	if alert.Condition == userentity.PriceAlertMove {
+		quotes, err := u.stocks.LatestStockQuotes(ctx, []string{code})
+		if err != nil {
+			return userentity.PriceAlert{}, fmt.Errorf("latest stock quotes: %w", err)
+		}
+		if len(quotes) == 0 {
+			stocks, err := u.stocks.GetStocksByCodes(ctx, []string{code})
+			if err != nil {
+				return userentity.PriceAlert{}, fmt.Errorf("get stocks: %w", err)
+			}
+			if len(stocks) == 0 {
+				return userentity.PriceAlert{}, ErrStockNotFound
+			}
+			return userentity.PriceAlert{}, ErrStockPriceUnavailable
+		}
+		alert.BasePrice = quotes[0].Price
+	}
+
+	created, err := u.alerts.CreatePriceAlert(ctx, alert)
+	if err != nil {
+		return userentity.PriceAlert{}, fmt.Errorf("create price alert: %w", err)
+	}
+	return created, nil
+}
+
+// List returns the caller's alerts, newest first.
+func (u UserPriceAlertUseCase) List(ctx context.Context, uid int64, username string) ([]userentity.PriceAlert, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	alerts, err := u.alerts.ListPriceAlerts(ctx, owner.Id)
+	if err != nil {
+		return nil, fmt.Errorf("list price alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+// Delete removes one of the caller's alerts, whether it fired or not.
+func (u UserPriceAlertUseCase) Delete(ctx context.Context, uid int64, username string, alertID int64) error {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return err
+	}
+	alert, err := u.alerts.GetPriceAlert(ctx, alertID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return ErrPriceAlertNotFound
+		}
+		return fmt.Errorf("get price alert: %w", err)
+	}
+	if alert.UserID != owner.Id {
+		return ErrPriceAlertNotFound
+	}
+	if err := u.alerts.DeletePriceAlert(ctx, alertID); err != nil {
+		return fmt.Errorf("delete price alert: %w", err)
+	}
+	return nil
+}
+
+// LastQuoteID returns the ID of the newest price record. Evaluators start
+// after it, so prices recorded before they run are not evaluated.
+func (u UserPriceAlertUseCase) LastQuoteID(ctx context.Context) (int64, error) {
+	id, err := u.stocks.LastStockQuoteID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("last stock quote: %w", err)
+	}
+	return id, nil
+}
+
+// EvaluateQuotesAfter evaluates the alerts against the price records after
+// afterID, in the order they were recorded. It returns the ID of the last
+// record evaluated, to pass as afterID next time, and how many alerts fired.
+func (u UserPriceAlertUseCase) EvaluateQuotesAfter(ctx context.Context, afterID int64) (int64, int, error) {
+	fired := 0
+	for {
+		quotes, err := u.stocks.ListStockQuotesAfter(ctx, afterID, priceAlertBatchSize)
+		if err != nil {
+			return afterID, fired, fmt.Errorf("list stock quotes: %w", err)
+		}
+		for _, quote := range quotes {
+			n, err := u.HandleQuote(ctx, quote)
+			fired += n
+			if err != nil {
+				return afterID, fired, err
+			}
+			afterID = quote.ID
+		}
+		if len(quotes) < priceAlertBatchSize {
+			return afterID, fired, nil
+		}
+	}
+}
+
+// HandleQuote fires the active alerts on the quoted stock whose condition the
+// price meets. Alerts only fire on prices recorded after they were created,
+// and only once even when several evaluators see the same price. Alerts of
+// accounts that are not active wait until the account is active again.
+func (u UserPriceAlertUseCase) HandleQuote(ctx context.Context, quote userentity.StockQuote) (int, error) {
+	active, err := u.alerts.ListActivePriceAlerts(ctx, quote.StockID)
+	if err != nil {
+		return 0, fmt.Errorf("list active price alerts: %w", err)
+	}
+	fired := 0
+	for _, candidate := range active {
+		alert := candidate.Alert
+		if quote.At.Before(alert.CreatedAt) || !alert.Matches(quote.Price) {
+			continue
+		}
+		if candidate.Owner.Status != userentity.AccountStatusActive {
+			continue
+		}
+		event, err := priceAlertEvent(candidate.Owner.Email, alert, quote)
+		if err != nil {
+			return fired, err
+		}
+		triggered, err := u.alerts.TriggerPriceAlert(ctx, ports.TriggerPriceAlertParams{
+			AlertID: alert.ID,
+			Price:   quote.Price,
+			At:      quote.At,
+			Event:   event,
+		})
+		if err != nil {
+			return fired, fmt.Errorf("trigger price alert: %w", err)
+		}
+		if triggered {
+			fired++
+		}
+	}
+	return fired, nil
+}
+
+func (u UserPriceAlertUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
+
+func priceAlertEvent(email string, alert userentity.PriceAlert, quote userentity.StockQuote) (userentity.OutboxEvent, error) {
+	payload, err := json.Marshal(priceAlertPayload{
+		Email:       email,
+		Purpose:     priceAlertPurpose,
+		AlertID:     alert.ID,
+		Code:        alert.Code,
+		Condition:   string(alert.Condition),
+		TargetPrice: alert.TargetPrice,
+		ChangeBps:   alert.ChangeBps,
+		BasePrice:   alert.BasePrice,
+		Price:       quote.Price,
+		TriggeredAt: quote.At,
+	})
+	if err != nil {
+		return userentity.OutboxEvent{}, fmt.Errorf("marshal price alert payload: %w", err)
+	}
+	now := time.Now().UTC()
+	return userentity.OutboxEvent{
+		AggregateType: "user",
+		EventType:     "user.alert.triggered",
+		Payload:       payload,
+		Status:        userentity.OutboxEventStatusPending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
@@ -0,0 +1,165 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func priceAlertNotices(t *testing.T, repo *database.InMemoryUserRepository, userID int64) []priceAlertPayload {
+	t.Helper()
+	data, err := repo.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	var notices []priceAlertPayload
+	for _, event := range data.OutboxEvents {
+		if event.EventType != "user.alert.triggered" {
+			continue
+		}
+		var payload priceAlertPayload
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		notices = append(notices, payload)
+	}
+	return notices
+}
+
+func TestUserPriceAlertUseCase_Manage(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	bob, err := seedUserWithToken(t, repo, "bob", "bob@example.com", "secret", "token-bob", true)
+	require.NoError(t, err)
+	seedStocks(repo, "VNM", "FPT")
+	require.NoError(t, repo.RecordStockPrice("VNM", 70000, time.Now().UTC()))
+	uc := NewUserPriceAlertUseCase(repo, repo, repo)
+
+	above, err := uc.Create(ctx, alice.Id, "alice", CreatePriceAlertInput{Code: "vnm", Condition: "Above", TargetPrice: 75000})
+	require.NoError(t, err)
+	assert.Equal(t, "VNM", above.Code)
+	assert.Equal(t, userentity.PriceAlertAbove, above.Condition)
+	assert.Equal(t, userentity.PriceAlertStatusActive, above.Status)
+
+	move, err := uc.Create(ctx, alice.Id, "alice", CreatePriceAlertInput{Code: "VNM", Condition: "move", ChangeBps: 500})
+	require.NoError(t, err)
+	assert.Equal(t, int64(70000), move.BasePrice, "moves are measured from the latest price")
+
+	tests := []struct {
+		name  string
+		input CreatePriceAlertInput
+		want  error
+	}{
+		{"unknown condition", CreatePriceAlertInput{Code: "VNM", Condition: "crosses", TargetPrice: 1}, ErrInvalidAlertCondition},
+		{"missing target", CreatePriceAlertInput{Code: "VNM", Condition: "below"}, ErrInvalidAlertTarget},
+		{"change too large", CreatePriceAlertInput{Code: "VNM", Condition: "move", ChangeBps: 10001}, ErrInvalidAlertChange},
+		{"bad code", CreatePriceAlertInput{Code: "VN-30", Condition: "above", TargetPrice: 1}, ErrInvalidStockCode},
+		{"unlisted code", CreatePriceAlertInput{Code: "SSI", Condition: "above", TargetPrice: 1}, ErrNotFound},
+		{"unlisted code for a move", CreatePriceAlertInput{Code: "SSI", Condition: "move", ChangeBps: 100}, ErrNotFound},
+		{"no price to move from", CreatePriceAlertInput{Code: "FPT", Condition: "move", ChangeBps: 100}, ErrStockPriceUnavailable},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := uc.Create(ctx, alice.Id, "alice", tc.input)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+
+	alerts, err := uc.List(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, move.ID, alerts[0].ID, "newest first")
+	_, err = uc.List(ctx, bob.Id, "alice")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	assert.ErrorIs(t, uc.Delete(ctx, bob.Id, "bob", above.ID), ErrPriceAlertNotFound, "other users' alerts are hidden")
+	require.NoError(t, uc.Delete(ctx, alice.Id, "alice", above.ID))
+	assert.ErrorIs(t, uc.Delete(ctx, alice.Id, "alice", above.ID), ErrPriceAlertNotFound)
+}
+
+func TestUserPriceAlertUseCase_Limit(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	seedStocks(repo, "VNM")
+	uc := NewUserPriceAlertUseCase(repo, repo, repo)
+
+	var last userentity.PriceAlert
+	for i := 0; i < MaxActivePriceAlerts; i++ {
+		var err error
+		last, err = uc.Create(ctx, alice.Id, "alice", CreatePriceAlertInput{Code: "VNM", Condition: "above", TargetPrice: int64(80000 + i)})
+		require.NoError(t, err)
+	}
+	_, err := uc.Create(ctx, alice.Id, "alice", CreatePriceAlertInput{Code: "VNM", Condition: "above", TargetPrice: 90000})
+	assert.ErrorIs(t, err, ErrPriceAlertLimit)
+
+	// Fired alerts no longer count.
+	require.NoError(t, repo.RecordStockPrice("VNM", last.TargetPrice, time.Now().UTC().Add(time.Second)))
+	_, fired, err := uc.EvaluateQuotesAfter(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, MaxActivePriceAlerts, fired)
+	_, err = uc.Create(ctx, alice.Id, "alice", CreatePriceAlertInput{Code: "VNM", Condition: "above", TargetPrice: 90000})
+	assert.NoError(t, err)
+}
+
+func TestUserPriceAlertUseCase_Evaluate(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	seedStocks(repo, "VNM", "FPT")
+	uc := NewUserPriceAlertUseCase(repo, repo, repo)
+
+	start := time.Now().UTC()
+	require.NoError(t, repo.RecordStockPrice("VNM", 80000, start.Add(-time.Minute)))
+	require.NoError(t, repo.RecordStockPrice("FPT", 100000, start.Add(-time.Minute)))
+	above, err := uc.Create(ctx, alice.Id, "alice", CreatePriceAlertInput{Code: "VNM", Condition: "above", TargetPrice: 75000})
+	require.NoError(t, err)
+	below, err := uc.Create(ctx, alice.Id, "alice", CreatePriceAlertInput{Code: "VNM", Condition: "below", TargetPrice: 70000})
+	require.NoError(t, err)
+	move, err := uc.Create(ctx, alice.Id, "alice", CreatePriceAlertInput{Code: "FPT", Condition: "move", ChangeBps: 1000})
+	require.NoError(t, err)
+
+	cursor, fired, err := uc.EvaluateQuotesAfter(ctx, 0)
+	require.NoError(t, err)
+	assert.Zero(t, fired, "prices recorded before an alert was created do not fire it")
+	assert.Equal(t, int64(2), cursor)
+
+	at := time.Now().UTC().Add(time.Second)
+	require.NoError(t, repo.RecordStockPrice("VNM", 76000, at))
+	require.NoError(t, repo.RecordStockPrice("FPT", 91000, at))
+	require.NoError(t, repo.RecordStockPrice("FPT", 90000, at))
+	require.NoError(t, repo.RecordStockPrice("VNM", 77000, at))
+	cursor, fired, err = uc.EvaluateQuotesAfter(ctx, cursor)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fired)
+	assert.Equal(t, int64(6), cursor)
+
+	// Replaying the same prices, as a second evaluator would, fires nothing.
+	_, fired, err = uc.EvaluateQuotesAfter(ctx, 0)
+	require.NoError(t, err)
+	assert.Zero(t, fired)
+
+	notices := priceAlertNotices(t, repo, alice.Id)
+	require.Len(t, notices, 2)
+	assert.Equal(t, above.ID, notices[0].AlertID)
+	assert.Equal(t, "alice@example.com", notices[0].Email)
+	assert.Equal(t, priceAlertPurpose, notices[0].Purpose)
+	assert.Equal(t, int64(76000), notices[0].Price, "the first matching price fires the alert")
+	assert.Equal(t, move.ID, notices[1].AlertID)
+	assert.Equal(t, int64(90000), notices[1].Price, "a 10% move from 100000")
+	assert.Equal(t, int64(100000), notices[1].BasePrice)
+
+	alerts, err := uc.List(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	statuses := make(map[int64]userentity.PriceAlertStatus)
+	for _, alert := range alerts {
+		statuses[alert.ID] = alert.Status
+	}
+	assert.Equal(t, userentity.PriceAlertStatusTriggered, statuses[above.ID])
+	assert.Equal(t, userentity.PriceAlertStatusActive, statuses[below.ID])
+	assert.Equal(t, userentity.PriceAlertStatusTriggered, statuses[move.ID])
+}
@@ -2,13 +2,22 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/security"
 )
 
+// ErrInvalidRefreshToken is returned when a refresh token is malformed, expired or revoked.
+var ErrInvalidRefreshToken = apperrors.New(apperrors.ErrUnauthenticated, "INVALID_REFRESH_TOKEN", "invalid refresh token")
+
 type UserTokenRefreshUseCase struct {
+	repository    ports.UserRepository
 	accessTokens  security.AccessTokenManager
 	refreshTokens security.RefreshTokenManager
 }
@@ -20,6 +29,14 @@ func NewUserTokenRefreshUseCase(access security.AccessTokenManager, refresh secu
 	}
 }
 
+// NewUserTokenRefreshUseCaseWithRepository also checks that the token owner is
+// still an active account before issuing new tokens.
+func NewUserTokenRefreshUseCaseWithRepository(repo ports.UserRepository, access security.AccessTokenManager, refresh security.RefreshTokenManager) UserTokenRefreshUseCase {
+	uc := NewUserTokenRefreshUseCase(access, refresh)
+	uc.repository = repo
+	return uc
+}
+
 type RefreshResult struct {
 	AccessToken         string
 	AccessTokenExpires  time.Time
@@ -30,13 +47,26 @@ type RefreshResult struct {
 }
 
 func (u UserTokenRefreshUseCase) Refresh(ctx context.Context, refreshToken string) (RefreshResult, error) {
-	_ = ctx
 	claims, err := u.refreshTokens.ValidateRefreshToken(refreshToken)
 	if err != nil {
-		return RefreshResult{}, fmt.Errorf("validate refresh token: %w", err)
+		return RefreshResult{}, fmt.Errorf("validate refresh token: %w: %v", ErrInvalidRefreshToken, err)
 	}
 	if err := u.refreshTokens.RevokeRefreshToken(refreshToken); err != nil {
-		return RefreshResult{}, fmt.Errorf("revoke refresh token: %w", err)
+		return RefreshResult{}, fmt.Errorf("revoke refresh token: %w: %v", ErrInvalidRefreshToken, err)
+	}
+	if u.repository != nil {
+		// GetUser only finds active accounts; sessions of deactivated or
+		// deleted accounts end at their next refresh.
+		owner, err := u.repository.GetUser(ctx, claims.Username)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return RefreshResult{}, ErrInvalidRefreshToken
+			}
+			return RefreshResult{}, fmt.Errorf("get user: %w", err)
+		}
+		if owner.Id != claims.UserID {
+			return RefreshResult{}, ErrInvalidRefreshToken
+		}
 	}
 	accessToken, accessExpires, err := u.accessTokens.GenerateAccessToken(claims.UserID, claims.Username)
 	if err != nil {
@@ -58,16 +88,45 @@ func (u UserTokenRefreshUseCase) Refresh(ctx context.Context, refreshToken strin
 
 type UserLogoutUseCase struct {
 	refreshTokens security.RefreshTokenManager
+	history       ports.LoginHistoryRepository
 }
 
 func NewUserLogoutUseCase(refresh security.RefreshTokenManager) UserLogoutUseCase {
 	return UserLogoutUseCase{refreshTokens: refresh}
 }
 
+// NewUserLogoutUseCaseWithHistory also records logouts in the login history.
+func NewUserLogoutUseCaseWithHistory(refresh security.RefreshTokenManager, history ports.LoginHistoryRepository) UserLogoutUseCase {
+	return UserLogoutUseCase{refreshTokens: refresh, history: history}
+}
+
 func (u UserLogoutUseCase) Logout(ctx context.Context, refreshToken string) error {
-	_ = ctx
 	if err := u.refreshTokens.RevokeRefreshToken(refreshToken); err != nil {
-		return fmt.Errorf("revoke refresh token: %w", err)
+		return fmt.Errorf("revoke refresh token: %w: %v", ErrInvalidRefreshToken, err)
 	}
+	// Logout requires an access token, so the caller is the account owner.
+	audit.Record(ctx, userentity.AuditEvent{Action: userentity.AuditActionLogout})
 	return nil
 }
+
+// LogoutFromClient is Logout for user uid from client, which is recorded in
+// the login history. The logout is recorded before the token is revoked so a
+// failed write can be retried.
+func (u UserLogoutUseCase) LogoutFromClient(ctx context.Context, uid int64, refreshToken string, client userentity.ClientInfo) error {
+	if u.history != nil {
+		if _, err := u.refreshTokens.ValidateRefreshToken(refreshToken); err != nil {
+			return fmt.Errorf("validate refresh token: %w: %v", ErrInvalidRefreshToken, err)
+		}
+		err := u.history.RecordLogout(ctx, userentity.LoginRecord{
+			UserID:            uid,
+			IP:                client.IP,
+			UserAgent:         client.UserAgent,
+			DeviceFingerprint: deviceFingerprint(client),
+			CreatedAt:         time.Now().UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("record logout: %w", err)
+		}
+	}
+	return u.Logout(ctx, refreshToken)
+}
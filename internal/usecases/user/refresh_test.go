@@ -49,3 +49,27 @@ func TestUserLogoutUseCase_Logout(t *testing.T) {
 	_, err = refreshManager.ValidateRefreshToken(token)
 	require.Error(t, err)
 }
+
+func TestUserTokenRefreshUseCase_RefreshRequiresActiveAccount(t *testing.T) {
+	accessManager, err := security.NewJWTManager("unit-test-secret-must-be-long-123456", "issuer", "aud", time.Minute)
+	require.NoError(t, err)
+	refreshManager, err := security.NewJWTRefreshManager("unit-test-refresh-secret-change-me-1234567890", "issuer", "aud", time.Hour)
+	require.NoError(t, err)
+
+	repo := newTestRepo()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc := NewUserTokenRefreshUseCaseWithRepository(repo, accessManager, refreshManager)
+
+	refreshToken, _, err := refreshManager.GenerateRefreshToken(alice.Id, "alice")
+	require.NoError(t, err)
+	result, err := uc.Refresh(context.Background(), refreshToken)
+	require.NoError(t, err)
+
+	_, err = NewUserAccountStatusUseCase(repo).Deactivate(context.Background(), alice.Id, "alice")
+	require.NoError(t, err)
+
+	_, err = uc.Refresh(context.Background(), result.RefreshToken)
+	require.ErrorIs(t, err, ErrInvalidRefreshToken)
+	_, err = refreshManager.ValidateRefreshToken(result.RefreshToken)
+	require.Error(t, err, "the rejected token is revoked")
+}
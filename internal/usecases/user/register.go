@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
 	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 	"golang.org/x/crypto/bcrypt"
@@ -38,6 +40,12 @@ func NewUserRegisterUseCaseWithTTL(repo ports.UserRepository, tokenTTL time.Dura
     }
 }
 
+var (
+	ErrRegisterEmptyUsername = apperrors.New(apperrors.ErrInvalidArgument, "USERNAME_REQUIRED", "user name is empty")
+	ErrRegisterEmptyPassword = apperrors.New(apperrors.ErrInvalidArgument, "PASSWORD_REQUIRED", "password is empty")
+	ErrRegisterEmptyEmail    = apperrors.New(apperrors.ErrInvalidArgument, "EMAIL_REQUIRED", "email is empty")
+)
+
 type RequestRegister struct {
 	Username         string `json:"username"`
 	Password         string `json:"password"`
@@ -52,13 +60,13 @@ type RequestRegister struct {
 
 func (u UserRegisterUseCase) RegisterAccount(ctx context.Context, req RequestRegister) error {
 	if req.Username == "" {
-		return fmt.Errorf("user name is empty")
+		return ErrRegisterEmptyUsername
 	}
 	if req.Password == "" {
-		return fmt.Errorf("password is empty")
+		return ErrRegisterEmptyPassword
 	}
 	if req.Email == "" {
-		return fmt.Errorf("email is empty")
+		return ErrRegisterEmptyEmail
 	}
 
 	if err := u.repository.CheckUserNameAndEmailIsExist(ctx, req.Username, req.Email); err != nil {
@@ -88,7 +96,7 @@ func (u UserRegisterUseCase) RegisterAccount(ctx context.Context, req RequestReg
 		return fmt.Errorf("marshal verification payload: %w", err)
 	}
 
-	_, err = u.repository.CreateUserWithVerification(ctx, ports.CreateUserWithVerificationParams{
+	created, err := u.repository.CreateUserWithVerification(ctx, ports.CreateUserWithVerificationParams{
 		User: userentity.User{
 			Username:         req.Username,
 			Name:             req.Name,
@@ -121,5 +129,6 @@ func (u UserRegisterUseCase) RegisterAccount(ctx context.Context, req RequestReg
 	if err != nil {
 		return fmt.Errorf("insert database got error: %w", err)
 	}
+	audit.Record(ctx, userentity.AuditEvent{UserID: created.Id, Action: userentity.AuditActionRegister})
 	return nil
 }
@@ -9,14 +9,15 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
 	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 )
 
 var (
-	ErrResendEmptyEmail      = errors.New("email is empty")
-	ErrResendAlreadyVerified = errors.New("user already verified")
-	ErrResendTooFrequent     = errors.New("too many resend requests, please try again later")
+	ErrResendEmptyEmail      = apperrors.New(apperrors.ErrInvalidArgument, "EMAIL_REQUIRED", "email is empty")
+	ErrResendAlreadyVerified = apperrors.New(apperrors.ErrFailedPrecondition, "USER_ALREADY_VERIFIED", "user already verified")
+	ErrResendTooFrequent     = apperrors.New(apperrors.ErrResourceExhausted, "RESEND_TOO_FREQUENT", "too many resend requests, please try again later")
 )
 
 type UserVerificationResendUseCase struct {
@@ -71,7 +72,7 @@ func (u UserVerificationResendUseCase) Resend(ctx context.Context, req RequestRe
 	now := time.Now().UTC()
 	latest, err := u.repository.GetLatestVerificationToken(ctx, user.Id)
 	if err != nil {
-		if !strings.Contains(strings.ToLower(err.Error()), "not found") {
+		if !errors.Is(err, ErrNotFound) {
 			return fmt.Errorf("get latest verification token: %w", err)
 		}
 	} else if u.cooldown > 0 && latest.Purpose == userentity.VerificationPurposeResend && latest.CreatedAt.Add(u.cooldown).After(now) {
@@ -0,0 +1,425 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// Names of the built-in risk rules, in the order NewRiskRules runs them by
+// default: the cheap checks of the order itself first, the ones reading the
+// account last.
+const (
+	RiskRuleLotSize       = "lot_size"
+	RiskRuleTickSize      = "tick_size"
+	RiskRulePriceBand     = "price_band"
+	RiskRuleMaxOrderValue = "max_order_value"
+	RiskRuleDailyLimits   = "daily_limits"
+	RiskRuleBuyingPower   = "buying_power"
+	RiskRuleHoldings      = "holdings"
+)
+
+// Rejections of the risk rules. Their metadata carries the numbers behind
+// the rejection, such as the limit and the value, as decimal strings.
+var (
+	ErrInvalidLotSize          = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_LOT_SIZE", "quantity must be a multiple of the lot size")
+	ErrInvalidTickSize         = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_TICK_SIZE", "price must be a multiple of the tick size")
+	ErrPriceOutsideBand        = apperrors.New(apperrors.ErrInvalidArgument, "PRICE_OUTSIDE_BAND", "price is outside the floor and ceiling of the day")
+	ErrOrderValueLimitExceeded = apperrors.New(apperrors.ErrFailedPrecondition, "ORDER_VALUE_LIMIT_EXCEEDED", "order value exceeds the limit per order")
+	ErrDailyOrderLimitExceeded = apperrors.New(apperrors.ErrFailedPrecondition, "DAILY_ORDER_LIMIT_EXCEEDED", "the number of orders of the day reached the limit")
+	ErrDailyValueLimitExceeded = apperrors.New(apperrors.ErrFailedPrecondition, "DAILY_VALUE_LIMIT_EXCEEDED", "order value exceeds what is left of the daily limit")
+	ErrInsufficientBuyingPower = apperrors.New(apperrors.ErrFailedPrecondition, "INSUFFICIENT_BUYING_POWER", "cash not reserved by open buy orders or withdrawals does not cover the order")
+	ErrInsufficientHoldings    = apperrors.New(apperrors.ErrFailedPrecondition, "INSUFFICIENT_HOLDINGS", "shares not reserved by open sell orders do not cover the order")
+)
+
+// TickSize is the price step of the prices from From up to the From of the
+// next tick size.
+type TickSize struct {
+	From int64
+	Tick int64
+}
+
+// RiskLimits are the limits the risk rules check an order against. A zero
+// limit is not checked.
+type RiskLimits struct {
+	// PriceBandBps is how far, in basis points of the reference price, the
+	// price may be above (ceiling) or below (floor) it.
+	PriceBandBps int64
+	LotSize      int64
+	// TickSizes by ascending From; the first starts at zero.
+	TickSizes []TickSize
+	// MaxOrderValue is the largest price times quantity of one order.
+	MaxOrderValue int64
+	// MaxDailyOrders and MaxDailyValue limit the orders a user places in a
+	// trading day, cancelled and rejected ones included.
+	MaxDailyOrders int64
+	MaxDailyValue  int64
+}
+
+// overriddenBy returns l with the non-zero limits of override.
+func (l RiskLimits) overriddenBy(override RiskLimits) RiskLimits {
+	if override.PriceBandBps != 0 {
+		l.PriceBandBps = override.PriceBandBps
+	}
+	if override.LotSize != 0 {
+		l.LotSize = override.LotSize
+	}
+	if len(override.TickSizes) != 0 {
+		l.TickSizes = override.TickSizes
+	}
+	if override.MaxOrderValue != 0 {
+		l.MaxOrderValue = override.MaxOrderValue
+	}
+	if override.MaxDailyOrders != 0 {
+		l.MaxDailyOrders = override.MaxDailyOrders
+	}
+	if override.MaxDailyValue != 0 {
+		l.MaxDailyValue = override.MaxDailyValue
+	}
+	return l
+}
+
+// invalidRiskLimits says what RiskLimits.valid checks.
+const invalidRiskLimits = "limits may not be negative and tick sizes must be positive, the first from zero, in ascending order"
+
+func (l RiskLimits) valid() bool {
+	if l.PriceBandBps < 0 || l.LotSize < 0 || l.MaxOrderValue < 0 || l.MaxDailyOrders < 0 || l.MaxDailyValue < 0 {
+		return false
+	}
+	for i, size := range l.TickSizes {
+		if size.Tick <= 0 || (i == 0 && size.From != 0) || (i > 0 && size.From <= l.TickSizes[i-1].From) {
+			return false
+		}
+	}
+	return true
+}
+
+// tick returns the tick size of price, or zero when there is none.
+func (l RiskLimits) tick(price int64) int64 {
+	tick := int64(0)
+	for _, size := range l.TickSizes {
+		if size.From > price {
+			break
+		}
+		tick = size.Tick
+	}
+	return tick
+}
+
+// RiskConfig holds the limits of every stock and user tier.
+type RiskConfig struct {
+	Default RiskLimits
+	// Stocks override the market rules of single stocks, by code.
+	Stocks map[string]RiskLimits
+	// Tiers override the limits of the users in a tier. Users without a tier
+	// are in userentity.DefaultTradingTier.
+	Tiers map[string]RiskLimits
+}
+
+// Limits returns the limits of an order on the stock with code of a user in
+// tier: the defaults, overridden by the non-zero limits of the stock and then
+// by those of the tier.
+func (c RiskConfig) Limits(code, tier string) RiskLimits {
+	return c.Default.overriddenBy(c.Stocks[code]).overriddenBy(c.Tiers[tier])
+}
+
+// HasTier reports whether tier is the default tier or has limits.
+func (c RiskConfig) HasTier(tier string) bool {
+	_, ok := c.Tiers[tier]
+	return ok || tier == userentity.DefaultTradingTier
+}
+
+// RiskCheck is an order about to be placed or amended with what the rules
+// know about its owner.
+type RiskCheck struct {
+	// Order carries the new price and quantity. Its ID is zero when it is
+	// being placed.
+	Order   userentity.Order
+	Account userentity.TradingAccount
+	// OpenOrders are the owner's other open orders.
+	OpenOrders []userentity.Order
+	Limits     RiskLimits
+	// At is when the order is placed or amended.
+	At time.Time
+	// DayStart is the start of the trading day, midnight in the zone of the
+	// trading calendar.
+	DayStart time.Time
+}
+
+// RiskRule is one pre-trade check. Rules are independent; RiskChain runs
+// them in turn.
+type RiskRule interface {
+	// Name identifies the rule in the configuration.
+	Name() string
+	// Check returns nil to let the order through or the rejection, an
+	// *apperrors.Error with metadata.
+	Check(ctx context.Context, check RiskCheck) error
+}
+
+// RiskChain runs risk rules on orders before they reach the book. The first
+// rule rejecting an order stops the chain.
+//
+// The order repository runs the chain as the ports.OrderCheck of the order
+// it stores, with the account and open orders read under a lock on the
+// owner. Orders and withdrawals of a user are therefore checked one at a
+// time and cannot together spend the same cash or shares.
+type RiskChain struct {
+	config RiskConfig
+	rules  []RiskRule
+}
+
+// NewRiskChain checks config and builds a chain of rules.
+func NewRiskChain(config RiskConfig, rules ...RiskRule) (RiskChain, error) {
+	if !config.Default.valid() {
+		return RiskChain{}, fmt.Errorf("invalid default risk limits: %s", invalidRiskLimits)
+	}
+	for code, limits := range config.Stocks {
+		if !limits.valid() {
+			return RiskChain{}, fmt.Errorf("invalid risk limits of stock %s: %s", code, invalidRiskLimits)
+		}
+	}
+	for tier, limits := range config.Tiers {
+		if !limits.valid() {
+			return RiskChain{}, fmt.Errorf("invalid risk limits of tier %s: %s", tier, invalidRiskLimits)
+		}
+	}
+	return RiskChain{config: config, rules: rules}, nil
+}
+
+// NewRiskRules builds the built-in rules with the given names in that order;
+// no names builds every rule.
+func NewRiskRules(names []string, orders ports.OrderRepository, stocks ports.StockRepository, fees ports.FeeScheduleRepository) ([]RiskRule, error) {
+	if len(names) == 0 {
+		names = []string{RiskRuleLotSize, RiskRuleTickSize, RiskRulePriceBand, RiskRuleMaxOrderValue, RiskRuleDailyLimits, RiskRuleBuyingPower, RiskRuleHoldings}
+	}
+	rules := make([]RiskRule, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case RiskRuleLotSize:
+			rules = append(rules, lotSizeRule{})
+		case RiskRuleTickSize:
+			rules = append(rules, tickSizeRule{})
+		case RiskRulePriceBand:
+			rules = append(rules, priceBandRule{stocks: stocks})
+		case RiskRuleMaxOrderValue:
+			rules = append(rules, orderValueRule{})
+		case RiskRuleDailyLimits:
+			rules = append(rules, dailyLimitsRule{orders: orders})
+		case RiskRuleBuyingPower:
+			rules = append(rules, buyingPowerRule{fees: fees})
+		case RiskRuleHoldings:
+			rules = append(rules, holdingsRule{})
+		default:
+			return nil, fmt.Errorf("unknown risk rule %q", name)
+		}
+	}
+	return rules, nil
+}
+
+// Config returns the limits the chain checks.
+func (c RiskChain) Config() RiskConfig {
+	return c.config
+}
+
+// OrderCheck returns the check that runs the rules on an order placed or
+// amended at at, during the trading day starting at dayStart. It is nil
+// when the chain has no rules.
+func (c RiskChain) OrderCheck(at, dayStart time.Time) ports.OrderCheck {
+	if len(c.rules) == 0 {
+		return nil
+	}
+	return func(ctx context.Context, order userentity.Order, account userentity.TradingAccount, open []userentity.Order) error {
+		check := RiskCheck{
+			Order:      order,
+			Account:    account,
+			OpenOrders: make([]userentity.Order, 0, len(open)),
+			Limits:     c.config.Limits(order.Code, account.Tier),
+			At:         at,
+			DayStart:   dayStart,
+		}
+		for _, other := range open {
+			if other.ID != order.ID {
+				check.OpenOrders = append(check.OpenOrders, other)
+			}
+		}
+		for _, rule := range c.rules {
+			if err := rule.Check(ctx, check); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// reservations returns the cash open buy orders reserve and the shares open
+// sell orders reserve, by code. Buy orders reserve the commission fees can
+// charge them at most on top of their value.
+func reservations(open []userentity.Order, fees userentity.FeeSchedule) (int64, map[string]int64) {
+	var cash int64
+	shares := make(map[string]int64)
+	for _, order := range open {
+		if order.Side == userentity.OrderSideBuy {
+			cash += buyReservation(order, fees)
+		} else {
+			shares[order.Code] += order.Remaining()
+		}
+	}
+	return cash, shares
+}
+
+// buyReservation is the cash what is left of a buy order can take: its
+// value and the largest commission fees can charge on it. Fills debit the
+// commission from the cash as soon as they are recorded.
+func buyReservation(order userentity.Order, fees userentity.FeeSchedule) int64 {
+	value := order.Remaining() * order.Price
+	return value + fees.MaxBuyFee(value)
+}
+
+// riskMetadata formats the numbers of a rejection.
+func riskMetadata(values map[string]int64) map[string]string {
+	metadata := make(map[string]string, len(values))
+	for key, value := range values {
+		metadata[key] = strconv.FormatInt(value, 10)
+	}
+	return metadata
+}
+
+type lotSizeRule struct{}
+
+func (lotSizeRule) Name() string { return RiskRuleLotSize }
+
+func (lotSizeRule) Check(_ context.Context, check RiskCheck) error {
+	if lot := check.Limits.LotSize; lot > 0 && check.Order.Quantity%lot != 0 {
+		return ErrInvalidLotSize.WithMetadata(riskMetadata(map[string]int64{"lot_size": lot}))
+	}
+	return nil
+}
+
+type tickSizeRule struct{}
+
+func (tickSizeRule) Name() string { return RiskRuleTickSize }
+
+func (tickSizeRule) Check(_ context.Context, check RiskCheck) error {
+	if tick := check.Limits.tick(check.Order.Price); tick > 0 && check.Order.Price%tick != 0 {
+		return ErrInvalidTickSize.WithMetadata(riskMetadata(map[string]int64{"tick_size": tick}))
+	}
+	return nil
+}
+
+// priceBandRule keeps prices between the floor and the ceiling around the
+// reference price, the last price recorded before the trading day. Stocks
+// without one, such as new listings, are not checked.
+type priceBandRule struct {
+	stocks ports.StockRepository
+}
+
+func (priceBandRule) Name() string { return RiskRulePriceBand }
+
+func (r priceBandRule) Check(ctx context.Context, check RiskCheck) error {
+	bps := check.Limits.PriceBandBps
+	if bps <= 0 {
+		return nil
+	}
+	quotes, err := r.stocks.LatestStockQuotesBefore(ctx, []string{check.Order.Code}, check.DayStart)
+	if err != nil {
+		return fmt.Errorf("reference price: %w", err)
+	}
+	if len(quotes) == 0 {
+		return nil
+	}
+	reference := quotes[0].Price
+	band := reference * bps / 10000
+	floor, ceiling := reference-band, reference+band
+	if price := check.Order.Price; price < floor || price > ceiling {
+		return ErrPriceOutsideBand.WithMetadata(riskMetadata(map[string]int64{"reference_price": reference, "floor": floor, "ceiling": ceiling}))
+	}
+	return nil
+}
+
+type orderValueRule struct{}
+
+func (orderValueRule) Name() string { return RiskRuleMaxOrderValue }
+
+func (orderValueRule) Check(_ context.Context, check RiskCheck) error {
+	value := check.Order.Price * check.Order.Quantity
+	if limit := check.Limits.MaxOrderValue; limit > 0 && value > limit {
+		return ErrOrderValueLimitExceeded.WithMetadata(riskMetadata(map[string]int64{"limit": limit, "value": value}))
+	}
+	return nil
+}
+
+// dailyLimitsRule counts the orders placed since the start of the trading
+// day. Amendments are not counted again.
+type dailyLimitsRule struct {
+	orders ports.OrderRepository
+}
+
+func (dailyLimitsRule) Name() string { return RiskRuleDailyLimits }
+
+func (r dailyLimitsRule) Check(ctx context.Context, check RiskCheck) error {
+	limits := check.Limits
+	if check.Order.ID != 0 || (limits.MaxDailyOrders <= 0 && limits.MaxDailyValue <= 0) {
+		return nil
+	}
+	totals, err := r.orders.OrderTotalsSince(ctx, check.Order.UserID, check.DayStart)
+	if err != nil {
+		return fmt.Errorf("order totals: %w", err)
+	}
+	if limits.MaxDailyOrders > 0 && int64(totals.Count) >= limits.MaxDailyOrders {
+		return ErrDailyOrderLimitExceeded.WithMetadata(riskMetadata(map[string]int64{"limit": limits.MaxDailyOrders}))
+	}
+	value := check.Order.Price * check.Order.Quantity
+	if limits.MaxDailyValue > 0 && totals.Value+value > limits.MaxDailyValue {
+		return ErrDailyValueLimitExceeded.WithMetadata(riskMetadata(map[string]int64{"limit": limits.MaxDailyValue, "used": totals.Value, "value": value}))
+	}
+	return nil
+}
+
+// buyingPowerRule makes sure the cash not reserved by the other open buy
+// orders or held back by open withdrawals pays for what is left of a buy
+// order and the largest commission the owner's fee schedule can charge on
+// it.
+type buyingPowerRule struct {
+	fees ports.FeeScheduleRepository
+}
+
+func (buyingPowerRule) Name() string { return RiskRuleBuyingPower }
+
+func (r buyingPowerRule) Check(ctx context.Context, check RiskCheck) error {
+	if check.Order.Side != userentity.OrderSideBuy {
+		return nil
+	}
+	fees, err := feeScheduleAt(ctx, r.fees, check.Account.Tier, check.At)
+	if err != nil {
+		return err
+	}
+	reserved, _ := reservations(check.OpenOrders, fees)
+	available := check.Account.Cash - check.Account.Withheld - reserved
+	if required := buyReservation(check.Order, fees); required > available {
+		return ErrInsufficientBuyingPower.WithMetadata(riskMetadata(map[string]int64{"required": required, "available": available}))
+	}
+	return nil
+}
+
+// holdingsRule makes sure the shares not reserved by the other open sell
+// orders cover what is left of a sell order; short selling is not allowed.
+type holdingsRule struct{}
+
+func (holdingsRule) Name() string { return RiskRuleHoldings }
+
+func (holdingsRule) Check(_ context.Context, check RiskCheck) error {
+	if check.Order.Side != userentity.OrderSideSell {
+		return nil
+	}
+	_, reserved := reservations(check.OpenOrders, userentity.FeeSchedule{})
+	available := check.Account.Shares(check.Order.Code) - reserved[check.Order.Code]
+	if required := check.Order.Remaining(); required > available {
+		return ErrInsufficientHoldings.WithMetadata(riskMetadata(map[string]int64{"required": required, "available": available}))
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// hoseRiskConfig has the market rules of HOSE and a vip tier with higher
+// limits.
+var hoseRiskConfig = RiskConfig{
+	Default: RiskLimits{
+		PriceBandBps:   700,
+		LotSize:        100,
+		TickSizes:      []TickSize{{From: 0, Tick: 10}, {From: 10000, Tick: 50}, {From: 50000, Tick: 100}},
+		MaxOrderValue:  100_000_000,
+		MaxDailyOrders: 3,
+		MaxDailyValue:  150_000_000,
+	},
+	Stocks: map[string]RiskLimits{"FPT": {LotSize: 10}},
+	Tiers:  map[string]RiskLimits{"vip": {MaxOrderValue: 1_000_000_000, MaxDailyOrders: 100, MaxDailyValue: 5_000_000_000}},
+}
+
+// fundAccount posts an opening balance of cash and shares of VNM.
+func fundAccount(t *testing.T, repo *database.InMemoryUserRepository, userID, cash, shares int64) {
+	t.Helper()
+	entries := []userentity.LedgerEntry{{UserID: userID, Amount: cash, Type: userentity.LedgerEntryAdjustment}}
+	if shares != 0 {
+		entries = append(entries, userentity.LedgerEntry{UserID: userID, Code: "VNM", Amount: shares, Type: userentity.LedgerEntryAdjustment})
+	}
+	_, err := repo.PostLedgerEntries(context.Background(), fmt.Sprintf("opening:%d", userID), entries)
+	require.NoError(t, err)
+}
+
+func newTestRiskChain(t *testing.T, repo *database.InMemoryUserRepository) RiskChain {
+	t.Helper()
+	rules, err := NewRiskRules(nil, repo, repo, repo)
+	require.NoError(t, err)
+	chain, err := NewRiskChain(hoseRiskConfig, rules...)
+	require.NoError(t, err)
+	return chain
+}
+
+// checkRisk runs the chain on order as the order repository does when it
+// stores the order.
+func checkRisk(ctx context.Context, repo *database.InMemoryUserRepository, chain RiskChain, order userentity.Order, dayStart time.Time) error {
+	account, err := repo.GetTradingAccount(ctx, order.UserID)
+	if err != nil {
+		return err
+	}
+	open, err := repo.ListOpenOrders(ctx, order.UserID)
+	if err != nil {
+		return err
+	}
+	return chain.OrderCheck(dayStart, dayStart)(ctx, order, account, open)
+}
+
+func TestRiskConfig_Limits(t *testing.T) {
+	limits := hoseRiskConfig.Limits("VNM", userentity.DefaultTradingTier)
+	assert.Equal(t, hoseRiskConfig.Default, limits)
+
+	limits = hoseRiskConfig.Limits("FPT", "vip")
+	assert.Equal(t, int64(10), limits.LotSize, "stock override")
+	assert.Equal(t, int64(700), limits.PriceBandBps, "default kept")
+	assert.Equal(t, int64(1_000_000_000), limits.MaxOrderValue, "tier override")
+	assert.Equal(t, int64(50), limits.tick(10000))
+	assert.Equal(t, int64(10), limits.tick(9990))
+
+	assert.True(t, hoseRiskConfig.HasTier("vip"))
+	assert.True(t, hoseRiskConfig.HasTier(userentity.DefaultTradingTier))
+	assert.False(t, hoseRiskConfig.HasTier("gold"))
+}
+
+func TestNewRiskChain_RejectsBadConfig(t *testing.T) {
+	repo := newTestRepo()
+	_, err := NewRiskRules([]string{RiskRuleLotSize, "margin"}, repo, repo, repo)
+	assert.Error(t, err)
+
+	for name, config := range map[string]RiskConfig{
+		"negative limit":       {Default: RiskLimits{LotSize: -1}},
+		"first tick not zero":  {Default: RiskLimits{TickSizes: []TickSize{{From: 10, Tick: 10}}}},
+		"ticks out of order":   {Stocks: map[string]RiskLimits{"VNM": {TickSizes: []TickSize{{From: 0, Tick: 10}, {From: 0, Tick: 50}}}}},
+		"tier with zero tick":  {Tiers: map[string]RiskLimits{"vip": {TickSizes: []TickSize{{From: 0}}}}},
+		"negative daily limit": {Tiers: map[string]RiskLimits{"vip": {MaxDailyValue: -5}}},
+	} {
+		_, err := NewRiskChain(config)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestRiskChain_Check(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM", "FPT", "HPG")
+	dayStart := time.Now().UTC().Truncate(24 * time.Hour)
+	require.NoError(t, repo.RecordStockPrice("VNM", 60000, dayStart.Add(-time.Hour)))
+	require.NoError(t, repo.RecordStockPrice("VNM", 90000, dayStart.Add(time.Hour)), "prices of the day are not the reference")
+	fundAccount(t, repo, alice.Id, 20_000_000, 500)
+	resting := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 200, dayStart.Add(-48*time.Hour))
+	createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideSell, userentity.TimeInForceGTC, 62000, 300, dayStart.Add(-48*time.Hour))
+	chain := newTestRiskChain(t, repo)
+
+	order := func(side userentity.OrderSide, code string, price, quantity int64) userentity.Order {
+		return userentity.Order{UserID: alice.Id, Code: code, Side: side, TimeInForce: userentity.TimeInForceDAY, Price: price, Quantity: quantity}
+	}
+	tests := []struct {
+		name     string
+		order    userentity.Order
+		want     error
+		metadata map[string]string
+	}{
+		{"odd lot", order(userentity.OrderSideBuy, "VNM", 60000, 150), ErrInvalidLotSize, map[string]string{"lot_size": "100"}},
+		{"stock lot size", order(userentity.OrderSideBuy, "FPT", 100000, 10), nil, nil},
+		{"off tick", order(userentity.OrderSideBuy, "VNM", 60050, 100), ErrInvalidTickSize, map[string]string{"tick_size": "100"}},
+		{"above ceiling", order(userentity.OrderSideBuy, "VNM", 64300, 100), ErrPriceOutsideBand, map[string]string{"reference_price": "60000", "floor": "55800", "ceiling": "64200"}},
+		{"below floor", order(userentity.OrderSideSell, "VNM", 55700, 100), ErrPriceOutsideBand, map[string]string{"reference_price": "60000", "floor": "55800", "ceiling": "64200"}},
+		{"no reference price", order(userentity.OrderSideBuy, "HPG", 28000, 100), nil, nil},
+		{"order value", order(userentity.OrderSideBuy, "HPG", 100000, 1100), ErrOrderValueLimitExceeded, map[string]string{"limit": "100000000", "value": "110000000"}},
+		{"buying power", order(userentity.OrderSideBuy, "VNM", 60000, 200), ErrInsufficientBuyingPower, map[string]string{"required": "12000000", "available": "8000000"}},
+		{"holdings", order(userentity.OrderSideSell, "VNM", 60000, 300), ErrInsufficientHoldings, map[string]string{"required": "300", "available": "200"}},
+		{"no shares", order(userentity.OrderSideSell, "FPT", 100000, 10), ErrInsufficientHoldings, map[string]string{"required": "10", "available": "0"}},
+		{"within limits", order(userentity.OrderSideSell, "VNM", 60000, 200), nil, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkRisk(ctx, repo, chain, tc.order, dayStart)
+			if tc.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.want)
+			assert.Equal(t, tc.metadata, apperrors.MetadataOf(err))
+		})
+	}
+
+	amended := resting
+	amended.Quantity = 300
+	assert.NoError(t, checkRisk(ctx, repo, chain, amended, dayStart), "an amended order does not reserve cash against itself")
+
+	for i := 0; i < 3; i++ {
+		createOrder(t, repo, alice.Id, "HPG", userentity.OrderSideBuy, userentity.TimeInForceDAY, 5000, 100, dayStart.Add(time.Hour))
+	}
+	err := checkRisk(ctx, repo, chain, order(userentity.OrderSideBuy, "HPG", 28000, 100), dayStart)
+	assert.ErrorIs(t, err, ErrDailyOrderLimitExceeded)
+	assert.Equal(t, map[string]string{"limit": "3"}, apperrors.MetadataOf(err))
+	assert.NoError(t, checkRisk(ctx, repo, chain, amended, dayStart), "amendments are not counted against the daily limits")
+
+	_, err = repo.SetTradingTier(ctx, alice.Id, "vip", time.Now().UTC())
+	require.NoError(t, err)
+	assert.NoError(t, checkRisk(ctx, repo, chain, order(userentity.OrderSideBuy, "HPG", 28000, 100), dayStart), "the vip tier has higher limits")
+}
+
+func TestRiskChain_BuyingPowerReservesFees(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	dayStart := time.Now().UTC().Truncate(24 * time.Hour)
+	seedFeeSchedule(t, repo, standardFees, userentity.DefaultTradingTier, dayStart.Add(-24*time.Hour))
+	fundAccount(t, repo, alice.Id, 16_020_000, 0)
+	createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 100, dayStart.Add(-48*time.Hour))
+	chain := newTestRiskChain(t, repo)
+
+	// The open order reserves 6,000,000 and the minimum fee of 10,000,
+	// which leaves 10,010,000.
+	err := checkRisk(ctx, repo, chain, userentity.Order{UserID: alice.Id, Code: "VNM", Side: userentity.OrderSideBuy, Price: 62000, Quantity: 100}, dayStart)
+	require.NoError(t, err)
+	err = checkRisk(ctx, repo, chain, userentity.Order{UserID: alice.Id, Code: "VNM", Side: userentity.OrderSideBuy, Price: 100000, Quantity: 100}, dayStart)
+	assert.ErrorIs(t, err, ErrInsufficientBuyingPower, "a buy of the whole free cash cannot pay its fee")
+	assert.Equal(t, map[string]string{"required": "10015000", "available": "10010000"}, apperrors.MetadataOf(err))
+}
+
+func TestRiskChain_DailyValue(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	fundAccount(t, repo, alice.Id, 1_000_000_000, 100)
+	dayStart := time.Now().UTC().Truncate(24 * time.Hour)
+	placed := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceDAY, 60000, 1500, dayStart.Add(time.Hour))
+	_, err := repo.CloseOrder(ctx, ports.CloseOrderParams{OrderID: placed.ID, Status: userentity.OrderStatusCancelled, At: dayStart.Add(2 * time.Hour)})
+	require.NoError(t, err)
+	chain := newTestRiskChain(t, repo)
+
+	err = checkRisk(ctx, repo, chain, userentity.Order{UserID: alice.Id, Code: "VNM", Side: userentity.OrderSideBuy, Price: 60000, Quantity: 1100}, dayStart)
+	assert.ErrorIs(t, err, ErrDailyValueLimitExceeded, "cancelled orders count")
+	assert.Equal(t, map[string]string{"limit": "150000000", "used": "90000000", "value": "66000000"}, apperrors.MetadataOf(err))
+
+	err = checkRisk(ctx, repo, chain, userentity.Order{UserID: alice.Id, Code: "VNM", Side: userentity.OrderSideBuy, Price: 60000, Quantity: 1100}, dayStart.Add(24*time.Hour))
+	assert.NoError(t, err, "a new trading day starts over")
+}
+
+func TestUserOrderUseCase_Risk(t *testing.T) {
+	if now := time.Now().UTC(); now.Hour() == 23 && now.Minute() == 59 {
+		t.Skip("the all-day calendar closes at 23:59 UTC")
+	}
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	fundAccount(t, repo, alice.Id, 10_000_000, 0)
+	calendar, err := NewTradingCalendar(TradingCalendarConfig{
+		TimeZone:    "UTC",
+		Windows:     []TradingWindow{{Session: "continuous", Start: "00:00", End: "23:59"}},
+		TradingDays: []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+	})
+	require.NoError(t, err)
+	risk := newTestRiskChain(t, repo)
+	uc := NewUserOrderUseCase(repo, repo, OrderConfig{Calendar: &calendar, Risk: &risk})
+
+	_, err = uc.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "VNM", Side: "sell", Price: 60000, Quantity: 100})
+	assert.ErrorIs(t, err, ErrInsufficientHoldings)
+	_, err = uc.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "VNM", Side: "buy", Price: 60000, Quantity: 200})
+	assert.ErrorIs(t, err, ErrInsufficientBuyingPower)
+	orders, err := repo.ListOpenOrders(ctx, alice.Id)
+	require.NoError(t, err)
+	assert.Empty(t, orders, "rejected orders are not stored")
+
+	order, err := uc.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "VNM", Side: "buy", Price: 60000, Quantity: 100})
+	require.NoError(t, err)
+	_, err = uc.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Quantity: 150})
+	assert.ErrorIs(t, err, ErrInvalidLotSize)
+	_, err = uc.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Quantity: 200})
+	assert.ErrorIs(t, err, ErrInsufficientBuyingPower)
+	amended, err := uc.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Price: 50000})
+	require.NoError(t, err)
+	assert.Equal(t, int64(50000), amended.Price)
+}
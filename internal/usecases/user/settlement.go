@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// UserSettlementUseCase settles trades. Fills post what they take out of an
+// account at once and schedule what they deliver for the settlement date of
+// the trading calendar; the daily settlement job brings it into the ledger.
+type UserSettlementUseCase struct {
+	accounts ports.TradingAccountRepository
+	calendar TradingCalendar
+}
+
+// NewUserSettlementUseCase settles on the trading days of calendar.
+func NewUserSettlementUseCase(accounts ports.TradingAccountRepository, calendar TradingCalendar) UserSettlementUseCase {
+	return UserSettlementUseCase{accounts: accounts, calendar: calendar}
+}
+
+// SettlementRunResult tells what a settlement run did.
+type SettlementRunResult struct {
+	// Date is the day the run settled for, at midnight UTC.
+	Date time.Time
+	// TradingDay is false when the market does not trade on Date; nothing
+	// settles then.
+	TradingDay bool
+	Settled    int
+}
+
+// Settle runs the settlement of the day of t in the calendar's zone. On a
+// trading day it settles everything due on or before that day, so a run
+// missed on an earlier day is caught up; weekends and holidays settle
+// nothing. Running it again on the same day settles nothing more.
+func (u UserSettlementUseCase) Settle(ctx context.Context, t time.Time) (SettlementRunResult, error) {
+	result := SettlementRunResult{Date: u.calendar.Date(t), TradingDay: u.calendar.TradingDay(t)}
+	if !result.TradingDay {
+		return result, nil
+	}
+	settled, err := u.accounts.SettleDue(ctx, result.Date, time.Now().UTC())
+	if err != nil {
+		return result, fmt.Errorf("settle due settlements: %w", err)
+	}
+	result.Settled = settled
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func TestUserSettlementUseCase_Settle(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	fundAccount(t, repo, alice.Id, 10_000_000, 500)
+	calendar := hoseCalendar(t)
+	orders := NewUserOrderUseCase(repo, repo, OrderConfig{Calendar: &calendar})
+	accounts := NewUserTradingAccountUseCase(repo, repo, repo, repo, hoseRiskConfig)
+	uc := NewUserSettlementUseCase(repo, calendar)
+
+	traded := vnTime("2026-10-19", "10:00")
+	buy := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 100, traded)
+	sell := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideSell, userentity.TimeInForceGTC, 61000, 200, traded)
+	_, err := orders.RecordFill(ctx, RecordFillInput{OrderID: buy.ID, ExecutionID: "x-1", Price: 60000, Quantity: 100, At: traded})
+	require.NoError(t, err)
+	_, err = orders.RecordFill(ctx, RecordFillInput{OrderID: sell.ID, ExecutionID: "x-2", Price: 61000, Quantity: 200, At: traded})
+	require.NoError(t, err)
+
+	result, err := accounts.Get(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4_000_000), result.Account.Cash, "the purchase is paid at the fill")
+	assert.Equal(t, int64(12_200_000), result.Account.PendingCash, "the proceeds wait for settlement")
+	assert.Equal(t, []userentity.Position{{StockID: result.Account.Positions[0].StockID, Code: "VNM", Quantity: 300, Pending: 100}}, result.Account.Positions)
+	assert.Equal(t, int64(4_000_000), result.BuyingPower)
+	require.Len(t, result.Settlements, 2)
+	for _, settlement := range result.Settlements {
+		assert.Equal(t, "2026-10-22", settlement.SettlesOn.Format(time.DateOnly), "T+2 skips the holiday")
+	}
+
+	run, err := uc.Settle(ctx, vnTime("2026-10-20", "16:00"))
+	require.NoError(t, err)
+	assert.False(t, run.TradingDay, "holidays settle nothing")
+	run, err = uc.Settle(ctx, vnTime("2026-10-21", "16:00"))
+	require.NoError(t, err)
+	assert.True(t, run.TradingDay)
+	assert.Equal(t, 0, run.Settled, "not due yet")
+
+	run, err = uc.Settle(ctx, vnTime("2026-10-23", "16:00"))
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-23", run.Date.Format(time.DateOnly))
+	assert.Equal(t, 2, run.Settled, "a missed day is caught up")
+	run, err = uc.Settle(ctx, vnTime("2026-10-23", "17:00"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, run.Settled)
+
+	result, err = accounts.Get(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, int64(16_200_000), result.Account.Cash)
+	assert.Zero(t, result.Account.PendingCash)
+	assert.Equal(t, int64(400), result.Account.Shares("VNM"))
+	assert.Empty(t, result.Settlements)
+}
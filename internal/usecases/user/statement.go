@@ -0,0 +1,322 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// statementURLTTL is how long the download URLs of a statement can be used.
+const statementURLTTL = 15 * time.Minute
+
+var (
+	ErrInvalidStatementPeriod = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_STATEMENT_PERIOD", "period must be a month such as 2026-09")
+	ErrStatementPeriodOpen    = apperrors.New(apperrors.ErrFailedPrecondition, "STATEMENT_PERIOD_OPEN", "statements are available once the month has ended")
+)
+
+// StatementResult is a statement with signed URLs its files can be
+// downloaded from until ExpiresAt.
+type StatementResult struct {
+	Statement userentity.Statement
+	CSVURL    string
+	PDFURL    string
+	ExpiresAt time.Time
+}
+
+// statementPosition is the holding of one stock over a statement period.
+// Price is the last price recorded before the period ended, zero when none
+// was; Value is Closing times Price.
+type statementPosition struct {
+	Code    string
+	Opening int64
+	Closing int64
+	Price   int64
+	Value   int64
+}
+
+// statementMovement is a cash entry of the period with the balance after
+// it.
+type statementMovement struct {
+	Entry   userentity.LedgerEntry
+	Balance int64
+}
+
+// statementReport is what a statement shows: the settled positions at the
+// start and end of the period, the cash movements and the fills of the
+// period.
+type statementReport struct {
+	Username  string
+	Statement userentity.Statement
+	Positions []statementPosition
+	Movements []statementMovement
+	Trades    []ports.Trade
+	Location  *time.Location
+}
+
+// UserStatementUseCase generates monthly account statements. A statement is
+// generated the first time it is asked for once its month has ended; its
+// CSV and PDF files are kept in the blob store and served through signed
+// URLs.
+type UserStatementUseCase struct {
+	users      ports.UserRepository
+	accounts   ports.TradingAccountRepository
+	orders     ports.OrderRepository
+	stocks     ports.StockRepository
+	statements ports.StatementRepository
+	blobs      ports.BlobStore
+	urls       ports.BlobURLSigner
+	calendar   TradingCalendar
+}
+
+// NewUserStatementUseCase cuts periods at midnight in the time zone of
+// calendar.
+func NewUserStatementUseCase(users ports.UserRepository, accounts ports.TradingAccountRepository, orders ports.OrderRepository, stocks ports.StockRepository, statements ports.StatementRepository, blobs ports.BlobStore, urls ports.BlobURLSigner, calendar TradingCalendar) UserStatementUseCase {
+	return UserStatementUseCase{
+		users:      users,
+		accounts:   accounts,
+		orders:     orders,
+		stocks:     stocks,
+		statements: statements,
+		blobs:      blobs,
+		urls:       urls,
+		calendar:   calendar,
+	}
+}
+
+// Get returns the statement of the caller's account for period, such as
+// "2026-09", generating it when it was not generated before. Months that
+// have not ended fail with ErrStatementPeriodOpen.
+func (u UserStatementUseCase) Get(ctx context.Context, uid int64, username, period string) (StatementResult, error) {
+	from, err := time.ParseInLocation(userentity.StatementPeriodLayout, period, u.calendar.Location())
+	if err != nil {
+		return StatementResult{}, ErrInvalidStatementPeriod
+	}
+	to := from.AddDate(0, 1, 0)
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return StatementResult{}, err
+	}
+	if now := time.Now(); now.Before(to) {
+		return StatementResult{}, ErrStatementPeriodOpen
+	}
+
+	statement, err := u.statements.GetStatement(ctx, owner.Id, period)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		statement, err = u.generate(ctx, owner, period, from, to)
+	}
+	if err != nil {
+		return StatementResult{}, fmt.Errorf("get statement: %w", err)
+	}
+	expires := time.Now().UTC().Add(statementURLTTL).Truncate(time.Second)
+	return StatementResult{
+		Statement: statement,
+		CSVURL:    u.urls.DownloadURL(statement.CSVKey, expires),
+		PDFURL:    u.urls.DownloadURL(statement.PDFKey, expires),
+		ExpiresAt: expires,
+	}, nil
+}
+
+// report gathers what the statement of the user for the period from..to
+// shows.
+func (u UserStatementUseCase) report(ctx context.Context, owner userentity.User, period string, from, to time.Time) (statementReport, error) {
+	before, err := u.accounts.ListLedgerEntries(ctx, ports.ListLedgerEntriesParams{UserID: owner.Id, To: from})
+	if err != nil {
+		return statementReport{}, fmt.Errorf("list ledger entries: %w", err)
+	}
+	during, err := u.accounts.ListLedgerEntries(ctx, ports.ListLedgerEntriesParams{UserID: owner.Id, From: from, To: to})
+	if err != nil {
+		return statementReport{}, fmt.Errorf("list ledger entries: %w", err)
+	}
+	trades, err := u.orders.ListTrades(ctx, ports.ListTradesParams{UserID: owner.Id, From: from, To: to})
+	if err != nil {
+		return statementReport{}, fmt.Errorf("list trades: %w", err)
+	}
+
+	report := statementReport{
+		Username:  owner.Username,
+		Statement: userentity.Statement{UserID: owner.Id, Period: period, From: from, To: to},
+		Movements: make([]statementMovement, 0),
+		Trades:    trades,
+		Location:  u.calendar.Location(),
+	}
+	holdings := make(map[string]*statementPosition)
+	holding := func(code string) *statementPosition {
+		if holdings[code] == nil {
+			holdings[code] = &statementPosition{Code: code}
+		}
+		return holdings[code]
+	}
+	for _, entry := range before {
+		if entry.Cash() {
+			report.Statement.OpeningCash += entry.Amount
+			continue
+		}
+		position := holding(entry.Code)
+		position.Opening += entry.Amount
+		position.Closing += entry.Amount
+	}
+	balance := report.Statement.OpeningCash
+	for _, entry := range during {
+		if entry.Cash() {
+			balance += entry.Amount
+			report.Movements = append(report.Movements, statementMovement{Entry: entry, Balance: balance})
+			continue
+		}
+		holding(entry.Code).Closing += entry.Amount
+	}
+	report.Statement.ClosingCash = balance
+	for _, trade := range trades {
+		report.Statement.Fees += trade.Fill.Fee
+		report.Statement.Taxes += trade.Fill.Tax
+	}
+
+	codes := make([]string, 0, len(holdings))
+	for code, position := range holdings {
+		if position.Opening != 0 || position.Closing != 0 {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	quotes, err := u.stocks.LatestStockQuotesBefore(ctx, codes, to)
+	if err != nil {
+		return statementReport{}, fmt.Errorf("latest stock quotes: %w", err)
+	}
+	prices := make(map[string]int64, len(quotes))
+	for _, quote := range quotes {
+		prices[quote.Code] = quote.Price
+	}
+	report.Positions = make([]statementPosition, 0, len(codes))
+	for _, code := range codes {
+		position := *holdings[code]
+		position.Price = prices[code]
+		position.Value = position.Closing * position.Price
+		report.Positions = append(report.Positions, position)
+	}
+	return report, nil
+}
+
+// generate renders the statement of the period, stores its files and
+// records it. The files are stored under keys derived from the user and the
+// period, so concurrent generations overwrite each other's files with the
+// same content.
+func (u UserStatementUseCase) generate(ctx context.Context, owner userentity.User, period string, from, to time.Time) (userentity.Statement, error) {
+	report, err := u.report(ctx, owner, period, from, to)
+	if err != nil {
+		return userentity.Statement{}, err
+	}
+	report.Statement.CreatedAt = time.Now().UTC()
+	report.Statement.CSVKey = statementKey(owner.Id, period, "csv")
+	report.Statement.PDFKey = statementKey(owner.Id, period, "pdf")
+
+	csvContent, err := renderStatementCSV(report)
+	if err != nil {
+		return userentity.Statement{}, err
+	}
+	if err := u.blobs.Put(ctx, report.Statement.CSVKey, bytes.NewReader(csvContent), "text/csv"); err != nil {
+		return userentity.Statement{}, fmt.Errorf("store statement csv: %w", err)
+	}
+	if err := u.blobs.Put(ctx, report.Statement.PDFKey, bytes.NewReader(renderStatementPDF(report)), "application/pdf"); err != nil {
+		return userentity.Statement{}, fmt.Errorf("store statement pdf: %w", err)
+	}
+	statement, err := u.statements.CreateStatement(ctx, report.Statement)
+	if err != nil {
+		return userentity.Statement{}, fmt.Errorf("create statement: %w", err)
+	}
+	return statement, nil
+}
+
+func (u UserStatementUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
+
+// statementKey is the blob key of a statement file with extension ext.
+func statementKey(userID int64, period, ext string) string {
+	return "statements/" + strconv.FormatInt(userID, 10) + "/" + period + "." + ext
+}
+
+// renderStatementCSV renders report as CSV: a summary followed by the
+// positions, the cash movements and the fills, each section with its own
+// header row and separated by an empty line. Amounts are plain integers in
+// VND; times are in the report's location.
+func renderStatementCSV(report statementReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	statement := report.Statement
+	records := [][]string{
+		{"Statement", statement.Period},
+		{"Account", report.Username},
+		{"From", statementTime(statement.From, report.Location)},
+		{"To", statementTime(statement.To, report.Location)},
+		{"Opening cash", strconv.FormatInt(statement.OpeningCash, 10)},
+		{"Closing cash", strconv.FormatInt(statement.ClosingCash, 10)},
+		{"Fees", strconv.FormatInt(statement.Fees, 10)},
+		{"Taxes", strconv.FormatInt(statement.Taxes, 10)},
+		nil,
+		{"Code", "Opening quantity", "Closing quantity", "Price", "Market value"},
+	}
+	for _, position := range report.Positions {
+		records = append(records, []string{
+			position.Code,
+			strconv.FormatInt(position.Opening, 10),
+			strconv.FormatInt(position.Closing, 10),
+			strconv.FormatInt(position.Price, 10),
+			strconv.FormatInt(position.Value, 10),
+		})
+	}
+	records = append(records, nil, []string{"Time", "Type", "Reference", "Note", "Amount", "Balance"})
+	for _, movement := range report.Movements {
+		records = append(records, []string{
+			statementTime(movement.Entry.CreatedAt, report.Location),
+			string(movement.Entry.Type),
+			movement.Entry.Reference,
+			movement.Entry.Note,
+			strconv.FormatInt(movement.Entry.Amount, 10),
+			strconv.FormatInt(movement.Balance, 10),
+		})
+	}
+	records = append(records, nil, []string{"Time", "Order", "Code", "Side", "Execution", "Quantity", "Price", "Value", "Fee", "Tax"})
+	for _, trade := range report.Trades {
+		records = append(records, []string{
+			statementTime(trade.Fill.CreatedAt, report.Location),
+			strconv.FormatInt(trade.Order.ID, 10),
+			trade.Order.Code,
+			string(trade.Order.Side),
+			trade.Fill.ExecutionID,
+			strconv.FormatInt(trade.Fill.Quantity, 10),
+			strconv.FormatInt(trade.Fill.Price, 10),
+			strconv.FormatInt(trade.Fill.Price*trade.Fill.Quantity, 10),
+			strconv.FormatInt(trade.Fill.Fee, 10),
+			strconv.FormatInt(trade.Fill.Tax, 10),
+		})
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("write statement csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func statementTime(t time.Time, location *time.Location) string {
+	if location == nil {
+		location = time.UTC
+	}
+	return t.In(location).Format("2006-01-02 15:04:05")
+}
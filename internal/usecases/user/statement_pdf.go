@@ -0,0 +1,169 @@
+package user
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Layout of statement PDFs: A4 pages in points, with the text set in 8
+// point Courier so columns line up by padding.
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 40
+	pdfFontSize     = 8
+	pdfLeading      = 11
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLeading
+)
+
+// renderStatementPDF renders report as a PDF of plain text pages. It uses
+// only the standard Courier font, so no font is embedded; characters
+// outside printable ASCII are printed as '?'.
+func renderStatementPDF(report statementReport) []byte {
+	return renderTextPDF(statementLines(report))
+}
+
+// statementLines lays report out as lines of at most 105 characters.
+func statementLines(report statementReport) []string {
+	statement := report.Statement
+	lines := []string{
+		"ACCOUNT STATEMENT " + statement.Period,
+		"",
+		"Account:      " + report.Username,
+		"Period:       " + statementTime(statement.From, report.Location) + " to " + statementTime(statement.To, report.Location),
+		"Opening cash: " + groupDigits(statement.OpeningCash) + " VND",
+		"Closing cash: " + groupDigits(statement.ClosingCash) + " VND",
+		"Fees:         " + groupDigits(statement.Fees) + " VND",
+		"Taxes:        " + groupDigits(statement.Taxes) + " VND",
+		"",
+		"POSITIONS",
+		fmt.Sprintf("%-10s %16s %16s %16s %20s", "Code", "Opening qty", "Closing qty", "Price", "Market value"),
+	}
+	for _, position := range report.Positions {
+		lines = append(lines, fmt.Sprintf("%-10s %16s %16s %16s %20s",
+			position.Code, groupDigits(position.Opening), groupDigits(position.Closing),
+			groupDigits(position.Price), groupDigits(position.Value)))
+	}
+	if len(report.Positions) == 0 {
+		lines = append(lines, "No positions.")
+	}
+
+	lines = append(lines, "", "CASH MOVEMENTS",
+		fmt.Sprintf("%-19s %-10s %-30s %20s %20s", "Time", "Type", "Reference", "Amount", "Balance"))
+	for _, movement := range report.Movements {
+		entry := movement.Entry
+		lines = append(lines, fmt.Sprintf("%-19s %-10s %-30s %20s %20s",
+			statementTime(entry.CreatedAt, report.Location), entry.Type, truncateText(entry.Reference, 30),
+			groupDigits(entry.Amount), groupDigits(movement.Balance)))
+		if entry.Note != "" {
+			lines = append(lines, "    "+truncateText(entry.Note, 100))
+		}
+	}
+	if len(report.Movements) == 0 {
+		lines = append(lines, "No cash movements.")
+	}
+
+	lines = append(lines, "", "FILLS",
+		fmt.Sprintf("%-19s %7s %-7s %-4s %10s %10s %16s %11s %11s", "Time", "Order", "Code", "Side", "Quantity", "Price", "Value", "Fee", "Tax"))
+	for _, trade := range report.Trades {
+		lines = append(lines, fmt.Sprintf("%-19s %7d %-7s %-4s %10s %10s %16s %11s %11s",
+			statementTime(trade.Fill.CreatedAt, report.Location), trade.Order.ID, trade.Order.Code, trade.Order.Side,
+			groupDigits(trade.Fill.Quantity), groupDigits(trade.Fill.Price),
+			groupDigits(trade.Fill.Price*trade.Fill.Quantity), groupDigits(trade.Fill.Fee), groupDigits(trade.Fill.Tax)))
+	}
+	if len(report.Trades) == 0 {
+		lines = append(lines, "No fills.")
+	}
+	return lines
+}
+
+// renderTextPDF writes lines onto as many pages as they need.
+func renderTextPDF(lines []string) []byte {
+	pages := make([][]string, 0, len(lines)/pdfLinesPerPage+1)
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	// Objects 1 to 3 are the catalog, the page tree and the font; each page
+	// is followed by its content stream.
+	objects := make([]string, 3, 3+2*len(pages))
+	kids := make([]string, 0, len(pages))
+	for i, page := range pages {
+		pageID, contentID := 4+2*i, 5+2*i
+		kids = append(kids, strconv.Itoa(pageID)+" 0 R")
+
+		var content strings.Builder
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin-pdfFontSize)
+		for _, line := range page {
+			content.WriteString("(" + pdfText(line) + ") Tj T*\n")
+		}
+		content.WriteString("ET\n")
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, contentID),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+		)
+	}
+	objects[0] = "<< /Type /Catalog /Pages 2 0 R >>"
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+	objects[2] = "<< /Type /Font /Subtype /Type1 /BaseFont /Courier >>"
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// pdfText escapes s for a PDF string literal, replacing what Courier cannot
+// print with '?'.
+func pdfText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < ' ' || r > '~':
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// groupDigits formats n with commas between groups of three digits.
+func groupDigits(n int64) string {
+	digits := strconv.FormatInt(n, 10)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return sign + digits
+}
+
+// truncateText cuts s to at most n runes.
+func truncateText(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "~"
+}
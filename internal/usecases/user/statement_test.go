@@ -0,0 +1,155 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/blobstore"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func newTestStatementUseCase(t *testing.T, repo *database.InMemoryUserRepository) (UserStatementUseCase, *blobstore.LocalBlobStore) {
+	t.Helper()
+	blobs, err := blobstore.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	signer, err := blobstore.NewURLSigner("statement-test-secret-0123456789", "/api/v1/blobs")
+	require.NoError(t, err)
+	return NewUserStatementUseCase(repo, repo, repo, repo, repo, blobs, signer, hoseCalendar(t)), blobs
+}
+
+func readBlob(t *testing.T, blobs *blobstore.LocalBlobStore, key string) string {
+	t.Helper()
+	content, err := blobs.Get(context.Background(), key)
+	require.NoError(t, err)
+	defer content.Close()
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	return string(data)
+}
+
+// seedSeptember gives alice cash and VNM shares in August, a fill charged a
+// fee and a withdrawal in September 2026 and a deposit in October.
+func seedSeptember(t *testing.T, repo *database.InMemoryUserRepository, userID int64) {
+	t.Helper()
+	ctx := context.Background()
+	post := func(reference string, entries ...userentity.LedgerEntry) {
+		t.Helper()
+		_, err := repo.PostLedgerEntries(ctx, reference, entries)
+		require.NoError(t, err)
+	}
+	post("opening",
+		userentity.LedgerEntry{UserID: userID, Amount: 100_000_000, Type: userentity.LedgerEntryDeposit, CreatedAt: vnTime("2026-08-25", "10:00")},
+		userentity.LedgerEntry{UserID: userID, Code: "VNM", Amount: 200, Type: userentity.LedgerEntryAdjustment, CreatedAt: vnTime("2026-08-25", "10:00")},
+	)
+	order, err := repo.CreateOrder(ctx, userentity.Order{
+		UserID: userID, Code: "VNM", Side: userentity.OrderSideBuy, TimeInForce: userentity.TimeInForceDAY,
+		Price: 70000, Quantity: 100, CreatedAt: vnTime("2026-09-10", "10:00"),
+	}, nil)
+	require.NoError(t, err)
+	_, err = repo.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: "x1", Price: 70000, Quantity: 100, Charges: userentity.FeeCharges{Fee: 10_500}, At: vnTime("2026-09-10", "10:01")})
+	require.NoError(t, err)
+	post("withdrawal", userentity.LedgerEntry{UserID: userID, Amount: -1_000_000, Type: userentity.LedgerEntryWithdrawal, Note: "to bank", CreatedAt: vnTime("2026-09-15", "09:30")})
+	post("deposit", userentity.LedgerEntry{UserID: userID, Amount: 5_000_000, Type: userentity.LedgerEntryDeposit, CreatedAt: vnTime("2026-10-05", "09:30")})
+	require.NoError(t, repo.RecordStockPrice("VNM", 71000, vnTime("2026-09-30", "14:45")))
+	require.NoError(t, repo.RecordStockPrice("VNM", 80000, vnTime("2026-10-05", "14:45")))
+}
+
+func TestUserStatementUseCase_Get(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "VNM")
+	seedSeptember(t, repo, alice.Id)
+	uc, blobs := newTestStatementUseCase(t, repo)
+
+	result, err := uc.Get(ctx, alice.Id, "alice", "2026-09")
+	require.NoError(t, err)
+	statement := result.Statement
+	assert.Equal(t, "2026-09", statement.Period)
+	assert.True(t, vnTime("2026-09-01", "00:00").Equal(statement.From))
+	assert.True(t, vnTime("2026-10-01", "00:00").Equal(statement.To))
+	assert.Equal(t, int64(100_000_000), statement.OpeningCash)
+	assert.Equal(t, int64(100_000_000-7_000_000-10_500-1_000_000), statement.ClosingCash)
+	assert.Equal(t, int64(10_500), statement.Fees)
+	assert.Zero(t, statement.Taxes)
+	assert.Equal(t, fmt.Sprintf("statements/%d/2026-09.csv", alice.Id), statement.CSVKey)
+	assert.Contains(t, result.CSVURL, statement.CSVKey)
+	assert.Contains(t, result.PDFURL, statement.PDFKey)
+	assert.WithinDuration(t, time.Now().Add(statementURLTTL), result.ExpiresAt, time.Minute)
+
+	csvContent := readBlob(t, blobs, statement.CSVKey)
+	assert.Contains(t, csvContent, "Opening cash,100000000\n")
+	assert.Contains(t, csvContent, "Closing cash,91989500\n")
+	assert.Contains(t, csvContent, "Fees,10500\n")
+	assert.Contains(t, csvContent, "VNM,200,300,71000,21300000\n", "priced before the month ended")
+	assert.Contains(t, csvContent, "2026-09-10 10:01:00,trade,", "times are in the market's time zone")
+	assert.Contains(t, csvContent, "2026-09-10 10:01:00,fee,order_fill:1,,-10500,92989500\n")
+	assert.Contains(t, csvContent, "2026-09-15 09:30:00,withdrawal,withdrawal,to bank,-1000000,91989500\n")
+	assert.Contains(t, csvContent, ",VNM,buy,x1,100,70000,7000000,10500,0\n")
+	assert.NotContains(t, csvContent, "5000000", "October is left out")
+
+	pdf := readBlob(t, blobs, statement.PDFKey)
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	assert.Contains(t, pdf, "(Closing cash: 91,989,500 VND) Tj")
+	assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+
+	// Later deposits do not change a statement once generated.
+	_, err = repo.PostLedgerEntries(ctx, "late", []userentity.LedgerEntry{{UserID: alice.Id, Amount: 1, Type: userentity.LedgerEntryAdjustment, CreatedAt: vnTime("2026-09-20", "10:00")}})
+	require.NoError(t, err)
+	again, err := uc.Get(ctx, alice.Id, "alice", "2026-09")
+	require.NoError(t, err)
+	assert.Equal(t, statement.ID, again.Statement.ID)
+	assert.Equal(t, statement.ClosingCash, again.Statement.ClosingCash)
+}
+
+func TestUserStatementUseCase_GetEmptyMonth(t *testing.T) {
+	repo := newTestRepo()
+	alice := seedTradingUser(t, repo, "alice")
+	uc, blobs := newTestStatementUseCase(t, repo)
+
+	result, err := uc.Get(context.Background(), alice.Id, "alice", "2026-08")
+	require.NoError(t, err)
+	assert.Zero(t, result.Statement.OpeningCash)
+	assert.Zero(t, result.Statement.ClosingCash)
+	pdf := readBlob(t, blobs, result.Statement.PDFKey)
+	assert.Contains(t, pdf, "(No positions.) Tj")
+	assert.Contains(t, pdf, "(No fills.) Tj")
+}
+
+func TestUserStatementUseCase_GetErrors(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob := seedTradingUser(t, repo, "bob")
+	uc, _ := newTestStatementUseCase(t, repo)
+
+	for _, period := range []string{"", "2026-9", "2026-13", "September"} {
+		_, err := uc.Get(ctx, alice.Id, "alice", period)
+		assert.ErrorIs(t, err, ErrInvalidStatementPeriod, period)
+	}
+	current := time.Now().In(hoseCalendar(t).Location()).Format(userentity.StatementPeriodLayout)
+	_, err := uc.Get(ctx, alice.Id, "alice", current)
+	assert.ErrorIs(t, err, ErrStatementPeriodOpen)
+	_, err = uc.Get(ctx, alice.Id, "alice", "2099-01")
+	assert.ErrorIs(t, err, ErrStatementPeriodOpen)
+
+	_, err = uc.Get(ctx, bob.Id, "alice", "2026-09")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	_, err = uc.Get(ctx, alice.Id, "", "2026-09")
+	assert.ErrorIs(t, err, ErrEmptyUsername)
+}
+
+func TestGroupDigits(t *testing.T) {
+	for n, want := range map[int64]string{0: "0", 999: "999", 1000: "1,000", -1234567: "-1,234,567", 100_000_000: "100,000,000"} {
+		assert.Equal(t, want, groupDigits(n))
+	}
+}
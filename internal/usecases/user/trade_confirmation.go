@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// tradeConfirmationBatchSize is how many fills the sender confirms at a
+// time.
+const tradeConfirmationBatchSize = 100
+
+// tradeConfirmationPayload is the outbox payload of a trade confirmation.
+// Prices, the value, the fee and the tax are in VND.
+type tradeConfirmationPayload struct {
+	Email       string    `json:"email"`
+	Purpose     string    `json:"purpose"`
+	OrderID     int64     `json:"order_id"`
+	FillID      int64     `json:"fill_id"`
+	ExecutionID string    `json:"execution_id"`
+	Code        string    `json:"code"`
+	Side        string    `json:"side"`
+	Price       int64     `json:"price"`
+	Quantity    int64     `json:"quantity"`
+	Fee         int64     `json:"fee"`
+	Tax         int64     `json:"tax"`
+	FilledAt    time.Time `json:"filled_at"`
+}
+
+// UserTradeConfirmationUseCase confirms every fill to the owner of the
+// order by email, through the outbox.
+type UserTradeConfirmationUseCase struct {
+	orders ports.OrderRepository
+}
+
+func NewUserTradeConfirmationUseCase(orders ports.OrderRepository) UserTradeConfirmationUseCase {
+	return UserTradeConfirmationUseCase{orders: orders}
+}
+
+// ConfirmPending confirms the fills that were not confirmed yet, oldest
+// first, and returns how many it confirmed. Fills of deleted accounts are
+// confirmed without an email, as there is no address left to send it to.
+func (u UserTradeConfirmationUseCase) ConfirmPending(ctx context.Context) (int, error) {
+	confirmed := 0
+	for {
+		trades, err := u.orders.ListUnconfirmedTrades(ctx, tradeConfirmationBatchSize)
+		if err != nil {
+			return confirmed, fmt.Errorf("list unconfirmed trades: %w", err)
+		}
+		for _, trade := range trades {
+			params := ports.ConfirmTradeParams{FillID: trade.Fill.ID, At: time.Now().UTC()}
+			if trade.Owner.Status != userentity.AccountStatusDeleted {
+				if params.Event, err = tradeConfirmationEvent(trade.Owner.Email, trade.Order, trade.Fill); err != nil {
+					return confirmed, err
+				}
+			}
+			ok, err := u.orders.ConfirmTrade(ctx, params)
+			if err != nil {
+				return confirmed, fmt.Errorf("confirm trade: %w", err)
+			}
+			if ok {
+				confirmed++
+			}
+		}
+		if len(trades) < tradeConfirmationBatchSize {
+			return confirmed, nil
+		}
+	}
+}
+
+func tradeConfirmationEvent(email string, order userentity.Order, fill userentity.OrderFill) (userentity.OutboxEvent, error) {
+	payload, err := json.Marshal(tradeConfirmationPayload{
+		Email:       email,
+		Purpose:     tradeConfirmationPurpose,
+		OrderID:     order.ID,
+		FillID:      fill.ID,
+		ExecutionID: fill.ExecutionID,
+		Code:        order.Code,
+		Side:        string(order.Side),
+		Price:       fill.Price,
+		Quantity:    fill.Quantity,
+		Fee:         fill.Fee,
+		Tax:         fill.Tax,
+		FilledAt:    fill.CreatedAt,
+	})
+	if err != nil {
+		return userentity.OutboxEvent{}, fmt.Errorf("marshal trade confirmation payload: %w", err)
+	}
+	now := time.Now().UTC()
+	return userentity.OutboxEvent{
+		AggregateType: "user",
+		EventType:     "user.trade.confirmed",
+		Payload:       payload,
+		Status:        userentity.OutboxEventStatusPending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
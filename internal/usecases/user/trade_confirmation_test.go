@@ -0,0 +1,87 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+func tradeConfirmations(t *testing.T, repo *database.InMemoryUserRepository, userID int64) []tradeConfirmationPayload {
+	t.Helper()
+	data, err := repo.GetPersonalData(context.Background(), userID)
+	require.NoError(t, err)
+	var notices []tradeConfirmationPayload
+	for _, event := range data.OutboxEvents {
+		if event.EventType != "user.trade.confirmed" {
+			continue
+		}
+		var payload tradeConfirmationPayload
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		notices = append(notices, payload)
+	}
+	return notices
+}
+
+func TestUserTradeConfirmationUseCase_ConfirmPending(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob := seedTradingUser(t, repo, "bob")
+	seedStocks(repo, "VNM")
+	at := time.Now().UTC().Truncate(time.Second)
+
+	fill := func(userID int64, side userentity.OrderSide, executionID string, quantity int64) userentity.Order {
+		t.Helper()
+		order, err := repo.CreateOrder(ctx, userentity.Order{
+			UserID: userID, Code: "VNM", Side: side, TimeInForce: userentity.TimeInForceDAY,
+			Price: 70000, Quantity: quantity, CreatedAt: at,
+		}, nil)
+		require.NoError(t, err)
+		_, err = repo.RecordOrderFill(ctx, ports.RecordOrderFillParams{OrderID: order.ID, ExecutionID: executionID, Price: 69900, Quantity: quantity, At: at})
+		require.NoError(t, err)
+		return order
+	}
+	buy := fill(alice.Id, userentity.OrderSideBuy, "e1", 100)
+	fill(alice.Id, userentity.OrderSideSell, "e2", 200)
+	fill(bob.Id, userentity.OrderSideBuy, "e3", 300)
+	closeOutAccount(t, repo, bob.Id)
+	_, err := NewUserDeleteUseCase(repo).EraseAccountOwned(ctx, bob.Id, "bob", "secret")
+	require.NoError(t, err)
+	_, err = NewUserAnonymizeUseCase(repo).AnonymizeDue(ctx, time.Now().UTC())
+	require.NoError(t, err)
+
+	uc := NewUserTradeConfirmationUseCase(repo)
+	confirmed, err := uc.ConfirmPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, confirmed)
+
+	notices := tradeConfirmations(t, repo, alice.Id)
+	require.Len(t, notices, 2)
+	notice := notices[0]
+	if notice.ExecutionID != "e1" {
+		notice = notices[1]
+	}
+	assert.Equal(t, "alice@example.com", notice.Email)
+	assert.Equal(t, tradeConfirmationPurpose, notice.Purpose)
+	assert.Equal(t, buy.ID, notice.OrderID)
+	assert.NotZero(t, notice.FillID)
+	assert.Equal(t, "VNM", notice.Code)
+	assert.Equal(t, "buy", notice.Side)
+	assert.Equal(t, int64(69900), notice.Price)
+	assert.Equal(t, int64(100), notice.Quantity)
+	assert.True(t, at.Equal(notice.FilledAt))
+	assert.Empty(t, tradeConfirmations(t, repo, bob.Id), "deleted accounts are not emailed")
+
+	confirmed, err = uc.ConfirmPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, confirmed, "each fill is confirmed once")
+	assert.Len(t, tradeConfirmations(t, repo, alice.Id), 2)
+}
@@ -0,0 +1,204 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	// adjustmentReferencePrefix keeps the references of adjustments apart
+	// from those of fills and other postings.
+	adjustmentReferencePrefix = "adjustment:"
+
+	maxAdjustmentReferenceLen = 50
+	maxAdjustmentNoteLen      = 255
+)
+
+var (
+	ErrUnknownTradingTier      = apperrors.New(apperrors.ErrInvalidArgument, "UNKNOWN_TRADING_TIER", "trading tier is not configured")
+	ErrInvalidLedgerAdjustment = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_LEDGER_ADJUSTMENT", "adjustment needs a non-zero amount, a reference of 1 to 50 and a note of at most 255 characters")
+)
+
+// UserTradingAccountUseCase shows users their cash and shares and lets
+// administrators move users between tiers and post adjustments, such as
+// opening balances, to the ledger.
+type UserTradingAccountUseCase struct {
+	users    ports.UserRepository
+	accounts ports.TradingAccountRepository
+	orders   ports.OrderRepository
+	fees     ports.FeeScheduleRepository
+	config   RiskConfig
+}
+
+// NewUserTradingAccountUseCase accepts the tiers of config. Buying power
+// leaves out the commission fees can charge open buy orders at most.
+func NewUserTradingAccountUseCase(users ports.UserRepository, accounts ports.TradingAccountRepository, orders ports.OrderRepository, fees ports.FeeScheduleRepository, config RiskConfig) UserTradingAccountUseCase {
+	return UserTradingAccountUseCase{users: users, accounts: accounts, orders: orders, fees: fees, config: config}
+}
+
+// TradingAccountResult is an account with what its open orders leave free
+// and what its trades will deliver when they settle.
+type TradingAccountResult struct {
+	Account userentity.TradingAccount
+	// BuyingPower is the settled cash neither open buy orders, with the
+	// largest commission they can be charged, nor open withdrawals hold
+	// back.
+	BuyingPower int64
+	// Available holds, by code, the settled shares open sell orders do not
+	// reserve.
+	Available map[string]int64
+	// Settlements are the pending settlements, earliest first.
+	Settlements []userentity.Settlement
+}
+
+// LedgerAdjustmentInput moves cash, or shares of the stock with Code, in or
+// out of an account. Reference identifies the adjustment: posting it again
+// posts nothing.
+type LedgerAdjustmentInput struct {
+	Code      string
+	Amount    int64
+	Reference string
+	Note      string
+}
+
+// Get returns the caller's account.
+func (u UserTradingAccountUseCase) Get(ctx context.Context, uid int64, username string) (TradingAccountResult, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return TradingAccountResult{}, err
+	}
+	account, err := u.accounts.GetTradingAccount(ctx, owner.Id)
+	if err != nil {
+		return TradingAccountResult{}, fmt.Errorf("get trading account: %w", err)
+	}
+	open, err := u.orders.ListOpenOrders(ctx, owner.Id)
+	if err != nil {
+		return TradingAccountResult{}, fmt.Errorf("list open orders: %w", err)
+	}
+	settlements, err := u.accounts.ListPendingSettlements(ctx, owner.Id)
+	if err != nil {
+		return TradingAccountResult{}, fmt.Errorf("list pending settlements: %w", err)
+	}
+	fees, err := feeScheduleAt(ctx, u.fees, account.Tier, time.Now().UTC())
+	if err != nil {
+		return TradingAccountResult{}, err
+	}
+	cash, shares := reservations(open, fees)
+	result := TradingAccountResult{
+		Account:     account,
+		BuyingPower: account.Cash - account.Withheld - cash,
+		Available:   make(map[string]int64, len(account.Positions)),
+		Settlements: settlements,
+	}
+	for _, position := range account.Positions {
+		result.Available[position.Code] = position.Quantity - shares[position.Code]
+	}
+	return result, nil
+}
+
+// SetTier moves a user to one of the configured tiers.
+func (u UserTradingAccountUseCase) SetTier(ctx context.Context, username, tier string) (userentity.TradingAccount, error) {
+	tier = strings.ToLower(strings.TrimSpace(tier))
+	if !u.config.HasTier(tier) {
+		return userentity.TradingAccount{}, ErrUnknownTradingTier
+	}
+	target, err := u.user(ctx, username)
+	if err != nil {
+		return userentity.TradingAccount{}, err
+	}
+	current, err := u.accounts.GetTradingAccount(ctx, target.Id)
+	if err != nil {
+		return userentity.TradingAccount{}, fmt.Errorf("get trading account: %w", err)
+	}
+	account, err := u.accounts.SetTradingTier(ctx, target.Id, tier, time.Now().UTC())
+	if err != nil {
+		return userentity.TradingAccount{}, fmt.Errorf("set trading tier: %w", err)
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		UserID:  target.Id,
+		Action:  userentity.AuditActionTradingTier,
+		Changes: map[string]userentity.AuditChange{"tier": {From: current.Tier, To: tier}},
+	})
+	return account, nil
+}
+
+// Adjust posts an adjustment to a user's ledger and returns the entry. An
+// adjustment posted before is returned as it was stored. The audit event
+// records the amount of the entry.
+func (u UserTradingAccountUseCase) Adjust(ctx context.Context, username string, input LedgerAdjustmentInput) (userentity.LedgerEntry, error) {
+	reference := strings.TrimSpace(input.Reference)
+	note := strings.TrimSpace(input.Note)
+	if input.Amount == 0 || reference == "" || utf8.RuneCountInString(reference) > maxAdjustmentReferenceLen || utf8.RuneCountInString(note) > maxAdjustmentNoteLen {
+		return userentity.LedgerEntry{}, ErrInvalidLedgerAdjustment
+	}
+	entry := userentity.LedgerEntry{
+		Amount:    input.Amount,
+		Type:      userentity.LedgerEntryAdjustment,
+		Note:      note,
+		CreatedAt: time.Now().UTC(),
+	}
+	if strings.TrimSpace(input.Code) != "" {
+		code, err := normalizeStockCode(input.Code)
+		if err != nil {
+			return userentity.LedgerEntry{}, err
+		}
+		entry.Code = code
+	}
+	target, err := u.user(ctx, username)
+	if err != nil {
+		return userentity.LedgerEntry{}, err
+	}
+	entry.UserID = target.Id
+
+	posted, err := u.accounts.PostLedgerEntries(ctx, adjustmentReferencePrefix+reference, []userentity.LedgerEntry{entry})
+	if err != nil {
+		if errors.Is(err, ErrStockNotFound) {
+			return userentity.LedgerEntry{}, ErrStockNotFound
+		}
+		return userentity.LedgerEntry{}, fmt.Errorf("post adjustment: %w", err)
+	}
+	field := "cash"
+	if entry.Code != "" {
+		field = "shares:" + entry.Code
+	}
+	audit.Record(ctx, userentity.AuditEvent{
+		UserID:  target.Id,
+		Action:  userentity.AuditActionLedgerAdjustment,
+		Changes: map[string]userentity.AuditChange{field: {To: strconv.FormatInt(posted[0].Amount, 10)}},
+	})
+	return posted[0], nil
+}
+
+// user returns the user an administrator names.
+func (u UserTradingAccountUseCase) user(ctx context.Context, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	target, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	return target, nil
+}
+
+// owner returns the caller's user.
+func (u UserTradingAccountUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	target, err := u.user(ctx, username)
+	if err != nil {
+		return userentity.User{}, err
+	}
+	if target.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return target, nil
+}
@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func TestUserTradingAccountUseCase(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob, err := seedUserWithToken(t, repo, "bob", "bob@example.com", "secret", "token-bob", true)
+	require.NoError(t, err)
+	seedStocks(repo, "VNM")
+	uc := NewUserTradingAccountUseCase(repo, repo, repo, repo, hoseRiskConfig)
+
+	entry, err := uc.Adjust(ctx, "alice", LedgerAdjustmentInput{Amount: 10_000_000, Reference: "opening", Note: " opening balance "})
+	require.NoError(t, err)
+	assert.Equal(t, userentity.LedgerEntryAdjustment, entry.Type)
+	assert.Equal(t, "adjustment:opening", entry.Reference)
+	assert.Equal(t, "opening balance", entry.Note)
+	again, err := uc.Adjust(ctx, "alice", LedgerAdjustmentInput{Amount: 5_000_000, Reference: "opening"})
+	require.NoError(t, err)
+	assert.Equal(t, entry.ID, again.ID, "a reference is posted once")
+	_, err = uc.Adjust(ctx, "alice", LedgerAdjustmentInput{Code: "vnm", Amount: 500, Reference: "transfer-in"})
+	require.NoError(t, err)
+
+	createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 60000, 100, time.Now().UTC())
+	createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideSell, userentity.TimeInForceGTC, 62000, 200, time.Now().UTC())
+	result, err := uc.Get(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, userentity.DefaultTradingTier, result.Account.Tier)
+	assert.Equal(t, int64(10_000_000), result.Account.Cash)
+	assert.Equal(t, int64(4_000_000), result.BuyingPower)
+	assert.Equal(t, int64(500), result.Account.Shares("VNM"))
+	assert.Equal(t, map[string]int64{"VNM": 300}, result.Available)
+
+	seedFeeSchedule(t, repo, standardFees, userentity.DefaultTradingTier, time.Now().UTC().Add(-time.Hour))
+	result, err = uc.Get(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3_990_000), result.BuyingPower, "open buy orders hold their largest fee")
+
+	_, err = uc.Get(ctx, bob.Id, "alice")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	account, err := uc.SetTier(ctx, "alice", " VIP ")
+	require.NoError(t, err)
+	assert.Equal(t, "vip", account.Tier)
+	_, err = uc.SetTier(ctx, "alice", "gold")
+	assert.ErrorIs(t, err, ErrUnknownTradingTier)
+
+	tests := []struct {
+		name  string
+		input LedgerAdjustmentInput
+		want  error
+	}{
+		{"zero amount", LedgerAdjustmentInput{Reference: "r1"}, ErrInvalidLedgerAdjustment},
+		{"no reference", LedgerAdjustmentInput{Amount: 1}, ErrInvalidLedgerAdjustment},
+		{"bad code", LedgerAdjustmentInput{Code: "VN-30", Amount: 1, Reference: "r1"}, ErrInvalidStockCode},
+		{"unlisted code", LedgerAdjustmentInput{Code: "SSI", Amount: 1, Reference: "r1"}, ErrStockNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := uc.Adjust(ctx, "alice", tc.input)
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
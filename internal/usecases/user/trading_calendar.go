@@ -0,0 +1,271 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata" // calendars name IANA zones; hosts may lack zoneinfo
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// calendarSearchDays bounds how far a calendar looks for the previous or
+// next trading day, so a calendar of holidays only cannot loop forever.
+const calendarSearchDays = 400
+
+// TradingWindow is a session of the trading day from Start until End, given
+// as HH:MM in the calendar's time zone. Session is ato, continuous or atc;
+// the time outside every window is closed.
+type TradingWindow struct {
+	Session string
+	Start   string
+	End     string
+}
+
+// TradingCalendarConfig describes when the market trades.
+type TradingCalendarConfig struct {
+	// TimeZone is the IANA zone of the windows and holidays, such as
+	// Asia/Ho_Chi_Minh.
+	TimeZone string
+	// Windows are the sessions of a trading day in time order. They may not
+	// overlap.
+	Windows []TradingWindow
+	// TradingDays are the weekdays the market trades, as mon to sun. Empty
+	// means Monday to Friday.
+	TradingDays []string
+	// Holidays are dates, as YYYY-MM-DD, the market is closed on.
+	Holidays []string
+	// SettlementDays is the settlement cycle: trades settle that many
+	// trading days after the trade date (T+N).
+	SettlementDays int
+}
+
+// TradingCalendar tells which session the market is in at any time and
+// when trades settle. The zero value is closed all the time; build
+// calendars with NewTradingCalendar.
+type TradingCalendar struct {
+	location       *time.Location
+	days           [7]bool
+	holidays       map[string]bool
+	settlementDays int
+	// daily are the transitions of every trading day, as offsets from
+	// midnight, in time order.
+	daily []dailyTransition
+}
+
+type dailyTransition struct {
+	offset time.Duration
+	from   userentity.TradingSession
+	to     userentity.TradingSession
+}
+
+var calendarWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// NewTradingCalendar checks cfg and builds its calendar.
+func NewTradingCalendar(cfg TradingCalendarConfig) (TradingCalendar, error) {
+	location, err := time.LoadLocation(cfg.TimeZone)
+	if err != nil {
+		return TradingCalendar{}, fmt.Errorf("invalid time zone %q: %w", cfg.TimeZone, err)
+	}
+	if cfg.SettlementDays < 0 {
+		return TradingCalendar{}, fmt.Errorf("invalid settlement cycle T+%d", cfg.SettlementDays)
+	}
+	calendar := TradingCalendar{location: location, holidays: make(map[string]bool, len(cfg.Holidays)), settlementDays: cfg.SettlementDays}
+
+	if len(cfg.TradingDays) == 0 {
+		for day := time.Monday; day <= time.Friday; day++ {
+			calendar.days[day] = true
+		}
+	}
+	for _, name := range cfg.TradingDays {
+		day, ok := calendarWeekdays[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return TradingCalendar{}, fmt.Errorf("invalid trading day %q", name)
+		}
+		calendar.days[day] = true
+	}
+	for _, holiday := range cfg.Holidays {
+		date, err := time.Parse(time.DateOnly, strings.TrimSpace(holiday))
+		if err != nil {
+			return TradingCalendar{}, fmt.Errorf("invalid holiday %q: %w", holiday, err)
+		}
+		calendar.holidays[date.Format(time.DateOnly)] = true
+	}
+
+	if len(cfg.Windows) == 0 {
+		return TradingCalendar{}, fmt.Errorf("a trading calendar needs at least one session window")
+	}
+	previous, previousEnd := userentity.TradingSessionClosed, time.Duration(-1)
+	for _, window := range cfg.Windows {
+		session := userentity.TradingSession(strings.ToLower(strings.TrimSpace(window.Session)))
+		if !session.Valid() || session == userentity.TradingSessionClosed {
+			return TradingCalendar{}, fmt.Errorf("invalid session %q: must be ato, continuous or atc", window.Session)
+		}
+		start, err := parseClock(window.Start)
+		if err != nil {
+			return TradingCalendar{}, err
+		}
+		end, err := parseClock(window.End)
+		if err != nil {
+			return TradingCalendar{}, err
+		}
+		if end <= start || start < previousEnd {
+			return TradingCalendar{}, fmt.Errorf("session window %s-%s must end after it starts and after the previous window", window.Start, window.End)
+		}
+		if start != previousEnd && previous != userentity.TradingSessionClosed {
+			calendar.daily = append(calendar.daily, dailyTransition{previousEnd, previous, userentity.TradingSessionClosed})
+			previous = userentity.TradingSessionClosed
+		}
+		if session != previous {
+			calendar.daily = append(calendar.daily, dailyTransition{start, previous, session})
+		}
+		previous, previousEnd = session, end
+	}
+	calendar.daily = append(calendar.daily, dailyTransition{previousEnd, previous, userentity.TradingSessionClosed})
+	return calendar, nil
+}
+
+// parseClock parses HH:MM into the time since midnight.
+func parseClock(clock string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(clock))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q: want HH:MM", clock)
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+// Location is the time zone of the calendar.
+func (c TradingCalendar) Location() *time.Location {
+	if c.location == nil {
+		return time.UTC
+	}
+	return c.location
+}
+
+// TradingDay reports whether the market trades on the day of t.
+func (c TradingCalendar) TradingDay(t time.Time) bool {
+	local := t.In(c.Location())
+	return c.days[local.Weekday()] && !c.holidays[local.Format(time.DateOnly)]
+}
+
+// SessionAt returns the session the market is in at t. A session starts at
+// the start of its window and ends at the end.
+func (c TradingCalendar) SessionAt(t time.Time) userentity.TradingSession {
+	session := userentity.TradingSessionClosed
+	if !c.TradingDay(t) {
+		return session
+	}
+	midnight := c.midnight(t)
+	for _, transition := range c.daily {
+		if midnight.Add(transition.offset).After(t) {
+			break
+		}
+		session = transition.to
+	}
+	return session
+}
+
+// Transitions returns the session changes after from up to and including
+// to, in time order.
+func (c TradingCalendar) Transitions(from, to time.Time) []userentity.TradingSessionTransition {
+	transitions := make([]userentity.TradingSessionTransition, 0)
+	for day := c.midnight(from); !day.After(to); day = c.nextDay(day) {
+		if !c.TradingDay(day) {
+			continue
+		}
+		for _, transition := range c.daily {
+			at := day.Add(transition.offset)
+			if at.After(from) && !at.After(to) {
+				transitions = append(transitions, userentity.TradingSessionTransition{At: at, From: transition.from, To: transition.to})
+			}
+		}
+	}
+	return transitions
+}
+
+// NextTransition returns the first session change after t. It reports false
+// when the market does not trade in the coming year.
+func (c TradingCalendar) NextTransition(t time.Time) (userentity.TradingSessionTransition, bool) {
+	day := c.midnight(t)
+	for i := 0; i < calendarSearchDays; i++ {
+		if c.TradingDay(day) {
+			for _, transition := range c.daily {
+				if at := day.Add(transition.offset); at.After(t) {
+					return userentity.TradingSessionTransition{At: at, From: transition.from, To: transition.to}, true
+				}
+			}
+		}
+		day = c.nextDay(day)
+	}
+	return userentity.TradingSessionTransition{}, false
+}
+
+// LastClose returns the end of the last trading day that ended at or before
+// t, or the zero time when there is none in the past year.
+func (c TradingCalendar) LastClose(t time.Time) time.Time {
+	if len(c.daily) == 0 {
+		return time.Time{}
+	}
+	closing := c.daily[len(c.daily)-1].offset
+	day := c.midnight(t)
+	for i := 0; i < calendarSearchDays; i++ {
+		if c.TradingDay(day) {
+			if end := day.Add(closing); !end.After(t) {
+				return end
+			}
+		}
+		day = c.midnight(day.Add(-time.Hour))
+	}
+	return time.Time{}
+}
+
+// SettlementDate returns the date trades made at t settle on, at midnight
+// UTC: SettlementDays trading days after the trade date, skipping weekends
+// and holidays. Trades made on a day the market does not trade count from
+// the next trading day. It returns the zero time when no such day is found
+// in the coming year.
+func (c TradingCalendar) SettlementDate(t time.Time) time.Time {
+	day := c.midnight(t)
+	for i, left := 0, c.settlementDays; ; i++ {
+		if i == calendarSearchDays {
+			return time.Time{}
+		}
+		if c.TradingDay(day) {
+			if left == 0 {
+				break
+			}
+			left--
+		}
+		day = c.nextDay(day)
+	}
+	return civilDate(day)
+}
+
+// Date returns the date of t in the calendar's zone, at midnight UTC, as
+// settlement dates are given.
+func (c TradingCalendar) Date(t time.Time) time.Time {
+	return civilDate(t.In(c.Location()))
+}
+
+// civilDate returns the year, month and day of t at midnight UTC.
+func civilDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// midnight returns the start of the day of t in the calendar's zone.
+func (c TradingCalendar) midnight(t time.Time) time.Time {
+	local := t.In(c.Location())
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.Location())
+}
+
+func (c TradingCalendar) nextDay(midnight time.Time) time.Time {
+	return time.Date(midnight.Year(), midnight.Month(), midnight.Day()+1, 0, 0, 0, 0, c.Location())
+}
@@ -0,0 +1,141 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+// hoseCalendar is the trading day of the Ho Chi Minh City Stock Exchange
+// with 2026-10-20 as a holiday.
+func hoseCalendar(t *testing.T) TradingCalendar {
+	t.Helper()
+	calendar, err := NewTradingCalendar(TradingCalendarConfig{
+		TimeZone: "Asia/Ho_Chi_Minh",
+		Windows: []TradingWindow{
+			{Session: "ato", Start: "09:00", End: "09:15"},
+			{Session: "continuous", Start: "09:15", End: "11:30"},
+			{Session: "continuous", Start: "13:00", End: "14:30"},
+			{Session: "ATC", Start: "14:30", End: "14:45"},
+		},
+		Holidays:       []string{"2026-10-20"},
+		SettlementDays: 2,
+	})
+	require.NoError(t, err)
+	return calendar
+}
+
+// vnTime is a time of day in Asia/Ho_Chi_Minh (UTC+7, no daylight saving).
+func vnTime(day string, clock string) time.Time {
+	at, err := time.Parse("2006-01-02 15:04 -0700", day+" "+clock+" +0700")
+	if err != nil {
+		panic(err)
+	}
+	return at.UTC()
+}
+
+func TestTradingCalendar_SessionAt(t *testing.T) {
+	calendar := hoseCalendar(t)
+	tests := []struct {
+		at   time.Time
+		want userentity.TradingSession
+	}{
+		{vnTime("2026-10-19", "08:59"), userentity.TradingSessionClosed},
+		{vnTime("2026-10-19", "09:00"), userentity.TradingSessionATO},
+		{vnTime("2026-10-19", "09:15"), userentity.TradingSessionContinuous},
+		{vnTime("2026-10-19", "11:30"), userentity.TradingSessionClosed},
+		{vnTime("2026-10-19", "13:00"), userentity.TradingSessionContinuous},
+		{vnTime("2026-10-19", "14:40"), userentity.TradingSessionATC},
+		{vnTime("2026-10-19", "14:45"), userentity.TradingSessionClosed},
+		{vnTime("2026-10-20", "10:00"), userentity.TradingSessionClosed}, // holiday
+		{vnTime("2026-10-24", "10:00"), userentity.TradingSessionClosed}, // Saturday
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, calendar.SessionAt(tc.at), tc.at.String())
+	}
+	assert.False(t, calendar.TradingDay(vnTime("2026-10-20", "10:00")))
+	assert.True(t, calendar.TradingDay(vnTime("2026-10-21", "10:00")))
+}
+
+func TestTradingCalendar_Transitions(t *testing.T) {
+	calendar := hoseCalendar(t)
+
+	transitions := calendar.Transitions(vnTime("2026-10-19", "09:00"), vnTime("2026-10-21", "09:15"))
+	want := []userentity.TradingSessionTransition{
+		{At: vnTime("2026-10-19", "09:15"), From: userentity.TradingSessionATO, To: userentity.TradingSessionContinuous},
+		{At: vnTime("2026-10-19", "11:30"), From: userentity.TradingSessionContinuous, To: userentity.TradingSessionClosed},
+		{At: vnTime("2026-10-19", "13:00"), From: userentity.TradingSessionClosed, To: userentity.TradingSessionContinuous},
+		{At: vnTime("2026-10-19", "14:30"), From: userentity.TradingSessionContinuous, To: userentity.TradingSessionATC},
+		{At: vnTime("2026-10-19", "14:45"), From: userentity.TradingSessionATC, To: userentity.TradingSessionClosed},
+		{At: vnTime("2026-10-21", "09:00"), From: userentity.TradingSessionClosed, To: userentity.TradingSessionATO},
+		{At: vnTime("2026-10-21", "09:15"), From: userentity.TradingSessionATO, To: userentity.TradingSessionContinuous},
+	}
+	require.Len(t, transitions, len(want))
+	for i := range want {
+		assert.True(t, want[i].At.Equal(transitions[i].At), "transition %d at %s", i, transitions[i].At)
+		assert.Equal(t, want[i].From, transitions[i].From)
+		assert.Equal(t, want[i].To, transitions[i].To)
+	}
+
+	next, ok := calendar.NextTransition(vnTime("2026-10-19", "15:00"))
+	require.True(t, ok)
+	assert.True(t, next.At.Equal(vnTime("2026-10-21", "09:00")), "the holiday is skipped")
+	assert.Equal(t, userentity.TradingSessionATO, next.To)
+
+	assert.True(t, calendar.LastClose(vnTime("2026-10-19", "14:45")).Equal(vnTime("2026-10-19", "14:45")))
+	assert.True(t, calendar.LastClose(vnTime("2026-10-19", "14:44")).Equal(vnTime("2026-10-16", "14:45")), "Friday before")
+	assert.True(t, calendar.LastClose(vnTime("2026-10-21", "08:00")).Equal(vnTime("2026-10-19", "14:45")))
+}
+
+func TestTradingCalendar_SettlementDate(t *testing.T) {
+	calendar := hoseCalendar(t)
+
+	tests := []struct {
+		name  string
+		trade time.Time
+		want  string
+	}{
+		{"skips the holiday", vnTime("2026-10-19", "10:00"), "2026-10-22"},
+		{"skips the weekend", vnTime("2026-10-22", "14:45"), "2026-10-26"},
+		{"local date", vnTime("2026-10-23", "06:00"), "2026-10-27"},
+		{"weekend trade counts from monday", vnTime("2026-10-24", "10:00"), "2026-10-28"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			date := calendar.SettlementDate(tc.trade)
+			assert.Equal(t, tc.want, date.Format(time.DateOnly))
+			assert.Equal(t, time.UTC, date.Location())
+		})
+	}
+}
+
+func TestNewTradingCalendar_Invalid(t *testing.T) {
+	valid := []TradingWindow{{Session: "continuous", Start: "09:00", End: "15:00"}}
+	tests := []struct {
+		name string
+		cfg  TradingCalendarConfig
+	}{
+		{"unknown zone", TradingCalendarConfig{TimeZone: "Mars/Olympus", Windows: valid}},
+		{"no windows", TradingCalendarConfig{TimeZone: "UTC"}},
+		{"closed window", TradingCalendarConfig{TimeZone: "UTC", Windows: []TradingWindow{{Session: "closed", Start: "09:00", End: "10:00"}}}},
+		{"bad clock", TradingCalendarConfig{TimeZone: "UTC", Windows: []TradingWindow{{Session: "ato", Start: "9h", End: "10:00"}}}},
+		{"empty window", TradingCalendarConfig{TimeZone: "UTC", Windows: []TradingWindow{{Session: "ato", Start: "10:00", End: "10:00"}}}},
+		{"overlap", TradingCalendarConfig{TimeZone: "UTC", Windows: []TradingWindow{
+			{Session: "ato", Start: "09:00", End: "09:30"},
+			{Session: "continuous", Start: "09:15", End: "11:00"},
+		}}},
+		{"bad day", TradingCalendarConfig{TimeZone: "UTC", Windows: valid, TradingDays: []string{"monday"}}},
+		{"bad holiday", TradingCalendarConfig{TimeZone: "UTC", Windows: valid, Holidays: []string{"20/10/2026"}}},
+		{"negative settlement", TradingCalendarConfig{TimeZone: "UTC", Windows: valid, SettlementDays: -1}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewTradingCalendar(tc.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
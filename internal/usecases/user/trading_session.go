@@ -0,0 +1,270 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+// UserTradingSessionUseCase moves the market through the sessions of its
+// trading calendar. At the end of the ATO and ATC sessions it runs a call
+// auction over the open orders of every stock, and after the close of a
+// trading day it expires the DAY orders placed before it.
+//
+// The auctions match the orders of this service's users with each other,
+// never a user's buy with the same user's sell; an order filled by a match
+// is reported like any other fill. Run one
+// scheduler at a time: auctions started from two replicas can record
+// different matches.
+type UserTradingSessionUseCase struct {
+	orders   ports.OrderRepository
+	stocks   ports.StockRepository
+	calendar TradingCalendar
+	// dayOrders expires DAY orders with the same rules as the order API.
+	dayOrders UserOrderUseCase
+	// fees prices the fills of auctions; without it they are free.
+	fees *FeeEngine
+}
+
+// TradingSessionConfig holds the optional parts of
+// UserTradingSessionUseCase. A nil part is left out.
+type TradingSessionConfig struct {
+	// Fees charges the fills of call auctions the fees and taxes it prices.
+	Fees *FeeEngine
+}
+
+func NewUserTradingSessionUseCase(users ports.UserRepository, orders ports.OrderRepository, stocks ports.StockRepository, calendar TradingCalendar, config TradingSessionConfig) UserTradingSessionUseCase {
+	return UserTradingSessionUseCase{
+		orders:    orders,
+		stocks:    stocks,
+		calendar:  calendar,
+		dayOrders: NewUserOrderUseCase(users, orders, OrderConfig{Calendar: &calendar}),
+		fees:      config.Fees,
+	}
+}
+
+// MarketSession is the session of the market at a point in time.
+type MarketSession struct {
+	Session    userentity.TradingSession
+	TradingDay bool
+	TimeZone   string
+	// Next is the next session change. It is zero when the market does not
+	// trade in the coming year.
+	Next userentity.TradingSessionTransition
+}
+
+// TradingSessionReport is what Advance did.
+type TradingSessionReport struct {
+	Transitions []userentity.TradingSessionTransition
+	// Matches is how many matches the call auctions recorded.
+	Matches int
+	// Expired is how many DAY orders expired.
+	Expired int
+}
+
+// auctionMatch is a buy and a sell order crossed by a call auction.
+type auctionMatch struct {
+	buy      int64
+	sell     int64
+	quantity int64
+}
+
+// Session returns the session of the market at now.
+func (u UserTradingSessionUseCase) Session(now time.Time) MarketSession {
+	session := MarketSession{
+		Session:    u.calendar.SessionAt(now),
+		TradingDay: u.calendar.TradingDay(now),
+		TimeZone:   u.calendar.Location().String(),
+	}
+	session.Next, _ = u.calendar.NextTransition(now)
+	return session
+}
+
+// Advance handles the session changes after from up to and including to:
+// the call auction of every ATO and ATC session that ended runs at the
+// session's end. It then expires the open DAY orders placed before the last
+// close. A failed auction stops Advance; the changes that follow are not
+// handled.
+func (u UserTradingSessionUseCase) Advance(ctx context.Context, from, to time.Time) (TradingSessionReport, error) {
+	report := TradingSessionReport{Transitions: u.calendar.Transitions(from, to)}
+	for _, transition := range report.Transitions {
+		if !transition.From.CallAuction() {
+			continue
+		}
+		matches, err := u.runCallAuction(ctx, transition)
+		report.Matches += matches
+		if err != nil {
+			return report, err
+		}
+	}
+	if lastClose := u.calendar.LastClose(to); !lastClose.IsZero() {
+		expired, err := u.dayOrders.ExpireDayOrders(ctx, lastClose)
+		if err != nil {
+			return report, err
+		}
+		report.Expired = expired
+	}
+	return report, nil
+}
+
+// runCallAuction crosses the open orders of every stock at a single price
+// per stock and records the matches at the end of the auction session.
+// Execution ids name the auction and its orders, so running an auction
+// again records nothing new; a match whose orders changed since they were
+// listed is skipped.
+func (u UserTradingSessionUseCase) runCallAuction(ctx context.Context, transition userentity.TradingSessionTransition) (int, error) {
+	open, err := u.orders.ListOpenOrders(ctx, 0)
+	if err != nil {
+		return 0, fmt.Errorf("list open orders: %w", err)
+	}
+	books := make(map[int64][]userentity.Order)
+	codes := make(map[int64]string)
+	for _, order := range open {
+		books[order.StockID] = append(books[order.StockID], order)
+		codes[order.StockID] = order.Code
+	}
+	stockIDs := make([]int64, 0, len(books))
+	codeList := make([]string, 0, len(books))
+	for stockID := range books {
+		stockIDs = append(stockIDs, stockID)
+		codeList = append(codeList, codes[stockID])
+	}
+	sort.Slice(stockIDs, func(i, j int) bool { return stockIDs[i] < stockIDs[j] })
+
+	references := make(map[string]int64, len(codeList))
+	if len(codeList) > 0 {
+		quotes, err := u.stocks.LatestStockQuotes(ctx, codeList)
+		if err != nil {
+			return 0, fmt.Errorf("latest stock quotes: %w", err)
+		}
+		for _, quote := range quotes {
+			references[quote.Code] = quote.Price
+		}
+	}
+
+	day := transition.At.In(u.calendar.Location()).Format("20060102")
+	settlesOn := u.calendar.SettlementDate(transition.At)
+	recorded := 0
+	for _, stockID := range stockIDs {
+		price, matches := matchCallAuction(books[stockID], references[codes[stockID]])
+		for _, match := range matches {
+			params := ports.RecordOrderMatchParams{
+				BuyOrderID:  match.buy,
+				SellOrderID: match.sell,
+				ExecutionID: fmt.Sprintf("%s-%s-%d-%d", transition.From, day, match.buy, match.sell),
+				Price:       price,
+				Quantity:    match.quantity,
+				At:          transition.At,
+				SettlesOn:   settlesOn,
+			}
+			if u.fees != nil {
+				params.Pricer = u.fees.Charges
+			}
+			_, _, err = u.orders.RecordOrderMatch(ctx, params)
+			if err != nil {
+				if errors.Is(err, apperrors.ErrFailedPrecondition) {
+					continue
+				}
+				return recorded, fmt.Errorf("record %s auction match: %w", transition.From, err)
+			}
+			recorded++
+		}
+	}
+	return recorded, nil
+}
+
+// matchCallAuction finds the price that crosses the most shares of a book of
+// open orders on one stock, given by priority, and the matches at that
+// price. Ties go to the price leaving the smallest imbalance, then to the
+// price closest to reference, the latest price of the stock (zero when
+// unknown), then to the lowest. Buys with the highest limit match first and
+// sells with the lowest, each side by priority within a price. A buy skips
+// the sells of its own user, so the auction records no wash trades; the
+// shares they would have crossed stay open.
+func matchCallAuction(book []userentity.Order, reference int64) (int64, []auctionMatch) {
+	var buys, sells []userentity.Order
+	prices := make(map[int64]bool)
+	for _, order := range book {
+		if order.Remaining() <= 0 {
+			continue
+		}
+		if order.Side == userentity.OrderSideBuy {
+			buys = append(buys, order)
+		} else {
+			sells = append(sells, order)
+		}
+		prices[order.Price] = true
+	}
+
+	var (
+		best                      int64
+		bestVolume, bestImbalance int64
+		bestDistance              int64
+	)
+	for price := range prices {
+		var demand, supply int64
+		for _, order := range buys {
+			if order.Price >= price {
+				demand += order.Remaining()
+			}
+		}
+		for _, order := range sells {
+			if order.Price <= price {
+				supply += order.Remaining()
+			}
+		}
+		volume, imbalance := min(demand, supply), abs64(demand-supply)
+		distance := int64(0)
+		if reference > 0 {
+			distance = abs64(price - reference)
+		}
+		better := volume > bestVolume ||
+			(volume == bestVolume && imbalance < bestImbalance) ||
+			(volume == bestVolume && imbalance == bestImbalance && distance < bestDistance) ||
+			(volume == bestVolume && imbalance == bestImbalance && distance == bestDistance && price < best)
+		if volume > 0 && (best == 0 || better) {
+			best, bestVolume, bestImbalance, bestDistance = price, volume, imbalance, distance
+		}
+	}
+	if best == 0 {
+		return 0, nil
+	}
+
+	sort.SliceStable(buys, func(i, j int) bool { return buys[i].Price > buys[j].Price })
+	sort.SliceStable(sells, func(i, j int) bool { return sells[i].Price < sells[j].Price })
+	unsold := make([]int64, len(sells))
+	for s, sell := range sells {
+		unsold[s] = sell.Remaining()
+	}
+	matches := make([]auctionMatch, 0)
+	for _, buy := range buys {
+		if buy.Price < best {
+			break
+		}
+		unbought := buy.Remaining()
+		for s := 0; s < len(sells) && sells[s].Price <= best && unbought > 0; s++ {
+			// A user's buy never trades with the same user's sell.
+			if unsold[s] == 0 || sells[s].UserID == buy.UserID {
+				continue
+			}
+			quantity := min(unbought, unsold[s])
+			matches = append(matches, auctionMatch{buy: buy.ID, sell: sells[s].ID, quantity: quantity})
+			unbought -= quantity
+			unsold[s] -= quantity
+		}
+	}
+	return best, matches
+}
+
+func abs64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,206 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func createOrder(t *testing.T, repo *database.InMemoryUserRepository, userID int64, code string, side userentity.OrderSide, tif userentity.TimeInForce, price, quantity int64, at time.Time) userentity.Order {
+	t.Helper()
+	order, err := repo.CreateOrder(context.Background(), userentity.Order{
+		UserID:      userID,
+		Code:        code,
+		Side:        side,
+		TimeInForce: tif,
+		Price:       price,
+		Quantity:    quantity,
+		CreatedAt:   at,
+	}, nil)
+	require.NoError(t, err)
+	return order
+}
+
+func TestMatchCallAuction(t *testing.T) {
+	order := func(id int64, side userentity.OrderSide, price, quantity int64) userentity.Order {
+		return userentity.Order{ID: id, UserID: id, Side: side, Price: price, Quantity: quantity}
+	}
+	buy, sell := userentity.OrderSideBuy, userentity.OrderSideSell
+
+	// Demand at 101 is 300 and supply 300: the only price crossing 300.
+	price, matches := matchCallAuction([]userentity.Order{
+		order(1, buy, 102, 100),
+		order(2, sell, 100, 200),
+		order(3, buy, 101, 200),
+		order(4, sell, 101, 100),
+		order(5, buy, 99, 500),
+		order(6, sell, 103, 500),
+	}, 0)
+	assert.Equal(t, int64(101), price)
+	assert.Equal(t, []auctionMatch{
+		{buy: 1, sell: 2, quantity: 100},
+		{buy: 3, sell: 2, quantity: 100},
+		{buy: 3, sell: 4, quantity: 100},
+	}, matches)
+
+	// Volume and imbalance tie between 100 and 102; the reference decides.
+	book := []userentity.Order{order(1, buy, 102, 100), order(2, sell, 100, 100)}
+	price, _ = matchCallAuction(book, 102)
+	assert.Equal(t, int64(102), price)
+	price, _ = matchCallAuction(book, 0)
+	assert.Equal(t, int64(100), price, "lowest without a reference")
+
+	price, matches = matchCallAuction([]userentity.Order{order(1, buy, 99, 100), order(2, sell, 100, 100)}, 0)
+	assert.Zero(t, price, "nothing crosses")
+	assert.Empty(t, matches)
+
+	// Remaining quantities take part, by priority within a price.
+	partly := order(1, sell, 100, 300)
+	partly.FilledQuantity = 200
+	_, matches = matchCallAuction([]userentity.Order{partly, order(2, sell, 100, 100), order(3, buy, 100, 150)}, 0)
+	assert.Equal(t, []auctionMatch{{buy: 3, sell: 1, quantity: 100}, {buy: 3, sell: 2, quantity: 50}}, matches)
+
+	// A buy passes over the sells of its own user to the next sell.
+	own := order(3, buy, 101, 200)
+	own.UserID = 2
+	price, matches = matchCallAuction([]userentity.Order{order(1, sell, 100, 100), order(2, sell, 100, 100), own}, 0)
+	assert.Equal(t, int64(100), price)
+	assert.Equal(t, []auctionMatch{{buy: 3, sell: 1, quantity: 100}}, matches)
+	_, matches = matchCallAuction([]userentity.Order{order(2, sell, 100, 100), own}, 0)
+	assert.Empty(t, matches, "no wash trade")
+}
+
+func TestUserTradingSessionUseCase_Advance(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob := seedTradingUser(t, repo, "bob")
+	seedStocks(repo, "VNM", "FPT")
+	require.NoError(t, repo.RecordStockPrice("VNM", 75000, vnTime("2026-10-16", "14:45")))
+	uc := NewUserTradingSessionUseCase(repo, repo, repo, hoseCalendar(t), TradingSessionConfig{})
+
+	assert.Equal(t, userentity.TradingSessionATO, uc.Session(vnTime("2026-10-19", "09:05")).Session)
+	closed := uc.Session(vnTime("2026-10-19", "20:00"))
+	assert.Equal(t, userentity.TradingSessionClosed, closed.Session)
+	assert.True(t, closed.TradingDay)
+	assert.True(t, closed.Next.At.Equal(vnTime("2026-10-21", "09:00")))
+
+	preOpen := vnTime("2026-10-19", "09:05")
+	buy := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceDAY, 76000, 300, preOpen)
+	sell := createOrder(t, repo, bob.Id, "VNM", userentity.OrderSideSell, userentity.TimeInForceGTC, 74000, 200, preOpen)
+	apart := createOrder(t, repo, bob.Id, "FPT", userentity.OrderSideSell, userentity.TimeInForceDAY, 120000, 100, preOpen)
+
+	report, err := uc.Advance(ctx, vnTime("2026-10-19", "09:14"), vnTime("2026-10-19", "09:16"))
+	require.NoError(t, err)
+	require.Len(t, report.Transitions, 1)
+	assert.Equal(t, 1, report.Matches)
+	assert.Zero(t, report.Expired, "DAY orders of the session stay")
+
+	// Both limits cross 200 shares, leave the same imbalance and are as far
+	// from the latest price, so the lower one is the auction price.
+	filled, _, err := NewUserOrderUseCase(repo, repo, OrderConfig{}).Get(ctx, alice.Id, "alice", buy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusPartiallyFilled, filled.Status)
+	assert.Equal(t, int64(200), filled.FilledQuantity)
+	assert.Equal(t, int64(74000), filled.AveragePrice())
+	sold, err := repo.GetOrder(ctx, sell.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusFilled, sold.Status)
+
+	// Running the same auction again records nothing new.
+	report, err = uc.Advance(ctx, vnTime("2026-10-19", "09:14"), vnTime("2026-10-19", "09:16"))
+	require.NoError(t, err)
+	assert.Zero(t, report.Matches)
+
+	// The closing auction crosses the rest, then the DAY orders expire.
+	late := createOrder(t, repo, bob.Id, "VNM", userentity.OrderSideSell, userentity.TimeInForceDAY, 76000, 50, vnTime("2026-10-19", "14:35"))
+	report, err = uc.Advance(ctx, vnTime("2026-10-19", "14:40"), vnTime("2026-10-19", "14:46"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Matches)
+	assert.Equal(t, 2, report.Expired)
+
+	for id, want := range map[int64]userentity.OrderStatus{
+		buy.ID:   userentity.OrderStatusExpired,
+		late.ID:  userentity.OrderStatusFilled,
+		apart.ID: userentity.OrderStatusExpired,
+	} {
+		got, err := repo.GetOrder(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, want, got.Status, "order %d", id)
+	}
+	got, err := repo.GetOrder(ctx, buy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(250), got.FilledQuantity)
+	assert.Equal(t, orderReasonSessionDone, got.Reason)
+}
+
+func TestUserTradingSessionUseCase_AdvanceSkipsInactiveOwners(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	bob := seedTradingUser(t, repo, "bob")
+	seedStocks(repo, "VNM")
+	uc := NewUserTradingSessionUseCase(repo, repo, repo, hoseCalendar(t), TradingSessionConfig{})
+
+	friday := vnTime("2026-10-16", "10:00")
+	buy := createOrder(t, repo, alice.Id, "VNM", userentity.OrderSideBuy, userentity.TimeInForceGTC, 76000, 100, friday)
+	sell := createOrder(t, repo, bob.Id, "VNM", userentity.OrderSideSell, userentity.TimeInForceGTC, 74000, 100, friday)
+	_, err := NewUserAccountStatusUseCase(repo).Deactivate(ctx, bob.Id, "bob")
+	require.NoError(t, err)
+
+	// A deactivated owner cannot cancel the sell, so it leaves the book
+	// instead of being filled by the next auction.
+	report, err := uc.Advance(ctx, vnTime("2026-10-19", "09:14"), vnTime("2026-10-19", "09:16"))
+	require.NoError(t, err)
+	assert.Zero(t, report.Matches)
+	cancelled, err := repo.GetOrder(ctx, sell.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusCancelled, cancelled.Status)
+	open, err := repo.GetOrder(ctx, buy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, userentity.OrderStatusNew, open.Status)
+}
+
+func TestUserOrderUseCase_MarketHours(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedTradingUser(t, repo, "alice")
+	seedStocks(repo, "HPG")
+	open := NewUserOrderUseCase(repo, repo, OrderConfig{})
+	order, err := open.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "HPG", Side: "buy", TimeInForce: "GTC", Price: 28000, Quantity: 100})
+	require.NoError(t, err)
+
+	// The zero calendar never trades.
+	closed := NewUserOrderUseCase(repo, repo, OrderConfig{Calendar: &TradingCalendar{}})
+	_, err = closed.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "HPG", Side: "buy", Price: 28000, Quantity: 100})
+	assert.ErrorIs(t, err, ErrMarketClosed)
+	_, err = closed.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Price: 27000})
+	assert.ErrorIs(t, err, ErrMarketClosed)
+
+	if now := time.Now().UTC(); now.Hour() == 23 && now.Minute() == 59 {
+		t.Skip("the all-day auction calendar closes at 23:59 UTC")
+	}
+	auctionCalendar, err := NewTradingCalendar(TradingCalendarConfig{
+		TimeZone:    "UTC",
+		Windows:     []TradingWindow{{Session: "ato", Start: "00:00", End: "23:59"}},
+		TradingDays: []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+	})
+	require.NoError(t, err)
+	auction := NewUserOrderUseCase(repo, repo, OrderConfig{Calendar: &auctionCalendar})
+	_, err = auction.Place(ctx, alice.Id, "alice", PlaceOrderInput{Code: "HPG", Side: "buy", Price: 28000, Quantity: 100})
+	assert.NoError(t, err, "limit orders join the auction")
+	_, err = auction.Amend(ctx, alice.Id, "alice", order.ID, AmendOrderInput{Price: 27000})
+	assert.ErrorIs(t, err, ErrOrderInCallAuction)
+	_, err = auction.Cancel(ctx, alice.Id, "alice", order.ID)
+	assert.ErrorIs(t, err, ErrOrderInCallAuction)
+
+	cancelled, err := closed.Cancel(ctx, alice.Id, "alice", order.ID)
+	require.NoError(t, err, "orders are cancelled while the market is closed")
+	assert.Equal(t, userentity.OrderStatusCancelled, cancelled.Status)
+}
@@ -2,10 +2,12 @@ package user
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
 	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 )
@@ -18,6 +20,31 @@ func NewUserUpdateUseCase(repo ports.UserRepository) UserUpdateUseCase {
 	return UserUpdateUseCase{repository: repo}
 }
 
+// Updatable profile fields, named after the UpdateUserRequest proto fields so
+// google.protobuf.FieldMask paths can be passed through unchanged.
+const (
+	UpdateFieldEmail            = "email"
+	UpdateFieldName             = "name"
+	UpdateFieldCmnd             = "cmnd"
+	UpdateFieldBirthday         = "birthday"
+	UpdateFieldGender           = "gender"
+	UpdateFieldPermanentAddress = "permanent_address"
+	UpdateFieldPhoneNumber      = "phone_number"
+
+	// UpdateFieldAll replaces every updatable field.
+	UpdateFieldAll = "*"
+)
+
+var updatableFields = []string{
+	UpdateFieldEmail,
+	UpdateFieldName,
+	UpdateFieldCmnd,
+	UpdateFieldBirthday,
+	UpdateFieldGender,
+	UpdateFieldPermanentAddress,
+	UpdateFieldPhoneNumber,
+}
+
 type RequestUpdate struct {
 	Email            string
 	Name             string
@@ -26,45 +53,169 @@ type RequestUpdate struct {
 	Gender           bool
 	PermanentAddress string
 	PhoneNumber      string
+
+	// Fields lists the fields to update. When empty, only fields holding a
+	// non-zero value are updated, so omitted fields keep their stored value.
+	Fields []string
+	// ExpectedVersion, when non-zero, must match the stored user version.
+	ExpectedVersion int64
 }
 
 var (
-	ErrUpdateEmptyUsername = errors.New("username is empty")
-	ErrUpdateEmptyEmail    = errors.New("email is empty")
-	ErrUpdateEmptyName     = errors.New("name is empty")
+	ErrUpdateEmptyUsername   = apperrors.New(apperrors.ErrInvalidArgument, "USERNAME_REQUIRED", "username is empty")
+	ErrUpdateEmptyEmail      = apperrors.New(apperrors.ErrInvalidArgument, "EMAIL_REQUIRED", "email is empty")
+	ErrUpdateEmptyName       = apperrors.New(apperrors.ErrInvalidArgument, "NAME_REQUIRED", "name is empty")
+	ErrUpdateInvalidField    = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_UPDATE_MASK", "unknown field in update mask")
+	ErrUpdateNoFields        = apperrors.New(apperrors.ErrInvalidArgument, "UPDATE_MASK_EMPTY", "no fields to update")
+	ErrUpdateVersionMismatch = apperrors.New(apperrors.ErrAborted, "USER_VERSION_CONFLICT", "user was modified concurrently")
+	ErrUpdateEmailChange     = apperrors.New(apperrors.ErrFailedPrecondition, "EMAIL_CHANGE_REQUIRES_CONFIRMATION", "email can only be changed through RequestEmailChange")
 )
 
-func (u UserUpdateUseCase) UpdateProfile(ctx context.Context, username string, req RequestUpdate) error {
+// UpdateProfile applies the requested fields on top of the stored profile and
+// returns the updated user. The write is conditional on the version that was
+// read, so concurrent updates cannot silently overwrite each other.
+func (u UserUpdateUseCase) UpdateProfile(ctx context.Context, username string, req RequestUpdate) (userentity.User, error) {
 	if username == "" {
-		return ErrUpdateEmptyUsername
+		return userentity.User{}, ErrUpdateEmptyUsername
+	}
+	fields, err := resolveUpdateFields(req)
+	if err != nil {
+		return userentity.User{}, err
 	}
-	if req.Email == "" {
-		return ErrUpdateEmptyEmail
+	if _, ok := fields[UpdateFieldEmail]; ok && req.Email == "" {
+		return userentity.User{}, ErrUpdateEmptyEmail
 	}
-	if req.Name == "" {
-		return ErrUpdateEmptyName
+	if _, ok := fields[UpdateFieldName]; ok && req.Name == "" {
+		return userentity.User{}, ErrUpdateEmptyName
 	}
 
 	current, err := u.repository.GetUser(ctx, username)
 	if err != nil {
-		return fmt.Errorf("get user: %w", err)
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if req.ExpectedVersion != 0 && req.ExpectedVersion != current.Version {
+		return userentity.User{}, ErrUpdateVersionMismatch
+	}
+	// Email changes must be confirmed from the new address; resending the
+	// current email (e.g. a full profile PATCH) is accepted as a no-op.
+	if _, ok := fields[UpdateFieldEmail]; ok && !strings.EqualFold(req.Email, current.Email) {
+		return userentity.User{}, ErrUpdateEmailChange
 	}
 
-	updated := userentity.User{
-		Id:               current.Id,
-		Name:             req.Name,
-		Email:            req.Email,
-		DocumentID:       req.Cmnd,
-		Birthday:         time.Unix(req.Birthday, 0),
-		Gender:           req.Gender,
-		PermanentAddress: req.PermanentAddress,
-		PhoneNumber:      req.PhoneNumber,
-		CreatedAt:        current.CreatedAt,
-		UpdatedAt:        time.Now().UTC(),
+	updated := current
+	for field := range fields {
+		switch field {
+		case UpdateFieldName:
+			updated.Name = req.Name
+		case UpdateFieldCmnd:
+			updated.DocumentID = req.Cmnd
+		case UpdateFieldBirthday:
+			updated.Birthday = time.Unix(req.Birthday, 0)
+		case UpdateFieldGender:
+			updated.Gender = req.Gender
+		case UpdateFieldPermanentAddress:
+			updated.PermanentAddress = req.PermanentAddress
+		case UpdateFieldPhoneNumber:
+			updated.PhoneNumber = req.PhoneNumber
+		}
 	}
+	updated.UpdatedAt = time.Now().UTC()
 
 	if err := u.repository.UpdateUser(ctx, username, updated); err != nil {
-		return fmt.Errorf("update user: %w", err)
+		return userentity.User{}, fmt.Errorf("update user: %w", err)
+	}
+	updated.Version = current.Version + 1
+	audit.Record(ctx, userentity.AuditEvent{
+		UserID:  current.Id,
+		Action:  userentity.AuditActionProfileUpdate,
+		Changes: profileChanges(current, updated),
+	})
+	return updated, nil
+}
+
+func resolveUpdateFields(req RequestUpdate) (map[string]struct{}, error) {
+	fields := make(map[string]struct{}, len(updatableFields))
+	if len(req.Fields) == 0 {
+		populated := map[string]bool{
+			UpdateFieldEmail:            req.Email != "",
+			UpdateFieldName:             req.Name != "",
+			UpdateFieldCmnd:             req.Cmnd != "",
+			UpdateFieldBirthday:         req.Birthday != 0,
+			UpdateFieldGender:           req.Gender,
+			UpdateFieldPermanentAddress: req.PermanentAddress != "",
+			UpdateFieldPhoneNumber:      req.PhoneNumber != "",
+		}
+		for field, ok := range populated {
+			if ok {
+				fields[field] = struct{}{}
+			}
+		}
+	}
+	for _, path := range req.Fields {
+		path = strings.TrimSpace(path)
+		if path == UpdateFieldAll {
+			for _, field := range updatableFields {
+				fields[field] = struct{}{}
+			}
+			continue
+		}
+		if !isUpdatableField(path) {
+			return nil, fmt.Errorf("%w: %q", ErrUpdateInvalidField, path)
+		}
+		fields[path] = struct{}{}
+	}
+	if len(fields) == 0 {
+		return nil, ErrUpdateNoFields
+	}
+	return fields, nil
+}
+
+func isUpdatableField(field string) bool {
+	for _, f := range updatableFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// profileChanges lists the fields that differ between before and after. The
+// values of sensitive fields are left out so the audit log does not hold the
+// data that is encrypted at rest.
+func profileChanges(before, after userentity.User) map[string]userentity.AuditChange {
+	changes := map[string]userentity.AuditChange{}
+	plain := []struct {
+		field         string
+		before, after string
+	}{
+		{UpdateFieldName, before.Name, after.Name},
+		{UpdateFieldGender, genderLabel(before.Gender), genderLabel(after.Gender)},
+	}
+	for _, f := range plain {
+		if f.before != f.after {
+			changes[f.field] = userentity.AuditChange{From: f.before, To: f.after}
+		}
+	}
+	sensitive := []struct {
+		field   string
+		changed bool
+	}{
+		{UpdateFieldCmnd, before.DocumentID != after.DocumentID},
+		{UpdateFieldBirthday, !before.Birthday.Equal(after.Birthday)},
+		{UpdateFieldPermanentAddress, before.PermanentAddress != after.PermanentAddress},
+		{UpdateFieldPhoneNumber, before.PhoneNumber != after.PhoneNumber},
+	}
+	for _, f := range sensitive {
+		if f.changed {
+			changes[f.field] = userentity.AuditChange{Redacted: true}
+		}
+	}
+	return changes
+}
+
+func genderLabel(male bool) string {
+	if male {
+		return "male"
 	}
-	return nil
+	return "female"
 }
@@ -6,15 +6,15 @@ import (
 	"time"
 
 	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
 	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 	"github.com/stretchr/testify/require"
 )
 
-func TestUserUpdateUseCase_UpdateProfile(t *testing.T) {
-	repo := database.NewInMemoryUserRepository()
+func seedUpdateUser(t *testing.T, repo *database.InMemoryUserRepository) userentity.User {
+	t.Helper()
 	ctx := context.Background()
-
 	original := userentity.User{
 		Username:         "alice123",
 		Name:             "Alice",
@@ -25,7 +25,7 @@ func TestUserUpdateUseCase_UpdateProfile(t *testing.T) {
 		PermanentAddress: "HN",
 		PhoneNumber:      "0123456789",
 	}
-	_, err := repo.CreateUserWithVerification(ctx, ports.CreateUserWithVerificationParams{
+	created, err := repo.CreateUserWithVerification(ctx, ports.CreateUserWithVerificationParams{
 		User:  original,
 		Login: userentity.LoginMethodPassword{UserName: "alice123", Password: "hashed"},
 		Token: userentity.VerificationToken{Token: "token-update", Purpose: userentity.VerificationPurposeRegister, ExpiresAt: time.Now().Add(24 * time.Hour), CreatedAt: time.Now()},
@@ -39,35 +39,117 @@ func TestUserUpdateUseCase_UpdateProfile(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
+	return created
+}
+
+func TestUserUpdateUseCase_UpdateProfile(t *testing.T) {
+	repo := database.NewInMemoryUserRepository()
+	ctx := context.Background()
+	seedUpdateUser(t, repo)
 
 	uc := NewUserUpdateUseCase(repo)
-	err = uc.UpdateProfile(ctx, "alice123", RequestUpdate{
-		Email:            "alice+new@example.com",
+	result, err := uc.UpdateProfile(ctx, "alice123", RequestUpdate{
+		Email:            "alice@example.com",
 		Name:             "Alice Updated",
 		Cmnd:             "CMND999",
 		Birthday:         time.Unix(978307200, 0).Unix(),
 		Gender:           false,
 		PermanentAddress: "SG",
 		PhoneNumber:      "0987654321",
+		Fields:           []string{UpdateFieldAll},
 	})
 	require.NoError(t, err)
 
 	updated, err := repo.GetUser(ctx, "alice123")
 	require.NoError(t, err)
+	require.Equal(t, result, updated)
 	require.Equal(t, "Alice Updated", updated.Name)
-	require.Equal(t, "alice+new@example.com", updated.Email)
+	require.Equal(t, "alice@example.com", updated.Email)
 	require.Equal(t, "CMND999", updated.DocumentID)
 	require.Equal(t, int64(978307200), updated.Birthday.Unix())
 	require.False(t, updated.Gender)
 	require.Equal(t, "SG", updated.PermanentAddress)
 	require.Equal(t, "0987654321", updated.PhoneNumber)
 	require.False(t, updated.UpdatedAt.IsZero())
+	require.Equal(t, int64(2), updated.Version)
+}
+
+func TestUserUpdateUseCase_PartialUpdateKeepsOmittedFields(t *testing.T) {
+	repo := database.NewInMemoryUserRepository()
+	ctx := context.Background()
+	seedUpdateUser(t, repo)
+	_, err := repo.VerifyUserWithToken(ctx, 1, 1, time.Now().UTC())
+	require.NoError(t, err)
+
+	uc := NewUserUpdateUseCase(repo)
+
+	// Without a mask only populated fields are applied.
+	_, err = uc.UpdateProfile(ctx, "alice123", RequestUpdate{Name: "Alice Nguyen"})
+	require.NoError(t, err)
+
+	// With a mask, zero values are applied only to the listed fields.
+	_, err = uc.UpdateProfile(ctx, "alice123", RequestUpdate{Fields: []string{UpdateFieldGender}})
+	require.NoError(t, err)
+
+	updated, err := repo.GetUser(ctx, "alice123")
+	require.NoError(t, err)
+	require.Equal(t, "Alice Nguyen", updated.Name)
+	require.Equal(t, "alice@example.com", updated.Email)
+	require.Equal(t, int64(946684800), updated.Birthday.Unix(), "birthday must not be reset to the epoch")
+	require.Equal(t, "HN", updated.PermanentAddress)
+	require.False(t, updated.Gender)
+	require.True(t, updated.Verified)
+}
+
+func TestUserUpdateUseCase_VersionMismatch(t *testing.T) {
+	repo := database.NewInMemoryUserRepository()
+	ctx := context.Background()
+	created := seedUpdateUser(t, repo)
+
+	uc := NewUserUpdateUseCase(repo)
+	first, err := uc.UpdateProfile(ctx, "alice123", RequestUpdate{Name: "First Writer", ExpectedVersion: created.Version})
+	require.NoError(t, err)
+	require.Equal(t, created.Version+1, first.Version)
+
+	_, err = uc.UpdateProfile(ctx, "alice123", RequestUpdate{Name: "Second Writer", ExpectedVersion: created.Version})
+	require.ErrorIs(t, err, ErrUpdateVersionMismatch)
+	require.ErrorIs(t, err, apperrors.ErrAborted)
+
+	current, err := repo.GetUser(ctx, "alice123")
+	require.NoError(t, err)
+	require.Equal(t, "First Writer", current.Name)
+}
+
+func TestUserUpdateUseCase_EmailChangeRequiresConfirmation(t *testing.T) {
+	repo := database.NewInMemoryUserRepository()
+	ctx := context.Background()
+	seedUpdateUser(t, repo)
+
+	uc := NewUserUpdateUseCase(repo)
+	_, err := uc.UpdateProfile(ctx, "alice123", RequestUpdate{Email: "mallory@example.com"})
+	require.ErrorIs(t, err, ErrUpdateEmailChange)
+
+	current, err := repo.GetUser(ctx, "alice123")
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", current.Email)
+}
+
+func TestUserUpdateUseCase_InvalidMask(t *testing.T) {
+	repo := database.NewInMemoryUserRepository()
+	uc := NewUserUpdateUseCase(repo)
+
+	_, err := uc.UpdateProfile(context.Background(), "alice123", RequestUpdate{Fields: []string{"username"}})
+	require.ErrorIs(t, err, ErrUpdateInvalidField)
+	require.Equal(t, "INVALID_UPDATE_MASK", apperrors.ReasonOf(err))
+
+	_, err = uc.UpdateProfile(context.Background(), "alice123", RequestUpdate{})
+	require.ErrorIs(t, err, ErrUpdateNoFields)
 }
 
 func TestUserUpdateUseCase_EmptyUsername(t *testing.T) {
 	repo := database.NewInMemoryUserRepository()
 	uc := NewUserUpdateUseCase(repo)
-	err := uc.UpdateProfile(context.Background(), "", RequestUpdate{})
+	_, err := uc.UpdateProfile(context.Background(), "", RequestUpdate{})
 	require.Error(t, err)
 	require.ErrorIs(t, err, ErrUpdateEmptyUsername)
 }
@@ -76,9 +158,10 @@ func TestUserUpdateUseCase_EmptyEmail(t *testing.T) {
 	repo := database.NewInMemoryUserRepository()
 	uc := NewUserUpdateUseCase(repo)
 
-	err := uc.UpdateProfile(context.Background(), "alice123", RequestUpdate{
-		Email: "",
-		Name:  "Alice",
+	_, err := uc.UpdateProfile(context.Background(), "alice123", RequestUpdate{
+		Email:  "",
+		Name:   "Alice",
+		Fields: []string{UpdateFieldEmail, UpdateFieldName},
 	})
 	require.Error(t, err)
 	require.ErrorIs(t, err, ErrUpdateEmptyEmail)
@@ -88,9 +171,10 @@ func TestUserUpdateUseCase_EmptyName(t *testing.T) {
 	repo := database.NewInMemoryUserRepository()
 	uc := NewUserUpdateUseCase(repo)
 
-	err := uc.UpdateProfile(context.Background(), "alice123", RequestUpdate{
-		Email: "alice@example.com",
-		Name:  "",
+	_, err := uc.UpdateProfile(context.Background(), "alice123", RequestUpdate{
+		Email:  "alice@example.com",
+		Name:   "",
+		Fields: []string{UpdateFieldAll},
 	})
 	require.Error(t, err)
 	require.ErrorIs(t, err, ErrUpdateEmptyName)
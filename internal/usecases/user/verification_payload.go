@@ -1,7 +1,37 @@
 package user
 
+import "time"
+
 type verificationEmailPayload struct {
 	Email   string `json:"email"`
 	Token   string `json:"token"`
 	Purpose string `json:"purpose"`
 }
+
+// emailChangeNoticePayload tells the previous address that an email change
+// was requested. NewEmail is masked so the notice does not leak the address.
+type emailChangeNoticePayload struct {
+	Email    string `json:"email"`
+	NewEmail string `json:"new_email"`
+	Purpose  string `json:"purpose"`
+}
+
+// newLoginAlertPayload warns the user about a login from a new device or IP.
+type newLoginAlertPayload struct {
+	Email      string    `json:"email"`
+	Purpose    string    `json:"purpose"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	LoggedInAt time.Time `json:"logged_in_at"`
+}
+
+// Outbox payload purposes understood by the notification service.
+const (
+	emailChangePurpose       = "email_change"
+	emailChangeNoticePurpose = "email_change_notice"
+	newLoginPurpose          = "new_login"
+	kycStatusPurpose         = "kyc_status"
+	priceAlertPurpose        = "price_alert"
+	tradeConfirmationPurpose = "trade_confirmation"
+	corporateActionPurpose   = "corporate_action"
+)
@@ -2,19 +2,21 @@ package user
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/audit"
 	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
 	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
 )
 
 var (
-	ErrVerifyEmptyToken   = errors.New("token is empty")
-	ErrVerifyTokenExpired = errors.New("verification token expired")
-	ErrVerifyTokenUsed    = errors.New("verification token already used")
+	ErrVerifyEmptyToken    = apperrors.New(apperrors.ErrInvalidArgument, "TOKEN_REQUIRED", "token is empty")
+	ErrVerifyTokenExpired  = apperrors.New(apperrors.ErrFailedPrecondition, "VERIFICATION_TOKEN_EXPIRED", "verification token expired")
+	ErrVerifyTokenUsed     = apperrors.New(apperrors.ErrFailedPrecondition, "VERIFICATION_TOKEN_USED", "verification token already used")
+	ErrVerifyTokenNotFound = apperrors.New(apperrors.ErrNotFound, "VERIFICATION_TOKEN_NOT_FOUND", "verification token not found")
 )
 
 type UserVerifyUseCase struct {
@@ -34,6 +36,13 @@ func (u UserVerifyUseCase) Verify(ctx context.Context, token string) (userentity
 	if err != nil {
 		return userentity.User{}, fmt.Errorf("find verification token: %w", err)
 	}
+	// Email change tokens are confirmed through UserEmailChangeUseCase only.
+	if vt.Purpose == userentity.VerificationPurposeEmailChange {
+		return userentity.User{}, ErrVerifyTokenNotFound
+	}
+	if err := requireActive(user); err != nil {
+		return userentity.User{}, err
+	}
 
 	if vt.ConsumedAt != nil {
 		return userentity.User{}, ErrVerifyTokenUsed
@@ -48,6 +57,7 @@ func (u UserVerifyUseCase) Verify(ctx context.Context, token string) (userentity
 	if err != nil {
 		return userentity.User{}, fmt.Errorf("verify user with token: %w", err)
 	}
+	audit.Record(ctx, userentity.AuditEvent{UserID: verifiedUser.Id, ActorID: verifiedUser.Id, Action: userentity.AuditActionVerify})
 
 	return verifiedUser, nil
 }
@@ -0,0 +1,298 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/apperrors"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+	"github.com/sinhnguyen1411/stock-trading-be/internal/ports"
+)
+
+const (
+	// MaxWatchlists is how many watchlists a user may keep.
+	MaxWatchlists = 20
+	// MaxWatchlistStocks is how many stock codes one watchlist may hold.
+	MaxWatchlistStocks = 50
+
+	maxWatchlistNameLen = 64
+	maxStockCodeLen     = 10
+
+	// defaultQuotePollInterval is how often WatchQuotes looks for new prices.
+	defaultQuotePollInterval = time.Second
+)
+
+var (
+	ErrWatchlistNotFound    = apperrors.New(apperrors.ErrNotFound, "WATCHLIST_NOT_FOUND", "watchlist not found")
+	ErrInvalidWatchlistName = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_WATCHLIST_NAME", "watchlist name must be 1 to 64 characters")
+	ErrInvalidStockCode     = apperrors.New(apperrors.ErrInvalidArgument, "INVALID_STOCK_CODE", "stock code must be 1 to 10 letters or digits")
+	ErrWatchlistLimit       = apperrors.New(apperrors.ErrResourceExhausted, "WATCHLIST_LIMIT", "at most 20 watchlists are allowed")
+	ErrWatchlistFull        = apperrors.New(apperrors.ErrResourceExhausted, "WATCHLIST_FULL", "a watchlist holds at most 50 stocks")
+)
+
+// UserWatchlistUseCase lets users keep named lists of stock codes and follow
+// their prices. Codes are validated against the stocks catalog by the
+// repository.
+type UserWatchlistUseCase struct {
+	users        ports.UserRepository
+	watchlists   ports.WatchlistRepository
+	stocks       ports.StockRepository
+	pollInterval time.Duration
+}
+
+func NewUserWatchlistUseCase(users ports.UserRepository, watchlists ports.WatchlistRepository, stocks ports.StockRepository) UserWatchlistUseCase {
+	return NewUserWatchlistUseCaseWithPollInterval(users, watchlists, stocks, defaultQuotePollInterval)
+}
+
+// NewUserWatchlistUseCaseWithPollInterval sets how often WatchQuotes polls
+// for new prices.
+func NewUserWatchlistUseCaseWithPollInterval(users ports.UserRepository, watchlists ports.WatchlistRepository, stocks ports.StockRepository, interval time.Duration) UserWatchlistUseCase {
+	if interval <= 0 {
+		interval = defaultQuotePollInterval
+	}
+	return UserWatchlistUseCase{users: users, watchlists: watchlists, stocks: stocks, pollInterval: interval}
+}
+
+// List returns the caller's watchlists, oldest first.
+func (u UserWatchlistUseCase) List(ctx context.Context, uid int64, username string) ([]userentity.Watchlist, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	watchlists, err := u.watchlists.ListWatchlists(ctx, owner.Id)
+	if err != nil {
+		return nil, fmt.Errorf("list watchlists: %w", err)
+	}
+	return watchlists, nil
+}
+
+// Create adds an empty watchlist. Names are unique per user, ignoring case.
+func (u UserWatchlistUseCase) Create(ctx context.Context, uid int64, username, name string) (userentity.Watchlist, error) {
+	name, err := normalizeWatchlistName(name)
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	// The limit is checked before the insert; two concurrent creations may
+	// exceed it by one, which is harmless.
+	existing, err := u.watchlists.ListWatchlists(ctx, owner.Id)
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("list watchlists: %w", err)
+	}
+	if len(existing) >= MaxWatchlists {
+		return userentity.Watchlist{}, ErrWatchlistLimit
+	}
+	watchlist, err := u.watchlists.CreateWatchlist(ctx, userentity.Watchlist{
+		UserID:    owner.Id,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("create watchlist: %w", err)
+	}
+	return watchlist, nil
+}
+
+func (u UserWatchlistUseCase) Rename(ctx context.Context, uid int64, username string, watchlistID int64, name string) (userentity.Watchlist, error) {
+	name, err := normalizeWatchlistName(name)
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	if _, err := u.watchlist(ctx, uid, username, watchlistID); err != nil {
+		return userentity.Watchlist{}, err
+	}
+	watchlist, err := u.watchlists.RenameWatchlist(ctx, watchlistID, name, time.Now().UTC())
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("rename watchlist: %w", err)
+	}
+	return watchlist, nil
+}
+
+func (u UserWatchlistUseCase) Delete(ctx context.Context, uid int64, username string, watchlistID int64) error {
+	if _, err := u.watchlist(ctx, uid, username, watchlistID); err != nil {
+		return err
+	}
+	if err := u.watchlists.DeleteWatchlist(ctx, watchlistID); err != nil {
+		return fmt.Errorf("delete watchlist: %w", err)
+	}
+	return nil
+}
+
+// AddStock appends a listed stock code to the end of the watchlist.
+func (u UserWatchlistUseCase) AddStock(ctx context.Context, uid int64, username string, watchlistID int64, code string) (userentity.Watchlist, error) {
+	code, err := normalizeStockCode(code)
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	current, err := u.watchlist(ctx, uid, username, watchlistID)
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	if len(current.Codes) >= MaxWatchlistStocks {
+		return userentity.Watchlist{}, ErrWatchlistFull
+	}
+	watchlist, err := u.watchlists.AddWatchlistStock(ctx, watchlistID, code, time.Now().UTC())
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("add watchlist stock: %w", err)
+	}
+	return watchlist, nil
+}
+
+func (u UserWatchlistUseCase) RemoveStock(ctx context.Context, uid int64, username string, watchlistID int64, code string) (userentity.Watchlist, error) {
+	code, err := normalizeStockCode(code)
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	if _, err := u.watchlist(ctx, uid, username, watchlistID); err != nil {
+		return userentity.Watchlist{}, err
+	}
+	watchlist, err := u.watchlists.RemoveWatchlistStock(ctx, watchlistID, code, time.Now().UTC())
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("remove watchlist stock: %w", err)
+	}
+	return watchlist, nil
+}
+
+// Reorder arranges the watchlist in the order of codes, which must list
+// every code on it exactly once.
+func (u UserWatchlistUseCase) Reorder(ctx context.Context, uid int64, username string, watchlistID int64, codes []string) (userentity.Watchlist, error) {
+	normalized := make([]string, 0, len(codes))
+	for _, code := range codes {
+		code, err := normalizeStockCode(code)
+		if err != nil {
+			return userentity.Watchlist{}, err
+		}
+		normalized = append(normalized, code)
+	}
+	if _, err := u.watchlist(ctx, uid, username, watchlistID); err != nil {
+		return userentity.Watchlist{}, err
+	}
+	watchlist, err := u.watchlists.ReorderWatchlist(ctx, watchlistID, normalized, time.Now().UTC())
+	if err != nil {
+		return userentity.Watchlist{}, fmt.Errorf("reorder watchlist: %w", err)
+	}
+	return watchlist, nil
+}
+
+// WatchQuotes calls send with the latest quote of every stock on the
+// watchlist, then again with the quotes that changed, until ctx is done or
+// send fails. Stocks added to the watchlist meanwhile are picked up; once the
+// watchlist is deleted it returns ErrWatchlistNotFound. Stocks without any
+// price are left out until their first one.
+func (u UserWatchlistUseCase) WatchQuotes(ctx context.Context, uid int64, username string, watchlistID int64, send func([]userentity.StockQuote) error) error {
+	watchlist, err := u.watchlist(ctx, uid, username, watchlistID)
+	if err != nil {
+		return err
+	}
+
+	sent := make(map[string]userentity.StockQuote)
+	ticker := time.NewTicker(u.pollInterval)
+	defer ticker.Stop()
+	for first := true; ; first = false {
+		quotes, err := u.stocks.LatestStockQuotes(ctx, watchlist.Codes)
+		if err != nil {
+			return fmt.Errorf("latest stock quotes: %w", err)
+		}
+		changed := make([]userentity.StockQuote, 0, len(quotes))
+		for _, quote := range quotes {
+			if last, ok := sent[quote.Code]; ok && last.Price == quote.Price && last.At.Equal(quote.At) {
+				continue
+			}
+			sent[quote.Code] = quote
+			changed = append(changed, quote)
+		}
+		if first || len(changed) > 0 {
+			if err := send(changed); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		watchlist, err = u.watchlists.GetWatchlist(ctx, watchlistID)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrNotFound) {
+				return ErrWatchlistNotFound
+			}
+			return fmt.Errorf("get watchlist: %w", err)
+		}
+		// Forget removed codes so they are sent again if re-added.
+		onList := make(map[string]bool, len(watchlist.Codes))
+		for _, code := range watchlist.Codes {
+			onList[code] = true
+		}
+		for code := range sent {
+			if !onList[code] {
+				delete(sent, code)
+			}
+		}
+	}
+}
+
+// watchlist returns the watchlist if it belongs to the caller. Watchlists of
+// other users are reported as not found.
+func (u UserWatchlistUseCase) watchlist(ctx context.Context, uid int64, username string, watchlistID int64) (userentity.Watchlist, error) {
+	owner, err := u.owner(ctx, uid, username)
+	if err != nil {
+		return userentity.Watchlist{}, err
+	}
+	watchlist, err := u.watchlists.GetWatchlist(ctx, watchlistID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return userentity.Watchlist{}, ErrWatchlistNotFound
+		}
+		return userentity.Watchlist{}, fmt.Errorf("get watchlist: %w", err)
+	}
+	if watchlist.UserID != owner.Id {
+		return userentity.Watchlist{}, ErrWatchlistNotFound
+	}
+	return watchlist, nil
+}
+
+func (u UserWatchlistUseCase) owner(ctx context.Context, uid int64, username string) (userentity.User, error) {
+	if username == "" {
+		return userentity.User{}, ErrEmptyUsername
+	}
+	owner, err := u.users.GetUser(ctx, username)
+	if err != nil {
+		return userentity.User{}, fmt.Errorf("get user: %w", err)
+	}
+	if owner.Id != uid {
+		return userentity.User{}, ErrPermissionDenied
+	}
+	return owner, nil
+}
+
+func normalizeWatchlistName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxWatchlistNameLen {
+		return "", ErrInvalidWatchlistName
+	}
+	return name, nil
+}
+
+// normalizeStockCode upper-cases a ticker such as "vnm" and checks its
+// shape; whether it is listed is up to the catalog.
+func normalizeStockCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" || len(code) > maxStockCodeLen {
+		return "", ErrInvalidStockCode
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return "", ErrInvalidStockCode
+		}
+	}
+	return code, nil
+}
@@ -0,0 +1,165 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sinhnguyen1411/stock-trading-be/internal/adapters/database"
+	userentity "github.com/sinhnguyen1411/stock-trading-be/internal/entities/user"
+)
+
+func seedStocks(repo *database.InMemoryUserRepository, codes ...string) {
+	for _, code := range codes {
+		repo.AddStock(userentity.Stock{Code: code, Name: code, CompanyName: code + " JSC"})
+	}
+}
+
+func TestUserWatchlistUseCase_ManageWatchlists(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	bob, err := seedUserWithToken(t, repo, "bob", "bob@example.com", "secret", "token-bob", true)
+	require.NoError(t, err)
+	seedStocks(repo, "VNM", "FPT", "HPG")
+	uc := NewUserWatchlistUseCase(repo, repo, repo)
+
+	watchlist, err := uc.Create(ctx, alice.Id, "alice", "  Blue chips ")
+	require.NoError(t, err)
+	assert.Equal(t, "Blue chips", watchlist.Name)
+	_, err = uc.Create(ctx, alice.Id, "alice", "blue CHIPS")
+	assert.ErrorIs(t, err, ErrConflict)
+	_, err = uc.Create(ctx, alice.Id, "alice", " ")
+	assert.ErrorIs(t, err, ErrInvalidWatchlistName)
+
+	for _, code := range []string{"vnm", "FPT", " hpg "} {
+		watchlist, err = uc.AddStock(ctx, alice.Id, "alice", watchlist.ID, code)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"VNM", "FPT", "HPG"}, watchlist.Codes)
+	_, err = uc.AddStock(ctx, alice.Id, "alice", watchlist.ID, "SSI")
+	assert.ErrorIs(t, err, ErrNotFound, "codes must be listed")
+	_, err = uc.AddStock(ctx, alice.Id, "alice", watchlist.ID, "VN-30")
+	assert.ErrorIs(t, err, ErrInvalidStockCode)
+
+	watchlist, err = uc.Reorder(ctx, alice.Id, "alice", watchlist.ID, []string{"hpg", "vnm", "fpt"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HPG", "VNM", "FPT"}, watchlist.Codes)
+	watchlist, err = uc.RemoveStock(ctx, alice.Id, "alice", watchlist.ID, "vnm")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HPG", "FPT"}, watchlist.Codes)
+	watchlist, err = uc.Rename(ctx, alice.Id, "alice", watchlist.ID, "Favourites")
+	require.NoError(t, err)
+	assert.Equal(t, "Favourites", watchlist.Name)
+
+	// Other users see neither the watchlist nor its existence.
+	_, err = uc.AddStock(ctx, bob.Id, "bob", watchlist.ID, "VNM")
+	assert.ErrorIs(t, err, ErrWatchlistNotFound)
+	assert.ErrorIs(t, uc.Delete(ctx, bob.Id, "bob", watchlist.ID), ErrWatchlistNotFound)
+	_, err = uc.List(ctx, bob.Id, "alice")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	watchlists, err := uc.List(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	require.Len(t, watchlists, 1)
+	require.NoError(t, uc.Delete(ctx, alice.Id, "alice", watchlist.ID))
+	watchlists, err = uc.List(ctx, alice.Id, "alice")
+	require.NoError(t, err)
+	assert.Empty(t, watchlists)
+}
+
+func TestUserWatchlistUseCase_Limits(t *testing.T) {
+	repo := newTestRepo()
+	ctx := context.Background()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	uc := NewUserWatchlistUseCase(repo, repo, repo)
+
+	var watchlist userentity.Watchlist
+	for i := 0; i < MaxWatchlists; i++ {
+		var err error
+		watchlist, err = uc.Create(ctx, alice.Id, "alice", fmt.Sprintf("list %d", i))
+		require.NoError(t, err)
+	}
+	_, err := uc.Create(ctx, alice.Id, "alice", "one more")
+	assert.ErrorIs(t, err, ErrWatchlistLimit)
+
+	for i := 0; i < MaxWatchlistStocks; i++ {
+		code := fmt.Sprintf("S%03d", i)
+		seedStocks(repo, code)
+		_, err := uc.AddStock(ctx, alice.Id, "alice", watchlist.ID, code)
+		require.NoError(t, err)
+	}
+	seedStocks(repo, "VNM")
+	_, err = uc.AddStock(ctx, alice.Id, "alice", watchlist.ID, "VNM")
+	assert.ErrorIs(t, err, ErrWatchlistFull)
+}
+
+func TestUserWatchlistUseCase_WatchQuotes(t *testing.T) {
+	repo := newTestRepo()
+	alice := seedVerifiedUser(t, repo, "alice", "secret")
+	seedStocks(repo, "VNM", "FPT", "HPG")
+	uc := NewUserWatchlistUseCaseWithPollInterval(repo, repo, repo, 5*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	watchlist, err := uc.Create(ctx, alice.Id, "alice", "core")
+	require.NoError(t, err)
+	for _, code := range []string{"VNM", "FPT"} {
+		_, err = uc.AddStock(ctx, alice.Id, "alice", watchlist.ID, code)
+		require.NoError(t, err)
+	}
+	at := time.Now().UTC()
+	require.NoError(t, repo.RecordStockPrice("VNM", 70000, at))
+
+	updates := make(chan []userentity.StockQuote)
+	done := make(chan error, 1)
+	go func() {
+		done <- uc.WatchQuotes(ctx, alice.Id, "alice", watchlist.ID, func(quotes []userentity.StockQuote) error {
+			select {
+			case updates <- quotes:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	next := func() []userentity.StockQuote {
+		t.Helper()
+		select {
+		case quotes := <-updates:
+			return quotes
+		case <-ctx.Done():
+			t.Fatal("no quote update")
+			return nil
+		}
+	}
+	codesOf := func(quotes []userentity.StockQuote) []string {
+		codes := make([]string, 0, len(quotes))
+		for _, quote := range quotes {
+			codes = append(codes, fmt.Sprintf("%s=%d", quote.Code, quote.Price))
+		}
+		return codes
+	}
+
+	assert.Equal(t, []string{"VNM=70000"}, codesOf(next()), "the first update is a snapshot")
+	require.NoError(t, repo.RecordStockPrice("FPT", 120000, at))
+	assert.Equal(t, []string{"FPT=120000"}, codesOf(next()), "later updates hold only changes")
+
+	_, err = uc.AddStock(ctx, alice.Id, "alice", watchlist.ID, "HPG")
+	require.NoError(t, err)
+	require.NoError(t, repo.RecordStockPrice("HPG", 25000, at))
+	require.NoError(t, repo.RecordStockPrice("VNM", 70500, at.Add(time.Second)))
+	// The poller may see the two prices in one update or in two.
+	var changed []string
+	for len(changed) < 2 {
+		changed = append(changed, codesOf(next())...)
+	}
+	assert.ElementsMatch(t, []string{"VNM=70500", "HPG=25000"}, changed, "stocks added meanwhile are followed")
+
+	require.NoError(t, uc.Delete(ctx, alice.Id, "alice", watchlist.ID))
+	assert.ErrorIs(t, <-done, ErrWatchlistNotFound)
+}